@@ -67,6 +67,62 @@ func (Group_GroupStatus) EnumDescriptor() ([]byte, []int) {
 	return file_ag_ag_proto_rawDescGZIP(), []int{3, 0}
 }
 
+// LatePolicy determines how submissions built after the deadline are handled.
+type Course_LatePolicy int32
+
+const (
+	Course_DEFAULT        Course_LatePolicy = 0 // slip days for courses; the course's policy for assignments
+	Course_SLIP_DAYS      Course_LatePolicy = 1 // late days are deducted from the student's slip days
+	Course_LINEAR_PENALTY Course_LatePolicy = 2 // the score is reduced by latePenalty percent per day late, prorated by the hour
+	Course_STEP_PENALTY   Course_LatePolicy = 3 // the score is reduced by latePenalty percent per started day late
+	Course_HARD_CUTOFF    Course_LatePolicy = 4 // late submissions score zero
+)
+
+// Enum value maps for Course_LatePolicy.
+var (
+	Course_LatePolicy_name = map[int32]string{
+		0: "DEFAULT",
+		1: "SLIP_DAYS",
+		2: "LINEAR_PENALTY",
+		3: "STEP_PENALTY",
+		4: "HARD_CUTOFF",
+	}
+	Course_LatePolicy_value = map[string]int32{
+		"DEFAULT":        0,
+		"SLIP_DAYS":      1,
+		"LINEAR_PENALTY": 2,
+		"STEP_PENALTY":   3,
+		"HARD_CUTOFF":    4,
+	}
+)
+
+func (x Course_LatePolicy) Enum() *Course_LatePolicy {
+	p := new(Course_LatePolicy)
+	*p = x
+	return p
+}
+
+func (x Course_LatePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Course_LatePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_ag_ag_proto_enumTypes[1].Descriptor()
+}
+
+func (Course_LatePolicy) Type() protoreflect.EnumType {
+	return &file_ag_ag_proto_enumTypes[1]
+}
+
+func (x Course_LatePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Course_LatePolicy.Descriptor instead.
+func (Course_LatePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{5, 0}
+}
+
 type Repository_Type int32
 
 const (
@@ -109,11 +165,11 @@ func (x Repository_Type) String() string {
 }
 
 func (Repository_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_ag_ag_proto_enumTypes[1].Descriptor()
+	return file_ag_ag_proto_enumTypes[2].Descriptor()
 }
 
 func (Repository_Type) Type() protoreflect.EnumType {
-	return &file_ag_ag_proto_enumTypes[1]
+	return &file_ag_ag_proto_enumTypes[2]
 }
 
 func (x Repository_Type) Number() protoreflect.EnumNumber {
@@ -161,11 +217,11 @@ func (x Enrollment_UserStatus) String() string {
 }
 
 func (Enrollment_UserStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_ag_ag_proto_enumTypes[2].Descriptor()
+	return file_ag_ag_proto_enumTypes[3].Descriptor()
 }
 
 func (Enrollment_UserStatus) Type() protoreflect.EnumType {
-	return &file_ag_ag_proto_enumTypes[2]
+	return &file_ag_ag_proto_enumTypes[3]
 }
 
 func (x Enrollment_UserStatus) Number() protoreflect.EnumNumber {
@@ -213,11 +269,11 @@ func (x Enrollment_DisplayState) String() string {
 }
 
 func (Enrollment_DisplayState) Descriptor() protoreflect.EnumDescriptor {
-	return file_ag_ag_proto_enumTypes[3].Descriptor()
+	return file_ag_ag_proto_enumTypes[4].Descriptor()
 }
 
 func (Enrollment_DisplayState) Type() protoreflect.EnumType {
-	return &file_ag_ag_proto_enumTypes[3]
+	return &file_ag_ag_proto_enumTypes[4]
 }
 
 func (x Enrollment_DisplayState) Number() protoreflect.EnumNumber {
@@ -265,11 +321,11 @@ func (x Submission_Status) String() string {
 }
 
 func (Submission_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_ag_ag_proto_enumTypes[4].Descriptor()
+	return file_ag_ag_proto_enumTypes[5].Descriptor()
 }
 
 func (Submission_Status) Type() protoreflect.EnumType {
-	return &file_ag_ag_proto_enumTypes[4]
+	return &file_ag_ag_proto_enumTypes[5]
 }
 
 func (x Submission_Status) Number() protoreflect.EnumNumber {
@@ -278,7 +334,62 @@ func (x Submission_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Submission_Status.Descriptor instead.
 func (Submission_Status) EnumDescriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{16, 0}
+	return file_ag_ag_proto_rawDescGZIP(), []int{22, 0}
+}
+
+type BuildJob_Status int32
+
+const (
+	BuildJob_QUEUED   BuildJob_Status = 0
+	BuildJob_RUNNING  BuildJob_Status = 1
+	BuildJob_DONE     BuildJob_Status = 2
+	BuildJob_FAILED   BuildJob_Status = 3
+	BuildJob_CANCELED BuildJob_Status = 4
+)
+
+// Enum value maps for BuildJob_Status.
+var (
+	BuildJob_Status_name = map[int32]string{
+		0: "QUEUED",
+		1: "RUNNING",
+		2: "DONE",
+		3: "FAILED",
+		4: "CANCELED",
+	}
+	BuildJob_Status_value = map[string]int32{
+		"QUEUED":   0,
+		"RUNNING":  1,
+		"DONE":     2,
+		"FAILED":   3,
+		"CANCELED": 4,
+	}
+)
+
+func (x BuildJob_Status) Enum() *BuildJob_Status {
+	p := new(BuildJob_Status)
+	*p = x
+	return p
+}
+
+func (x BuildJob_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BuildJob_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_ag_ag_proto_enumTypes[6].Descriptor()
+}
+
+func (BuildJob_Status) Type() protoreflect.EnumType {
+	return &file_ag_ag_proto_enumTypes[6]
+}
+
+func (x BuildJob_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BuildJob_Status.Descriptor instead.
+func (BuildJob_Status) EnumDescriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{32, 0}
 }
 
 type GradingCriterion_Grade int32
@@ -314,11 +425,11 @@ func (x GradingCriterion_Grade) String() string {
 }
 
 func (GradingCriterion_Grade) Descriptor() protoreflect.EnumDescriptor {
-	return file_ag_ag_proto_enumTypes[5].Descriptor()
+	return file_ag_ag_proto_enumTypes[7].Descriptor()
 }
 
 func (GradingCriterion_Grade) Type() protoreflect.EnumType {
-	return &file_ag_ag_proto_enumTypes[5]
+	return &file_ag_ag_proto_enumTypes[7]
 }
 
 func (x GradingCriterion_Grade) Number() protoreflect.EnumNumber {
@@ -327,7 +438,7 @@ func (x GradingCriterion_Grade) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GradingCriterion_Grade.Descriptor instead.
 func (GradingCriterion_Grade) EnumDescriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{20, 0}
+	return file_ag_ag_proto_rawDescGZIP(), []int{41, 0}
 }
 
 type SubmissionsForCourseRequest_Type int32
@@ -363,11 +474,11 @@ func (x SubmissionsForCourseRequest_Type) String() string {
 }
 
 func (SubmissionsForCourseRequest_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_ag_ag_proto_enumTypes[6].Descriptor()
+	return file_ag_ag_proto_enumTypes[8].Descriptor()
 }
 
 func (SubmissionsForCourseRequest_Type) Type() protoreflect.EnumType {
-	return &file_ag_ag_proto_enumTypes[6]
+	return &file_ag_ag_proto_enumTypes[8]
 }
 
 func (x SubmissionsForCourseRequest_Type) Number() protoreflect.EnumNumber {
@@ -376,7 +487,59 @@ func (x SubmissionsForCourseRequest_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SubmissionsForCourseRequest_Type.Descriptor instead.
 func (SubmissionsForCourseRequest_Type) EnumDescriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{44, 0}
+	return file_ag_ag_proto_rawDescGZIP(), []int{68, 0}
+}
+
+type GradebookRequest_Format int32
+
+const (
+	GradebookRequest_CSV    GradebookRequest_Format = 0
+	GradebookRequest_XLSX   GradebookRequest_Format = 1
+	GradebookRequest_CANVAS GradebookRequest_Format = 2 // Canvas gradebook import (CSV)
+	GradebookRequest_MOODLE GradebookRequest_Format = 3 // Moodle grade import (CSV)
+)
+
+// Enum value maps for GradebookRequest_Format.
+var (
+	GradebookRequest_Format_name = map[int32]string{
+		0: "CSV",
+		1: "XLSX",
+		2: "CANVAS",
+		3: "MOODLE",
+	}
+	GradebookRequest_Format_value = map[string]int32{
+		"CSV":    0,
+		"XLSX":   1,
+		"CANVAS": 2,
+		"MOODLE": 3,
+	}
+)
+
+func (x GradebookRequest_Format) Enum() *GradebookRequest_Format {
+	p := new(GradebookRequest_Format)
+	*p = x
+	return p
+}
+
+func (x GradebookRequest_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GradebookRequest_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_ag_ag_proto_enumTypes[9].Descriptor()
+}
+
+func (GradebookRequest_Format) Type() protoreflect.EnumType {
+	return &file_ag_ag_proto_enumTypes[9]
+}
+
+func (x GradebookRequest_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GradebookRequest_Format.Descriptor instead.
+func (GradebookRequest_Format) EnumDescriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{78, 0}
 }
 
 type User struct {
@@ -774,6 +937,9 @@ type Course struct {
 	OrganizationPath string                `protobuf:"bytes,9,opt,name=organizationPath,proto3" json:"organizationPath,omitempty"` // The organization's SCM name, e.g. uis-dat520-2020.
 	SlipDays         uint32                `protobuf:"varint,10,opt,name=slipDays,proto3" json:"slipDays,omitempty"`
 	Enrolled         Enrollment_UserStatus `protobuf:"varint,11,opt,name=enrolled,proto3,enum=ag.Enrollment_UserStatus" json:"enrolled,omitempty" sql:"-"`
+	GracePeriod      string                `protobuf:"bytes,15,opt,name=gracePeriod,proto3" json:"gracePeriod,omitempty"` // duration after deadlines without using slip days, such as "2h"; defaults to two hours
+	LatePolicy       Course_LatePolicy     `protobuf:"varint,16,opt,name=latePolicy,proto3,enum=ag.Course_LatePolicy" json:"latePolicy,omitempty"`
+	LatePenalty      uint32                `protobuf:"varint,17,opt,name=latePenalty,proto3" json:"latePenalty,omitempty"` // percent of the score deducted per day late
 	Enrollments      []*Enrollment         `protobuf:"bytes,12,rep,name=enrollments,proto3" json:"enrollments,omitempty"`
 	Assignments      []*Assignment         `protobuf:"bytes,13,rep,name=assignments,proto3" json:"assignments,omitempty"`
 	Groups           []*Group              `protobuf:"bytes,14,rep,name=groups,proto3" json:"groups,omitempty"`
@@ -888,6 +1054,27 @@ func (x *Course) GetEnrolled() Enrollment_UserStatus {
 	return Enrollment_NONE
 }
 
+func (x *Course) GetGracePeriod() string {
+	if x != nil {
+		return x.GracePeriod
+	}
+	return ""
+}
+
+func (x *Course) GetLatePolicy() Course_LatePolicy {
+	if x != nil {
+		return x.LatePolicy
+	}
+	return Course_DEFAULT
+}
+
+func (x *Course) GetLatePenalty() uint32 {
+	if x != nil {
+		return x.LatePenalty
+	}
+	return 0
+}
+
 func (x *Course) GetEnrollments() []*Enrollment {
 	if x != nil {
 		return x.Enrollments
@@ -1273,16 +1460,19 @@ func (x *UsedSlipDays) GetUsedSlipDays() uint32 {
 	return 0
 }
 
-type Enrollments struct {
+// UsedSlipDaysChange is a change of the slip days used by a student for an assignment.
+type UsedSlipDaysChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enrollments []*Enrollment `protobuf:"bytes,1,rep,name=enrollments,proto3" json:"enrollments,omitempty"`
+	AssignmentID    uint64 `protobuf:"varint,1,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	OldUsedSlipDays uint32 `protobuf:"varint,2,opt,name=oldUsedSlipDays,proto3" json:"oldUsedSlipDays,omitempty"`
+	NewUsedSlipDays uint32 `protobuf:"varint,3,opt,name=newUsedSlipDays,proto3" json:"newUsedSlipDays,omitempty"`
 }
 
-func (x *Enrollments) Reset() {
-	*x = Enrollments{}
+func (x *UsedSlipDaysChange) Reset() {
+	*x = UsedSlipDaysChange{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ag_ag_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1290,13 +1480,13 @@ func (x *Enrollments) Reset() {
 	}
 }
 
-func (x *Enrollments) String() string {
+func (x *UsedSlipDaysChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Enrollments) ProtoMessage() {}
+func (*UsedSlipDaysChange) ProtoMessage() {}
 
-func (x *Enrollments) ProtoReflect() protoreflect.Message {
+func (x *UsedSlipDaysChange) ProtoReflect() protoreflect.Message {
 	mi := &file_ag_ag_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1308,29 +1498,49 @@ func (x *Enrollments) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Enrollments.ProtoReflect.Descriptor instead.
-func (*Enrollments) Descriptor() ([]byte, []int) {
+// Deprecated: Use UsedSlipDaysChange.ProtoReflect.Descriptor instead.
+func (*UsedSlipDaysChange) Descriptor() ([]byte, []int) {
 	return file_ag_ag_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Enrollments) GetEnrollments() []*Enrollment {
+func (x *UsedSlipDaysChange) GetAssignmentID() uint64 {
 	if x != nil {
-		return x.Enrollments
+		return x.AssignmentID
 	}
-	return nil
+	return 0
 }
 
-type SubmissionLink struct {
+func (x *UsedSlipDaysChange) GetOldUsedSlipDays() uint32 {
+	if x != nil {
+		return x.OldUsedSlipDays
+	}
+	return 0
+}
+
+func (x *UsedSlipDaysChange) GetNewUsedSlipDays() uint32 {
+	if x != nil {
+		return x.NewUsedSlipDays
+	}
+	return 0
+}
+
+// SlipDaysChange is a change of the used or remaining slip days of a student.
+type SlipDaysChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Assignment *Assignment `protobuf:"bytes,1,opt,name=assignment,proto3" json:"assignment,omitempty"`
-	Submission *Submission `protobuf:"bytes,2,opt,name=submission,proto3" json:"submission,omitempty"`
+	EnrollmentID      uint64                `protobuf:"varint,1,opt,name=enrollmentID,proto3" json:"enrollmentID,omitempty"`
+	UserID            uint64                `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	Login             string                `protobuf:"bytes,3,opt,name=login,proto3" json:"login,omitempty"`
+	OldRemaining      int32                 `protobuf:"varint,4,opt,name=oldRemaining,proto3" json:"oldRemaining,omitempty"`
+	NewRemaining      int32                 `protobuf:"varint,5,opt,name=newRemaining,proto3" json:"newRemaining,omitempty"`
+	Assignments       []*UsedSlipDaysChange `protobuf:"bytes,6,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	KeptAssignmentIDs []uint64              `protobuf:"varint,7,rep,packed,name=keptAssignmentIDs,proto3" json:"keptAssignmentIDs,omitempty"` // assignments whose used slip days were kept because they could not be replayed
 }
 
-func (x *SubmissionLink) Reset() {
-	*x = SubmissionLink{}
+func (x *SlipDaysChange) Reset() {
+	*x = SlipDaysChange{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ag_ag_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1338,13 +1548,13 @@ func (x *SubmissionLink) Reset() {
 	}
 }
 
-func (x *SubmissionLink) String() string {
+func (x *SlipDaysChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmissionLink) ProtoMessage() {}
+func (*SlipDaysChange) ProtoMessage() {}
 
-func (x *SubmissionLink) ProtoReflect() protoreflect.Message {
+func (x *SlipDaysChange) ProtoReflect() protoreflect.Message {
 	mi := &file_ag_ag_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1356,36 +1566,74 @@ func (x *SubmissionLink) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmissionLink.ProtoReflect.Descriptor instead.
-func (*SubmissionLink) Descriptor() ([]byte, []int) {
+// Deprecated: Use SlipDaysChange.ProtoReflect.Descriptor instead.
+func (*SlipDaysChange) Descriptor() ([]byte, []int) {
 	return file_ag_ag_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *SubmissionLink) GetAssignment() *Assignment {
+func (x *SlipDaysChange) GetEnrollmentID() uint64 {
 	if x != nil {
-		return x.Assignment
+		return x.EnrollmentID
+	}
+	return 0
+}
+
+func (x *SlipDaysChange) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *SlipDaysChange) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *SlipDaysChange) GetOldRemaining() int32 {
+	if x != nil {
+		return x.OldRemaining
+	}
+	return 0
+}
+
+func (x *SlipDaysChange) GetNewRemaining() int32 {
+	if x != nil {
+		return x.NewRemaining
+	}
+	return 0
+}
+
+func (x *SlipDaysChange) GetAssignments() []*UsedSlipDaysChange {
+	if x != nil {
+		return x.Assignments
 	}
 	return nil
 }
 
-func (x *SubmissionLink) GetSubmission() *Submission {
+func (x *SlipDaysChange) GetKeptAssignmentIDs() []uint64 {
 	if x != nil {
-		return x.Submission
+		return x.KeptAssignmentIDs
 	}
 	return nil
 }
 
-type EnrollmentLink struct {
+// SlipDaysRecomputation is the result of recomputing the slip days of the students of a course.
+type SlipDaysRecomputation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enrollment  *Enrollment       `protobuf:"bytes,2,opt,name=enrollment,proto3" json:"enrollment,omitempty"` // must have course and user/group preloaded
-	Submissions []*SubmissionLink `protobuf:"bytes,3,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	OldSlipDays uint32            `protobuf:"varint,1,opt,name=oldSlipDays,proto3" json:"oldSlipDays,omitempty"` // the course's slip days before the recomputation
+	NewSlipDays uint32            `protobuf:"varint,2,opt,name=newSlipDays,proto3" json:"newSlipDays,omitempty"`
+	Changes     []*SlipDaysChange `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
+	Applied     bool              `protobuf:"varint,4,opt,name=applied,proto3" json:"applied,omitempty"` // whether the changes have been saved
 }
 
-func (x *EnrollmentLink) Reset() {
-	*x = EnrollmentLink{}
+func (x *SlipDaysRecomputation) Reset() {
+	*x = SlipDaysRecomputation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ag_ag_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1393,13 +1641,13 @@ func (x *EnrollmentLink) Reset() {
 	}
 }
 
-func (x *EnrollmentLink) String() string {
+func (x *SlipDaysRecomputation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnrollmentLink) ProtoMessage() {}
+func (*SlipDaysRecomputation) ProtoMessage() {}
 
-func (x *EnrollmentLink) ProtoReflect() protoreflect.Message {
+func (x *SlipDaysRecomputation) ProtoReflect() protoreflect.Message {
 	mi := &file_ag_ag_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1411,36 +1659,49 @@ func (x *EnrollmentLink) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnrollmentLink.ProtoReflect.Descriptor instead.
-func (*EnrollmentLink) Descriptor() ([]byte, []int) {
+// Deprecated: Use SlipDaysRecomputation.ProtoReflect.Descriptor instead.
+func (*SlipDaysRecomputation) Descriptor() ([]byte, []int) {
 	return file_ag_ag_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *EnrollmentLink) GetEnrollment() *Enrollment {
+func (x *SlipDaysRecomputation) GetOldSlipDays() uint32 {
 	if x != nil {
-		return x.Enrollment
+		return x.OldSlipDays
 	}
-	return nil
+	return 0
 }
 
-func (x *EnrollmentLink) GetSubmissions() []*SubmissionLink {
+func (x *SlipDaysRecomputation) GetNewSlipDays() uint32 {
 	if x != nil {
-		return x.Submissions
+		return x.NewSlipDays
+	}
+	return 0
+}
+
+func (x *SlipDaysRecomputation) GetChanges() []*SlipDaysChange {
+	if x != nil {
+		return x.Changes
 	}
 	return nil
 }
 
-type CourseSubmissions struct {
+func (x *SlipDaysRecomputation) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+type Enrollments struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Course *Course           `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"` // preloaded assignments
-	Links  []*EnrollmentLink `protobuf:"bytes,2,rep,name=links,proto3" json:"links,omitempty"`
+	Enrollments []*Enrollment `protobuf:"bytes,1,rep,name=enrollments,proto3" json:"enrollments,omitempty"`
 }
 
-func (x *CourseSubmissions) Reset() {
-	*x = CourseSubmissions{}
+func (x *Enrollments) Reset() {
+	*x = Enrollments{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ag_ag_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1448,13 +1709,13 @@ func (x *CourseSubmissions) Reset() {
 	}
 }
 
-func (x *CourseSubmissions) String() string {
+func (x *Enrollments) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CourseSubmissions) ProtoMessage() {}
+func (*Enrollments) ProtoMessage() {}
 
-func (x *CourseSubmissions) ProtoReflect() protoreflect.Message {
+func (x *Enrollments) ProtoReflect() protoreflect.Message {
 	mi := &file_ag_ag_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1466,48 +1727,29 @@ func (x *CourseSubmissions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CourseSubmissions.ProtoReflect.Descriptor instead.
-func (*CourseSubmissions) Descriptor() ([]byte, []int) {
+// Deprecated: Use Enrollments.ProtoReflect.Descriptor instead.
+func (*Enrollments) Descriptor() ([]byte, []int) {
 	return file_ag_ag_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CourseSubmissions) GetCourse() *Course {
-	if x != nil {
-		return x.Course
-	}
-	return nil
-}
-
-func (x *CourseSubmissions) GetLinks() []*EnrollmentLink {
+func (x *Enrollments) GetEnrollments() []*Enrollment {
 	if x != nil {
-		return x.Links
+		return x.Enrollments
 	}
 	return nil
 }
 
-type Assignment struct {
+type SubmissionLink struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID                uint64              `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	CourseID          uint64              `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	Name              string              `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	ScriptFile        string              `protobuf:"bytes,4,opt,name=scriptFile,proto3" json:"scriptFile,omitempty"`
-	Deadline          string              `protobuf:"bytes,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
-	AutoApprove       bool                `protobuf:"varint,6,opt,name=autoApprove,proto3" json:"autoApprove,omitempty"`
-	Order             uint32              `protobuf:"varint,7,opt,name=order,proto3" json:"order,omitempty"`
-	IsGroupLab        bool                `protobuf:"varint,8,opt,name=isGroupLab,proto3" json:"isGroupLab,omitempty"`
-	ScoreLimit        uint32              `protobuf:"varint,9,opt,name=scoreLimit,proto3" json:"scoreLimit,omitempty"` // defines minimal score limit for auto approval
-	Reviewers         uint32              `protobuf:"varint,10,opt,name=reviewers,proto3" json:"reviewers,omitempty"`  // number of TA's to review submissions for this assignment
-	SkipTests         bool                `protobuf:"varint,11,opt,name=skipTests,proto3" json:"skipTests,omitempty"`
-	Submissions       []*Submission       `protobuf:"bytes,12,rep,name=submissions,proto3" json:"submissions,omitempty"`
-	GradingBenchmarks []*GradingBenchmark `protobuf:"bytes,13,rep,name=gradingBenchmarks,proto3" json:"gradingBenchmarks,omitempty"`
-	ContainerTimeout  uint32              `protobuf:"varint,14,opt,name=containerTimeout,proto3" json:"containerTimeout,omitempty"`
+	Assignment *Assignment `protobuf:"bytes,1,opt,name=assignment,proto3" json:"assignment,omitempty"`
+	Submission *Submission `protobuf:"bytes,2,opt,name=submission,proto3" json:"submission,omitempty"`
 }
 
-func (x *Assignment) Reset() {
-	*x = Assignment{}
+func (x *SubmissionLink) Reset() {
+	*x = SubmissionLink{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ag_ag_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1515,13 +1757,13 @@ func (x *Assignment) Reset() {
 	}
 }
 
-func (x *Assignment) String() string {
+func (x *SubmissionLink) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Assignment) ProtoMessage() {}
+func (*SubmissionLink) ProtoMessage() {}
 
-func (x *Assignment) ProtoReflect() protoreflect.Message {
+func (x *SubmissionLink) ProtoReflect() protoreflect.Message {
 	mi := &file_ag_ag_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1533,134 +1775,106 @@ func (x *Assignment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Assignment.ProtoReflect.Descriptor instead.
-func (*Assignment) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubmissionLink.ProtoReflect.Descriptor instead.
+func (*SubmissionLink) Descriptor() ([]byte, []int) {
 	return file_ag_ag_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Assignment) GetID() uint64 {
-	if x != nil {
-		return x.ID
-	}
-	return 0
-}
-
-func (x *Assignment) GetCourseID() uint64 {
-	if x != nil {
-		return x.CourseID
-	}
-	return 0
-}
-
-func (x *Assignment) GetName() string {
+func (x *SubmissionLink) GetAssignment() *Assignment {
 	if x != nil {
-		return x.Name
+		return x.Assignment
 	}
-	return ""
+	return nil
 }
 
-func (x *Assignment) GetScriptFile() string {
+func (x *SubmissionLink) GetSubmission() *Submission {
 	if x != nil {
-		return x.ScriptFile
+		return x.Submission
 	}
-	return ""
+	return nil
 }
 
-func (x *Assignment) GetDeadline() string {
-	if x != nil {
-		return x.Deadline
-	}
-	return ""
-}
+type EnrollmentLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *Assignment) GetAutoApprove() bool {
-	if x != nil {
-		return x.AutoApprove
-	}
-	return false
+	Enrollment  *Enrollment       `protobuf:"bytes,2,opt,name=enrollment,proto3" json:"enrollment,omitempty"` // must have course and user/group preloaded
+	Submissions []*SubmissionLink `protobuf:"bytes,3,rep,name=submissions,proto3" json:"submissions,omitempty"`
 }
 
-func (x *Assignment) GetOrder() uint32 {
-	if x != nil {
-		return x.Order
+func (x *EnrollmentLink) Reset() {
+	*x = EnrollmentLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Assignment) GetIsGroupLab() bool {
-	if x != nil {
-		return x.IsGroupLab
-	}
-	return false
+func (x *EnrollmentLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Assignment) GetScoreLimit() uint32 {
-	if x != nil {
-		return x.ScoreLimit
-	}
-	return 0
-}
+func (*EnrollmentLink) ProtoMessage() {}
 
-func (x *Assignment) GetReviewers() uint32 {
-	if x != nil {
-		return x.Reviewers
+func (x *EnrollmentLink) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Assignment) GetSkipTests() bool {
-	if x != nil {
-		return x.SkipTests
-	}
-	return false
+// Deprecated: Use EnrollmentLink.ProtoReflect.Descriptor instead.
+func (*EnrollmentLink) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *Assignment) GetSubmissions() []*Submission {
+func (x *EnrollmentLink) GetEnrollment() *Enrollment {
 	if x != nil {
-		return x.Submissions
+		return x.Enrollment
 	}
 	return nil
 }
 
-func (x *Assignment) GetGradingBenchmarks() []*GradingBenchmark {
+func (x *EnrollmentLink) GetSubmissions() []*SubmissionLink {
 	if x != nil {
-		return x.GradingBenchmarks
+		return x.Submissions
 	}
 	return nil
 }
 
-func (x *Assignment) GetContainerTimeout() uint32 {
-	if x != nil {
-		return x.ContainerTimeout
-	}
-	return 0
-}
-
-type Assignments struct {
+type CourseSubmissions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Assignments []*Assignment `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	Course *Course           `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"` // preloaded assignments
+	Links  []*EnrollmentLink `protobuf:"bytes,2,rep,name=links,proto3" json:"links,omitempty"`
 }
 
-func (x *Assignments) Reset() {
-	*x = Assignments{}
+func (x *CourseSubmissions) Reset() {
+	*x = CourseSubmissions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[15]
+		mi := &file_ag_ag_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Assignments) String() string {
+func (x *CourseSubmissions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Assignments) ProtoMessage() {}
+func (*CourseSubmissions) ProtoMessage() {}
 
-func (x *Assignments) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[15]
+func (x *CourseSubmissions) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1671,54 +1885,80 @@ func (x *Assignments) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Assignments.ProtoReflect.Descriptor instead.
-func (*Assignments) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use CourseSubmissions.ProtoReflect.Descriptor instead.
+func (*CourseSubmissions) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *Assignments) GetAssignments() []*Assignment {
+func (x *CourseSubmissions) GetCourse() *Course {
 	if x != nil {
-		return x.Assignments
+		return x.Course
 	}
 	return nil
 }
 
-type Submission struct {
+func (x *CourseSubmissions) GetLinks() []*EnrollmentLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+type Assignment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID           uint64            `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	AssignmentID uint64            `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
-	UserID       uint64            `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty"`
-	GroupID      uint64            `protobuf:"varint,4,opt,name=groupID,proto3" json:"groupID,omitempty"`
-	Score        uint32            `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
-	ScoreObjects string            `protobuf:"bytes,6,opt,name=scoreObjects,proto3" json:"scoreObjects,omitempty"`
-	BuildInfo    string            `protobuf:"bytes,7,opt,name=buildInfo,proto3" json:"buildInfo,omitempty"`
-	CommitHash   string            `protobuf:"bytes,8,opt,name=commitHash,proto3" json:"commitHash,omitempty"`
-	Released     bool              `protobuf:"varint,9,opt,name=released,proto3" json:"released,omitempty"` // defines whether the feedback is visible for the student or group members
-	Status       Submission_Status `protobuf:"varint,10,opt,name=status,proto3,enum=ag.Submission_Status" json:"status,omitempty"`
-	ApprovedDate string            `protobuf:"bytes,11,opt,name=approvedDate,proto3" json:"approvedDate,omitempty"`
-	Reviews      []*Review         `protobuf:"bytes,12,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	ID                uint64              `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID          uint64              `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Name              string              `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ScriptFile        string              `protobuf:"bytes,4,opt,name=scriptFile,proto3" json:"scriptFile,omitempty"`
+	Deadline          string              `protobuf:"bytes,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	AutoApprove       bool                `protobuf:"varint,6,opt,name=autoApprove,proto3" json:"autoApprove,omitempty"`
+	Order             uint32              `protobuf:"varint,7,opt,name=order,proto3" json:"order,omitempty"`
+	IsGroupLab        bool                `protobuf:"varint,8,opt,name=isGroupLab,proto3" json:"isGroupLab,omitempty"`
+	ScoreLimit        uint32              `protobuf:"varint,9,opt,name=scoreLimit,proto3" json:"scoreLimit,omitempty"` // defines minimal score limit for auto approval
+	Reviewers         uint32              `protobuf:"varint,10,opt,name=reviewers,proto3" json:"reviewers,omitempty"`  // number of TA's to review submissions for this assignment
+	SkipTests         bool                `protobuf:"varint,11,opt,name=skipTests,proto3" json:"skipTests,omitempty"`
+	Submissions       []*Submission       `protobuf:"bytes,12,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	GradingBenchmarks []*GradingBenchmark `protobuf:"bytes,13,rep,name=gradingBenchmarks,proto3" json:"gradingBenchmarks,omitempty"`
+	ContainerTimeout  uint32              `protobuf:"varint,14,opt,name=containerTimeout,proto3" json:"containerTimeout,omitempty"`
+	LatePolicy        Course_LatePolicy   `protobuf:"varint,15,opt,name=latePolicy,proto3,enum=ag.Course_LatePolicy" json:"latePolicy,omitempty"` // overrides the course's late policy unless DEFAULT
+	LatePenalty       uint32              `protobuf:"varint,16,opt,name=latePenalty,proto3" json:"latePenalty,omitempty"`                         // percent of the score deducted per day late
+	FreezeAtDeadline  bool                `protobuf:"varint,17,opt,name=freezeAtDeadline,proto3" json:"freezeAtDeadline,omitempty"`               // builds after the deadline snapshot are not graded
+	FinalBuild        bool                `protobuf:"varint,18,opt,name=finalBuild,proto3" json:"finalBuild,omitempty"`                           // build repositories without any build at the deadline
+	ReleaseDate       string              `protobuf:"bytes,19,opt,name=releaseDate,proto3" json:"releaseDate,omitempty"`                          // date at which the reviews of the submissions are released
+	ReleasedDate      string              `protobuf:"bytes,20,opt,name=releasedDate,proto3" json:"releasedDate,omitempty"`                        // date at which the reviews were released at the release date
+	HiddenTests       string              `protobuf:"bytes,21,opt,name=hiddenTests,proto3" json:"hiddenTests,omitempty"`                          // comma-separated paths of the hidden test files and directories
+	HiddenAtDeadline  bool                `protobuf:"varint,22,opt,name=hiddenAtDeadline,proto3" json:"hiddenAtDeadline,omitempty"`               // hidden tests only run in the deadline builds
+	BuildsPerHour     uint32              `protobuf:"varint,23,opt,name=buildsPerHour,proto3" json:"buildsPerHour,omitempty"`                     // maximum number of push builds started per hour for each repository; 0 is unlimited
+	BuildInterval     string              `protobuf:"bytes,24,opt,name=buildInterval,proto3" json:"buildInterval,omitempty"`                      // minimum duration between push builds for each repository, such as 5m
+	MemoryLimit       uint64              `protobuf:"varint,25,opt,name=memoryLimit,proto3" json:"memoryLimit,omitempty"`                         // maximum memory in bytes of the container running the tests; 0 is unlimited
+	CpuLimit          float64             `protobuf:"fixed64,26,opt,name=cpuLimit,proto3" json:"cpuLimit,omitempty"`                              // maximum number of CPUs of the container running the tests; 0 is unlimited
+	PidsLimit         uint32              `protobuf:"varint,27,opt,name=pidsLimit,proto3" json:"pidsLimit,omitempty"`                             // maximum number of processes in the container running the tests; 0 is unlimited
+	DiskLimit         uint64              `protobuf:"varint,28,opt,name=diskLimit,proto3" json:"diskLimit,omitempty"`                             // maximum size in bytes of the files written by the tests; 0 is unlimited
+	NetworkDisabled   bool                `protobuf:"varint,29,opt,name=networkDisabled,proto3" json:"networkDisabled,omitempty"`                 // run the tests without network access
+	ReadOnlyRoot      bool                `protobuf:"varint,30,opt,name=readOnlyRoot,proto3" json:"readOnlyRoot,omitempty"`                       // run the tests with a read-only root filesystem
+	Pipeline          string              `protobuf:"bytes,31,opt,name=pipeline,proto3" json:"pipeline,omitempty"`                                // JSON encoded build pipeline; the tests are run by the script file if empty
 }
 
-func (x *Submission) Reset() {
-	*x = Submission{}
+func (x *Assignment) Reset() {
+	*x = Assignment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[16]
+		mi := &file_ag_ag_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Submission) String() string {
+func (x *Assignment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Submission) ProtoMessage() {}
+func (*Assignment) ProtoMessage() {}
 
-func (x *Submission) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[16]
+func (x *Assignment) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1729,120 +1969,2476 @@ func (x *Submission) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Submission.ProtoReflect.Descriptor instead.
-func (*Submission) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use Assignment.ProtoReflect.Descriptor instead.
+func (*Assignment) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *Submission) GetID() uint64 {
+func (x *Assignment) GetID() uint64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *Submission) GetAssignmentID() uint64 {
+func (x *Assignment) GetCourseID() uint64 {
 	if x != nil {
-		return x.AssignmentID
+		return x.CourseID
 	}
 	return 0
 }
 
-func (x *Submission) GetUserID() uint64 {
+func (x *Assignment) GetName() string {
 	if x != nil {
-		return x.UserID
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Assignment) GetScriptFile() string {
+	if x != nil {
+		return x.ScriptFile
+	}
+	return ""
+}
+
+func (x *Assignment) GetDeadline() string {
+	if x != nil {
+		return x.Deadline
+	}
+	return ""
+}
+
+func (x *Assignment) GetAutoApprove() bool {
+	if x != nil {
+		return x.AutoApprove
+	}
+	return false
+}
+
+func (x *Assignment) GetOrder() uint32 {
+	if x != nil {
+		return x.Order
 	}
 	return 0
 }
 
-func (x *Submission) GetGroupID() uint64 {
+func (x *Assignment) GetIsGroupLab() bool {
 	if x != nil {
-		return x.GroupID
+		return x.IsGroupLab
+	}
+	return false
+}
+
+func (x *Assignment) GetScoreLimit() uint32 {
+	if x != nil {
+		return x.ScoreLimit
 	}
 	return 0
 }
 
-func (x *Submission) GetScore() uint32 {
+func (x *Assignment) GetReviewers() uint32 {
 	if x != nil {
-		return x.Score
+		return x.Reviewers
 	}
 	return 0
 }
 
-func (x *Submission) GetScoreObjects() string {
+func (x *Assignment) GetSkipTests() bool {
 	if x != nil {
-		return x.ScoreObjects
+		return x.SkipTests
 	}
-	return ""
+	return false
 }
 
-func (x *Submission) GetBuildInfo() string {
+func (x *Assignment) GetSubmissions() []*Submission {
 	if x != nil {
-		return x.BuildInfo
+		return x.Submissions
 	}
-	return ""
+	return nil
 }
 
-func (x *Submission) GetCommitHash() string {
+func (x *Assignment) GetGradingBenchmarks() []*GradingBenchmark {
 	if x != nil {
-		return x.CommitHash
+		return x.GradingBenchmarks
 	}
-	return ""
+	return nil
+}
+
+func (x *Assignment) GetContainerTimeout() uint32 {
+	if x != nil {
+		return x.ContainerTimeout
+	}
+	return 0
+}
+
+func (x *Assignment) GetLatePolicy() Course_LatePolicy {
+	if x != nil {
+		return x.LatePolicy
+	}
+	return Course_DEFAULT
+}
+
+func (x *Assignment) GetLatePenalty() uint32 {
+	if x != nil {
+		return x.LatePenalty
+	}
+	return 0
+}
+
+func (x *Assignment) GetFreezeAtDeadline() bool {
+	if x != nil {
+		return x.FreezeAtDeadline
+	}
+	return false
+}
+
+func (x *Assignment) GetFinalBuild() bool {
+	if x != nil {
+		return x.FinalBuild
+	}
+	return false
+}
+
+func (x *Assignment) GetReleaseDate() string {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return ""
+}
+
+func (x *Assignment) GetReleasedDate() string {
+	if x != nil {
+		return x.ReleasedDate
+	}
+	return ""
+}
+
+func (x *Assignment) GetHiddenTests() string {
+	if x != nil {
+		return x.HiddenTests
+	}
+	return ""
+}
+
+func (x *Assignment) GetHiddenAtDeadline() bool {
+	if x != nil {
+		return x.HiddenAtDeadline
+	}
+	return false
+}
+
+func (x *Assignment) GetBuildsPerHour() uint32 {
+	if x != nil {
+		return x.BuildsPerHour
+	}
+	return 0
+}
+
+func (x *Assignment) GetBuildInterval() string {
+	if x != nil {
+		return x.BuildInterval
+	}
+	return ""
+}
+
+func (x *Assignment) GetMemoryLimit() uint64 {
+	if x != nil {
+		return x.MemoryLimit
+	}
+	return 0
+}
+
+func (x *Assignment) GetCpuLimit() float64 {
+	if x != nil {
+		return x.CpuLimit
+	}
+	return 0
+}
+
+func (x *Assignment) GetPidsLimit() uint32 {
+	if x != nil {
+		return x.PidsLimit
+	}
+	return 0
+}
+
+func (x *Assignment) GetDiskLimit() uint64 {
+	if x != nil {
+		return x.DiskLimit
+	}
+	return 0
+}
+
+func (x *Assignment) GetNetworkDisabled() bool {
+	if x != nil {
+		return x.NetworkDisabled
+	}
+	return false
+}
+
+func (x *Assignment) GetReadOnlyRoot() bool {
+	if x != nil {
+		return x.ReadOnlyRoot
+	}
+	return false
+}
+
+func (x *Assignment) GetPipeline() string {
+	if x != nil {
+		return x.Pipeline
+	}
+	return ""
+}
+
+type Assignments struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Assignments []*Assignment `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+}
+
+func (x *Assignments) Reset() {
+	*x = Assignments{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Assignments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Assignments) ProtoMessage() {}
+
+func (x *Assignments) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Assignments.ProtoReflect.Descriptor instead.
+func (*Assignments) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Assignments) GetAssignments() []*Assignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+// DeadlineExtension replaces the deadline of an assignment for a student or a group.
+type DeadlineExtension struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty" gorm:"uniqueIndex:idx_unique_extension"`
+	UserID       uint64 `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty" gorm:"uniqueIndex:idx_unique_extension"`
+	GroupID      uint64 `protobuf:"varint,4,opt,name=groupID,proto3" json:"groupID,omitempty" gorm:"uniqueIndex:idx_unique_extension"`
+	Deadline     string `protobuf:"bytes,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Reason       string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *DeadlineExtension) Reset() {
+	*x = DeadlineExtension{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeadlineExtension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadlineExtension) ProtoMessage() {}
+
+func (x *DeadlineExtension) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadlineExtension.ProtoReflect.Descriptor instead.
+func (*DeadlineExtension) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeadlineExtension) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *DeadlineExtension) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *DeadlineExtension) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *DeadlineExtension) GetGroupID() uint64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *DeadlineExtension) GetDeadline() string {
+	if x != nil {
+		return x.Deadline
+	}
+	return ""
+}
+
+func (x *DeadlineExtension) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DeadlineExtensions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Extensions []*DeadlineExtension `protobuf:"bytes,1,rep,name=extensions,proto3" json:"extensions,omitempty"`
+}
+
+func (x *DeadlineExtensions) Reset() {
+	*x = DeadlineExtensions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeadlineExtensions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadlineExtensions) ProtoMessage() {}
+
+func (x *DeadlineExtensions) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadlineExtensions.ProtoReflect.Descriptor instead.
+func (*DeadlineExtensions) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeadlineExtensions) GetExtensions() []*DeadlineExtension {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// DeadlineSnapshot records the commit of a student's or group's repository at the deadline of
+// an assignment, which is the deadline taking any extension and the course's grace period into account.
+// The commit is the last commit pushed before the deadline, or empty if nothing was pushed.
+type DeadlineSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty" gorm:"uniqueIndex:idx_unique_snapshot"`
+	UserID       uint64 `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty" gorm:"uniqueIndex:idx_unique_snapshot"`
+	GroupID      uint64 `protobuf:"varint,4,opt,name=groupID,proto3" json:"groupID,omitempty" gorm:"uniqueIndex:idx_unique_snapshot"`
+	CommitHash   string `protobuf:"bytes,5,opt,name=commitHash,proto3" json:"commitHash,omitempty"`
+	Deadline     string `protobuf:"bytes,6,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	SnapshotDate string `protobuf:"bytes,7,opt,name=snapshotDate,proto3" json:"snapshotDate,omitempty"`
+	Frozen       bool   `protobuf:"varint,8,opt,name=frozen,proto3" json:"frozen,omitempty"`         // only builds of the snapshot's commit are graded
+	BuildJobID   uint64 `protobuf:"varint,9,opt,name=buildJobID,proto3" json:"buildJobID,omitempty"` // the final build of a repository without any build before the deadline
+}
+
+func (x *DeadlineSnapshot) Reset() {
+	*x = DeadlineSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeadlineSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadlineSnapshot) ProtoMessage() {}
+
+func (x *DeadlineSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadlineSnapshot.ProtoReflect.Descriptor instead.
+func (*DeadlineSnapshot) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeadlineSnapshot) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *DeadlineSnapshot) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *DeadlineSnapshot) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *DeadlineSnapshot) GetGroupID() uint64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *DeadlineSnapshot) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *DeadlineSnapshot) GetDeadline() string {
+	if x != nil {
+		return x.Deadline
+	}
+	return ""
+}
+
+func (x *DeadlineSnapshot) GetSnapshotDate() string {
+	if x != nil {
+		return x.SnapshotDate
+	}
+	return ""
+}
+
+func (x *DeadlineSnapshot) GetFrozen() bool {
+	if x != nil {
+		return x.Frozen
+	}
+	return false
+}
+
+func (x *DeadlineSnapshot) GetBuildJobID() uint64 {
+	if x != nil {
+		return x.BuildJobID
+	}
+	return 0
+}
+
+type Submission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID                 uint64            `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	AssignmentID       uint64            `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	UserID             uint64            `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	GroupID            uint64            `protobuf:"varint,4,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	Score              uint32            `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
+	ScoreObjects       string            `protobuf:"bytes,6,opt,name=scoreObjects,proto3" json:"scoreObjects,omitempty"`
+	BuildInfo          string            `protobuf:"bytes,7,opt,name=buildInfo,proto3" json:"buildInfo,omitempty"`
+	CommitHash         string            `protobuf:"bytes,8,opt,name=commitHash,proto3" json:"commitHash,omitempty"`
+	Released           bool              `protobuf:"varint,9,opt,name=released,proto3" json:"released,omitempty"` // defines whether the feedback is visible for the student or group members
+	Status             Submission_Status `protobuf:"varint,10,opt,name=status,proto3,enum=ag.Submission_Status" json:"status,omitempty"`
+	ApprovedDate       string            `protobuf:"bytes,11,opt,name=approvedDate,proto3" json:"approvedDate,omitempty"`
+	Reviews            []*Review         `protobuf:"bytes,12,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	GradedAttemptID    uint64            `protobuf:"varint,13,opt,name=gradedAttemptID,proto3" json:"gradedAttemptID,omitempty"`                                               // the attempt whose results are graded and reviewed
+	TestResults        []*TestResult     `protobuf:"bytes,14,rep,name=testResults,proto3" json:"testResults,omitempty" gorm:"foreignKey:AttemptID;references:GradedAttemptID"` // the graded attempt's test results
+	RawScore           uint32            `protobuf:"varint,15,opt,name=rawScore,proto3" json:"rawScore,omitempty"`                                                             // the graded attempt's score before any late penalty
+	HiddenScore        uint32            `protobuf:"varint,16,opt,name=hiddenScore,proto3" json:"hiddenScore,omitempty"`                                                       // the graded attempt's score of the hidden tests
+	HiddenScoreObjects string            `protobuf:"bytes,17,opt,name=hiddenScoreObjects,proto3" json:"hiddenScoreObjects,omitempty"`
+	HiddenBuildInfo    string            `protobuf:"bytes,18,opt,name=hiddenBuildInfo,proto3" json:"hiddenBuildInfo,omitempty"`
+}
+
+func (x *Submission) Reset() {
+	*x = Submission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Submission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Submission) ProtoMessage() {}
+
+func (x *Submission) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Submission.ProtoReflect.Descriptor instead.
+func (*Submission) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Submission) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Submission) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *Submission) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *Submission) GetGroupID() uint64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *Submission) GetScore() uint32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Submission) GetScoreObjects() string {
+	if x != nil {
+		return x.ScoreObjects
+	}
+	return ""
+}
+
+func (x *Submission) GetBuildInfo() string {
+	if x != nil {
+		return x.BuildInfo
+	}
+	return ""
+}
+
+func (x *Submission) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *Submission) GetReleased() bool {
+	if x != nil {
+		return x.Released
+	}
+	return false
+}
+
+func (x *Submission) GetStatus() Submission_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Submission_NONE
+}
+
+func (x *Submission) GetApprovedDate() string {
+	if x != nil {
+		return x.ApprovedDate
+	}
+	return ""
+}
+
+func (x *Submission) GetReviews() []*Review {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *Submission) GetGradedAttemptID() uint64 {
+	if x != nil {
+		return x.GradedAttemptID
+	}
+	return 0
+}
+
+func (x *Submission) GetTestResults() []*TestResult {
+	if x != nil {
+		return x.TestResults
+	}
+	return nil
+}
+
+func (x *Submission) GetRawScore() uint32 {
+	if x != nil {
+		return x.RawScore
+	}
+	return 0
+}
+
+func (x *Submission) GetHiddenScore() uint32 {
+	if x != nil {
+		return x.HiddenScore
+	}
+	return 0
+}
+
+func (x *Submission) GetHiddenScoreObjects() string {
+	if x != nil {
+		return x.HiddenScoreObjects
+	}
+	return ""
+}
+
+func (x *Submission) GetHiddenBuildInfo() string {
+	if x != nil {
+		return x.HiddenBuildInfo
+	}
+	return ""
+}
+
+type Submissions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Submissions []*Submission `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
+}
+
+func (x *Submissions) Reset() {
+	*x = Submissions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Submissions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Submissions) ProtoMessage() {}
+
+func (x *Submissions) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Submissions.ProtoReflect.Descriptor instead.
+func (*Submissions) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Submissions) GetSubmissions() []*Submission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+// SubmissionAttempt is the result of building and testing a commit for a submission.
+// Attempts are never changed; a new attempt is recorded for every build.
+type SubmissionAttempt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID                 uint64        `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	SubmissionID       uint64        `protobuf:"varint,2,opt,name=submissionID,proto3" json:"submissionID,omitempty" gorm:"index"`
+	CommitHash         string        `protobuf:"bytes,3,opt,name=commitHash,proto3" json:"commitHash,omitempty"`
+	Score              uint32        `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
+	ScoreObjects       string        `protobuf:"bytes,5,opt,name=scoreObjects,proto3" json:"scoreObjects,omitempty"`
+	BuildInfo          string        `protobuf:"bytes,6,opt,name=buildInfo,proto3" json:"buildInfo,omitempty"`
+	CreatedDate        string        `protobuf:"bytes,7,opt,name=createdDate,proto3" json:"createdDate,omitempty"`
+	TestResults        []*TestResult `protobuf:"bytes,8,rep,name=testResults,proto3" json:"testResults,omitempty" gorm:"foreignKey:AttemptID"`
+	RawScore           uint32        `protobuf:"varint,9,opt,name=rawScore,proto3" json:"rawScore,omitempty"`        // the score before any late penalty
+	HiddenScore        uint32        `protobuf:"varint,10,opt,name=hiddenScore,proto3" json:"hiddenScore,omitempty"` // the score of the hidden tests
+	HiddenScoreObjects string        `protobuf:"bytes,11,opt,name=hiddenScoreObjects,proto3" json:"hiddenScoreObjects,omitempty"`
+	HiddenBuildInfo    string        `protobuf:"bytes,12,opt,name=hiddenBuildInfo,proto3" json:"hiddenBuildInfo,omitempty"`
+}
+
+func (x *SubmissionAttempt) Reset() {
+	*x = SubmissionAttempt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmissionAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionAttempt) ProtoMessage() {}
+
+func (x *SubmissionAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionAttempt.ProtoReflect.Descriptor instead.
+func (*SubmissionAttempt) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SubmissionAttempt) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *SubmissionAttempt) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *SubmissionAttempt) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *SubmissionAttempt) GetScore() uint32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SubmissionAttempt) GetScoreObjects() string {
+	if x != nil {
+		return x.ScoreObjects
+	}
+	return ""
+}
+
+func (x *SubmissionAttempt) GetBuildInfo() string {
+	if x != nil {
+		return x.BuildInfo
+	}
+	return ""
+}
+
+func (x *SubmissionAttempt) GetCreatedDate() string {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return ""
+}
+
+func (x *SubmissionAttempt) GetTestResults() []*TestResult {
+	if x != nil {
+		return x.TestResults
+	}
+	return nil
+}
+
+func (x *SubmissionAttempt) GetRawScore() uint32 {
+	if x != nil {
+		return x.RawScore
+	}
+	return 0
+}
+
+func (x *SubmissionAttempt) GetHiddenScore() uint32 {
+	if x != nil {
+		return x.HiddenScore
+	}
+	return 0
+}
+
+func (x *SubmissionAttempt) GetHiddenScoreObjects() string {
+	if x != nil {
+		return x.HiddenScoreObjects
+	}
+	return ""
+}
+
+func (x *SubmissionAttempt) GetHiddenBuildInfo() string {
+	if x != nil {
+		return x.HiddenBuildInfo
+	}
+	return ""
+}
+
+type SubmissionAttempts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attempts []*SubmissionAttempt `protobuf:"bytes,1,rep,name=attempts,proto3" json:"attempts,omitempty"`
+}
+
+func (x *SubmissionAttempts) Reset() {
+	*x = SubmissionAttempts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmissionAttempts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionAttempts) ProtoMessage() {}
+
+func (x *SubmissionAttempts) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionAttempts.ProtoReflect.Descriptor instead.
+func (*SubmissionAttempts) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SubmissionAttempts) GetAttempts() []*SubmissionAttempt {
+	if x != nil {
+		return x.Attempts
+	}
+	return nil
+}
+
+// TestResult is the result of a test case of a submission attempt.
+// The test passed if the score equals the max score.
+type TestResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID            uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	AttemptID     uint64 `protobuf:"varint,2,opt,name=attemptID,proto3" json:"attemptID,omitempty" gorm:"index"`
+	SubmissionID  uint64 `protobuf:"varint,3,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+	AssignmentID  uint64 `protobuf:"varint,4,opt,name=assignmentID,proto3" json:"assignmentID,omitempty" gorm:"index"`
+	TestName      string `protobuf:"bytes,5,opt,name=testName,proto3" json:"testName,omitempty"`
+	Score         int32  `protobuf:"varint,6,opt,name=score,proto3" json:"score,omitempty"`
+	MaxScore      int32  `protobuf:"varint,7,opt,name=maxScore,proto3" json:"maxScore,omitempty"`
+	Weight        int32  `protobuf:"varint,8,opt,name=weight,proto3" json:"weight,omitempty"`
+	Duration      int64  `protobuf:"varint,9,opt,name=duration,proto3" json:"duration,omitempty"`           // in milliseconds
+	FailureOutput string `protobuf:"bytes,10,opt,name=failureOutput,proto3" json:"failureOutput,omitempty"` // the output of the test if it failed
+	Hidden        bool   `protobuf:"varint,11,opt,name=hidden,proto3" json:"hidden,omitempty"`              // the test is hidden from students until the submission is released
+}
+
+func (x *TestResult) Reset() {
+	*x = TestResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestResult) ProtoMessage() {}
+
+func (x *TestResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestResult.ProtoReflect.Descriptor instead.
+func (*TestResult) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TestResult) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *TestResult) GetAttemptID() uint64 {
+	if x != nil {
+		return x.AttemptID
+	}
+	return 0
+}
+
+func (x *TestResult) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *TestResult) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *TestResult) GetTestName() string {
+	if x != nil {
+		return x.TestName
+	}
+	return ""
+}
+
+func (x *TestResult) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TestResult) GetMaxScore() int32 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *TestResult) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *TestResult) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *TestResult) GetFailureOutput() string {
+	if x != nil {
+		return x.FailureOutput
+	}
+	return ""
+}
+
+func (x *TestResult) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+// TestCaseStatistics summarizes the results of a test case for an assignment.
+type TestCaseStatistics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TestName        string `protobuf:"bytes,1,opt,name=testName,proto3" json:"testName,omitempty"`
+	Runs            uint32 `protobuf:"varint,2,opt,name=runs,proto3" json:"runs,omitempty"`
+	Failures        uint32 `protobuf:"varint,3,opt,name=failures,proto3" json:"failures,omitempty"`
+	AverageScore    uint32 `protobuf:"varint,4,opt,name=averageScore,proto3" json:"averageScore,omitempty"`       // in percent of the max score
+	AverageDuration int64  `protobuf:"varint,5,opt,name=averageDuration,proto3" json:"averageDuration,omitempty"` // in milliseconds
+}
+
+func (x *TestCaseStatistics) Reset() {
+	*x = TestCaseStatistics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestCaseStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestCaseStatistics) ProtoMessage() {}
+
+func (x *TestCaseStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestCaseStatistics.ProtoReflect.Descriptor instead.
+func (*TestCaseStatistics) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TestCaseStatistics) GetTestName() string {
+	if x != nil {
+		return x.TestName
+	}
+	return ""
+}
+
+func (x *TestCaseStatistics) GetRuns() uint32 {
+	if x != nil {
+		return x.Runs
+	}
+	return 0
+}
+
+func (x *TestCaseStatistics) GetFailures() uint32 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *TestCaseStatistics) GetAverageScore() uint32 {
+	if x != nil {
+		return x.AverageScore
+	}
+	return 0
+}
+
+func (x *TestCaseStatistics) GetAverageDuration() int64 {
+	if x != nil {
+		return x.AverageDuration
+	}
+	return 0
+}
+
+type TestStatistics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tests []*TestCaseStatistics `protobuf:"bytes,1,rep,name=tests,proto3" json:"tests,omitempty"`
+}
+
+func (x *TestStatistics) Reset() {
+	*x = TestStatistics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestStatistics) ProtoMessage() {}
+
+func (x *TestStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestStatistics.ProtoReflect.Descriptor instead.
+func (*TestStatistics) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TestStatistics) GetTests() []*TestCaseStatistics {
+	if x != nil {
+		return x.Tests
+	}
+	return nil
+}
+
+// PushActivity is the number of attempts built on a day relative to an assignment's deadline.
+type PushActivity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DaysToDeadline int32  `protobuf:"varint,1,opt,name=daysToDeadline,proto3" json:"daysToDeadline,omitempty"` // negative after the deadline
+	Attempts       uint32 `protobuf:"varint,2,opt,name=attempts,proto3" json:"attempts,omitempty"`
+}
+
+func (x *PushActivity) Reset() {
+	*x = PushActivity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushActivity) ProtoMessage() {}
+
+func (x *PushActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushActivity.ProtoReflect.Descriptor instead.
+func (*PushActivity) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PushActivity) GetDaysToDeadline() int32 {
+	if x != nil {
+		return x.DaysToDeadline
+	}
+	return 0
+}
+
+func (x *PushActivity) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+// AssignmentStatistics summarizes the submissions of an assignment.
+type AssignmentStatistics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentID         uint64          `protobuf:"varint,1,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	Name                 string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Submissions          uint32          `protobuf:"varint,3,opt,name=submissions,proto3" json:"submissions,omitempty"`
+	Approved             uint32          `protobuf:"varint,4,opt,name=approved,proto3" json:"approved,omitempty"`
+	ApprovalRate         uint32          `protobuf:"varint,5,opt,name=approvalRate,proto3" json:"approvalRate,omitempty"`                  // in percent of the submissions
+	ScoreDistribution    []uint32        `protobuf:"varint,6,rep,packed,name=scoreDistribution,proto3" json:"scoreDistribution,omitempty"` // number of submissions scoring 0-9, 10-19, ..., 90-100
+	MedianTimeToApproval int64           `protobuf:"varint,7,opt,name=medianTimeToApproval,proto3" json:"medianTimeToApproval,omitempty"`  // in seconds from the first attempt to approval
+	UsedSlipDays         uint32          `protobuf:"varint,8,opt,name=usedSlipDays,proto3" json:"usedSlipDays,omitempty"`
+	PushActivity         []*PushActivity `protobuf:"bytes,9,rep,name=pushActivity,proto3" json:"pushActivity,omitempty"` // ordered by decreasing days to the deadline
+}
+
+func (x *AssignmentStatistics) Reset() {
+	*x = AssignmentStatistics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssignmentStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentStatistics) ProtoMessage() {}
+
+func (x *AssignmentStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentStatistics.ProtoReflect.Descriptor instead.
+func (*AssignmentStatistics) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AssignmentStatistics) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *AssignmentStatistics) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AssignmentStatistics) GetSubmissions() uint32 {
+	if x != nil {
+		return x.Submissions
+	}
+	return 0
+}
+
+func (x *AssignmentStatistics) GetApproved() uint32 {
+	if x != nil {
+		return x.Approved
+	}
+	return 0
+}
+
+func (x *AssignmentStatistics) GetApprovalRate() uint32 {
+	if x != nil {
+		return x.ApprovalRate
+	}
+	return 0
+}
+
+func (x *AssignmentStatistics) GetScoreDistribution() []uint32 {
+	if x != nil {
+		return x.ScoreDistribution
+	}
+	return nil
+}
+
+func (x *AssignmentStatistics) GetMedianTimeToApproval() int64 {
+	if x != nil {
+		return x.MedianTimeToApproval
+	}
+	return 0
+}
+
+func (x *AssignmentStatistics) GetUsedSlipDays() uint32 {
+	if x != nil {
+		return x.UsedSlipDays
+	}
+	return 0
+}
+
+func (x *AssignmentStatistics) GetPushActivity() []*PushActivity {
+	if x != nil {
+		return x.PushActivity
+	}
+	return nil
+}
+
+type CourseStatistics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Students       uint32                  `protobuf:"varint,1,opt,name=students,proto3" json:"students,omitempty"`
+	ActiveStudents uint32                  `protobuf:"varint,2,opt,name=activeStudents,proto3" json:"activeStudents,omitempty"` // students with activity or built attempts in the last two weeks
+	Assignments    []*AssignmentStatistics `protobuf:"bytes,3,rep,name=assignments,proto3" json:"assignments,omitempty"`
+}
+
+func (x *CourseStatistics) Reset() {
+	*x = CourseStatistics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CourseStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseStatistics) ProtoMessage() {}
+
+func (x *CourseStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseStatistics.ProtoReflect.Descriptor instead.
+func (*CourseStatistics) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CourseStatistics) GetStudents() uint32 {
+	if x != nil {
+		return x.Students
+	}
+	return 0
+}
+
+func (x *CourseStatistics) GetActiveStudents() uint32 {
+	if x != nil {
+		return x.ActiveStudents
+	}
+	return 0
+}
+
+func (x *CourseStatistics) GetAssignments() []*AssignmentStatistics {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+type BuildJob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64          `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID     uint64          `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentID uint64          `protobuf:"varint,3,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	RepositoryID uint64          `protobuf:"varint,4,opt,name=repositoryID,proto3" json:"repositoryID,omitempty"`
+	CommitID     string          `protobuf:"bytes,5,opt,name=commitID,proto3" json:"commitID,omitempty"`
+	JobOwner     string          `protobuf:"bytes,6,opt,name=jobOwner,proto3" json:"jobOwner,omitempty"`
+	Status       BuildJob_Status `protobuf:"varint,7,opt,name=status,proto3,enum=ag.BuildJob_Status" json:"status,omitempty"`
+	QueuedDate   string          `protobuf:"bytes,8,opt,name=queuedDate,proto3" json:"queuedDate,omitempty"`
+	StartedDate  string          `protobuf:"bytes,9,opt,name=startedDate,proto3" json:"startedDate,omitempty"`
+	FinishedDate string          `protobuf:"bytes,10,opt,name=finishedDate,proto3" json:"finishedDate,omitempty"`
+	ErrorMessage string          `protobuf:"bytes,11,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	Deadline     bool            `protobuf:"varint,12,opt,name=deadline,proto3" json:"deadline,omitempty"`        // the job builds the commit of a deadline snapshot
+	DelayedUntil string          `protobuf:"bytes,13,opt,name=delayedUntil,proto3" json:"delayedUntil,omitempty"` // the job is not started before this date, since the build quota is used up
+}
+
+func (x *BuildJob) Reset() {
+	*x = BuildJob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildJob) ProtoMessage() {}
+
+func (x *BuildJob) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildJob.ProtoReflect.Descriptor instead.
+func (*BuildJob) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *BuildJob) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *BuildJob) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *BuildJob) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *BuildJob) GetRepositoryID() uint64 {
+	if x != nil {
+		return x.RepositoryID
+	}
+	return 0
+}
+
+func (x *BuildJob) GetCommitID() string {
+	if x != nil {
+		return x.CommitID
+	}
+	return ""
+}
+
+func (x *BuildJob) GetJobOwner() string {
+	if x != nil {
+		return x.JobOwner
+	}
+	return ""
+}
+
+func (x *BuildJob) GetStatus() BuildJob_Status {
+	if x != nil {
+		return x.Status
+	}
+	return BuildJob_QUEUED
+}
+
+func (x *BuildJob) GetQueuedDate() string {
+	if x != nil {
+		return x.QueuedDate
+	}
+	return ""
+}
+
+func (x *BuildJob) GetStartedDate() string {
+	if x != nil {
+		return x.StartedDate
+	}
+	return ""
+}
+
+func (x *BuildJob) GetFinishedDate() string {
+	if x != nil {
+		return x.FinishedDate
+	}
+	return ""
+}
+
+func (x *BuildJob) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *BuildJob) GetDeadline() bool {
+	if x != nil {
+		return x.Deadline
+	}
+	return false
+}
+
+func (x *BuildJob) GetDelayedUntil() string {
+	if x != nil {
+		return x.DelayedUntil
+	}
+	return ""
+}
+
+type BuildJobs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jobs []*BuildJob `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+}
+
+func (x *BuildJobs) Reset() {
+	*x = BuildJobs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildJobs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildJobs) ProtoMessage() {}
+
+func (x *BuildJobs) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildJobs.ProtoReflect.Descriptor instead.
+func (*BuildJobs) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *BuildJobs) GetJobs() []*BuildJob {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+// BuildQuota records the date at which a teacher last reset the build quota of a repository for an assignment.
+// The builds started for pushes in the last hour since the reset, the remaining builds in the hour,
+// and the date before which pushes are not built, are computed from the build jobs of the repository.
+type BuildQuota struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty" gorm:"uniqueIndex:idx_unique_quota"`
+	RepositoryID uint64 `protobuf:"varint,3,opt,name=repositoryID,proto3" json:"repositoryID,omitempty" gorm:"uniqueIndex:idx_unique_quota"`
+	ResetDate    string `protobuf:"bytes,4,opt,name=resetDate,proto3" json:"resetDate,omitempty"`
+	Builds       uint32 `protobuf:"varint,5,opt,name=builds,proto3" json:"builds,omitempty" gorm:"-"`
+	Remaining    uint32 `protobuf:"varint,6,opt,name=remaining,proto3" json:"remaining,omitempty" gorm:"-"` // only set if the assignment limits the builds per hour
+	NextBuild    string `protobuf:"bytes,7,opt,name=nextBuild,proto3" json:"nextBuild,omitempty" gorm:"-"`  // empty if pushes are built right away
+}
+
+func (x *BuildQuota) Reset() {
+	*x = BuildQuota{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildQuota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildQuota) ProtoMessage() {}
+
+func (x *BuildQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildQuota.ProtoReflect.Descriptor instead.
+func (*BuildQuota) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *BuildQuota) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *BuildQuota) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *BuildQuota) GetRepositoryID() uint64 {
+	if x != nil {
+		return x.RepositoryID
+	}
+	return 0
+}
+
+func (x *BuildQuota) GetResetDate() string {
+	if x != nil {
+		return x.ResetDate
+	}
+	return ""
+}
+
+func (x *BuildQuota) GetBuilds() uint32 {
+	if x != nil {
+		return x.Builds
+	}
+	return 0
+}
+
+func (x *BuildQuota) GetRemaining() uint32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *BuildQuota) GetNextBuild() string {
+	if x != nil {
+		return x.NextBuild
+	}
+	return ""
+}
+
+type BuildQuotas struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Quotas []*BuildQuota `protobuf:"bytes,1,rep,name=quotas,proto3" json:"quotas,omitempty"`
+}
+
+func (x *BuildQuotas) Reset() {
+	*x = BuildQuotas{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildQuotas) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildQuotas) ProtoMessage() {}
+
+func (x *BuildQuotas) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildQuotas.ProtoReflect.Descriptor instead.
+func (*BuildQuotas) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *BuildQuotas) GetQuotas() []*BuildQuota {
+	if x != nil {
+		return x.Quotas
+	}
+	return nil
+}
+
+// SimilarityPair is a pair of submissions for the same assignment whose code is similar.
+// The similarity is the percentage of the smaller submission's fingerprints found in the other submission.
+type SimilarityPair struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64             `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	AssignmentID uint64             `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	SubmissionA  uint64             `protobuf:"varint,3,opt,name=submissionA,proto3" json:"submissionA,omitempty"`
+	SubmissionB  uint64             `protobuf:"varint,4,opt,name=submissionB,proto3" json:"submissionB,omitempty"`
+	OwnerA       string             `protobuf:"bytes,5,opt,name=ownerA,proto3" json:"ownerA,omitempty"`
+	OwnerB       string             `protobuf:"bytes,6,opt,name=ownerB,proto3" json:"ownerB,omitempty"`
+	Similarity   uint32             `protobuf:"varint,7,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	CheckedDate  string             `protobuf:"bytes,8,opt,name=checkedDate,proto3" json:"checkedDate,omitempty"`
+	Matches      []*SimilarityMatch `protobuf:"bytes,9,rep,name=matches,proto3" json:"matches,omitempty" gorm:"foreignKey:PairID"`
+}
+
+func (x *SimilarityPair) Reset() {
+	*x = SimilarityPair{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimilarityPair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimilarityPair) ProtoMessage() {}
+
+func (x *SimilarityPair) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimilarityPair.ProtoReflect.Descriptor instead.
+func (*SimilarityPair) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SimilarityPair) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *SimilarityPair) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *SimilarityPair) GetSubmissionA() uint64 {
+	if x != nil {
+		return x.SubmissionA
+	}
+	return 0
+}
+
+func (x *SimilarityPair) GetSubmissionB() uint64 {
+	if x != nil {
+		return x.SubmissionB
+	}
+	return 0
+}
+
+func (x *SimilarityPair) GetOwnerA() string {
+	if x != nil {
+		return x.OwnerA
+	}
+	return ""
+}
+
+func (x *SimilarityPair) GetOwnerB() string {
+	if x != nil {
+		return x.OwnerB
+	}
+	return ""
+}
+
+func (x *SimilarityPair) GetSimilarity() uint32 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+func (x *SimilarityPair) GetCheckedDate() string {
+	if x != nil {
+		return x.CheckedDate
+	}
+	return ""
+}
+
+func (x *SimilarityPair) GetMatches() []*SimilarityMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+type SimilarityPairs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pairs []*SimilarityPair `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"`
+}
+
+func (x *SimilarityPairs) Reset() {
+	*x = SimilarityPairs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimilarityPairs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimilarityPairs) ProtoMessage() {}
+
+func (x *SimilarityPairs) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimilarityPairs.ProtoReflect.Descriptor instead.
+func (*SimilarityPairs) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SimilarityPairs) GetPairs() []*SimilarityPair {
+	if x != nil {
+		return x.Pairs
+	}
+	return nil
+}
+
+// SimilarityMatch is a region of code found in both submissions of a pair.
+type SimilarityMatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID         uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	PairID     uint64 `protobuf:"varint,2,opt,name=pairID,proto3" json:"pairID,omitempty"`
+	FileA      string `protobuf:"bytes,3,opt,name=fileA,proto3" json:"fileA,omitempty"`
+	StartLineA uint32 `protobuf:"varint,4,opt,name=startLineA,proto3" json:"startLineA,omitempty"`
+	EndLineA   uint32 `protobuf:"varint,5,opt,name=endLineA,proto3" json:"endLineA,omitempty"`
+	FileB      string `protobuf:"bytes,6,opt,name=fileB,proto3" json:"fileB,omitempty"`
+	StartLineB uint32 `protobuf:"varint,7,opt,name=startLineB,proto3" json:"startLineB,omitempty"`
+	EndLineB   uint32 `protobuf:"varint,8,opt,name=endLineB,proto3" json:"endLineB,omitempty"`
+}
+
+func (x *SimilarityMatch) Reset() {
+	*x = SimilarityMatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimilarityMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimilarityMatch) ProtoMessage() {}
+
+func (x *SimilarityMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimilarityMatch.ProtoReflect.Descriptor instead.
+func (*SimilarityMatch) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SimilarityMatch) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *SimilarityMatch) GetPairID() uint64 {
+	if x != nil {
+		return x.PairID
+	}
+	return 0
+}
+
+func (x *SimilarityMatch) GetFileA() string {
+	if x != nil {
+		return x.FileA
+	}
+	return ""
+}
+
+func (x *SimilarityMatch) GetStartLineA() uint32 {
+	if x != nil {
+		return x.StartLineA
+	}
+	return 0
+}
+
+func (x *SimilarityMatch) GetEndLineA() uint32 {
+	if x != nil {
+		return x.EndLineA
+	}
+	return 0
+}
+
+func (x *SimilarityMatch) GetFileB() string {
+	if x != nil {
+		return x.FileB
+	}
+	return ""
+}
+
+func (x *SimilarityMatch) GetStartLineB() uint32 {
+	if x != nil {
+		return x.StartLineB
+	}
+	return 0
+}
+
+func (x *SimilarityMatch) GetEndLineB() uint32 {
+	if x != nil {
+		return x.EndLineB
+	}
+	return 0
+}
+
+type GradingBenchmark struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64              `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	AssignmentID uint64              `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	Heading      string              `protobuf:"bytes,3,opt,name=heading,proto3" json:"heading,omitempty"`
+	Comment      string              `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	Criteria     []*GradingCriterion `protobuf:"bytes,5,rep,name=criteria,proto3" json:"criteria,omitempty" gorm:"foreignKey:BenchmarkID"`
+}
+
+func (x *GradingBenchmark) Reset() {
+	*x = GradingBenchmark{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradingBenchmark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradingBenchmark) ProtoMessage() {}
+
+func (x *GradingBenchmark) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradingBenchmark.ProtoReflect.Descriptor instead.
+func (*GradingBenchmark) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GradingBenchmark) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *GradingBenchmark) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *GradingBenchmark) GetHeading() string {
+	if x != nil {
+		return x.Heading
+	}
+	return ""
+}
+
+func (x *GradingBenchmark) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *GradingBenchmark) GetCriteria() []*GradingCriterion {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+type Benchmarks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Benchmarks []*GradingBenchmark `protobuf:"bytes,1,rep,name=benchmarks,proto3" json:"benchmarks,omitempty"`
+}
+
+func (x *Benchmarks) Reset() {
+	*x = Benchmarks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Benchmarks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Benchmarks) ProtoMessage() {}
+
+func (x *Benchmarks) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Benchmarks.ProtoReflect.Descriptor instead.
+func (*Benchmarks) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Benchmarks) GetBenchmarks() []*GradingBenchmark {
+	if x != nil {
+		return x.Benchmarks
+	}
+	return nil
+}
+
+type GradingCriterion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID          uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Points      uint64                 `protobuf:"varint,2,opt,name=points,proto3" json:"points,omitempty"`
+	BenchmarkID uint64                 `protobuf:"varint,3,opt,name=benchmarkID,proto3" json:"benchmarkID,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Grade       GradingCriterion_Grade `protobuf:"varint,5,opt,name=grade,proto3,enum=ag.GradingCriterion_Grade" json:"grade,omitempty"`
+	Comment     string                 `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *GradingCriterion) Reset() {
+	*x = GradingCriterion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradingCriterion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradingCriterion) ProtoMessage() {}
+
+func (x *GradingCriterion) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradingCriterion.ProtoReflect.Descriptor instead.
+func (*GradingCriterion) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GradingCriterion) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *GradingCriterion) GetPoints() uint64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *GradingCriterion) GetBenchmarkID() uint64 {
+	if x != nil {
+		return x.BenchmarkID
+	}
+	return 0
+}
+
+func (x *GradingCriterion) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GradingCriterion) GetGrade() GradingCriterion_Grade {
+	if x != nil {
+		return x.Grade
+	}
+	return GradingCriterion_NONE
+}
+
+func (x *GradingCriterion) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type Review struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64              `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	SubmissionID uint64              `protobuf:"varint,2,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+	ReviewerID   uint64              `protobuf:"varint,3,opt,name=reviewerID,proto3" json:"reviewerID,omitempty"`
+	Review       string              `protobuf:"bytes,4,opt,name=review,proto3" json:"review,omitempty"` // JSON encoded grading criteria
+	Feedback     string              `protobuf:"bytes,5,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	Ready        bool                `protobuf:"varint,6,opt,name=ready,proto3" json:"ready,omitempty"`
+	Score        uint64              `protobuf:"varint,7,opt,name=score,proto3" json:"score,omitempty"`
+	Benchmarks   []*GradingBenchmark `protobuf:"bytes,8,rep,name=benchmarks,proto3" json:"benchmarks,omitempty" gorm:"foreignKey:ID"`
+	Edited       string              `protobuf:"bytes,9,opt,name=edited,proto3" json:"edited,omitempty"`
+}
+
+func (x *Review) Reset() {
+	*x = Review{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Review) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Review) ProtoMessage() {}
+
+func (x *Review) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Review.ProtoReflect.Descriptor instead.
+func (*Review) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Review) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Review) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *Review) GetReviewerID() uint64 {
+	if x != nil {
+		return x.ReviewerID
+	}
+	return 0
+}
+
+func (x *Review) GetReview() string {
+	if x != nil {
+		return x.Review
+	}
+	return ""
+}
+
+func (x *Review) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+func (x *Review) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *Review) GetScore() uint64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Review) GetBenchmarks() []*GradingBenchmark {
+	if x != nil {
+		return x.Benchmarks
+	}
+	return nil
+}
+
+func (x *Review) GetEdited() string {
+	if x != nil {
+		return x.Edited
+	}
+	return ""
+}
+
+type Reviewers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reviewers []*User `protobuf:"bytes,1,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+}
+
+func (x *Reviewers) Reset() {
+	*x = Reviewers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Reviewers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reviewers) ProtoMessage() {}
+
+func (x *Reviewers) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reviewers.ProtoReflect.Descriptor instead.
+func (*Reviewers) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Reviewers) GetReviewers() []*User {
+	if x != nil {
+		return x.Reviewers
+	}
+	return nil
+}
+
+type ReviewRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID uint64  `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Review   *Review `protobuf:"bytes,2,opt,name=review,proto3" json:"review,omitempty"`
+}
+
+func (x *ReviewRequest) Reset() {
+	*x = ReviewRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Submission) GetReleased() bool {
-	if x != nil {
-		return x.Released
+func (*ReviewRequest) ProtoMessage() {}
+
+func (x *ReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *Submission) GetStatus() Submission_Status {
-	if x != nil {
-		return x.Status
-	}
-	return Submission_NONE
+// Deprecated: Use ReviewRequest.ProtoReflect.Descriptor instead.
+func (*ReviewRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *Submission) GetApprovedDate() string {
+func (x *ReviewRequest) GetCourseID() uint64 {
 	if x != nil {
-		return x.ApprovedDate
+		return x.CourseID
 	}
-	return ""
+	return 0
 }
 
-func (x *Submission) GetReviews() []*Review {
+func (x *ReviewRequest) GetReview() *Review {
 	if x != nil {
-		return x.Reviews
+		return x.Review
 	}
 	return nil
 }
 
-type Submissions struct {
+type DeadlineExtensionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Submissions []*Submission `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	CourseID  uint64             `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Extension *DeadlineExtension `protobuf:"bytes,2,opt,name=extension,proto3" json:"extension,omitempty"`
 }
 
-func (x *Submissions) Reset() {
-	*x = Submissions{}
+func (x *DeadlineExtensionRequest) Reset() {
+	*x = DeadlineExtensionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[17]
+		mi := &file_ag_ag_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Submissions) String() string {
+func (x *DeadlineExtensionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Submissions) ProtoMessage() {}
+func (*DeadlineExtensionRequest) ProtoMessage() {}
 
-func (x *Submissions) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[17]
+func (x *DeadlineExtensionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1853,47 +4449,53 @@ func (x *Submissions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Submissions.ProtoReflect.Descriptor instead.
-func (*Submissions) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use DeadlineExtensionRequest.ProtoReflect.Descriptor instead.
+func (*DeadlineExtensionRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *Submissions) GetSubmissions() []*Submission {
+func (x *DeadlineExtensionRequest) GetCourseID() uint64 {
 	if x != nil {
-		return x.Submissions
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *DeadlineExtensionRequest) GetExtension() *DeadlineExtension {
+	if x != nil {
+		return x.Extension
 	}
 	return nil
 }
 
-type GradingBenchmark struct {
+type SlipDaysRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID           uint64              `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	AssignmentID uint64              `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
-	Heading      string              `protobuf:"bytes,3,opt,name=heading,proto3" json:"heading,omitempty"`
-	Comment      string              `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
-	Criteria     []*GradingCriterion `protobuf:"bytes,5,rep,name=criteria,proto3" json:"criteria,omitempty" gorm:"foreignKey:BenchmarkID"`
+	CourseID       uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	ChangeSlipDays bool   `protobuf:"varint,2,opt,name=changeSlipDays,proto3" json:"changeSlipDays,omitempty"` // recompute with slipDays as the number of slip days of the course
+	SlipDays       uint32 `protobuf:"varint,3,opt,name=slipDays,proto3" json:"slipDays,omitempty"`
+	Apply          bool   `protobuf:"varint,4,opt,name=apply,proto3" json:"apply,omitempty"` // save the changes; otherwise the changes are only reported
 }
 
-func (x *GradingBenchmark) Reset() {
-	*x = GradingBenchmark{}
+func (x *SlipDaysRequest) Reset() {
+	*x = SlipDaysRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[18]
+		mi := &file_ag_ag_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GradingBenchmark) String() string {
+func (x *SlipDaysRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GradingBenchmark) ProtoMessage() {}
+func (*SlipDaysRequest) ProtoMessage() {}
 
-func (x *GradingBenchmark) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[18]
+func (x *SlipDaysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1904,71 +4506,64 @@ func (x *GradingBenchmark) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GradingBenchmark.ProtoReflect.Descriptor instead.
-func (*GradingBenchmark) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *GradingBenchmark) GetID() uint64 {
-	if x != nil {
-		return x.ID
-	}
-	return 0
+// Deprecated: Use SlipDaysRequest.ProtoReflect.Descriptor instead.
+func (*SlipDaysRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *GradingBenchmark) GetAssignmentID() uint64 {
+func (x *SlipDaysRequest) GetCourseID() uint64 {
 	if x != nil {
-		return x.AssignmentID
+		return x.CourseID
 	}
 	return 0
 }
 
-func (x *GradingBenchmark) GetHeading() string {
+func (x *SlipDaysRequest) GetChangeSlipDays() bool {
 	if x != nil {
-		return x.Heading
+		return x.ChangeSlipDays
 	}
-	return ""
+	return false
 }
 
-func (x *GradingBenchmark) GetComment() string {
+func (x *SlipDaysRequest) GetSlipDays() uint32 {
 	if x != nil {
-		return x.Comment
+		return x.SlipDays
 	}
-	return ""
+	return 0
 }
 
-func (x *GradingBenchmark) GetCriteria() []*GradingCriterion {
+func (x *SlipDaysRequest) GetApply() bool {
 	if x != nil {
-		return x.Criteria
+		return x.Apply
 	}
-	return nil
+	return false
 }
 
-type Benchmarks struct {
+type CourseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Benchmarks []*GradingBenchmark `protobuf:"bytes,1,rep,name=benchmarks,proto3" json:"benchmarks,omitempty"`
+	CourseID uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
 }
 
-func (x *Benchmarks) Reset() {
-	*x = Benchmarks{}
+func (x *CourseRequest) Reset() {
+	*x = CourseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[19]
+		mi := &file_ag_ag_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Benchmarks) String() string {
+func (x *CourseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Benchmarks) ProtoMessage() {}
+func (*CourseRequest) ProtoMessage() {}
 
-func (x *Benchmarks) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[19]
+func (x *CourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1979,48 +4574,43 @@ func (x *Benchmarks) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Benchmarks.ProtoReflect.Descriptor instead.
-func (*Benchmarks) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use CourseRequest.ProtoReflect.Descriptor instead.
+func (*CourseRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *Benchmarks) GetBenchmarks() []*GradingBenchmark {
+func (x *CourseRequest) GetCourseID() uint64 {
 	if x != nil {
-		return x.Benchmarks
+		return x.CourseID
 	}
-	return nil
+	return 0
 }
 
-type GradingCriterion struct {
+type UserRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID          uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Points      uint64                 `protobuf:"varint,2,opt,name=points,proto3" json:"points,omitempty"`
-	BenchmarkID uint64                 `protobuf:"varint,3,opt,name=benchmarkID,proto3" json:"benchmarkID,omitempty"`
-	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Grade       GradingCriterion_Grade `protobuf:"varint,5,opt,name=grade,proto3,enum=ag.GradingCriterion_Grade" json:"grade,omitempty"`
-	Comment     string                 `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+	UserID uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
 }
 
-func (x *GradingCriterion) Reset() {
-	*x = GradingCriterion{}
+func (x *UserRequest) Reset() {
+	*x = UserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[20]
+		mi := &file_ag_ag_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GradingCriterion) String() string {
+func (x *UserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GradingCriterion) ProtoMessage() {}
+func (*UserRequest) ProtoMessage() {}
 
-func (x *GradingCriterion) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[20]
+func (x *UserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2031,86 +4621,92 @@ func (x *GradingCriterion) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GradingCriterion.ProtoReflect.Descriptor instead.
-func (*GradingCriterion) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use UserRequest.ProtoReflect.Descriptor instead.
+func (*UserRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *GradingCriterion) GetID() uint64 {
+func (x *UserRequest) GetUserID() uint64 {
 	if x != nil {
-		return x.ID
+		return x.UserID
 	}
 	return 0
 }
 
-func (x *GradingCriterion) GetPoints() uint64 {
-	if x != nil {
-		return x.Points
-	}
-	return 0
+type GetGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID uint64 `protobuf:"varint,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
 }
 
-func (x *GradingCriterion) GetBenchmarkID() uint64 {
-	if x != nil {
-		return x.BenchmarkID
+func (x *GetGroupRequest) Reset() {
+	*x = GetGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *GradingCriterion) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+func (x *GetGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GradingCriterion) GetGrade() GradingCriterion_Grade {
-	if x != nil {
-		return x.Grade
+func (*GetGroupRequest) ProtoMessage() {}
+
+func (x *GetGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return GradingCriterion_NONE
+	return mi.MessageOf(x)
 }
 
-func (x *GradingCriterion) GetComment() string {
+// Deprecated: Use GetGroupRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetGroupRequest) GetGroupID() uint64 {
 	if x != nil {
-		return x.Comment
+		return x.GroupID
 	}
-	return ""
+	return 0
 }
 
-type Review struct {
+type GroupRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID           uint64              `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	SubmissionID uint64              `protobuf:"varint,2,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
-	ReviewerID   uint64              `protobuf:"varint,3,opt,name=reviewerID,proto3" json:"reviewerID,omitempty"`
-	Review       string              `protobuf:"bytes,4,opt,name=review,proto3" json:"review,omitempty"` // JSON encoded grading criteria
-	Feedback     string              `protobuf:"bytes,5,opt,name=feedback,proto3" json:"feedback,omitempty"`
-	Ready        bool                `protobuf:"varint,6,opt,name=ready,proto3" json:"ready,omitempty"`
-	Score        uint64              `protobuf:"varint,7,opt,name=score,proto3" json:"score,omitempty"`
-	Benchmarks   []*GradingBenchmark `protobuf:"bytes,8,rep,name=benchmarks,proto3" json:"benchmarks,omitempty" gorm:"foreignKey:ID"`
-	Edited       string              `protobuf:"bytes,9,opt,name=edited,proto3" json:"edited,omitempty"`
+	UserID   uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	GroupID  uint64 `protobuf:"varint,2,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	CourseID uint64 `protobuf:"varint,3,opt,name=courseID,proto3" json:"courseID,omitempty"`
 }
 
-func (x *Review) Reset() {
-	*x = Review{}
+func (x *GroupRequest) Reset() {
+	*x = GroupRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[21]
+		mi := &file_ag_ag_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Review) String() string {
+func (x *GroupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Review) ProtoMessage() {}
+func (*GroupRequest) ProtoMessage() {}
 
-func (x *Review) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[21]
+func (x *GroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2121,99 +4717,104 @@ func (x *Review) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Review.ProtoReflect.Descriptor instead.
-func (*Review) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use GroupRequest.ProtoReflect.Descriptor instead.
+func (*GroupRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *Review) GetID() uint64 {
+func (x *GroupRequest) GetUserID() uint64 {
 	if x != nil {
-		return x.ID
+		return x.UserID
 	}
 	return 0
 }
 
-func (x *Review) GetSubmissionID() uint64 {
+func (x *GroupRequest) GetGroupID() uint64 {
 	if x != nil {
-		return x.SubmissionID
+		return x.GroupID
 	}
 	return 0
 }
 
-func (x *Review) GetReviewerID() uint64 {
+func (x *GroupRequest) GetCourseID() uint64 {
 	if x != nil {
-		return x.ReviewerID
+		return x.CourseID
 	}
 	return 0
 }
 
-func (x *Review) GetReview() string {
-	if x != nil {
-		return x.Review
-	}
-	return ""
+type Provider struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
 }
 
-func (x *Review) GetFeedback() string {
-	if x != nil {
-		return x.Feedback
+func (x *Provider) Reset() {
+	*x = Provider{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Review) GetReady() bool {
-	if x != nil {
-		return x.Ready
-	}
-	return false
+func (x *Provider) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Review) GetScore() uint64 {
-	if x != nil {
-		return x.Score
+func (*Provider) ProtoMessage() {}
+
+func (x *Provider) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Review) GetBenchmarks() []*GradingBenchmark {
-	if x != nil {
-		return x.Benchmarks
-	}
-	return nil
+// Deprecated: Use Provider.ProtoReflect.Descriptor instead.
+func (*Provider) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *Review) GetEdited() string {
+func (x *Provider) GetProvider() string {
 	if x != nil {
-		return x.Edited
+		return x.Provider
 	}
 	return ""
 }
 
-type Reviewers struct {
+type OrgRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Reviewers []*User `protobuf:"bytes,1,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+	OrgName string `protobuf:"bytes,1,opt,name=orgName,proto3" json:"orgName,omitempty"`
 }
 
-func (x *Reviewers) Reset() {
-	*x = Reviewers{}
+func (x *OrgRequest) Reset() {
+	*x = OrgRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[22]
+		mi := &file_ag_ag_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Reviewers) String() string {
+func (x *OrgRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Reviewers) ProtoMessage() {}
+func (*OrgRequest) ProtoMessage() {}
 
-func (x *Reviewers) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[22]
+func (x *OrgRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2224,44 +4825,46 @@ func (x *Reviewers) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Reviewers.ProtoReflect.Descriptor instead.
-func (*Reviewers) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use OrgRequest.ProtoReflect.Descriptor instead.
+func (*OrgRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *Reviewers) GetReviewers() []*User {
+func (x *OrgRequest) GetOrgName() string {
 	if x != nil {
-		return x.Reviewers
+		return x.OrgName
 	}
-	return nil
+	return ""
 }
 
-type ReviewRequest struct {
+type Organization struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CourseID uint64  `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	Review   *Review `protobuf:"bytes,2,opt,name=review,proto3" json:"review,omitempty"`
+	ID          uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Path        string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Avatar      string `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	PaymentPlan string `protobuf:"bytes,4,opt,name=paymentPlan,proto3" json:"paymentPlan,omitempty"`
 }
 
-func (x *ReviewRequest) Reset() {
-	*x = ReviewRequest{}
+func (x *Organization) Reset() {
+	*x = Organization{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[23]
+		mi := &file_ag_ag_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReviewRequest) String() string {
+func (x *Organization) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReviewRequest) ProtoMessage() {}
+func (*Organization) ProtoMessage() {}
 
-func (x *ReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[23]
+func (x *Organization) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2272,50 +4875,64 @@ func (x *ReviewRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReviewRequest.ProtoReflect.Descriptor instead.
-func (*ReviewRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use Organization.ProtoReflect.Descriptor instead.
+func (*Organization) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *ReviewRequest) GetCourseID() uint64 {
+func (x *Organization) GetID() uint64 {
 	if x != nil {
-		return x.CourseID
+		return x.ID
 	}
 	return 0
 }
 
-func (x *ReviewRequest) GetReview() *Review {
+func (x *Organization) GetPath() string {
 	if x != nil {
-		return x.Review
+		return x.Path
 	}
-	return nil
+	return ""
 }
 
-type CourseRequest struct {
+func (x *Organization) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *Organization) GetPaymentPlan() string {
+	if x != nil {
+		return x.PaymentPlan
+	}
+	return ""
+}
+
+type Organizations struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CourseID uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Organizations []*Organization `protobuf:"bytes,1,rep,name=organizations,proto3" json:"organizations,omitempty"`
 }
 
-func (x *CourseRequest) Reset() {
-	*x = CourseRequest{}
+func (x *Organizations) Reset() {
+	*x = Organizations{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[24]
+		mi := &file_ag_ag_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CourseRequest) String() string {
+func (x *Organizations) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CourseRequest) ProtoMessage() {}
+func (*Organizations) ProtoMessage() {}
 
-func (x *CourseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[24]
+func (x *Organizations) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2326,43 +4943,49 @@ func (x *CourseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CourseRequest.ProtoReflect.Descriptor instead.
-func (*CourseRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use Organizations.ProtoReflect.Descriptor instead.
+func (*Organizations) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *CourseRequest) GetCourseID() uint64 {
+func (x *Organizations) GetOrganizations() []*Organization {
 	if x != nil {
-		return x.CourseID
+		return x.Organizations
 	}
-	return 0
+	return nil
 }
 
-type UserRequest struct {
+// EnrollmentRequest is a request for enrolled users of a given course,
+// whose enrollment status match those provided in the request. To ignore group members
+// that otherwise match the enrollment request, set ignoreGroupMembers to true.
+type EnrollmentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	CourseID           uint64                  `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	IgnoreGroupMembers bool                    `protobuf:"varint,2,opt,name=ignoreGroupMembers,proto3" json:"ignoreGroupMembers,omitempty"`
+	WithActivity       bool                    `protobuf:"varint,3,opt,name=withActivity,proto3" json:"withActivity,omitempty"`
+	Statuses           []Enrollment_UserStatus `protobuf:"varint,4,rep,packed,name=statuses,proto3,enum=ag.Enrollment_UserStatus" json:"statuses,omitempty"`
 }
 
-func (x *UserRequest) Reset() {
-	*x = UserRequest{}
+func (x *EnrollmentRequest) Reset() {
+	*x = EnrollmentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[25]
+		mi := &file_ag_ag_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserRequest) String() string {
+func (x *EnrollmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserRequest) ProtoMessage() {}
+func (*EnrollmentRequest) ProtoMessage() {}
 
-func (x *UserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[25]
+func (x *EnrollmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2373,43 +4996,66 @@ func (x *UserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserRequest.ProtoReflect.Descriptor instead.
-func (*UserRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use EnrollmentRequest.ProtoReflect.Descriptor instead.
+func (*EnrollmentRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *UserRequest) GetUserID() uint64 {
+func (x *EnrollmentRequest) GetCourseID() uint64 {
 	if x != nil {
-		return x.UserID
+		return x.CourseID
 	}
 	return 0
 }
 
-type GetGroupRequest struct {
+func (x *EnrollmentRequest) GetIgnoreGroupMembers() bool {
+	if x != nil {
+		return x.IgnoreGroupMembers
+	}
+	return false
+}
+
+func (x *EnrollmentRequest) GetWithActivity() bool {
+	if x != nil {
+		return x.WithActivity
+	}
+	return false
+}
+
+func (x *EnrollmentRequest) GetStatuses() []Enrollment_UserStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+// EnrollmentStatusRequest is a request for a given user, with a specific enrollment status.
+type EnrollmentStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupID uint64 `protobuf:"varint,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	UserID   uint64                  `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Statuses []Enrollment_UserStatus `protobuf:"varint,2,rep,packed,name=statuses,proto3,enum=ag.Enrollment_UserStatus" json:"statuses,omitempty"`
 }
 
-func (x *GetGroupRequest) Reset() {
-	*x = GetGroupRequest{}
+func (x *EnrollmentStatusRequest) Reset() {
+	*x = EnrollmentStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[26]
+		mi := &file_ag_ag_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetGroupRequest) String() string {
+func (x *EnrollmentStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupRequest) ProtoMessage() {}
+func (*EnrollmentStatusRequest) ProtoMessage() {}
 
-func (x *GetGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[26]
+func (x *EnrollmentStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2420,19 +5066,26 @@ func (x *GetGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupRequest.ProtoReflect.Descriptor instead.
-func (*GetGroupRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use EnrollmentStatusRequest.ProtoReflect.Descriptor instead.
+func (*EnrollmentStatusRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *GetGroupRequest) GetGroupID() uint64 {
+func (x *EnrollmentStatusRequest) GetUserID() uint64 {
 	if x != nil {
-		return x.GroupID
+		return x.UserID
 	}
 	return 0
 }
 
-type GroupRequest struct {
+func (x *EnrollmentStatusRequest) GetStatuses() []Enrollment_UserStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type SubmissionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2442,23 +5095,23 @@ type GroupRequest struct {
 	CourseID uint64 `protobuf:"varint,3,opt,name=courseID,proto3" json:"courseID,omitempty"`
 }
 
-func (x *GroupRequest) Reset() {
-	*x = GroupRequest{}
+func (x *SubmissionRequest) Reset() {
+	*x = SubmissionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[27]
+		mi := &file_ag_ag_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GroupRequest) String() string {
+func (x *SubmissionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupRequest) ProtoMessage() {}
-
-func (x *GroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[27]
+func (*SubmissionRequest) ProtoMessage() {}
+
+func (x *SubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2469,57 +5122,61 @@ func (x *GroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupRequest.ProtoReflect.Descriptor instead.
-func (*GroupRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use SubmissionRequest.ProtoReflect.Descriptor instead.
+func (*SubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *GroupRequest) GetUserID() uint64 {
+func (x *SubmissionRequest) GetUserID() uint64 {
 	if x != nil {
 		return x.UserID
 	}
 	return 0
 }
 
-func (x *GroupRequest) GetGroupID() uint64 {
+func (x *SubmissionRequest) GetGroupID() uint64 {
 	if x != nil {
 		return x.GroupID
 	}
 	return 0
 }
 
-func (x *GroupRequest) GetCourseID() uint64 {
+func (x *SubmissionRequest) GetCourseID() uint64 {
 	if x != nil {
 		return x.CourseID
 	}
 	return 0
 }
 
-type Provider struct {
+type UpdateSubmissionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	SubmissionID uint64            `protobuf:"varint,1,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+	CourseID     uint64            `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Score        uint32            `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	Released     bool              `protobuf:"varint,4,opt,name=released,proto3" json:"released,omitempty"`
+	Status       Submission_Status `protobuf:"varint,5,opt,name=status,proto3,enum=ag.Submission_Status" json:"status,omitempty"`
 }
 
-func (x *Provider) Reset() {
-	*x = Provider{}
+func (x *UpdateSubmissionRequest) Reset() {
+	*x = UpdateSubmissionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[28]
+		mi := &file_ag_ag_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Provider) String() string {
+func (x *UpdateSubmissionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Provider) ProtoMessage() {}
+func (*UpdateSubmissionRequest) ProtoMessage() {}
 
-func (x *Provider) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[28]
+func (x *UpdateSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2530,43 +5187,75 @@ func (x *Provider) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Provider.ProtoReflect.Descriptor instead.
-func (*Provider) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use UpdateSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *Provider) GetProvider() string {
+func (x *UpdateSubmissionRequest) GetSubmissionID() uint64 {
 	if x != nil {
-		return x.Provider
+		return x.SubmissionID
 	}
-	return ""
+	return 0
 }
 
-type OrgRequest struct {
+func (x *UpdateSubmissionRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *UpdateSubmissionRequest) GetScore() uint32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *UpdateSubmissionRequest) GetReleased() bool {
+	if x != nil {
+		return x.Released
+	}
+	return false
+}
+
+func (x *UpdateSubmissionRequest) GetStatus() Submission_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Submission_NONE
+}
+
+type UpdateSubmissionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrgName string `protobuf:"bytes,1,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	CourseID     uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	ScoreLimit   uint32 `protobuf:"varint,3,opt,name=scoreLimit,proto3" json:"scoreLimit,omitempty"`
+	Release      bool   `protobuf:"varint,4,opt,name=release,proto3" json:"release,omitempty"`
+	Approve      bool   `protobuf:"varint,5,opt,name=approve,proto3" json:"approve,omitempty"`
 }
 
-func (x *OrgRequest) Reset() {
-	*x = OrgRequest{}
+func (x *UpdateSubmissionsRequest) Reset() {
+	*x = UpdateSubmissionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[29]
+		mi := &file_ag_ag_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OrgRequest) String() string {
+func (x *UpdateSubmissionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrgRequest) ProtoMessage() {}
+func (*UpdateSubmissionsRequest) ProtoMessage() {}
 
-func (x *OrgRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[29]
+func (x *UpdateSubmissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2577,46 +5266,73 @@ func (x *OrgRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrgRequest.ProtoReflect.Descriptor instead.
-func (*OrgRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use UpdateSubmissionsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSubmissionsRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *OrgRequest) GetOrgName() string {
+func (x *UpdateSubmissionsRequest) GetCourseID() uint64 {
 	if x != nil {
-		return x.OrgName
+		return x.CourseID
 	}
-	return ""
+	return 0
 }
 
-type Organization struct {
+func (x *UpdateSubmissionsRequest) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *UpdateSubmissionsRequest) GetScoreLimit() uint32 {
+	if x != nil {
+		return x.ScoreLimit
+	}
+	return 0
+}
+
+func (x *UpdateSubmissionsRequest) GetRelease() bool {
+	if x != nil {
+		return x.Release
+	}
+	return false
+}
+
+func (x *UpdateSubmissionsRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+type SubmissionAttemptRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID          uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Path        string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Avatar      string `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
-	PaymentPlan string `protobuf:"bytes,4,opt,name=paymentPlan,proto3" json:"paymentPlan,omitempty"`
+	CourseID     uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	SubmissionID uint64 `protobuf:"varint,2,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+	AttemptID    uint64 `protobuf:"varint,3,opt,name=attemptID,proto3" json:"attemptID,omitempty"`
 }
 
-func (x *Organization) Reset() {
-	*x = Organization{}
+func (x *SubmissionAttemptRequest) Reset() {
+	*x = SubmissionAttemptRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[30]
+		mi := &file_ag_ag_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Organization) String() string {
+func (x *SubmissionAttemptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Organization) ProtoMessage() {}
+func (*SubmissionAttemptRequest) ProtoMessage() {}
 
-func (x *Organization) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[30]
+func (x *SubmissionAttemptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2627,64 +5343,58 @@ func (x *Organization) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Organization.ProtoReflect.Descriptor instead.
-func (*Organization) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use SubmissionAttemptRequest.ProtoReflect.Descriptor instead.
+func (*SubmissionAttemptRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *Organization) GetID() uint64 {
+func (x *SubmissionAttemptRequest) GetCourseID() uint64 {
 	if x != nil {
-		return x.ID
+		return x.CourseID
 	}
 	return 0
 }
 
-func (x *Organization) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *Organization) GetAvatar() string {
+func (x *SubmissionAttemptRequest) GetSubmissionID() uint64 {
 	if x != nil {
-		return x.Avatar
+		return x.SubmissionID
 	}
-	return ""
+	return 0
 }
 
-func (x *Organization) GetPaymentPlan() string {
+func (x *SubmissionAttemptRequest) GetAttemptID() uint64 {
 	if x != nil {
-		return x.PaymentPlan
+		return x.AttemptID
 	}
-	return ""
+	return 0
 }
 
-type Organizations struct {
+type SubmissionReviewersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Organizations []*Organization `protobuf:"bytes,1,rep,name=organizations,proto3" json:"organizations,omitempty"`
+	SubmissionID uint64 `protobuf:"varint,1,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+	CourseID     uint64 `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
 }
 
-func (x *Organizations) Reset() {
-	*x = Organizations{}
+func (x *SubmissionReviewersRequest) Reset() {
+	*x = SubmissionReviewersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[31]
+		mi := &file_ag_ag_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Organizations) String() string {
+func (x *SubmissionReviewersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Organizations) ProtoMessage() {}
+func (*SubmissionReviewersRequest) ProtoMessage() {}
 
-func (x *Organizations) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[31]
+func (x *SubmissionReviewersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2695,49 +5405,50 @@ func (x *Organizations) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Organizations.ProtoReflect.Descriptor instead.
-func (*Organizations) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use SubmissionReviewersRequest.ProtoReflect.Descriptor instead.
+func (*SubmissionReviewersRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *Organizations) GetOrganizations() []*Organization {
+func (x *SubmissionReviewersRequest) GetSubmissionID() uint64 {
 	if x != nil {
-		return x.Organizations
+		return x.SubmissionID
 	}
-	return nil
+	return 0
 }
 
-// EnrollmentRequest is a request for enrolled users of a given course,
-// whose enrollment status match those provided in the request. To ignore group members
-// that otherwise match the enrollment request, set ignoreGroupMembers to true.
-type EnrollmentRequest struct {
+func (x *SubmissionReviewersRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+type Providers struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CourseID           uint64                  `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	IgnoreGroupMembers bool                    `protobuf:"varint,2,opt,name=ignoreGroupMembers,proto3" json:"ignoreGroupMembers,omitempty"`
-	WithActivity       bool                    `protobuf:"varint,3,opt,name=withActivity,proto3" json:"withActivity,omitempty"`
-	Statuses           []Enrollment_UserStatus `protobuf:"varint,4,rep,packed,name=statuses,proto3,enum=ag.Enrollment_UserStatus" json:"statuses,omitempty"`
+	Providers []string `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
 }
 
-func (x *EnrollmentRequest) Reset() {
-	*x = EnrollmentRequest{}
+func (x *Providers) Reset() {
+	*x = Providers{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[32]
+		mi := &file_ag_ag_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EnrollmentRequest) String() string {
+func (x *Providers) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnrollmentRequest) ProtoMessage() {}
+func (*Providers) ProtoMessage() {}
 
-func (x *EnrollmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[32]
+func (x *Providers) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2748,66 +5459,44 @@ func (x *EnrollmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnrollmentRequest.ProtoReflect.Descriptor instead.
-func (*EnrollmentRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{32}
-}
-
-func (x *EnrollmentRequest) GetCourseID() uint64 {
-	if x != nil {
-		return x.CourseID
-	}
-	return 0
-}
-
-func (x *EnrollmentRequest) GetIgnoreGroupMembers() bool {
-	if x != nil {
-		return x.IgnoreGroupMembers
-	}
-	return false
-}
-
-func (x *EnrollmentRequest) GetWithActivity() bool {
-	if x != nil {
-		return x.WithActivity
-	}
-	return false
+// Deprecated: Use Providers.ProtoReflect.Descriptor instead.
+func (*Providers) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *EnrollmentRequest) GetStatuses() []Enrollment_UserStatus {
+func (x *Providers) GetProviders() []string {
 	if x != nil {
-		return x.Statuses
+		return x.Providers
 	}
 	return nil
 }
 
-// EnrollmentStatusRequest is a request for a given user, with a specific enrollment status.
-type EnrollmentStatusRequest struct {
+type URLRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID   uint64                  `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	Statuses []Enrollment_UserStatus `protobuf:"varint,2,rep,packed,name=statuses,proto3,enum=ag.Enrollment_UserStatus" json:"statuses,omitempty"`
+	CourseID  uint64            `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	RepoTypes []Repository_Type `protobuf:"varint,2,rep,packed,name=repoTypes,proto3,enum=ag.Repository_Type" json:"repoTypes,omitempty"`
 }
 
-func (x *EnrollmentStatusRequest) Reset() {
-	*x = EnrollmentStatusRequest{}
+func (x *URLRequest) Reset() {
+	*x = URLRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[33]
+		mi := &file_ag_ag_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EnrollmentStatusRequest) String() string {
+func (x *URLRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnrollmentStatusRequest) ProtoMessage() {}
+func (*URLRequest) ProtoMessage() {}
 
-func (x *EnrollmentStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[33]
+func (x *URLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2818,26 +5507,27 @@ func (x *EnrollmentStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnrollmentStatusRequest.ProtoReflect.Descriptor instead.
-func (*EnrollmentStatusRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use URLRequest.ProtoReflect.Descriptor instead.
+func (*URLRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *EnrollmentStatusRequest) GetUserID() uint64 {
+func (x *URLRequest) GetCourseID() uint64 {
 	if x != nil {
-		return x.UserID
+		return x.CourseID
 	}
 	return 0
 }
 
-func (x *EnrollmentStatusRequest) GetStatuses() []Enrollment_UserStatus {
+func (x *URLRequest) GetRepoTypes() []Repository_Type {
 	if x != nil {
-		return x.Statuses
+		return x.RepoTypes
 	}
 	return nil
 }
 
-type SubmissionRequest struct {
+// used to check whether student/group submission repo is empty
+type RepositoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2847,23 +5537,23 @@ type SubmissionRequest struct {
 	CourseID uint64 `protobuf:"varint,3,opt,name=courseID,proto3" json:"courseID,omitempty"`
 }
 
-func (x *SubmissionRequest) Reset() {
-	*x = SubmissionRequest{}
+func (x *RepositoryRequest) Reset() {
+	*x = RepositoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[34]
+		mi := &file_ag_ag_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubmissionRequest) String() string {
+func (x *RepositoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmissionRequest) ProtoMessage() {}
+func (*RepositoryRequest) ProtoMessage() {}
 
-func (x *SubmissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[34]
+func (x *RepositoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2874,61 +5564,57 @@ func (x *SubmissionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmissionRequest.ProtoReflect.Descriptor instead.
-func (*SubmissionRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use RepositoryRequest.ProtoReflect.Descriptor instead.
+func (*RepositoryRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *SubmissionRequest) GetUserID() uint64 {
+func (x *RepositoryRequest) GetUserID() uint64 {
 	if x != nil {
 		return x.UserID
 	}
 	return 0
 }
 
-func (x *SubmissionRequest) GetGroupID() uint64 {
+func (x *RepositoryRequest) GetGroupID() uint64 {
 	if x != nil {
 		return x.GroupID
 	}
 	return 0
 }
 
-func (x *SubmissionRequest) GetCourseID() uint64 {
+func (x *RepositoryRequest) GetCourseID() uint64 {
 	if x != nil {
 		return x.CourseID
 	}
 	return 0
 }
 
-type UpdateSubmissionRequest struct {
+type Repositories struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubmissionID uint64            `protobuf:"varint,1,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
-	CourseID     uint64            `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	Score        uint32            `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
-	Released     bool              `protobuf:"varint,4,opt,name=released,proto3" json:"released,omitempty"`
-	Status       Submission_Status `protobuf:"varint,5,opt,name=status,proto3,enum=ag.Submission_Status" json:"status,omitempty"`
+	URLs map[string]string `protobuf:"bytes,1,rep,name=URLs,proto3" json:"URLs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *UpdateSubmissionRequest) Reset() {
-	*x = UpdateSubmissionRequest{}
+func (x *Repositories) Reset() {
+	*x = Repositories{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[35]
+		mi := &file_ag_ag_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateSubmissionRequest) String() string {
+func (x *Repositories) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSubmissionRequest) ProtoMessage() {}
+func (*Repositories) ProtoMessage() {}
 
-func (x *UpdateSubmissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[35]
+func (x *Repositories) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2939,75 +5625,91 @@ func (x *UpdateSubmissionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSubmissionRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSubmissionRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use Repositories.ProtoReflect.Descriptor instead.
+func (*Repositories) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *UpdateSubmissionRequest) GetSubmissionID() uint64 {
+func (x *Repositories) GetURLs() map[string]string {
 	if x != nil {
-		return x.SubmissionID
+		return x.URLs
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateSubmissionRequest) GetCourseID() uint64 {
-	if x != nil {
-		return x.CourseID
-	}
-	return 0
+type AuthorizationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsAuthorized bool `protobuf:"varint,1,opt,name=IsAuthorized,proto3" json:"IsAuthorized,omitempty"`
 }
 
-func (x *UpdateSubmissionRequest) GetScore() uint32 {
-	if x != nil {
-		return x.Score
+func (x *AuthorizationResponse) Reset() {
+	*x = AuthorizationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *UpdateSubmissionRequest) GetReleased() bool {
-	if x != nil {
-		return x.Released
+func (x *AuthorizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizationResponse) ProtoMessage() {}
+
+func (x *AuthorizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateSubmissionRequest) GetStatus() Submission_Status {
+// Deprecated: Use AuthorizationResponse.ProtoReflect.Descriptor instead.
+func (*AuthorizationResponse) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *AuthorizationResponse) GetIsAuthorized() bool {
 	if x != nil {
-		return x.Status
+		return x.IsAuthorized
 	}
-	return Submission_NONE
+	return false
 }
 
-type UpdateSubmissionsRequest struct {
+type Status struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CourseID     uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
-	ScoreLimit   uint32 `protobuf:"varint,3,opt,name=scoreLimit,proto3" json:"scoreLimit,omitempty"`
-	Release      bool   `protobuf:"varint,4,opt,name=release,proto3" json:"release,omitempty"`
-	Approve      bool   `protobuf:"varint,5,opt,name=approve,proto3" json:"approve,omitempty"`
+	Code  uint64 `protobuf:"varint,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
 }
 
-func (x *UpdateSubmissionsRequest) Reset() {
-	*x = UpdateSubmissionsRequest{}
+func (x *Status) Reset() {
+	*x = Status{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[36]
+		mi := &file_ag_ag_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateSubmissionsRequest) String() string {
+func (x *Status) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSubmissionsRequest) ProtoMessage() {}
+func (*Status) ProtoMessage() {}
 
-func (x *UpdateSubmissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[36]
+func (x *Status) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3018,72 +5720,114 @@ func (x *UpdateSubmissionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSubmissionsRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSubmissionsRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use Status.ProtoReflect.Descriptor instead.
+func (*Status) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *UpdateSubmissionsRequest) GetCourseID() uint64 {
+func (x *Status) GetCode() uint64 {
 	if x != nil {
-		return x.CourseID
+		return x.Code
 	}
 	return 0
 }
 
-func (x *UpdateSubmissionsRequest) GetAssignmentID() uint64 {
+func (x *Status) GetError() string {
 	if x != nil {
-		return x.AssignmentID
+		return x.Error
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateSubmissionsRequest) GetScoreLimit() uint32 {
+type SubmissionsForCourseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID      uint64                           `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Type          SubmissionsForCourseRequest_Type `protobuf:"varint,2,opt,name=type,proto3,enum=ag.SubmissionsForCourseRequest_Type" json:"type,omitempty"`
+	SkipBuildInfo bool                             `protobuf:"varint,3,opt,name=skipBuildInfo,proto3" json:"skipBuildInfo,omitempty"`
+}
+
+func (x *SubmissionsForCourseRequest) Reset() {
+	*x = SubmissionsForCourseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmissionsForCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionsForCourseRequest) ProtoMessage() {}
+
+func (x *SubmissionsForCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionsForCourseRequest.ProtoReflect.Descriptor instead.
+func (*SubmissionsForCourseRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SubmissionsForCourseRequest) GetCourseID() uint64 {
 	if x != nil {
-		return x.ScoreLimit
+		return x.CourseID
 	}
 	return 0
 }
 
-func (x *UpdateSubmissionsRequest) GetRelease() bool {
+func (x *SubmissionsForCourseRequest) GetType() SubmissionsForCourseRequest_Type {
 	if x != nil {
-		return x.Release
+		return x.Type
 	}
-	return false
+	return SubmissionsForCourseRequest_ALL
 }
 
-func (x *UpdateSubmissionsRequest) GetApprove() bool {
+func (x *SubmissionsForCourseRequest) GetSkipBuildInfo() bool {
 	if x != nil {
-		return x.Approve
+		return x.SkipBuildInfo
 	}
 	return false
 }
 
-type SubmissionReviewersRequest struct {
+type RebuildRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	SubmissionID uint64 `protobuf:"varint,1,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
-	CourseID     uint64 `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
 }
 
-func (x *SubmissionReviewersRequest) Reset() {
-	*x = SubmissionReviewersRequest{}
+func (x *RebuildRequest) Reset() {
+	*x = RebuildRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[37]
+		mi := &file_ag_ag_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubmissionReviewersRequest) String() string {
+func (x *RebuildRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmissionReviewersRequest) ProtoMessage() {}
+func (*RebuildRequest) ProtoMessage() {}
 
-func (x *SubmissionReviewersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[37]
+func (x *RebuildRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3094,50 +5838,52 @@ func (x *SubmissionReviewersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmissionReviewersRequest.ProtoReflect.Descriptor instead.
-func (*SubmissionReviewersRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use RebuildRequest.ProtoReflect.Descriptor instead.
+func (*RebuildRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *SubmissionReviewersRequest) GetSubmissionID() uint64 {
+func (x *RebuildRequest) GetSubmissionID() uint64 {
 	if x != nil {
 		return x.SubmissionID
 	}
 	return 0
 }
 
-func (x *SubmissionReviewersRequest) GetCourseID() uint64 {
+func (x *RebuildRequest) GetAssignmentID() uint64 {
 	if x != nil {
-		return x.CourseID
+		return x.AssignmentID
 	}
 	return 0
 }
 
-type Providers struct {
+type CourseUserRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Providers []string `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+	CourseCode string `protobuf:"bytes,1,opt,name=courseCode,proto3" json:"courseCode,omitempty"`
+	CourseYear uint32 `protobuf:"varint,2,opt,name=courseYear,proto3" json:"courseYear,omitempty"`
+	UserLogin  string `protobuf:"bytes,3,opt,name=userLogin,proto3" json:"userLogin,omitempty"`
 }
 
-func (x *Providers) Reset() {
-	*x = Providers{}
+func (x *CourseUserRequest) Reset() {
+	*x = CourseUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[38]
+		mi := &file_ag_ag_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Providers) String() string {
+func (x *CourseUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Providers) ProtoMessage() {}
+func (*CourseUserRequest) ProtoMessage() {}
 
-func (x *Providers) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[38]
+func (x *CourseUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3148,44 +5894,58 @@ func (x *Providers) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Providers.ProtoReflect.Descriptor instead.
-func (*Providers) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use CourseUserRequest.ProtoReflect.Descriptor instead.
+func (*CourseUserRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *Providers) GetProviders() []string {
+func (x *CourseUserRequest) GetCourseCode() string {
 	if x != nil {
-		return x.Providers
+		return x.CourseCode
 	}
-	return nil
+	return ""
 }
 
-type URLRequest struct {
+func (x *CourseUserRequest) GetCourseYear() uint32 {
+	if x != nil {
+		return x.CourseYear
+	}
+	return 0
+}
+
+func (x *CourseUserRequest) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+type LoadCriteriaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CourseID  uint64            `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	RepoTypes []Repository_Type `protobuf:"varint,2,rep,packed,name=repoTypes,proto3,enum=ag.Repository_Type" json:"repoTypes,omitempty"`
+	CourseID     uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
 }
 
-func (x *URLRequest) Reset() {
-	*x = URLRequest{}
+func (x *LoadCriteriaRequest) Reset() {
+	*x = LoadCriteriaRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[39]
+		mi := &file_ag_ag_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *URLRequest) String() string {
+func (x *LoadCriteriaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*URLRequest) ProtoMessage() {}
+func (*LoadCriteriaRequest) ProtoMessage() {}
 
-func (x *URLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[39]
+func (x *LoadCriteriaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3196,53 +5956,51 @@ func (x *URLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use URLRequest.ProtoReflect.Descriptor instead.
-func (*URLRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use LoadCriteriaRequest.ProtoReflect.Descriptor instead.
+func (*LoadCriteriaRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *URLRequest) GetCourseID() uint64 {
+func (x *LoadCriteriaRequest) GetCourseID() uint64 {
 	if x != nil {
 		return x.CourseID
 	}
 	return 0
 }
 
-func (x *URLRequest) GetRepoTypes() []Repository_Type {
+func (x *LoadCriteriaRequest) GetAssignmentID() uint64 {
 	if x != nil {
-		return x.RepoTypes
+		return x.AssignmentID
 	}
-	return nil
+	return 0
 }
 
-// used to check whether student/group submission repo is empty
-type RepositoryRequest struct {
+type BuildJobRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID   uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	GroupID  uint64 `protobuf:"varint,2,opt,name=groupID,proto3" json:"groupID,omitempty"`
-	CourseID uint64 `protobuf:"varint,3,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	CourseID uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	JobID    uint64 `protobuf:"varint,2,opt,name=jobID,proto3" json:"jobID,omitempty"`
 }
 
-func (x *RepositoryRequest) Reset() {
-	*x = RepositoryRequest{}
+func (x *BuildJobRequest) Reset() {
+	*x = BuildJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[40]
+		mi := &file_ag_ag_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RepositoryRequest) String() string {
+func (x *BuildJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RepositoryRequest) ProtoMessage() {}
+func (*BuildJobRequest) ProtoMessage() {}
 
-func (x *RepositoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[40]
+func (x *BuildJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3253,57 +6011,54 @@ func (x *RepositoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RepositoryRequest.ProtoReflect.Descriptor instead.
-func (*RepositoryRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{40}
-}
-
-func (x *RepositoryRequest) GetUserID() uint64 {
-	if x != nil {
-		return x.UserID
-	}
-	return 0
+// Deprecated: Use BuildJobRequest.ProtoReflect.Descriptor instead.
+func (*BuildJobRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *RepositoryRequest) GetGroupID() uint64 {
+func (x *BuildJobRequest) GetCourseID() uint64 {
 	if x != nil {
-		return x.GroupID
+		return x.CourseID
 	}
 	return 0
 }
 
-func (x *RepositoryRequest) GetCourseID() uint64 {
+func (x *BuildJobRequest) GetJobID() uint64 {
 	if x != nil {
-		return x.CourseID
+		return x.JobID
 	}
 	return 0
 }
 
-type Repositories struct {
+// BuildQuotaRequest is a request for the build quotas of an assignment,
+// or to reset the build quota of the given repository.
+type BuildQuotaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	URLs map[string]string `protobuf:"bytes,1,rep,name=URLs,proto3" json:"URLs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	CourseID     uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	RepositoryID uint64 `protobuf:"varint,3,opt,name=repositoryID,proto3" json:"repositoryID,omitempty"`
 }
 
-func (x *Repositories) Reset() {
-	*x = Repositories{}
+func (x *BuildQuotaRequest) Reset() {
+	*x = BuildQuotaRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[41]
+		mi := &file_ag_ag_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Repositories) String() string {
+func (x *BuildQuotaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Repositories) ProtoMessage() {}
+func (*BuildQuotaRequest) ProtoMessage() {}
 
-func (x *Repositories) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[41]
+func (x *BuildQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3314,43 +6069,58 @@ func (x *Repositories) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Repositories.ProtoReflect.Descriptor instead.
-func (*Repositories) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use BuildQuotaRequest.ProtoReflect.Descriptor instead.
+func (*BuildQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *Repositories) GetURLs() map[string]string {
+func (x *BuildQuotaRequest) GetCourseID() uint64 {
 	if x != nil {
-		return x.URLs
+		return x.CourseID
 	}
-	return nil
+	return 0
 }
 
-type AuthorizationResponse struct {
+func (x *BuildQuotaRequest) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *BuildQuotaRequest) GetRepositoryID() uint64 {
+	if x != nil {
+		return x.RepositoryID
+	}
+	return 0
+}
+
+type BuildLogRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsAuthorized bool `protobuf:"varint,1,opt,name=IsAuthorized,proto3" json:"IsAuthorized,omitempty"`
+	CourseID uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	CommitID string `protobuf:"bytes,2,opt,name=commitID,proto3" json:"commitID,omitempty"`
 }
 
-func (x *AuthorizationResponse) Reset() {
-	*x = AuthorizationResponse{}
+func (x *BuildLogRequest) Reset() {
+	*x = BuildLogRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[42]
+		mi := &file_ag_ag_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AuthorizationResponse) String() string {
+func (x *BuildLogRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthorizationResponse) ProtoMessage() {}
+func (*BuildLogRequest) ProtoMessage() {}
 
-func (x *AuthorizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[42]
+func (x *BuildLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3361,44 +6131,52 @@ func (x *AuthorizationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthorizationResponse.ProtoReflect.Descriptor instead.
-func (*AuthorizationResponse) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use BuildLogRequest.ProtoReflect.Descriptor instead.
+func (*BuildLogRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *AuthorizationResponse) GetIsAuthorized() bool {
+func (x *BuildLogRequest) GetCourseID() uint64 {
 	if x != nil {
-		return x.IsAuthorized
+		return x.CourseID
 	}
-	return false
+	return 0
 }
 
-type Status struct {
+func (x *BuildLogRequest) GetCommitID() string {
+	if x != nil {
+		return x.CommitID
+	}
+	return ""
+}
+
+type BuildLogLine struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code  uint64 `protobuf:"varint,1,opt,name=Code,proto3" json:"Code,omitempty"`
-	Error string `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+	JobID        uint64 `protobuf:"varint,1,opt,name=jobID,proto3" json:"jobID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	Line         string `protobuf:"bytes,3,opt,name=line,proto3" json:"line,omitempty"`
 }
 
-func (x *Status) Reset() {
-	*x = Status{}
+func (x *BuildLogLine) Reset() {
+	*x = BuildLogLine{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[43]
+		mi := &file_ag_ag_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Status) String() string {
+func (x *BuildLogLine) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Status) ProtoMessage() {}
+func (*BuildLogLine) ProtoMessage() {}
 
-func (x *Status) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[43]
+func (x *BuildLogLine) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3409,52 +6187,61 @@ func (x *Status) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Status.ProtoReflect.Descriptor instead.
-func (*Status) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use BuildLogLine.ProtoReflect.Descriptor instead.
+func (*BuildLogLine) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *Status) GetCode() uint64 {
+func (x *BuildLogLine) GetJobID() uint64 {
 	if x != nil {
-		return x.Code
+		return x.JobID
 	}
 	return 0
 }
 
-func (x *Status) GetError() string {
+func (x *BuildLogLine) GetAssignmentID() uint64 {
 	if x != nil {
-		return x.Error
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *BuildLogLine) GetLine() string {
+	if x != nil {
+		return x.Line
 	}
 	return ""
 }
 
-type SubmissionsForCourseRequest struct {
+// TestStatisticsRequest is a request for the test case statistics of an assignment,
+// computed from the graded attempts, or from all attempts if allAttempts is set.
+type TestStatisticsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CourseID      uint64                           `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	Type          SubmissionsForCourseRequest_Type `protobuf:"varint,2,opt,name=type,proto3,enum=ag.SubmissionsForCourseRequest_Type" json:"type,omitempty"`
-	SkipBuildInfo bool                             `protobuf:"varint,3,opt,name=skipBuildInfo,proto3" json:"skipBuildInfo,omitempty"`
+	CourseID     uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	AllAttempts  bool   `protobuf:"varint,3,opt,name=allAttempts,proto3" json:"allAttempts,omitempty"`
 }
 
-func (x *SubmissionsForCourseRequest) Reset() {
-	*x = SubmissionsForCourseRequest{}
+func (x *TestStatisticsRequest) Reset() {
+	*x = TestStatisticsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[44]
+		mi := &file_ag_ag_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubmissionsForCourseRequest) String() string {
+func (x *TestStatisticsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmissionsForCourseRequest) ProtoMessage() {}
+func (*TestStatisticsRequest) ProtoMessage() {}
 
-func (x *SubmissionsForCourseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[44]
+func (x *TestStatisticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3465,58 +6252,58 @@ func (x *SubmissionsForCourseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmissionsForCourseRequest.ProtoReflect.Descriptor instead.
-func (*SubmissionsForCourseRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use TestStatisticsRequest.ProtoReflect.Descriptor instead.
+func (*TestStatisticsRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *SubmissionsForCourseRequest) GetCourseID() uint64 {
+func (x *TestStatisticsRequest) GetCourseID() uint64 {
 	if x != nil {
 		return x.CourseID
 	}
 	return 0
 }
 
-func (x *SubmissionsForCourseRequest) GetType() SubmissionsForCourseRequest_Type {
+func (x *TestStatisticsRequest) GetAssignmentID() uint64 {
 	if x != nil {
-		return x.Type
+		return x.AssignmentID
 	}
-	return SubmissionsForCourseRequest_ALL
+	return 0
 }
 
-func (x *SubmissionsForCourseRequest) GetSkipBuildInfo() bool {
+func (x *TestStatisticsRequest) GetAllAttempts() bool {
 	if x != nil {
-		return x.SkipBuildInfo
+		return x.AllAttempts
 	}
 	return false
 }
 
-type RebuildRequest struct {
+type SimilarityRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubmissionID uint64 `protobuf:"varint,1,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+	CourseID     uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
 	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
 }
 
-func (x *RebuildRequest) Reset() {
-	*x = RebuildRequest{}
+func (x *SimilarityRequest) Reset() {
+	*x = SimilarityRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[45]
+		mi := &file_ag_ag_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RebuildRequest) String() string {
+func (x *SimilarityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RebuildRequest) ProtoMessage() {}
+func (*SimilarityRequest) ProtoMessage() {}
 
-func (x *RebuildRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[45]
+func (x *SimilarityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3527,52 +6314,58 @@ func (x *RebuildRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RebuildRequest.ProtoReflect.Descriptor instead.
-func (*RebuildRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use SimilarityRequest.ProtoReflect.Descriptor instead.
+func (*SimilarityRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *RebuildRequest) GetSubmissionID() uint64 {
+func (x *SimilarityRequest) GetCourseID() uint64 {
 	if x != nil {
-		return x.SubmissionID
+		return x.CourseID
 	}
 	return 0
 }
 
-func (x *RebuildRequest) GetAssignmentID() uint64 {
+func (x *SimilarityRequest) GetAssignmentID() uint64 {
 	if x != nil {
 		return x.AssignmentID
 	}
 	return 0
 }
 
-type CourseUserRequest struct {
+// GradebookRequest is a request to export the gradebook of a course.
+// Students pass if they have at least minApproved approved assignments,
+// including all the required assignments. Users with the ignored logins are left out.
+type GradebookRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CourseCode string `protobuf:"bytes,1,opt,name=courseCode,proto3" json:"courseCode,omitempty"`
-	CourseYear uint32 `protobuf:"varint,2,opt,name=courseYear,proto3" json:"courseYear,omitempty"`
-	UserLogin  string `protobuf:"bytes,3,opt,name=userLogin,proto3" json:"userLogin,omitempty"`
+	CourseID            uint64                  `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Format              GradebookRequest_Format `protobuf:"varint,2,opt,name=format,proto3,enum=ag.GradebookRequest_Format" json:"format,omitempty"`
+	Groups              bool                    `protobuf:"varint,3,opt,name=groups,proto3" json:"groups,omitempty"` // export one row per group and only group assignments
+	MinApproved         uint32                  `protobuf:"varint,4,opt,name=minApproved,proto3" json:"minApproved,omitempty"`
+	RequiredAssignments []string                `protobuf:"bytes,5,rep,name=requiredAssignments,proto3" json:"requiredAssignments,omitempty"`
+	IgnoredLogins       []string                `protobuf:"bytes,6,rep,name=ignoredLogins,proto3" json:"ignoredLogins,omitempty"`
 }
 
-func (x *CourseUserRequest) Reset() {
-	*x = CourseUserRequest{}
+func (x *GradebookRequest) Reset() {
+	*x = GradebookRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[46]
+		mi := &file_ag_ag_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CourseUserRequest) String() string {
+func (x *GradebookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CourseUserRequest) ProtoMessage() {}
+func (*GradebookRequest) ProtoMessage() {}
 
-func (x *CourseUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[46]
+func (x *GradebookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3583,58 +6376,80 @@ func (x *CourseUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CourseUserRequest.ProtoReflect.Descriptor instead.
-func (*CourseUserRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use GradebookRequest.ProtoReflect.Descriptor instead.
+func (*GradebookRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *CourseUserRequest) GetCourseCode() string {
+func (x *GradebookRequest) GetCourseID() uint64 {
 	if x != nil {
-		return x.CourseCode
+		return x.CourseID
 	}
-	return ""
+	return 0
 }
 
-func (x *CourseUserRequest) GetCourseYear() uint32 {
+func (x *GradebookRequest) GetFormat() GradebookRequest_Format {
 	if x != nil {
-		return x.CourseYear
+		return x.Format
+	}
+	return GradebookRequest_CSV
+}
+
+func (x *GradebookRequest) GetGroups() bool {
+	if x != nil {
+		return x.Groups
+	}
+	return false
+}
+
+func (x *GradebookRequest) GetMinApproved() uint32 {
+	if x != nil {
+		return x.MinApproved
 	}
 	return 0
 }
 
-func (x *CourseUserRequest) GetUserLogin() string {
+func (x *GradebookRequest) GetRequiredAssignments() []string {
 	if x != nil {
-		return x.UserLogin
+		return x.RequiredAssignments
 	}
-	return ""
+	return nil
 }
 
-type LoadCriteriaRequest struct {
+func (x *GradebookRequest) GetIgnoredLogins() []string {
+	if x != nil {
+		return x.IgnoredLogins
+	}
+	return nil
+}
+
+type GradebookFile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CourseID     uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ContentType string `protobuf:"bytes,2,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	Content     []byte `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
 }
 
-func (x *LoadCriteriaRequest) Reset() {
-	*x = LoadCriteriaRequest{}
+func (x *GradebookFile) Reset() {
+	*x = GradebookFile{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[47]
+		mi := &file_ag_ag_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LoadCriteriaRequest) String() string {
+func (x *GradebookFile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoadCriteriaRequest) ProtoMessage() {}
+func (*GradebookFile) ProtoMessage() {}
 
-func (x *LoadCriteriaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[47]
+func (x *GradebookFile) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3645,23 +6460,30 @@ func (x *LoadCriteriaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoadCriteriaRequest.ProtoReflect.Descriptor instead.
-func (*LoadCriteriaRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use GradebookFile.ProtoReflect.Descriptor instead.
+func (*GradebookFile) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *LoadCriteriaRequest) GetCourseID() uint64 {
+func (x *GradebookFile) GetName() string {
 	if x != nil {
-		return x.CourseID
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *LoadCriteriaRequest) GetAssignmentID() uint64 {
+func (x *GradebookFile) GetContentType() string {
 	if x != nil {
-		return x.AssignmentID
+		return x.ContentType
 	}
-	return 0
+	return ""
+}
+
+func (x *GradebookFile) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
 }
 
 // Void contains no fields. A server response with a Void still contains a gRPC status code,
@@ -3676,7 +6498,7 @@ type Void struct {
 func (x *Void) Reset() {
 	*x = Void{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[48]
+		mi := &file_ag_ag_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3689,7 +6511,7 @@ func (x *Void) String() string {
 func (*Void) ProtoMessage() {}
 
 func (x *Void) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[48]
+	mi := &file_ag_ag_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3702,7 +6524,7 @@ func (x *Void) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Void.ProtoReflect.Descriptor instead.
 func (*Void) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{48}
+	return file_ag_ag_proto_rawDescGZIP(), []int{80}
 }
 
 var File_ag_ag_proto protoreflect.FileDescriptor
@@ -3774,7 +6596,7 @@ var file_ag_ag_proto_rawDesc = []byte{
 	0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x01, 0x22, 0x2b, 0x0a, 0x06, 0x47, 0x72, 0x6f,
 	0x75, 0x70, 0x73, 0x12, 0x21, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20,
 	0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0xea, 0x03, 0x0a, 0x06, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0xc6, 0x05, 0x0a, 0x06, 0x43, 0x6f, 0x75, 0x72, 0x73,
 	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49,
 	0x44, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74,
 	0x6f, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x63, 0x6f, 0x75, 0x72,
@@ -3796,549 +6618,1075 @@ var file_ag_ag_proto_rawDesc = []byte{
 	0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72,
 	0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
 	0x75, 0x73, 0x42, 0x0e, 0xca, 0xb5, 0x03, 0x0a, 0xa2, 0x01, 0x07, 0x73, 0x71, 0x6c, 0x3a, 0x22,
-	0x2d, 0x22, 0x52, 0x08, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x0b,
-	0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28,
+	0x2d, 0x22, 0x52, 0x08, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b,
+	0x67, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x35,
+	0x0a, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x4c,
+	0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x65, 0x6e,
+	0x61, 0x6c, 0x74, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6c, 0x61, 0x74, 0x65,
+	0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61,
+	0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x06, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x67,
+	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x5f,
+	0x0a, 0x0a, 0x4c, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x0b, 0x0a, 0x07,
+	0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x4c, 0x49,
+	0x50, 0x5f, 0x44, 0x41, 0x59, 0x53, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x49, 0x4e, 0x45,
+	0x41, 0x52, 0x5f, 0x50, 0x45, 0x4e, 0x41, 0x4c, 0x54, 0x59, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c,
+	0x53, 0x54, 0x45, 0x50, 0x5f, 0x50, 0x45, 0x4e, 0x41, 0x4c, 0x54, 0x59, 0x10, 0x03, 0x12, 0x0f,
+	0x0a, 0x0b, 0x48, 0x41, 0x52, 0x44, 0x5f, 0x43, 0x55, 0x54, 0x4f, 0x46, 0x46, 0x10, 0x04, 0x22,
+	0x2f, 0x0a, 0x07, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x07, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x67,
+	0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
+	0x22, 0xe8, 0x03, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12,
+	0x52, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2a, 0xca, 0xb5, 0x03, 0x26, 0xa2, 0x01, 0x23,
+	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x3a, 0x75, 0x69, 0x64, 0x5f, 0x67, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x67, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x22, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x79, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x12, 0x42, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2a, 0xca, 0xb5, 0x03, 0x26, 0xa2, 0x01, 0x23,
+	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x3a, 0x75, 0x69, 0x64, 0x5f, 0x67, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x67, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x22, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x44, 0x0a, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2a, 0xca, 0xb5,
+	0x03, 0x26, 0xa2, 0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x75, 0x69, 0x64, 0x5f, 0x67, 0x69, 0x64, 0x5f, 0x6f,
+	0x72, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x44, 0x12, 0x18, 0x0a, 0x07, 0x48, 0x54, 0x4d, 0x4c, 0x55, 0x52, 0x4c, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x48, 0x54, 0x4d, 0x4c, 0x55, 0x52, 0x4c, 0x12, 0x5b, 0x0a, 0x08, 0x72,
+	0x65, 0x70, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e,
+	0x61, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x54, 0x79,
+	0x70, 0x65, 0x42, 0x2a, 0xca, 0xb5, 0x03, 0x26, 0xa2, 0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d, 0x3a,
+	0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x75, 0x69, 0x64,
+	0x5f, 0x67, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52, 0x08,
+	0x72, 0x65, 0x70, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x22, 0x51, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f,
+	0x55, 0x52, 0x53, 0x45, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x53,
+	0x53, 0x49, 0x47, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x53, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x54,
+	0x45, 0x53, 0x54, 0x53, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53, 0x45, 0x52, 0x10, 0x04,
+	0x12, 0x09, 0x0a, 0x05, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x05, 0x22, 0x88, 0x06, 0x0a, 0x0a,
+	0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x4b, 0x0a, 0x08, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2f, 0xca, 0xb5,
+	0x03, 0x2b, 0xa2, 0x01, 0x28, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x5f, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x52, 0x08, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x47, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2f, 0xca, 0xb5, 0x03, 0x2b, 0xa2, 0x01, 0x28,
+	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x65, 0x6e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x10, 0x68, 0x61,
+	0x73, 0x54, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x68, 0x61, 0x73, 0x54, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72,
+	0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x52, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x2e, 0x45,
+	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x61, 0x67,
+	0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x3c, 0x0a, 0x11, 0x73, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x52, 0x65, 0x6d, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0e, 0xca, 0xb5, 0x03, 0x0a,
+	0xa2, 0x01, 0x07, 0x73, 0x71, 0x6c, 0x3a, 0x22, 0x2d, 0x22, 0x52, 0x11, 0x73, 0x6c, 0x69, 0x70,
+	0x44, 0x61, 0x79, 0x73, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x0a,
+	0x10, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x44, 0x61, 0x74,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x44, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12,
+	0x34, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x18,
+	0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x64, 0x53,
+	0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69,
+	0x70, 0x44, 0x61, 0x79, 0x73, 0x22, 0x3d, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a,
+	0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54,
+	0x55, 0x44, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x45, 0x41, 0x43, 0x48,
+	0x45, 0x52, 0x10, 0x03, 0x22, 0x40, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x4e, 0x53, 0x45, 0x54, 0x10, 0x00, 0x12,
+	0x0a, 0x0a, 0x06, 0x48, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x56,
+	0x49, 0x53, 0x49, 0x42, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x41, 0x56, 0x4f,
+	0x52, 0x49, 0x54, 0x45, 0x10, 0x03, 0x22, 0x8a, 0x01, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x64, 0x53,
+	0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x6e, 0x72, 0x6f, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x65,
+	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12,
+	0x22, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44,
+	0x61, 0x79, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70,
+	0x44, 0x61, 0x79, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x28,
+	0x0a, 0x0f, 0x6f, 0x6c, 0x64, 0x55, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6f, 0x6c, 0x64, 0x55, 0x73, 0x65, 0x64,
+	0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6e, 0x65, 0x77, 0x55,
+	0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0f, 0x6e, 0x65, 0x77, 0x55, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61,
+	0x79, 0x73, 0x22, 0x92, 0x02, 0x0a, 0x0e, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x65, 0x6e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x6c, 0x64, 0x52, 0x65,
+	0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6f,
+	0x6c, 0x64, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x0a, 0x0c, 0x6e,
+	0x65, 0x77, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12,
+	0x38, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x64, 0x53, 0x6c,
+	0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0b, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x6b, 0x65, 0x70,
+	0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x04, 0x52, 0x11, 0x6b, 0x65, 0x70, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x73, 0x22, 0xa3, 0x01, 0x0a, 0x15, 0x53, 0x6c, 0x69, 0x70,
+	0x44, 0x61, 0x79, 0x73, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x6c, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x6c, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44,
+	0x61, 0x79, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61,
+	0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x53, 0x6c, 0x69,
+	0x70, 0x44, 0x61, 0x79, 0x73, 0x12, 0x2c, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x6c, 0x69, 0x70,
+	0x44, 0x61, 0x79, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x22, 0x3f, 0x0a,
+	0x0b, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x0b,
+	0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
 	0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x30,
-	0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0d, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x12, 0x21, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x73, 0x22, 0x2f, 0x0a, 0x07, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x24,
-	0x0a, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x73, 0x22, 0xe8, 0x03, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x02, 0x49, 0x44, 0x12, 0x52, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2a, 0xca, 0xb5, 0x03,
-	0x26, 0xa2, 0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
-	0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x75, 0x69, 0x64, 0x5f, 0x67, 0x69, 0x64, 0x5f, 0x6f, 0x72,
-	0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x72,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x12, 0x42, 0x0a, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2a, 0xca, 0xb5, 0x03,
-	0x26, 0xa2, 0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
-	0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x75, 0x69, 0x64, 0x5f, 0x67, 0x69, 0x64, 0x5f, 0x6f, 0x72,
-	0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
-	0x44, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x2a, 0xca, 0xb5, 0x03, 0x26, 0xa2, 0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75,
-	0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x75, 0x69, 0x64, 0x5f, 0x67,
-	0x69, 0x64, 0x5f, 0x6f, 0x72, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52, 0x07, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x48, 0x54, 0x4d, 0x4c, 0x55, 0x52, 0x4c,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x48, 0x54, 0x4d, 0x4c, 0x55, 0x52, 0x4c, 0x12,
-	0x5b, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
-	0x79, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x42, 0x2a, 0xca, 0xb5, 0x03, 0x26, 0xa2, 0x01, 0x23, 0x67,
-	0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x3a, 0x75, 0x69, 0x64, 0x5f, 0x67, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x67, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x22, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x22, 0x51, 0x0a, 0x04,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0e,
-	0x0a, 0x0a, 0x43, 0x4f, 0x55, 0x52, 0x53, 0x45, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x01, 0x12, 0x0f,
-	0x0a, 0x0b, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x53, 0x10, 0x02, 0x12,
-	0x09, 0x0a, 0x05, 0x54, 0x45, 0x53, 0x54, 0x53, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53,
-	0x45, 0x52, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x05, 0x22,
-	0x88, 0x06, 0x0a, 0x0a, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x4b,
-	0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x2f, 0xca, 0xb5, 0x03, 0x2b, 0xa2, 0x01, 0x28, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75,
+	0x74, 0x52, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x70,
+	0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x6b,
+	0x12, 0x2e, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x2e, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x76, 0x0a, 0x0e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69,
+	0x6e, 0x6b, 0x12, 0x2e, 0x0a, 0x0a, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x34, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x0b, 0x73, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x61, 0x0a, 0x11, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x0a,
+	0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
+	0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x12, 0x28, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x22, 0xc7, 0x08, 0x0a, 0x0a,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65,
+	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65,
+	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x75, 0x74,
+	0x6f, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1e,
+	0x0a, 0x0a, 0x69, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x61, 0x62, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x61, 0x62, 0x12, 0x1e,
+	0x0a, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x6b, 0x69, 0x70, 0x54, 0x65, 0x73, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x73, 0x6b, 0x69, 0x70, 0x54, 0x65, 0x73, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x73, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x42, 0x0a, 0x11,
+	0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
+	0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x11, 0x67,
+	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73,
+	0x12, 0x2a, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x35, 0x0a, 0x0a,
+	0x6c, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x4c, 0x61, 0x74,
+	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x65, 0x6e, 0x61, 0x6c,
+	0x74, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x65,
+	0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x2a, 0x0a, 0x10, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x41,
+	0x74, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x10, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x41, 0x74, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x44,
+	0x61, 0x74, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x68, 0x69, 0x64, 0x64, 0x65,
+	0x6e, 0x54, 0x65, 0x73, 0x74, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x69,
+	0x64, 0x64, 0x65, 0x6e, 0x54, 0x65, 0x73, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x68, 0x69, 0x64,
+	0x64, 0x65, 0x6e, 0x41, 0x74, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x16, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x10, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x41, 0x74, 0x44, 0x65, 0x61,
+	0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x50,
+	0x65, 0x72, 0x48, 0x6f, 0x75, 0x72, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x73, 0x50, 0x65, 0x72, 0x48, 0x6f, 0x75, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x18, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x19, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x70, 0x75, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x1a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x63, 0x70, 0x75, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x69, 0x64, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x1b, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x09, 0x70, 0x69, 0x64, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x64, 0x69, 0x73, 0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x1d,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x44, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c,
+	0x79, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x65, 0x61,
+	0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x3f, 0x0a, 0x0b, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xbd, 0x02, 0x0a, 0x11, 0x44, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x52, 0x0a, 0x0c,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x2e, 0xca, 0xb5, 0x03, 0x2a, 0xa2, 0x01, 0x27, 0x67, 0x6f, 0x72, 0x6d, 0x3a,
+	0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78,
+	0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44,
+	0x12, 0x46, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x2e, 0xca, 0xb5, 0x03, 0x2a, 0xa2, 0x01, 0x27, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75,
 	0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75,
-	0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
-	0x22, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x47, 0x0a, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2f, 0xca, 0xb5, 0x03,
-	0x2b, 0xa2, 0x01, 0x28, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x48, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2e, 0xca, 0xb5, 0x03, 0x2a, 0xa2,
+	0x01, 0x27, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x4b, 0x0a, 0x12, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x35, 0x0a, 0x0a,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x9d, 0x03, 0x0a, 0x10, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x51, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d,
+	0xca, 0xb5, 0x03, 0x29, 0xa2, 0x01, 0x26, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x52, 0x0c, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x45, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xca, 0xb5, 0x03,
+	0x29, 0xa2, 0x01, 0x26, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
 	0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
-	0x5f, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x2a,
-	0x0a, 0x10, 0x68, 0x61, 0x73, 0x54, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x70,
-	0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x68, 0x61, 0x73, 0x54, 0x65, 0x61,
-	0x63, 0x68, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x73,
-	0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x05,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x67,
-	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x31, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
-	0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x31, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x1b, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x11, 0x73, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x52,
-	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0e,
-	0xca, 0xb5, 0x03, 0x0a, 0xa2, 0x01, 0x07, 0x73, 0x71, 0x6c, 0x3a, 0x22, 0x2d, 0x22, 0x52, 0x11,
-	0x73, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
-	0x67, 0x12, 0x2a, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
-	0x79, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6c, 0x61, 0x73,
-	0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x44, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a,
-	0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x0d,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x70, 0x70, 0x72, 0x6f,
-	0x76, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44,
-	0x61, 0x79, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x55,
-	0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x52, 0x0c, 0x75, 0x73, 0x65,
-	0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x22, 0x3d, 0x0a, 0x0a, 0x55, 0x73, 0x65,
-	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10,
-	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0b,
-	0x0a, 0x07, 0x53, 0x54, 0x55, 0x44, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x54,
-	0x45, 0x41, 0x43, 0x48, 0x45, 0x52, 0x10, 0x03, 0x22, 0x40, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x70,
-	0x6c, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x4e, 0x53, 0x45,
-	0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x48, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x01, 0x12,
-	0x0b, 0x0a, 0x07, 0x56, 0x49, 0x53, 0x49, 0x42, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08,
-	0x46, 0x41, 0x56, 0x4f, 0x52, 0x49, 0x54, 0x45, 0x10, 0x03, 0x22, 0x8a, 0x01, 0x0a, 0x0c, 0x55,
-	0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x65,
-	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0c, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12,
+	0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x47, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x2d, 0xca, 0xb5, 0x03, 0x29, 0xa2, 0x01, 0x26, 0x67, 0x6f, 0x72, 0x6d,
+	0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64,
+	0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x22, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
+	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x72, 0x6f,
+	0x7a, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x4a, 0x6f, 0x62, 0x49,
+	0x44, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x4a, 0x6f,
+	0x62, 0x49, 0x44, 0x22, 0xf0, 0x05, 0x0a, 0x0a, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18,
+	0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61,
+	0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x24, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x07, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x41,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x49, 0x44, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x49, 0x44, 0x12,
+	0x6f, 0x0a, 0x0b, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x0e,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x42, 0x3d, 0xca, 0xb5, 0x03, 0x39, 0xa2, 0x01, 0x36, 0x67, 0x6f, 0x72,
+	0x6d, 0x3a, 0x22, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79, 0x3a, 0x41, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x49, 0x44, 0x3b, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x3a, 0x47, 0x72, 0x61, 0x64, 0x65, 0x64, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x49, 0x44, 0x22, 0x52, 0x0b, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x61, 0x77, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x72, 0x61, 0x77, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x2e,
+	0x0a, 0x12, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x68, 0x69, 0x64, 0x64,
+	0x65, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x28,
+	0x0a, 0x0f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66,
+	0x6f, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x3c, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08,
+	0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45,
+	0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x56, 0x49,
+	0x53, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x22, 0x3f, 0x0a, 0x0b, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xe4, 0x03, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x37, 0x0a,
+	0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x13, 0xca, 0xb5, 0x03, 0x0f, 0xa2, 0x01, 0x0c, 0x67, 0x6f, 0x72, 0x6d,
+	0x3a, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x22, 0x0a, 0x0c,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x54, 0x0a, 0x0b, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x22, 0xca, 0xb5, 0x03, 0x1e, 0xa2, 0x01, 0x1b, 0x67, 0x6f,
+	0x72, 0x6d, 0x3a, 0x22, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79, 0x3a, 0x41,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x49, 0x44, 0x22, 0x52, 0x0b, 0x74, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x61, 0x77, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x61, 0x77, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x12, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x68,
+	0x69, 0x64, 0x64, 0x65, 0x6e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x47,
+	0x0a, 0x12, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x08, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x22, 0xec, 0x02, 0x0a, 0x0a, 0x54, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x31, 0x0a, 0x09, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x13, 0xca, 0xb5, 0x03, 0x0f, 0xa2,
+	0x01, 0x0c, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x52, 0x09,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x37, 0x0a,
+	0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x13, 0xca, 0xb5, 0x03, 0x0f, 0xa2, 0x01, 0x0c, 0x67, 0x6f, 0x72, 0x6d,
+	0x3a, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x73, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x73, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x22, 0xae, 0x01, 0x0a, 0x12, 0x54, 0x65, 0x73, 0x74, 0x43,
+	0x61, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x74, 0x65, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x65, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6e,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x75, 0x6e, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x76, 0x65,
+	0x72, 0x61, 0x67, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0c, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x28, 0x0a,
+	0x0f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3e, 0x0a, 0x0e, 0x54, 0x65, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x74, 0x65, 0x73,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x67, 0x2e, 0x54, 0x65,
+	0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x52, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73, 0x22, 0x52, 0x0a, 0x0c, 0x50, 0x75, 0x73, 0x68, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x61, 0x79, 0x73, 0x54,
+	0x6f, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0e, 0x64, 0x61, 0x79, 0x73, 0x54, 0x6f, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x22, 0xec, 0x02, 0x0a, 0x14,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0c, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2c,
+	0x0a, 0x11, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x11, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x14,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x12, 0x22, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70,
+	0x44, 0x61, 0x79, 0x73, 0x12, 0x34, 0x0a, 0x0c, 0x70, 0x75, 0x73, 0x68, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x2e,
+	0x50, 0x75, 0x73, 0x68, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x0c, 0x70, 0x75,
+	0x73, 0x68, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x22, 0x92, 0x01, 0x0a, 0x10, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22,
+	0xf4, 0x03, 0x0a, 0x08, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4a, 0x6f, 0x62, 0x12, 0x0e, 0x0a, 0x02,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c,
+	0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x44,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x6a, 0x6f, 0x62, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6a, 0x6f, 0x62, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x4a, 0x6f, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x44,
+	0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
+	0x44, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64,
+	0x65, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22,
+	0x45, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0a, 0x0a, 0x06, 0x51, 0x55, 0x45,
+	0x55, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47,
+	0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06,
+	0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x41, 0x4e, 0x43,
+	0x45, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x22, 0x2d, 0x0a, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4a,
+	0x6f, 0x62, 0x73, 0x12, 0x20, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x67, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4a, 0x6f, 0x62, 0x52,
+	0x04, 0x6a, 0x6f, 0x62, 0x73, 0x22, 0xe1, 0x02, 0x0a, 0x0a, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x51,
+	0x75, 0x6f, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x49, 0x44, 0x12, 0x4e, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2a, 0xca, 0xb5, 0x03, 0x26,
+	0xa2, 0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f,
+	0x71, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x44, 0x12, 0x4e, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x79, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2a, 0xca, 0xb5, 0x03, 0x26,
+	0xa2, 0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f,
+	0x71, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x79, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x65, 0x74, 0x44, 0x61, 0x74,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x73, 0x65, 0x74, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x0f, 0xca, 0xb5, 0x03, 0x0b, 0xa2, 0x01, 0x08, 0x67, 0x6f, 0x72, 0x6d, 0x3a,
+	0x22, 0x2d, 0x22, 0x52, 0x06, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x09, 0x72,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0f,
+	0xca, 0xb5, 0x03, 0x0b, 0xa2, 0x01, 0x08, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x2d, 0x22, 0x52,
+	0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x2d, 0x0a, 0x09, 0x6e, 0x65,
+	0x78, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xca,
+	0xb5, 0x03, 0x0b, 0xa2, 0x01, 0x08, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x2d, 0x22, 0x52, 0x09,
+	0x6e, 0x65, 0x78, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x22, 0x35, 0x0a, 0x0b, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x71, 0x75, 0x6f, 0x74,
+	0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x06, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x73,
+	0x22, 0xca, 0x02, 0x0a, 0x0e, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x50,
+	0x61, 0x69, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x41, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x41, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x42, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x42, 0x12, 0x1e, 0x0a, 0x0a, 0x73,
+	0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x4e, 0x0a,
+	0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x61, 0x67, 0x2e, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x42, 0x1f, 0xca, 0xb5, 0x03, 0x1b, 0xa2, 0x01, 0x18, 0x67, 0x6f, 0x72, 0x6d,
+	0x3a, 0x22, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79, 0x3a, 0x50, 0x61, 0x69,
+	0x72, 0x49, 0x44, 0x22, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x22, 0x3b, 0x0a,
+	0x0f, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x50, 0x61, 0x69, 0x72, 0x73,
+	0x12, 0x28, 0x0a, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x50,
+	0x61, 0x69, 0x72, 0x52, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x22, 0xdd, 0x01, 0x0a, 0x0f, 0x53,
+	0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x0e,
+	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x61, 0x69, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x70, 0x61, 0x69, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x12, 0x1e, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x41, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x41, 0x12, 0x1a, 0x0a, 0x08,
+	0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x65, 0x41, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x65, 0x41, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65,
+	0x42, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x12, 0x1e,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x42, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x42, 0x12, 0x1a,
+	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x65, 0x42, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x65, 0x42, 0x22, 0xd2, 0x01, 0x0a, 0x10, 0x47,
+	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12,
 	0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44,
-	0x61, 0x79, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x53,
-	0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x22, 0x3f, 0x0a, 0x0b, 0x45, 0x6e, 0x72, 0x6f, 0x6c,
-	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67,
-	0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x72,
-	0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x70, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x2e, 0x0a, 0x0a, 0x61, 0x73,
-	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
-	0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a,
-	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x0a, 0x73, 0x75,
-	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
-	0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
-	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x76, 0x0a, 0x0e, 0x45, 0x6e,
-	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x2e, 0x0a, 0x0a,
-	0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x0a, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x0b,
-	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x22, 0x61, 0x0a, 0x11, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x75, 0x62, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x6c,
-	0x69, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x67, 0x2e,
-	0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x05,
-	0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x22, 0xde, 0x03, 0x0a, 0x0a, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x46, 0x69,
-	0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x46, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
-	0x12, 0x20, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x41, 0x70, 0x70, 0x72, 0x6f,
-	0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x4c, 0x61, 0x62, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x61, 0x62, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x6f, 0x72,
-	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x63,
-	0x6f, 0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x6b, 0x69, 0x70, 0x54, 0x65,
-	0x73, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x6b, 0x69, 0x70, 0x54,
-	0x65, 0x73, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x53,
-	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x42, 0x0a, 0x11, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e,
-	0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x56, 0x0a, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65,
+	0x72, 0x69, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47,
+	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x42,
+	0x24, 0xca, 0xb5, 0x03, 0x20, 0xa2, 0x01, 0x1d, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x66, 0x6f,
+	0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79, 0x3a, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
+	0x72, 0x6b, 0x49, 0x44, 0x22, 0x52, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x22,
+	0x42, 0x0a, 0x0a, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x34, 0x0a,
+	0x0a, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
 	0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65,
-	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x11, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67,
-	0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0e,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x54,
-	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x3f, 0x0a, 0x0b, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e,
-	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69,
-	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xbd, 0x03, 0x0a, 0x0a, 0x53, 0x75, 0x62, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
-	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73,
-	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x63, 0x6f,
-	0x72, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49,
-	0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61,
-	0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x48, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64,
-	0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x0a, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
+	0x72, 0x6b, 0x73, 0x22, 0xf5, 0x01, 0x0a, 0x10, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x44, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
+	0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52,
+	0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x22, 0x29, 0x0a, 0x05, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e,
+	0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x0a, 0x0a, 0x06, 0x50, 0x41, 0x53, 0x53, 0x45, 0x44, 0x10, 0x02, 0x22, 0xa7, 0x02, 0x0a, 0x06,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72,
+	0x65, 0x61, 0x64, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x51, 0x0a, 0x0a, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x42, 0x1b, 0xca, 0xb5, 0x03, 0x17, 0xa2, 0x01, 0x14, 0x67, 0x6f, 0x72,
+	0x6d, 0x3a, 0x22, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79, 0x3a, 0x49, 0x44,
+	0x22, 0x52, 0x0a, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65,
+	0x64, 0x69, 0x74, 0x65, 0x64, 0x22, 0x33, 0x0a, 0x09, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65,
+	0x72, 0x73, 0x12, 0x26, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x22, 0x4f, 0x0a, 0x0d, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x52, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x6b, 0x0a, 0x18, 0x44,
+	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x12, 0x33, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x44, 0x65, 0x61, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x87, 0x01, 0x0a, 0x0f, 0x53, 0x6c, 0x69,
+	0x70, 0x44, 0x61, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x73, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x70, 0x70,
+	0x6c, 0x79, 0x22, 0x2b, 0x0a, 0x0d, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22,
+	0x25, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x2b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x44, 0x22, 0x5c, 0x0a, 0x0c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x44, 0x22, 0x26, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0x26, 0x0a, 0x0a, 0x4f, 0x72, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x6c, 0x0a, 0x0c, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x20, 0x0a,
+	0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x22,
+	0x47, 0x0a, 0x0d, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x36, 0x0a, 0x0d, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x4f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xba, 0x01, 0x0a, 0x11, 0x45, 0x6e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x12, 0x69, 0x67,
+	0x6e, 0x6f, 0x72, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x69,
+	0x74, 0x68, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x35,
+	0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x19, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x65, 0x73, 0x22, 0x68, 0x0a, 0x17, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x2e,
+	0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x22,
+	0x61, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x49, 0x44, 0x22, 0xba, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22,
+	0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64,
+	0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
 	0x32, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x22, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x44,
-	0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x0c,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x52, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x22, 0x3c, 0x0a, 0x06, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a,
-	0x08, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x52,
-	0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x56,
-	0x49, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x22, 0x3f, 0x0a, 0x0b, 0x53, 0x75, 0x62, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67,
-	0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xd2, 0x01, 0x0a, 0x10, 0x47, 0x72, 0x61,
-	0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x0e, 0x0a,
-	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x22, 0x0a,
-	0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49,
-	0x44, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x56, 0x0a, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69,
-	0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61,
-	0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x42, 0x24, 0xca,
-	0xb5, 0x03, 0x20, 0xa2, 0x01, 0x1d, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x66, 0x6f, 0x72, 0x65,
-	0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79, 0x3a, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
-	0x49, 0x44, 0x22, 0x52, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x22, 0x42, 0x0a,
-	0x0a, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x62,
-	0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63,
-	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x0a, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
-	0x73, 0x22, 0xf5, 0x01, 0x0a, 0x10, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69,
-	0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x20,
-	0x0a, 0x0b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x44, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x44,
-	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72,
-	0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x05, 0x67,
-	0x72, 0x61, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x29,
-	0x0a, 0x05, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10,
-	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a,
-	0x06, 0x50, 0x41, 0x53, 0x53, 0x45, 0x44, 0x10, 0x02, 0x22, 0xa7, 0x02, 0x0a, 0x06, 0x52, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x02, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05,
-	0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x61,
-	0x64, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x51, 0x0a, 0x0a, 0x62, 0x65, 0x6e, 0x63,
-	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61,
-	0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
-	0x72, 0x6b, 0x42, 0x1b, 0xca, 0xb5, 0x03, 0x17, 0xa2, 0x01, 0x14, 0x67, 0x6f, 0x72, 0x6d, 0x3a,
-	0x22, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79, 0x3a, 0x49, 0x44, 0x22, 0x52,
-	0x0a, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65,
-	0x64, 0x69, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x64, 0x69,
-	0x74, 0x65, 0x64, 0x22, 0x33, 0x0a, 0x09, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73,
-	0x12, 0x26, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x09, 0x72,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x22, 0x4f, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65,
-	0x77, 0x52, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x2b, 0x0a, 0x0d, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22, 0x25, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0xae, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x22, 0x78, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x49, 0x44, 0x22, 0x5c, 0x0a, 0x1a, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22, 0x29, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x22, 0x5b, 0x0a, 0x0a, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x31, 0x0a,
+	0x09, 0x72, 0x65, 0x70, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
+	0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x22, 0x61, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x2b, 0x0a,
-	0x0f, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x22, 0x5c, 0x0a, 0x0c, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
-	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
-	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22, 0x26, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x22, 0x26, 0x0a, 0x0a, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x6c, 0x0a, 0x0c, 0x4f, 0x72, 0x67, 0x61,
-	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x76,
-	0x61, 0x74, 0x61, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50,
-	0x6c, 0x61, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x22, 0x47, 0x0a, 0x0d, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69,
-	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x0d, 0x6f, 0x72, 0x67, 0x61, 0x6e,
-	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x61, 0x67, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x0d, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
-	0xba, 0x01, 0x0a, 0x11, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
-	0x44, 0x12, 0x2e, 0x0a, 0x12, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69,
-	0x67, 0x6e, 0x6f, 0x72, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x73, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65,
-	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72,
-	0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x22, 0x68, 0x0a, 0x17,
-	0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
-	0x35, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0e, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x22, 0x61, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1a, 0x0a,
-	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22, 0xba, 0x01, 0x0a, 0x17, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x62,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72,
-	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72,
-	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xae, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a,
+	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x22, 0x77, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x55, 0x52, 0x4c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x69, 0x65, 0x73, 0x2e, 0x55, 0x52, 0x4c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x55,
+	0x52, 0x4c, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x55, 0x52, 0x4c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3b, 0x0a, 0x15,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x49, 0x73, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x22, 0x32, 0x0a, 0x06, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xc5, 0x01,
+	0x0a, 0x1b, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x6b, 0x69, 0x70, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x6b, 0x69, 0x70,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x2a, 0x0a, 0x04, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x4e,
+	0x44, 0x49, 0x56, 0x49, 0x44, 0x55, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x52,
+	0x4f, 0x55, 0x50, 0x10, 0x02, 0x22, 0x58, 0x0a, 0x0e, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22,
+	0x71, 0x0a, 0x11, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x59, 0x65,
+	0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x59, 0x65, 0x61, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x22, 0x55, 0x0a, 0x13, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72,
+	0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x43, 0x0a, 0x0f, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x44, 0x22, 0x77,
+	0x0a, 0x11, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12,
 	0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x22, 0x5c, 0x0a, 0x1a, 0x53, 0x75, 0x62, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x62,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x49, 0x44, 0x22, 0x29, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
-	0x22, 0x5b, 0x0a, 0x0a, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x31, 0x0a, 0x09, 0x72, 0x65,
-	0x70, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e,
-	0x61, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x61, 0x0a,
-	0x11, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
-	0x22, 0x77, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73,
-	0x12, 0x2e, 0x0a, 0x04, 0x55, 0x52, 0x4c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73,
-	0x2e, 0x55, 0x52, 0x4c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x55, 0x52, 0x4c, 0x73,
-	0x1a, 0x37, 0x0a, 0x09, 0x55, 0x52, 0x4c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3b, 0x0a, 0x15, 0x41, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
-	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x22, 0x32, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xc5, 0x01, 0x0a, 0x1b, 0x53,
-	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f,
+	0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x79, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x22, 0x49, 0x0a, 0x0f, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f,
 	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x24, 0x0a, 0x0d, 0x73, 0x6b, 0x69, 0x70, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66,
-	0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x6b, 0x69, 0x70, 0x42, 0x75, 0x69,
-	0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x2a, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07,
-	0x0a, 0x03, 0x41, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x4e, 0x44, 0x49, 0x56,
-	0x49, 0x44, 0x55, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x52, 0x4f, 0x55, 0x50,
-	0x10, 0x02, 0x22, 0x58, 0x0a, 0x0e, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69,
+	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x49, 0x44, 0x22, 0x5c, 0x0a, 0x0c, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x6f, 0x67, 0x4c, 0x69,
+	0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69,
 	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
-	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x71, 0x0a, 0x11,
-	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x59, 0x65, 0x61, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x59, 0x65, 0x61,
-	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x22,
-	0x55, 0x0a, 0x13, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
-	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
-	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x06, 0x0a, 0x04, 0x56, 0x6f, 0x69, 0x64, 0x32, 0xf4,
-	0x11, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12,
-	0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x22, 0x00, 0x12, 0x21, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x73, 0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x09, 0x2e, 0x61, 0x67,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x15, 0x2e, 0x61, 0x67,
-	0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x22, 0x00, 0x12, 0x22,
-	0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x08, 0x2e, 0x61,
-	0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64,
-	0x22, 0x00, 0x12, 0x3c, 0x0a, 0x13, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
-	0x65, 0x64, 0x54, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56,
-	0x6f, 0x69, 0x64, 0x1a, 0x19, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x2c, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x13, 0x2e, 0x61,
-	0x67, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x00, 0x12, 0x38,
-	0x0a, 0x17, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72,
-	0x41, 0x6e, 0x64, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x61, 0x67,
-	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x11, 0x2e,
-	0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x00, 0x12, 0x25,
-	0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x09, 0x2e,
-	0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x1a, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x22, 0x00, 0x12, 0x24, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x12, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x1a,
-	0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x0b, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x10, 0x2e, 0x61, 0x67, 0x2e,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61,
-	0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x2c, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65,
+	0x22, 0x79, 0x0a, 0x15, 0x54, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x6c, 0x6c,
+	0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x61, 0x6c, 0x6c, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x22, 0x53, 0x0a, 0x11, 0x53,
+	0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44,
+	0x22, 0xaa, 0x02, 0x0a, 0x10, 0x47, 0x72, 0x61, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x44, 0x12, 0x33, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64,
+	0x12, 0x30, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x67, 0x6e, 0x6f, 0x72,
+	0x65, 0x64, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x73, 0x22, 0x33, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x53, 0x56, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x58,
+	0x4c, 0x53, 0x58, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x41, 0x4e, 0x56, 0x41, 0x53, 0x10,
+	0x02, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x4f, 0x4f, 0x44, 0x4c, 0x45, 0x10, 0x03, 0x22, 0x5f, 0x0a,
+	0x0d, 0x47, 0x72, 0x61, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x06,
+	0x0a, 0x04, 0x56, 0x6f, 0x69, 0x64, 0x32, 0x9a, 0x1b, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x6f, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x07,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69,
+	0x64, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x22, 0x00, 0x12, 0x21, 0x0a,
+	0x08, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56,
+	0x6f, 0x69, 0x64, 0x1a, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x22, 0x00,
+	0x12, 0x34, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x22, 0x00, 0x12, 0x22, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x1a, 0x08,
+	0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x13, 0x49, 0x73,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x54, 0x65, 0x61, 0x63, 0x68, 0x65,
+	0x72, 0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x19, 0x2e, 0x61, 0x67,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2c, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x12, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x00,
+	0x12, 0x34, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x42, 0x79, 0x43,
 	0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x22, 0x00, 0x12, 0x25, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x73, 0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x0b,
-	0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0x00, 0x12, 0x3e, 0x0a,
-	0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65,
-	0x72, 0x12, 0x1b, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b,
-	0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0x00, 0x12, 0x28, 0x0a,
-	0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x0a, 0x2e,
-	0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x1a, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x22, 0x00, 0x12, 0x26, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12,
-	0x34, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x56,
-	0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45,
-	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56,
-	0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69,
-	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x67, 0x2e,
-	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x32, 0x0a,
-	0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22,
-	0x00, 0x12, 0x46, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x61, 0x67, 0x2e, 0x45,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x22, 0x00, 0x12, 0x25, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x1a, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x00, 0x12, 0x24, 0x0a,
+	0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x09, 0x2e, 0x61,
+	0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69,
+	0x64, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x12, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00,
+	0x12, 0x2c, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x11, 0x2e,
+	0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x22, 0x00, 0x12, 0x25,
+	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x08, 0x2e, 0x61,
+	0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x0b, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x73, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x61, 0x67, 0x2e, 0x45,
 	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f,
-	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x16, 0x47, 0x65, 0x74,
-	0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x67, 0x2e,
-	0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x2e, 0x0a,
-	0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
-	0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x2e, 0x0a,
-	0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
-	0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x32, 0x0a,
-	0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22,
-	0x00, 0x12, 0x3a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x67, 0x2e,
-	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x52, 0x0a,
-	0x16, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42,
-	0x79, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x1f, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22,
-	0x00, 0x12, 0x3b, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3d,
-	0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
-	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x39, 0x0a,
-	0x11, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x14, 0x2e, 0x61, 0x67,
-	0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
-	0x6b, 0x1a, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65,
-	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0f, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x14, 0x2e, 0x61,
-	0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
-	0x72, 0x6b, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x33,
-	0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
-	0x6b, 0x12, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65,
-	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69,
-	0x64, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x69,
-	0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64,
-	0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x1a, 0x14, 0x2e, 0x61,
-	0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69,
-	0x6f, 0x6e, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72,
-	0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61,
-	0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x1a, 0x08, 0x2e,
-	0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0f, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x61,
-	0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69,
-	0x6f, 0x6e, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x2f,
-	0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x11,
-	0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x00, 0x12,
-	0x2d, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12,
-	0x11, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3f,
-	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x12, 0x1e,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x73, 0x22, 0x00, 0x12, 0x28, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x1a, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x26, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12,
+	0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x1a, 0x08, 0x2e, 0x61, 0x67,
+	0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x12, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
+	0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x36, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e,
+	0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x67, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12,
+	0x4f, 0x0a, 0x16, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x61, 0x67, 0x2e, 0x44,
+	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x44, 0x65, 0x61,
+	0x64, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00,
+	0x12, 0x43, 0x0a, 0x17, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x61, 0x67,
+	0x2e, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56,
+	0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75,
+	0x74, 0x65, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x12, 0x13, 0x2e, 0x61, 0x67, 0x2e,
+	0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x15,
+	0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x2e, 0x61,
+	0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x08, 0x2e, 0x61,
+	0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x2e, 0x61,
+	0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x08, 0x2e, 0x61,
+	0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x11, 0x2e,
+	0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x15,
 	0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d,
-	0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x22, 0x00, 0x12,
-	0x39, 0x0a, 0x0c, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12,
-	0x17, 0x2e, 0x61, 0x67, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69,
-	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x42, 0x65,
-	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x22, 0x00, 0x12, 0x29, 0x0a, 0x0c, 0x47, 0x65,
-	0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e,
-	0x56, 0x6f, 0x69, 0x64, 0x1a, 0x0d, 0x2e, 0x61, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x73, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x61,
-	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x4f, 0x72,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x4f, 0x72,
-	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0f,
-	0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12,
-	0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x10, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65,
-	0x73, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0b, 0x49, 0x73, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65,
-	0x70, 0x6f, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
-	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56,
-	0x6f, 0x69, 0x64, 0x22, 0x00, 0x42, 0x26, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x2f, 0x71, 0x75,
-	0x69, 0x63, 0x6b, 0x66, 0x65, 0x65, 0x64, 0x2f, 0x61, 0x67, 0xba, 0x02, 0x00, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x12, 0x1f, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x10,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1b, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e,
+	0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x11, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c,
+	0x2e, 0x61, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61,
+	0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x11, 0x52, 0x65, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x2e,
+	0x61, 0x67, 0x2e, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x61,
+	0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x67, 0x2e,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x73, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x1c, 0x2e, 0x61,
+	0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x67, 0x2e,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x64, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x1c, 0x2e, 0x61, 0x67, 0x2e,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x10, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x11,
+	0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0x00, 0x30, 0x01, 0x12, 0x44, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x65, 0x73, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x19, 0x2e, 0x61, 0x67, 0x2e,
+	0x54, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0x00, 0x12, 0x3c, 0x0a,
+	0x0f, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b,
+	0x12, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x0c, 0x47,
+	0x65, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x11, 0x2e, 0x61, 0x67,
+	0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d,
+	0x2e, 0x61, 0x67, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4a, 0x6f, 0x62, 0x73, 0x22, 0x00, 0x12,
+	0x31, 0x0a, 0x0e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4a, 0x6f,
+	0x62, 0x12, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64,
+	0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x6f, 0x67, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x12, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4c,
+	0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x3a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x51, 0x75, 0x6f, 0x74, 0x61,
+	0x73, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x51, 0x75, 0x6f, 0x74,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0f, 0x52,
+	0x65, 0x73, 0x65, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x15,
+	0x2e, 0x61, 0x67, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22,
+	0x00, 0x12, 0x3f, 0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61,
+	0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x61, 0x67,
+	0x2e, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x50, 0x61, 0x69, 0x72, 0x73,
+	0x22, 0x00, 0x12, 0x42, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72,
+	0x69, 0x74, 0x79, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x69,
+	0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x50,
+	0x61, 0x69, 0x72, 0x73, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47,
+	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x1a,
+	0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x14, 0x2e, 0x61, 0x67, 0x2e,
+	0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
+	0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0f,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12,
+	0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22,
+	0x00, 0x12, 0x3f, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x69, 0x74, 0x65,
+	0x72, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x1a, 0x14, 0x2e, 0x61, 0x67, 0x2e,
+	0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e,
+	0x22, 0x00, 0x12, 0x33, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x69, 0x74,
+	0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x1a, 0x08, 0x2e, 0x61, 0x67,
+	0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x61, 0x67, 0x2e,
+	0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e,
+	0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0c,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x11, 0x2e, 0x61,
+	0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x00, 0x12, 0x2d, 0x0a,
+	0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x11, 0x2e,
+	0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0c,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x2e, 0x61,
+	0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x61,
+	0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x22, 0x00, 0x12, 0x39, 0x0a,
+	0x0c, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x17, 0x2e,
+	0x61, 0x67, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x42, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x22, 0x00, 0x12, 0x29, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f,
+	0x69, 0x64, 0x1a, 0x0d, 0x2e, 0x61, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x4f, 0x72, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x4f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x0e, 0x2e,
+	0x61, 0x67, 0x2e, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
+	0x61, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22,
+	0x00, 0x12, 0x30, 0x0a, 0x0b, 0x49, 0x73, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6f,
+	0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69,
+	0x64, 0x22, 0x00, 0x42, 0x26, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x2f, 0x71, 0x75, 0x69, 0x63,
+	0x6b, 0x66, 0x65, 0x65, 0x64, 0x2f, 0x61, 0x67, 0xba, 0x02, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4353,204 +7701,293 @@ func file_ag_ag_proto_rawDescGZIP() []byte {
 	return file_ag_ag_proto_rawDescData
 }
 
-var file_ag_ag_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_ag_ag_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
+var file_ag_ag_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_ag_ag_proto_msgTypes = make([]protoimpl.MessageInfo, 82)
 var file_ag_ag_proto_goTypes = []interface{}{
 	(Group_GroupStatus)(0),                // 0: ag.Group.GroupStatus
-	(Repository_Type)(0),                  // 1: ag.Repository.Type
-	(Enrollment_UserStatus)(0),            // 2: ag.Enrollment.UserStatus
-	(Enrollment_DisplayState)(0),          // 3: ag.Enrollment.DisplayState
-	(Submission_Status)(0),                // 4: ag.Submission.Status
-	(GradingCriterion_Grade)(0),           // 5: ag.GradingCriterion.Grade
-	(SubmissionsForCourseRequest_Type)(0), // 6: ag.SubmissionsForCourseRequest.Type
-	(*User)(nil),                          // 7: ag.User
-	(*Users)(nil),                         // 8: ag.Users
-	(*RemoteIdentity)(nil),                // 9: ag.RemoteIdentity
-	(*Group)(nil),                         // 10: ag.Group
-	(*Groups)(nil),                        // 11: ag.Groups
-	(*Course)(nil),                        // 12: ag.Course
-	(*Courses)(nil),                       // 13: ag.Courses
-	(*Repository)(nil),                    // 14: ag.Repository
-	(*Enrollment)(nil),                    // 15: ag.Enrollment
-	(*UsedSlipDays)(nil),                  // 16: ag.UsedSlipDays
-	(*Enrollments)(nil),                   // 17: ag.Enrollments
-	(*SubmissionLink)(nil),                // 18: ag.SubmissionLink
-	(*EnrollmentLink)(nil),                // 19: ag.EnrollmentLink
-	(*CourseSubmissions)(nil),             // 20: ag.CourseSubmissions
-	(*Assignment)(nil),                    // 21: ag.Assignment
-	(*Assignments)(nil),                   // 22: ag.Assignments
-	(*Submission)(nil),                    // 23: ag.Submission
-	(*Submissions)(nil),                   // 24: ag.Submissions
-	(*GradingBenchmark)(nil),              // 25: ag.GradingBenchmark
-	(*Benchmarks)(nil),                    // 26: ag.Benchmarks
-	(*GradingCriterion)(nil),              // 27: ag.GradingCriterion
-	(*Review)(nil),                        // 28: ag.Review
-	(*Reviewers)(nil),                     // 29: ag.Reviewers
-	(*ReviewRequest)(nil),                 // 30: ag.ReviewRequest
-	(*CourseRequest)(nil),                 // 31: ag.CourseRequest
-	(*UserRequest)(nil),                   // 32: ag.UserRequest
-	(*GetGroupRequest)(nil),               // 33: ag.GetGroupRequest
-	(*GroupRequest)(nil),                  // 34: ag.GroupRequest
-	(*Provider)(nil),                      // 35: ag.Provider
-	(*OrgRequest)(nil),                    // 36: ag.OrgRequest
-	(*Organization)(nil),                  // 37: ag.Organization
-	(*Organizations)(nil),                 // 38: ag.Organizations
-	(*EnrollmentRequest)(nil),             // 39: ag.EnrollmentRequest
-	(*EnrollmentStatusRequest)(nil),       // 40: ag.EnrollmentStatusRequest
-	(*SubmissionRequest)(nil),             // 41: ag.SubmissionRequest
-	(*UpdateSubmissionRequest)(nil),       // 42: ag.UpdateSubmissionRequest
-	(*UpdateSubmissionsRequest)(nil),      // 43: ag.UpdateSubmissionsRequest
-	(*SubmissionReviewersRequest)(nil),    // 44: ag.SubmissionReviewersRequest
-	(*Providers)(nil),                     // 45: ag.Providers
-	(*URLRequest)(nil),                    // 46: ag.URLRequest
-	(*RepositoryRequest)(nil),             // 47: ag.RepositoryRequest
-	(*Repositories)(nil),                  // 48: ag.Repositories
-	(*AuthorizationResponse)(nil),         // 49: ag.AuthorizationResponse
-	(*Status)(nil),                        // 50: ag.Status
-	(*SubmissionsForCourseRequest)(nil),   // 51: ag.SubmissionsForCourseRequest
-	(*RebuildRequest)(nil),                // 52: ag.RebuildRequest
-	(*CourseUserRequest)(nil),             // 53: ag.CourseUserRequest
-	(*LoadCriteriaRequest)(nil),           // 54: ag.LoadCriteriaRequest
-	(*Void)(nil),                          // 55: ag.Void
-	nil,                                   // 56: ag.Repositories.URLsEntry
+	(Course_LatePolicy)(0),                // 1: ag.Course.LatePolicy
+	(Repository_Type)(0),                  // 2: ag.Repository.Type
+	(Enrollment_UserStatus)(0),            // 3: ag.Enrollment.UserStatus
+	(Enrollment_DisplayState)(0),          // 4: ag.Enrollment.DisplayState
+	(Submission_Status)(0),                // 5: ag.Submission.Status
+	(BuildJob_Status)(0),                  // 6: ag.BuildJob.Status
+	(GradingCriterion_Grade)(0),           // 7: ag.GradingCriterion.Grade
+	(SubmissionsForCourseRequest_Type)(0), // 8: ag.SubmissionsForCourseRequest.Type
+	(GradebookRequest_Format)(0),          // 9: ag.GradebookRequest.Format
+	(*User)(nil),                          // 10: ag.User
+	(*Users)(nil),                         // 11: ag.Users
+	(*RemoteIdentity)(nil),                // 12: ag.RemoteIdentity
+	(*Group)(nil),                         // 13: ag.Group
+	(*Groups)(nil),                        // 14: ag.Groups
+	(*Course)(nil),                        // 15: ag.Course
+	(*Courses)(nil),                       // 16: ag.Courses
+	(*Repository)(nil),                    // 17: ag.Repository
+	(*Enrollment)(nil),                    // 18: ag.Enrollment
+	(*UsedSlipDays)(nil),                  // 19: ag.UsedSlipDays
+	(*UsedSlipDaysChange)(nil),            // 20: ag.UsedSlipDaysChange
+	(*SlipDaysChange)(nil),                // 21: ag.SlipDaysChange
+	(*SlipDaysRecomputation)(nil),         // 22: ag.SlipDaysRecomputation
+	(*Enrollments)(nil),                   // 23: ag.Enrollments
+	(*SubmissionLink)(nil),                // 24: ag.SubmissionLink
+	(*EnrollmentLink)(nil),                // 25: ag.EnrollmentLink
+	(*CourseSubmissions)(nil),             // 26: ag.CourseSubmissions
+	(*Assignment)(nil),                    // 27: ag.Assignment
+	(*Assignments)(nil),                   // 28: ag.Assignments
+	(*DeadlineExtension)(nil),             // 29: ag.DeadlineExtension
+	(*DeadlineExtensions)(nil),            // 30: ag.DeadlineExtensions
+	(*DeadlineSnapshot)(nil),              // 31: ag.DeadlineSnapshot
+	(*Submission)(nil),                    // 32: ag.Submission
+	(*Submissions)(nil),                   // 33: ag.Submissions
+	(*SubmissionAttempt)(nil),             // 34: ag.SubmissionAttempt
+	(*SubmissionAttempts)(nil),            // 35: ag.SubmissionAttempts
+	(*TestResult)(nil),                    // 36: ag.TestResult
+	(*TestCaseStatistics)(nil),            // 37: ag.TestCaseStatistics
+	(*TestStatistics)(nil),                // 38: ag.TestStatistics
+	(*PushActivity)(nil),                  // 39: ag.PushActivity
+	(*AssignmentStatistics)(nil),          // 40: ag.AssignmentStatistics
+	(*CourseStatistics)(nil),              // 41: ag.CourseStatistics
+	(*BuildJob)(nil),                      // 42: ag.BuildJob
+	(*BuildJobs)(nil),                     // 43: ag.BuildJobs
+	(*BuildQuota)(nil),                    // 44: ag.BuildQuota
+	(*BuildQuotas)(nil),                   // 45: ag.BuildQuotas
+	(*SimilarityPair)(nil),                // 46: ag.SimilarityPair
+	(*SimilarityPairs)(nil),               // 47: ag.SimilarityPairs
+	(*SimilarityMatch)(nil),               // 48: ag.SimilarityMatch
+	(*GradingBenchmark)(nil),              // 49: ag.GradingBenchmark
+	(*Benchmarks)(nil),                    // 50: ag.Benchmarks
+	(*GradingCriterion)(nil),              // 51: ag.GradingCriterion
+	(*Review)(nil),                        // 52: ag.Review
+	(*Reviewers)(nil),                     // 53: ag.Reviewers
+	(*ReviewRequest)(nil),                 // 54: ag.ReviewRequest
+	(*DeadlineExtensionRequest)(nil),      // 55: ag.DeadlineExtensionRequest
+	(*SlipDaysRequest)(nil),               // 56: ag.SlipDaysRequest
+	(*CourseRequest)(nil),                 // 57: ag.CourseRequest
+	(*UserRequest)(nil),                   // 58: ag.UserRequest
+	(*GetGroupRequest)(nil),               // 59: ag.GetGroupRequest
+	(*GroupRequest)(nil),                  // 60: ag.GroupRequest
+	(*Provider)(nil),                      // 61: ag.Provider
+	(*OrgRequest)(nil),                    // 62: ag.OrgRequest
+	(*Organization)(nil),                  // 63: ag.Organization
+	(*Organizations)(nil),                 // 64: ag.Organizations
+	(*EnrollmentRequest)(nil),             // 65: ag.EnrollmentRequest
+	(*EnrollmentStatusRequest)(nil),       // 66: ag.EnrollmentStatusRequest
+	(*SubmissionRequest)(nil),             // 67: ag.SubmissionRequest
+	(*UpdateSubmissionRequest)(nil),       // 68: ag.UpdateSubmissionRequest
+	(*UpdateSubmissionsRequest)(nil),      // 69: ag.UpdateSubmissionsRequest
+	(*SubmissionAttemptRequest)(nil),      // 70: ag.SubmissionAttemptRequest
+	(*SubmissionReviewersRequest)(nil),    // 71: ag.SubmissionReviewersRequest
+	(*Providers)(nil),                     // 72: ag.Providers
+	(*URLRequest)(nil),                    // 73: ag.URLRequest
+	(*RepositoryRequest)(nil),             // 74: ag.RepositoryRequest
+	(*Repositories)(nil),                  // 75: ag.Repositories
+	(*AuthorizationResponse)(nil),         // 76: ag.AuthorizationResponse
+	(*Status)(nil),                        // 77: ag.Status
+	(*SubmissionsForCourseRequest)(nil),   // 78: ag.SubmissionsForCourseRequest
+	(*RebuildRequest)(nil),                // 79: ag.RebuildRequest
+	(*CourseUserRequest)(nil),             // 80: ag.CourseUserRequest
+	(*LoadCriteriaRequest)(nil),           // 81: ag.LoadCriteriaRequest
+	(*BuildJobRequest)(nil),               // 82: ag.BuildJobRequest
+	(*BuildQuotaRequest)(nil),             // 83: ag.BuildQuotaRequest
+	(*BuildLogRequest)(nil),               // 84: ag.BuildLogRequest
+	(*BuildLogLine)(nil),                  // 85: ag.BuildLogLine
+	(*TestStatisticsRequest)(nil),         // 86: ag.TestStatisticsRequest
+	(*SimilarityRequest)(nil),             // 87: ag.SimilarityRequest
+	(*GradebookRequest)(nil),              // 88: ag.GradebookRequest
+	(*GradebookFile)(nil),                 // 89: ag.GradebookFile
+	(*Void)(nil),                          // 90: ag.Void
+	nil,                                   // 91: ag.Repositories.URLsEntry
 }
 var file_ag_ag_proto_depIdxs = []int32{
-	9,  // 0: ag.User.remoteIdentities:type_name -> ag.RemoteIdentity
-	15, // 1: ag.User.enrollments:type_name -> ag.Enrollment
-	7,  // 2: ag.Users.users:type_name -> ag.User
-	0,  // 3: ag.Group.status:type_name -> ag.Group.GroupStatus
-	7,  // 4: ag.Group.users:type_name -> ag.User
-	15, // 5: ag.Group.enrollments:type_name -> ag.Enrollment
-	10, // 6: ag.Groups.groups:type_name -> ag.Group
-	2,  // 7: ag.Course.enrolled:type_name -> ag.Enrollment.UserStatus
-	15, // 8: ag.Course.enrollments:type_name -> ag.Enrollment
-	21, // 9: ag.Course.assignments:type_name -> ag.Assignment
-	10, // 10: ag.Course.groups:type_name -> ag.Group
-	12, // 11: ag.Courses.courses:type_name -> ag.Course
-	1,  // 12: ag.Repository.repoType:type_name -> ag.Repository.Type
-	7,  // 13: ag.Enrollment.user:type_name -> ag.User
-	12, // 14: ag.Enrollment.course:type_name -> ag.Course
-	10, // 15: ag.Enrollment.group:type_name -> ag.Group
-	2,  // 16: ag.Enrollment.status:type_name -> ag.Enrollment.UserStatus
-	3,  // 17: ag.Enrollment.state:type_name -> ag.Enrollment.DisplayState
-	16, // 18: ag.Enrollment.usedSlipDays:type_name -> ag.UsedSlipDays
-	15, // 19: ag.Enrollments.enrollments:type_name -> ag.Enrollment
-	21, // 20: ag.SubmissionLink.assignment:type_name -> ag.Assignment
-	23, // 21: ag.SubmissionLink.submission:type_name -> ag.Submission
-	15, // 22: ag.EnrollmentLink.enrollment:type_name -> ag.Enrollment
-	18, // 23: ag.EnrollmentLink.submissions:type_name -> ag.SubmissionLink
-	12, // 24: ag.CourseSubmissions.course:type_name -> ag.Course
-	19, // 25: ag.CourseSubmissions.links:type_name -> ag.EnrollmentLink
-	23, // 26: ag.Assignment.submissions:type_name -> ag.Submission
-	25, // 27: ag.Assignment.gradingBenchmarks:type_name -> ag.GradingBenchmark
-	21, // 28: ag.Assignments.assignments:type_name -> ag.Assignment
-	4,  // 29: ag.Submission.status:type_name -> ag.Submission.Status
-	28, // 30: ag.Submission.reviews:type_name -> ag.Review
-	23, // 31: ag.Submissions.submissions:type_name -> ag.Submission
-	27, // 32: ag.GradingBenchmark.criteria:type_name -> ag.GradingCriterion
-	25, // 33: ag.Benchmarks.benchmarks:type_name -> ag.GradingBenchmark
-	5,  // 34: ag.GradingCriterion.grade:type_name -> ag.GradingCriterion.Grade
-	25, // 35: ag.Review.benchmarks:type_name -> ag.GradingBenchmark
-	7,  // 36: ag.Reviewers.reviewers:type_name -> ag.User
-	28, // 37: ag.ReviewRequest.review:type_name -> ag.Review
-	37, // 38: ag.Organizations.organizations:type_name -> ag.Organization
-	2,  // 39: ag.EnrollmentRequest.statuses:type_name -> ag.Enrollment.UserStatus
-	2,  // 40: ag.EnrollmentStatusRequest.statuses:type_name -> ag.Enrollment.UserStatus
-	4,  // 41: ag.UpdateSubmissionRequest.status:type_name -> ag.Submission.Status
-	1,  // 42: ag.URLRequest.repoTypes:type_name -> ag.Repository.Type
-	56, // 43: ag.Repositories.URLs:type_name -> ag.Repositories.URLsEntry
-	6,  // 44: ag.SubmissionsForCourseRequest.type:type_name -> ag.SubmissionsForCourseRequest.Type
-	55, // 45: ag.AutograderService.GetUser:input_type -> ag.Void
-	55, // 46: ag.AutograderService.GetUsers:input_type -> ag.Void
-	53, // 47: ag.AutograderService.GetUserByCourse:input_type -> ag.CourseUserRequest
-	7,  // 48: ag.AutograderService.UpdateUser:input_type -> ag.User
-	55, // 49: ag.AutograderService.IsAuthorizedTeacher:input_type -> ag.Void
-	33, // 50: ag.AutograderService.GetGroup:input_type -> ag.GetGroupRequest
-	34, // 51: ag.AutograderService.GetGroupByUserAndCourse:input_type -> ag.GroupRequest
-	31, // 52: ag.AutograderService.GetGroupsByCourse:input_type -> ag.CourseRequest
-	10, // 53: ag.AutograderService.CreateGroup:input_type -> ag.Group
-	10, // 54: ag.AutograderService.UpdateGroup:input_type -> ag.Group
-	34, // 55: ag.AutograderService.DeleteGroup:input_type -> ag.GroupRequest
-	31, // 56: ag.AutograderService.GetCourse:input_type -> ag.CourseRequest
-	55, // 57: ag.AutograderService.GetCourses:input_type -> ag.Void
-	40, // 58: ag.AutograderService.GetCoursesByUser:input_type -> ag.EnrollmentStatusRequest
-	12, // 59: ag.AutograderService.CreateCourse:input_type -> ag.Course
-	12, // 60: ag.AutograderService.UpdateCourse:input_type -> ag.Course
-	15, // 61: ag.AutograderService.UpdateCourseVisibility:input_type -> ag.Enrollment
-	31, // 62: ag.AutograderService.GetAssignments:input_type -> ag.CourseRequest
-	31, // 63: ag.AutograderService.UpdateAssignments:input_type -> ag.CourseRequest
-	40, // 64: ag.AutograderService.GetEnrollmentsByUser:input_type -> ag.EnrollmentStatusRequest
-	39, // 65: ag.AutograderService.GetEnrollmentsByCourse:input_type -> ag.EnrollmentRequest
-	15, // 66: ag.AutograderService.CreateEnrollment:input_type -> ag.Enrollment
-	15, // 67: ag.AutograderService.UpdateEnrollment:input_type -> ag.Enrollment
-	31, // 68: ag.AutograderService.UpdateEnrollments:input_type -> ag.CourseRequest
-	41, // 69: ag.AutograderService.GetSubmissions:input_type -> ag.SubmissionRequest
-	51, // 70: ag.AutograderService.GetSubmissionsByCourse:input_type -> ag.SubmissionsForCourseRequest
-	42, // 71: ag.AutograderService.UpdateSubmission:input_type -> ag.UpdateSubmissionRequest
-	43, // 72: ag.AutograderService.UpdateSubmissions:input_type -> ag.UpdateSubmissionsRequest
-	52, // 73: ag.AutograderService.RebuildSubmission:input_type -> ag.RebuildRequest
-	25, // 74: ag.AutograderService.CreateBenchmark:input_type -> ag.GradingBenchmark
-	25, // 75: ag.AutograderService.UpdateBenchmark:input_type -> ag.GradingBenchmark
-	25, // 76: ag.AutograderService.DeleteBenchmark:input_type -> ag.GradingBenchmark
-	27, // 77: ag.AutograderService.CreateCriterion:input_type -> ag.GradingCriterion
-	27, // 78: ag.AutograderService.UpdateCriterion:input_type -> ag.GradingCriterion
-	27, // 79: ag.AutograderService.DeleteCriterion:input_type -> ag.GradingCriterion
-	30, // 80: ag.AutograderService.CreateReview:input_type -> ag.ReviewRequest
-	30, // 81: ag.AutograderService.UpdateReview:input_type -> ag.ReviewRequest
-	44, // 82: ag.AutograderService.GetReviewers:input_type -> ag.SubmissionReviewersRequest
-	54, // 83: ag.AutograderService.LoadCriteria:input_type -> ag.LoadCriteriaRequest
-	55, // 84: ag.AutograderService.GetProviders:input_type -> ag.Void
-	36, // 85: ag.AutograderService.GetOrganization:input_type -> ag.OrgRequest
-	46, // 86: ag.AutograderService.GetRepositories:input_type -> ag.URLRequest
-	47, // 87: ag.AutograderService.IsEmptyRepo:input_type -> ag.RepositoryRequest
-	7,  // 88: ag.AutograderService.GetUser:output_type -> ag.User
-	8,  // 89: ag.AutograderService.GetUsers:output_type -> ag.Users
-	7,  // 90: ag.AutograderService.GetUserByCourse:output_type -> ag.User
-	55, // 91: ag.AutograderService.UpdateUser:output_type -> ag.Void
-	49, // 92: ag.AutograderService.IsAuthorizedTeacher:output_type -> ag.AuthorizationResponse
-	10, // 93: ag.AutograderService.GetGroup:output_type -> ag.Group
-	10, // 94: ag.AutograderService.GetGroupByUserAndCourse:output_type -> ag.Group
-	11, // 95: ag.AutograderService.GetGroupsByCourse:output_type -> ag.Groups
-	10, // 96: ag.AutograderService.CreateGroup:output_type -> ag.Group
-	55, // 97: ag.AutograderService.UpdateGroup:output_type -> ag.Void
-	55, // 98: ag.AutograderService.DeleteGroup:output_type -> ag.Void
-	12, // 99: ag.AutograderService.GetCourse:output_type -> ag.Course
-	13, // 100: ag.AutograderService.GetCourses:output_type -> ag.Courses
-	13, // 101: ag.AutograderService.GetCoursesByUser:output_type -> ag.Courses
-	12, // 102: ag.AutograderService.CreateCourse:output_type -> ag.Course
-	55, // 103: ag.AutograderService.UpdateCourse:output_type -> ag.Void
-	55, // 104: ag.AutograderService.UpdateCourseVisibility:output_type -> ag.Void
-	22, // 105: ag.AutograderService.GetAssignments:output_type -> ag.Assignments
-	55, // 106: ag.AutograderService.UpdateAssignments:output_type -> ag.Void
-	17, // 107: ag.AutograderService.GetEnrollmentsByUser:output_type -> ag.Enrollments
-	17, // 108: ag.AutograderService.GetEnrollmentsByCourse:output_type -> ag.Enrollments
-	55, // 109: ag.AutograderService.CreateEnrollment:output_type -> ag.Void
-	55, // 110: ag.AutograderService.UpdateEnrollment:output_type -> ag.Void
-	55, // 111: ag.AutograderService.UpdateEnrollments:output_type -> ag.Void
-	24, // 112: ag.AutograderService.GetSubmissions:output_type -> ag.Submissions
-	20, // 113: ag.AutograderService.GetSubmissionsByCourse:output_type -> ag.CourseSubmissions
-	55, // 114: ag.AutograderService.UpdateSubmission:output_type -> ag.Void
-	55, // 115: ag.AutograderService.UpdateSubmissions:output_type -> ag.Void
-	23, // 116: ag.AutograderService.RebuildSubmission:output_type -> ag.Submission
-	25, // 117: ag.AutograderService.CreateBenchmark:output_type -> ag.GradingBenchmark
-	55, // 118: ag.AutograderService.UpdateBenchmark:output_type -> ag.Void
-	55, // 119: ag.AutograderService.DeleteBenchmark:output_type -> ag.Void
-	27, // 120: ag.AutograderService.CreateCriterion:output_type -> ag.GradingCriterion
-	55, // 121: ag.AutograderService.UpdateCriterion:output_type -> ag.Void
-	55, // 122: ag.AutograderService.DeleteCriterion:output_type -> ag.Void
-	28, // 123: ag.AutograderService.CreateReview:output_type -> ag.Review
-	55, // 124: ag.AutograderService.UpdateReview:output_type -> ag.Void
-	29, // 125: ag.AutograderService.GetReviewers:output_type -> ag.Reviewers
-	26, // 126: ag.AutograderService.LoadCriteria:output_type -> ag.Benchmarks
-	45, // 127: ag.AutograderService.GetProviders:output_type -> ag.Providers
-	37, // 128: ag.AutograderService.GetOrganization:output_type -> ag.Organization
-	48, // 129: ag.AutograderService.GetRepositories:output_type -> ag.Repositories
-	55, // 130: ag.AutograderService.IsEmptyRepo:output_type -> ag.Void
-	88, // [88:131] is the sub-list for method output_type
-	45, // [45:88] is the sub-list for method input_type
-	45, // [45:45] is the sub-list for extension type_name
-	45, // [45:45] is the sub-list for extension extendee
-	0,  // [0:45] is the sub-list for field type_name
+	12,  // 0: ag.User.remoteIdentities:type_name -> ag.RemoteIdentity
+	18,  // 1: ag.User.enrollments:type_name -> ag.Enrollment
+	10,  // 2: ag.Users.users:type_name -> ag.User
+	0,   // 3: ag.Group.status:type_name -> ag.Group.GroupStatus
+	10,  // 4: ag.Group.users:type_name -> ag.User
+	18,  // 5: ag.Group.enrollments:type_name -> ag.Enrollment
+	13,  // 6: ag.Groups.groups:type_name -> ag.Group
+	3,   // 7: ag.Course.enrolled:type_name -> ag.Enrollment.UserStatus
+	1,   // 8: ag.Course.latePolicy:type_name -> ag.Course.LatePolicy
+	18,  // 9: ag.Course.enrollments:type_name -> ag.Enrollment
+	27,  // 10: ag.Course.assignments:type_name -> ag.Assignment
+	13,  // 11: ag.Course.groups:type_name -> ag.Group
+	15,  // 12: ag.Courses.courses:type_name -> ag.Course
+	2,   // 13: ag.Repository.repoType:type_name -> ag.Repository.Type
+	10,  // 14: ag.Enrollment.user:type_name -> ag.User
+	15,  // 15: ag.Enrollment.course:type_name -> ag.Course
+	13,  // 16: ag.Enrollment.group:type_name -> ag.Group
+	3,   // 17: ag.Enrollment.status:type_name -> ag.Enrollment.UserStatus
+	4,   // 18: ag.Enrollment.state:type_name -> ag.Enrollment.DisplayState
+	19,  // 19: ag.Enrollment.usedSlipDays:type_name -> ag.UsedSlipDays
+	20,  // 20: ag.SlipDaysChange.assignments:type_name -> ag.UsedSlipDaysChange
+	21,  // 21: ag.SlipDaysRecomputation.changes:type_name -> ag.SlipDaysChange
+	18,  // 22: ag.Enrollments.enrollments:type_name -> ag.Enrollment
+	27,  // 23: ag.SubmissionLink.assignment:type_name -> ag.Assignment
+	32,  // 24: ag.SubmissionLink.submission:type_name -> ag.Submission
+	18,  // 25: ag.EnrollmentLink.enrollment:type_name -> ag.Enrollment
+	24,  // 26: ag.EnrollmentLink.submissions:type_name -> ag.SubmissionLink
+	15,  // 27: ag.CourseSubmissions.course:type_name -> ag.Course
+	25,  // 28: ag.CourseSubmissions.links:type_name -> ag.EnrollmentLink
+	32,  // 29: ag.Assignment.submissions:type_name -> ag.Submission
+	49,  // 30: ag.Assignment.gradingBenchmarks:type_name -> ag.GradingBenchmark
+	1,   // 31: ag.Assignment.latePolicy:type_name -> ag.Course.LatePolicy
+	27,  // 32: ag.Assignments.assignments:type_name -> ag.Assignment
+	29,  // 33: ag.DeadlineExtensions.extensions:type_name -> ag.DeadlineExtension
+	5,   // 34: ag.Submission.status:type_name -> ag.Submission.Status
+	52,  // 35: ag.Submission.reviews:type_name -> ag.Review
+	36,  // 36: ag.Submission.testResults:type_name -> ag.TestResult
+	32,  // 37: ag.Submissions.submissions:type_name -> ag.Submission
+	36,  // 38: ag.SubmissionAttempt.testResults:type_name -> ag.TestResult
+	34,  // 39: ag.SubmissionAttempts.attempts:type_name -> ag.SubmissionAttempt
+	37,  // 40: ag.TestStatistics.tests:type_name -> ag.TestCaseStatistics
+	39,  // 41: ag.AssignmentStatistics.pushActivity:type_name -> ag.PushActivity
+	40,  // 42: ag.CourseStatistics.assignments:type_name -> ag.AssignmentStatistics
+	6,   // 43: ag.BuildJob.status:type_name -> ag.BuildJob.Status
+	42,  // 44: ag.BuildJobs.jobs:type_name -> ag.BuildJob
+	44,  // 45: ag.BuildQuotas.quotas:type_name -> ag.BuildQuota
+	48,  // 46: ag.SimilarityPair.matches:type_name -> ag.SimilarityMatch
+	46,  // 47: ag.SimilarityPairs.pairs:type_name -> ag.SimilarityPair
+	51,  // 48: ag.GradingBenchmark.criteria:type_name -> ag.GradingCriterion
+	49,  // 49: ag.Benchmarks.benchmarks:type_name -> ag.GradingBenchmark
+	7,   // 50: ag.GradingCriterion.grade:type_name -> ag.GradingCriterion.Grade
+	49,  // 51: ag.Review.benchmarks:type_name -> ag.GradingBenchmark
+	10,  // 52: ag.Reviewers.reviewers:type_name -> ag.User
+	52,  // 53: ag.ReviewRequest.review:type_name -> ag.Review
+	29,  // 54: ag.DeadlineExtensionRequest.extension:type_name -> ag.DeadlineExtension
+	63,  // 55: ag.Organizations.organizations:type_name -> ag.Organization
+	3,   // 56: ag.EnrollmentRequest.statuses:type_name -> ag.Enrollment.UserStatus
+	3,   // 57: ag.EnrollmentStatusRequest.statuses:type_name -> ag.Enrollment.UserStatus
+	5,   // 58: ag.UpdateSubmissionRequest.status:type_name -> ag.Submission.Status
+	2,   // 59: ag.URLRequest.repoTypes:type_name -> ag.Repository.Type
+	91,  // 60: ag.Repositories.URLs:type_name -> ag.Repositories.URLsEntry
+	8,   // 61: ag.SubmissionsForCourseRequest.type:type_name -> ag.SubmissionsForCourseRequest.Type
+	9,   // 62: ag.GradebookRequest.format:type_name -> ag.GradebookRequest.Format
+	90,  // 63: ag.AutograderService.GetUser:input_type -> ag.Void
+	90,  // 64: ag.AutograderService.GetUsers:input_type -> ag.Void
+	80,  // 65: ag.AutograderService.GetUserByCourse:input_type -> ag.CourseUserRequest
+	10,  // 66: ag.AutograderService.UpdateUser:input_type -> ag.User
+	90,  // 67: ag.AutograderService.IsAuthorizedTeacher:input_type -> ag.Void
+	59,  // 68: ag.AutograderService.GetGroup:input_type -> ag.GetGroupRequest
+	60,  // 69: ag.AutograderService.GetGroupByUserAndCourse:input_type -> ag.GroupRequest
+	57,  // 70: ag.AutograderService.GetGroupsByCourse:input_type -> ag.CourseRequest
+	13,  // 71: ag.AutograderService.CreateGroup:input_type -> ag.Group
+	13,  // 72: ag.AutograderService.UpdateGroup:input_type -> ag.Group
+	60,  // 73: ag.AutograderService.DeleteGroup:input_type -> ag.GroupRequest
+	57,  // 74: ag.AutograderService.GetCourse:input_type -> ag.CourseRequest
+	90,  // 75: ag.AutograderService.GetCourses:input_type -> ag.Void
+	66,  // 76: ag.AutograderService.GetCoursesByUser:input_type -> ag.EnrollmentStatusRequest
+	15,  // 77: ag.AutograderService.CreateCourse:input_type -> ag.Course
+	15,  // 78: ag.AutograderService.UpdateCourse:input_type -> ag.Course
+	18,  // 79: ag.AutograderService.UpdateCourseVisibility:input_type -> ag.Enrollment
+	57,  // 80: ag.AutograderService.GetAssignments:input_type -> ag.CourseRequest
+	57,  // 81: ag.AutograderService.UpdateAssignments:input_type -> ag.CourseRequest
+	57,  // 82: ag.AutograderService.GetDeadlineExtensions:input_type -> ag.CourseRequest
+	55,  // 83: ag.AutograderService.GrantDeadlineExtension:input_type -> ag.DeadlineExtensionRequest
+	55,  // 84: ag.AutograderService.RevokeDeadlineExtension:input_type -> ag.DeadlineExtensionRequest
+	56,  // 85: ag.AutograderService.RecomputeSlipDays:input_type -> ag.SlipDaysRequest
+	66,  // 86: ag.AutograderService.GetEnrollmentsByUser:input_type -> ag.EnrollmentStatusRequest
+	65,  // 87: ag.AutograderService.GetEnrollmentsByCourse:input_type -> ag.EnrollmentRequest
+	18,  // 88: ag.AutograderService.CreateEnrollment:input_type -> ag.Enrollment
+	18,  // 89: ag.AutograderService.UpdateEnrollment:input_type -> ag.Enrollment
+	57,  // 90: ag.AutograderService.UpdateEnrollments:input_type -> ag.CourseRequest
+	67,  // 91: ag.AutograderService.GetSubmissions:input_type -> ag.SubmissionRequest
+	78,  // 92: ag.AutograderService.GetSubmissionsByCourse:input_type -> ag.SubmissionsForCourseRequest
+	68,  // 93: ag.AutograderService.UpdateSubmission:input_type -> ag.UpdateSubmissionRequest
+	69,  // 94: ag.AutograderService.UpdateSubmissions:input_type -> ag.UpdateSubmissionsRequest
+	79,  // 95: ag.AutograderService.RebuildSubmission:input_type -> ag.RebuildRequest
+	70,  // 96: ag.AutograderService.GetSubmissionAttempts:input_type -> ag.SubmissionAttemptRequest
+	70,  // 97: ag.AutograderService.GetSubmissionAttempt:input_type -> ag.SubmissionAttemptRequest
+	70,  // 98: ag.AutograderService.UpdateGradedAttempt:input_type -> ag.SubmissionAttemptRequest
+	57,  // 99: ag.AutograderService.SubmissionStream:input_type -> ag.CourseRequest
+	86,  // 100: ag.AutograderService.GetTestStatistics:input_type -> ag.TestStatisticsRequest
+	57,  // 101: ag.AutograderService.GetCourseStatistics:input_type -> ag.CourseRequest
+	88,  // 102: ag.AutograderService.ExportGradebook:input_type -> ag.GradebookRequest
+	57,  // 103: ag.AutograderService.GetBuildJobs:input_type -> ag.CourseRequest
+	82,  // 104: ag.AutograderService.CancelBuildJob:input_type -> ag.BuildJobRequest
+	84,  // 105: ag.AutograderService.BuildLogStream:input_type -> ag.BuildLogRequest
+	83,  // 106: ag.AutograderService.GetBuildQuotas:input_type -> ag.BuildQuotaRequest
+	83,  // 107: ag.AutograderService.ResetBuildQuota:input_type -> ag.BuildQuotaRequest
+	87,  // 108: ag.AutograderService.CheckSimilarity:input_type -> ag.SimilarityRequest
+	87,  // 109: ag.AutograderService.GetSimilarityPairs:input_type -> ag.SimilarityRequest
+	49,  // 110: ag.AutograderService.CreateBenchmark:input_type -> ag.GradingBenchmark
+	49,  // 111: ag.AutograderService.UpdateBenchmark:input_type -> ag.GradingBenchmark
+	49,  // 112: ag.AutograderService.DeleteBenchmark:input_type -> ag.GradingBenchmark
+	51,  // 113: ag.AutograderService.CreateCriterion:input_type -> ag.GradingCriterion
+	51,  // 114: ag.AutograderService.UpdateCriterion:input_type -> ag.GradingCriterion
+	51,  // 115: ag.AutograderService.DeleteCriterion:input_type -> ag.GradingCriterion
+	54,  // 116: ag.AutograderService.CreateReview:input_type -> ag.ReviewRequest
+	54,  // 117: ag.AutograderService.UpdateReview:input_type -> ag.ReviewRequest
+	71,  // 118: ag.AutograderService.GetReviewers:input_type -> ag.SubmissionReviewersRequest
+	81,  // 119: ag.AutograderService.LoadCriteria:input_type -> ag.LoadCriteriaRequest
+	90,  // 120: ag.AutograderService.GetProviders:input_type -> ag.Void
+	62,  // 121: ag.AutograderService.GetOrganization:input_type -> ag.OrgRequest
+	73,  // 122: ag.AutograderService.GetRepositories:input_type -> ag.URLRequest
+	74,  // 123: ag.AutograderService.IsEmptyRepo:input_type -> ag.RepositoryRequest
+	10,  // 124: ag.AutograderService.GetUser:output_type -> ag.User
+	11,  // 125: ag.AutograderService.GetUsers:output_type -> ag.Users
+	10,  // 126: ag.AutograderService.GetUserByCourse:output_type -> ag.User
+	90,  // 127: ag.AutograderService.UpdateUser:output_type -> ag.Void
+	76,  // 128: ag.AutograderService.IsAuthorizedTeacher:output_type -> ag.AuthorizationResponse
+	13,  // 129: ag.AutograderService.GetGroup:output_type -> ag.Group
+	13,  // 130: ag.AutograderService.GetGroupByUserAndCourse:output_type -> ag.Group
+	14,  // 131: ag.AutograderService.GetGroupsByCourse:output_type -> ag.Groups
+	13,  // 132: ag.AutograderService.CreateGroup:output_type -> ag.Group
+	90,  // 133: ag.AutograderService.UpdateGroup:output_type -> ag.Void
+	90,  // 134: ag.AutograderService.DeleteGroup:output_type -> ag.Void
+	15,  // 135: ag.AutograderService.GetCourse:output_type -> ag.Course
+	16,  // 136: ag.AutograderService.GetCourses:output_type -> ag.Courses
+	16,  // 137: ag.AutograderService.GetCoursesByUser:output_type -> ag.Courses
+	15,  // 138: ag.AutograderService.CreateCourse:output_type -> ag.Course
+	90,  // 139: ag.AutograderService.UpdateCourse:output_type -> ag.Void
+	90,  // 140: ag.AutograderService.UpdateCourseVisibility:output_type -> ag.Void
+	28,  // 141: ag.AutograderService.GetAssignments:output_type -> ag.Assignments
+	90,  // 142: ag.AutograderService.UpdateAssignments:output_type -> ag.Void
+	30,  // 143: ag.AutograderService.GetDeadlineExtensions:output_type -> ag.DeadlineExtensions
+	29,  // 144: ag.AutograderService.GrantDeadlineExtension:output_type -> ag.DeadlineExtension
+	90,  // 145: ag.AutograderService.RevokeDeadlineExtension:output_type -> ag.Void
+	22,  // 146: ag.AutograderService.RecomputeSlipDays:output_type -> ag.SlipDaysRecomputation
+	23,  // 147: ag.AutograderService.GetEnrollmentsByUser:output_type -> ag.Enrollments
+	23,  // 148: ag.AutograderService.GetEnrollmentsByCourse:output_type -> ag.Enrollments
+	90,  // 149: ag.AutograderService.CreateEnrollment:output_type -> ag.Void
+	90,  // 150: ag.AutograderService.UpdateEnrollment:output_type -> ag.Void
+	90,  // 151: ag.AutograderService.UpdateEnrollments:output_type -> ag.Void
+	33,  // 152: ag.AutograderService.GetSubmissions:output_type -> ag.Submissions
+	26,  // 153: ag.AutograderService.GetSubmissionsByCourse:output_type -> ag.CourseSubmissions
+	90,  // 154: ag.AutograderService.UpdateSubmission:output_type -> ag.Void
+	90,  // 155: ag.AutograderService.UpdateSubmissions:output_type -> ag.Void
+	32,  // 156: ag.AutograderService.RebuildSubmission:output_type -> ag.Submission
+	35,  // 157: ag.AutograderService.GetSubmissionAttempts:output_type -> ag.SubmissionAttempts
+	34,  // 158: ag.AutograderService.GetSubmissionAttempt:output_type -> ag.SubmissionAttempt
+	32,  // 159: ag.AutograderService.UpdateGradedAttempt:output_type -> ag.Submission
+	32,  // 160: ag.AutograderService.SubmissionStream:output_type -> ag.Submission
+	38,  // 161: ag.AutograderService.GetTestStatistics:output_type -> ag.TestStatistics
+	41,  // 162: ag.AutograderService.GetCourseStatistics:output_type -> ag.CourseStatistics
+	89,  // 163: ag.AutograderService.ExportGradebook:output_type -> ag.GradebookFile
+	43,  // 164: ag.AutograderService.GetBuildJobs:output_type -> ag.BuildJobs
+	90,  // 165: ag.AutograderService.CancelBuildJob:output_type -> ag.Void
+	85,  // 166: ag.AutograderService.BuildLogStream:output_type -> ag.BuildLogLine
+	45,  // 167: ag.AutograderService.GetBuildQuotas:output_type -> ag.BuildQuotas
+	90,  // 168: ag.AutograderService.ResetBuildQuota:output_type -> ag.Void
+	47,  // 169: ag.AutograderService.CheckSimilarity:output_type -> ag.SimilarityPairs
+	47,  // 170: ag.AutograderService.GetSimilarityPairs:output_type -> ag.SimilarityPairs
+	49,  // 171: ag.AutograderService.CreateBenchmark:output_type -> ag.GradingBenchmark
+	90,  // 172: ag.AutograderService.UpdateBenchmark:output_type -> ag.Void
+	90,  // 173: ag.AutograderService.DeleteBenchmark:output_type -> ag.Void
+	51,  // 174: ag.AutograderService.CreateCriterion:output_type -> ag.GradingCriterion
+	90,  // 175: ag.AutograderService.UpdateCriterion:output_type -> ag.Void
+	90,  // 176: ag.AutograderService.DeleteCriterion:output_type -> ag.Void
+	52,  // 177: ag.AutograderService.CreateReview:output_type -> ag.Review
+	90,  // 178: ag.AutograderService.UpdateReview:output_type -> ag.Void
+	53,  // 179: ag.AutograderService.GetReviewers:output_type -> ag.Reviewers
+	50,  // 180: ag.AutograderService.LoadCriteria:output_type -> ag.Benchmarks
+	72,  // 181: ag.AutograderService.GetProviders:output_type -> ag.Providers
+	63,  // 182: ag.AutograderService.GetOrganization:output_type -> ag.Organization
+	75,  // 183: ag.AutograderService.GetRepositories:output_type -> ag.Repositories
+	90,  // 184: ag.AutograderService.IsEmptyRepo:output_type -> ag.Void
+	124, // [124:185] is the sub-list for method output_type
+	63,  // [63:124] is the sub-list for method input_type
+	63,  // [63:63] is the sub-list for extension type_name
+	63,  // [63:63] is the sub-list for extension extendee
+	0,   // [0:63] is the sub-list for field type_name
 }
 
 func init() { file_ag_ag_proto_init() }
@@ -4571,8 +8008,212 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Users); i {
+		file_ag_ag_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Users); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoteIdentity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Group); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Groups); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Course); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Courses); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Repository); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Enrollment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsedSlipDays); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsedSlipDaysChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlipDaysChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlipDaysRecomputation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Enrollments); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnrollmentLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CourseSubmissions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Assignment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Assignments); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4583,8 +8224,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoteIdentity); i {
+		file_ag_ag_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeadlineExtension); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4595,8 +8236,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Group); i {
+		file_ag_ag_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeadlineExtensions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4607,8 +8248,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Groups); i {
+		file_ag_ag_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeadlineSnapshot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4619,8 +8260,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Course); i {
+		file_ag_ag_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Submission); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4631,8 +8272,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Courses); i {
+		file_ag_ag_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Submissions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4643,8 +8284,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Repository); i {
+		file_ag_ag_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionAttempt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4655,8 +8296,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Enrollment); i {
+		file_ag_ag_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionAttempts); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4667,8 +8308,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UsedSlipDays); i {
+		file_ag_ag_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4679,8 +8320,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Enrollments); i {
+		file_ag_ag_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestCaseStatistics); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4691,8 +8332,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubmissionLink); i {
+		file_ag_ag_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestStatistics); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4703,8 +8344,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnrollmentLink); i {
+		file_ag_ag_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushActivity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4715,8 +8356,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CourseSubmissions); i {
+		file_ag_ag_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssignmentStatistics); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4727,8 +8368,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Assignment); i {
+		file_ag_ag_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CourseStatistics); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4739,8 +8380,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Assignments); i {
+		file_ag_ag_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildJob); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4751,8 +8392,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Submission); i {
+		file_ag_ag_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildJobs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4763,8 +8404,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Submissions); i {
+		file_ag_ag_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildQuota); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4775,7 +8416,55 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildQuotas); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimilarityPair); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimilarityPairs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimilarityMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GradingBenchmark); i {
 			case 0:
 				return &v.state
@@ -4787,7 +8476,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Benchmarks); i {
 			case 0:
 				return &v.state
@@ -4799,7 +8488,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GradingCriterion); i {
 			case 0:
 				return &v.state
@@ -4811,7 +8500,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Review); i {
 			case 0:
 				return &v.state
@@ -4823,7 +8512,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Reviewers); i {
 			case 0:
 				return &v.state
@@ -4835,7 +8524,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReviewRequest); i {
 			case 0:
 				return &v.state
@@ -4847,7 +8536,31 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeadlineExtensionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlipDaysRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CourseRequest); i {
 			case 0:
 				return &v.state
@@ -4859,7 +8572,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserRequest); i {
 			case 0:
 				return &v.state
@@ -4871,7 +8584,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetGroupRequest); i {
 			case 0:
 				return &v.state
@@ -4883,7 +8596,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GroupRequest); i {
 			case 0:
 				return &v.state
@@ -4895,7 +8608,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Provider); i {
 			case 0:
 				return &v.state
@@ -4907,7 +8620,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OrgRequest); i {
 			case 0:
 				return &v.state
@@ -4919,7 +8632,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Organization); i {
 			case 0:
 				return &v.state
@@ -4931,7 +8644,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Organizations); i {
 			case 0:
 				return &v.state
@@ -4943,7 +8656,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EnrollmentRequest); i {
 			case 0:
 				return &v.state
@@ -4955,7 +8668,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EnrollmentStatusRequest); i {
 			case 0:
 				return &v.state
@@ -4967,7 +8680,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubmissionRequest); i {
 			case 0:
 				return &v.state
@@ -4979,7 +8692,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateSubmissionRequest); i {
 			case 0:
 				return &v.state
@@ -4991,7 +8704,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateSubmissionsRequest); i {
 			case 0:
 				return &v.state
@@ -5003,7 +8716,19 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionAttemptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubmissionReviewersRequest); i {
 			case 0:
 				return &v.state
@@ -5015,7 +8740,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Providers); i {
 			case 0:
 				return &v.state
@@ -5027,7 +8752,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*URLRequest); i {
 			case 0:
 				return &v.state
@@ -5039,7 +8764,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RepositoryRequest); i {
 			case 0:
 				return &v.state
@@ -5051,7 +8776,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Repositories); i {
 			case 0:
 				return &v.state
@@ -5063,7 +8788,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AuthorizationResponse); i {
 			case 0:
 				return &v.state
@@ -5075,7 +8800,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Status); i {
 			case 0:
 				return &v.state
@@ -5087,7 +8812,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubmissionsForCourseRequest); i {
 			case 0:
 				return &v.state
@@ -5099,7 +8824,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RebuildRequest); i {
 			case 0:
 				return &v.state
@@ -5111,7 +8836,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CourseUserRequest); i {
 			case 0:
 				return &v.state
@@ -5123,7 +8848,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LoadCriteriaRequest); i {
 			case 0:
 				return &v.state
@@ -5135,7 +8860,103 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildQuotaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildLogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildLogLine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestStatisticsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimilarityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradebookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradebookFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Void); i {
 			case 0:
 				return &v.state
@@ -5153,8 +8974,8 @@ func file_ag_ag_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ag_ag_proto_rawDesc,
-			NumEnums:      7,
-			NumMessages:   50,
+			NumEnums:      10,
+			NumMessages:   82,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
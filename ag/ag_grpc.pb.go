@@ -37,6 +37,14 @@ type AutograderServiceClient interface {
 	UpdateCourseVisibility(ctx context.Context, in *Enrollment, opts ...grpc.CallOption) (*Void, error)
 	GetAssignments(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Assignments, error)
 	UpdateAssignments(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Void, error)
+	// Get the deadline extensions of the course assignments.
+	GetDeadlineExtensions(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*DeadlineExtensions, error)
+	// Grant a student or a group an extended deadline for an assignment, replacing any previous extension.
+	GrantDeadlineExtension(ctx context.Context, in *DeadlineExtensionRequest, opts ...grpc.CallOption) (*DeadlineExtension, error)
+	RevokeDeadlineExtension(ctx context.Context, in *DeadlineExtensionRequest, opts ...grpc.CallOption) (*Void, error)
+	// Recompute the slip days used by the course students from the build dates of their submission attempts
+	// and the current deadlines, and report the changes. The changes are only saved if requested.
+	RecomputeSlipDays(ctx context.Context, in *SlipDaysRequest, opts ...grpc.CallOption) (*SlipDaysRecomputation, error)
 	GetEnrollmentsByUser(ctx context.Context, in *EnrollmentStatusRequest, opts ...grpc.CallOption) (*Enrollments, error)
 	GetEnrollmentsByCourse(ctx context.Context, in *EnrollmentRequest, opts ...grpc.CallOption) (*Enrollments, error)
 	CreateEnrollment(ctx context.Context, in *Enrollment, opts ...grpc.CallOption) (*Void, error)
@@ -49,6 +57,32 @@ type AutograderServiceClient interface {
 	UpdateSubmission(ctx context.Context, in *UpdateSubmissionRequest, opts ...grpc.CallOption) (*Void, error)
 	UpdateSubmissions(ctx context.Context, in *UpdateSubmissionsRequest, opts ...grpc.CallOption) (*Void, error)
 	RebuildSubmission(ctx context.Context, in *RebuildRequest, opts ...grpc.CallOption) (*Submission, error)
+	// Get the attempts of a submission, without their build logs.
+	GetSubmissionAttempts(ctx context.Context, in *SubmissionAttemptRequest, opts ...grpc.CallOption) (*SubmissionAttempts, error)
+	// Get an attempt of a submission, including its build log.
+	GetSubmissionAttempt(ctx context.Context, in *SubmissionAttemptRequest, opts ...grpc.CallOption) (*SubmissionAttempt, error)
+	// Make the given attempt the graded attempt of its submission.
+	UpdateGradedAttempt(ctx context.Context, in *SubmissionAttemptRequest, opts ...grpc.CallOption) (*Submission, error)
+	// Stream the course submissions as they are created or updated.
+	// Teachers receive all course submissions; students receive their own and their group's submissions.
+	SubmissionStream(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (AutograderService_SubmissionStreamClient, error)
+	// Get the test case statistics of an assignment, ordered by decreasing number of failures.
+	GetTestStatistics(ctx context.Context, in *TestStatisticsRequest, opts ...grpc.CallOption) (*TestStatistics, error)
+	// Get the score distributions, approval rates, slip day usage and push activity of the course assignments.
+	GetCourseStatistics(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*CourseStatistics, error)
+	// Export the course gradebook with the scores, approvals, slip days and reviews of all students or groups.
+	ExportGradebook(ctx context.Context, in *GradebookRequest, opts ...grpc.CallOption) (*GradebookFile, error)
+	GetBuildJobs(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*BuildJobs, error)
+	CancelBuildJob(ctx context.Context, in *BuildJobRequest, opts ...grpc.CallOption) (*Void, error)
+	// Stream the output of the queued and running builds of a commit until the builds end.
+	BuildLogStream(ctx context.Context, in *BuildLogRequest, opts ...grpc.CallOption) (AutograderService_BuildLogStreamClient, error)
+	// Get the build quotas of the repositories with recent push builds for an assignment.
+	GetBuildQuotas(ctx context.Context, in *BuildQuotaRequest, opts ...grpc.CallOption) (*BuildQuotas, error)
+	// Reset the build quota of a repository for an assignment, starting any delayed build right away.
+	ResetBuildQuota(ctx context.Context, in *BuildQuotaRequest, opts ...grpc.CallOption) (*Void, error)
+	// Compare the latest submissions of an assignment and store the similar pairs.
+	CheckSimilarity(ctx context.Context, in *SimilarityRequest, opts ...grpc.CallOption) (*SimilarityPairs, error)
+	GetSimilarityPairs(ctx context.Context, in *SimilarityRequest, opts ...grpc.CallOption) (*SimilarityPairs, error)
 	// manual grading //
 	CreateBenchmark(ctx context.Context, in *GradingBenchmark, opts ...grpc.CallOption) (*GradingBenchmark, error)
 	UpdateBenchmark(ctx context.Context, in *GradingBenchmark, opts ...grpc.CallOption) (*Void, error)
@@ -245,6 +279,42 @@ func (c *autograderServiceClient) UpdateAssignments(ctx context.Context, in *Cou
 	return out, nil
 }
 
+func (c *autograderServiceClient) GetDeadlineExtensions(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*DeadlineExtensions, error) {
+	out := new(DeadlineExtensions)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetDeadlineExtensions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GrantDeadlineExtension(ctx context.Context, in *DeadlineExtensionRequest, opts ...grpc.CallOption) (*DeadlineExtension, error) {
+	out := new(DeadlineExtension)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GrantDeadlineExtension", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) RevokeDeadlineExtension(ctx context.Context, in *DeadlineExtensionRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/RevokeDeadlineExtension", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) RecomputeSlipDays(ctx context.Context, in *SlipDaysRequest, opts ...grpc.CallOption) (*SlipDaysRecomputation, error) {
+	out := new(SlipDaysRecomputation)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/RecomputeSlipDays", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *autograderServiceClient) GetEnrollmentsByUser(ctx context.Context, in *EnrollmentStatusRequest, opts ...grpc.CallOption) (*Enrollments, error) {
 	out := new(Enrollments)
 	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetEnrollmentsByUser", in, out, opts...)
@@ -335,6 +405,178 @@ func (c *autograderServiceClient) RebuildSubmission(ctx context.Context, in *Reb
 	return out, nil
 }
 
+func (c *autograderServiceClient) GetSubmissionAttempts(ctx context.Context, in *SubmissionAttemptRequest, opts ...grpc.CallOption) (*SubmissionAttempts, error) {
+	out := new(SubmissionAttempts)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetSubmissionAttempts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetSubmissionAttempt(ctx context.Context, in *SubmissionAttemptRequest, opts ...grpc.CallOption) (*SubmissionAttempt, error) {
+	out := new(SubmissionAttempt)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetSubmissionAttempt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) UpdateGradedAttempt(ctx context.Context, in *SubmissionAttemptRequest, opts ...grpc.CallOption) (*Submission, error) {
+	out := new(Submission)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/UpdateGradedAttempt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) SubmissionStream(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (AutograderService_SubmissionStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AutograderService_ServiceDesc.Streams[0], "/ag.AutograderService/SubmissionStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &autograderServiceSubmissionStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AutograderService_SubmissionStreamClient interface {
+	Recv() (*Submission, error)
+	grpc.ClientStream
+}
+
+type autograderServiceSubmissionStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *autograderServiceSubmissionStreamClient) Recv() (*Submission, error) {
+	m := new(Submission)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *autograderServiceClient) GetTestStatistics(ctx context.Context, in *TestStatisticsRequest, opts ...grpc.CallOption) (*TestStatistics, error) {
+	out := new(TestStatistics)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetTestStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetCourseStatistics(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*CourseStatistics, error) {
+	out := new(CourseStatistics)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetCourseStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) ExportGradebook(ctx context.Context, in *GradebookRequest, opts ...grpc.CallOption) (*GradebookFile, error) {
+	out := new(GradebookFile)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/ExportGradebook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetBuildJobs(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*BuildJobs, error) {
+	out := new(BuildJobs)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetBuildJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) CancelBuildJob(ctx context.Context, in *BuildJobRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/CancelBuildJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) BuildLogStream(ctx context.Context, in *BuildLogRequest, opts ...grpc.CallOption) (AutograderService_BuildLogStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AutograderService_ServiceDesc.Streams[1], "/ag.AutograderService/BuildLogStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &autograderServiceBuildLogStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AutograderService_BuildLogStreamClient interface {
+	Recv() (*BuildLogLine, error)
+	grpc.ClientStream
+}
+
+type autograderServiceBuildLogStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *autograderServiceBuildLogStreamClient) Recv() (*BuildLogLine, error) {
+	m := new(BuildLogLine)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *autograderServiceClient) GetBuildQuotas(ctx context.Context, in *BuildQuotaRequest, opts ...grpc.CallOption) (*BuildQuotas, error) {
+	out := new(BuildQuotas)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetBuildQuotas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) ResetBuildQuota(ctx context.Context, in *BuildQuotaRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/ResetBuildQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) CheckSimilarity(ctx context.Context, in *SimilarityRequest, opts ...grpc.CallOption) (*SimilarityPairs, error) {
+	out := new(SimilarityPairs)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/CheckSimilarity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetSimilarityPairs(ctx context.Context, in *SimilarityRequest, opts ...grpc.CallOption) (*SimilarityPairs, error) {
+	out := new(SimilarityPairs)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetSimilarityPairs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *autograderServiceClient) CreateBenchmark(ctx context.Context, in *GradingBenchmark, opts ...grpc.CallOption) (*GradingBenchmark, error) {
 	out := new(GradingBenchmark)
 	err := c.cc.Invoke(ctx, "/ag.AutograderService/CreateBenchmark", in, out, opts...)
@@ -484,6 +726,14 @@ type AutograderServiceServer interface {
 	UpdateCourseVisibility(context.Context, *Enrollment) (*Void, error)
 	GetAssignments(context.Context, *CourseRequest) (*Assignments, error)
 	UpdateAssignments(context.Context, *CourseRequest) (*Void, error)
+	// Get the deadline extensions of the course assignments.
+	GetDeadlineExtensions(context.Context, *CourseRequest) (*DeadlineExtensions, error)
+	// Grant a student or a group an extended deadline for an assignment, replacing any previous extension.
+	GrantDeadlineExtension(context.Context, *DeadlineExtensionRequest) (*DeadlineExtension, error)
+	RevokeDeadlineExtension(context.Context, *DeadlineExtensionRequest) (*Void, error)
+	// Recompute the slip days used by the course students from the build dates of their submission attempts
+	// and the current deadlines, and report the changes. The changes are only saved if requested.
+	RecomputeSlipDays(context.Context, *SlipDaysRequest) (*SlipDaysRecomputation, error)
 	GetEnrollmentsByUser(context.Context, *EnrollmentStatusRequest) (*Enrollments, error)
 	GetEnrollmentsByCourse(context.Context, *EnrollmentRequest) (*Enrollments, error)
 	CreateEnrollment(context.Context, *Enrollment) (*Void, error)
@@ -496,6 +746,32 @@ type AutograderServiceServer interface {
 	UpdateSubmission(context.Context, *UpdateSubmissionRequest) (*Void, error)
 	UpdateSubmissions(context.Context, *UpdateSubmissionsRequest) (*Void, error)
 	RebuildSubmission(context.Context, *RebuildRequest) (*Submission, error)
+	// Get the attempts of a submission, without their build logs.
+	GetSubmissionAttempts(context.Context, *SubmissionAttemptRequest) (*SubmissionAttempts, error)
+	// Get an attempt of a submission, including its build log.
+	GetSubmissionAttempt(context.Context, *SubmissionAttemptRequest) (*SubmissionAttempt, error)
+	// Make the given attempt the graded attempt of its submission.
+	UpdateGradedAttempt(context.Context, *SubmissionAttemptRequest) (*Submission, error)
+	// Stream the course submissions as they are created or updated.
+	// Teachers receive all course submissions; students receive their own and their group's submissions.
+	SubmissionStream(*CourseRequest, AutograderService_SubmissionStreamServer) error
+	// Get the test case statistics of an assignment, ordered by decreasing number of failures.
+	GetTestStatistics(context.Context, *TestStatisticsRequest) (*TestStatistics, error)
+	// Get the score distributions, approval rates, slip day usage and push activity of the course assignments.
+	GetCourseStatistics(context.Context, *CourseRequest) (*CourseStatistics, error)
+	// Export the course gradebook with the scores, approvals, slip days and reviews of all students or groups.
+	ExportGradebook(context.Context, *GradebookRequest) (*GradebookFile, error)
+	GetBuildJobs(context.Context, *CourseRequest) (*BuildJobs, error)
+	CancelBuildJob(context.Context, *BuildJobRequest) (*Void, error)
+	// Stream the output of the queued and running builds of a commit until the builds end.
+	BuildLogStream(*BuildLogRequest, AutograderService_BuildLogStreamServer) error
+	// Get the build quotas of the repositories with recent push builds for an assignment.
+	GetBuildQuotas(context.Context, *BuildQuotaRequest) (*BuildQuotas, error)
+	// Reset the build quota of a repository for an assignment, starting any delayed build right away.
+	ResetBuildQuota(context.Context, *BuildQuotaRequest) (*Void, error)
+	// Compare the latest submissions of an assignment and store the similar pairs.
+	CheckSimilarity(context.Context, *SimilarityRequest) (*SimilarityPairs, error)
+	GetSimilarityPairs(context.Context, *SimilarityRequest) (*SimilarityPairs, error)
 	// manual grading //
 	CreateBenchmark(context.Context, *GradingBenchmark) (*GradingBenchmark, error)
 	UpdateBenchmark(context.Context, *GradingBenchmark) (*Void, error)
@@ -575,6 +851,18 @@ func (UnimplementedAutograderServiceServer) GetAssignments(context.Context, *Cou
 func (UnimplementedAutograderServiceServer) UpdateAssignments(context.Context, *CourseRequest) (*Void, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateAssignments not implemented")
 }
+func (UnimplementedAutograderServiceServer) GetDeadlineExtensions(context.Context, *CourseRequest) (*DeadlineExtensions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeadlineExtensions not implemented")
+}
+func (UnimplementedAutograderServiceServer) GrantDeadlineExtension(context.Context, *DeadlineExtensionRequest) (*DeadlineExtension, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantDeadlineExtension not implemented")
+}
+func (UnimplementedAutograderServiceServer) RevokeDeadlineExtension(context.Context, *DeadlineExtensionRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeDeadlineExtension not implemented")
+}
+func (UnimplementedAutograderServiceServer) RecomputeSlipDays(context.Context, *SlipDaysRequest) (*SlipDaysRecomputation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecomputeSlipDays not implemented")
+}
 func (UnimplementedAutograderServiceServer) GetEnrollmentsByUser(context.Context, *EnrollmentStatusRequest) (*Enrollments, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEnrollmentsByUser not implemented")
 }
@@ -605,6 +893,48 @@ func (UnimplementedAutograderServiceServer) UpdateSubmissions(context.Context, *
 func (UnimplementedAutograderServiceServer) RebuildSubmission(context.Context, *RebuildRequest) (*Submission, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RebuildSubmission not implemented")
 }
+func (UnimplementedAutograderServiceServer) GetSubmissionAttempts(context.Context, *SubmissionAttemptRequest) (*SubmissionAttempts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubmissionAttempts not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetSubmissionAttempt(context.Context, *SubmissionAttemptRequest) (*SubmissionAttempt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubmissionAttempt not implemented")
+}
+func (UnimplementedAutograderServiceServer) UpdateGradedAttempt(context.Context, *SubmissionAttemptRequest) (*Submission, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGradedAttempt not implemented")
+}
+func (UnimplementedAutograderServiceServer) SubmissionStream(*CourseRequest, AutograderService_SubmissionStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubmissionStream not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetTestStatistics(context.Context, *TestStatisticsRequest) (*TestStatistics, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTestStatistics not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetCourseStatistics(context.Context, *CourseRequest) (*CourseStatistics, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseStatistics not implemented")
+}
+func (UnimplementedAutograderServiceServer) ExportGradebook(context.Context, *GradebookRequest) (*GradebookFile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportGradebook not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetBuildJobs(context.Context, *CourseRequest) (*BuildJobs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBuildJobs not implemented")
+}
+func (UnimplementedAutograderServiceServer) CancelBuildJob(context.Context, *BuildJobRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBuildJob not implemented")
+}
+func (UnimplementedAutograderServiceServer) BuildLogStream(*BuildLogRequest, AutograderService_BuildLogStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method BuildLogStream not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetBuildQuotas(context.Context, *BuildQuotaRequest) (*BuildQuotas, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBuildQuotas not implemented")
+}
+func (UnimplementedAutograderServiceServer) ResetBuildQuota(context.Context, *BuildQuotaRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetBuildQuota not implemented")
+}
+func (UnimplementedAutograderServiceServer) CheckSimilarity(context.Context, *SimilarityRequest) (*SimilarityPairs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckSimilarity not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetSimilarityPairs(context.Context, *SimilarityRequest) (*SimilarityPairs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSimilarityPairs not implemented")
+}
 func (UnimplementedAutograderServiceServer) CreateBenchmark(context.Context, *GradingBenchmark) (*GradingBenchmark, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBenchmark not implemented")
 }
@@ -1002,6 +1332,78 @@ func _AutograderService_UpdateAssignments_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AutograderService_GetDeadlineExtensions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetDeadlineExtensions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetDeadlineExtensions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetDeadlineExtensions(ctx, req.(*CourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GrantDeadlineExtension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeadlineExtensionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GrantDeadlineExtension(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GrantDeadlineExtension",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GrantDeadlineExtension(ctx, req.(*DeadlineExtensionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_RevokeDeadlineExtension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeadlineExtensionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).RevokeDeadlineExtension(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/RevokeDeadlineExtension",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).RevokeDeadlineExtension(ctx, req.(*DeadlineExtensionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_RecomputeSlipDays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlipDaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).RecomputeSlipDays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/RecomputeSlipDays",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).RecomputeSlipDays(ctx, req.(*SlipDaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AutograderService_GetEnrollmentsByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EnrollmentStatusRequest)
 	if err := dec(in); err != nil {
@@ -1182,6 +1584,264 @@ func _AutograderService_RebuildSubmission_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AutograderService_GetSubmissionAttempts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmissionAttemptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetSubmissionAttempts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetSubmissionAttempts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetSubmissionAttempts(ctx, req.(*SubmissionAttemptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetSubmissionAttempt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmissionAttemptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetSubmissionAttempt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetSubmissionAttempt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetSubmissionAttempt(ctx, req.(*SubmissionAttemptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_UpdateGradedAttempt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmissionAttemptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateGradedAttempt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/UpdateGradedAttempt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateGradedAttempt(ctx, req.(*SubmissionAttemptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_SubmissionStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CourseRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AutograderServiceServer).SubmissionStream(m, &autograderServiceSubmissionStreamServer{stream})
+}
+
+type AutograderService_SubmissionStreamServer interface {
+	Send(*Submission) error
+	grpc.ServerStream
+}
+
+type autograderServiceSubmissionStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *autograderServiceSubmissionStreamServer) Send(m *Submission) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AutograderService_GetTestStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetTestStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetTestStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetTestStatistics(ctx, req.(*TestStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetCourseStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetCourseStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetCourseStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetCourseStatistics(ctx, req.(*CourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_ExportGradebook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradebookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).ExportGradebook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/ExportGradebook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).ExportGradebook(ctx, req.(*GradebookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetBuildJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetBuildJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetBuildJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetBuildJobs(ctx, req.(*CourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_CancelBuildJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).CancelBuildJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/CancelBuildJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).CancelBuildJob(ctx, req.(*BuildJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_BuildLogStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BuildLogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AutograderServiceServer).BuildLogStream(m, &autograderServiceBuildLogStreamServer{stream})
+}
+
+type AutograderService_BuildLogStreamServer interface {
+	Send(*BuildLogLine) error
+	grpc.ServerStream
+}
+
+type autograderServiceBuildLogStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *autograderServiceBuildLogStreamServer) Send(m *BuildLogLine) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AutograderService_GetBuildQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetBuildQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetBuildQuotas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetBuildQuotas(ctx, req.(*BuildQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_ResetBuildQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).ResetBuildQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/ResetBuildQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).ResetBuildQuota(ctx, req.(*BuildQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_CheckSimilarity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimilarityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).CheckSimilarity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/CheckSimilarity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).CheckSimilarity(ctx, req.(*SimilarityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetSimilarityPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimilarityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetSimilarityPairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetSimilarityPairs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetSimilarityPairs(ctx, req.(*SimilarityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AutograderService_CreateBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GradingBenchmark)
 	if err := dec(in); err != nil {
@@ -1517,6 +2177,22 @@ var AutograderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateAssignments",
 			Handler:    _AutograderService_UpdateAssignments_Handler,
 		},
+		{
+			MethodName: "GetDeadlineExtensions",
+			Handler:    _AutograderService_GetDeadlineExtensions_Handler,
+		},
+		{
+			MethodName: "GrantDeadlineExtension",
+			Handler:    _AutograderService_GrantDeadlineExtension_Handler,
+		},
+		{
+			MethodName: "RevokeDeadlineExtension",
+			Handler:    _AutograderService_RevokeDeadlineExtension_Handler,
+		},
+		{
+			MethodName: "RecomputeSlipDays",
+			Handler:    _AutograderService_RecomputeSlipDays_Handler,
+		},
 		{
 			MethodName: "GetEnrollmentsByUser",
 			Handler:    _AutograderService_GetEnrollmentsByUser_Handler,
@@ -1557,6 +2233,54 @@ var AutograderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RebuildSubmission",
 			Handler:    _AutograderService_RebuildSubmission_Handler,
 		},
+		{
+			MethodName: "GetSubmissionAttempts",
+			Handler:    _AutograderService_GetSubmissionAttempts_Handler,
+		},
+		{
+			MethodName: "GetSubmissionAttempt",
+			Handler:    _AutograderService_GetSubmissionAttempt_Handler,
+		},
+		{
+			MethodName: "UpdateGradedAttempt",
+			Handler:    _AutograderService_UpdateGradedAttempt_Handler,
+		},
+		{
+			MethodName: "GetTestStatistics",
+			Handler:    _AutograderService_GetTestStatistics_Handler,
+		},
+		{
+			MethodName: "GetCourseStatistics",
+			Handler:    _AutograderService_GetCourseStatistics_Handler,
+		},
+		{
+			MethodName: "ExportGradebook",
+			Handler:    _AutograderService_ExportGradebook_Handler,
+		},
+		{
+			MethodName: "GetBuildJobs",
+			Handler:    _AutograderService_GetBuildJobs_Handler,
+		},
+		{
+			MethodName: "CancelBuildJob",
+			Handler:    _AutograderService_CancelBuildJob_Handler,
+		},
+		{
+			MethodName: "GetBuildQuotas",
+			Handler:    _AutograderService_GetBuildQuotas_Handler,
+		},
+		{
+			MethodName: "ResetBuildQuota",
+			Handler:    _AutograderService_ResetBuildQuota_Handler,
+		},
+		{
+			MethodName: "CheckSimilarity",
+			Handler:    _AutograderService_CheckSimilarity_Handler,
+		},
+		{
+			MethodName: "GetSimilarityPairs",
+			Handler:    _AutograderService_GetSimilarityPairs_Handler,
+		},
 		{
 			MethodName: "CreateBenchmark",
 			Handler:    _AutograderService_CreateBenchmark_Handler,
@@ -1614,6 +2338,17 @@ var AutograderService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _AutograderService_IsEmptyRepo_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubmissionStream",
+			Handler:       _AutograderService_SubmissionStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "BuildLogStream",
+			Handler:       _AutograderService_BuildLogStream_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "ag/ag.proto",
 }
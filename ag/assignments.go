@@ -1,6 +1,7 @@
 package ag
 
 import (
+	"strings"
 	"time"
 )
 
@@ -33,6 +34,39 @@ func (m *Assignment) IsApproved(latest *Submission, score uint32) bool {
 	return approved
 }
 
+// HiddenTestPaths returns the paths of the assignment's hidden test files and directories,
+// relative to the assignment's folder in the tests repository.
+func (m *Assignment) HiddenTestPaths() []string {
+	var paths []string
+	for _, path := range strings.Split(m.GetHiddenTests(), ",") {
+		if path = strings.TrimSpace(path); path != "" {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+// RunsHiddenTests returns true if the assignment's hidden tests are run
+// in deadline builds if deadline is true, or in other builds otherwise.
+func (m *Assignment) RunsHiddenTests(deadline bool) bool {
+	return m.GetHiddenTests() != "" && (deadline || !m.GetHiddenAtDeadline())
+}
+
+// BuildIntervalDuration returns the minimum duration between the builds of pushes
+// to a repository for the assignment, which is zero unless specified by the assignment.
+func (m *Assignment) BuildIntervalDuration() time.Duration {
+	interval, err := time.ParseDuration(m.GetBuildInterval())
+	if err != nil || interval < 0 {
+		return zero
+	}
+	return interval
+}
+
+// HasBuildQuota returns true if the assignment limits the builds of pushes to a repository.
+func (m *Assignment) HasBuildQuota() bool {
+	return m.GetBuildsPerHour() > 0 || m.BuildIntervalDuration() > 0
+}
+
 // CloneWithoutSubmissions returns a deep copy of the given assignment
 // without submissions
 func (a *Assignment) CloneWithoutSubmissions() *Assignment {
@@ -49,5 +83,22 @@ func (a *Assignment) CloneWithoutSubmissions() *Assignment {
 		Reviewers:         a.Reviewers,
 		SkipTests:         a.SkipTests,
 		GradingBenchmarks: a.GradingBenchmarks,
+		LatePolicy:        a.LatePolicy,
+		LatePenalty:       a.LatePenalty,
+		FreezeAtDeadline:  a.FreezeAtDeadline,
+		FinalBuild:        a.FinalBuild,
+		ReleaseDate:       a.ReleaseDate,
+		ReleasedDate:      a.ReleasedDate,
+		HiddenTests:       a.HiddenTests,
+		HiddenAtDeadline:  a.HiddenAtDeadline,
+		BuildsPerHour:     a.BuildsPerHour,
+		BuildInterval:     a.BuildInterval,
+		MemoryLimit:       a.MemoryLimit,
+		CpuLimit:          a.CpuLimit,
+		PidsLimit:         a.PidsLimit,
+		DiskLimit:         a.DiskLimit,
+		NetworkDisabled:   a.NetworkDisabled,
+		ReadOnlyRoot:      a.ReadOnlyRoot,
+		Pipeline:          a.Pipeline,
 	}
 }
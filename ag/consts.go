@@ -4,4 +4,6 @@ package ag
 
 const (
 	TimeLayout = "2006-01-02T15:04:05"
+	// DateLayout is the date part of TimeLayout, used for the last activity date of enrollments.
+	DateLayout = "2006-01-02"
 )
@@ -1,5 +1,11 @@
 package ag
 
+import "time"
+
+// defaultGracePeriod is the grace period for submissions after the deadline,
+// for courses that do not specify their grace period.
+const defaultGracePeriod = 2 * time.Hour
+
 // cache of access tokens for courses; they are cached here when fetching from database
 var accessTokens = make(map[uint64]string)
 
@@ -23,3 +29,13 @@ func (course *Course) SetSlipDays() {
 		g.SetSlipDays(course)
 	}
 }
+
+// GracePeriodDuration returns the grace period after deadlines in which submissions
+// do not use slip days. The grace period is two hours unless specified by the course.
+func (course *Course) GracePeriodDuration() time.Duration {
+	gracePeriod, err := time.ParseDuration(course.GetGracePeriod())
+	if err != nil {
+		return defaultGracePeriod
+	}
+	return gracePeriod
+}
@@ -0,0 +1,26 @@
+package ag
+
+import "time"
+
+// AppliesTo returns true if this extension is for the given assignment and
+// the student or group of the given enrollment.
+func (m *DeadlineExtension) AppliesTo(enrollment *Enrollment, assignmentID uint64) bool {
+	if m.GetAssignmentID() != assignmentID {
+		return false
+	}
+	if m.GetGroupID() > 0 {
+		return m.GetGroupID() == enrollment.GetGroupID()
+	}
+	return m.GetUserID() == enrollment.GetUserID()
+}
+
+// SinceDeadline returns the duration since the extended deadline.
+// A positive duration means the deadline has passed, whereas
+// a negative duration means the deadline has not yet passed.
+func (m *DeadlineExtension) SinceDeadline(now time.Time) (time.Duration, error) {
+	deadline, err := time.ParseInLocation(TimeLayout, m.GetDeadline(), now.Location())
+	if err != nil {
+		return zero, err
+	}
+	return now.Sub(deadline), nil
+}
@@ -0,0 +1,7 @@
+package ag
+
+// Freezes returns true if builds of the given commit are not graded
+// because the graded attempt is frozen at this snapshot.
+func (m *DeadlineSnapshot) Freezes(commitHash string) bool {
+	return m.GetFrozen() && m.GetCommitHash() != commitHash
+}
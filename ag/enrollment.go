@@ -5,36 +5,51 @@ import (
 	"time"
 )
 
-// gracePeriod is the grace period for submissions after the deadline.
-// The grace period should be hours in the range 0-23.
-// Note grace period applies to all enrollments (courses).
-const gracePeriod time.Duration = time.Duration(2 * time.Hour)
-
 // UpdateSlipDays updates the number of slipdays for the given assignment/submission.
-func (m *Enrollment) UpdateSlipDays(start time.Time, assignment *Assignment, submission *Submission) error {
+// The slip days are counted from the enrollment's deadline for the assignment, taking
+// the given deadline extensions into account, after the grace period of the course.
+func (m *Enrollment) UpdateSlipDays(start time.Time, assignment *Assignment, submission *Submission, extensions ...*DeadlineExtension) error {
 	if m.GetCourseID() != assignment.GetCourseID() {
 		return fmt.Errorf("invariant violation (enrollment.CourseID != assignment.CourseID) (%d != %d)", m.CourseID, assignment.CourseID)
 	}
 	if assignment.GetID() != submission.GetAssignmentID() {
 		return fmt.Errorf("invariant violation (assignment.ID != submission.AssignmentID) (%d != %d)", assignment.ID, submission.AssignmentID)
 	}
-	sinceDeadline, err := assignment.SinceDeadline(start)
+	sinceDeadline, err := m.SinceDeadline(start, assignment, extensions)
 	if err != nil {
 		return err
 	}
 	// if score is less than limit and it's not yet approved, update slip days if deadline has passed
 	if submission.Score < assignment.ScoreLimit && submission.Status != Submission_APPROVED && sinceDeadline > 0 {
 		// deadline exceeded; calculate used slipdays for this assignment
-		slpDays, slpHours := uint32(sinceDeadline/days), sinceDeadline%days
-		// slpHours is hours after deadline, excluding subsequent full-day slipdays after deadline
-		if slpHours > gracePeriod {
-			slpDays++
-		}
-		m.updateSlipDays(assignment.GetID(), slpDays)
+		m.updateSlipDays(assignment.GetID(), lateDays(sinceDeadline, m.GetCourse().GracePeriodDuration()))
 	}
 	return nil
 }
 
+// SinceDeadline returns the duration since this enrollment's deadline for the given
+// assignment, which is the latest of the assignment's deadline and the deadlines of
+// the given extensions that apply to this enrollment.
+func (m *Enrollment) SinceDeadline(now time.Time, assignment *Assignment, extensions []*DeadlineExtension) (time.Duration, error) {
+	sinceDeadline, err := assignment.SinceDeadline(now)
+	if err != nil {
+		return zero, err
+	}
+	for _, extension := range extensions {
+		if !extension.AppliesTo(m, assignment.GetID()) {
+			continue
+		}
+		sinceExtension, err := extension.SinceDeadline(now)
+		if err != nil {
+			return zero, err
+		}
+		if sinceExtension < sinceDeadline {
+			sinceDeadline = sinceExtension
+		}
+	}
+	return sinceDeadline, nil
+}
+
 // updateSlipDays updates the number of slipdays for the given assignment.
 func (m *Enrollment) updateSlipDays(assignmentID uint64, slipDays uint32) {
 	for _, val := range m.GetUsedSlipDays() {
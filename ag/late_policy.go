@@ -0,0 +1,80 @@
+package ag
+
+import "time"
+
+// maxPenalty is the largest percentage of a score that can be deducted.
+const maxPenalty = 100
+
+// EffectiveLatePolicy returns the late policy and the penalty per day late of this
+// assignment, which are the given course's unless the assignment specifies its own
+// late policy. Courses that do not specify their late policy use slip days.
+func (m *Assignment) EffectiveLatePolicy(course *Course) (Course_LatePolicy, uint32) {
+	if m.GetLatePolicy() != Course_DEFAULT {
+		return m.GetLatePolicy(), m.GetLatePenalty()
+	}
+	if course.GetLatePolicy() == Course_DEFAULT {
+		return Course_SLIP_DAYS, course.GetLatePenalty()
+	}
+	return course.GetLatePolicy(), course.GetLatePenalty()
+}
+
+// UsesSlipDays returns true if late submissions of this assignment use slip days.
+func (m *Assignment) UsesSlipDays(course *Course) bool {
+	policy, _ := m.EffectiveLatePolicy(course)
+	return policy == Course_SLIP_DAYS
+}
+
+// PenaltyAt returns the percentage of the score deducted from a submission of this
+// assignment built at the given time by the student or group of the given enrollment.
+// The submission is late if it is built after the enrollment's deadline, taking the
+// given extensions into account, by more than the grace period of the enrollment's course.
+// No percentage is deducted from assignments using slip days.
+func (m *Assignment) PenaltyAt(built time.Time, enrollment *Enrollment, extensions []*DeadlineExtension) (uint32, error) {
+	course := enrollment.GetCourse()
+	policy, penalty := m.EffectiveLatePolicy(course)
+	if policy == Course_SLIP_DAYS {
+		return 0, nil
+	}
+	sinceDeadline, err := enrollment.SinceDeadline(built, m, extensions)
+	if err != nil {
+		return 0, err
+	}
+	gracePeriod := course.GracePeriodDuration()
+	if sinceDeadline <= gracePeriod {
+		return 0, nil
+	}
+	var percent uint32
+	switch policy {
+	case Course_LINEAR_PENALTY:
+		// prorated by the started hour since the deadline
+		hours := uint32((sinceDeadline + time.Hour - 1) / time.Hour)
+		percent = penalty * hours / 24
+	case Course_STEP_PENALTY:
+		percent = penalty * lateDays(sinceDeadline, gracePeriod)
+	case Course_HARD_CUTOFF:
+		percent = maxPenalty
+	}
+	if percent > maxPenalty {
+		percent = maxPenalty
+	}
+	return percent, nil
+}
+
+// PenalizedScore returns the given score with the given percentage deducted.
+func PenalizedScore(score, penalty uint32) uint32 {
+	if penalty >= maxPenalty {
+		return 0
+	}
+	return score * (maxPenalty - penalty) / maxPenalty
+}
+
+// lateDays returns the number of days since the deadline, where the last day
+// is only counted if it is started by more than the grace period.
+func lateDays(sinceDeadline, gracePeriod time.Duration) uint32 {
+	lateDays, lateHours := uint32(sinceDeadline/days), sinceDeadline%days
+	// lateHours is the time after the deadline, excluding subsequent full days after the deadline
+	if lateHours > gracePeriod {
+		lateDays++
+	}
+	return lateDays
+}
@@ -0,0 +1,139 @@
+package ag_test
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+func TestEffectiveLatePolicy(t *testing.T) {
+	tests := []struct {
+		name        string
+		course      *pb.Course
+		assignment  *pb.Assignment
+		wantPolicy  pb.Course_LatePolicy
+		wantPenalty uint32
+	}{
+		{
+			name:       "course without policy",
+			course:     &pb.Course{},
+			assignment: &pb.Assignment{},
+			wantPolicy: pb.Course_SLIP_DAYS,
+		},
+		{
+			name:        "course policy",
+			course:      &pb.Course{LatePolicy: pb.Course_LINEAR_PENALTY, LatePenalty: 10},
+			assignment:  &pb.Assignment{},
+			wantPolicy:  pb.Course_LINEAR_PENALTY,
+			wantPenalty: 10,
+		},
+		{
+			name:        "assignment policy",
+			course:      &pb.Course{LatePolicy: pb.Course_LINEAR_PENALTY, LatePenalty: 10},
+			assignment:  &pb.Assignment{LatePolicy: pb.Course_STEP_PENALTY, LatePenalty: 25},
+			wantPolicy:  pb.Course_STEP_PENALTY,
+			wantPenalty: 25,
+		},
+		{
+			name:       "assignment using slip days",
+			course:     &pb.Course{LatePolicy: pb.Course_HARD_CUTOFF},
+			assignment: &pb.Assignment{LatePolicy: pb.Course_SLIP_DAYS},
+			wantPolicy: pb.Course_SLIP_DAYS,
+		},
+	}
+	for _, test := range tests {
+		policy, penalty := test.assignment.EffectiveLatePolicy(test.course)
+		if policy != test.wantPolicy || penalty != test.wantPenalty {
+			t.Errorf("%s: EffectiveLatePolicy() = (%v, %d), want (%v, %d)", test.name, policy, penalty, test.wantPolicy, test.wantPenalty)
+		}
+		if got := test.assignment.UsesSlipDays(test.course); got != (test.wantPolicy == pb.Course_SLIP_DAYS) {
+			t.Errorf("%s: UsesSlipDays() = %t, want %t", test.name, got, !got)
+		}
+	}
+}
+
+func TestPenaltyAt(t *testing.T) {
+	lab := a(0)
+	lab.ID = 1
+	timeOfDeadline, err := time.Parse(pb.TimeLayout, lab.Deadline)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		policy      pb.Course_LatePolicy
+		penalty     uint32
+		delivered   time.Time
+		wantPenalty uint32
+	}{
+		{policy: pb.Course_SLIP_DAYS, penalty: 10, delivered: timeOfDeadline.Add(3 * days), wantPenalty: 0},
+		{policy: pb.Course_LINEAR_PENALTY, penalty: 24, delivered: timeOfDeadline.Add(-time.Hour), wantPenalty: 0},
+		{policy: pb.Course_LINEAR_PENALTY, penalty: 24, delivered: timeOfDeadline.Add(time.Hour), wantPenalty: 0},
+		{policy: pb.Course_LINEAR_PENALTY, penalty: 24, delivered: timeOfDeadline.Add(2*time.Hour + time.Minute), wantPenalty: 3},
+		{policy: pb.Course_LINEAR_PENALTY, penalty: 24, delivered: timeOfDeadline.Add(36 * time.Hour), wantPenalty: 36},
+		{policy: pb.Course_LINEAR_PENALTY, penalty: 50, delivered: timeOfDeadline.Add(5 * days), wantPenalty: 100},
+		{policy: pb.Course_STEP_PENALTY, penalty: 20, delivered: timeOfDeadline.Add(time.Hour), wantPenalty: 0},
+		{policy: pb.Course_STEP_PENALTY, penalty: 20, delivered: timeOfDeadline.Add(3 * time.Hour), wantPenalty: 20},
+		{policy: pb.Course_STEP_PENALTY, penalty: 20, delivered: timeOfDeadline.Add(days + time.Hour), wantPenalty: 20},
+		{policy: pb.Course_STEP_PENALTY, penalty: 20, delivered: timeOfDeadline.Add(days + 3*time.Hour), wantPenalty: 40},
+		{policy: pb.Course_HARD_CUTOFF, delivered: timeOfDeadline.Add(time.Hour), wantPenalty: 0},
+		{policy: pb.Course_HARD_CUTOFF, delivered: timeOfDeadline.Add(3 * time.Hour), wantPenalty: 100},
+	}
+	for _, test := range tests {
+		course := &pb.Course{ID: course.ID, LatePolicy: test.policy, LatePenalty: test.penalty}
+		enrol := &pb.Enrollment{Course: course, CourseID: course.ID}
+		got, err := lab.PenaltyAt(test.delivered, enrol, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.wantPenalty {
+			t.Errorf("PenaltyAt(%v) with %v of %d%%: got %d%%, want %d%%", test.delivered.Sub(timeOfDeadline), test.policy, test.penalty, got, test.wantPenalty)
+		}
+	}
+}
+
+func TestPenaltyAtWithExtension(t *testing.T) {
+	lab := a(0)
+	lab.ID = 1
+	timeOfDeadline, err := time.Parse(pb.TimeLayout, lab.Deadline)
+	if err != nil {
+		t.Fatal(err)
+	}
+	course := &pb.Course{ID: course.ID, LatePolicy: pb.Course_HARD_CUTOFF}
+	extensions := []*pb.DeadlineExtension{
+		{AssignmentID: lab.ID, GroupID: 2, Deadline: timeOfDeadline.Add(2 * days).Format(pb.TimeLayout)},
+	}
+	delivered := timeOfDeadline.Add(days)
+	for _, test := range []struct {
+		enrol       *pb.Enrollment
+		wantPenalty uint32
+	}{
+		{enrol: &pb.Enrollment{Course: course, CourseID: course.ID, UserID: 1, GroupID: 2}, wantPenalty: 0},
+		{enrol: &pb.Enrollment{Course: course, CourseID: course.ID, UserID: 1, GroupID: 3}, wantPenalty: 100},
+	} {
+		got, err := lab.PenaltyAt(delivered, test.enrol, extensions)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.wantPenalty {
+			t.Errorf("PenaltyAt() for group %d: got %d%%, want %d%%", test.enrol.GetGroupID(), got, test.wantPenalty)
+		}
+	}
+}
+
+func TestPenalizedScore(t *testing.T) {
+	tests := []struct {
+		score, penalty, want uint32
+	}{
+		{score: 80, penalty: 0, want: 80},
+		{score: 80, penalty: 25, want: 60},
+		{score: 99, penalty: 10, want: 89},
+		{score: 80, penalty: 100, want: 0},
+		{score: 80, penalty: 120, want: 0},
+	}
+	for _, test := range tests {
+		if got := pb.PenalizedScore(test.score, test.penalty); got != test.want {
+			t.Errorf("PenalizedScore(%d, %d) = %d, want %d", test.score, test.penalty, got, test.want)
+		}
+	}
+}
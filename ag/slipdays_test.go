@@ -361,3 +361,71 @@ func TestSlipDaysWGracePeriod(t *testing.T) {
 		})
 	}
 }
+
+func TestSlipDaysWithCourseGracePeriod(t *testing.T) {
+	lab := a(0)
+	lab.ID = 1
+	timeOfDeadline, err := time.Parse(pb.TimeLayout, lab.Deadline)
+	if err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{Status: pb.Submission_NONE, AssignmentID: lab.ID}
+	tests := []struct {
+		gracePeriod  string
+		delivered    time.Time
+		wantSlipDays uint32
+	}{
+		{gracePeriod: "", delivered: timeOfDeadline.Add(2 * time.Hour), wantSlipDays: 0},
+		{gracePeriod: "0s", delivered: timeOfDeadline.Add(time.Second), wantSlipDays: 1},
+		{gracePeriod: "30m", delivered: timeOfDeadline.Add(30 * time.Minute), wantSlipDays: 0},
+		{gracePeriod: "30m", delivered: timeOfDeadline.Add(time.Hour), wantSlipDays: 1},
+		{gracePeriod: "6h", delivered: timeOfDeadline.Add(days + 5*time.Hour), wantSlipDays: 1},
+	}
+	for _, test := range tests {
+		course := &pb.Course{ID: course.ID, SlipDays: 5, GracePeriod: test.gracePeriod}
+		enrol := &pb.Enrollment{Course: course, CourseID: course.ID}
+		if err := enrol.UpdateSlipDays(test.delivered, lab, submission); err != nil {
+			t.Fatal(err)
+		}
+		if got := enrol.RemainingSlipDays(course); got != int32(5-test.wantSlipDays) {
+			t.Errorf("UpdateSlipDays(%v) with grace period %q: used %d slip days, want %d", test.delivered.Sub(timeOfDeadline), test.gracePeriod, 5-got, test.wantSlipDays)
+		}
+	}
+}
+
+func TestSlipDaysWithExtension(t *testing.T) {
+	lab := a(-3)
+	lab.ID = 1
+	extendedDeadline := testNow.Add(-1 * days).Format(pb.TimeLayout)
+	extensions := []*pb.DeadlineExtension{
+		{AssignmentID: lab.ID, UserID: 1, Deadline: extendedDeadline},
+		{AssignmentID: lab.ID, GroupID: 1, Deadline: extendedDeadline},
+		{AssignmentID: lab.ID + 1, UserID: 2, Deadline: extendedDeadline},
+		// extensions cannot shorten the deadline of the assignment
+		{AssignmentID: lab.ID, UserID: 3, Deadline: testNow.Add(-4 * days).Format(pb.TimeLayout)},
+	}
+	submission := &pb.Submission{Status: pb.Submission_NONE, AssignmentID: lab.ID}
+	tests := []struct {
+		name         string
+		enrollment   *pb.Enrollment
+		wantSlipDays int32
+	}{
+		{"student with extension", &pb.Enrollment{UserID: 1}, 1},
+		{"member of group with extension", &pb.Enrollment{UserID: 4, GroupID: 1}, 1},
+		{"student with extension for another assignment", &pb.Enrollment{UserID: 2}, 3},
+		{"student with earlier extension", &pb.Enrollment{UserID: 3}, 3},
+		{"student without extension", &pb.Enrollment{UserID: 5, GroupID: 2}, 3},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			enrol := test.enrollment
+			enrol.Course, enrol.CourseID = course, course.ID
+			if err := enrol.UpdateSlipDays(testNow, lab, submission, extensions...); err != nil {
+				t.Fatal(err)
+			}
+			if got := int32(course.SlipDays) - enrol.RemainingSlipDays(course); got != test.wantSlipDays {
+				t.Errorf("UpdateSlipDays() used %d slip days, want %d", got, test.wantSlipDays)
+			}
+		})
+	}
+}
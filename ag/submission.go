@@ -3,3 +3,42 @@ package ag
 func (s *Submission) IsApproved() bool {
 	return s.GetStatus() == Submission_APPROVED
 }
+
+// HasReview returns true if the submission has a review with the given ID.
+func (s *Submission) HasReview(reviewID uint64) bool {
+	for _, review := range s.GetReviews() {
+		if review.GetID() == reviewID {
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveHiddenResults removes the score, build log and test results of
+// the hidden tests from the submission.
+func (s *Submission) RemoveHiddenResults() {
+	s.HiddenScore = 0
+	s.HiddenScoreObjects = ""
+	s.HiddenBuildInfo = ""
+	s.TestResults = visibleTestResults(s.GetTestResults())
+}
+
+// RemoveHiddenResults removes the score, build log and test results of
+// the hidden tests from the attempt.
+func (a *SubmissionAttempt) RemoveHiddenResults() {
+	a.HiddenScore = 0
+	a.HiddenScoreObjects = ""
+	a.HiddenBuildInfo = ""
+	a.TestResults = visibleTestResults(a.GetTestResults())
+}
+
+// visibleTestResults returns the test results that are not hidden.
+func visibleTestResults(results []*TestResult) []*TestResult {
+	visible := make([]*TestResult, 0, len(results))
+	for _, result := range results {
+		if !result.GetHidden() {
+			visible = append(visible, result)
+		}
+	}
+	return visible
+}
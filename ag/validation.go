@@ -83,7 +83,26 @@ func (c *Course) IsValid() bool {
 		(c.GetProvider() == "github" || c.GetProvider() == "gitlab" || c.GetProvider() == "fake") &&
 		c.GetOrganizationID() != 0 &&
 		c.GetYear() != 0 &&
-		c.GetTag() != ""
+		c.GetTag() != "" &&
+		c.validGracePeriod() &&
+		validLatePolicy(c.GetLatePolicy(), c.GetLatePenalty())
+}
+
+// validGracePeriod returns true if the course's grace period is unspecified
+// or a duration of less than a day, since slip days are counted per day.
+func (c *Course) validGracePeriod() bool {
+	if c.GetGracePeriod() == "" {
+		return true
+	}
+	gracePeriod, err := time.ParseDuration(c.GetGracePeriod())
+	return err == nil && gracePeriod >= 0 && gracePeriod < days
+}
+
+// validLatePolicy returns true if the late policy is known and
+// the penalty per day late is at most the whole score.
+func validLatePolicy(policy Course_LatePolicy, penalty uint32) bool {
+	_, ok := Course_LatePolicy_name[int32(policy)]
+	return ok && penalty <= maxPenalty
 }
 
 // IsValid checks required fields of a user request
@@ -211,6 +230,12 @@ func (req *SubmissionReviewersRequest) IsValid() bool {
 	return req.CourseID > 0 && req.SubmissionID > 0
 }
 
+// IsValid ensures that course ID and submission ID are present.
+// The attempt ID is only required by the requests for a single attempt.
+func (req *SubmissionAttemptRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetSubmissionID() > 0
+}
+
 // IsValid ensures that a review always has a reviewer and a submission IDs.
 func (r *Review) IsValid() bool {
 	return r.ReviewerID > 0 && r.SubmissionID > 0
@@ -221,6 +246,25 @@ func (r *ReviewRequest) IsValid() bool {
 	return r.CourseID > 0 && r.Review.IsValid()
 }
 
+// IsValid ensures that a deadline extension is for an assignment and either a student
+// or a group, and that the extended deadline is a valid time.
+func (m *DeadlineExtension) IsValid() bool {
+	_, err := time.Parse(TimeLayout, m.GetDeadline())
+	return m.GetAssignmentID() > 0 &&
+		(m.GetUserID() > 0) != (m.GetGroupID() > 0) &&
+		err == nil
+}
+
+// IsValid ensures that course ID is provided and the deadline extension is valid.
+func (r *DeadlineExtensionRequest) IsValid() bool {
+	return r.GetCourseID() > 0 && r.GetExtension().IsValid()
+}
+
+// IsValid ensures that course ID is provided.
+func (r *SlipDaysRequest) IsValid() bool {
+	return r.GetCourseID() > 0
+}
+
 // IsValid ensures that a grading benchmark always belongs to an assignment
 // and is not empty.
 func (bm *GradingBenchmark) IsValid() bool {
@@ -237,3 +281,34 @@ func (c *GradingCriterion) IsValid() bool {
 func (r *CourseUserRequest) IsValid() bool {
 	return r.CourseCode != "" && r.UserLogin != "" && r.CourseYear > 2019
 }
+
+// IsValid ensures that both course and job IDs are set
+func (req *BuildJobRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetJobID() > 0
+}
+
+// IsValid ensures that course ID and assignment ID are provided
+func (req *BuildQuotaRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetAssignmentID() > 0
+}
+
+// IsValid ensures that course ID and commit ID are provided
+func (req *BuildLogRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetCommitID() != ""
+}
+
+// IsValid ensures that course ID and assignment ID are provided
+func (req *SimilarityRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetAssignmentID() > 0
+}
+
+// IsValid ensures that course ID is provided and that the format is known
+func (req *GradebookRequest) IsValid() bool {
+	_, ok := GradebookRequest_Format_name[int32(req.GetFormat())]
+	return req.GetCourseID() > 0 && ok
+}
+
+// IsValid ensures that course ID and assignment ID are provided
+func (req *TestStatisticsRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetAssignmentID() > 0
+}
@@ -0,0 +1,256 @@
+package agent
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/autograde/quickfeed/ci"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// registerRetryInterval is the time to wait before registering again after the server lost the agent.
+const registerRetryInterval = 5 * time.Second
+
+// AgentOptions holds the options of a build agent.
+type AgentOptions struct {
+	Name     string   // name of the agent, shown in the server's logs
+	Capacity int      // maximum number of jobs to run at the same time; default 1
+	Images   []string // images of the jobs to run; all images if empty
+}
+
+// Agent is a build agent that runs the jobs of a QuickFeed server with a local runner.
+type Agent struct {
+	logger *zap.SugaredLogger
+	client AgentServiceClient
+	runner ci.Runner
+	opts   AgentOptions
+
+	mu      sync.Mutex
+	running map[string]context.CancelFunc // cancels the running jobs by ID
+}
+
+// NewAgent returns a build agent that runs the jobs of the server at the other end of the
+// given connection with the given runner. The connection must add an agent token to the
+// requests of the agent; see TokenCredentials.
+func NewAgent(logger *zap.SugaredLogger, conn grpc.ClientConnInterface, runner ci.Runner, opts AgentOptions) *Agent {
+	if opts.Capacity <= 0 {
+		opts.Capacity = 1
+	}
+	return &Agent{
+		logger:  logger,
+		client:  NewAgentServiceClient(conn),
+		runner:  runner,
+		opts:    opts,
+		running: make(map[string]context.CancelFunc),
+	}
+}
+
+// Run registers the agent with the server and runs the jobs assigned to it until the given
+// context is done. If the server loses the agent, the agent stops its jobs and registers again.
+func (a *Agent) Run(ctx context.Context) error {
+	for {
+		err := a.session(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		a.logger.Errorf("Build agent session ended: %v", err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(registerRetryInterval):
+		}
+	}
+}
+
+// session registers the agent and runs the jobs assigned to it until the server loses the agent
+// or the given context is done. The agent pulls a job for each unit of its capacity.
+func (a *Agent) session(ctx context.Context) error {
+	resp, err := a.client.Register(ctx, &RegisterRequest{
+		Name:     a.opts.Name,
+		Capacity: uint32(a.opts.Capacity),
+		Images:   a.opts.Images,
+	})
+	if err != nil {
+		return err
+	}
+	id, interval := resp.GetAgentID(), time.Duration(resp.GetHeartbeatInterval())*time.Millisecond
+	a.logger.Infof("Registered build agent %s (%s)", a.opts.Name, id)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	errs := make(chan error, a.opts.Capacity+1)
+	var wg sync.WaitGroup
+	for i := 0; i < a.opts.Capacity; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- a.work(ctx, id, interval)
+		}()
+	}
+	go func() {
+		errs <- a.heartbeat(ctx, id, interval)
+	}()
+	err = <-errs
+	cancel()
+	wg.Wait()
+	return err
+}
+
+// heartbeat sends heartbeats to the server at the given interval, and stops the jobs the
+// server has canceled, until the server loses the agent or the given context is done.
+func (a *Agent) heartbeat(ctx context.Context, id string, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+		resp, err := a.client.Heartbeat(ctx, &HeartbeatRequest{AgentID: id, JobIDs: a.jobIDs()})
+		if err != nil {
+			if lost(err) {
+				return err
+			}
+			a.logger.Errorf("Failed to send heartbeat: %v", err)
+			continue
+		}
+		for _, jobID := range resp.GetCanceledJobIDs() {
+			a.cancel(jobID)
+		}
+	}
+}
+
+// work pulls jobs from the server and runs them one at a time,
+// until the server loses the agent or the given context is done.
+func (a *Agent) work(ctx context.Context, id string, interval time.Duration) error {
+	for {
+		resp, err := a.client.PullJob(ctx, &PullJobRequest{AgentID: id})
+		if err != nil {
+			if ctx.Err() != nil || lost(err) {
+				return err
+			}
+			a.logger.Errorf("Failed to pull job: %v", err)
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(interval):
+			}
+			continue
+		}
+		if job := resp.GetJob(); job != nil {
+			a.runJob(ctx, id, job)
+		}
+	}
+}
+
+// lost returns true if the given error means that the server does not accept the agent's requests.
+func lost(err error) bool {
+	switch status.Code(err) {
+	case codes.NotFound, codes.PermissionDenied, codes.Unauthenticated, codes.InvalidArgument:
+		return true
+	}
+	return false
+}
+
+// runJob runs the given job, and streams its output and result to the server.
+// The job is stopped if it times out, if the server cancels it, or if the given context is done.
+func (a *Agent) runJob(ctx context.Context, id string, job *Job) {
+	var jobCtx context.Context
+	var cancel context.CancelFunc
+	if job.GetTimeout() > 0 {
+		jobCtx, cancel = context.WithTimeout(ctx, time.Duration(job.GetTimeout())*time.Millisecond)
+	} else {
+		jobCtx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+	a.mu.Lock()
+	a.running[job.GetID()] = cancel
+	a.mu.Unlock()
+	defer func() {
+		a.mu.Lock()
+		delete(a.running, job.GetID())
+		a.mu.Unlock()
+	}()
+
+	// the result is reported with the agent's context, so that it is reported if the job times out
+	stream, err := a.client.ReportJob(ctx)
+	if err != nil {
+		a.logger.Errorf("Failed to report %s: %v", job.GetName(), err)
+		return
+	}
+	var mu sync.Mutex
+	send := func(update *JobUpdate) error {
+		mu.Lock()
+		defer mu.Unlock()
+		update.AgentID, update.JobID = id, job.GetID()
+		return stream.Send(update)
+	}
+	emit := func(line string) {
+		// a failure to send the line is reported when the result is sent
+		_ = send(&JobUpdate{Update: &JobUpdate_Line{Line: line}})
+	}
+
+	a.logger.Infof("Running %s", job.GetName())
+	result := a.run(jobCtx, job, emit)
+	if ctx.Err() != nil {
+		// the agent stopped the job; the server assigns it to another agent when it has lost this agent
+		return
+	}
+	if err := send(&JobUpdate{Update: &JobUpdate_Result{Result: result}}); err != nil {
+		a.logger.Errorf("Failed to report %s: %v", job.GetName(), err)
+		return
+	}
+	if _, err := stream.CloseAndRecv(); err != nil {
+		a.logger.Errorf("Failed to report %s: %v", job.GetName(), err)
+	}
+}
+
+// run runs the given job with the agent's runner and returns its result.
+func (a *Agent) run(ctx context.Context, job *Job, emit func(line string)) *JobResult {
+	result := &JobResult{}
+	var err error
+	if len(job.GetSteps()) > 0 {
+		pr, ok := a.runner.(ci.PipelineRunner)
+		if !ok {
+			result.Error = "build agent cannot run pipelines"
+			return result
+		}
+		var steps []*ci.StepResult
+		steps, err = pr.RunSteps(ctx, job.ciJob(), emit)
+		result.Steps = newStepResults(steps)
+	} else if sr, ok := a.runner.(ci.StreamingRunner); ok {
+		result.Output, err = sr.RunStream(ctx, job.ciJob(), emit)
+	} else {
+		result.Output, err = a.runner.Run(ctx, job.ciJob())
+	}
+	if err != nil {
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// jobIDs returns the IDs of the running jobs.
+func (a *Agent) jobIDs() []string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	ids := make([]string, 0, len(a.running))
+	for id := range a.running {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// cancel stops the running job with the given ID.
+func (a *Agent) cancel(id string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if cancel, ok := a.running[id]; ok {
+		a.logger.Infof("Canceling job %s", id)
+		cancel()
+	}
+}
@@ -0,0 +1,89 @@
+package agent
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// TokenKey is the metadata key of the agent token in the requests of agents.
+const TokenKey = "agent-token"
+
+type tokenKey struct{}
+
+// verifyToken returns a context with the agent token in the metadata of the given context,
+// or an error if the metadata does not hold one of the given tokens.
+func verifyToken(ctx context.Context, tokens []string) (context.Context, error) {
+	meta, _ := metadata.FromIncomingContext(ctx)
+	for _, token := range meta.Get(TokenKey) {
+		for _, valid := range tokens {
+			if subtle.ConstantTimeCompare([]byte(token), []byte(valid)) == 1 {
+				return context.WithValue(ctx, tokenKey{}, valid), nil
+			}
+		}
+	}
+	return nil, status.Error(codes.Unauthenticated, "request does not contain a valid agent token")
+}
+
+// contextToken returns the agent token verified for the request with the given context.
+func contextToken(ctx context.Context) string {
+	token, _ := ctx.Value(tokenKey{}).(string)
+	return token
+}
+
+// UnaryTokenVerifier returns an interceptor that rejects the requests without one of the given agent tokens.
+func UnaryTokenVerifier(tokens []string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := verifyToken(ctx, tokens)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamTokenVerifier returns an interceptor that rejects the streams without one of the given agent tokens.
+func StreamTokenVerifier(tokens []string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := verifyToken(ss.Context(), tokens)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &tokenStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// tokenStream is a server stream whose context holds the verified agent token.
+type tokenStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *tokenStream) Context() context.Context {
+	return s.ctx
+}
+
+// tokenCredentials adds an agent token to the metadata of the requests of an agent.
+type tokenCredentials struct {
+	token    string
+	insecure bool
+}
+
+// TokenCredentials returns credentials that add the given agent token to the requests of an agent.
+// Unless insecure is set, the credentials require a secure connection, so that the token is not revealed.
+func TokenCredentials(token string, insecure bool) credentials.PerRPCCredentials {
+	return &tokenCredentials{token: token, insecure: insecure}
+}
+
+func (c *tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{TokenKey: c.token}, nil
+}
+
+func (c *tokenCredentials) RequireTransportSecurity() bool {
+	return !c.insecure
+}
@@ -0,0 +1,113 @@
+package agent
+
+import (
+	"time"
+
+	"github.com/autograde/quickfeed/ci"
+)
+
+// newJob returns the message of the given job with the given ID and time left until the job times out.
+func newJob(id string, job *ci.Job, timeout time.Duration) *Job {
+	j := &Job{
+		ID:       id,
+		Name:     job.Name,
+		Image:    job.Image,
+		Clone:    job.Clone,
+		Commands: job.Commands,
+		Hidden:   job.Hidden,
+		Env:      job.Env,
+		Limits: &Limits{
+			Memory:    job.Limits.Memory,
+			Cpus:      job.Limits.CPUs,
+			Pids:      job.Limits.Pids,
+			Disk:      job.Limits.Disk,
+			NoNetwork: job.Limits.NoNetwork,
+			ReadOnly:  job.Limits.ReadOnly,
+		},
+		Timeout: timeout.Milliseconds(),
+	}
+	for _, step := range job.Steps {
+		j.Steps = append(j.Steps, &Step{
+			Name:         step.Name,
+			Image:        step.Image,
+			Commands:     step.Commands,
+			Env:          step.Env,
+			Timeout:      step.Timeout.Milliseconds(),
+			AllowFailure: step.AllowFailure,
+			Artifacts:    step.Artifacts,
+		})
+	}
+	return j
+}
+
+// ciJob returns the job described by the message.
+// The caches of the server are not available to agents, so that the job clones the repositories.
+func (j *Job) ciJob() *ci.Job {
+	job := &ci.Job{
+		Name:     j.GetName(),
+		Image:    j.GetImage(),
+		Clone:    j.GetClone(),
+		Commands: j.GetCommands(),
+		Hidden:   j.GetHidden(),
+		Env:      j.GetEnv(),
+		Limits: ci.Limits{
+			Memory:    j.GetLimits().GetMemory(),
+			CPUs:      j.GetLimits().GetCpus(),
+			Pids:      j.GetLimits().GetPids(),
+			Disk:      j.GetLimits().GetDisk(),
+			NoNetwork: j.GetLimits().GetNoNetwork(),
+			ReadOnly:  j.GetLimits().GetReadOnly(),
+		},
+	}
+	for _, step := range j.GetSteps() {
+		job.Steps = append(job.Steps, &ci.Step{
+			Name:         step.GetName(),
+			Image:        step.GetImage(),
+			Commands:     step.GetCommands(),
+			Env:          step.GetEnv(),
+			Timeout:      time.Duration(step.GetTimeout()) * time.Millisecond,
+			AllowFailure: step.GetAllowFailure(),
+			Artifacts:    step.GetArtifacts(),
+		})
+	}
+	return job
+}
+
+// jobImages returns the images of the containers that run the given job.
+func jobImages(job *ci.Job) []string {
+	images := []string{job.Image}
+	for _, step := range job.Steps {
+		images = append(images, step.Image)
+	}
+	return images
+}
+
+// newStepResults returns the messages of the given step results.
+func newStepResults(results []*ci.StepResult) []*StepResult {
+	steps := make([]*StepResult, 0, len(results))
+	for _, result := range results {
+		steps = append(steps, &StepResult{
+			Name:         result.Name,
+			Status:       string(result.Status),
+			AllowFailure: result.AllowFailure,
+			Output:       result.Output,
+			ExecTime:     result.ExecTime.Milliseconds(),
+		})
+	}
+	return steps
+}
+
+// stepResults returns the step results of the job result.
+func (r *JobResult) stepResults() []*ci.StepResult {
+	var results []*ci.StepResult
+	for _, step := range r.GetSteps() {
+		results = append(results, &ci.StepResult{
+			Name:         step.GetName(),
+			Status:       ci.StepStatus(step.GetStatus()),
+			AllowFailure: step.GetAllowFailure(),
+			Output:       step.GetOutput(),
+			ExecTime:     time.Duration(step.GetExecTime()) * time.Millisecond,
+		})
+	}
+	return results
+}
@@ -0,0 +1,435 @@
+package agent
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/autograde/quickfeed/ci"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultHeartbeatInterval = 10 * time.Second
+	defaultPollTimeout       = 30 * time.Second
+	defaultMaxAttempts       = 3
+
+	// missedHeartbeats is the number of heartbeats an agent may miss before it is considered lost.
+	missedHeartbeats = 3
+	// resultTimeout is the time to wait for the result of a job that timed out, which holds
+	// the output of the job; the agent times out the job at the same time as the server.
+	resultTimeout = 10 * time.Second
+	// restartMessage is emitted to the user when a job is restarted on another agent.
+	restartMessage = "*** Lost build agent; restarting build ***"
+)
+
+// RemoteOptions holds the options of a remote runner.
+type RemoteOptions struct {
+	Tokens            []string      // the agent tokens; agents must present one of them
+	HeartbeatInterval time.Duration // time between the heartbeats of agents; default 10 seconds
+	PollTimeout       time.Duration // maximum time an agent waits for a job; default 30 seconds
+	MaxAttempts       int           // maximum number of agents that may be lost while running a job; default 3
+}
+
+// RemoteRunner is an implementation of the CI interface that runs jobs on remote build agents.
+// Each job is assigned to the first agent with free capacity that runs all the images of the job
+// and asks for a job. Jobs wait for an agent until their context is done. If an agent misses
+// several heartbeats, it is considered lost, and its jobs are assigned to other agents.
+// The caches of the server are not available to agents.
+type RemoteRunner struct {
+	UnimplementedAgentServiceServer
+	logger *zap.SugaredLogger
+	opts   RemoteOptions
+	done   chan struct{}
+
+	mu      sync.Mutex
+	agents  map[string]*remoteAgent
+	pending []*remoteJob  // the jobs waiting for an agent, in order
+	changed chan struct{} // closed when a job is waiting for an agent
+}
+
+// remoteAgent is a registered build agent.
+type remoteAgent struct {
+	id       string
+	name     string
+	token    string // the token the agent registered with
+	capacity int
+	images   map[string]bool // all images if empty
+	jobs     map[string]*remoteJob
+	lastSeen time.Time
+}
+
+// remoteJob is a job submitted to the remote runner.
+type remoteJob struct {
+	id       string
+	job      *ci.Job
+	deadline time.Time // no deadline if zero
+	emit     func(line string)
+	agent    *remoteAgent // nil while the job waits for an agent
+	attempts int
+	canceled bool
+	outcome  chan jobOutcome // receives the outcome of the job once
+}
+
+// jobOutcome is the result of a job reported by an agent, or the error if no agent could complete the job.
+type jobOutcome struct {
+	result *JobResult
+	err    error
+}
+
+// NewRemoteRunner returns a remote runner with the given options.
+// The runner's agent service must be served to build agents; see NewServer.
+func NewRemoteRunner(logger *zap.SugaredLogger, opts RemoteOptions) *RemoteRunner {
+	if opts.HeartbeatInterval <= 0 {
+		opts.HeartbeatInterval = defaultHeartbeatInterval
+	}
+	if opts.PollTimeout <= 0 {
+		opts.PollTimeout = defaultPollTimeout
+	}
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = defaultMaxAttempts
+	}
+	r := &RemoteRunner{
+		logger:  logger,
+		opts:    opts,
+		done:    make(chan struct{}),
+		agents:  make(map[string]*remoteAgent),
+		changed: make(chan struct{}),
+	}
+	go r.monitor()
+	return r
+}
+
+// NewServer returns a gRPC server with the given options that serves the runner's agent service,
+// and rejects the requests of agents without one of the runner's agent tokens.
+func (r *RemoteRunner) NewServer(opts ...grpc.ServerOption) *grpc.Server {
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(UnaryTokenVerifier(r.opts.Tokens)),
+		grpc.ChainStreamInterceptor(StreamTokenVerifier(r.opts.Tokens)),
+	)
+	s := grpc.NewServer(opts...)
+	RegisterAgentServiceServer(s, r)
+	return s
+}
+
+// Close stops monitoring the heartbeats of the agents.
+func (r *RemoteRunner) Close() {
+	close(r.done)
+}
+
+// Run implements the CI interface. This method blocks until the job has been
+// completed by an agent or an error occurs, e.g., the context times out.
+func (r *RemoteRunner) Run(ctx context.Context, job *ci.Job) (string, error) {
+	return r.RunStream(ctx, job, nil)
+}
+
+// RunStream implements the StreamingRunner interface. This method blocks until the job
+// has been completed by an agent or an error occurs, e.g., the context is canceled.
+// The output is emitted line by line while the job is running.
+func (r *RemoteRunner) RunStream(ctx context.Context, job *ci.Job, emit func(line string)) (string, error) {
+	result, err := r.run(ctx, job, emit)
+	return result.GetOutput(), err
+}
+
+// RunSteps implements the PipelineRunner interface. This method blocks until the steps of
+// the job have been completed by an agent or an error occurs, e.g., the context is canceled.
+// The output is emitted line by line while the steps are running.
+func (r *RemoteRunner) RunSteps(ctx context.Context, job *ci.Job, emit func(line string)) ([]*ci.StepResult, error) {
+	result, err := r.run(ctx, job, emit)
+	return result.stepResults(), err
+}
+
+// run submits the given job and waits for its result.
+// If the job times out, the result holds the output of the job, if the agent reports it in time.
+func (r *RemoteRunner) run(ctx context.Context, job *ci.Job, emit func(line string)) (*JobResult, error) {
+	rj := &remoteJob{id: newID(), job: job, emit: emit, outcome: make(chan jobOutcome, 1)}
+	rj.deadline, _ = ctx.Deadline()
+	r.mu.Lock()
+	r.pending = append(r.pending, rj)
+	r.notify()
+	r.mu.Unlock()
+
+	select {
+	case outcome := <-rj.outcome:
+		if outcome.err != nil {
+			return nil, outcome.err
+		}
+		if outcome.result.GetError() != "" {
+			return outcome.result, errors.New(outcome.result.GetError())
+		}
+		return outcome.result, nil
+	case <-ctx.Done():
+	}
+	running := r.cancel(rj)
+	if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return nil, ctx.Err()
+	}
+	if running {
+		select {
+		case outcome := <-rj.outcome:
+			if result := outcome.result; result != nil {
+				if !strings.Contains(result.GetOutput(), ci.ContainerTimeoutMessage) {
+					// the agent may have stopped the job when it was canceled, before it timed out
+					if result.Output != "" && !strings.HasSuffix(result.Output, "\n") {
+						result.Output += "\n"
+					}
+					result.Output += ci.ContainerTimeoutMessage
+				}
+				return result, ctx.Err()
+			}
+		case <-time.After(resultTimeout):
+		}
+	}
+	// return message to user to be shown in the results log
+	return &JobResult{Output: ci.ContainerTimeoutMessage}, ctx.Err()
+}
+
+// cancel removes the given job if it waits for an agent, or else makes the agent running
+// the job stop it at its next heartbeat. It returns true if an agent is running the job.
+func (r *RemoteRunner) cancel(rj *remoteJob) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	rj.canceled = true
+	for i, pending := range r.pending {
+		if pending == rj {
+			r.pending = append(r.pending[:i], r.pending[i+1:]...)
+			return false
+		}
+	}
+	return rj.agent != nil
+}
+
+// notify wakes up the agents waiting for a job. The caller must hold r.mu.
+func (r *RemoteRunner) notify() {
+	close(r.changed)
+	r.changed = make(chan struct{})
+}
+
+// agent returns the registered agent with the given ID, if the agent registered
+// with the agent token of the given context, and records that the agent was seen.
+// The caller must hold r.mu.
+func (r *RemoteRunner) agent(ctx context.Context, id string) (*remoteAgent, error) {
+	a, ok := r.agents[id]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown agent %s", id)
+	}
+	if a.token != contextToken(ctx) {
+		return nil, status.Errorf(codes.PermissionDenied, "agent %s registered with another token", id)
+	}
+	a.lastSeen = time.Now()
+	return a, nil
+}
+
+// Register implements the AgentService interface.
+func (r *RemoteRunner) Register(ctx context.Context, in *RegisterRequest) (*RegisterResponse, error) {
+	if in.GetCapacity() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "agent capacity must be positive")
+	}
+	a := &remoteAgent{
+		id:       newID(),
+		name:     in.GetName(),
+		token:    contextToken(ctx),
+		capacity: int(in.GetCapacity()),
+		images:   make(map[string]bool),
+		jobs:     make(map[string]*remoteJob),
+		lastSeen: time.Now(),
+	}
+	for _, image := range in.GetImages() {
+		a.images[image] = true
+	}
+	r.mu.Lock()
+	r.agents[a.id] = a
+	r.mu.Unlock()
+	r.logger.Infof("Registered build agent %s (%s) with capacity %d and images %v", a.name, a.id, a.capacity, in.GetImages())
+	return &RegisterResponse{AgentID: a.id, HeartbeatInterval: r.opts.HeartbeatInterval.Milliseconds()}, nil
+}
+
+// Heartbeat implements the AgentService interface.
+// The response lists the jobs of the agent that have been canceled,
+// and the jobs the agent runs that are no longer assigned to it.
+func (r *RemoteRunner) Heartbeat(ctx context.Context, in *HeartbeatRequest) (*HeartbeatResponse, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	a, err := r.agent(ctx, in.GetAgentID())
+	if err != nil {
+		return nil, err
+	}
+	resp := &HeartbeatResponse{}
+	for _, id := range in.GetJobIDs() {
+		if rj, ok := a.jobs[id]; !ok || rj.canceled {
+			resp.CanceledJobIDs = append(resp.CanceledJobIDs, id)
+		}
+	}
+	return resp, nil
+}
+
+// PullJob implements the AgentService interface.
+func (r *RemoteRunner) PullJob(ctx context.Context, in *PullJobRequest) (*PullJobResponse, error) {
+	timer := time.NewTimer(r.opts.PollTimeout)
+	defer timer.Stop()
+	for {
+		r.mu.Lock()
+		a, err := r.agent(ctx, in.GetAgentID())
+		if err != nil {
+			r.mu.Unlock()
+			return nil, err
+		}
+		if rj := r.assign(a); rj != nil {
+			r.mu.Unlock()
+			var timeout time.Duration
+			if !rj.deadline.IsZero() {
+				timeout = time.Until(rj.deadline)
+			}
+			r.logger.Debugf("Assigned %s to build agent %s (%s)", rj.job.Name, a.name, a.id)
+			return &PullJobResponse{Job: newJob(rj.id, rj.job, timeout)}, nil
+		}
+		changed := r.changed
+		r.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-timer.C:
+			return &PullJobResponse{}, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// assign assigns the first waiting job the given agent can run to the agent,
+// if the agent has free capacity. The caller must hold r.mu.
+func (r *RemoteRunner) assign(a *remoteAgent) *remoteJob {
+	if len(a.jobs) >= a.capacity {
+		return nil
+	}
+	for i, rj := range r.pending {
+		if a.runs(rj.job) {
+			r.pending = append(r.pending[:i], r.pending[i+1:]...)
+			rj.agent = a
+			rj.attempts++
+			a.jobs[rj.id] = rj
+			return rj
+		}
+	}
+	return nil
+}
+
+// runs returns true if the agent runs all the images of the given job.
+func (a *remoteAgent) runs(job *ci.Job) bool {
+	if len(a.images) == 0 {
+		return true
+	}
+	for _, image := range jobImages(job) {
+		if !a.images[image] {
+			return false
+		}
+	}
+	return true
+}
+
+// ReportJob implements the AgentService interface.
+func (r *RemoteRunner) ReportJob(stream AgentService_ReportJobServer) error {
+	for {
+		update, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(&Void{})
+		}
+		if err != nil {
+			return err
+		}
+
+		r.mu.Lock()
+		a, err := r.agent(stream.Context(), update.GetAgentID())
+		if err != nil {
+			r.mu.Unlock()
+			return err
+		}
+		rj, ok := a.jobs[update.GetJobID()]
+		if !ok {
+			r.mu.Unlock()
+			return status.Errorf(codes.NotFound, "job %s is not assigned to agent %s", update.GetJobID(), a.id)
+		}
+		if result := update.GetResult(); result != nil {
+			delete(a.jobs, rj.id)
+			r.mu.Unlock()
+			rj.outcome <- jobOutcome{result: result}
+			continue
+		}
+		emit := rj.emit
+		if rj.canceled {
+			emit = nil
+		}
+		r.mu.Unlock()
+		if emit != nil {
+			emit(update.GetLine())
+		}
+	}
+}
+
+// monitor removes the agents that miss their heartbeats until the runner is closed.
+func (r *RemoteRunner) monitor() {
+	ticker := time.NewTicker(r.opts.HeartbeatInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.done:
+			return
+		case <-ticker.C:
+			r.removeLostAgents()
+		}
+	}
+}
+
+// removeLostAgents removes the agents that have missed their heartbeats, and assigns their jobs
+// to other agents, unless the jobs have been assigned to too many agents already.
+func (r *RemoteRunner) removeLostAgents() {
+	var requeued []*remoteJob
+	var restarted []func(line string)
+	r.mu.Lock()
+	for id, a := range r.agents {
+		if time.Since(a.lastSeen) <= missedHeartbeats*r.opts.HeartbeatInterval {
+			continue
+		}
+		delete(r.agents, id)
+		r.logger.Errorf("Lost build agent %s (%s) running %d jobs", a.name, a.id, len(a.jobs))
+		for _, rj := range a.jobs {
+			rj.agent = nil
+			switch {
+			case rj.canceled:
+			case rj.attempts >= r.opts.MaxAttempts:
+				rj.outcome <- jobOutcome{err: fmt.Errorf("lost %d build agents while running %s", rj.attempts, rj.job.Name)}
+			default:
+				requeued = append(requeued, rj)
+				if rj.emit != nil {
+					restarted = append(restarted, rj.emit)
+				}
+			}
+		}
+	}
+	if len(requeued) > 0 {
+		// the jobs have waited the longest, and are assigned first
+		r.pending = append(requeued, r.pending...)
+		r.notify()
+	}
+	r.mu.Unlock()
+	for _, emit := range restarted {
+		emit(restartMessage)
+	}
+}
+
+// newID returns a random identifier of an agent or a job.
+func newID() string {
+	randomness := make([]byte, 16)
+	if _, err := rand.Read(randomness); err != nil {
+		panic("couldn't generate randomness")
+	}
+	return fmt.Sprintf("%x", randomness)
+}
@@ -0,0 +1,299 @@
+package agent_test
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/autograde/quickfeed/agent"
+	"github.com/autograde/quickfeed/ci"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const token = "agent-token"
+
+// testServer serves the agent service of a remote runner to in-process agents.
+type testServer struct {
+	t      *testing.T
+	runner *agent.RemoteRunner
+	lis    *bufconn.Listener
+}
+
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+	runner := agent.NewRemoteRunner(zap.NewNop().Sugar(), agent.RemoteOptions{
+		Tokens:            []string{"other-token", token},
+		HeartbeatInterval: 20 * time.Millisecond,
+		PollTimeout:       100 * time.Millisecond,
+	})
+	lis := bufconn.Listen(1 << 20)
+	server := runner.NewServer()
+	go server.Serve(lis)
+	t.Cleanup(func() {
+		server.Stop()
+		runner.Close()
+	})
+	return &testServer{t: t, runner: runner, lis: lis}
+}
+
+// dial returns a connection to the server that adds the given agent token to the requests.
+func (s *testServer) dial(token string) *grpc.ClientConn {
+	s.t.Helper()
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return s.lis.Dial() }),
+		grpc.WithInsecure(),
+		grpc.WithPerRPCCredentials(agent.TokenCredentials(token, true)),
+	)
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	s.t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+// startAgent starts an agent with the given runner and options, and returns a function that stops it.
+func (s *testServer) startAgent(runner ci.Runner, opts agent.AgentOptions) (stop func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		agent.NewAgent(zap.NewNop().Sugar(), s.dial(token), runner, opts).Run(ctx)
+	}()
+	stop = func() {
+		cancel()
+		<-done
+	}
+	s.t.Cleanup(stop)
+	return stop
+}
+
+// nameRunner returns the name of the agent followed by the name of the job.
+type nameRunner struct {
+	name string
+}
+
+func (r *nameRunner) Run(ctx context.Context, job *ci.Job) (string, error) {
+	return r.name + ":" + job.Name, nil
+}
+
+// blockingRunner runs jobs until their context is done.
+type blockingRunner struct {
+	once    sync.Once
+	started chan struct{} // closed when the first job starts
+	stopped chan error    // receives the context error of each job
+}
+
+func newBlockingRunner() *blockingRunner {
+	return &blockingRunner{started: make(chan struct{}), stopped: make(chan error, 10)}
+}
+
+func (r *blockingRunner) Run(ctx context.Context, job *ci.Job) (string, error) {
+	r.once.Do(func() { close(r.started) })
+	<-ctx.Done()
+	r.stopped <- ctx.Err()
+	return "", ctx.Err()
+}
+
+func TestRemoteRunner(t *testing.T) {
+	s := newTestServer(t)
+	s.startAgent(&ci.Local{}, agent.AgentOptions{Name: "local", Capacity: 2})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	out, err := s.runner.Run(ctx, &ci.Job{
+		Name:     "TestRemoteRunner",
+		Clone:    []string{`echo -n "cloned" > /quickfeed/clone.txt`},
+		Commands: []string{`cat /quickfeed/clone.txt`, `echo -n " $GREETING"`},
+		Env:      []string{"GREETING=hello"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != "cloned hello" {
+		t.Errorf("Run() = %#v, want %#v", out, "cloned hello")
+	}
+
+	var lines []string
+	out, err = s.runner.RunStream(ctx, &ci.Job{Commands: []string{`printf "first\nsecond\nlast"`}}, func(line string) {
+		lines = append(lines, line)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != "first\nsecond\nlast" || strings.Join(lines, ",") != "first,second,last" {
+		t.Errorf("RunStream() = %#v emitting %#v, want %#v emitting %#v", out, lines, "first\nsecond\nlast", []string{"first", "second", "last"})
+	}
+
+	_, err = s.runner.Run(ctx, &ci.Job{Commands: []string{`exit 3`}})
+	if err == nil || err.Error() != "exit status 3" {
+		t.Errorf("Run() = %v, want exit status 3", err)
+	}
+
+	steps, err := s.runner.RunSteps(ctx, &ci.Job{Steps: []*ci.Step{
+		{Name: "build", Commands: []string{`echo "built"`}},
+		{Name: "test", Commands: []string{`false`}},
+		{Name: "report", Commands: []string{`echo "reported"`}},
+	}}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []ci.StepStatus{ci.StepPassed, ci.StepFailed, ci.StepSkipped}
+	if len(steps) != len(want) {
+		t.Fatalf("RunSteps() returned %d steps, want %d", len(steps), len(want))
+	}
+	for i, step := range steps {
+		if step.Status != want[i] {
+			t.Errorf("step %s: status = %s, want %s", step.Name, step.Status, want[i])
+		}
+	}
+	if steps[0].Output != "built\n" {
+		t.Errorf("step build: output = %#v, want %#v", steps[0].Output, "built\n")
+	}
+}
+
+func TestRemoteRunnerImages(t *testing.T) {
+	s := newTestServer(t)
+	s.startAgent(&nameRunner{name: "go"}, agent.AgentOptions{Name: "go", Images: []string{"quickfeed:go"}})
+	s.startAgent(&nameRunner{name: "python"}, agent.AgentOptions{Name: "python", Images: []string{"quickfeed:python", "buildpack-deps:scm"}})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	for _, tt := range []struct {
+		job  *ci.Job
+		want string
+	}{
+		{job: &ci.Job{Name: "lab1", Image: "quickfeed:go"}, want: "go:lab1"},
+		{job: &ci.Job{Name: "lab2", Image: "quickfeed:python"}, want: "python:lab2"},
+		{job: &ci.Job{Name: "lab3", Image: "quickfeed:go"}, want: "go:lab3"},
+	} {
+		out, err := s.runner.Run(ctx, tt.job)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != tt.want {
+			t.Errorf("Run(%s) = %#v, want %#v", tt.job.Name, out, tt.want)
+		}
+	}
+
+	// no agent runs all the images of the job, so that it waits until it times out
+	ctx, cancel = context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	out, err := s.runner.Run(ctx, &ci.Job{Name: "lab4", Image: "quickfeed:java"})
+	if err != context.DeadlineExceeded || out != ci.ContainerTimeoutMessage {
+		t.Errorf("Run(lab4) = (%#v, %v), want (%#v, %v)", out, err, ci.ContainerTimeoutMessage, context.DeadlineExceeded)
+	}
+}
+
+func TestRemoteRunnerToken(t *testing.T) {
+	s := newTestServer(t)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	for _, token := range []string{"", "invalid-token"} {
+		client := agent.NewAgentServiceClient(s.dial(token))
+		_, err := client.Register(ctx, &agent.RegisterRequest{Name: "intruder", Capacity: 1})
+		if status.Code(err) != codes.Unauthenticated {
+			t.Errorf("Register() with token %q = %v, want %v", token, err, codes.Unauthenticated)
+		}
+	}
+
+	// an agent cannot pull the jobs of an agent registered with another token
+	resp, err := agent.NewAgentServiceClient(s.dial(token)).Register(ctx, &agent.RegisterRequest{Name: "agent", Capacity: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = agent.NewAgentServiceClient(s.dial("other-token")).PullJob(ctx, &agent.PullJobRequest{AgentID: resp.GetAgentID()})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("PullJob() with other token = %v, want %v", err, codes.PermissionDenied)
+	}
+}
+
+func TestRemoteRunnerAgentLost(t *testing.T) {
+	s := newTestServer(t)
+	blocking := newBlockingRunner()
+	stop := s.startAgent(blocking, agent.AgentOptions{Name: "lost"})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	type result struct {
+		out string
+		err error
+	}
+	results := make(chan result)
+	var mu sync.Mutex
+	var lines []string
+	go func() {
+		out, err := s.runner.RunStream(ctx, &ci.Job{Name: "lab1"}, func(line string) {
+			mu.Lock()
+			lines = append(lines, line)
+			mu.Unlock()
+		})
+		results <- result{out, err}
+	}()
+
+	<-blocking.started
+	// the lost agent stops its job, and the job is assigned to the other agent
+	stop()
+	s.startAgent(&nameRunner{name: "other"}, agent.AgentOptions{Name: "other"})
+	res := <-results
+	if res.err != nil {
+		t.Fatal(res.err)
+	}
+	if res.out != "other:lab1" {
+		t.Errorf("RunStream() = %#v, want %#v", res.out, "other:lab1")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(lines) != 1 || !strings.Contains(lines[0], "restarting build") {
+		t.Errorf("RunStream() emitted %#v, want restart message", lines)
+	}
+}
+
+func TestRemoteRunnerCancel(t *testing.T) {
+	s := newTestServer(t)
+	blocking := newBlockingRunner()
+	s.startAgent(blocking, agent.AgentOptions{Name: "agent"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := make(chan error)
+	go func() {
+		_, err := s.runner.Run(ctx, &ci.Job{Name: "lab1"})
+		errs <- err
+	}()
+	<-blocking.started
+	cancel()
+	if err := <-errs; err != context.Canceled {
+		t.Errorf("Run() = %v, want %v", err, context.Canceled)
+	}
+	// the agent stops the job at its next heartbeat
+	select {
+	case err := <-blocking.stopped:
+		if err != context.Canceled {
+			t.Errorf("job stopped with %v, want %v", err, context.Canceled)
+		}
+	case <-time.After(5 * time.Second):
+		t.Error("agent did not stop the canceled job")
+	}
+}
+
+func TestRemoteRunnerTimeout(t *testing.T) {
+	s := newTestServer(t)
+	s.startAgent(&ci.Local{}, agent.AgentOptions{Name: "local"})
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	out, err := s.runner.Run(ctx, &ci.Job{Commands: []string{`echo "started"`, `sleep 10`}})
+	if err != context.DeadlineExceeded {
+		t.Errorf("Run() = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if out != "started\n"+ci.ContainerTimeoutMessage {
+		t.Errorf("Run() = %#v, want %#v", out, "started\n"+ci.ContainerTimeoutMessage)
+	}
+}
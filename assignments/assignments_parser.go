@@ -1,14 +1,18 @@
 package assignments
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	units "github.com/docker/go-units"
 
 	"gopkg.in/yaml.v2"
 )
@@ -16,6 +20,8 @@ import (
 const (
 	target                       = "assignment.yml"
 	targetYaml                   = "assignment.yaml"
+	pipelineFile                 = "pipeline.yml"
+	pipelineYaml                 = "pipeline.yaml"
 	defaultAutoApproveScoreLimit = 80
 )
 
@@ -24,15 +30,45 @@ const (
 // Note that the struct can be private, but the fields must be
 // public to allow parsing.
 type assignmentData struct {
-	AssignmentID     uint   `yaml:"assignmentid"`
-	ScriptFile       string `yaml:"scriptfile"`
-	Deadline         string `yaml:"deadline"`
-	AutoApprove      bool   `yaml:"autoapprove"`
-	ScoreLimit       uint   `yaml:"scorelimit"`
-	IsGroupLab       bool   `yaml:"isgrouplab"`
-	Reviewers        uint   `yaml:"reviewers"`
-	ContainerTimeout uint   `yaml:"containertimeout"`
-	SkipTests        bool   `yaml:"skiptests"`
+	AssignmentID     uint         `yaml:"assignmentid"`
+	ScriptFile       string       `yaml:"scriptfile"`
+	Deadline         string       `yaml:"deadline"`
+	AutoApprove      bool         `yaml:"autoapprove"`
+	ScoreLimit       uint         `yaml:"scorelimit"`
+	IsGroupLab       bool         `yaml:"isgrouplab"`
+	Reviewers        uint         `yaml:"reviewers"`
+	ContainerTimeout uint         `yaml:"containertimeout"`
+	SkipTests        bool         `yaml:"skiptests"`
+	LatePolicy       string       `yaml:"latepolicy"`
+	LatePenalty      uint         `yaml:"latepenalty"`
+	FreezeAtDeadline bool         `yaml:"freezeatdeadline"`
+	FinalBuild       bool         `yaml:"finalbuild"`
+	ReleaseDate      string       `yaml:"releasedate"`
+	HiddenTests      []string     `yaml:"hiddentests"`
+	HiddenAtDeadline bool         `yaml:"hiddenatdeadline"`
+	BuildsPerHour    uint         `yaml:"buildsperhour"`
+	BuildInterval    string       `yaml:"buildinterval"`
+	Memory           string       `yaml:"memory"`
+	CPUs             float64      `yaml:"cpus"`
+	Pids             uint         `yaml:"pids"`
+	Disk             string       `yaml:"disk"`
+	Network          *bool        `yaml:"network"`
+	ReadOnly         bool         `yaml:"readonly"`
+	Pipeline         *ci.Pipeline `yaml:"pipeline"`
+}
+
+// hiddenTestPath matches the paths of hidden tests, which are
+// relative to the assignment folder and used in test scripts.
+var hiddenTestPath = regexp.MustCompile(`^[A-Za-z0-9_.-]+(/[A-Za-z0-9_.-]+)*/?$`)
+
+// latePolicies maps the late policies of 'assignment.yml' files to late policies.
+// Assignments without a late policy use the late policy of the course.
+var latePolicies = map[string]pb.Course_LatePolicy{
+	"":         pb.Course_DEFAULT,
+	"slipdays": pb.Course_SLIP_DAYS,
+	"linear":   pb.Course_LINEAR_PENALTY,
+	"step":     pb.Course_STEP_PENALTY,
+	"cutoff":   pb.Course_HARD_CUTOFF,
 }
 
 // ParseAssignments recursively walks the given directory and parses
@@ -61,9 +97,41 @@ func parseAssignments(dir string, courseID uint64) ([]*pb.Assignment, error) {
 				if newAssignment.ScoreLimit < 1 {
 					newAssignment.ScoreLimit = defaultAutoApproveScoreLimit
 				}
-				if newAssignment.ScriptFile == "" && !newAssignment.SkipTests {
+				pipeline, err := parsePipeline(filepath.Dir(path), newAssignment)
+				if err != nil {
+					return fmt.Errorf("error unmarshalling assignment: %w", err)
+				}
+				if newAssignment.ScriptFile == "" && pipeline == "" && !newAssignment.SkipTests {
 					return fmt.Errorf("error unmarshalling assignment: missing field 'scriptfile'")
 				}
+				latePolicy, ok := latePolicies[strings.ToLower(newAssignment.LatePolicy)]
+				if !ok {
+					return fmt.Errorf("error unmarshalling assignment: unknown late policy %q", newAssignment.LatePolicy)
+				}
+				if newAssignment.LatePenalty > 100 {
+					return fmt.Errorf("error unmarshalling assignment: late penalty %d exceeds 100 percent", newAssignment.LatePenalty)
+				}
+				for _, hidden := range newAssignment.HiddenTests {
+					if !hiddenTestPath.MatchString(hidden) || strings.Contains("/"+hidden+"/", "/../") {
+						return fmt.Errorf("error unmarshalling assignment: invalid hidden test path %q", hidden)
+					}
+				}
+				if newAssignment.BuildInterval != "" {
+					if interval, err := time.ParseDuration(newAssignment.BuildInterval); err != nil || interval < 0 {
+						return fmt.Errorf("error unmarshalling assignment: invalid build interval %q", newAssignment.BuildInterval)
+					}
+				}
+				memoryLimit, err := parseSize(newAssignment.Memory)
+				if err != nil {
+					return fmt.Errorf("error unmarshalling assignment: invalid memory limit %q", newAssignment.Memory)
+				}
+				diskLimit, err := parseSize(newAssignment.Disk)
+				if err != nil {
+					return fmt.Errorf("error unmarshalling assignment: invalid disk limit %q", newAssignment.Disk)
+				}
+				if newAssignment.CPUs < 0 {
+					return fmt.Errorf("error unmarshalling assignment: invalid number of CPUs %v", newAssignment.CPUs)
+				}
 
 				// AssignmentID field from the parsed yaml is used to set Order, not assignment ID,
 				// or it will cause a database constraint violation (IDs must be unique)
@@ -80,6 +148,24 @@ func parseAssignments(dir string, courseID uint64) ([]*pb.Assignment, error) {
 					Reviewers:        uint32(newAssignment.Reviewers),
 					ContainerTimeout: uint32(newAssignment.ContainerTimeout),
 					SkipTests:        newAssignment.SkipTests,
+					LatePolicy:       latePolicy,
+					LatePenalty:      uint32(newAssignment.LatePenalty),
+					FreezeAtDeadline: newAssignment.FreezeAtDeadline,
+					FinalBuild:       newAssignment.FinalBuild,
+					HiddenTests:      strings.Join(newAssignment.HiddenTests, ","),
+					HiddenAtDeadline: newAssignment.HiddenAtDeadline,
+					BuildsPerHour:    uint32(newAssignment.BuildsPerHour),
+					BuildInterval:    newAssignment.BuildInterval,
+					MemoryLimit:      memoryLimit,
+					CpuLimit:         newAssignment.CPUs,
+					PidsLimit:        uint32(newAssignment.Pids),
+					DiskLimit:        diskLimit,
+					NetworkDisabled:  newAssignment.Network != nil && !*newAssignment.Network,
+					ReadOnlyRoot:     newAssignment.ReadOnly,
+					Pipeline:         pipeline,
+				}
+				if newAssignment.ReleaseDate != "" {
+					assignment.ReleaseDate = FixDeadline(newAssignment.ReleaseDate)
 				}
 
 				assignments = append(assignments, assignment)
@@ -93,6 +179,56 @@ func parseAssignments(dir string, courseID uint64) ([]*pb.Assignment, error) {
 	return assignments, nil
 }
 
+// parsePipeline returns the JSON encoded build pipeline of the given assignment in the given folder,
+// which is described in the assignment's 'assignment.yml' file or in a 'pipeline.yml' file next to it,
+// or an empty string if the assignment has no build pipeline.
+func parsePipeline(dir string, assignment assignmentData) (string, error) {
+	pipeline := assignment.Pipeline
+	for _, filename := range []string{pipelineFile, pipelineYaml} {
+		source, err := ioutil.ReadFile(filepath.Join(dir, filename))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return "", fmt.Errorf("could not to read %q file: %w", filename, err)
+		}
+		if pipeline != nil {
+			return "", fmt.Errorf("pipeline is described in both the assignment and %q", filename)
+		}
+		pipeline = &ci.Pipeline{}
+		if err := yaml.Unmarshal(source, pipeline); err != nil {
+			return "", fmt.Errorf("error unmarshalling pipeline: %w", err)
+		}
+	}
+	if pipeline == nil {
+		return "", nil
+	}
+	if err := pipeline.Validate(); err != nil {
+		return "", fmt.Errorf("invalid pipeline: %w", err)
+	}
+	if len(assignment.HiddenTests) > 0 {
+		return "", fmt.Errorf("pipelines do not support hidden tests")
+	}
+	b, err := json.Marshal(pipeline)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// parseSize returns the number of bytes of the given size, such as 512m or 1g,
+// or zero if the size is empty.
+func parseSize(size string) (uint64, error) {
+	if size == "" {
+		return 0, nil
+	}
+	bytes, err := units.RAMInBytes(size)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(bytes), nil
+}
+
 func FixDeadline(in string) string {
 	wantLayout := pb.TimeLayout
 	acceptedLayouts := []string{
@@ -2,9 +2,11 @@ package assignments
 
 import (
 	"context"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	pb "github.com/autograde/quickfeed/ag"
@@ -155,6 +157,327 @@ func TestParseUnknownFields(t *testing.T) {
 	}
 }
 
+func TestParseLatePolicy(t *testing.T) {
+	const yLatePolicy = `assignmentid: 1
+scriptfile: "go.sh"
+deadline: "27-08-2017 12:00"
+latepolicy: %s
+latepenalty: %d
+`
+	tests := []struct {
+		name        string
+		latePolicy  string
+		latePenalty uint32
+		want        pb.Course_LatePolicy
+		wantErr     bool
+	}{
+		{name: "course policy", latePolicy: `""`, want: pb.Course_DEFAULT},
+		{name: "slip days", latePolicy: "slipdays", want: pb.Course_SLIP_DAYS},
+		{name: "linear", latePolicy: "linear", latePenalty: 10, want: pb.Course_LINEAR_PENALTY},
+		{name: "step", latePolicy: "Step", latePenalty: 25, want: pb.Course_STEP_PENALTY},
+		{name: "cutoff", latePolicy: "cutoff", want: pb.Course_HARD_CUTOFF},
+		{name: "unknown policy", latePolicy: "lenient", wantErr: true},
+		{name: "penalty above 100 percent", latePolicy: "linear", latePenalty: 101, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testsDir, err := ioutil.TempDir("", pb.TestsRepo)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(testsDir)
+			if err := os.Mkdir(filepath.Join(testsDir, "lab1"), 0755); err != nil {
+				t.Fatal(err)
+			}
+			source := fmt.Sprintf(yLatePolicy, tt.latePolicy, tt.latePenalty)
+			if err := ioutil.WriteFile(filepath.Join(testsDir, "lab1", "assignment.yml"), []byte(source), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			assignments, err := parseAssignments(testsDir, 0)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("parseAssignments() = %v, want error", assignments)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(assignments) != 1 {
+				t.Fatalf("len(assignments) = %d, want 1", len(assignments))
+			}
+			if got := assignments[0].GetLatePolicy(); got != tt.want {
+				t.Errorf("LatePolicy = %v, want %v", got, tt.want)
+			}
+			if got := assignments[0].GetLatePenalty(); got != tt.latePenalty {
+				t.Errorf("LatePenalty = %d, want %d", got, tt.latePenalty)
+			}
+		})
+	}
+}
+
+func TestParseHiddenTests(t *testing.T) {
+	const yHiddenTests = `assignmentid: 1
+scriptfile: "go.sh"
+deadline: "27-08-2017 12:00"
+hiddentests: %s
+hiddenatdeadline: true
+`
+	tests := []struct {
+		name        string
+		hiddenTests string
+		want        string
+		wantErr     bool
+	}{
+		{name: "no hidden tests", hiddenTests: "[]", want: ""},
+		{name: "files and directories", hiddenTests: `["hidden_test.go", "stress/", "more/tests"]`, want: "hidden_test.go,stress/,more/tests"},
+		{name: "absolute path", hiddenTests: `["/etc"]`, wantErr: true},
+		{name: "parent directory", hiddenTests: `["stress/../../lab2"]`, wantErr: true},
+		{name: "shell characters", hiddenTests: `["$(id)"]`, wantErr: true},
+		{name: "comma", hiddenTests: `["a,b"]`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testsDir, err := ioutil.TempDir("", pb.TestsRepo)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(testsDir)
+			if err := os.Mkdir(filepath.Join(testsDir, "lab1"), 0755); err != nil {
+				t.Fatal(err)
+			}
+			source := fmt.Sprintf(yHiddenTests, tt.hiddenTests)
+			if err := ioutil.WriteFile(filepath.Join(testsDir, "lab1", "assignment.yml"), []byte(source), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			assignments, err := parseAssignments(testsDir, 0)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("parseAssignments() = %v, want error", assignments)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(assignments) != 1 {
+				t.Fatalf("len(assignments) = %d, want 1", len(assignments))
+			}
+			if got := assignments[0].GetHiddenTests(); got != tt.want {
+				t.Errorf("HiddenTests = %q, want %q", got, tt.want)
+			}
+			if !assignments[0].GetHiddenAtDeadline() {
+				t.Error("HiddenAtDeadline = false, want true")
+			}
+		})
+	}
+}
+
+func TestParseBuildQuota(t *testing.T) {
+	const yBuildQuota = `assignmentid: 1
+scriptfile: "go.sh"
+deadline: "27-08-2017 12:00"
+buildsperhour: 10
+buildinterval: %s
+`
+	tests := []struct {
+		name          string
+		buildInterval string
+		want          string
+		wantErr       bool
+	}{
+		{name: "no interval", buildInterval: `""`, want: ""},
+		{name: "minutes", buildInterval: "5m", want: "5m"},
+		{name: "invalid duration", buildInterval: "5 minutes", wantErr: true},
+		{name: "negative duration", buildInterval: "-5m", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testsDir, err := ioutil.TempDir("", pb.TestsRepo)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(testsDir)
+			if err := os.Mkdir(filepath.Join(testsDir, "lab1"), 0755); err != nil {
+				t.Fatal(err)
+			}
+			source := fmt.Sprintf(yBuildQuota, tt.buildInterval)
+			if err := ioutil.WriteFile(filepath.Join(testsDir, "lab1", "assignment.yml"), []byte(source), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			assignments, err := parseAssignments(testsDir, 0)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("parseAssignments() = %v, want error", assignments)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(assignments) != 1 {
+				t.Fatalf("len(assignments) = %d, want 1", len(assignments))
+			}
+			if got := assignments[0].GetBuildsPerHour(); got != 10 {
+				t.Errorf("BuildsPerHour = %d, want 10", got)
+			}
+			if got := assignments[0].GetBuildInterval(); got != tt.want {
+				t.Errorf("BuildInterval = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseResourceLimits(t *testing.T) {
+	const yLimits = `assignmentid: 1
+scriptfile: "go.sh"
+deadline: "27-08-2017 12:00"
+%s
+`
+	tests := []struct {
+		name    string
+		limits  string
+		want    *pb.Assignment
+		wantErr bool
+	}{
+		{name: "no limits", limits: "", want: &pb.Assignment{}},
+		{
+			name:   "all limits",
+			limits: "memory: 512m\ncpus: 1.5\npids: 128\ndisk: 1g\nnetwork: false\nreadonly: true",
+			want:   &pb.Assignment{MemoryLimit: 512 << 20, CpuLimit: 1.5, PidsLimit: 128, DiskLimit: 1 << 30, NetworkDisabled: true, ReadOnlyRoot: true},
+		},
+		{name: "network enabled", limits: "network: true", want: &pb.Assignment{}},
+		{name: "invalid memory", limits: "memory: lots", wantErr: true},
+		{name: "invalid disk", limits: "disk: 1 terabyte", wantErr: true},
+		{name: "negative cpus", limits: "cpus: -1", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testsDir, err := ioutil.TempDir("", pb.TestsRepo)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(testsDir)
+			if err := os.Mkdir(filepath.Join(testsDir, "lab1"), 0755); err != nil {
+				t.Fatal(err)
+			}
+			source := fmt.Sprintf(yLimits, tt.limits)
+			if err := ioutil.WriteFile(filepath.Join(testsDir, "lab1", "assignment.yml"), []byte(source), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			assignments, err := parseAssignments(testsDir, 0)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("parseAssignments() = %v, want error", assignments)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(assignments) != 1 {
+				t.Fatalf("len(assignments) = %d, want 1", len(assignments))
+			}
+			got := assignments[0]
+			if got.GetMemoryLimit() != tt.want.GetMemoryLimit() || got.GetCpuLimit() != tt.want.GetCpuLimit() ||
+				got.GetPidsLimit() != tt.want.GetPidsLimit() || got.GetDiskLimit() != tt.want.GetDiskLimit() ||
+				got.GetNetworkDisabled() != tt.want.GetNetworkDisabled() || got.GetReadOnlyRoot() != tt.want.GetReadOnlyRoot() {
+				t.Errorf("limits = (memory %d, cpus %g, pids %d, disk %d, network disabled %t, read-only %t), want (memory %d, cpus %g, pids %d, disk %d, network disabled %t, read-only %t)",
+					got.GetMemoryLimit(), got.GetCpuLimit(), got.GetPidsLimit(), got.GetDiskLimit(), got.GetNetworkDisabled(), got.GetReadOnlyRoot(),
+					tt.want.GetMemoryLimit(), tt.want.GetCpuLimit(), tt.want.GetPidsLimit(), tt.want.GetDiskLimit(), tt.want.GetNetworkDisabled(), tt.want.GetReadOnlyRoot())
+			}
+		})
+	}
+}
+
+func TestParsePipeline(t *testing.T) {
+	const yPipeline = `assignmentid: 1
+deadline: "27-08-2017 12:00"
+%s
+`
+	const pipeline = `image: golang:latest
+env:
+  CGO_ENABLED: 0
+steps:
+  - name: build
+    run: go build ./...
+    artifacts: [bin/]
+  - name: test
+    image: quickfeed:go
+    run: |
+      cd assignments/lab1
+      go test ./...
+    timeout: 5m
+    allowfailure: true
+`
+	const want = `{"image":"golang:latest","env":{"CGO_ENABLED":"0"},"steps":[` +
+		`{"name":"build","run":"go build ./...","artifacts":["bin/"]},` +
+		`{"name":"test","image":"quickfeed:go","run":"cd assignments/lab1\ngo test ./...\n","timeout":"5m","allowfailure":true}]}`
+
+	tests := []struct {
+		name         string
+		assignment   string
+		pipelineFile string
+		want         string
+		wantErr      bool
+	}{
+		{name: "inline pipeline", assignment: "pipeline:\n" + indent(pipeline), want: want},
+		{name: "pipeline file", pipelineFile: pipeline, want: want},
+		{name: "script file", assignment: `scriptfile: "go.sh"`, want: ""},
+		{name: "no script file or pipeline", wantErr: true},
+		{name: "inline pipeline and pipeline file", assignment: "pipeline:\n" + indent(pipeline), pipelineFile: pipeline, wantErr: true},
+		{name: "invalid pipeline", pipelineFile: "image: golang:latest\nsteps:\n  - name: Build\n    run: go build ./...\n", wantErr: true},
+		{name: "hidden tests", assignment: `hiddentests: ["hidden_test.go"]`, pipelineFile: pipeline, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testsDir, err := ioutil.TempDir("", pb.TestsRepo)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(testsDir)
+			if err := os.Mkdir(filepath.Join(testsDir, "lab1"), 0755); err != nil {
+				t.Fatal(err)
+			}
+			source := fmt.Sprintf(yPipeline, tt.assignment)
+			if err := ioutil.WriteFile(filepath.Join(testsDir, "lab1", "assignment.yml"), []byte(source), 0644); err != nil {
+				t.Fatal(err)
+			}
+			if tt.pipelineFile != "" {
+				if err := ioutil.WriteFile(filepath.Join(testsDir, "lab1", "pipeline.yml"), []byte(tt.pipelineFile), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			assignments, err := parseAssignments(testsDir, 0)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("parseAssignments() = %v, want error", assignments)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(assignments) != 1 {
+				t.Fatalf("len(assignments) = %d, want 1", len(assignments))
+			}
+			if got := assignments[0].GetPipeline(); got != tt.want {
+				t.Errorf("Pipeline = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// indent indents the lines of the given yaml source to nest it in a yaml map.
+func indent(source string) string {
+	return "  " + strings.ReplaceAll(strings.TrimRight(source, "\n"), "\n", "\n  ")
+}
+
 func TestFixDeadline(t *testing.T) {
 	deadlineTests := []struct {
 		in, want string
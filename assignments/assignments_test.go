@@ -3,6 +3,8 @@ package assignments
 import (
 	"context"
 	"os"
+	"os/exec"
+	"sort"
 	"testing"
 
 	pb "github.com/autograde/quickfeed/ag"
@@ -52,3 +54,44 @@ func TestFetchAssignments(t *testing.T) {
 		t.Logf("assignment: %v", assignment)
 	}
 }
+
+func TestFetchAssignmentsFakeSCM(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("This test requires git")
+	}
+	s := scm.NewFakeSCMClient()
+	defer s.Cleanup()
+	ctx := context.Background()
+
+	org, err := s.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "dat520"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantAssignments := []*pb.Assignment{
+		{Name: "lab1", Order: 1, ScriptFile: "go.sh", Deadline: "2021-02-01T23:59:00", AutoApprove: true, ScoreLimit: 80},
+		{Name: "lab2", Order: 2, ScriptFile: "go.sh", Deadline: "2021-03-01T23:59:00", IsGroupLab: true, ScoreLimit: 60},
+	}
+	if _, err := s.SeedTestsRepo(ctx, org, wantAssignments...); err != nil {
+		t.Fatal(err)
+	}
+
+	course := &pb.Course{ID: 1, OrganizationID: org.GetID()}
+	assignments, err := FetchAssignments(ctx, s, course)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if course.GetOrganizationPath() != "dat520" {
+		t.Errorf("course.OrganizationPath = %q, want %q", course.GetOrganizationPath(), "dat520")
+	}
+	if len(assignments) != len(wantAssignments) {
+		t.Fatalf("FetchAssignments() = %d assignments, want %d", len(assignments), len(wantAssignments))
+	}
+	sort.Slice(assignments, func(i, j int) bool { return assignments[i].GetOrder() < assignments[j].GetOrder() })
+	for i, want := range wantAssignments {
+		got := assignments[i]
+		if got.GetName() != want.GetName() || got.GetOrder() != want.GetOrder() || got.GetCourseID() != course.GetID() ||
+			got.GetAutoApprove() != want.GetAutoApprove() || got.GetScoreLimit() != want.GetScoreLimit() || got.GetIsGroupLab() != want.GetIsGroupLab() {
+			t.Errorf("FetchAssignments()[%d] = %v, want %v", i, got, want)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package ci
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"go.uber.org/zap"
+)
+
+// refreshRunner is a caching runner whose refreshes of tests snapshots complete when the test responds.
+type refreshRunner struct {
+	Local
+	refreshes chan *Job
+	results   chan error
+}
+
+func (r *refreshRunner) RefreshTests(_ context.Context, job *Job) error {
+	r.refreshes <- job
+	return <-r.results
+}
+
+// refresh waits for the queue to refresh the tests snapshot and completes it with the given error.
+func (r *refreshRunner) refresh(t *testing.T, err error) *Job {
+	t.Helper()
+	select {
+	case job := <-r.refreshes:
+		r.results <- err
+		return job
+	case <-time.After(10 * time.Second):
+		t.Fatal("tests snapshot not refreshed")
+		return nil
+	}
+}
+
+// waitForSnapshot waits until the snapshot of the given course's tests repository has the given availability.
+func waitForSnapshot(t *testing.T, q *Queue, courseID uint64, want bool) {
+	t.Helper()
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		q.mu.Lock()
+		have, refreshing := q.snapshots[courseID], q.refreshing[courseID]
+		q.mu.Unlock()
+		if have == want && !refreshing {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("snapshot of course %d available: %t, want %t", courseID, !want, want)
+}
+
+func TestQueueCaches(t *testing.T) {
+	runner := &refreshRunner{refreshes: make(chan *Job, 10), results: make(chan error)}
+	q := NewQueue(zap.NewNop().Sugar(), nil, runner, QueueOptions{Cache: true})
+	rData := &RunData{
+		Course: &pb.Course{ID: 1, Code: "DAT320"},
+		Repo:   &pb.Repository{HTMLURL: "https://github.com/dat320/user-labs"},
+	}
+
+	// the first job clones the tests repository and refreshes the snapshot for later jobs
+	caches := q.caches(rData)
+	if caches.cache != "quickfeed-cache-1" || caches.tests != "" {
+		t.Errorf("caches() = %+v, want dependency cache and no tests snapshot", caches)
+	}
+	// jobs started while the snapshot is refreshed do not refresh it again
+	q.caches(rData)
+	job := runner.refresh(t, nil)
+	if job.Tests != "quickfeed-tests-1" {
+		t.Errorf("refreshed tests snapshot in volume %q, want %q", job.Tests, "quickfeed-tests-1")
+	}
+	if commands := strings.Join(job.Commands, "\n"); !strings.Contains(commands, "git clone -q https://github.com/dat320/tests /quickfeed-tests/") {
+		t.Errorf("refresh commands do not clone the tests repository:\n%s", commands)
+	}
+	waitForSnapshot(t, q, 1, true)
+	if len(runner.refreshes) != 0 {
+		t.Errorf("refreshed tests snapshot %d more times, want once", len(runner.refreshes))
+	}
+	if caches := q.caches(rData); caches.tests != "quickfeed-tests-1" {
+		t.Errorf("caches() = %+v, want tests snapshot", caches)
+	}
+
+	// a push to the tests repository while refreshing the snapshot refreshes it again
+	q.RefreshTests(rData.Course, rData.Repo)
+	if caches := q.caches(rData); caches.tests != "" {
+		t.Errorf("caches() = %+v while refreshing, want no tests snapshot", caches)
+	}
+	q.RefreshTests(rData.Course, rData.Repo)
+	runner.refresh(t, nil)
+	runner.refresh(t, nil)
+	waitForSnapshot(t, q, 1, true)
+
+	// jobs clone the tests repository if the snapshot could not be refreshed
+	q.RefreshTests(rData.Course, rData.Repo)
+	runner.refresh(t, errors.New("repository not found"))
+	waitForSnapshot(t, q, 1, false)
+}
+
+func TestQueueCachesDisabled(t *testing.T) {
+	rData := &RunData{Course: &pb.Course{ID: 1}, Repo: &pb.Repository{HTMLURL: "https://github.com/dat320/user-labs"}}
+	for name, q := range map[string]*Queue{
+		"not enabled":        NewQueue(zap.NewNop().Sugar(), nil, &refreshRunner{}, QueueOptions{}),
+		"not caching runner": NewQueue(zap.NewNop().Sugar(), nil, &Local{}, QueueOptions{Cache: true}),
+	} {
+		if caches := q.caches(rData); caches != (jobCaches{}) {
+			t.Errorf("%s: caches() = %+v, want no caches", name, caches)
+		}
+		q.RefreshTests(rData.Course, rData.Repo)
+		if q.refreshing[rData.Course.GetID()] {
+			t.Errorf("%s: RefreshTests() refreshed tests snapshot", name)
+		}
+	}
+}
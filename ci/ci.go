@@ -2,16 +2,108 @@ package ci
 
 import (
 	"context"
+	"time"
 )
 
+// Workspace is the folder into which the clone commands of a job fetch the code to test.
+// The commands of the job run with a copy of the workspace at the same path.
+const Workspace = "/quickfeed"
+
+// CacheDir is the folder at which the dependency cache of a job's course is mounted.
+// The clone commands of the job may write to the cache; its commands may only read it.
+const CacheDir = "/quickfeed-cache"
+
+// TestsDir is the folder at which the snapshot of the tests repository of a job's course is
+// mounted read-only for the clone commands of the job. The current snapshot is TestsSnapshot.
+const TestsDir = "/quickfeed-tests"
+
+// TestsSnapshot is the folder with the current snapshot of the tests repository.
+const TestsSnapshot = TestsDir + "/current"
+
+// HiddenDir is the folder into which the clone commands of a job set aside the hidden tests.
+// It is only available to the clone commands and the hidden commands of the job, so that
+// the code under test cannot read the hidden tests while the commands of the job run.
+const HiddenDir = "/quickfeed-hidden"
+
 // Job describes how to execute a CI job.
 type Job struct {
 	// Name describes the running job; mainly used to name docker containers.
 	Name string
 	// Image names the image to use to run the job.
 	Image string
+	// Clone is a list of shell commands that fetch the code to test into the workspace.
+	// They run before, and separately from, the commands of the job, so that the
+	// credentials used to fetch the code are not available to the code under test.
+	Clone []string
 	// Commands is a list of shell commands to run as part of the job.
 	Commands []string
+	// Hidden is a list of shell commands that run the hidden tests after the commands of the job.
+	// They run separately, with the cloned workspace and the hidden tests at HiddenDir.
+	Hidden []string
+	// Limits restricts the resources available to the commands of the job.
+	Limits Limits
+	// Cache names the persistent volume with the dependency cache of the job's course,
+	// mounted at CacheDir. No cache is mounted if empty.
+	Cache string
+	// Tests names the volume with the snapshot of the tests repository of the job's course,
+	// mounted at TestsDir. No snapshot is mounted if empty.
+	Tests string
+	// Env is a list of environment variables, in the form KEY=value, of the commands of the job.
+	Env []string
+	// Steps is the pipeline of steps to run instead of the commands of the job.
+	Steps []*Step
+}
+
+// Step describes a step of a job's pipeline. Each step runs in its own container with
+// a copy of the workspace cloned by the job and the artifacts of the preceding steps.
+type Step struct {
+	// Name identifies the step in the job's results; it is unique in the pipeline.
+	Name string
+	// Image names the image to use to run the step.
+	Image string
+	// Commands is a list of shell commands to run as part of the step.
+	// The step fails if any of the commands fails.
+	Commands []string
+	// Env is a list of environment variables, in the form KEY=value, of the commands of the step.
+	Env []string
+	// Timeout is the maximum duration of the step; the step may run until the job times out if zero.
+	Timeout time.Duration
+	// AllowFailure lets the pipeline continue if the step fails.
+	AllowFailure bool
+	// Artifacts is a list of paths, relative to the workspace, of files and folders
+	// written by the step that are copied into the workspace of the following steps.
+	Artifacts []string
+}
+
+// StepStatus is the outcome of a step of a job's pipeline.
+type StepStatus string
+
+// The outcomes of the steps of a pipeline.
+const (
+	StepPassed   StepStatus = "passed"
+	StepFailed   StepStatus = "failed"
+	StepTimedOut StepStatus = "timeout"
+	StepSkipped  StepStatus = "skipped" // a preceding step failed
+)
+
+// StepResult is the result of a step of a job's pipeline.
+type StepResult struct {
+	Name         string
+	Status       StepStatus
+	AllowFailure bool
+	Output       string
+	ExecTime     time.Duration
+}
+
+// Limits restricts the resources available to the commands of a job.
+// The zero value imposes no limits.
+type Limits struct {
+	Memory    int64   // maximum memory in bytes
+	CPUs      float64 // maximum number of CPUs
+	Pids      int64   // maximum number of processes
+	Disk      int64   // maximum size in bytes of the files written by the commands
+	NoNetwork bool    // run the commands without network access
+	ReadOnly  bool    // read-only root filesystem; only the workspace and /tmp are writable
 }
 
 // Runner contains methods for running user provided code in isolation.
@@ -19,3 +111,32 @@ type Runner interface {
 	// Run should synchronously execute the described job and return the output.
 	Run(context.Context, *Job) (string, error)
 }
+
+// StreamingRunner is a runner that can also stream the output of a job while it is running.
+type StreamingRunner interface {
+	Runner
+	// RunStream should execute the described job like Run, and in addition
+	// call emit with each line of output as it is produced. The caller is
+	// responsible for filtering out lines that must not be revealed.
+	RunStream(ctx context.Context, job *Job, emit func(line string)) (string, error)
+}
+
+// CachingRunner is a runner that keeps the dependency caches and the snapshots of
+// the tests repositories of courses in volumes that are shared by their jobs.
+type CachingRunner interface {
+	Runner
+	// RefreshTests should run the commands of the job with its Tests volume mounted
+	// writable at TestsDir, so that they can replace the snapshot of the tests repository.
+	RefreshTests(ctx context.Context, job *Job) error
+}
+
+// PipelineRunner is a runner that can run the pipelines of jobs.
+type PipelineRunner interface {
+	Runner
+	// RunSteps should run the clone commands of the job, followed by its steps in order,
+	// and return the result of each step. The steps following a failed step are skipped,
+	// unless the failed step allows failure. If emit is non-nil, it is called with each
+	// line of output as it is produced. An error is returned if the job could not be run,
+	// or if ctx is done before the steps complete.
+	RunSteps(ctx context.Context, job *Job, emit func(line string)) ([]*StepResult, error)
+}
@@ -11,11 +11,14 @@ import (
 	"io"
 	"io/ioutil"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/volume"
 	"github.com/docker/docker/client"
 	"github.com/docker/docker/pkg/stdcopy"
 	"go.uber.org/zap"
@@ -28,26 +31,44 @@ var (
 	lastSegmentSize  = 1_000     // bytes
 )
 
+// ContainerTimeoutMessage is shown to the user in the results log if a job times out.
+const ContainerTimeoutMessage = "Container timeout. Please check for infinite loops or other slowness."
+
 // Docker is an implementation of the CI interface using Docker.
 type Docker struct {
 	client *client.Client
 	logger *zap.SugaredLogger
+	pool   *pool
+}
+
+// DockerOptions configures the docker runner.
+type DockerOptions struct {
+	// PoolSize is the number of pre-warmed containers to keep for each image
+	// and limits of the jobs that have been run. Defaults to no pre-warmed containers.
+	PoolSize int
 }
 
 // NewDockerCI returns a runner to run CI tests.
-func NewDockerCI(logger *zap.Logger) (*Docker, error) {
+func NewDockerCI(logger *zap.Logger, opts DockerOptions) (*Docker, error) {
 	cli, err := client.NewClientWithOpts(client.FromEnv)
 	if err != nil {
 		return nil, err
 	}
-	return &Docker{
+	d := &Docker{
 		client: cli,
 		logger: logger.Sugar(),
-	}, nil
+	}
+	if opts.PoolSize > 0 {
+		d.pool = newPool(d.logger, opts.PoolSize, d.createWarmContainer, d.removeWarmContainer)
+	}
+	return d, nil
 }
 
-// Close ensures that the docker client is closed.
+// Close removes the pre-warmed containers and ensures that the docker client is closed.
 func (d *Docker) Close() error {
+	if d.pool != nil {
+		d.pool.close()
+	}
 	if d.logger != nil {
 		d.logger.Sync()
 	}
@@ -57,55 +78,420 @@ func (d *Docker) Close() error {
 // Run implements the CI interface. This method blocks until the job has been
 // completed or an error occurs, e.g., the context times out.
 func (d *Docker) Run(ctx context.Context, job *Job) (string, error) {
+	return d.RunStream(ctx, job, nil)
+}
+
+// RunStream implements the StreamingRunner interface. This method blocks until the job has been
+// completed or an error occurs, e.g., the context times out. The output of the job's containers
+// is emitted line by line while they are running. The clone commands of the job, if any, run in
+// a separate container that shares a volume with the container running the job's commands.
+// The job's commands run in a pre-warmed container, if one is available. The hidden commands
+// of the job, if any, run in another container, which is the only one besides the clone
+// container with the volume into which the clone commands set aside the hidden tests.
+func (d *Docker) RunStream(ctx context.Context, job *Job, emit func(line string)) (string, error) {
 	if d.client == nil {
 		return "", fmt.Errorf("cannot run job: %s; docker client not initialized", job.Name)
 	}
+	if len(job.Clone) == 0 {
+		return d.runContainer(ctx, job, hostConfig(job.Limits, ""), emit)
+	}
+	if job.Cache != "" {
+		_, err := d.client.VolumeInspect(ctx, job.Cache)
+		if err != nil && !client.IsErrNotFound(err) {
+			return "", err
+		}
+		// the cache volume is created when it is first mounted
+		countCacheRequest(dependencyCache, err == nil)
+	}
+	var hidden string
+	if len(job.Hidden) > 0 {
+		var err error
+		if hidden, err = d.createVolume(ctx, job, job.Name+"-hidden"); err != nil {
+			return "", err
+		}
+		defer d.removeVolume(job, hidden)
+	}
+
+	if d.pool != nil {
+		if c, ok := d.pool.take(poolKey{image: job.Image, limits: job.Limits, cache: job.Cache}); ok {
+			defer d.removeWarmContainer(c)
+			if err := d.runClone(ctx, job, c.volume, hidden); err != nil {
+				return "", err
+			}
+			out, err := d.execContainer(ctx, job, c.id, emit)
+			return d.runHidden(ctx, job, c.volume, hidden, out, err, emit)
+		}
+	}
 
-	resp, err := d.createImage(ctx, job)
+	workspace, err := d.createVolume(ctx, job, job.Name+"-workspace")
 	if err != nil {
 		return "", err
 	}
-	d.logger.Infof("Created container image '%s' for %s", job.Image, job.Name)
-	if err := d.client.ContainerStart(ctx, resp.ID, types.ContainerStartOptions{}); err != nil {
+	defer d.removeVolume(job, workspace)
+	if err := d.runClone(ctx, job, workspace, hidden); err != nil {
+		return "", err
+	}
+	testJob := &Job{Name: job.Name, Image: job.Image, Commands: testCommands(job.Commands)}
+	out, err := d.runContainer(ctx, testJob, testHostConfig(job.Limits, job.Cache, workspace), emit)
+	return d.runHidden(ctx, job, workspace, hidden, out, err, emit)
+}
+
+// runHidden runs the hidden commands of the given job, if any, after its commands have completed
+// with the given output and error, in a container with the cloned workspace in the given volume
+// and the hidden tests in the given hidden volume. It returns the output of both containers.
+func (d *Docker) runHidden(ctx context.Context, job *Job, workspace, hidden, out string, err error, emit func(line string)) (string, error) {
+	if err != nil || len(job.Hidden) == 0 {
+		return out, err
+	}
+	hc := testHostConfig(job.Limits, job.Cache, workspace)
+	hc.Mounts = append(hc.Mounts, mount.Mount{Type: mount.TypeVolume, Source: hidden, Target: HiddenDir, ReadOnly: true})
+	hiddenJob := &Job{Name: job.Name + "-hidden", Image: job.Image, Commands: testCommands(job.Hidden)}
+	hiddenOut, err := d.runContainer(ctx, hiddenJob, hc, emit)
+	return out + hiddenOut, err
+}
+
+// RunSteps implements the PipelineRunner interface. This method blocks until the steps of the job
+// have completed or an error occurs, e.g., the context times out. The clone commands of the job run
+// in a separate container that shares a volume with the containers running the steps, which share
+// another volume with the artifacts of the steps.
+func (d *Docker) RunSteps(ctx context.Context, job *Job, emit func(line string)) ([]*StepResult, error) {
+	if d.client == nil {
+		return nil, fmt.Errorf("cannot run job: %s; docker client not initialized", job.Name)
+	}
+	workspace, err := d.createVolume(ctx, job, job.Name+"-workspace")
+	if err != nil {
+		return nil, err
+	}
+	defer d.removeVolume(job, workspace)
+	artifacts, err := d.createVolume(ctx, job, job.Name+"-artifacts")
+	if err != nil {
+		return nil, err
+	}
+	defer d.removeVolume(job, artifacts)
+
+	mounts := []mount.Mount{{Type: mount.TypeVolume, Source: workspace, Target: Workspace}}
+	if job.Tests != "" {
+		mounts = append(mounts, mount.Mount{Type: mount.TypeVolume, Source: job.Tests, Target: TestsDir, ReadOnly: true})
+	}
+	if err := d.clone(ctx, job, mounts); err != nil {
+		return nil, err
+	}
+
+	return runSteps(ctx, job, emit, func(ctx context.Context, step *Step, emit func(line string)) (string, int, error) {
+		hc := testHostConfig(job.Limits, job.Cache, workspace)
+		hc.Mounts = append(hc.Mounts, mount.Mount{Type: mount.TypeVolume, Source: artifacts, Target: artifactsDir})
+		stepJob := &Job{Name: job.Name + "-" + step.Name, Image: step.Image, Commands: stepCommands(step), Env: step.Env}
+		out, exitCode, err := d.runContainerStatus(ctx, stepJob, hc, emit)
+		return out, int(exitCode), err
+	})
+}
+
+// artifactsDir is the folder at which the volume with the artifacts of the steps of a job is mounted.
+const artifactsDir = "/quickfeed-artifacts"
+
+// stepCommands returns the commands of the given step, preceded by commands that copy the cloned workspace
+// and the artifacts of the preceding steps, and followed by commands that copy the artifacts of the step.
+// The step fails if any of its commands fails.
+func stepCommands(step *Step) []string {
+	commands := []string{
+		"set -e",
+		fmt.Sprintf("mkdir -p %s && cp -a %s/. %s/ && cp -a %s/. %s/", Workspace, cloneDir, Workspace, artifactsDir, Workspace),
+		"cd " + Workspace,
+		"(",
+	}
+	commands = append(commands, step.Commands...)
+	commands = append(commands, ")")
+	for _, artifact := range step.Artifacts {
+		artifact = strings.TrimSuffix(artifact, "/")
+		commands = append(commands, fmt.Sprintf(`cd %s && rm -rf "%s/%s" && mkdir -p "$(dirname "%s/%s")" && cp -a "%s" "%s/%s"`,
+			Workspace, artifactsDir, artifact, artifactsDir, artifact, artifact, artifactsDir, artifact))
+	}
+	return commands
+}
+
+// createVolume creates a volume with the given name for the given job.
+func (d *Docker) createVolume(ctx context.Context, job *Job, name string) (string, error) {
+	vol, err := d.client.VolumeCreate(ctx, volume.VolumeCreateBody{Name: name})
+	if err != nil {
 		return "", err
 	}
+	return vol.Name, nil
+}
+
+// removeVolume removes the given volume of the given job.
+func (d *Docker) removeVolume(job *Job, name string) {
+	if err := d.client.VolumeRemove(context.Background(), name, true); err != nil {
+		d.logger.Errorf("Failed to remove volume '%s' for %s: %v", name, job.Name, err)
+	}
+}
+
+// RefreshTests implements the CachingRunner interface. This method blocks until the
+// commands of the job have completed, and returns an error if any of them fails.
+func (d *Docker) RefreshTests(ctx context.Context, job *Job) error {
+	if d.client == nil {
+		return fmt.Errorf("cannot run job: %s; docker client not initialized", job.Name)
+	}
+	const refreshed = "*** Refreshed tests snapshot ***"
+	refreshJob := &Job{
+		Name:     job.Name,
+		Image:    job.Image,
+		Commands: append(append([]string{"set -e"}, job.Commands...), fmt.Sprintf("echo '%s'", refreshed)),
+	}
+	out, err := d.runContainer(ctx, refreshJob, &container.HostConfig{
+		Mounts: []mount.Mount{{Type: mount.TypeVolume, Source: job.Tests, Target: TestsDir}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+	if !strings.HasSuffix(strings.TrimSpace(out), refreshed) {
+		return fmt.Errorf("failed to refresh tests snapshot in volume '%s': %s", job.Tests, out)
+	}
+	return nil
+}
+
+// runClone runs the clone commands of the given job in a container that writes the workspace to the given volume,
+// and the hidden tests to the given hidden volume, if any. The dependency cache of the job is writable and
+// the snapshot of its tests repository is read-only.
+func (d *Docker) runClone(ctx context.Context, job *Job, workspace, hidden string) error {
+	mounts := []mount.Mount{{Type: mount.TypeVolume, Source: workspace, Target: Workspace}}
+	if job.Cache != "" {
+		mounts = append(mounts, mount.Mount{Type: mount.TypeVolume, Source: job.Cache, Target: CacheDir})
+	}
+	if job.Tests != "" {
+		mounts = append(mounts, mount.Mount{Type: mount.TypeVolume, Source: job.Tests, Target: TestsDir, ReadOnly: true})
+	}
+	if hidden != "" {
+		mounts = append(mounts, mount.Mount{Type: mount.TypeVolume, Source: hidden, Target: HiddenDir})
+	}
+	return d.clone(ctx, job, mounts)
+}
+
+// clone runs the clone commands of the given job in a container with the given mounts.
+// The output of the clone commands is neither emitted nor returned, since it may reveal
+// the access token; it is only logged by the server if the clone commands fail.
+func (d *Docker) clone(ctx context.Context, job *Job, mounts []mount.Mount) error {
+	cloneJob := &Job{Name: job.Name + "-clone", Image: job.Image, Commands: job.Clone}
+	out, exitCode, err := d.runContainerStatus(ctx, cloneJob, &container.HostConfig{Mounts: mounts}, nil)
+	if err != nil {
+		return err
+	}
+	if exitCode != 0 {
+		d.logger.Errorf("Failed to clone repositories for %s: %s", job.Name, out)
+		return fmt.Errorf("clone commands of %s exited with status %d", job.Name, exitCode)
+	}
+	return nil
+}
+
+// testCommands returns the given commands of a job, preceded by a command that copies
+// the cloned workspace, so that the files written by the commands are counted against the disk limit.
+func testCommands(commands []string) []string {
+	return append([]string{fmt.Sprintf("mkdir -p %s && cp -a %s/. %s/", Workspace, cloneDir, Workspace)}, commands...)
+}
+
+// cloneDir is the folder at which the cloned workspace is mounted read-only for the job's commands.
+const cloneDir = "/quickfeed-clone"
+
+// hostConfig returns the host configuration that enforces the given limits on a container.
+// If cloneVolume is not empty, the volume is mounted read-only at cloneDir.
+func hostConfig(limits Limits, cloneVolume string) *container.HostConfig {
+	hc := &container.HostConfig{ReadonlyRootfs: limits.ReadOnly}
+	if limits.Memory > 0 {
+		hc.Memory = limits.Memory
+		// prevent the container from using swap beyond the memory limit
+		hc.MemorySwap = limits.Memory
+	}
+	if limits.CPUs > 0 {
+		hc.NanoCPUs = int64(limits.CPUs * 1e9)
+	}
+	if limits.Pids > 0 {
+		pids := limits.Pids
+		hc.PidsLimit = &pids
+	}
+	if limits.NoNetwork {
+		hc.NetworkMode = "none"
+	}
+	if limits.ReadOnly {
+		// the workspace and /tmp are the only writable folders;
+		// each may use half of the disk limit, if any
+		tmpfs := "rw,exec"
+		if limits.Disk > 0 {
+			tmpfs += ",size=" + strconv.FormatInt(limits.Disk/2, 10)
+		}
+		hc.Tmpfs = map[string]string{Workspace: tmpfs, "/tmp": tmpfs}
+	} else if limits.Disk > 0 {
+		// this requires a storage driver that supports limiting the size of containers
+		hc.StorageOpt = map[string]string{"size": strconv.FormatInt(limits.Disk, 10)}
+	}
+	if cloneVolume != "" {
+		hc.Mounts = []mount.Mount{{Type: mount.TypeVolume, Source: cloneVolume, Target: cloneDir, ReadOnly: true}}
+	}
+	return hc
+}
+
+// testHostConfig returns the host configuration of a container running the commands of a job
+// with the given limits, with the cloned workspace in the given volume mounted read-only at cloneDir
+// and the given dependency cache volume, if any, mounted read-only at CacheDir.
+func testHostConfig(limits Limits, cache, cloneVolume string) *container.HostConfig {
+	hc := hostConfig(limits, cloneVolume)
+	if cache != "" {
+		hc.Mounts = append(hc.Mounts, mount.Mount{Type: mount.TypeVolume, Source: cache, Target: CacheDir, ReadOnly: true})
+	}
+	return hc
+}
 
-	msg, err := d.waitForContainer(ctx, job, resp.ID)
+// runContainer runs the commands of the given job in a container with the given host configuration,
+// emitting the container's output line by line while it is running, and removes the container.
+func (d *Docker) runContainer(ctx context.Context, job *Job, hc *container.HostConfig, emit func(line string)) (string, error) {
+	out, _, err := d.runContainerStatus(ctx, job, hc, emit)
+	return out, err
+}
+
+// runContainerStatus is like runContainer, and also returns the exit code of the container.
+func (d *Docker) runContainerStatus(ctx context.Context, job *Job, hc *container.HostConfig, emit func(line string)) (string, int64, error) {
+	resp, err := d.createImage(ctx, job, hc)
 	if err != nil {
-		return msg, err
+		return "", 0, err
+	}
+	d.logger.Infof("Created container image '%s' for %s", job.Image, job.Name)
+	if err := d.client.ContainerStart(ctx, resp.ID, types.ContainerStartOptions{}); err != nil {
+		return "", 0, err
 	}
 
-	// extract the logs before removing the container below
+	// follow the logs while the container is running; the log reader
+	// reaches the end of the logs when the container stops
 	logReader, err := d.client.ContainerLogs(ctx, resp.ID, types.ContainerLogsOptions{
 		ShowStdout: true,
+		Follow:     true,
 	})
 	if err != nil {
-		return "", err
+		return "", 0, err
+	}
+	var stdout bytes.Buffer
+	lines := newLineWriter(&stdout, emit)
+	logsDone := make(chan error, 1)
+	go func() {
+		_, err := stdcopy.StdCopy(lines, ioutil.Discard, logReader)
+		lines.Flush()
+		logsDone <- err
+	}()
+
+	msg, exitCode, err := d.waitForContainer(ctx, job, resp.ID)
+	if err != nil {
+		// stop following the logs, so that nothing is emitted after returning
+		logReader.Close()
+		<-logsDone
+		return msg, exitCode, err
+	}
+	err = <-logsDone
+	logReader.Close()
+	if err != nil {
+		return "", exitCode, err
 	}
 
 	// remove the container when finished to prevent too many open files
 	err = d.client.ContainerRemove(ctx, resp.ID, types.ContainerRemoveOptions{})
+	if err != nil {
+		return "", exitCode, err
+	}
+
+	return output(&stdout), exitCode, nil
+}
+
+// createWarmContainer creates and starts a container that waits for the commands of a job with the given key.
+func (d *Docker) createWarmContainer(ctx context.Context, key poolKey) (*warmContainer, error) {
+	vol, err := d.client.VolumeCreate(ctx, volume.VolumeCreateBody{})
+	if err != nil {
+		return nil, err
+	}
+	job := &Job{Image: key.image, Commands: []string{"exec tail -f /dev/null"}}
+	resp, err := d.createImage(ctx, job, testHostConfig(key.limits, key.cache, vol.Name))
+	if err != nil {
+		d.removeWarmContainer(&warmContainer{volume: vol.Name})
+		return nil, err
+	}
+	c := &warmContainer{id: resp.ID, volume: vol.Name}
+	if err := d.client.ContainerStart(ctx, resp.ID, types.ContainerStartOptions{}); err != nil {
+		d.removeWarmContainer(c)
+		return nil, err
+	}
+	d.logger.Debugf("Warmed container for image '%s'", key.image)
+	return c, nil
+}
+
+// removeWarmContainer stops and removes the given container and its volume.
+func (d *Docker) removeWarmContainer(c *warmContainer) {
+	if c.id != "" {
+		if err := d.client.ContainerRemove(context.Background(), c.id, types.ContainerRemoveOptions{Force: true}); err != nil {
+			d.logger.Errorf("Failed to remove warm container '%s': %v", c.id, err)
+		}
+	}
+	if err := d.client.VolumeRemove(context.Background(), c.volume, true); err != nil {
+		d.logger.Errorf("Failed to remove volume '%s' of warm container: %v", c.volume, err)
+	}
+}
+
+// execContainer runs the commands of the given job in the given pre-warmed container,
+// emitting the output line by line while the commands are running.
+// The caller is responsible for removing the container.
+func (d *Docker) execContainer(ctx context.Context, job *Job, id string, emit func(line string)) (string, error) {
+	exec, err := d.client.ContainerExecCreate(ctx, id, types.ExecConfig{
+		AttachStdout: true,
+		Cmd:          []string{"/bin/bash", "-c", strings.Join(testCommands(job.Commands), "\n")},
+	})
 	if err != nil {
 		return "", err
 	}
+	d.logger.Infof("Running %s in warm container for image '%s'", job.Name, job.Image)
+	resp, err := d.client.ContainerExecAttach(ctx, exec.ID, types.ExecStartCheck{})
+	if err != nil {
+		return "", err
+	}
+	defer resp.Close()
 
 	var stdout bytes.Buffer
-	if _, err := stdcopy.StdCopy(&stdout, ioutil.Discard, logReader); err != nil {
-		return "", err
+	lines := newLineWriter(&stdout, emit)
+	outputDone := make(chan error, 1)
+	go func() {
+		_, err := stdcopy.StdCopy(lines, ioutil.Discard, resp.Reader)
+		lines.Flush()
+		outputDone <- err
+	}()
+
+	select {
+	case err := <-outputDone:
+		if err != nil {
+			return "", err
+		}
+	case <-ctx.Done():
+		// stop reading the output; the commands are killed when the container is removed
+		resp.Close()
+		<-outputDone
+		d.logger.Errorf("Failed to complete %s in warm container for image '%s': %v", job.Name, job.Image, ctx.Err())
+		if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return "", ctx.Err()
+		}
+		return ContainerTimeoutMessage, ctx.Err()
 	}
+	return output(&stdout), nil
+}
+
+// output returns the given output of a job, truncated if it is too long.
+func output(stdout *bytes.Buffer) string {
 	if stdout.Len() > maxLogSize+lastSegmentSize {
-		return truncateLog(&stdout, maxLogSize, lastSegmentSize, maxToScan), nil
+		return truncateLog(stdout, maxLogSize, lastSegmentSize, maxToScan)
 	}
-	return stdout.String(), nil
+	return stdout.String()
 }
 
-// createImage creates an image for the given job.
-func (d *Docker) createImage(ctx context.Context, job *Job) (*container.ContainerCreateCreatedBody, error) {
+// createImage creates an image for the given job with the given host configuration.
+func (d *Docker) createImage(ctx context.Context, job *Job, hc *container.HostConfig) (*container.ContainerCreateCreatedBody, error) {
 	create := func() (container.ContainerCreateCreatedBody, error) {
 		return d.client.ContainerCreate(ctx, &container.Config{
-			Image: job.Image,
-			Cmd:   []string{"/bin/bash", "-c", strings.Join(job.Commands, "\n")},
-		}, nil, nil, nil, job.Name)
+			Image:           job.Image,
+			Cmd:             []string{"/bin/bash", "-c", strings.Join(job.Commands, "\n")},
+			Env:             job.Env,
+			NetworkDisabled: hc.NetworkMode == "none",
+		}, hc, nil, nil, job.Name)
 	}
 
 	resp, err := create()
@@ -126,33 +512,35 @@ func (d *Docker) createImage(ctx context.Context, job *Job) (*container.Containe
 	return &resp, err
 }
 
-// waitForContainer waits until the container stops or context times out.
-func (d *Docker) waitForContainer(ctx context.Context, job *Job, respID string) (string, error) {
+// waitForContainer waits until the container stops or context times out,
+// and returns the exit code of the container.
+func (d *Docker) waitForContainer(ctx context.Context, job *Job, respID string) (string, int64, error) {
 	statusCh, errCh := d.client.ContainerWait(ctx, respID, container.WaitConditionNotRunning)
 	select {
 	case err := <-errCh:
 		if err != nil {
 			d.logger.Errorf("Failed to stop container image '%s' for %s: %v", job.Image, job.Name, err)
 			if !errors.Is(err, context.DeadlineExceeded) {
-				return "", err
+				return "", 0, err
 			}
 			// stop runaway container whose deadline was exceeded
 			timeout := time.Duration(1 * time.Second)
 			stopErr := d.client.ContainerStop(context.Background(), respID, &timeout)
 			if stopErr != nil {
-				return "", stopErr
+				return "", 0, stopErr
 			}
 			// remove the docker container (when stopped due to timeout) to prevent too many open files
 			rmErr := d.client.ContainerRemove(context.Background(), respID, types.ContainerRemoveOptions{})
 			if rmErr != nil {
-				return "", rmErr
+				return "", 0, rmErr
 			}
 			// return message to user to be shown in the results log
-			return "Container timeout. Please check for infinite loops or other slowness.", err
+			return ContainerTimeoutMessage, 0, err
 		}
-	case <-statusCh:
+	case status := <-statusCh:
+		return "", status.StatusCode, nil
 	}
-	return "", nil
+	return "", 0, nil
 }
 
 // pullImage pulls an image from docker hub; this can be slow and should be
@@ -9,12 +9,14 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/autograde/quickfeed/ci"
 	"github.com/autograde/quickfeed/log"
 	"github.com/docker/docker/client"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 )
 
 var docker bool
@@ -42,7 +44,7 @@ func TestDocker(t *testing.T) {
 		wantOut = "hello world"
 	)
 
-	docker, err := ci.NewDockerCI(log.Zap(true))
+	docker, err := ci.NewDockerCI(log.Zap(true), ci.DockerOptions{})
 	if err != nil {
 		t.Fatalf("failed to set up docker client: %v", err)
 	}
@@ -79,7 +81,7 @@ func TestDockerBuild(t *testing.T) {
 		wantOut = "hello world"
 	)
 
-	docker, err := ci.NewDockerCI(log.Zap(true))
+	docker, err := ci.NewDockerCI(log.Zap(true), ci.DockerOptions{})
 	if err != nil {
 		t.Fatalf("failed to set up docker client: %v", err)
 	}
@@ -115,7 +117,7 @@ func TestDockerTimeout(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 1000*time.Millisecond)
 	defer cancel()
 
-	docker, err := ci.NewDockerCI(log.Zap(true))
+	docker, err := ci.NewDockerCI(log.Zap(true), ci.DockerOptions{})
 	if err != nil {
 		t.Fatalf("failed to set up docker client: %v", err)
 	}
@@ -137,6 +139,114 @@ func TestDockerTimeout(t *testing.T) {
 	}
 }
 
+func TestDockerLimits(t *testing.T) {
+	if !docker {
+		t.SkipNow()
+	}
+
+	docker, err := ci.NewDockerCI(log.Zap(true), ci.DockerOptions{})
+	if err != nil {
+		t.Fatalf("failed to set up docker client: %v", err)
+	}
+	defer docker.Close()
+
+	out, err := docker.Run(context.Background(), &ci.Job{
+		Name:  "TestDockerLimits-" + randomString(t),
+		Image: "golang:latest",
+		Clone: []string{`echo -n "cloned" > /quickfeed/clone.txt`},
+		Commands: []string{
+			`cat /quickfeed/clone.txt`,
+			`touch /root/file 2> /dev/null || echo -n " read-only"`,
+			`wget -q -T 2 https://github.com 2> /dev/null || echo -n " offline"`,
+		},
+		Limits: ci.Limits{Memory: 256 << 20, Pids: 64, NoNetwork: true, ReadOnly: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const wantOut = "cloned read-only offline"
+	if !strings.HasSuffix(out, wantOut) {
+		t.Errorf("docker.Run() = %#v, want suffix %#v", out, wantOut)
+	}
+}
+
+func TestDockerPipeline(t *testing.T) {
+	if !docker {
+		t.SkipNow()
+	}
+
+	docker, err := ci.NewDockerCI(log.Zap(true), ci.DockerOptions{})
+	if err != nil {
+		t.Fatalf("failed to set up docker client: %v", err)
+	}
+	defer docker.Close()
+
+	results, err := docker.RunSteps(context.Background(), &ci.Job{
+		Name:  "TestDockerPipeline-" + randomString(t),
+		Image: "golang:latest",
+		Clone: []string{`echo -n "cloned" > /quickfeed/clone.txt`},
+		Steps: []*ci.Step{
+			{Name: "build", Image: "golang:latest", Commands: []string{`mkdir bin`, `echo -n "$GREETING" > bin/artifact.txt`}, Env: []string{"GREETING=built"}, Artifacts: []string{"bin/"}},
+			{Name: "lint", Image: "golang:latest", Commands: []string{`echo -n "linted" > lint.txt`, `exit 1`}, AllowFailure: true},
+			{Name: "test", Image: "golang:latest", Commands: []string{`cat clone.txt bin/artifact.txt`, `test -e lint.txt || echo -n " isolated"`}},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantStatus := []ci.StepStatus{ci.StepPassed, ci.StepFailed, ci.StepPassed}
+	if len(results) != len(wantStatus) {
+		t.Fatalf("docker.RunSteps() returned %d results, want %d", len(results), len(wantStatus))
+	}
+	for i, result := range results {
+		if result.Status != wantStatus[i] {
+			t.Errorf("step %s: status = %s, want %s", result.Name, result.Status, wantStatus[i])
+		}
+	}
+	const wantOut = "clonedbuilt isolated"
+	if out := results[2].Output; out != wantOut {
+		t.Errorf("step test: output = %#v, want %#v", out, wantOut)
+	}
+}
+
+func TestDockerWarmContainer(t *testing.T) {
+	if !docker {
+		t.SkipNow()
+	}
+
+	docker, err := ci.NewDockerCI(log.Zap(true), ci.DockerOptions{PoolSize: 1})
+	if err != nil {
+		t.Fatalf("failed to set up docker client: %v", err)
+	}
+	defer docker.Close()
+
+	hits := testutil.ToFloat64(ci.CacheRequestsMetric.WithLabelValues("containers", "hit"))
+	const wantOut = "hello world"
+	// the first job warms a container for the next job
+	for i := 0; i < 10; i++ {
+		out, err := docker.Run(context.Background(), &ci.Job{
+			Name:     "TestDockerWarmContainer-" + randomString(t),
+			Image:    "golang:latest",
+			Clone:    []string{`echo -n "hello world" > /quickfeed/hello.txt`},
+			Commands: []string{`cat /quickfeed/hello.txt`},
+			Limits:   ci.Limits{Memory: 256 << 20, ReadOnly: true},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != wantOut {
+			t.Errorf("docker.Run() = %#v, want %#v", out, wantOut)
+		}
+		if testutil.ToFloat64(ci.CacheRequestsMetric.WithLabelValues("containers", "hit")) > hits {
+			return
+		}
+		time.Sleep(time.Second)
+	}
+	t.Error("no job ran in a warm container")
+}
+
 func TestDockerOpenFileDescriptors(t *testing.T) {
 	// This is mainly for debugging the 'too many open file descriptors' issue
 	if !docker {
@@ -149,7 +259,7 @@ func TestDockerOpenFileDescriptors(t *testing.T) {
 		numContainers = 5
 	)
 
-	docker, err := ci.NewDockerCI(log.Zap(true))
+	docker, err := ci.NewDockerCI(log.Zap(true), ci.DockerOptions{})
 	if err != nil {
 		t.Fatalf("failed to set up docker client: %v", err)
 	}
@@ -0,0 +1,54 @@
+package ci
+
+import (
+	"testing"
+
+	"github.com/docker/docker/api/types/mount"
+)
+
+func TestHostConfig(t *testing.T) {
+	hc := hostConfig(Limits{}, "")
+	if hc.Memory != 0 || hc.NanoCPUs != 0 || hc.PidsLimit != nil || hc.NetworkMode != "" ||
+		hc.ReadonlyRootfs || len(hc.Tmpfs) != 0 || len(hc.StorageOpt) != 0 || len(hc.Mounts) != 0 {
+		t.Errorf("hostConfig(no limits) = %+v, want no limits", hc)
+	}
+
+	limits := Limits{Memory: 512 << 20, CPUs: 1.5, Pids: 128, Disk: 1 << 30, NoNetwork: true}
+	hc = hostConfig(limits, "")
+	if hc.Memory != limits.Memory || hc.MemorySwap != limits.Memory {
+		t.Errorf("Memory, MemorySwap = %d, %d, want %d, %d", hc.Memory, hc.MemorySwap, limits.Memory, limits.Memory)
+	}
+	if hc.NanoCPUs != 1500000000 {
+		t.Errorf("NanoCPUs = %d, want %d", hc.NanoCPUs, 1500000000)
+	}
+	if hc.PidsLimit == nil || *hc.PidsLimit != 128 {
+		t.Errorf("PidsLimit = %v, want 128", hc.PidsLimit)
+	}
+	if hc.NetworkMode != "none" {
+		t.Errorf("NetworkMode = %q, want %q", hc.NetworkMode, "none")
+	}
+	if hc.ReadonlyRootfs || len(hc.Tmpfs) != 0 {
+		t.Errorf("ReadonlyRootfs, Tmpfs = %t, %v, want writable root", hc.ReadonlyRootfs, hc.Tmpfs)
+	}
+	if got := hc.StorageOpt["size"]; got != "1073741824" {
+		t.Errorf("StorageOpt[size] = %q, want %q", got, "1073741824")
+	}
+
+	limits.ReadOnly = true
+	hc = hostConfig(limits, "job-workspace")
+	if !hc.ReadonlyRootfs {
+		t.Error("ReadonlyRootfs = false, want true")
+	}
+	for _, dir := range []string{Workspace, "/tmp"} {
+		if got, want := hc.Tmpfs[dir], "rw,exec,size=536870912"; got != want {
+			t.Errorf("Tmpfs[%s] = %q, want %q", dir, got, want)
+		}
+	}
+	if len(hc.StorageOpt) != 0 {
+		t.Errorf("StorageOpt = %v, want none with read-only root", hc.StorageOpt)
+	}
+	want := mount.Mount{Type: mount.TypeVolume, Source: "job-workspace", Target: cloneDir, ReadOnly: true}
+	if len(hc.Mounts) != 1 || hc.Mounts[0] != want {
+		t.Errorf("Mounts = %+v, want [%+v]", hc.Mounts, want)
+	}
+}
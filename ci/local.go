@@ -0,0 +1,258 @@
+package ci
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Local is an implementation of the CI interface executing code locally, for deployments without Docker.
+// Each job runs in a new temporary folder, which replaces the workspace folder in the commands of the job,
+// with a home folder of its own and without the environment of the server. The clone commands of a job
+// run in a separate shell before its commands, with a separate home folder, so that the access token
+// configured by the clone commands is not available to the commands. The processes of a job are killed
+// when its context is done. The steps of a pipeline run in the same folder, so that their artifacts need
+// not be copied. The hidden tests set aside by the clone commands are kept in memory while the commands
+// run, and the hidden commands run in the same folder after the commands.
+// On Linux and macOS, the processes are limited by rlimits, as described by localLimits.
+type Local struct {
+	// Unshare runs the commands of each job in new Linux namespaces with unshare(1), so that
+	// they cannot see or signal the other processes of the machine, and have no network access
+	// if the job's limits disable it. Unshare requires unprivileged user namespaces.
+	Unshare bool
+}
+
+// Run implements the CI interface. This method blocks until the job has been
+// completed or an error occurs, e.g., the context times out. The output is
+// returned even if an error occurs.
+func (l *Local) Run(ctx context.Context, job *Job) (string, error) {
+	return l.RunStream(ctx, job, nil)
+}
+
+// RunStream implements the StreamingRunner interface. This method blocks until the job
+// has been completed or an error occurs, e.g., the context is canceled.
+// The output is emitted line by line while the job is running.
+func (l *Local) RunStream(ctx context.Context, job *Job, emit func(line string)) (string, error) {
+	w, err := newLocalWorkspace()
+	if err != nil {
+		return "", err
+	}
+	defer w.remove()
+
+	var stdout bytes.Buffer
+	lines := newLineWriter(&stdout, emit)
+	if err := l.clone(ctx, w, job); err != nil {
+		return localOutput(ctx, &stdout), err
+	}
+	hidden, err := w.stashHidden()
+	if err != nil {
+		return "", err
+	}
+	run := func(commands []string) error {
+		cmd, err := l.command(ctx, w, commands, w.env(w.home, job.Env), job.Limits, job.Limits.NoNetwork)
+		if err != nil {
+			return err
+		}
+		err = runCommand(ctx, cmd, lines)
+		lines.Flush()
+		return err
+	}
+	if len(job.Commands) > 0 {
+		if err := run(job.Commands); err != nil {
+			return localOutput(ctx, &stdout), err
+		}
+	}
+	if len(job.Hidden) > 0 {
+		if err := w.restoreHidden(hidden); err != nil {
+			return "", err
+		}
+		if err := run(job.Hidden); err != nil {
+			return localOutput(ctx, &stdout), err
+		}
+	}
+	return output(&stdout), nil
+}
+
+// RunSteps implements the PipelineRunner interface. This method blocks until the steps of
+// the job have completed or an error occurs, e.g., the context is canceled.
+// The output is emitted line by line while the steps are running.
+func (l *Local) RunSteps(ctx context.Context, job *Job, emit func(line string)) ([]*StepResult, error) {
+	w, err := newLocalWorkspace()
+	if err != nil {
+		return nil, err
+	}
+	defer w.remove()
+
+	if err := l.clone(ctx, w, job); err != nil {
+		return nil, err
+	}
+	return runSteps(ctx, job, emit, func(ctx context.Context, step *Step, emit func(line string)) (string, int, error) {
+		var stdout bytes.Buffer
+		lines := newLineWriter(&stdout, emit)
+		cmd, err := l.command(ctx, w, append([]string{"set -e"}, step.Commands...), w.env(w.home, step.Env), job.Limits, job.Limits.NoNetwork)
+		if err != nil {
+			return "", 0, err
+		}
+		err = runCommand(ctx, cmd, lines)
+		lines.Flush()
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return output(&stdout), exitErr.ExitCode(), nil
+		}
+		return output(&stdout), 0, err
+	})
+}
+
+// clone runs the clone commands of the given job in the given workspace. The output of the clone commands
+// is discarded, like with Docker, since it may reveal the access token; a generic error is returned if they fail.
+// The clone commands have network access, and their home folder is removed when they have completed.
+func (l *Local) clone(ctx context.Context, w *localWorkspace, job *Job) error {
+	defer os.RemoveAll(w.cloneHome)
+	if len(job.Clone) == 0 {
+		return nil
+	}
+	cmd, err := l.command(ctx, w, job.Clone, w.env(w.cloneHome, nil), job.Limits, false)
+	if err != nil {
+		return err
+	}
+	if err := runCommand(ctx, cmd, ioutil.Discard); err != nil {
+		return fmt.Errorf("clone commands of %s failed: %w", job.Name, err)
+	}
+	return nil
+}
+
+// localOutput returns the given output of a job that failed, followed
+// by the timeout message if the job failed because it timed out.
+func localOutput(ctx context.Context, stdout *bytes.Buffer) string {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		if stdout.Len() > 0 && !bytes.HasSuffix(stdout.Bytes(), []byte("\n")) {
+			stdout.WriteString("\n")
+		}
+		stdout.WriteString(ContainerTimeoutMessage)
+	}
+	return output(stdout)
+}
+
+// localWorkspace is the temporary folder of a job run by the Local runner.
+type localWorkspace struct {
+	dir       string // the temporary folder; removed with the folders below when the job has completed
+	workspace string // replaces the workspace folder in the commands of the job, which run in this folder
+	cloneHome string // home folder of the clone commands
+	home      string // home folder of the commands
+	tmp       string // temporary folder of the commands
+	hidden    string // replaces the folder of the hidden tests in the commands of the job
+}
+
+func newLocalWorkspace() (*localWorkspace, error) {
+	dir, err := ioutil.TempDir("", "quickfeed-local")
+	if err != nil {
+		return nil, err
+	}
+	w := &localWorkspace{
+		dir:       dir,
+		workspace: filepath.Join(dir, "workspace"),
+		cloneHome: filepath.Join(dir, "clone-home"),
+		home:      filepath.Join(dir, "home"),
+		tmp:       filepath.Join(dir, "tmp"),
+		hidden:    filepath.Join(dir, "hidden"),
+	}
+	for _, d := range []string{w.workspace, w.cloneHome, w.home, w.tmp, w.hidden} {
+		if err := os.Mkdir(d, 0o700); err != nil {
+			w.remove()
+			return nil, err
+		}
+	}
+	return w, nil
+}
+
+func (w *localWorkspace) remove() {
+	os.RemoveAll(w.dir)
+}
+
+// workspacePath matches the workspace folder in the commands of a job,
+// but not the other folders whose names start with the workspace folder.
+var workspacePath = regexp.MustCompile(regexp.QuoteMeta(Workspace) + `([/\s"';&|)]|$)`)
+
+// script returns a shell script with the given commands, in which the workspace folder is replaced by this workspace,
+// and the folder of the hidden tests by this workspace's folder of the hidden tests.
+func (w *localWorkspace) script(commands []string) string {
+	script := strings.ReplaceAll(strings.Join(commands, "\n"), HiddenDir, filepath.ToSlash(w.hidden))
+	return workspacePath.ReplaceAllString(script, strings.ReplaceAll(filepath.ToSlash(w.workspace), "$", "$$")+"${1}")
+}
+
+// hiddenFile is a file that the clone commands of a job set aside in the folder of the hidden tests.
+type hiddenFile struct {
+	path    string // relative to the folder of the hidden tests
+	mode    os.FileMode
+	content []byte
+}
+
+// stashHidden reads the files in the folder of the hidden tests into memory and removes the folder,
+// so that the code under test cannot read the hidden tests while the commands of the job run.
+func (w *localWorkspace) stashHidden() ([]hiddenFile, error) {
+	var files []hiddenFile
+	err := filepath.Walk(w.hidden, func(path string, info os.FileInfo, err error) error {
+		if err != nil || !info.Mode().IsRegular() {
+			return err
+		}
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(w.hidden, path)
+		if err != nil {
+			return err
+		}
+		files = append(files, hiddenFile{path: rel, mode: info.Mode().Perm(), content: content})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, os.RemoveAll(w.hidden)
+}
+
+// restoreHidden replaces the folder of the hidden tests with one that holds the given files.
+func (w *localWorkspace) restoreHidden(files []hiddenFile) error {
+	if err := os.RemoveAll(w.hidden); err != nil {
+		return err
+	}
+	if err := os.Mkdir(w.hidden, 0o700); err != nil {
+		return err
+	}
+	for _, f := range files {
+		path := filepath.Join(w.hidden, f.path)
+		if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(path, f.content, f.mode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// env returns the environment of commands with the given home folder, followed by the given variables.
+func (w *localWorkspace) env(home string, env []string) []string {
+	return append(append(baseEnv(), "HOME="+home, "TMPDIR="+w.tmp), env...)
+}
+
+// command returns a command that runs a shell script with the given commands in the given workspace,
+// with the given environment and limits. The command has network access unless noNetwork is set
+// and the commands run in new namespaces.
+func (l *Local) command(ctx context.Context, w *localWorkspace, commands []string, env []string, limits Limits, noNetwork bool) (*exec.Cmd, error) {
+	if l.Unshare && !unshareSupported {
+		return nil, fmt.Errorf("running jobs in new namespaces is not supported on this platform")
+	}
+	cmd := shellCommand(ctx, l.Unshare, noNetwork, limits, w.script(commands))
+	cmd.Dir = w.workspace
+	cmd.Env = env
+	return cmd, nil
+}
@@ -4,21 +4,87 @@ package ci
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"math"
+	"os"
 	"os/exec"
-	"strings"
+	"runtime"
+	"syscall"
+	"time"
 )
 
-// Local is an implementation of the CI interface executing code locally.
-type Local struct{}
-
-// Run implements the CI interface. This method blocks until the job has been
-// completed or an error occurs, e.g., the context times out.
-func (l *Local) Run(ctx context.Context, job *Job) (string, error) {
-	// TODO: Execute tests in something like ioutil.TempDir(os.TempDir(), "local-ci").
-	cmd := exec.Command("/bin/sh", "-c", strings.Join(job.Commands, "\n"))
-	b, err := cmd.Output()
-	if err != nil {
-		return "", err
+// unshareSupported is true if jobs can run in new namespaces on this platform.
+var unshareSupported = runtime.GOOS == "linux"
+
+// localOpenFiles is the maximum number of open files of each process of a job run by the Local runner.
+const localOpenFiles = 1024
+
+// baseEnv returns the environment of the server that is passed on to the commands of a job.
+func baseEnv() []string {
+	return []string{"PATH=" + os.Getenv("PATH")}
+}
+
+// shellCommand returns a command that runs the given shell script with the given limits,
+// in new namespaces with unshare(1) if unshare is set.
+func shellCommand(ctx context.Context, unshare, noNetwork bool, limits Limits, script string) *exec.Cmd {
+	args := []string{"/bin/sh", "-c", localLimits(ctx, limits) + script}
+	if !unshare {
+		return exec.Command(args[0], args[1:]...)
+	}
+	flags := []string{"--user", "--map-root-user", "--pid", "--fork", "--kill-child", "--mount-proc", "--ipc", "--uts"}
+	if noNetwork {
+		flags = append(flags, "--net")
+	}
+	return exec.Command("unshare", append(append(flags, "--"), args...)...)
+}
+
+// localLimits returns the shell commands that limit the processes of a job with rlimits: the CPU time
+// of each process to the time left until the deadline of the given context, the virtual memory of each
+// process to the memory limit of the job, and the number of open files of each process to localOpenFiles.
+// The other limits of the job are not enforced.
+func localLimits(ctx context.Context, limits Limits) string {
+	openFiles := uint64(localOpenFiles)
+	var rlimit syscall.Rlimit
+	if err := syscall.Getrlimit(syscall.RLIMIT_NOFILE, &rlimit); err == nil && rlimit.Max < openFiles {
+		openFiles = rlimit.Max
+	}
+	commands := fmt.Sprintf("ulimit -n %d || exit 1\n", openFiles)
+	if deadline, ok := ctx.Deadline(); ok {
+		seconds := int64(math.Ceil(time.Until(deadline).Seconds()))
+		if seconds < 1 {
+			seconds = 1
+		}
+		commands += fmt.Sprintf("ulimit -t %d || exit 1\n", seconds)
+	}
+	if limits.Memory > 0 {
+		commands += fmt.Sprintf("ulimit -v %d || exit 1\n", (limits.Memory+1023)/1024)
+	}
+	return commands
+}
+
+// runCommand runs the given command with its standard output and error written to out.
+// The command runs in a new process group, which is killed when the given context is done,
+// and when the command has completed, to kill the processes it left behind.
+func runCommand(ctx context.Context, cmd *exec.Cmd, out io.Writer) error {
+	cmd.Stdout, cmd.Stderr = out, out
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+		case <-done:
+		}
+	}()
+	err := cmd.Wait()
+	close(done)
+	_ = syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	if ctx.Err() != nil {
+		return ctx.Err()
 	}
-	return string(b), nil
+	return err
 }
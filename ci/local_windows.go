@@ -2,20 +2,45 @@ package ci
 
 import (
 	"context"
+	"io"
+	"os"
 	"os/exec"
-	"strings"
 )
 
-// Local is an implementation of the CI interface executing code locally.
-type Local struct{}
+// unshareSupported is true if jobs can run in new namespaces on this platform.
+const unshareSupported = false
 
-// Run implements the CI interface. This method blocks until the job has been
-// completed or an error occurs, e.g., the context times out.
-func (l *Local) Run(ctx context.Context, job *Job) (string, error) {
-	cmd := exec.Command("bash", "-c", strings.Join(job.Commands, "\n"))
-	b, err := cmd.Output()
-	if err != nil {
-		return "", err
+// baseEnv returns the environment of the server that is passed on to the commands of a job.
+// Windows programs depend on many environment variables, so the whole environment is passed on.
+func baseEnv() []string {
+	return os.Environ()
+}
+
+// shellCommand returns a command that runs the given shell script.
+// The limits of the job are not enforced on Windows.
+func shellCommand(ctx context.Context, unshare, noNetwork bool, limits Limits, script string) *exec.Cmd {
+	return exec.Command("bash", "-c", script)
+}
+
+// runCommand runs the given command with its standard output and error written to out.
+// The command is killed when the given context is done; the processes it started are not.
+func runCommand(ctx context.Context, cmd *exec.Cmd, out io.Writer) error {
+	cmd.Stdout, cmd.Stderr = out, out
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = cmd.Process.Kill()
+		case <-done:
+		}
+	}()
+	err := cmd.Wait()
+	close(done)
+	if ctx.Err() != nil {
+		return ctx.Err()
 	}
-	return string(b), nil
+	return err
 }
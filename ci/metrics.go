@@ -0,0 +1,27 @@
+package ci
+
+import "github.com/prometheus/client_golang/prometheus"
+
+// Caches for which hits and misses are counted by CacheRequestsMetric.
+const (
+	dependencyCache = "dependencies" // the dependency cache volume of a course exists
+	testsCache      = "tests"        // a snapshot of the tests repository of a course is available
+	containerCache  = "containers"   // a pre-warmed container is available for the image and limits of a job
+)
+
+var (
+	// CacheRequestsMetric counts the cache requests of build jobs, allows
+	// grouping by cache ("dependencies", "tests", "containers") and result ("hit", "miss")
+	CacheRequestsMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ci_cache_requests",
+	}, []string{"cache", "result"})
+)
+
+// countCacheRequest counts a hit or a miss for the given cache.
+func countCacheRequest(cache string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	CacheRequestsMetric.WithLabelValues(cache, result).Inc()
+}
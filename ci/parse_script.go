@@ -19,6 +19,15 @@ type AssignmentInfo struct {
 	GetURL             string
 	TestURL            string
 	RandomSecret       string
+	CommitID           string   // the commit to build; the latest commit if empty
+	HiddenTests        []string // paths of hidden tests relative to the assignment folder
+	RunHidden          bool     // run the hidden tests after the visible tests
+	HiddenSecret       string   // secret of the hidden tests' score lines
+	CacheDir           string   // folder of the course's dependency cache; no cache if empty
+	TestsSnapshot      string   // folder of the snapshot of the tests repository; clone it if empty
+
+	cacheVolume string // volume with the course's dependency cache
+	testsVolume string // volume with the snapshot of the course's tests repository
 }
 
 func newAssignmentInfo(course *pb.Course, assignment *pb.Assignment, cloneURL, testURL string) *AssignmentInfo {
@@ -27,25 +36,50 @@ func newAssignmentInfo(course *pb.Course, assignment *pb.Assignment, cloneURL, t
 		script = script + ".sh"
 	}
 
-	return &AssignmentInfo{
+	info := &AssignmentInfo{
 		AssignmentName:     assignment.GetName(),
 		Script:             script,
 		CreatorAccessToken: course.GetAccessToken(),
 		GetURL:             cloneURL,
 		TestURL:            testURL,
 		RandomSecret:       randomSecret(),
+		HiddenTests:        assignment.HiddenTestPaths(),
+	}
+	if len(info.HiddenTests) > 0 {
+		info.HiddenSecret = randomSecret()
 	}
+	return info
 }
 
+// cloneMarker is the line of a script template separating the commands that
+// clone the repositories from the commands that run the tests.
+const cloneMarker = "#test"
+
+// hiddenMarker is the line of a script template separating the commands that
+// run the tests from the commands that run the hidden tests.
+const hiddenMarker = "#hidden"
+
 // parseScriptTemplate returns a job describing the docker image to use and
 // the commands of the job. The job is extracted from a script template file
 // provided as input along with assignment metadata for the template.
+// The lines before a line with the clone marker are the job's clone commands,
+// and the lines after a line with the hidden marker are its hidden commands.
+// An error is returned if the assignment has hidden tests and the script
+// template does not set them aside, since they would be visible to students,
+// and if the course creator's access token is used outside the clone commands,
+// since it would be available to the code under test.
 func parseScriptTemplate(scriptPath string, info *AssignmentInfo) (*Job, error) {
 	tmplFile := filepath.Join(scriptPath, info.Script)
 	t, err := template.ParseFiles(tmplFile)
 	if err != nil {
 		return nil, err
 	}
+	if len(info.HiddenTests) > 0 && !strings.Contains(t.Root.String(), ".HiddenTests") {
+		return nil, fmt.Errorf("script template %s does not support hidden tests", tmplFile)
+	}
+	if usesAccessToken(t.Root.String()) {
+		return nil, fmt.Errorf("script template %s uses the access token outside the clone commands", tmplFile)
+	}
 	buffer := new(bytes.Buffer)
 	if err := t.Execute(buffer, info); err != nil {
 		return nil, err
@@ -58,5 +92,32 @@ func parseScriptTemplate(scriptPath string, info *AssignmentInfo) (*Job, error)
 	if len(parts) < 2 {
 		return nil, fmt.Errorf("no docker image specified in script template %s", tmplFile)
 	}
-	return &Job{Image: parts[1], Commands: s[1:]}, nil
+	job := &Job{Image: parts[1], Commands: s[1:]}
+	for i, line := range job.Commands {
+		if strings.TrimSpace(line) == cloneMarker {
+			job.Clone, job.Commands = job.Commands[:i], job.Commands[i+1:]
+			break
+		}
+	}
+	for i, line := range job.Commands {
+		if strings.TrimSpace(line) == hiddenMarker {
+			job.Commands, job.Hidden = job.Commands[:i], job.Commands[i+1:]
+			break
+		}
+	}
+	return job, nil
+}
+
+// usesAccessToken returns true if the given script template uses
+// the course creator's access token after the clone marker line,
+// or anywhere if the template has no clone marker.
+func usesAccessToken(tmpl string) bool {
+	lines := strings.Split(tmpl, "\n")
+	for i, line := range lines {
+		if strings.TrimSpace(line) == cloneMarker {
+			lines = lines[i+1:]
+			break
+		}
+	}
+	return strings.Contains(strings.Join(lines, "\n"), ".CreatorAccessToken")
 }
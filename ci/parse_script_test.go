@@ -4,7 +4,10 @@ import (
 	"crypto/rand"
 	"crypto/sha1"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -62,3 +65,143 @@ func TestParseScript(t *testing.T) {
 		fmt.Println(j.Image)
 	}
 }
+
+func TestParseScriptWithHiddenTests(t *testing.T) {
+	info := &AssignmentInfo{
+		AssignmentName:     "lab2",
+		Script:             "go.sh",
+		CreatorAccessToken: "secret",
+		GetURL:             getURL,
+		TestURL:            testURL,
+		RandomSecret:       "visible",
+		CommitID:           "deadbeef",
+		HiddenTests:        []string{"hidden_test.go", "stress/"},
+		HiddenSecret:       "hidden",
+	}
+	j, err := parseScriptTemplate("scripts", info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if clone := strings.Join(j.Clone, "\n"); !strings.Contains(clone, "checkout -q deadbeef") {
+		t.Errorf("clone commands do not check out the commit:\n%s", clone)
+	}
+	// the hidden tests are removed before the code under test runs, and are not run
+	clone := strings.Join(j.Clone, "\n")
+	for _, want := range []string{
+		`rm -rf "/quickfeed/tests/lab2/hidden_test.go"`,
+		`rm -rf "/quickfeed/tests/lab2/stress/"`,
+	} {
+		if !strings.Contains(clone, want) {
+			t.Errorf("clone commands do not contain %q:\n%s", want, clone)
+		}
+	}
+	if script := strings.Join(append(j.Commands, j.Hidden...), "\n"); strings.Contains(script, "QUICKFEED_SESSION_SECRET=hidden") || strings.Contains(script, HiddenDir) {
+		t.Errorf("script runs hidden tests:\n%s", script)
+	}
+
+	// the hidden tests are set aside for the hidden commands, which run them
+	info.RunHidden = true
+	j, err = parseScriptTemplate("scripts", info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clone = strings.Join(j.Clone, "\n")
+	for _, want := range []string{
+		`mv "/quickfeed/tests/lab2/hidden_test.go" "/quickfeed-hidden/hidden_test.go"`,
+		`mv "/quickfeed/tests/lab2/stress/" "/quickfeed-hidden/stress/"`,
+	} {
+		if !strings.Contains(clone, want) {
+			t.Errorf("clone commands do not contain %q:\n%s", want, clone)
+		}
+	}
+	if script := strings.Join(j.Commands, "\n"); strings.Contains(script, "QUICKFEED_SESSION_SECRET=hidden") || strings.Contains(script, HiddenDir) {
+		t.Errorf("commands use the hidden tests:\n%s", script)
+	}
+	if hidden := strings.Join(j.Hidden, "\n"); !strings.Contains(hidden, "QUICKFEED_SESSION_SECRET=hidden") || !strings.Contains(hidden, HiddenDir) {
+		t.Errorf("hidden commands do not run hidden tests:\n%s", hidden)
+	}
+
+	// scripts that do not set aside hidden tests would reveal them to students
+	info.Script = "python361.sh"
+	if _, err := parseScriptTemplate("scripts", info); err == nil {
+		t.Error("parseScriptTemplate() for script without hidden tests support succeeded, want error")
+	}
+}
+
+func TestParseScriptCloneStep(t *testing.T) {
+	info := &AssignmentInfo{
+		AssignmentName:     "lab2",
+		CreatorAccessToken: "access-token",
+		GetURL:             getURL,
+		TestURL:            testURL,
+		RandomSecret:       "secret",
+	}
+	for _, script := range []string{"go.sh", "python361.sh", "python-dat550.sh", "python-ml.sh", "java8.sh", "java12.sh"} {
+		info.Script = script
+		j, err := parseScriptTemplate("scripts", info)
+		if err != nil {
+			t.Fatal(err)
+		}
+		clone := strings.Join(j.Clone, "\n")
+		if !strings.Contains(clone, "access-token") || !strings.Contains(clone, getURL) || !strings.Contains(clone, testURL) {
+			t.Errorf("%s: clone commands do not clone the repositories with the access token:\n%s", script, clone)
+		}
+		if commands := strings.Join(j.Commands, "\n"); strings.Contains(commands, "access-token") {
+			t.Errorf("%s: commands use the access token:\n%s", script, commands)
+		}
+	}
+
+	// the access token must not be available to the code under test
+	dir, err := ioutil.TempDir("", "scripts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for name, template := range map[string]string{
+		"no-clone.sh":    "#image/quickfeed:go\ngit clone https://{{ .CreatorAccessToken }}@github.com/dat520/tests\ngo test ./...\n",
+		"after-clone.sh": "#image/quickfeed:go\ngit clone {{ .GetURL }}\n#test\necho {{ .CreatorAccessToken }}\n",
+	} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(template), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		info.Script = name
+		if _, err := parseScriptTemplate(dir, info); err == nil {
+			t.Errorf("parseScriptTemplate(%s) succeeded, want error", name)
+		}
+	}
+}
+
+func TestParseScriptCaches(t *testing.T) {
+	info := &AssignmentInfo{
+		AssignmentName:     "lab2",
+		CreatorAccessToken: "access-token",
+		GetURL:             getURL,
+		TestURL:            testURL,
+		RandomSecret:       "secret",
+	}
+	jobCaches{cache: "quickfeed-cache-1", tests: "quickfeed-tests-1"}.use(info)
+	tests := []struct {
+		script      string
+		testsDir    string
+		wantClone   string
+		wantCommand string
+	}{
+		{script: "go.sh", testsDir: "/quickfeed/tests", wantClone: "GOMODCACHE=/quickfeed-cache/go/mod", wantCommand: "GOPROXY=file:///quickfeed-cache/go/mod/cache/download"},
+		{script: "python361.sh", testsDir: "/quickfeed/test", wantClone: "pip download", wantCommand: "PIP_FIND_LINKS=/quickfeed-cache/pip"},
+		{script: "java8.sh", testsDir: "/quickfeed/test"},
+	}
+	for _, tt := range tests {
+		info.Script = tt.script
+		j, err := parseScriptTemplate("scripts", info)
+		if err != nil {
+			t.Fatal(err)
+		}
+		clone, commands := strings.Join(j.Clone, "\n"), strings.Join(j.Commands, "\n")
+		if strings.Contains(clone, testURL) || !strings.Contains(clone, "cp -a /quickfeed-tests/current/. "+tt.testsDir) {
+			t.Errorf("%s: clone commands do not copy the tests snapshot:\n%s", tt.script, clone)
+		}
+		if !strings.Contains(clone, tt.wantClone) || !strings.Contains(commands, tt.wantCommand) {
+			t.Errorf("%s: script does not use the dependency cache:\n%s\n#test\n%s", tt.script, clone, commands)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package ci
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPipelineValidate(t *testing.T) {
+	step := func(name string) *PipelineStep {
+		return &PipelineStep{Name: name, Run: "go test ./..."}
+	}
+	tests := []struct {
+		name     string
+		pipeline *Pipeline
+		wantErr  bool
+	}{
+		{name: "valid", pipeline: &Pipeline{Image: "golang:latest", Env: map[string]string{"GOFLAGS": "-mod=mod"}, Steps: []*PipelineStep{
+			step("build"),
+			{Name: "test", Image: "quickfeed:go", Run: "go test ./...", Timeout: "5m", AllowFailure: true, Artifacts: []string{"bin/", "report.xml"}},
+		}}},
+		{name: "no steps", pipeline: &Pipeline{Image: "golang:latest"}, wantErr: true},
+		{name: "no image", pipeline: &Pipeline{Steps: []*PipelineStep{step("build")}}, wantErr: true},
+		{name: "invalid name", pipeline: &Pipeline{Image: "golang:latest", Steps: []*PipelineStep{step("Build Step")}}, wantErr: true},
+		{name: "duplicate name", pipeline: &Pipeline{Image: "golang:latest", Steps: []*PipelineStep{step("build"), step("build")}}, wantErr: true},
+		{name: "no commands", pipeline: &Pipeline{Image: "golang:latest", Steps: []*PipelineStep{{Name: "build", Run: "\n"}}}, wantErr: true},
+		{name: "invalid timeout", pipeline: &Pipeline{Image: "golang:latest", Steps: []*PipelineStep{{Name: "build", Run: "make", Timeout: "5 minutes"}}}, wantErr: true},
+		{name: "reserved environment variable", pipeline: &Pipeline{Image: "golang:latest", Env: map[string]string{"QUICKFEED_SESSION_SECRET": "guess"}, Steps: []*PipelineStep{step("build")}}, wantErr: true},
+		{name: "invalid environment variable", pipeline: &Pipeline{Image: "golang:latest", Steps: []*PipelineStep{{Name: "build", Run: "make", Env: map[string]string{"A-B": "1"}}}}, wantErr: true},
+		{name: "artifact outside workspace", pipeline: &Pipeline{Image: "golang:latest", Steps: []*PipelineStep{{Name: "build", Run: "make", Artifacts: []string{"../bin"}}}}, wantErr: true},
+		{name: "absolute artifact", pipeline: &Pipeline{Image: "golang:latest", Steps: []*PipelineStep{{Name: "build", Run: "make", Artifacts: []string{"/etc/passwd"}}}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.pipeline.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() = %v, want error: %t", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewPipelineJob(t *testing.T) {
+	info := &AssignmentInfo{
+		AssignmentName:     "lab2",
+		CreatorAccessToken: "access-token",
+		GetURL:             getURL,
+		TestURL:            testURL,
+		RandomSecret:       "secret",
+		CommitID:           "deadbeef",
+		TestsSnapshot:      TestsSnapshot,
+	}
+	pipeline := &Pipeline{
+		Image: "golang:latest",
+		Env:   map[string]string{"GOFLAGS": "-mod=mod", "CGO_ENABLED": "0"},
+		Steps: []*PipelineStep{
+			{Name: "build", Run: "cd assignments/lab2\ngo build ./...\n", Artifacts: []string{"assignments/lab2/bin/"}},
+			{Name: "test", Image: "quickfeed:go", Run: "go test ./...", Timeout: "2m", AllowFailure: true, Env: map[string]string{"CGO_ENABLED": "1"}},
+		},
+	}
+	job := newPipelineJob(info, pipeline)
+
+	clone := strings.Join(job.Clone, "\n")
+	for _, want := range []string{
+		"git clone -q " + getURL + " /quickfeed/assignments",
+		"cp -a /quickfeed-tests/current/. /quickfeed/tests",
+		"git -C /quickfeed/assignments checkout -q deadbeef",
+	} {
+		if !strings.Contains(clone, want) {
+			t.Errorf("clone commands do not contain %q:\n%s", want, clone)
+		}
+	}
+	if len(job.Commands) != 0 || len(job.Steps) != 2 {
+		t.Fatalf("job has %d commands and %d steps, want 0 commands and 2 steps", len(job.Commands), len(job.Steps))
+	}
+	build, test := job.Steps[0], job.Steps[1]
+	if build.Image != "golang:latest" || test.Image != "quickfeed:go" {
+		t.Errorf("step images = %q, %q, want %q, %q", build.Image, test.Image, "golang:latest", "quickfeed:go")
+	}
+	if got := strings.Join(build.Commands, ";"); got != "cd assignments/lab2;go build ./..." {
+		t.Errorf("build commands = %q, want %q", got, "cd assignments/lab2;go build ./...")
+	}
+	if build.Timeout != 0 || test.Timeout != 2*time.Minute || build.AllowFailure || !test.AllowFailure {
+		t.Errorf("build step (timeout %v, allow failure %t), test step (timeout %v, allow failure %t), want (0s, false), (2m0s, true)",
+			build.Timeout, build.AllowFailure, test.Timeout, test.AllowFailure)
+	}
+	wantEnv := "CGO_ENABLED=1 GOFLAGS=-mod=mod QUICKFEED_ASSIGNMENT=lab2 QUICKFEED_SESSION_SECRET=secret"
+	if got := strings.Join(test.Env, " "); got != wantEnv {
+		t.Errorf("test step environment = %q, want %q", got, wantEnv)
+	}
+	if len(build.Artifacts) != 1 || build.Artifacts[0] != "assignments/lab2/bin/" {
+		t.Errorf("build step artifacts = %v, want [assignments/lab2/bin/]", build.Artifacts)
+	}
+}
+
+func TestRunStepsLocal(t *testing.T) {
+	job := &Job{Steps: []*Step{
+		{Name: "setup", Commands: []string{`echo "setting up $GREETING"`}, Env: []string{"GREETING=hello"}},
+		{Name: "lint", Commands: []string{"echo linting", "exit 1", "echo unreachable"}, AllowFailure: true},
+		{Name: "slow", Commands: []string{"sleep 5"}, Timeout: 100 * time.Millisecond, AllowFailure: true},
+		{Name: "test", Commands: []string{"false", "echo unreachable"}},
+		{Name: "report", Commands: []string{"echo reporting"}},
+	}}
+	var emitted []string
+	results, err := (&Local{}).RunSteps(context.Background(), job, func(line string) {
+		emitted = append(emitted, line)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct {
+		status StepStatus
+		output string
+	}{
+		{StepPassed, "setting up hello\n"},
+		{StepFailed, "linting\n"},
+		{StepTimedOut, ""},
+		{StepFailed, ""},
+		{StepSkipped, ""},
+	}
+	if len(results) != len(want) {
+		t.Fatalf("RunSteps() returned %d results, want %d", len(results), len(want))
+	}
+	for i, result := range results {
+		if result.Name != job.Steps[i].Name || result.Status != want[i].status || result.Output != want[i].output {
+			t.Errorf("result %d = (%s, %s, %q), want (%s, %s, %q)", i, result.Name, result.Status, result.Output, job.Steps[i].Name, want[i].status, want[i].output)
+		}
+	}
+	if out := pipelineOutput(results); strings.Join(emitted, "\n")+"\n" != out {
+		t.Errorf("emitted output:\n%s\nwant the pipeline output:\n%s", strings.Join(emitted, "\n"), out)
+	}
+}
+
+func TestRunStepsCanceled(t *testing.T) {
+	job := &Job{Steps: []*Step{
+		{Name: "test", Commands: []string{"sleep 5"}},
+		{Name: "report", Commands: []string{"echo reporting"}},
+	}}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	results, err := (&Local{}).RunSteps(ctx, job, nil)
+	if err != context.DeadlineExceeded {
+		t.Errorf("RunSteps() = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if len(results) != 1 || results[0].Status != StepTimedOut {
+		t.Errorf("RunSteps() = %+v, want timed out test step only", results)
+	}
+}
+
+func TestNewStepInfos(t *testing.T) {
+	results := []*StepResult{
+		{Name: "test", Status: StepPassed, Output: "=== RUN TestA\n{\"Secret\":\"secret\",\"TestName\":\"TestA\",\"Score\":1,\"MaxScore\":1,\"Weight\":1}\n--- PASS: TestA\n", ExecTime: 1500 * time.Millisecond},
+		{Name: "report", Status: StepSkipped},
+	}
+	infos := newStepInfos(results)
+	if len(infos) != 2 {
+		t.Fatalf("newStepInfos() returned %d infos, want 2", len(infos))
+	}
+	if infos[0].Log != "=== RUN TestA\n--- PASS: TestA" || infos[0].ExecTime != 1500 || infos[0].Status != StepPassed {
+		t.Errorf("infos[0] = %+v, want log without score lines", infos[0])
+	}
+	if infos[1].Log != "" || infos[1].Status != StepSkipped {
+		t.Errorf("infos[1] = %+v, want skipped step without log", infos[1])
+	}
+}
@@ -0,0 +1,114 @@
+package ci
+
+import (
+	"context"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// poolKey identifies the pre-warmed containers that can run the commands of a job.
+type poolKey struct {
+	image  string
+	limits Limits
+	cache  string
+}
+
+// warmContainer is a started container that waits for the commands of a job.
+// The clone commands of the job write the workspace to the container's volume,
+// which is mounted read-only at cloneDir in the container.
+type warmContainer struct {
+	id     string
+	volume string
+}
+
+// pool keeps up to size pre-warmed containers for each key of the jobs it has run.
+// Each container runs the commands of a single job, and is then removed.
+type pool struct {
+	logger *zap.SugaredLogger
+	size   int
+	create func(ctx context.Context, key poolKey) (*warmContainer, error)
+	remove func(c *warmContainer)
+	wg     sync.WaitGroup
+
+	mu      sync.Mutex
+	idle    map[poolKey][]*warmContainer
+	warming map[poolKey]int
+	closed  bool
+}
+
+func newPool(logger *zap.SugaredLogger, size int, create func(context.Context, poolKey) (*warmContainer, error), remove func(*warmContainer)) *pool {
+	return &pool{
+		logger:  logger,
+		size:    size,
+		create:  create,
+		remove:  remove,
+		idle:    make(map[poolKey][]*warmContainer),
+		warming: make(map[poolKey]int),
+	}
+}
+
+// take returns a pre-warmed container for the given key, if one is available,
+// and starts warming containers to refill the pool for the key.
+func (p *pool) take(key poolKey) (*warmContainer, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var c *warmContainer
+	if idle := p.idle[key]; len(idle) > 0 {
+		c, p.idle[key] = idle[0], idle[1:]
+	}
+	countCacheRequest(containerCache, c != nil)
+	p.fill(key)
+	return c, c != nil
+}
+
+// fill starts warming containers for the given key until the pool
+// has size containers for the key. The caller must hold p.mu.
+func (p *pool) fill(key poolKey) {
+	if p.closed {
+		return
+	}
+	for n := len(p.idle[key]) + p.warming[key]; n < p.size; n++ {
+		p.warming[key]++
+		p.wg.Add(1)
+		go p.warm(key)
+	}
+}
+
+// warm creates a container for the given key and adds it to the pool.
+// A container warmed after the pool is closed is removed right away.
+func (p *pool) warm(key poolKey) {
+	defer p.wg.Done()
+	c, err := p.create(context.Background(), key)
+	p.mu.Lock()
+	p.warming[key]--
+	if err != nil {
+		p.mu.Unlock()
+		p.logger.Errorf("Failed to warm container for image '%s': %v", key.image, err)
+		return
+	}
+	if p.closed {
+		p.mu.Unlock()
+		p.remove(c)
+		return
+	}
+	p.idle[key] = append(p.idle[key], c)
+	p.mu.Unlock()
+}
+
+// close removes the containers of the pool and waits for
+// the containers that are being warmed to be removed.
+func (p *pool) close() {
+	p.mu.Lock()
+	p.closed = true
+	idle := p.idle
+	p.idle = make(map[poolKey][]*warmContainer)
+	p.mu.Unlock()
+
+	for _, containers := range idle {
+		for _, c := range containers {
+			p.remove(c)
+		}
+	}
+	p.wg.Wait()
+}
@@ -0,0 +1,125 @@
+package ci
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"go.uber.org/zap"
+)
+
+// fakeContainers creates and removes the warm containers of a pool without docker.
+type fakeContainers struct {
+	mu      sync.Mutex
+	created int
+	removed map[string]bool
+	fail    bool
+}
+
+func (f *fakeContainers) create(_ context.Context, key poolKey) (*warmContainer, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.fail {
+		return nil, errors.New("image not found")
+	}
+	f.created++
+	return &warmContainer{id: fmt.Sprintf("%s-%d", key.image, f.created)}, nil
+}
+
+func (f *fakeContainers) remove(c *warmContainer) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.removed[c.id] = true
+}
+
+// waitForIdle waits until the pool has the given number of idle containers for the given key.
+func waitForIdle(t *testing.T, p *pool, key poolKey, want int) {
+	t.Helper()
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		p.mu.Lock()
+		idle, warming := len(p.idle[key]), p.warming[key]
+		p.mu.Unlock()
+		if idle == want && warming == 0 {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("pool did not reach %d idle containers for image '%s'", want, key.image)
+}
+
+func TestPool(t *testing.T) {
+	containers := &fakeContainers{removed: make(map[string]bool)}
+	p := newPool(zap.NewNop().Sugar(), 2, containers.create, containers.remove)
+	goKey := poolKey{image: "quickfeed:go"}
+	limitedKey := poolKey{image: "quickfeed:go", limits: Limits{Memory: 1 << 30}}
+	hits := testutil.ToFloat64(CacheRequestsMetric.WithLabelValues(containerCache, "hit"))
+	misses := testutil.ToFloat64(CacheRequestsMetric.WithLabelValues(containerCache, "miss"))
+
+	// the first job of a key warms containers for later jobs
+	if _, ok := p.take(goKey); ok {
+		t.Error("take() returned a container before any were warmed")
+	}
+	waitForIdle(t, p, goKey, 2)
+	c, ok := p.take(goKey)
+	if !ok {
+		t.Fatal("take() returned no container after warming")
+	}
+	// the pool is refilled after taking a container
+	waitForIdle(t, p, goKey, 2)
+	if containers.created != 3 {
+		t.Errorf("created %d containers, want 3", containers.created)
+	}
+	// containers are not shared between different limits
+	if _, ok := p.take(limitedKey); ok {
+		t.Error("take() returned a container warmed for other limits")
+	}
+	waitForIdle(t, p, limitedKey, 2)
+
+	if got := testutil.ToFloat64(CacheRequestsMetric.WithLabelValues(containerCache, "hit")) - hits; got != 1 {
+		t.Errorf("counted %g hits, want 1", got)
+	}
+	if got := testutil.ToFloat64(CacheRequestsMetric.WithLabelValues(containerCache, "miss")) - misses; got != 2 {
+		t.Errorf("counted %g misses, want 2", got)
+	}
+
+	// closing the pool removes the idle containers, but not the one that was taken
+	p.close()
+	if len(containers.removed) != 4 || containers.removed[c.id] {
+		t.Errorf("removed containers %v, want all idle containers except %s", containers.removed, c.id)
+	}
+	if _, ok := p.take(goKey); ok {
+		t.Error("take() returned a container after closing the pool")
+	}
+	if containers.created != 5 {
+		t.Errorf("created %d containers after closing the pool, want 5", containers.created)
+	}
+}
+
+func TestPoolWarmFailure(t *testing.T) {
+	containers := &fakeContainers{removed: make(map[string]bool), fail: true}
+	p := newPool(zap.NewNop().Sugar(), 1, containers.create, containers.remove)
+	defer p.close()
+	key := poolKey{image: "missing:latest"}
+
+	if _, ok := p.take(key); ok {
+		t.Error("take() returned a container that failed to warm")
+	}
+	waitForIdle(t, p, key, 0)
+
+	// the pool tries to warm containers again for the next job
+	containers.mu.Lock()
+	containers.fail = false
+	containers.mu.Unlock()
+	if _, ok := p.take(key); ok {
+		t.Error("take() returned a container that failed to warm")
+	}
+	waitForIdle(t, p, key, 1)
+	if _, ok := p.take(key); !ok {
+		t.Error("take() returned no container after warming")
+	}
+}
@@ -0,0 +1,483 @@
+package ci
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/events"
+	"go.uber.org/zap"
+)
+
+// ErrJobNotCancelable is returned when trying to cancel a build job
+// that has already completed.
+var ErrJobNotCancelable = errors.New("build job has already completed")
+
+// QueueOptions configures the build queue.
+type QueueOptions struct {
+	// Workers is the maximum number of concurrently running build jobs.
+	// Defaults to the number of CPUs.
+	Workers int
+	// CourseLimit is the maximum number of concurrently running build jobs
+	// for a single course. Defaults to Workers.
+	CourseLimit int
+	// ScriptPath is the path to the test script templates.
+	// Defaults to ci/scripts.
+	ScriptPath string
+	// Submissions is the hub on which the submissions recorded by build jobs
+	// are published. Defaults to a new hub.
+	Submissions *events.SubmissionHub
+	// BuildLogs is the hub on which the output of running build jobs is
+	// published, if the runner is a StreamingRunner. Defaults to a new hub.
+	BuildLogs *events.BuildLogHub
+	// Cache enables the dependency caches and the snapshots of the tests
+	// repositories of courses, if the runner is a CachingRunner.
+	Cache bool
+}
+
+// Queue is a persistent build queue backed by the database.
+// Jobs are executed by a bounded pool of workers, and jobs that were
+// queued or running when the server stopped are resumed by Start.
+type Queue struct {
+	logger *zap.SugaredLogger
+	db     database.Database
+	runner Runner
+	opts   QueueOptions
+	wake   chan struct{}
+	pool   sync.WaitGroup // running workers
+
+	mu      sync.Mutex
+	running map[uint64]int                // course ID -> number of running jobs
+	cancel  map[uint64]context.CancelFunc // job ID -> cancel function for running job
+	done    map[uint64]chan struct{}      // job ID -> closed when the job completes
+
+	snapshots  map[uint64]bool // course ID -> snapshot of the tests repository is available
+	refreshing map[uint64]bool // course ID -> snapshot of the tests repository is being refreshed
+	stale      map[uint64]bool // course ID -> snapshot being refreshed is already outdated
+}
+
+// NewQueue returns a new build queue that runs jobs using the given runner.
+// The queue does not run any jobs until Start is called.
+func NewQueue(logger *zap.SugaredLogger, db database.Database, runner Runner, opts QueueOptions) *Queue {
+	if opts.Workers <= 0 {
+		opts.Workers = runtime.NumCPU()
+	}
+	if opts.CourseLimit <= 0 || opts.CourseLimit > opts.Workers {
+		opts.CourseLimit = opts.Workers
+	}
+	if opts.ScriptPath == "" {
+		opts.ScriptPath = scriptPath
+	}
+	if opts.Submissions == nil {
+		opts.Submissions = events.NewSubmissionHub(0)
+	}
+	if opts.BuildLogs == nil {
+		opts.BuildLogs = events.NewBuildLogHub(0)
+	}
+	return &Queue{
+		logger:  logger,
+		db:      db,
+		runner:  runner,
+		opts:    opts,
+		wake:    make(chan struct{}, opts.Workers),
+		running: make(map[uint64]int),
+		cancel:  make(map[uint64]context.CancelFunc),
+		done:    make(map[uint64]chan struct{}),
+
+		snapshots:  make(map[uint64]bool),
+		refreshing: make(map[uint64]bool),
+		stale:      make(map[uint64]bool),
+	}
+}
+
+// Start requeues jobs that were interrupted by a server restart and starts
+// the worker pool. The workers stop when ctx is canceled; use Wait to wait for them.
+func (q *Queue) Start(ctx context.Context) error {
+	q.mu.Lock()
+	interrupted, err := q.db.GetBuildJobs(0, pb.BuildJob_RUNNING)
+	if err != nil {
+		q.mu.Unlock()
+		return fmt.Errorf("failed to get interrupted build jobs: %w", err)
+	}
+	for _, job := range interrupted {
+		q.logger.Debugf("Requeuing interrupted build job %d for %s", job.GetID(), job.GetJobOwner())
+		job.Status = pb.BuildJob_QUEUED
+		job.StartedDate = ""
+		if err := q.db.UpdateBuildJob(job); err != nil {
+			q.mu.Unlock()
+			return fmt.Errorf("failed to requeue build job %d: %w", job.GetID(), err)
+		}
+	}
+	q.mu.Unlock()
+
+	for i := 0; i < q.opts.Workers; i++ {
+		q.pool.Add(1)
+		go func() {
+			defer q.pool.Done()
+			q.work(ctx)
+		}()
+	}
+	return nil
+}
+
+// Wait waits until the workers have stopped after the context given to Start
+// is canceled. Jobs that were running are interrupted and left as running,
+// so that they are requeued when the queue is started again.
+func (q *Queue) Wait() {
+	q.pool.Wait()
+}
+
+// Enqueue adds a build job for the given run data to the queue. If a job for
+// the same repository and assignment is already waiting in the queue, that job
+// is updated to build the new commit instead of adding another job. Deadline
+// builds are never replaced by other builds, or other builds by deadline builds.
+// If the build quota of the repository for the assignment is used up, the new
+// job is delayed until the quota allows it to start; deadline builds are never delayed.
+func (q *Queue) Enqueue(rData *RunData) (*pb.BuildJob, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	queued, err := q.db.GetBuildJobs(rData.Course.GetID(), pb.BuildJob_QUEUED)
+	if err != nil {
+		return nil, err
+	}
+	for _, job := range queued {
+		if job.GetRepositoryID() == rData.Repo.GetID() && job.GetAssignmentID() == rData.Assignment.GetID() && job.GetDeadline() == rData.Deadline {
+			q.logger.Debugf("Replacing commit %s with %s in queued build job %d", job.GetCommitID(), rData.CommitID, job.GetID())
+			job.CommitID = rData.CommitID
+			job.JobOwner = rData.JobOwner
+			if err := q.db.UpdateBuildJob(job); err != nil {
+				return nil, err
+			}
+			return job, nil
+		}
+	}
+	var delayedUntil string
+	if !rData.Deadline && rData.Assignment.HasBuildQuota() {
+		quota, err := getBuildQuota(q.db, rData.Assignment, rData.Repo.GetID(), time.Now())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get build quota: %w", err)
+		}
+		delayedUntil = quota.GetNextBuild()
+	}
+	job, err := q.add(rData, delayedUntil)
+	if err != nil {
+		return nil, err
+	}
+	q.notify()
+	return job, nil
+}
+
+// Run adds a build job for the given run data to the queue without
+// deduplication, and waits for the job to complete.
+func (q *Queue) Run(ctx context.Context, rData *RunData) (*pb.BuildJob, error) {
+	q.mu.Lock()
+	job, err := q.add(rData, "")
+	if err != nil {
+		q.mu.Unlock()
+		return nil, err
+	}
+	done := q.done[job.GetID()]
+	q.mu.Unlock()
+	q.notify()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	return q.db.GetBuildJob(job.GetID())
+}
+
+// Submissions returns the hub on which recorded submissions are published.
+func (q *Queue) Submissions() *events.SubmissionHub {
+	return q.opts.Submissions
+}
+
+// BuildLogs returns the hub on which the output of running build jobs is published.
+// The end of a build job's output is published after its final status has been recorded.
+func (q *Queue) BuildLogs() *events.BuildLogHub {
+	return q.opts.BuildLogs
+}
+
+// Jobs returns the queued and running build jobs for the given course.
+func (q *Queue) Jobs(courseID uint64) ([]*pb.BuildJob, error) {
+	return q.db.GetBuildJobs(courseID)
+}
+
+// Quotas returns the build quotas of the repositories with builds of pushes
+// for the given assignment in the last hour, or within its build interval.
+func (q *Queue) Quotas(assignment *pb.Assignment) ([]*pb.BuildQuota, error) {
+	quotas, err := getBuildQuotas(q.db, assignment, 0, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	repoQuotas := make([]*pb.BuildQuota, 0, len(quotas))
+	for _, quota := range quotas {
+		repoQuotas = append(repoQuotas, quota)
+	}
+	sort.Slice(repoQuotas, func(i, j int) bool {
+		return repoQuotas[i].GetRepositoryID() < repoQuotas[j].GetRepositoryID()
+	})
+	return repoQuotas, nil
+}
+
+// ResetQuota resets the build quota of the given repository for the given assignment,
+// so that the builds started before the reset are not counted. A queued job delayed
+// by the quota is started right away.
+func (q *Queue) ResetQuota(assignment *pb.Assignment, repoID uint64) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if err := q.db.CreateBuildQuota(&pb.BuildQuota{
+		AssignmentID: assignment.GetID(),
+		RepositoryID: repoID,
+		ResetDate:    time.Now().Format(pb.TimeLayout),
+	}); err != nil {
+		return err
+	}
+	queued, err := q.db.GetBuildJobs(assignment.GetCourseID(), pb.BuildJob_QUEUED)
+	if err != nil {
+		return err
+	}
+	for _, job := range queued {
+		if job.GetRepositoryID() == repoID && job.GetAssignmentID() == assignment.GetID() && job.GetDelayedUntil() != "" {
+			q.logger.Debugf("Starting delayed build job %d for %s after quota reset", job.GetID(), job.GetJobOwner())
+			job.DelayedUntil = ""
+			if err := q.db.UpdateBuildJob(job); err != nil {
+				return err
+			}
+			q.notify()
+		}
+	}
+	return nil
+}
+
+// Cancel cancels the build job with the given ID. A queued job is removed
+// from the queue, and a running job is stopped without recording results.
+func (q *Queue) Cancel(jobID uint64) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	job, err := q.db.GetBuildJob(jobID)
+	if err != nil {
+		return err
+	}
+	switch job.GetStatus() {
+	case pb.BuildJob_QUEUED:
+		job.Status = pb.BuildJob_CANCELED
+		job.FinishedDate = time.Now().Format(pb.TimeLayout)
+		if err := q.db.UpdateBuildJob(job); err != nil {
+			return err
+		}
+		q.complete(jobID)
+		q.opts.BuildLogs.End(jobID)
+	case pb.BuildJob_RUNNING:
+		if cancel, ok := q.cancel[jobID]; ok {
+			cancel()
+		}
+	default:
+		return ErrJobNotCancelable
+	}
+	return nil
+}
+
+// add creates a new queued build job for the given run data,
+// which is not started before the given date, if any.
+// The caller must hold q.mu.
+func (q *Queue) add(rData *RunData, delayedUntil string) (*pb.BuildJob, error) {
+	job := &pb.BuildJob{
+		CourseID:     rData.Course.GetID(),
+		AssignmentID: rData.Assignment.GetID(),
+		RepositoryID: rData.Repo.GetID(),
+		CommitID:     rData.CommitID,
+		JobOwner:     rData.JobOwner,
+		Status:       pb.BuildJob_QUEUED,
+		QueuedDate:   time.Now().Format(pb.TimeLayout),
+		Deadline:     rData.Deadline,
+		DelayedUntil: delayedUntil,
+	}
+	if err := q.db.CreateBuildJob(job); err != nil {
+		return nil, err
+	}
+	q.done[job.GetID()] = make(chan struct{})
+	if delayedUntil != "" {
+		q.logger.Debugf("Queued build job %d for %s, delayed by the build quota until %s", job.GetID(), job.GetJobOwner(), delayedUntil)
+	} else {
+		q.logger.Debugf("Queued build job %d for %s", job.GetID(), job.GetJobOwner())
+	}
+	return job, nil
+}
+
+// complete signals any waiters that the given job has completed.
+// The caller must hold q.mu.
+func (q *Queue) complete(jobID uint64) {
+	if done, ok := q.done[jobID]; ok {
+		close(done)
+		delete(q.done, jobID)
+	}
+}
+
+// notify wakes up an idle worker, if any.
+func (q *Queue) notify() {
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+// work runs build jobs until ctx is canceled.
+func (q *Queue) work(ctx context.Context) {
+	for {
+		job, jobCtx, delay := q.next(ctx)
+		if job != nil {
+			q.run(ctx, jobCtx, job)
+			continue
+		}
+		// wake up when the earliest delayed job is due, if any
+		if !q.wait(ctx, delay) {
+			return
+		}
+	}
+}
+
+// wait waits until a worker is woken up, or the given delay has passed if it is positive.
+// It returns false if ctx is canceled while waiting.
+func (q *Queue) wait(ctx context.Context, delay time.Duration) bool {
+	var due <-chan time.Time
+	if delay > 0 {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		due = timer.C
+	}
+	select {
+	case <-q.wake:
+	case <-due:
+	case <-ctx.Done():
+		return false
+	}
+	return true
+}
+
+// next marks the oldest queued job that is not delayed, and whose course has
+// not reached its concurrency limit, as running, and returns it along with its
+// context. If no such job exists, nil is returned along with the duration until
+// the earliest delayed job is due, or zero if there are no delayed jobs.
+func (q *Queue) next(ctx context.Context) (*pb.BuildJob, context.Context, time.Duration) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if ctx.Err() != nil {
+		return nil, nil, 0
+	}
+	queued, err := q.db.GetBuildJobs(0, pb.BuildJob_QUEUED)
+	if err != nil {
+		q.logger.Errorf("Failed to get queued build jobs: %v", err)
+		return nil, nil, 0
+	}
+	now := time.Now()
+	var due string // the earliest date at which a delayed job is due
+	for _, job := range queued {
+		if delayedUntil := job.GetDelayedUntil(); delayedUntil > now.Format(pb.TimeLayout) {
+			if due == "" || delayedUntil < due {
+				due = delayedUntil
+			}
+			continue
+		}
+		if q.running[job.GetCourseID()] >= q.opts.CourseLimit {
+			continue
+		}
+		job.Status = pb.BuildJob_RUNNING
+		job.StartedDate = time.Now().Format(pb.TimeLayout)
+		if err := q.db.UpdateBuildJob(job); err != nil {
+			q.logger.Errorf("Failed to update build job %d: %v", job.GetID(), err)
+			return nil, nil, 0
+		}
+		q.running[job.GetCourseID()]++
+		jobCtx, cancel := context.WithCancel(ctx)
+		q.cancel[job.GetID()] = cancel
+		return job, jobCtx, 0
+	}
+	if due == "" {
+		return nil, nil, 0
+	}
+	dueTime, err := time.ParseInLocation(pb.TimeLayout, due, now.Location())
+	if err != nil {
+		q.logger.Errorf("Failed to parse delay of queued build job (%s): %v", due, err)
+		return nil, nil, 0
+	}
+	return nil, nil, dueTime.Sub(now)
+}
+
+// run executes the given job and records its final status.
+// If ctx is canceled while the job is running, the server is shutting down and
+// the job is left as running, so that it will be requeued when the queue is restarted.
+func (q *Queue) run(ctx, jobCtx context.Context, job *pb.BuildJob) {
+	err := q.execute(jobCtx, job)
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	defer q.notify()
+	defer q.complete(job.GetID())
+
+	canceled := jobCtx.Err() != nil
+	q.cancel[job.GetID()]()
+	delete(q.cancel, job.GetID())
+	q.running[job.GetCourseID()]--
+	if q.running[job.GetCourseID()] == 0 {
+		delete(q.running, job.GetCourseID())
+	}
+
+	switch {
+	case ctx.Err() != nil:
+		q.logger.Debugf("Build job %d interrupted by shutdown", job.GetID())
+		return
+	case canceled:
+		job.Status = pb.BuildJob_CANCELED
+	case err != nil:
+		q.logger.Errorf("Build job %d failed: %v", job.GetID(), err)
+		job.Status = pb.BuildJob_FAILED
+		job.ErrorMessage = err.Error()
+	default:
+		job.Status = pb.BuildJob_DONE
+	}
+	job.FinishedDate = time.Now().Format(pb.TimeLayout)
+	if err := q.db.UpdateBuildJob(job); err != nil {
+		q.logger.Errorf("Failed to update build job %d: %v", job.GetID(), err)
+	}
+	q.opts.BuildLogs.End(job.GetID())
+}
+
+// execute fetches the run data for the given job and runs the tests.
+func (q *Queue) execute(ctx context.Context, job *pb.BuildJob) error {
+	course, err := q.db.GetCourse(job.GetCourseID(), false)
+	if err != nil {
+		return fmt.Errorf("failed to get course %d: %w", job.GetCourseID(), err)
+	}
+	assignment, err := q.db.GetAssignment(&pb.Assignment{ID: job.GetAssignmentID()})
+	if err != nil {
+		return fmt.Errorf("failed to get assignment %d: %w", job.GetAssignmentID(), err)
+	}
+	repos, err := q.db.GetRepositories(&pb.Repository{ID: job.GetRepositoryID()})
+	if err != nil || len(repos) != 1 {
+		return fmt.Errorf("failed to get repository %d: %v", job.GetRepositoryID(), err)
+	}
+	rData := &RunData{
+		Course:     course,
+		Assignment: assignment,
+		Repo:       repos[0],
+		CommitID:   job.GetCommitID(),
+		JobOwner:   job.GetJobOwner(),
+		Deadline:   job.GetDeadline(),
+	}
+	submission, err := runTestsAndRecord(ctx, q.logger, q.db, q.runner, q.opts.ScriptPath, rData, q.caches(rData), func(line string) {
+		q.opts.BuildLogs.Publish(job.GetID(), line)
+	})
+	if err != nil {
+		return err
+	}
+	q.opts.Submissions.Publish(course.GetID(), submission)
+	return nil
+}
@@ -0,0 +1,483 @@
+package ci_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/database"
+	"go.uber.org/zap"
+)
+
+// blockingRunner is a test runner that blocks each job until it is released
+// or its context is canceled, and records the number of concurrently running jobs.
+type blockingRunner struct {
+	mu      sync.Mutex
+	running map[string]int // course code -> running jobs
+	maxRun  map[string]int // course code -> max concurrently running jobs
+	started chan string
+	release chan struct{}
+}
+
+func newBlockingRunner() *blockingRunner {
+	return &blockingRunner{
+		running: make(map[string]int),
+		maxRun:  make(map[string]int),
+		started: make(chan string, 100),
+		release: make(chan struct{}),
+	}
+}
+
+func (r *blockingRunner) Run(ctx context.Context, job *ci.Job) (string, error) {
+	// job names are formatted as: course code-assignment name-job owner-secret
+	code := job.Name[:len("DAT000")]
+	r.mu.Lock()
+	r.running[code]++
+	if r.running[code] > r.maxRun[code] {
+		r.maxRun[code] = r.running[code]
+	}
+	r.mu.Unlock()
+	defer func() {
+		r.mu.Lock()
+		r.running[code]--
+		r.mu.Unlock()
+	}()
+
+	r.started <- code
+	select {
+	case <-r.release:
+		return "tests passed", nil
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+func (r *blockingRunner) max(code string) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.maxRun[code]
+}
+
+func setupQueueDB(t *testing.T) (database.Database, func()) {
+	t.Helper()
+	f, err := ioutil.TempFile(os.TempDir(), "testdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		t.Fatal(err)
+	}
+	db, err := database.NewGormDB(f.Name(), zap.NewNop())
+	if err != nil {
+		os.Remove(f.Name())
+		t.Fatal(err)
+	}
+	return db, func() {
+		if err := os.Remove(f.Name()); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+// createRunData creates a course with the given code, an assignment and a
+// user repository in the database, and returns the corresponding run data.
+func createRunData(t *testing.T, db database.Database, user *pb.User, code string, orgID uint64) *ci.RunData {
+	t.Helper()
+	course := &pb.Course{Code: code, OrganizationID: orgID}
+	if err := db.CreateCourse(user.GetID(), course); err != nil {
+		t.Fatal(err)
+	}
+	assignment := &pb.Assignment{CourseID: course.GetID(), Name: "lab1", ScriptFile: "go", Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	repo := &pb.Repository{OrganizationID: orgID, RepositoryID: orgID, UserID: user.GetID(), RepoType: pb.Repository_USER}
+	if err := db.CreateRepository(repo); err != nil {
+		t.Fatal(err)
+	}
+	return &ci.RunData{Course: course, Assignment: assignment, Repo: repo, CommitID: "deadbeef", JobOwner: "student"}
+}
+
+func createUser(t *testing.T, db database.Database) *pb.User {
+	t.Helper()
+	user := &pb.User{}
+	if err := db.CreateUserFromRemoteIdentity(user, &pb.RemoteIdentity{Provider: "fake", RemoteID: 1}); err != nil {
+		t.Fatal(err)
+	}
+	return user
+}
+
+func waitForStatus(t *testing.T, db database.Database, jobID uint64, want pb.BuildJob_Status) {
+	t.Helper()
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		job, err := db.GetBuildJob(jobID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if job.GetStatus() == want {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("build job %d did not reach status %s", jobID, want)
+}
+
+func TestQueueDeduplication(t *testing.T) {
+	db, cleanup := setupQueueDB(t)
+	defer cleanup()
+	user := createUser(t, db)
+	rData := createRunData(t, db, user, "DAT320", 1)
+
+	queue := ci.NewQueue(zap.NewNop().Sugar(), db, newBlockingRunner(), ci.QueueOptions{ScriptPath: "scripts"})
+	first, err := queue.Enqueue(rData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rData.CommitID = "cafebabe"
+	second, err := queue.Enqueue(rData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.GetID() != second.GetID() {
+		t.Errorf("Enqueue() created job %d, expected queued job %d to be reused", second.GetID(), first.GetID())
+	}
+
+	jobs, err := queue.Jobs(rData.Course.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs) != 1 {
+		t.Fatalf("have %d queued jobs, want 1", len(jobs))
+	}
+	if jobs[0].GetCommitID() != "cafebabe" {
+		t.Errorf("have commit %s, want %s", jobs[0].GetCommitID(), "cafebabe")
+	}
+
+	// a different assignment should get a separate job
+	other := &pb.Assignment{CourseID: rData.Course.GetID(), Name: "lab2", ScriptFile: "go", Order: 2}
+	if err := db.CreateAssignment(other); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := queue.Enqueue(&ci.RunData{Course: rData.Course, Assignment: other, Repo: rData.Repo, CommitID: "cafebabe"}); err != nil {
+		t.Fatal(err)
+	}
+	jobs, err = queue.Jobs(rData.Course.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs) != 2 {
+		t.Errorf("have %d queued jobs, want 2", len(jobs))
+	}
+
+	// a deadline build should not replace a queued build of a later commit, or be replaced by one
+	deadline := &ci.RunData{Course: rData.Course, Assignment: rData.Assignment, Repo: rData.Repo, CommitID: "deadbeef", Deadline: true}
+	deadlineJob, err := queue.Enqueue(deadline)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deadlineJob.GetID() == first.GetID() || !deadlineJob.GetDeadline() {
+		t.Errorf("Enqueue() of deadline build = %v, want a separate deadline job", deadlineJob)
+	}
+	rData.CommitID = "f00d"
+	if third, err := queue.Enqueue(rData); err != nil {
+		t.Fatal(err)
+	} else if third.GetID() != first.GetID() {
+		t.Errorf("Enqueue() after deadline build reused job %d, want queued job %d", third.GetID(), first.GetID())
+	}
+}
+
+func TestQueueCourseLimit(t *testing.T) {
+	db, cleanup := setupQueueDB(t)
+	defer cleanup()
+	user := createUser(t, db)
+	dat320 := createRunData(t, db, user, "DAT320", 1)
+	dat520 := createRunData(t, db, user, "DAT520", 2)
+
+	runner := newBlockingRunner()
+	queue := ci.NewQueue(zap.NewNop().Sugar(), db, runner, ci.QueueOptions{Workers: 4, CourseLimit: 1, ScriptPath: "scripts"})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := queue.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	var jobs []*pb.BuildJob
+	for i, rData := range []*ci.RunData{dat320, dat320, dat520} {
+		// use different assignments to avoid deduplication
+		assignment := &pb.Assignment{CourseID: rData.Course.GetID(), Name: fmt.Sprintf("lab%d", i+10), ScriptFile: "go", Order: uint32(i + 10)}
+		if err := db.CreateAssignment(assignment); err != nil {
+			t.Fatal(err)
+		}
+		job, err := queue.Enqueue(&ci.RunData{Course: rData.Course, Assignment: assignment, Repo: rData.Repo})
+		if err != nil {
+			t.Fatal(err)
+		}
+		jobs = append(jobs, job)
+	}
+
+	// one job from each course should start
+	started := map[string]bool{<-runner.started: true, <-runner.started: true}
+	if !started["DAT320"] || !started["DAT520"] {
+		t.Errorf("expected one job from each course to start, got %v", started)
+	}
+	for i := 0; i < len(jobs); i++ {
+		runner.release <- struct{}{}
+	}
+	for _, job := range jobs {
+		waitForStatus(t, db, job.GetID(), pb.BuildJob_DONE)
+	}
+	if max := runner.max("DAT320"); max != 1 {
+		t.Errorf("have %d concurrent DAT320 jobs, want 1", max)
+	}
+}
+
+func TestQueueResumeAfterRestart(t *testing.T) {
+	db, cleanup := setupQueueDB(t)
+	defer cleanup()
+	user := createUser(t, db)
+	rData := createRunData(t, db, user, "DAT320", 1)
+
+	// simulate a job that was running when the server stopped
+	job := &pb.BuildJob{
+		CourseID:     rData.Course.GetID(),
+		AssignmentID: rData.Assignment.GetID(),
+		RepositoryID: rData.Repo.GetID(),
+		CommitID:     rData.CommitID,
+		JobOwner:     rData.JobOwner,
+		Status:       pb.BuildJob_RUNNING,
+	}
+	if err := db.CreateBuildJob(job); err != nil {
+		t.Fatal(err)
+	}
+
+	runner := newBlockingRunner()
+	queue := ci.NewQueue(zap.NewNop().Sugar(), db, runner, ci.QueueOptions{Workers: 1, ScriptPath: "scripts"})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := queue.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	<-runner.started
+	runner.release <- struct{}{}
+	waitForStatus(t, db, job.GetID(), pb.BuildJob_DONE)
+
+	submission, err := db.GetSubmission(&pb.Submission{AssignmentID: rData.Assignment.GetID(), UserID: user.GetID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if submission.GetCommitHash() != rData.CommitID {
+		t.Errorf("have commit %s, want %s", submission.GetCommitHash(), rData.CommitID)
+	}
+}
+
+func TestQueueShutdown(t *testing.T) {
+	db, cleanup := setupQueueDB(t)
+	defer cleanup()
+	user := createUser(t, db)
+	rData := createRunData(t, db, user, "DAT320", 1)
+
+	runner := newBlockingRunner()
+	queue := ci.NewQueue(zap.NewNop().Sugar(), db, runner, ci.QueueOptions{Workers: 2, ScriptPath: "scripts"})
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := queue.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	job, err := queue.Enqueue(rData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-runner.started
+
+	// shutting down interrupts the running job, which is left as running
+	cancel()
+	queue.Wait()
+	interrupted, err := db.GetBuildJob(job.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if interrupted.GetStatus() != pb.BuildJob_RUNNING {
+		t.Errorf("have status %s after shutdown, want %s", interrupted.GetStatus(), pb.BuildJob_RUNNING)
+	}
+
+	// the interrupted job is resumed when the queue is restarted
+	queue = ci.NewQueue(zap.NewNop().Sugar(), db, runner, ci.QueueOptions{Workers: 2, ScriptPath: "scripts"})
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	if err := queue.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	<-runner.started
+	runner.release <- struct{}{}
+	waitForStatus(t, db, job.GetID(), pb.BuildJob_DONE)
+}
+
+func TestQueueCancel(t *testing.T) {
+	db, cleanup := setupQueueDB(t)
+	defer cleanup()
+	user := createUser(t, db)
+	rData := createRunData(t, db, user, "DAT320", 1)
+
+	runner := newBlockingRunner()
+	queue := ci.NewQueue(zap.NewNop().Sugar(), db, runner, ci.QueueOptions{Workers: 1, ScriptPath: "scripts"})
+
+	// cancel a queued job
+	queued, err := queue.Enqueue(rData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := queue.Cancel(queued.GetID()); err != nil {
+		t.Fatal(err)
+	}
+	waitForStatus(t, db, queued.GetID(), pb.BuildJob_CANCELED)
+	if err := queue.Cancel(queued.GetID()); err != ci.ErrJobNotCancelable {
+		t.Errorf("Cancel() = %v, want %v", err, ci.ErrJobNotCancelable)
+	}
+
+	// cancel a running job
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := queue.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	running, err := queue.Enqueue(rData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-runner.started
+	if err := queue.Cancel(running.GetID()); err != nil {
+		t.Fatal(err)
+	}
+	waitForStatus(t, db, running.GetID(), pb.BuildJob_CANCELED)
+	if _, err := db.GetSubmission(&pb.Submission{AssignmentID: rData.Assignment.GetID(), UserID: user.GetID()}); err == nil {
+		t.Error("expected no submission for canceled build job")
+	}
+}
+
+func TestQueuePublishesSubmissions(t *testing.T) {
+	db, cleanup := setupQueueDB(t)
+	defer cleanup()
+	user := createUser(t, db)
+	rData := createRunData(t, db, user, "DAT320", 1)
+
+	runner := newBlockingRunner()
+	queue := ci.NewQueue(zap.NewNop().Sugar(), db, runner, ci.QueueOptions{Workers: 1, ScriptPath: "scripts"})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := queue.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	sub := queue.Submissions().Subscribe(nil)
+	defer sub.Close()
+
+	if _, err := queue.Enqueue(rData); err != nil {
+		t.Fatal(err)
+	}
+	<-runner.started
+	runner.release <- struct{}{}
+
+	select {
+	case event := <-sub.Events():
+		if event.CourseID != rData.Course.GetID() {
+			t.Errorf("have course %d, want %d", event.CourseID, rData.Course.GetID())
+		}
+		if event.Submission.GetUserID() != user.GetID() || event.Submission.GetCommitHash() != rData.CommitID {
+			t.Errorf("have submission by user %d for commit %s, want user %d and commit %s",
+				event.Submission.GetUserID(), event.Submission.GetCommitHash(), user.GetID(), rData.CommitID)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("no submission published")
+	}
+}
+
+func TestQueueBuildQuota(t *testing.T) {
+	db, cleanup := setupQueueDB(t)
+	defer cleanup()
+	user := createUser(t, db)
+	rData := createRunData(t, db, user, "DAT320", 1)
+	rData.Assignment.BuildsPerHour = 1
+	if err := db.UpdateAssignments([]*pb.Assignment{rData.Assignment}); err != nil {
+		t.Fatal(err)
+	}
+
+	runner := newBlockingRunner()
+	queue := ci.NewQueue(zap.NewNop().Sugar(), db, runner, ci.QueueOptions{Workers: 1, ScriptPath: "scripts"})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := queue.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	first, err := queue.Enqueue(rData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.GetDelayedUntil() != "" {
+		t.Errorf("first build delayed until %s, want no delay", first.GetDelayedUntil())
+	}
+	<-runner.started
+	runner.release <- struct{}{}
+	waitForStatus(t, db, first.GetID(), pb.BuildJob_DONE)
+
+	// the build info reports that the quota is used up
+	submission, err := db.GetSubmission(&pb.Submission{AssignmentID: rData.Assignment.GetID(), UserID: user.GetID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buildInfo ci.BuildInfo
+	if err := json.Unmarshal([]byte(submission.GetBuildInfo()), &buildInfo); err != nil {
+		t.Fatal(err)
+	}
+	if buildInfo.Quota == nil || buildInfo.Quota.BuildsPerHour != 1 || buildInfo.Quota.Remaining != 0 || buildInfo.Quota.NextBuild == "" {
+		t.Errorf("build info quota = %+v, want 1 build per hour with none remaining", buildInfo.Quota)
+	}
+
+	// the builds of the next pushes are coalesced into a single delayed build
+	second, err := queue.Enqueue(rData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second.GetDelayedUntil() == "" {
+		t.Fatal("second build not delayed")
+	}
+	rData.CommitID = "cafebabe"
+	third, err := queue.Enqueue(rData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if third.GetID() != second.GetID() || third.GetCommitID() != "cafebabe" {
+		t.Errorf("third build is job %d for commit %s, want job %d for commit cafebabe", third.GetID(), third.GetCommitID(), second.GetID())
+	}
+	quotas, err := queue.Quotas(rData.Assignment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(quotas) != 1 || quotas[0].GetRepositoryID() != rData.Repo.GetID() || quotas[0].GetBuilds() != 1 || quotas[0].GetNextBuild() != second.GetDelayedUntil() {
+		t.Errorf("Quotas() = %v, want 1 build in repository %d with next build at %s", quotas, rData.Repo.GetID(), second.GetDelayedUntil())
+	}
+	select {
+	case <-runner.started:
+		t.Fatal("delayed build started before the quota was reset")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// resetting the quota starts the delayed build
+	if err := queue.ResetQuota(rData.Assignment, rData.Repo.GetID()); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-runner.started:
+	case <-time.After(10 * time.Second):
+		t.Fatal("delayed build not started after the quota was reset")
+	}
+	runner.release <- struct{}{}
+	waitForStatus(t, db, second.GetID(), pb.BuildJob_DONE)
+}
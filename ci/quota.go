@@ -0,0 +1,119 @@
+package ci
+
+import (
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+)
+
+// quotaWindow is the period in which the builds per hour of an assignment are counted.
+const quotaWindow = time.Hour
+
+// Quota reports the build quota of a repository in the build info of its submissions.
+type Quota struct {
+	BuildsPerHour uint32 `json:"buildsperhour,omitempty"`
+	Remaining     uint32 `json:"remaining"`
+	NextBuild     string `json:"nextbuild,omitempty"`
+}
+
+// newQuota returns the given build quota of a repository for the given assignment to report in the build info.
+func newQuota(assignment *pb.Assignment, quota *pb.BuildQuota) *Quota {
+	return &Quota{
+		BuildsPerHour: assignment.GetBuildsPerHour(),
+		Remaining:     quota.GetRemaining(),
+		NextBuild:     quota.GetNextBuild(),
+	}
+}
+
+// getBuildQuota returns the build quota of the given repository for the given assignment at the given time.
+func getBuildQuota(db database.Database, assignment *pb.Assignment, repoID uint64, now time.Time) (*pb.BuildQuota, error) {
+	quotas, err := getBuildQuotas(db, assignment, repoID, now)
+	if err != nil {
+		return nil, err
+	}
+	if quota, ok := quotas[repoID]; ok {
+		return quota, nil
+	}
+	return computeQuota(assignment, &pb.BuildQuota{AssignmentID: assignment.GetID(), RepositoryID: repoID}, nil, now), nil
+}
+
+// getBuildQuotas returns the build quotas of the given repository (or all repositories if 0)
+// with builds of pushes for the given assignment in the quota window at the given time,
+// indexed by repository ID.
+func getBuildQuotas(db database.Database, assignment *pb.Assignment, repoID uint64, now time.Time) (map[uint64]*pb.BuildQuota, error) {
+	window := quotaWindow
+	if interval := assignment.BuildIntervalDuration(); interval > window {
+		window = interval
+	}
+	jobs, err := db.GetStartedBuildJobs(assignment.GetID(), repoID, now.Add(-window).Format(pb.TimeLayout))
+	if err != nil {
+		return nil, err
+	}
+	resets, err := db.GetBuildQuotas(assignment.GetID())
+	if err != nil {
+		return nil, err
+	}
+	repoJobs := make(map[uint64][]*pb.BuildJob)
+	for _, job := range jobs {
+		// deadline builds do not count against the quota
+		if !job.GetDeadline() {
+			repoJobs[job.GetRepositoryID()] = append(repoJobs[job.GetRepositoryID()], job)
+		}
+	}
+	quotas := make(map[uint64]*pb.BuildQuota, len(repoJobs))
+	for _, reset := range resets {
+		if jobs, ok := repoJobs[reset.GetRepositoryID()]; ok {
+			quotas[reset.GetRepositoryID()] = computeQuota(assignment, reset, jobs, now)
+		}
+	}
+	for id, jobs := range repoJobs {
+		if _, ok := quotas[id]; !ok {
+			quotas[id] = computeQuota(assignment, &pb.BuildQuota{AssignmentID: assignment.GetID(), RepositoryID: id}, jobs, now)
+		}
+	}
+	return quotas, nil
+}
+
+// computeQuota computes the builds, remaining builds and next build of the given quota at the given time,
+// from the given build jobs of pushes to the quota's repository, in the order they were started.
+// Builds started up to the date at which the quota was reset are not counted.
+func computeQuota(assignment *pb.Assignment, quota *pb.BuildQuota, jobs []*pb.BuildJob, now time.Time) *pb.BuildQuota {
+	var reset time.Time
+	if quota.GetResetDate() != "" {
+		reset, _ = time.ParseInLocation(pb.TimeLayout, quota.GetResetDate(), now.Location())
+	}
+	var started []time.Time
+	for _, job := range jobs {
+		start, err := time.ParseInLocation(pb.TimeLayout, job.GetStartedDate(), now.Location())
+		if err != nil || !start.After(reset) {
+			continue
+		}
+		started = append(started, start)
+	}
+
+	var next time.Time
+	if interval := assignment.BuildIntervalDuration(); interval > 0 && len(started) > 0 {
+		next = started[len(started)-1].Add(interval)
+	}
+	// only the builds started in the last hour count against the builds per hour
+	windowStart := now.Add(-quotaWindow)
+	for len(started) > 0 && started[0].Before(windowStart) {
+		started = started[1:]
+	}
+	quota.Builds = uint32(len(started))
+	quota.Remaining = 0
+	if limit := assignment.GetBuildsPerHour(); limit > 0 {
+		if quota.Builds < limit {
+			quota.Remaining = limit - quota.Builds
+		} else if oldest := started[len(started)-int(limit)].Add(quotaWindow); oldest.After(next) {
+			// the next build may start when the oldest of the last limit builds leaves the window
+			next = oldest
+		}
+	}
+	quota.NextBuild = ""
+	if next.After(now) {
+		quota.NextBuild = next.Format(pb.TimeLayout)
+	}
+	return quota
+}
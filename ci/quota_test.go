@@ -0,0 +1,54 @@
+package ci
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+func TestComputeQuota(t *testing.T) {
+	now := time.Date(2021, 9, 1, 12, 0, 0, 0, time.Local)
+	// ago returns the date the given number of minutes before now
+	ago := func(minutes int) string {
+		return now.Add(-time.Duration(minutes) * time.Minute).Format(pb.TimeLayout)
+	}
+	jobs := func(minutesAgo ...int) []*pb.BuildJob {
+		var jobs []*pb.BuildJob
+		for _, m := range minutesAgo {
+			jobs = append(jobs, &pb.BuildJob{StartedDate: ago(m)})
+		}
+		return jobs
+	}
+
+	tests := []struct {
+		name          string
+		assignment    *pb.Assignment
+		resetDate     string
+		jobs          []*pb.BuildJob
+		wantBuilds    uint32
+		wantRemaining uint32
+		wantNextBuild string
+	}{
+		{name: "no quota", assignment: &pb.Assignment{}, jobs: jobs(50, 10, 1), wantBuilds: 3},
+		{name: "no builds", assignment: &pb.Assignment{BuildsPerHour: 3, BuildInterval: "5m"}, wantRemaining: 3},
+		{name: "builds left", assignment: &pb.Assignment{BuildsPerHour: 3}, jobs: jobs(50, 10), wantBuilds: 2, wantRemaining: 1},
+		{name: "builds older than an hour", assignment: &pb.Assignment{BuildsPerHour: 2}, jobs: jobs(90, 70, 10), wantBuilds: 1, wantRemaining: 1},
+		{name: "builds used up", assignment: &pb.Assignment{BuildsPerHour: 2}, jobs: jobs(70, 50, 10), wantBuilds: 2, wantNextBuild: ago(-10)},
+		{name: "interval", assignment: &pb.Assignment{BuildInterval: "15m"}, jobs: jobs(50, 10), wantBuilds: 2, wantNextBuild: ago(-5)},
+		{name: "interval passed", assignment: &pb.Assignment{BuildInterval: "5m"}, jobs: jobs(50, 10), wantBuilds: 2},
+		{name: "interval longer than an hour", assignment: &pb.Assignment{BuildInterval: "2h"}, jobs: jobs(90), wantNextBuild: ago(-30)},
+		{name: "later of interval and builds used up", assignment: &pb.Assignment{BuildsPerHour: 1, BuildInterval: "30m"}, jobs: jobs(50), wantBuilds: 1, wantNextBuild: ago(-10)},
+		{name: "reset", assignment: &pb.Assignment{BuildsPerHour: 2, BuildInterval: "15m"}, resetDate: ago(5), jobs: jobs(50, 10), wantRemaining: 2},
+		{name: "builds after reset", assignment: &pb.Assignment{BuildsPerHour: 2}, resetDate: ago(30), jobs: jobs(50, 20, 10), wantBuilds: 2, wantNextBuild: ago(-40)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quota := computeQuota(tt.assignment, &pb.BuildQuota{ResetDate: tt.resetDate}, tt.jobs, now)
+			if quota.GetBuilds() != tt.wantBuilds || quota.GetRemaining() != tt.wantRemaining || quota.GetNextBuild() != tt.wantNextBuild {
+				t.Errorf("computeQuota() = (builds %d, remaining %d, next build %q), want (builds %d, remaining %d, next build %q)",
+					quota.GetBuilds(), quota.GetRemaining(), quota.GetNextBuild(), tt.wantBuilds, tt.wantRemaining, tt.wantNextBuild)
+			}
+		})
+	}
+}
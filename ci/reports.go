@@ -0,0 +1,279 @@
+package ci
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/autograde/quickfeed/kit/score"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v2"
+)
+
+// Test reports of test frameworks for other languages than Go are included in the build log
+// between a line with the report marker, the session secret and the report's format, and a
+// line with the report end marker and the session secret, such as:
+//
+//	QUICKFEED_REPORT 59fd5fe1c4f741604c1beeab875b9c789d2a7c73 junit
+//	<testsuites>...</testsuites>
+//	QUICKFEED_REPORT_END 59fd5fe1c4f741604c1beeab875b9c789d2a7c73
+//
+// The reports are converted into score entries, one for each test, with the weights of the
+// tests given in a report with the weights format, which maps test names to weights.
+const (
+	reportMarker    = "QUICKFEED_REPORT"
+	reportEndMarker = "QUICKFEED_REPORT_END"
+)
+
+// Formats of the reports in the build log.
+const (
+	junitFormat   = "junit"   // JUnit XML, written by most test frameworks, e.g. pytest --junitxml and Gradle
+	pytestFormat  = "pytest"  // JSON, written by pytest --json-report (pytest-json-report plugin)
+	weightsFormat = "weights" // YAML mapping of test names, which may contain * wildcards, to weights
+)
+
+// report holds the lines of a report found in the build log.
+type report struct {
+	format string
+	lines  []string
+}
+
+// reportStart returns the format of the report started by the given line,
+// and false if the line does not start a report with the given secret.
+func reportStart(line, secret string) (string, bool) {
+	fields := strings.Fields(line)
+	if secret == "" || len(fields) != 3 || fields[0] != reportMarker || fields[1] != secret {
+		return "", false
+	}
+	return fields[2], true
+}
+
+// isReportEnd returns true if the given line ends a report with the given secret.
+func isReportEnd(line, secret string) bool {
+	fields := strings.Fields(line)
+	return secret != "" && len(fields) == 2 && fields[0] == reportEndMarker && fields[1] == secret
+}
+
+// reportTest holds the outcome of a test found in a report.
+type reportTest struct {
+	name     string
+	passed   bool
+	duration int64 // in milliseconds
+	output   string
+}
+
+// convertReports returns score entries for the tests of the given reports, and their test runs.
+// If there are weights reports, only the tests whose names match one of their patterns are scored,
+// with the weight of the first matching pattern. Otherwise, all tests are scored with weight 1.
+// A test scores 1 out of 1 if it passed, and 0 otherwise. Invalid reports are logged and ignored.
+func convertReports(logger *zap.SugaredLogger, reports []*report) ([]*score.Score, map[string]*testRun) {
+	var weights []*testWeight
+	var tests []*reportTest
+	for _, r := range reports {
+		var err error
+		switch r.format {
+		case weightsFormat:
+			var w []*testWeight
+			w, err = parseWeights(r.lines)
+			weights = append(weights, w...)
+		case junitFormat:
+			var t []*reportTest
+			t, err = parseJUnit(r.lines)
+			tests = append(tests, t...)
+		case pytestFormat:
+			var t []*reportTest
+			t, err = parsePytest(r.lines)
+			tests = append(tests, t...)
+		default:
+			err = fmt.Errorf("unknown report format %q", r.format)
+		}
+		if err != nil {
+			logger.Error("ci.ExtractResults", zap.String("format", r.format), zap.Error(err))
+		}
+	}
+
+	scores := make([]*score.Score, 0, len(tests))
+	runs := make(map[string]*testRun, len(tests))
+	for _, t := range tests {
+		weight := 1
+		if len(weights) > 0 {
+			w := matchWeight(weights, t.name)
+			if w == nil {
+				continue
+			}
+			weight = w.weight
+		}
+		sc := &score.Score{TestName: t.name, MaxScore: 1, Weight: weight}
+		if t.passed {
+			sc.Score = 1
+		}
+		scores = append(scores, sc)
+		run := &testRun{duration: t.duration}
+		if t.output != "" {
+			run.output = strings.Split(t.output, "\n")
+		}
+		runs[t.name] = run
+	}
+	return scores, runs
+}
+
+// testWeight is the weight of the tests whose names match pattern.
+type testWeight struct {
+	pattern *regexp.Regexp
+	weight  int
+}
+
+// parseWeights returns the weights of the given weights report, in the order of the report.
+func parseWeights(lines []string) ([]*testWeight, error) {
+	var mapping yaml.MapSlice
+	if err := yaml.Unmarshal([]byte(strings.Join(lines, "\n")), &mapping); err != nil {
+		return nil, fmt.Errorf("invalid weights: %w", err)
+	}
+	weights := make([]*testWeight, 0, len(mapping))
+	for _, item := range mapping {
+		name := fmt.Sprint(item.Key)
+		weight, ok := item.Value.(int)
+		if !ok || weight < 0 {
+			return nil, fmt.Errorf("invalid weight %v of test %q", item.Value, name)
+		}
+		parts := strings.Split(name, "*")
+		for i := range parts {
+			parts[i] = regexp.QuoteMeta(parts[i])
+		}
+		weights = append(weights, &testWeight{
+			pattern: regexp.MustCompile("^" + strings.Join(parts, ".*") + "$"),
+			weight:  weight,
+		})
+	}
+	return weights, nil
+}
+
+// matchWeight returns the first of the given weights whose pattern matches the given test name, or nil if none does.
+func matchWeight(weights []*testWeight, name string) *testWeight {
+	for _, w := range weights {
+		if w.pattern.MatchString(name) {
+			return w
+		}
+	}
+	return nil
+}
+
+// junitSuite is a testsuites or testsuite element of a JUnit XML report.
+type junitSuite struct {
+	Suites []*junitSuite `xml:"testsuite"`
+	Cases  []*junitCase  `xml:"testcase"`
+}
+
+type junitCase struct {
+	Name      string          `xml:"name,attr"`
+	ClassName string          `xml:"classname,attr"`
+	Time      string          `xml:"time,attr"`
+	Failures  []*junitFailure `xml:"failure"`
+	Errors    []*junitFailure `xml:"error"`
+	Skipped   *struct{}       `xml:"skipped"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// parseJUnit returns the tests of the given JUnit XML report, named by their class name and name,
+// such as test_fib.test_fibonacci. Tests that were skipped, failed or had errors did not pass.
+func parseJUnit(lines []string) ([]*reportTest, error) {
+	var root junitSuite
+	if err := xml.Unmarshal([]byte(strings.Join(lines, "\n")), &root); err != nil {
+		return nil, fmt.Errorf("invalid junit report: %w", err)
+	}
+	var tests []*reportTest
+	var walk func(suite *junitSuite)
+	walk = func(suite *junitSuite) {
+		for _, c := range suite.Cases {
+			name := c.Name
+			if c.ClassName != "" {
+				name = c.ClassName + "." + c.Name
+			}
+			seconds, _ := strconv.ParseFloat(strings.ReplaceAll(c.Time, ",", ""), 64)
+			var output []string
+			for _, f := range append(c.Failures, c.Errors...) {
+				for _, s := range []string{f.Message, strings.TrimSpace(f.Text)} {
+					if s != "" && !contains(output, s) {
+						output = append(output, s)
+					}
+				}
+			}
+			tests = append(tests, &reportTest{
+				name:     name,
+				passed:   len(c.Failures) == 0 && len(c.Errors) == 0 && c.Skipped == nil,
+				duration: int64(seconds * 1000),
+				output:   strings.Join(output, "\n"),
+			})
+		}
+		for _, s := range suite.Suites {
+			walk(s)
+		}
+	}
+	walk(&root)
+	if len(tests) == 0 {
+		return nil, errors.New("junit report has no tests")
+	}
+	return tests, nil
+}
+
+// pytestReport is a JSON report written by the pytest-json-report plugin.
+type pytestReport struct {
+	Tests []*struct {
+		NodeID   string       `json:"nodeid"`
+		Outcome  string       `json:"outcome"`
+		Setup    *pytestStage `json:"setup"`
+		Call     *pytestStage `json:"call"`
+		Teardown *pytestStage `json:"teardown"`
+	} `json:"tests"`
+}
+
+type pytestStage struct {
+	Duration float64 `json:"duration"`
+	Longrepr string  `json:"longrepr"`
+}
+
+// parsePytest returns the tests of the given pytest JSON report, named by their node IDs,
+// such as test_fib.py::test_fibonacci. Only tests with the passed outcome passed.
+func parsePytest(lines []string) ([]*reportTest, error) {
+	var r pytestReport
+	if err := json.Unmarshal([]byte(strings.Join(lines, "\n")), &r); err != nil {
+		return nil, fmt.Errorf("invalid pytest report: %w", err)
+	}
+	if len(r.Tests) == 0 {
+		return nil, errors.New("pytest report has no tests")
+	}
+	tests := make([]*reportTest, 0, len(r.Tests))
+	for _, t := range r.Tests {
+		test := &reportTest{name: t.NodeID, passed: t.Outcome == "passed"}
+		var seconds float64
+		for _, stage := range []*pytestStage{t.Setup, t.Call, t.Teardown} {
+			if stage == nil {
+				continue
+			}
+			seconds += stage.Duration
+			if test.output == "" {
+				test.output = strings.TrimSpace(stage.Longrepr)
+			}
+		}
+		test.duration = int64(seconds * 1000)
+		tests = append(tests, test)
+	}
+	return tests, nil
+}
+
+func contains(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,184 @@
+package ci
+
+import (
+	"strings"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/kit/score"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"go.uber.org/zap"
+)
+
+const reportSecret = "59fd5fe1c4f741604c1beeab875b9c789d2a7c73"
+
+const junitXMLReport = `<?xml version="1.0" encoding="utf-8"?>
+<testsuites>
+  <testsuite name="pytest" tests="3" failures="1" skipped="1">
+    <testcase classname="test_fib" name="test_fibonacci" time="0.120"/>
+    <testcase classname="test_fib" name="test_large" time="1.500">
+      <failure message="AssertionError: assert 1 == 2">def test_large():
+&gt;       assert fib(3) == 2
+E       AssertionError: assert 1 == 2</failure>
+    </testcase>
+    <testcase classname="test_sort" name="test_sort" time="0.001">
+      <skipped message="not implemented"/>
+    </testcase>
+  </testsuite>
+</testsuites>`
+
+const pytestJSONReport = `{
+  "created": 1634567890.1,
+  "summary": {"passed": 1, "failed": 1, "total": 2},
+  "tests": [
+    {"nodeid": "test_fib.py::test_fibonacci", "outcome": "passed",
+     "setup": {"duration": 0.01, "outcome": "passed"},
+     "call": {"duration": 0.2, "outcome": "passed"},
+     "teardown": {"duration": 0.01, "outcome": "passed"}},
+    {"nodeid": "test_fib.py::test_large[40]", "outcome": "failed",
+     "setup": {"duration": 0.0, "outcome": "passed"},
+     "call": {"duration": 1.0, "outcome": "failed", "longrepr": "E   AssertionError: assert 1 == 2"},
+     "teardown": {"duration": 0.0, "outcome": "passed"}}
+  ]
+}`
+
+// inReport returns the given report in the build log format.
+func inReport(format, secret, report string) string {
+	return reportMarker + " " + secret + " " + format + "\n" + report + "\n" + reportEndMarker + " " + secret + "\n"
+}
+
+func TestExtractResultReports(t *testing.T) {
+	tests := []struct {
+		name string
+		out  string
+		want []*pb.TestResult
+		// reports with another secret are not converted, and are kept in the build log
+		forged bool
+	}{
+		{
+			name: "junit",
+			out:  "Running tests\n" + inReport(junitFormat, reportSecret, junitXMLReport) + "Finished tests\n",
+			want: []*pb.TestResult{
+				{TestName: "test_fib.test_fibonacci", Score: 1, MaxScore: 1, Weight: 1, Duration: 120},
+				{TestName: "test_fib.test_large", Score: 0, MaxScore: 1, Weight: 1, Duration: 1500, FailureOutput: "AssertionError: assert 1 == 2\ndef test_large():\n>       assert fib(3) == 2\nE       AssertionError: assert 1 == 2"},
+				{TestName: "test_sort.test_sort", Score: 0, MaxScore: 1, Weight: 1, Duration: 1},
+			},
+		},
+		{
+			name: "pytest",
+			out:  inReport(pytestFormat, reportSecret, pytestJSONReport),
+			want: []*pb.TestResult{
+				{TestName: "test_fib.py::test_fibonacci", Score: 1, MaxScore: 1, Weight: 1, Duration: 220},
+				{TestName: "test_fib.py::test_large[40]", Score: 0, MaxScore: 1, Weight: 1, Duration: 1000, FailureOutput: "E   AssertionError: assert 1 == 2"},
+			},
+		},
+		{
+			name: "weights",
+			out: inReport(weightsFormat, reportSecret, `# the first matching pattern gives the weight of a test
+test_fib.test_large: 5
+"*.test_fibonacci": 2
+"test_sort.*": 0`) + inReport(junitFormat, reportSecret, junitXMLReport),
+			want: []*pb.TestResult{
+				{TestName: "test_fib.test_fibonacci", Score: 1, MaxScore: 1, Weight: 2, Duration: 120},
+				{TestName: "test_fib.test_large", Score: 0, MaxScore: 1, Weight: 5, Duration: 1500, FailureOutput: "AssertionError: assert 1 == 2\ndef test_large():\n>       assert fib(3) == 2\nE       AssertionError: assert 1 == 2"},
+				{TestName: "test_sort.test_sort", Score: 0, MaxScore: 1, Weight: 0, Duration: 1},
+			},
+		},
+		{
+			name: "weights leave out unknown tests",
+			out:  inReport(pytestFormat, reportSecret, pytestJSONReport) + inReport(weightsFormat, reportSecret, `"test_fib.py::test_large[*]": 3`),
+			want: []*pb.TestResult{
+				{TestName: "test_fib.py::test_large[40]", Score: 0, MaxScore: 1, Weight: 3, Duration: 1000, FailureOutput: "E   AssertionError: assert 1 == 2"},
+			},
+		},
+		{
+			name: "with score lines",
+			out: `{"Secret":"` + reportSecret + `","TestName":"Style","Score":8,"MaxScore":10,"Weight":1}
+` + inReport(pytestFormat, reportSecret, pytestJSONReport),
+			want: []*pb.TestResult{
+				{TestName: "Style", Score: 8, MaxScore: 10, Weight: 1},
+				{TestName: "test_fib.py::test_fibonacci", Score: 1, MaxScore: 1, Weight: 1, Duration: 220},
+				{TestName: "test_fib.py::test_large[40]", Score: 0, MaxScore: 1, Weight: 1, Duration: 1000, FailureOutput: "E   AssertionError: assert 1 == 2"},
+			},
+		},
+		{
+			name:   "forged report",
+			out:    inReport(junitFormat, "guess", junitXMLReport),
+			want:   []*pb.TestResult{},
+			forged: true,
+		},
+		{
+			name: "invalid reports",
+			out: inReport(junitFormat, reportSecret, "<testsuite>") + inReport(pytestFormat, reportSecret, `{"tests": []}`) +
+				inReport("tap", reportSecret, "ok 1 - test_fib") + inReport(weightsFormat, reportSecret, `test_fib: many`),
+			want: []*pb.TestResult{},
+		},
+		{
+			name: "report without end marker",
+			out:  reportMarker + " " + reportSecret + " " + junitFormat + "\n" + junitXMLReport,
+			want: []*pb.TestResult{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := ExtractResult(zap.NewNop().Sugar(), tt.out, reportSecret, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, res.TestResults(), cmpopts.IgnoreUnexported(pb.TestResult{})); diff != "" {
+				t.Errorf("TestResults() mismatch (-want +got):\n%s", diff)
+			}
+			if inLog := strings.Contains(res.BuildInfo.BuildLog, "testcase") || strings.Contains(res.BuildInfo.BuildLog, "nodeid"); inLog != tt.forged {
+				t.Errorf("ExtractResult() build log contains test report = %t, want %t:\n%s", inLog, tt.forged, res.BuildInfo.BuildLog)
+			}
+		})
+	}
+}
+
+func TestExtractResultsHiddenReports(t *testing.T) {
+	const hiddenSecret = "0b3ef6d1a1e5e8b3a4c7a1b3a2f3e4d5c6b7a8f9"
+	out := inReport(pytestFormat, reportSecret, pytestJSONReport) +
+		inReport(junitFormat, hiddenSecret, `<testsuite><testcase classname="test_hidden" name="test_hidden"/></testsuite>`)
+	visible, hidden, err := ExtractResults(zap.NewNop().Sugar(), out, reportSecret, hiddenSecret, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(visible.Scores) != 2 {
+		t.Errorf("ExtractResults() visible scores = %+v, want the tests of the pytest report", visible.Scores)
+	}
+	want := []*score.Score{{TestName: "test_hidden.test_hidden", Score: 1, MaxScore: 1, Weight: 1}}
+	if hidden == nil || !cmp.Equal(want, hidden.Scores) {
+		t.Errorf("ExtractResults() hidden result = %+v, want scores %+v", hidden, want)
+	}
+}
+
+func TestReportMarkers(t *testing.T) {
+	tests := []struct {
+		line       string
+		wantFormat string
+		wantStart  bool
+		wantEnd    bool
+	}{
+		{line: "QUICKFEED_REPORT " + reportSecret + " junit", wantFormat: "junit", wantStart: true},
+		{line: "  QUICKFEED_REPORT " + reportSecret + " pytest\r", wantFormat: "pytest", wantStart: true},
+		{line: "QUICKFEED_REPORT_END " + reportSecret, wantEnd: true},
+		{line: "QUICKFEED_REPORT guess junit"},
+		{line: "QUICKFEED_REPORT " + reportSecret},
+		{line: "QUICKFEED_REPORT_END guess"},
+		{line: "echo QUICKFEED_REPORT " + reportSecret + " junit"},
+	}
+	for _, tt := range tests {
+		format, ok := reportStart(tt.line, reportSecret)
+		if format != tt.wantFormat || ok != tt.wantStart {
+			t.Errorf("reportStart(%q) = (%q, %t), want (%q, %t)", tt.line, format, ok, tt.wantFormat, tt.wantStart)
+		}
+		if got := isReportEnd(tt.line, reportSecret); got != tt.wantEnd {
+			t.Errorf("isReportEnd(%q) = %t, want %t", tt.line, got, tt.wantEnd)
+		}
+	}
+	// reports are never recognized without a secret
+	if _, ok := reportStart("QUICKFEED_REPORT  junit", ""); ok {
+		t.Error("reportStart() recognized a report without a secret")
+	}
+}
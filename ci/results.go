@@ -2,6 +2,7 @@ package ci
 
 import (
 	"encoding/json"
+	"errors"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -17,6 +18,7 @@ import (
 type Result struct {
 	Scores    []*score.Score `json:"scores"`
 	BuildInfo *BuildInfo     `json:"buildinfo"`
+	testRuns  map[string]*testRun
 }
 
 // BuildInfo holds build data for one test execution for an assignment.
@@ -25,15 +27,35 @@ type BuildInfo struct {
 	BuildDate string `json:"builddate"`
 	BuildLog  string `json:"buildlog"`
 	ExecTime  int64  `json:"execTime"`
+	Quota     *Quota `json:"quota,omitempty"`
+	// Steps holds the results of the steps of the build pipeline, if any.
+	Steps []*StepInfo `json:"steps,omitempty"`
 }
 
 var globalBuildID = new(int64)
 
 // ExtractResult returns a result struct for the given log.
+// The test reports in the log are converted into score entries, as described by convertReports,
+// and are left out of the build log.
 func ExtractResult(logger *zap.SugaredLogger, out, secret string, execTime time.Duration) (*Result, error) {
 	var filteredLog []string
 	scores := make([]*score.Score, 0)
+	var reports []*report
+	var current *report
 	for _, line := range strings.Split(out, "\n") {
+		if current != nil {
+			if isReportEnd(line, secret) {
+				reports = append(reports, current)
+				current = nil
+			} else {
+				current.lines = append(current.lines, line)
+			}
+			continue
+		}
+		if format, ok := reportStart(line, secret); ok {
+			current = &report{format: format}
+			continue
+		}
 		// check if line has expected JSON score string
 		if score.HasPrefix(line) {
 			sc, err := score.Parse(line, secret)
@@ -50,7 +72,15 @@ func ExtractResult(logger *zap.SugaredLogger, out, secret string, execTime time.
 			filteredLog = append(filteredLog, line)
 		}
 	}
-	scores = filter(scores)
+	if current != nil {
+		logger.Error("ci.ExtractResults", zap.String("format", current.format), zap.Error(errors.New("report has no end marker")))
+	}
+	reportScores, reportRuns := convertReports(logger, reports)
+	scores = filter(append(scores, reportScores...))
+	testRuns := parseTestRuns(filteredLog)
+	for name, run := range reportRuns {
+		testRuns[name] = run
+	}
 	logger.Debug("ci.ExtractResults",
 		zap.Any("scores", log.IndentJson(scores)),
 		zap.Any("filteredLog", log.IndentJson(filteredLog)),
@@ -63,9 +93,52 @@ func ExtractResult(logger *zap.SugaredLogger, out, secret string, execTime time.
 			BuildLog:  strings.Join(filteredLog, "\n"),
 			ExecTime:  execTime.Milliseconds(),
 		},
+		testRuns: testRuns,
 	}, nil
 }
 
+// ExtractResults returns the results of the visible and the hidden tests for the given log.
+// The output of the hidden tests follows the first line containing the hidden secret,
+// and their score lines are validated with the hidden secret. The hidden result is nil
+// if the log has no output of hidden tests. Since the hidden tests may run together with
+// the visible tests, the hidden result leaves out the scores of the visible tests.
+func ExtractResults(logger *zap.SugaredLogger, out, secret, hiddenSecret string, execTime time.Duration) (visible, hidden *Result, err error) {
+	lines := strings.Split(out, "\n")
+	start := len(lines)
+	if hiddenSecret != "" {
+		for i, line := range lines {
+			if strings.Contains(line, hiddenSecret) {
+				start = i
+				break
+			}
+		}
+	}
+	visible, err = ExtractResult(logger, strings.Join(lines[:start], "\n"), secret, execTime)
+	if err != nil || start == len(lines) {
+		return visible, nil, err
+	}
+	if _, ok := reportStart(lines[start], hiddenSecret); !ok && !score.HasPrefix(lines[start]) {
+		// skip the line announcing the hidden tests
+		start++
+	}
+	hidden, err = ExtractResult(logger, strings.Join(lines[start:], "\n"), hiddenSecret, execTime)
+	if err != nil {
+		return nil, nil, err
+	}
+	visibleTests := make(map[string]bool, len(visible.Scores))
+	for _, sc := range visible.Scores {
+		visibleTests[sc.TestName] = true
+	}
+	hiddenScores := make([]*score.Score, 0, len(hidden.Scores))
+	for _, sc := range hidden.Scores {
+		if !visibleTests[sc.TestName] {
+			hiddenScores = append(hiddenScores, sc)
+		}
+	}
+	hidden.Scores = hiddenScores
+	return visible, hidden, nil
+}
+
 // filter returns a slice of scores, exactly one per TestName.
 // The input score slice may contain one or two entries per TestName.
 // If more than two entries are found for a given TestName, we return a 0 score for that test.
@@ -159,3 +159,57 @@ func TestExecTime(t *testing.T) {
 		})
 	}
 }
+
+func TestExtractResults(t *testing.T) {
+	const (
+		secret       = "59fd5fe1c4f741604c1beeab875b9c789d2a7c73"
+		hiddenSecret = "0b3ef6d1a1e5e8b3a4c7a1b3a2f3e4d5c6b7a8f9"
+	)
+	out := `=== RUN   TestVisible
+{"Secret":"` + secret + `","TestName":"TestVisible","Score":100,"MaxScore":100,"Weight":1}
+--- PASS: TestVisible (0.01s)
+
+*** Running Hidden Tests ` + hiddenSecret + ` ***
+
+=== RUN   TestVisible
+{"Secret":"` + hiddenSecret + `","TestName":"TestVisible","Score":100,"MaxScore":100,"Weight":1}
+--- PASS: TestVisible (0.01s)
+=== RUN   TestHidden
+{"Secret":"` + hiddenSecret + `","TestName":"TestHidden","Score":0,"MaxScore":100,"Weight":1}
+    hidden_test.go:10: hidden failure
+--- FAIL: TestHidden (0.02s)
+{"Secret":"` + secret + `","TestName":"TestForged","Score":100,"MaxScore":100,"Weight":1}
+`
+	visible, hidden, err := ExtractResults(zap.NewNop().Sugar(), out, secret, hiddenSecret, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(visible.Scores) != 1 || visible.Scores[0].TestName != "TestVisible" {
+		t.Errorf("ExtractResults() visible scores = %+v, want only TestVisible", visible.Scores)
+	}
+	if strings.Contains(visible.BuildInfo.BuildLog, "hidden") || strings.Contains(visible.BuildInfo.BuildLog, hiddenSecret) {
+		t.Errorf("ExtractResults() visible build log contains output of hidden tests:\n%s", visible.BuildInfo.BuildLog)
+	}
+	if hidden == nil {
+		t.Fatal("ExtractResults() returned no hidden result")
+	}
+	// the visible tests are run again with the hidden tests, and score lines with the visible secret are rejected
+	if len(hidden.Scores) != 1 || hidden.Scores[0].TestName != "TestHidden" {
+		t.Errorf("ExtractResults() hidden scores = %+v, want only TestHidden", hidden.Scores)
+	}
+	if !strings.Contains(hidden.BuildInfo.BuildLog, "hidden failure") {
+		t.Errorf("ExtractResults() hidden build log does not contain output of hidden tests:\n%s", hidden.BuildInfo.BuildLog)
+	}
+	if results := hidden.TestResults(); len(results) != 1 || results[0].GetFailureOutput() == "" {
+		t.Errorf("ExtractResults() hidden test results = %v, want failure output of TestHidden", results)
+	}
+
+	// without hidden tests, all score lines with the visible secret are visible
+	visible, hidden, err = ExtractResults(zap.NewNop().Sugar(), out, secret, "", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hidden != nil || len(visible.Scores) != 2 {
+		t.Errorf("ExtractResults() without hidden secret = (%+v, %+v), want 2 visible scores and no hidden result", visible.Scores, hidden)
+	}
+}
@@ -5,10 +5,12 @@ import (
 	"crypto/rand"
 	"crypto/sha1"
 	"fmt"
+	"strings"
 	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/kit/score"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 )
@@ -24,6 +26,7 @@ type RunData struct {
 	Repo       *pb.Repository
 	CommitID   string
 	JobOwner   string
+	Deadline   bool // build the commit of a deadline snapshot, which is never late
 }
 
 // String returns a string representation of the run data structure
@@ -31,41 +34,76 @@ func (r RunData) String(secret string) string {
 	return fmt.Sprintf("%s-%s-%s-%s", r.Course.GetCode(), r.Assignment.GetName(), r.JobOwner, secret)
 }
 
+// newLimits returns the limits of the containers running the tests of the given assignment.
+func newLimits(assignment *pb.Assignment) Limits {
+	return Limits{
+		Memory:    int64(assignment.GetMemoryLimit()),
+		CPUs:      assignment.GetCpuLimit(),
+		Pids:      int64(assignment.GetPidsLimit()),
+		Disk:      int64(assignment.GetDiskLimit()),
+		NoNetwork: assignment.GetNetworkDisabled(),
+		ReadOnly:  assignment.GetReadOnlyRoot(),
+	}
+}
+
 // RunTests runs the assignment specified in the provided RunData structure.
 func RunTests(logger *zap.SugaredLogger, db database.Database, runner Runner, rData *RunData) {
+	if _, err := runTestsAndRecord(context.Background(), logger, db, runner, scriptPath, rData, jobCaches{}, nil); err != nil {
+		logger.Errorf("Failed to run tests for %s: %v", rData.JobOwner, err)
+	}
+}
+
+// runTestsAndRecord runs the assignment specified in the provided RunData structure
+// using the scripts found in path, records the results in the database, and returns the new submission.
+// The tests use the given caches of the course, if any.
+// If emit is non-nil and runner is a StreamingRunner, the output is emitted line by line while running.
+// Canceling ctx aborts the test execution without recording any results.
+func runTestsAndRecord(ctx context.Context, logger *zap.SugaredLogger, db database.Database, runner Runner, path string, rData *RunData, caches jobCaches, emit func(line string)) (*pb.Submission, error) {
 	info := newAssignmentInfo(rData.Course, rData.Assignment, rData.Repo.GetHTMLURL(), rData.Repo.GetTestURL())
+	caches.use(info)
+	if rData.Deadline {
+		info.CommitID = rData.CommitID
+	}
+	info.RunHidden = rData.Assignment.RunsHiddenTests(rData.Deadline)
 	logger.Debugf("Running tests for %s", rData.JobOwner)
-	ed, err := runTests(scriptPath, runner, info, rData)
+	ed, err := runTests(ctx, path, runner, info, rData, emit)
 	if err != nil {
-		logger.Errorf("Failed to run tests: %v", err)
-		if ed == nil {
-			return
+		if ed == nil || ctx.Err() == context.Canceled {
+			return nil, err
 		}
 		// we only get here if err was a timeout, so that we can log 'out' to the user
+		logger.Errorf("Failed to run tests: %v", err)
 	}
-	result, err := ExtractResult(logger, ed.out, info.RandomSecret, ed.execTime)
+	result, hidden, err := ExtractResults(logger, ed.out, info.RandomSecret, info.HiddenSecret, ed.execTime)
 	if err != nil {
-		logger.Errorf("Failed to extract results from log: %v", err)
-		return
+		return nil, fmt.Errorf("failed to extract results from log: %w", err)
 	}
-	recordResults(logger, db, rData, result)
+	if ed.steps != nil {
+		result.BuildInfo.Steps = newStepInfos(ed.steps)
+	}
+	return recordResults(logger, db, rData, result, hidden)
 }
 
 type execData struct {
 	out      string
 	execTime time.Duration
+	steps    []*StepResult // the results of the steps of the build pipeline, if any
 }
 
 // runTests returns execData struct.
 // An error is returned if the execution fails, or times out.
 // If a timeout is the cause of the error, we also return an output string to the user.
-func runTests(path string, runner Runner, info *AssignmentInfo, rData *RunData) (*execData, error) {
-	job, err := parseScriptTemplate(path, info)
+// If emit is non-nil and runner is a StreamingRunner, the output is emitted line by line,
+// except score lines, test reports, lines revealing the secret, and the output of hidden tests.
+func runTests(parent context.Context, path string, runner Runner, info *AssignmentInfo, rData *RunData, emit func(line string)) (*execData, error) {
+	job, err := newJob(path, info, rData.Assignment)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse script template: %w", err)
+		return nil, err
 	}
 
 	job.Name = rData.String(info.RandomSecret[:6])
+	job.Limits = newLimits(rData.Assignment)
+	job.Cache, job.Tests = info.cacheVolume, info.testsVolume
 	start := time.Now()
 
 	timeout := containerTimeout
@@ -73,23 +111,108 @@ func runTests(path string, runner Runner, info *AssignmentInfo, rData *RunData)
 	if t > 0 {
 		timeout = time.Duration(t) * time.Minute
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	ctx, cancel := context.WithTimeout(parent, timeout)
 	defer cancel()
 
-	out, err := runner.Run(ctx, job)
+	var filteredEmit func(line string)
+	if emit != nil {
+		hidden, inReport := false, false
+		filteredEmit = func(line string) {
+			// the output of hidden tests follows the first line with the hidden secret
+			if info.HiddenSecret != "" && strings.Contains(line, info.HiddenSecret) {
+				hidden = true
+			}
+			// test reports are converted into scores
+			if inReport {
+				inReport = !isReportEnd(line, info.RandomSecret)
+				return
+			}
+			if _, ok := reportStart(line, info.RandomSecret); ok {
+				inReport = true
+				return
+			}
+			// never reveal the score lines, the secret used to validate them, or hidden tests
+			if !hidden && !score.HasPrefix(line) && !strings.Contains(line, info.RandomSecret) {
+				emit(line)
+			}
+		}
+	}
+
+	var out string
+	var steps []*StepResult
+	if len(job.Steps) > 0 {
+		pr, ok := runner.(PipelineRunner)
+		if !ok {
+			return nil, fmt.Errorf("runner does not support the pipeline of assignment %s", rData.Assignment.GetName())
+		}
+		steps, err = pr.RunSteps(ctx, job, filteredEmit)
+		out = pipelineOutput(steps)
+	} else if sr, ok := runner.(StreamingRunner); ok && emit != nil {
+		out, err = sr.RunStream(ctx, job, filteredEmit)
+	} else {
+		out, err = runner.Run(ctx, job)
+	}
 	if err != nil && out == "" {
 		return nil, fmt.Errorf("test execution failed: %w", err)
 	}
 	// this may return a timeout error as well
-	return &execData{out: out, execTime: time.Since(start)}, err
+	return &execData{out: out, execTime: time.Since(start), steps: steps}, err
+}
+
+// newJob returns the job that runs the tests of the given assignment; the job runs the
+// steps of the assignment's build pipeline, if any, or else the commands of its script file.
+func newJob(path string, info *AssignmentInfo, assignment *pb.Assignment) (*Job, error) {
+	pipeline, err := getPipeline(assignment)
+	if err != nil {
+		return nil, err
+	}
+	if pipeline != nil {
+		return newPipelineJob(info, pipeline), nil
+	}
+	job, err := parseScriptTemplate(path, info)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse script template: %w", err)
+	}
+	return job, nil
 }
 
-// recordResults for the assignment given by the run data structure.
-func recordResults(logger *zap.SugaredLogger, db database.Database, rData *RunData, result *Result) {
+// recordResults for the assignment given by the run data structure, and returns the new submission.
+// The score of the submission is the total score of the result less the late penalty of the assignment.
+// The results of the hidden tests, if any, are recorded separately with the same late penalty.
+func recordResults(logger *zap.SugaredLogger, db database.Database, rData *RunData, result, hidden *Result) (*pb.Submission, error) {
+	rawScore := result.TotalScore()
+	penalty := latePenalty(logger, db, rData, result.BuildInfo.BuildDate)
+	score := pb.PenalizedScore(rawScore, penalty)
+	if penalty > 0 {
+		// show the penalty to the student along with the build log
+		result.BuildInfo.BuildLog += fmt.Sprintf("\nLate submission: score reduced by %d%% from %d to %d\n", penalty, rawScore, score)
+	}
+	if !rData.Deadline && rData.Assignment.HasBuildQuota() {
+		// show the remaining build quota to the student along with the build log
+		quota, err := getBuildQuota(db, rData.Assignment, rData.Repo.GetID(), time.Now())
+		if err != nil {
+			logger.Errorf("Failed to get build quota for assignment %d: %v", rData.Assignment.GetID(), err)
+		} else {
+			result.BuildInfo.Quota = newQuota(rData.Assignment, quota)
+		}
+	}
 	buildInfo, scores, err := result.Marshal()
 	if err != nil {
-		logger.Errorf("Failed to marshal build info and scores: %v", err)
-		return
+		return nil, fmt.Errorf("failed to marshal build info and scores: %w", err)
+	}
+	testResults := result.TestResults()
+	var hiddenScore uint32
+	var hiddenBuildInfo, hiddenScores string
+	if hidden != nil {
+		hiddenScore = pb.PenalizedScore(hidden.TotalScore(), penalty)
+		hiddenBuildInfo, hiddenScores, err = hidden.Marshal()
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal build info and scores of hidden tests: %w", err)
+		}
+		for _, testResult := range hidden.TestResults() {
+			testResult.Hidden = true
+			testResults = append(testResults, testResult)
+		}
 	}
 
 	logger.Debugf("Fetching most recent submission for assignment %d", rData.Assignment.GetID())
@@ -100,33 +223,76 @@ func recordResults(logger *zap.SugaredLogger, db database.Database, rData *RunDa
 	}
 	newest, err := db.GetSubmission(submissionQuery)
 	if err != nil && err != gorm.ErrRecordNotFound {
-		logger.Errorf("Failed to get submission data from database: %v", err)
-		return
+		return nil, fmt.Errorf("failed to get submission data from database: %w", err)
 	}
 	// keep approved status if already approved
 	approvedStatus := newest.GetStatus()
-	if rData.Assignment.AutoApprove && result.TotalScore() >= rData.Assignment.GetScoreLimit() {
+	if rData.Assignment.AutoApprove && score >= rData.Assignment.GetScoreLimit() {
 		approvedStatus = pb.Submission_APPROVED
 	}
 
-	score := result.TotalScore()
 	newSubmission := &pb.Submission{
-		AssignmentID: rData.Assignment.ID,
-		BuildInfo:    buildInfo,
-		CommitHash:   rData.CommitID,
-		Score:        score,
-		ScoreObjects: scores,
-		UserID:       rData.Repo.GetUserID(),
-		GroupID:      rData.Repo.GetGroupID(),
-		Status:       approvedStatus,
+		AssignmentID:       rData.Assignment.ID,
+		BuildInfo:          buildInfo,
+		CommitHash:         rData.CommitID,
+		Score:              score,
+		RawScore:           rawScore,
+		ScoreObjects:       scores,
+		UserID:             rData.Repo.GetUserID(),
+		GroupID:            rData.Repo.GetGroupID(),
+		Status:             approvedStatus,
+		TestResults:        testResults,
+		HiddenScore:        hiddenScore,
+		HiddenScoreObjects: hiddenScores,
+		HiddenBuildInfo:    hiddenBuildInfo,
 	}
 	err = db.CreateSubmission(newSubmission)
 	if err != nil {
-		logger.Errorf("Failed to add submission to database: %v", err)
-		return
+		return nil, fmt.Errorf("failed to add submission to database: %w", err)
+	}
+	if newSubmission.GetGradedAttemptID() == newest.GetGradedAttemptID() {
+		// the attempt is not graded, since the submission is frozen at its deadline snapshot
+		logger.Debugf("Recorded ungraded attempt for frozen submission %d for assignment '%s'", newSubmission.GetID(), rData.Assignment.GetName())
+		return newSubmission, nil
 	}
 	logger.Debugf("Created submission for assignment '%s' with status %s", rData.Assignment.GetName(), approvedStatus)
-	updateSlipDays(logger, db, rData.Assignment, newSubmission, result.BuildInfo.BuildDate)
+	if rData.Assignment.UsesSlipDays(rData.Course) && !rData.Deadline {
+		updateSlipDays(logger, db, rData.Assignment, newSubmission, result.BuildInfo.BuildDate)
+	}
+	return newSubmission, nil
+}
+
+// latePenalty returns the percentage deducted from the score of a submission built at
+// the given build date, according to the late policy of the assignment given by the
+// run data structure. No penalty is deducted if the penalty cannot be computed, or for
+// deadline builds, since the commit of a deadline snapshot was pushed before the deadline.
+func latePenalty(logger *zap.SugaredLogger, db database.Database, rData *RunData, buildDate string) uint32 {
+	if rData.Assignment.UsesSlipDays(rData.Course) || rData.Deadline {
+		return 0
+	}
+	buildTime, err := time.Parse(pb.TimeLayout, buildDate)
+	if err != nil {
+		logger.Errorf("Failed to parse time from build date (%s): %v", buildDate, err)
+		return 0
+	}
+	extensions, err := db.GetDeadlineExtensions(rData.Assignment.GetCourseID())
+	if err != nil {
+		logger.Errorf("Failed to get deadline extensions for course %d: %v", rData.Assignment.GetCourseID(), err)
+		return 0
+	}
+	// the deadline extensions apply to the student or group owning the repository
+	enrollment := &pb.Enrollment{
+		CourseID: rData.Assignment.GetCourseID(),
+		UserID:   rData.Repo.GetUserID(),
+		GroupID:  rData.Repo.GetGroupID(),
+		Course:   rData.Course,
+	}
+	penalty, err := rData.Assignment.PenaltyAt(buildTime, enrollment, extensions)
+	if err != nil {
+		logger.Errorf("Failed to compute late penalty for assignment %d: %v", rData.Assignment.GetID(), err)
+		return 0
+	}
+	return penalty
 }
 
 func randomSecret() string {
@@ -161,15 +327,31 @@ func updateSlipDays(logger *zap.SugaredLogger, db database.Database, assignment
 		}
 		enrollments = append(enrollments, enrol)
 	}
+	// slip days are counted from extended deadlines, after the course's grace period
+	course, err := db.GetCourse(assignment.CourseID, false)
+	if err != nil {
+		logger.Errorf("Failed to get course %d: %v", assignment.CourseID, err)
+		return
+	}
+	extensions, err := db.GetDeadlineExtensions(assignment.CourseID)
+	if err != nil {
+		logger.Errorf("Failed to get deadline extensions for course %d: %v", assignment.CourseID, err)
+		return
+	}
 
-	for _, enrol := range enrollments {
-		if err := enrol.UpdateSlipDays(buildTime, assignment, submission); err != nil {
-			logger.Errorf("Failed to update slip days for submission %d: %v", submission.ID, err)
-			return
-		}
-		if err := db.UpdateSlipDays(enrol.UsedSlipDays); err != nil {
-			logger.Errorf("Failed to update slip days for enrollment %d: %v", enrol.ID, err)
-			return
+	// update the slip days of all group members, or none of them
+	if err := db.Transaction(func(tx database.Database) error {
+		for _, enrol := range enrollments {
+			enrol.Course = course
+			if err := enrol.UpdateSlipDays(buildTime, assignment, submission, extensions...); err != nil {
+				return fmt.Errorf("submission %d: %w", submission.ID, err)
+			}
+			if err := tx.UpdateSlipDays(enrol.UsedSlipDays); err != nil {
+				return fmt.Errorf("enrollment %d: %w", enrol.ID, err)
+			}
 		}
+		return nil
+	}); err != nil {
+		logger.Errorf("Failed to update slip days: %v", err)
 	}
 }
@@ -1,14 +1,22 @@
 package ci
 
 import (
+	"context"
 	"crypto/rand"
 	"crypto/sha1"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/kit/score"
 	"github.com/autograde/quickfeed/log"
+	"go.uber.org/zap"
 )
 
 const (
@@ -49,14 +57,297 @@ func TestRunTests(t *testing.T) {
 		JobOwner: "muggles",
 	}
 
-	runner, err := NewDockerCI(log.Zap(true))
+	runner, err := NewDockerCI(log.Zap(true), DockerOptions{})
 	if err != nil {
 		t.Fatal(err)
 	}
 	defer runner.Close()
-	ed, err := runTests("scripts", runner, info, runData)
+	ed, err := runTests(context.Background(), "scripts", runner, info, runData, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
 	t.Logf("\n%s\nExecTime: %v\nSecret: %v\n", ed.out, ed.execTime, info.RandomSecret)
 }
+
+func setup(t *testing.T) (database.Database, func()) {
+	t.Helper()
+	f, err := ioutil.TempFile(os.TempDir(), "testdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		t.Fatal(err)
+	}
+	db, err := database.NewGormDB(f.Name(), zap.NewNop())
+	if err != nil {
+		os.Remove(f.Name())
+		t.Fatal(err)
+	}
+	return db, func() {
+		if err := os.Remove(f.Name()); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+// createCourseWithStudent creates an admin, the given course with the admin as
+// teacher, a student enrolled in the course and the given assignment lab1 of the course.
+func createCourseWithStudent(t *testing.T, db database.Database, course *pb.Course, assignment *pb.Assignment) (admin, student *pb.User) {
+	t.Helper()
+	admin = &pb.User{}
+	if err := db.CreateUserFromRemoteIdentity(admin, &pb.RemoteIdentity{Provider: "fake", RemoteID: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateCourse(admin.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	student = createStudent(t, db, course, 2)
+	assignment.CourseID = course.ID
+	assignment.Name = "lab1"
+	assignment.Order = 1
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	return admin, student
+}
+
+// createStudent creates a user with the given remote ID and enrolls the user as student in the course.
+func createStudent(t *testing.T, db database.Database, course *pb.Course, remoteID uint64) *pb.User {
+	t.Helper()
+	student := &pb.User{}
+	if err := db.CreateUserFromRemoteIdentity(student, &pb.RemoteIdentity{Provider: "fake", RemoteID: remoteID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+		t.Fatal(err)
+	}
+	return student
+}
+
+// failingDB is a database that fails the n'th update of slip days.
+type failingDB struct {
+	database.Database
+	failAt *int
+}
+
+func (db failingDB) Transaction(fn func(tx database.Database) error) error {
+	return db.Database.Transaction(func(tx database.Database) error {
+		return fn(failingDB{Database: tx, failAt: db.failAt})
+	})
+}
+
+func (db failingDB) UpdateSlipDays(usedSlipDays []*pb.UsedSlipDays) error {
+	if *db.failAt--; *db.failAt == 0 {
+		return errors.New("injected failure")
+	}
+	return db.Database.UpdateSlipDays(usedSlipDays)
+}
+
+func TestUpdateSlipDaysRollback(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	course := &pb.Course{SlipDays: 5}
+	assignment := &pb.Assignment{
+		IsGroupLab: true,
+		ScoreLimit: 80,
+		Deadline:   time.Now().Add(-72 * time.Hour).Format(pb.TimeLayout),
+	}
+	admin, student := createCourseWithStudent(t, db, course, assignment)
+	users := []*pb.User{admin, student}
+	group := &pb.Group{Name: "group", CourseID: course.ID, Users: users}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{AssignmentID: assignment.ID, GroupID: group.ID, Score: 50}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+
+	// fail when updating the slip days of the second group member
+	failAt := 2
+	updateSlipDays(zap.NewNop().Sugar(), failingDB{Database: db, failAt: &failAt}, assignment, submission, time.Now().Format(pb.TimeLayout))
+
+	for _, user := range users {
+		enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, user.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if used := enrollment.GetUsedSlipDays(); len(used) != 0 {
+			t.Errorf("user %d: got used slip days %v after rollback, want none", user.ID, used)
+		}
+	}
+
+	// without failures, the slip days of both group members are updated
+	failAt = 0
+	updateSlipDays(zap.NewNop().Sugar(), failingDB{Database: db, failAt: &failAt}, assignment, submission, time.Now().Format(pb.TimeLayout))
+	for _, user := range users {
+		enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, user.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := enrollment.RemainingSlipDays(course); got != 2 {
+			t.Errorf("user %d: got %d remaining slip days, want 2", user.ID, got)
+		}
+	}
+}
+
+func TestUpdateSlipDaysWithExtension(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	now := time.Now()
+	course := &pb.Course{SlipDays: 5, GracePeriod: "30m"}
+	assignment := &pb.Assignment{
+		ScoreLimit: 80,
+		Deadline:   now.Add(-72 * time.Hour).Format(pb.TimeLayout),
+	}
+	_, student := createCourseWithStudent(t, db, course, assignment)
+	students := []*pb.User{student, createStudent(t, db, course, 3)}
+	// the first student's deadline is extended by two days
+	extension := &pb.DeadlineExtension{AssignmentID: assignment.ID, UserID: students[0].ID, Deadline: now.Add(-24 * time.Hour).Format(pb.TimeLayout)}
+	if err := db.CreateDeadlineExtension(extension); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, wantRemaining := range []int32{4, 2} {
+		submission := &pb.Submission{AssignmentID: assignment.ID, UserID: students[i].ID, Score: 50}
+		if err := db.CreateSubmission(submission); err != nil {
+			t.Fatal(err)
+		}
+		updateSlipDays(zap.NewNop().Sugar(), db, assignment, submission, now.Format(pb.TimeLayout))
+		enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, students[i].ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := enrollment.RemainingSlipDays(course); got != wantRemaining {
+			t.Errorf("user %d: got %d remaining slip days, want %d", students[i].ID, got, wantRemaining)
+		}
+	}
+}
+
+func TestRecordResultsWithLatePenalty(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	now := time.Now()
+	course := &pb.Course{SlipDays: 5, LatePolicy: pb.Course_STEP_PENALTY, LatePenalty: 25}
+	// the build is late by two started days
+	assignment := &pb.Assignment{
+		AutoApprove: true,
+		ScoreLimit:  50,
+		Deadline:    now.Add(-30 * time.Hour).Format(pb.TimeLayout),
+	}
+	_, student := createCourseWithStudent(t, db, course, assignment)
+
+	rData := &RunData{
+		Course:     course,
+		Assignment: assignment,
+		Repo:       &pb.Repository{UserID: student.ID},
+		CommitID:   "deadbeef",
+	}
+	result := &Result{
+		Scores:    []*score.Score{{TestName: "TestLab1", Score: 80, MaxScore: 100, Weight: 1}},
+		BuildInfo: &BuildInfo{BuildDate: now.Format(pb.TimeLayout), BuildLog: "ok"},
+	}
+	submission, err := recordResults(zap.NewNop().Sugar(), db, rData, result, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if submission.GetRawScore() != 80 || submission.GetScore() != 40 {
+		t.Errorf("recordResults() score = %d (raw %d), want 40 (raw 80)", submission.GetScore(), submission.GetRawScore())
+	}
+	// the raw score would have been auto approved
+	if submission.GetStatus() == pb.Submission_APPROVED {
+		t.Errorf("recordResults() status = %v, want not approved", submission.GetStatus())
+	}
+	if !strings.Contains(submission.GetBuildInfo(), "score reduced by 50% from 80 to 40") {
+		t.Errorf("recordResults() build info %q does not show the late penalty", submission.GetBuildInfo())
+	}
+
+	got, err := db.GetSubmission(&pb.Submission{ID: submission.GetID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.GetRawScore() != 80 || got.GetScore() != 40 {
+		t.Errorf("stored score = %d (raw %d), want 40 (raw 80)", got.GetScore(), got.GetRawScore())
+	}
+	attempts, err := db.GetSubmissionAttempts(submission.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(attempts) != 1 || attempts[0].GetRawScore() != 80 || attempts[0].GetScore() != 40 {
+		t.Errorf("stored attempts = %v, want one attempt with score 40 (raw 80)", attempts)
+	}
+	// late builds do not use slip days under a late penalty policy
+	enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, student.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := enrollment.RemainingSlipDays(course); got != 5 {
+		t.Errorf("got %d remaining slip days, want 5", got)
+	}
+}
+
+func TestRecordResultsWithHiddenTests(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	now := time.Now()
+	course := &pb.Course{LatePolicy: pb.Course_HARD_CUTOFF}
+	// the deadline build runs after the deadline
+	assignment := &pb.Assignment{
+		Deadline:         now.Add(-time.Hour).Format(pb.TimeLayout),
+		HiddenTests:      "hidden_test.go",
+		HiddenAtDeadline: true,
+	}
+	_, student := createCourseWithStudent(t, db, course, assignment)
+
+	rData := &RunData{
+		Course:     course,
+		Assignment: assignment,
+		Repo:       &pb.Repository{UserID: student.ID},
+		CommitID:   "deadbeef",
+		Deadline:   true,
+	}
+	result := &Result{
+		Scores:    []*score.Score{{TestName: "TestVisible", Score: 80, MaxScore: 100, Weight: 1}},
+		BuildInfo: &BuildInfo{BuildDate: now.Format(pb.TimeLayout), BuildLog: "visible"},
+	}
+	hidden := &Result{
+		Scores:    []*score.Score{{TestName: "TestHidden", Score: 50, MaxScore: 100, Weight: 1}},
+		BuildInfo: &BuildInfo{BuildDate: now.Format(pb.TimeLayout), BuildLog: "hidden"},
+	}
+	submission, err := recordResults(zap.NewNop().Sugar(), db, rData, result, hidden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// deadline builds are never late
+	if submission.GetScore() != 80 || submission.GetHiddenScore() != 50 {
+		t.Errorf("recordResults() score = %d (hidden %d), want 80 (hidden 50)", submission.GetScore(), submission.GetHiddenScore())
+	}
+
+	got, err := db.GetSubmission(&pb.Submission{ID: submission.GetID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.GetHiddenScore() != 50 || !strings.Contains(got.GetHiddenBuildInfo(), "hidden") || strings.Contains(got.GetBuildInfo(), "hidden") {
+		t.Errorf("stored submission = (hidden score %d, build info %q, hidden build info %q), want hidden results stored separately",
+			got.GetHiddenScore(), got.GetBuildInfo(), got.GetHiddenBuildInfo())
+	}
+	hiddenResults := make(map[string]bool)
+	for _, testResult := range got.GetTestResults() {
+		hiddenResults[testResult.GetTestName()] = testResult.GetHidden()
+	}
+	if len(hiddenResults) != 2 || hiddenResults["TestVisible"] || !hiddenResults["TestHidden"] {
+		t.Errorf("stored test results = %v, want TestVisible visible and TestHidden hidden", got.GetTestResults())
+	}
+	got.RemoveHiddenResults()
+	if got.GetHiddenScore() != 0 || got.GetHiddenBuildInfo() != "" || len(got.GetTestResults()) != 1 {
+		t.Errorf("RemoveHiddenResults() = %v, want no hidden results", got)
+	}
+}
@@ -3,13 +3,28 @@
 echo "\n\n==Start_CI==\n"
 git config --global url."https://{{ .CreatorAccessToken }}:x-oauth-basic@github.com/".insteadOf "https://github.com/"
 
+git clone {{ .GetURL }} /quickfeed/user
+{{- if .TestsSnapshot }}
+cp -a {{ .TestsSnapshot }}/. /quickfeed/test
+{{- else }}
+git clone {{ .TestURL }} /quickfeed/test
+{{- end }}
+{{- if .CacheDir }}
+
+# Download the packages required by the assignment into the course's package cache
+if [ -f /quickfeed/test/{{ .AssignmentName }}/requirements.txt ]; then
+  pip download -q --only-binary=:all: -d {{ .CacheDir }}/pip -r /quickfeed/test/{{ .AssignmentName }}/requirements.txt
+fi
+{{- end }}
+#test
+
+cp -r /quickfeed/user /quickfeed/test /root/
 cd /root/
 export PYTHONPATH="/root"
-
-git clone {{ .GetURL }} user
-git clone {{ .TestURL }} test
-
-history -c
+{{- if .CacheDir }}
+# install packages from the course's package cache before falling back to the package index
+export PIP_FIND_LINKS={{ .CacheDir }}/pip
+{{- end }}
 
 
 if [ -f "test/{{ .AssignmentName }}/setup.sh" ]; then
@@ -0,0 +1,66 @@
+package ci
+
+import (
+	"bytes"
+	"io"
+	"strings"
+)
+
+// lineWriter is a writer that passes all output on to out, and emits each
+// complete line of output until maxLogSize bytes have been emitted.
+// Lines longer than maxLogSize are emitted in pieces.
+type lineWriter struct {
+	out     io.Writer
+	emit    func(line string)
+	partial []byte
+	emitted int
+}
+
+// newLineWriter returns a writer that writes to out and emits lines to emit.
+// If emit is nil, the writer only writes to out.
+func newLineWriter(out io.Writer, emit func(line string)) *lineWriter {
+	return &lineWriter{out: out, emit: emit}
+}
+
+// Write implements io.Writer.
+func (w *lineWriter) Write(p []byte) (int, error) {
+	n, err := w.out.Write(p)
+	if w.emit == nil || w.emitted > maxLogSize {
+		return n, err
+	}
+	w.partial = append(w.partial, p[:n]...)
+	for {
+		i := bytes.IndexByte(w.partial, '\n')
+		if i < 0 {
+			break
+		}
+		w.line(string(w.partial[:i]))
+		w.partial = w.partial[i+1:]
+	}
+	if len(w.partial) > maxLogSize {
+		w.line(string(w.partial))
+		w.partial = nil
+	}
+	return n, err
+}
+
+// Flush emits the last line of output, if it is not terminated by a newline.
+func (w *lineWriter) Flush() {
+	if len(w.partial) > 0 {
+		w.line(string(w.partial))
+		w.partial = nil
+	}
+}
+
+// line emits the given line, unless the maximum log size has been reached.
+func (w *lineWriter) line(line string) {
+	if w.emitted > maxLogSize {
+		return
+	}
+	w.emitted += len(line) + 1
+	if w.emitted > maxLogSize {
+		w.emit(strings.TrimSpace(truncateMsg))
+		return
+	}
+	w.emit(line)
+}
@@ -0,0 +1,77 @@
+package ci
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLineWriter(t *testing.T) {
+	var out bytes.Buffer
+	var lines []string
+	w := newLineWriter(&out, func(line string) {
+		lines = append(lines, line)
+	})
+	for _, s := range []string{"hello ", "world\nsecond", " line\n\nlast"} {
+		if _, err := w.Write([]byte(s)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the last line is only emitted when flushed
+	if diff := cmp.Diff([]string{"hello world", "second line", ""}, lines); diff != "" {
+		t.Errorf("lines mismatch (-want +got):\n%s", diff)
+	}
+	w.Flush()
+	if diff := cmp.Diff([]string{"hello world", "second line", "", "last"}, lines); diff != "" {
+		t.Errorf("lines mismatch (-want +got):\n%s", diff)
+	}
+	if out.String() != "hello world\nsecond line\n\nlast" {
+		t.Errorf("out = %q, want all output", out.String())
+	}
+}
+
+func TestLineWriterMaxLogSize(t *testing.T) {
+	var out bytes.Buffer
+	var lines []string
+	w := newLineWriter(&out, func(line string) {
+		lines = append(lines, line)
+	})
+	line := strings.Repeat("x", 99) + "\n"
+	numLines := 2 * maxLogSize / 100
+	for i := 0; i < numLines; i++ {
+		if _, err := w.Write([]byte(line)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	w.Flush()
+	// the emitted lines are capped, followed by the truncate message, but the output is not
+	if got, want := len(lines), maxLogSize/100+1; got != want {
+		t.Errorf("got %d lines, want %d", got, want)
+	}
+	if last := lines[len(lines)-1]; last != strings.TrimSpace(truncateMsg) {
+		t.Errorf("last line = %q, want %q", last, strings.TrimSpace(truncateMsg))
+	}
+	if out.Len() != numLines*len(line) {
+		t.Errorf("out has %d bytes, want %d", out.Len(), numLines*len(line))
+	}
+
+	// a line longer than the maximum log size is emitted in pieces
+	lines = nil
+	w = newLineWriter(&out, func(line string) {
+		lines = append(lines, line)
+	})
+	if _, err := w.Write([]byte(strings.Repeat("y", maxLogSize/2+1))); err != nil {
+		t.Fatal(err)
+	}
+	if len(lines) != 0 {
+		t.Errorf("got %d lines, want 0", len(lines))
+	}
+	if _, err := w.Write([]byte(strings.Repeat("y", maxLogSize/2+1))); err != nil {
+		t.Fatal(err)
+	}
+	if len(lines) != 1 || lines[0] != strings.TrimSpace(truncateMsg) {
+		t.Errorf("got lines %.20q, want only the truncate message", lines)
+	}
+}
@@ -0,0 +1,90 @@
+package ci
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// maxFailureOutput is the maximum length of the failure output stored for a test.
+const maxFailureOutput = 4096
+
+// testEndPattern matches the line printed by go test when a test ends, such as:
+// --- FAIL: TestFib (0.01s)
+var testEndPattern = regexp.MustCompile(`^--- (PASS|FAIL|SKIP): (\S+) \(([0-9.]+)s\)$`)
+
+// testRun holds the duration and failure output of a test found in the go test output.
+type testRun struct {
+	duration int64 // in milliseconds
+	output   []string
+}
+
+// parseTestRuns returns the duration and failure output of the tests found in the
+// given lines of go test output, keyed by test name. The output of a test is the
+// lines printed after its === RUN or === CONT line, or after its --- FAIL line
+// if the output is not verbose. The output of tests that pass is not kept.
+func parseTestRuns(lines []string) map[string]*testRun {
+	runs := make(map[string]*testRun)
+	run := func(name string) *testRun {
+		if _, ok := runs[name]; !ok {
+			runs[name] = &testRun{}
+		}
+		return runs[name]
+	}
+	var current *testRun
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(trimmed, "=== RUN "), strings.HasPrefix(trimmed, "=== CONT "):
+			current = run(strings.Fields(trimmed)[2])
+		case strings.HasPrefix(trimmed, "=== "):
+			current = nil
+		case testEndPattern.MatchString(trimmed):
+			m := testEndPattern.FindStringSubmatch(trimmed)
+			current = run(m[2])
+			seconds, _ := strconv.ParseFloat(m[3], 64)
+			current.duration = int64(seconds * 1000)
+			if m[1] != "FAIL" {
+				current.output = nil
+				current = nil
+			}
+		case trimmed == "PASS" || trimmed == "FAIL" || strings.HasPrefix(trimmed, "ok ") || strings.HasPrefix(trimmed, "FAIL\t"):
+			current = nil
+		case current != nil:
+			current.output = append(current.output, strings.TrimPrefix(line, "    "))
+		}
+	}
+	return runs
+}
+
+// TestResults returns the results of the tests of this execution result,
+// with the duration and failure output found in the build log.
+func (r Result) TestResults() []*pb.TestResult {
+	results := make([]*pb.TestResult, 0, len(r.Scores))
+	for _, sc := range r.Scores {
+		result := &pb.TestResult{
+			TestName: sc.TestName,
+			Score:    int32(sc.Score),
+			MaxScore: int32(sc.MaxScore),
+			Weight:   int32(sc.Weight),
+		}
+		if run, ok := r.testRuns[sc.TestName]; ok {
+			result.Duration = run.duration
+			if sc.Score < sc.MaxScore {
+				result.FailureOutput = truncateOutput(strings.Join(run.output, "\n"), maxFailureOutput)
+			}
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// truncateOutput returns the first max bytes of the given output.
+func truncateOutput(output string, max int) string {
+	if len(output) <= max {
+		return output
+	}
+	return output[:max] + strings.TrimRight(truncateMsg, "\n")
+}
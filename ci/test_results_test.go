@@ -0,0 +1,96 @@
+package ci
+
+import (
+	"strings"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"go.uber.org/zap"
+)
+
+func TestTestResults(t *testing.T) {
+	const secret = "59fd5fe1c4f741604c1beeab875b9c789d2a7c73"
+	tests := []struct {
+		name string
+		out  string
+		want []*pb.TestResult
+	}{
+		{
+			name: "verbose",
+			out: `=== RUN   TestFib
+    fib_test.go:12: Fib(3) = 1, want 2
+--- FAIL: TestFib (0.12s)
+=== RUN   TestSum
+    sum_test.go:8: sum computed
+--- PASS: TestSum (0.00s)
+{"Secret":"` + secret + `","TestName":"TestFib","Score":5,"MaxScore":10,"Weight":1}
+{"Secret":"` + secret + `","TestName":"TestSum","Score":10,"MaxScore":10,"Weight":1}
+FAIL
+exit status 1
+FAIL	example.com/lab1	0.124s
+`,
+			want: []*pb.TestResult{
+				{TestName: "TestFib", Score: 5, MaxScore: 10, Weight: 1, Duration: 120, FailureOutput: "fib_test.go:12: Fib(3) = 1, want 2"},
+				{TestName: "TestSum", Score: 10, MaxScore: 10, Weight: 1},
+			},
+		},
+		{
+			name: "non-verbose",
+			out: `--- FAIL: TestFib (1.50s)
+    fib_test.go:12: Fib(3) = 1, want 2
+    fib_test.go:12: Fib(4) = 1, want 3
+{"Secret":"` + secret + `","TestName":"TestFib","Score":0,"MaxScore":10,"Weight":1}
+FAIL
+`,
+			want: []*pb.TestResult{
+				{TestName: "TestFib", Score: 0, MaxScore: 10, Weight: 1, Duration: 1500, FailureOutput: "fib_test.go:12: Fib(3) = 1, want 2\nfib_test.go:12: Fib(4) = 1, want 3"},
+			},
+		},
+		{
+			name: "subtests",
+			out: `=== RUN   TestFib
+=== RUN   TestFib/small
+=== RUN   TestFib/large
+    fib_test.go:20: Fib(40) timed out
+--- FAIL: TestFib (0.30s)
+    --- PASS: TestFib/small (0.00s)
+    --- FAIL: TestFib/large (0.30s)
+{"Secret":"` + secret + `","TestName":"TestFib/large","Score":0,"MaxScore":1,"Weight":1}
+`,
+			want: []*pb.TestResult{
+				{TestName: "TestFib/large", Score: 0, MaxScore: 1, Weight: 1, Duration: 300, FailureOutput: "fib_test.go:20: Fib(40) timed out"},
+			},
+		},
+		{
+			name: "no test output",
+			out:  `{"Secret":"` + secret + `","TestName":"Gradle","Score":50,"MaxScore":100,"Weight":1}`,
+			want: []*pb.TestResult{
+				{TestName: "Gradle", Score: 50, MaxScore: 100, Weight: 1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := ExtractResult(zap.NewNop().Sugar(), tt.out, secret, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := res.TestResults()
+			if diff := cmp.Diff(tt.want, got, cmpopts.IgnoreUnexported(pb.TestResult{})); diff != "" {
+				t.Errorf("TestResults() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTruncateOutput(t *testing.T) {
+	if got := truncateOutput("short", 10); got != "short" {
+		t.Errorf("truncateOutput(short, 10) = %q, want %q", got, "short")
+	}
+	want := "too" + strings.TrimRight(truncateMsg, "\n")
+	if got := truncateOutput("too long", 3); got != want {
+		t.Errorf("truncateOutput(too long, 3) = %q, want %q", got, want)
+	}
+}
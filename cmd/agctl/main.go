@@ -1,18 +1,27 @@
 package main
 
 import (
+	"fmt"
 	"log"
 	"os"
 	"path/filepath"
+	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/slipdays"
 	"github.com/urfave/cli"
 	"go.uber.org/zap"
 )
 
 // Example usage (to set admin user to the first user registered):
 // agctl set admin -id 1
+//
+// Example usage (to apply pending database migrations):
+// agctl -database qf.db db migrate
+//
+// Example usage (to show the slip days that change if course 1 had 7 slip days):
+// agctl -database qf.db slipdays recompute -course 1 -slip-days 7
 
 func main() {
 	var db database.GormDB
@@ -23,7 +32,7 @@ func main() {
 	app.Flags = []cli.Flag{
 		cli.StringFlag{
 			Name:  "database",
-			Usage: "Path to the autograder database",
+			Usage: "Path or data source name of the autograder database",
 			Value: tempFile("ag.db"),
 		},
 	}
@@ -60,6 +69,117 @@ func main() {
 				},
 			},
 		},
+		{
+			Name:  "db",
+			Usage: "Database schema migration commands.",
+			Subcommands: cli.Commands{
+				{
+					Name:  "migrate",
+					Usage: "Apply pending migrations.",
+					Flags: []cli.Flag{
+						cli.UintFlag{
+							Name:  "version",
+							Usage: "Migrate up to and including this version (default: latest).",
+						},
+					},
+					Action: func(c *cli.Context) error {
+						migrator, err := database.NewMigrator(&db, database.Migrations)
+						if err != nil {
+							return err
+						}
+						applied, err := migrator.Migrate(c.Uint("version"))
+						for _, m := range applied {
+							fmt.Printf("Applied migration %d: %s\n", m.Version, m.Description)
+						}
+						return err
+					},
+				},
+				{
+					Name:  "status",
+					Usage: "Show the status of all migrations.",
+					Action: func(c *cli.Context) error {
+						migrator, err := database.NewMigrator(&db, database.Migrations)
+						if err != nil {
+							return err
+						}
+						status, err := migrator.Status()
+						if err != nil {
+							return err
+						}
+						for _, s := range status {
+							appliedAt := "pending"
+							if s.Applied {
+								appliedAt = s.AppliedAt.Format(time.RFC3339)
+							}
+							fmt.Printf("%4d  %-25s  %s\n", s.Version, appliedAt, s.Description)
+						}
+						return nil
+					},
+				},
+				{
+					Name:  "rollback",
+					Usage: "Revert the most recently applied migrations.",
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  "steps",
+							Usage: "Number of migrations to revert.",
+							Value: 1,
+						},
+					},
+					Action: func(c *cli.Context) error {
+						migrator, err := database.NewMigrator(&db, database.Migrations)
+						if err != nil {
+							return err
+						}
+						reverted, err := migrator.Rollback(c.Int("steps"))
+						for _, m := range reverted {
+							fmt.Printf("Reverted migration %d: %s\n", m.Version, m.Description)
+						}
+						return err
+					},
+				},
+			},
+		},
+		{
+			Name:  "slipdays",
+			Usage: "Slip day commands.",
+			Subcommands: cli.Commands{
+				{
+					Name:  "recompute",
+					Usage: "Recompute the used slip days of a course's students against the current deadlines, and show the changes.",
+					Flags: []cli.Flag{
+						cli.Uint64Flag{
+							Name:  "course",
+							Usage: "Course id.",
+						},
+						cli.UintFlag{
+							Name:  "slip-days",
+							Usage: "Recompute with this number of slip days for the course (default: unchanged).",
+						},
+						cli.BoolFlag{
+							Name:  "apply",
+							Usage: "Save the changes; without this flag, the changes are only shown.",
+						},
+					},
+					Action: func(c *cli.Context) error {
+						if !c.IsSet("course") {
+							return cli.NewExitError("missing course id", 1)
+						}
+						recomputation, err := slipdays.Recompute(&db, &pb.SlipDaysRequest{
+							CourseID:       c.Uint64("course"),
+							ChangeSlipDays: c.IsSet("slip-days"),
+							SlipDays:       uint32(c.Uint("slip-days")),
+							Apply:          c.Bool("apply"),
+						})
+						if err != nil {
+							return err
+						}
+						printSlipDays(recomputation)
+						return nil
+					},
+				},
+			},
+		},
 	}
 
 	if err := app.Run(os.Args); err != nil {
@@ -69,7 +189,8 @@ func main() {
 
 func before(db *database.GormDB) cli.BeforeFunc {
 	return func(c *cli.Context) error {
-		tdb, err := database.NewGormDB(c.String("database"), zap.NewNop())
+		// migrations are applied explicitly with the db commands
+		tdb, err := database.OpenGormDB(c.String("database"), zap.NewNop())
 		if err != nil {
 			return err
 		}
@@ -84,6 +205,30 @@ func after(db *database.GormDB) cli.AfterFunc {
 	}
 }
 
+// printSlipDays prints the changes of the used and remaining slip days of each student.
+func printSlipDays(recomputation *pb.SlipDaysRecomputation) {
+	if recomputation.GetOldSlipDays() != recomputation.GetNewSlipDays() {
+		fmt.Printf("Course slip days: %d -> %d\n", recomputation.GetOldSlipDays(), recomputation.GetNewSlipDays())
+	}
+	for _, change := range recomputation.GetChanges() {
+		fmt.Printf("%-20s remaining %3d -> %3d\n", change.GetLogin(), change.GetOldRemaining(), change.GetNewRemaining())
+		for _, assignment := range change.GetAssignments() {
+			fmt.Printf("  assignment %-4d used %3d -> %3d\n", assignment.GetAssignmentID(), assignment.GetOldUsedSlipDays(), assignment.GetNewUsedSlipDays())
+		}
+		for _, assignmentID := range change.GetKeptAssignmentIDs() {
+			fmt.Printf("  assignment %-4d kept; the builds could not be replayed\n", assignmentID)
+		}
+	}
+	switch {
+	case len(recomputation.GetChanges()) == 0:
+		fmt.Println("No slip days change.")
+	case recomputation.GetApplied():
+		fmt.Printf("Saved the slip days of %d students.\n", len(recomputation.GetChanges()))
+	default:
+		fmt.Println("Dry run; use -apply to save the changes.")
+	}
+}
+
 func tempFile(name string) string {
 	return filepath.Join(os.TempDir(), name)
 }
@@ -2,8 +2,8 @@ package main
 
 import (
 	"context"
-	"flag"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
 	"strings"
@@ -11,144 +11,280 @@ import (
 
 	"github.com/360EntSecGroup-Skylar/excelize"
 	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/gradebook"
+	"github.com/urfave/cli"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
 
-const (
-	srcFile      = "dat320-original.xlsx"
-	approvedFile = "dat320-approve-list.xlsx"
-	sheetName    = "DAT320 Operativsystemer og syst"
-	pass         = "Godkjent"
-	fail         = "Ikke godkjent"
-)
-
-var ignoredStudents = map[string]bool{
-	"Hein Meling Student":      true,
-	"Eivind Stavnes (student)": true,
-	"John Ingve Olsen Test":    true,
-	"Hein Meling Stud5":        true,
-	"Hans Erik Frøyland":       true,
-}
+// Example usage (to export the gradebook of course 1 in Canvas format):
+// QUICKFEED_USER=1 qctrl -course 1 export -format canvas -min-approved 5
+//
+// Example usage (to fill in the approval column of a student list exported from FS):
+// QUICKFEED_USER=1 qctrl -course 1 approve-list -src original.xlsx -dst approved.xlsx -sheet "DAT320"
 
 func main() {
-	var (
-		passLimit  = flag.Int("limit", 5, "number of assignments required to pass")
-		ignorePass = flag.Bool("ignore", false, "ignore assignments that pass; only insert failed")
-	)
-	flag.Parse()
+	var client pb.AutograderServiceClient
+	var conn *grpc.ClientConn
 
-	studentMap := loadApproveSheet(srcFile, sheetName)
-	currentUserID := os.Getenv("QUICKFEED_USER")
-	if currentUserID == "" {
-		log.Fatal("Requires a 'QUICKFEED_USER' environmental variable with a valid ID of a registered user")
+	app := cli.NewApp()
+	app.Name = "qctrl"
+	app.Usage = "CLI tool for exporting gradebooks from a running QuickFeed server."
+	app.Flags = []cli.Flag{
+		cli.StringFlag{
+			Name:  "server",
+			Usage: "Address of the QuickFeed gRPC server",
+			Value: ":9090",
+		},
+		cli.StringFlag{
+			Name:   "user",
+			Usage:  "ID of the registered teacher making the requests",
+			EnvVar: "QUICKFEED_USER",
+		},
+		cli.Uint64Flag{
+			Name:  "course",
+			Usage: "Course ID",
+		},
 	}
-	convertedID := strings.TrimSpace(currentUserID)
-	requestMetadata := metadata.New(map[string]string{"user": convertedID})
-	reqCtx := metadata.NewOutgoingContext(context.Background(), requestMetadata)
-
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-	conn, err := grpc.DialContext(ctx, ":9090",
-		grpc.WithInsecure(),
-		grpc.WithBlock(),
-		grpc.WithDefaultCallOptions(
-			grpc.MaxCallRecvMsgSize(1024*1024*20),
-			grpc.MaxCallSendMsgSize(1024*1024*20),
-		),
-	)
-	if err != nil {
-		log.Fatalf("Connection failed, make sure server is running on :9090: %v", err)
+	ruleFlags := []cli.Flag{
+		cli.IntFlag{
+			Name:  "min-approved",
+			Usage: "Number of approved assignments required to pass",
+		},
+		cli.StringSliceFlag{
+			Name:  "required",
+			Usage: "Name of an assignment that must be approved to pass (may be repeated)",
+		},
+		cli.StringSliceFlag{
+			Name:  "ignore",
+			Usage: "Login of a user to leave out of the gradebook, such as a test account (may be repeated)",
+		},
 	}
-	defer conn.Close()
-
-	client := pb.NewAutograderServiceClient(conn)
-
-	request := &pb.CourseUserRequest{
-		CourseCode: "DAT320",
-		CourseYear: 2020,
-		UserLogin:  "meling",
+	app.Before = before(&client, &conn)
+	app.After = after(&conn)
+	app.Commands = []cli.Command{
+		{
+			Name:  "export",
+			Usage: "Export the course gradebook.",
+			Flags: append([]cli.Flag{
+				cli.StringFlag{
+					Name:  "format",
+					Usage: "Gradebook format: csv, xlsx, canvas or moodle",
+					Value: "csv",
+				},
+				cli.BoolFlag{
+					Name:  "groups",
+					Usage: "Export the group gradebook instead of the student gradebook",
+				},
+				cli.StringFlag{
+					Name:  "output",
+					Usage: "Output file (default: the name suggested by the server)",
+				},
+			}, ruleFlags...),
+			Action: func(c *cli.Context) error {
+				format, ok := pb.GradebookRequest_Format_value[strings.ToUpper(c.String("format"))]
+				if !ok {
+					return fmt.Errorf("unknown gradebook format %q", c.String("format"))
+				}
+				ctx, cancel := requestContext(c)
+				defer cancel()
+				file, err := client.ExportGradebook(ctx, &pb.GradebookRequest{
+					CourseID:            c.GlobalUint64("course"),
+					Format:              pb.GradebookRequest_Format(format),
+					Groups:              c.Bool("groups"),
+					MinApproved:         uint32(c.Int("min-approved")),
+					RequiredAssignments: c.StringSlice("required"),
+					IgnoredLogins:       c.StringSlice("ignore"),
+				})
+				if err != nil {
+					return err
+				}
+				output := c.String("output")
+				if output == "" {
+					output = file.GetName()
+				}
+				if err := ioutil.WriteFile(output, file.GetContent(), 0o600); err != nil {
+					return err
+				}
+				fmt.Printf("Wrote gradebook to %s\n", output)
+				return nil
+			},
+		},
+		{
+			Name:  "approve-list",
+			Usage: "Fill in the pass/fail column of a spreadsheet listing the students by name.",
+			Flags: append([]cli.Flag{
+				cli.StringFlag{
+					Name:  "src",
+					Usage: "Spreadsheet with the student names in the first column",
+				},
+				cli.StringFlag{
+					Name:  "dst",
+					Usage: "Output spreadsheet",
+				},
+				cli.StringFlag{
+					Name:  "sheet",
+					Usage: "Name of the sheet listing the students",
+				},
+				cli.StringFlag{
+					Name:  "column",
+					Usage: "Column to fill in",
+					Value: "B",
+				},
+				cli.StringFlag{
+					Name:  "pass",
+					Usage: "Value of students that pass",
+					Value: "Godkjent",
+				},
+				cli.StringFlag{
+					Name:  "fail",
+					Usage: "Value of students that fail",
+					Value: "Ikke godkjent",
+				},
+				cli.BoolFlag{
+					Name:  "only-failed",
+					Usage: "Only fill in students that fail",
+				},
+			}, ruleFlags...),
+			Action: func(c *cli.Context) error {
+				for _, name := range []string{"src", "dst", "sheet"} {
+					if c.String(name) == "" {
+						return fmt.Errorf("missing required flag -%s", name)
+					}
+				}
+				ctx, cancel := requestContext(c)
+				defer cancel()
+				courseSubmissions, err := client.GetSubmissionsByCourse(ctx, &pb.SubmissionsForCourseRequest{
+					CourseID: c.GlobalUint64("course"),
+					Type:     pb.SubmissionsForCourseRequest_ALL,
+				})
+				if err != nil {
+					return err
+				}
+				rules := gradebook.Rules{MinApproved: c.Int("min-approved"), Required: c.StringSlice("required")}
+				gb, err := gradebook.New(courseSubmissions, false, rules, c.StringSlice("ignore")...)
+				if err != nil {
+					return err
+				}
+				return approveList(c, gb)
+			},
+		},
 	}
-	userInfo, err := client.GetUserByCourse(reqCtx, request)
-	if err != nil {
+
+	if err := app.Run(os.Args); err != nil {
 		log.Fatal(err)
 	}
+}
 
-	courses, err := client.GetCoursesByUser(reqCtx, &pb.EnrollmentStatusRequest{UserID: userInfo.GetID()})
-	if err != nil {
-		log.Fatal(err)
+func before(client *pb.AutograderServiceClient, conn **grpc.ClientConn) cli.BeforeFunc {
+	return func(c *cli.Context) error {
+		if c.NArg() == 0 || c.Args().First() == "help" {
+			return nil
+		}
+		if strings.TrimSpace(c.String("user")) == "" {
+			return fmt.Errorf("requires the -user flag or a QUICKFEED_USER environment variable with the ID of a registered user")
+		}
+		if c.Uint64("course") == 0 {
+			return fmt.Errorf("requires the -course flag")
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		cc, err := grpc.DialContext(ctx, c.String("server"),
+			grpc.WithInsecure(),
+			grpc.WithBlock(),
+			grpc.WithDefaultCallOptions(
+				grpc.MaxCallRecvMsgSize(1024*1024*20),
+				grpc.MaxCallSendMsgSize(1024*1024*20),
+			),
+		)
+		if err != nil {
+			return fmt.Errorf("connection failed, make sure the server is running on %s: %w", c.String("server"), err)
+		}
+		*conn = cc
+		*client = pb.NewAutograderServiceClient(cc)
+		return nil
 	}
-	var courseID uint64
-	for _, c := range courses.GetCourses() {
-		courseID = c.GetID()
+}
+
+func after(conn **grpc.ClientConn) cli.AfterFunc {
+	return func(c *cli.Context) error {
+		if *conn != nil {
+			return (*conn).Close()
+		}
+		return nil
 	}
+}
 
-	gotSubmissions, err := client.GetSubmissionsByCourse(
-		reqCtx,
-		&pb.SubmissionsForCourseRequest{CourseID: courseID, Type: pb.SubmissionsForCourseRequest_ALL},
-	)
+// requestContext returns a context carrying the user ID of the requests.
+func requestContext(c *cli.Context) (context.Context, context.CancelFunc) {
+	md := metadata.New(map[string]string{"user": strings.TrimSpace(c.GlobalString("user"))})
+	return context.WithTimeout(metadata.NewOutgoingContext(context.Background(), md), time.Minute)
+}
+
+// approveList fills in the pass or fail value of each student listed in the src spreadsheet,
+// and saves the result as the dst spreadsheet. Students listed in the spreadsheet without
+// a QuickFeed account fail.
+func approveList(c *cli.Context, gb *gradebook.Gradebook) error {
+	sheet, column := c.String("sheet"), c.String("column")
+	f, err := excelize.OpenFile(c.String("src"))
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
+	studentMap := make(map[string]int)
+	for i, row := range f.GetRows(sheet) {
+		if i > 0 && len(row) > 0 && row[0] != "" {
+			studentMap[row[0]] = i + 1
+		}
+	}
+
 	approvedMap := make(map[string]string)
 	agStudents := make(map[string]int)
 	numPass, numIgnored := 0, 0
-	for _, el := range gotSubmissions.GetLinks() {
-		if el.Enrollment.User.IsAdmin || el.Enrollment.IsTeacher() {
-			// log.Printf("%s: admin: %t, teacher: %t\n", el.Enrollment.GetUser().GetName(), el.Enrollment.User.IsAdmin, el.Enrollment.IsTeacher())
-			continue
-		}
-		if ignoredStudents[el.Enrollment.User.Name] {
-			continue
-		}
-		approved := make([]bool, len(el.Submissions))
-		for i, s := range el.Submissions {
-			approved[i] = s.GetSubmission().IsApproved()
-		}
-		agStudents[el.Enrollment.User.Name] = 1
-
-		rowNum, err := lookup(el.Enrollment.User.Name, studentMap)
+	for _, row := range gb.Rows {
+		agStudents[row.Name] = 1
+		rowNum, err := lookup(row.Name, studentMap)
 		if err != nil {
-			fmt.Printf("%v in FS database; but has QuickFeed account\n", err)
+			fmt.Printf("%v in spreadsheet; but has QuickFeed account\n", err)
 			continue
 		}
-		approvedValue := fail
-		if isApproved(*passLimit, approved) {
-			approvedValue = pass
+		approvedValue := c.String("fail")
+		if row.Passed {
+			approvedValue = c.String("pass")
 			numPass++
-			if *ignorePass {
+			if c.Bool("only-failed") {
 				numIgnored++
 				continue
 			}
 		}
-		cell := fmt.Sprintf("B%d", rowNum)
-		approvedMap[cell] = approvedValue
+		approvedMap[fmt.Sprintf("%s%d", column, rowNum)] = approvedValue
 	}
 
 	// find students signed up to course, but not found in QuickFeed
 	for student, rowNum := range studentMap {
-		_, err := lookup(student, agStudents)
-		if err != nil {
+		if _, err := lookup(student, agStudents); err != nil {
 			fmt.Printf("%v in QuickFeed database; is signed up at row %d\n", err, rowNum)
-			cell := fmt.Sprintf("B%d", rowNum)
-			approvedMap[cell] = fail
+			approvedMap[fmt.Sprintf("%s%d", column, rowNum)] = c.String("fail")
 		}
 	}
-	fmt.Printf("Total: %d, passed: %d, fail: %d\n", len(approvedMap)+numIgnored, numPass, len(approvedMap)+numIgnored-numPass)
-	saveApproveSheet(srcFile, approvedFile, sheetName, approvedMap)
+	total := len(approvedMap) + numIgnored
+	fmt.Printf("Total: %d, passed: %d, fail: %d\n", total, numPass, total-numPass)
+
+	for cell, approved := range approvedMap {
+		f.SetCellValue(sheet, cell, approved)
+	}
+	return f.SaveAs(c.String("dst"))
 }
 
 func lookup(name string, studentMap map[string]int) (int, error) {
 	if rowNum, ok := studentMap[name]; ok {
 		return rowNum, nil
-	} else {
-		return partialMatch(name, studentMap)
 	}
+	return partialMatch(name, studentMap)
 }
 
 func partialMatch(name string, studentMap map[string]int) (int, error) {
 	nameParts := strings.Split(strings.ToLower(name), " ")
-	possibleNames := make(map[string][]string)
+	var possibleNames []string
 	for expectedName := range studentMap {
 		expectedNameParts := strings.Split(strings.ToLower(expectedName), " ")
 		matchCount := 0
@@ -161,51 +297,14 @@ func partialMatch(name string, studentMap map[string]int) (int, error) {
 		}
 		if matchCount > 1 {
 			// if at least two parts of the names match
-			possibleNames[name] = append(possibleNames[name], expectedName)
-			// fmt.Printf("Probable match found: %s = %s\n", name, expectedName)
+			possibleNames = append(possibleNames, expectedName)
 		}
 	}
 	switch {
-	case len(possibleNames[name]) == 0:
+	case len(possibleNames) == 0:
 		return 0, fmt.Errorf("Not found: %s", name)
-	case len(possibleNames[name]) > 1:
-		return 0, fmt.Errorf("Multiple possibilities found for: %s --> %v", name, possibleNames[name])
-	}
-	return studentMap[possibleNames[name][0]], nil
-}
-
-func isApproved(requirements int, approved []bool) bool {
-	for _, a := range approved {
-		if a {
-			requirements--
-		}
-	}
-	return requirements <= 0
-}
-
-func loadApproveSheet(file, sheetName string) map[string]int {
-	f, err := excelize.OpenFile(file)
-	if err != nil {
-		log.Fatal(err)
-	}
-	approveMap := make(map[string]int)
-	for i, row := range f.GetRows(sheetName) {
-		if i > 0 && row[0] != "" {
-			approveMap[row[0]] = i + 1
-		}
-	}
-	return approveMap
-}
-
-func saveApproveSheet(srcFile, dstFile, sheetName string, approveMap map[string]string) {
-	f, err := excelize.OpenFile(srcFile)
-	if err != nil {
-		log.Fatal(err)
-	}
-	for cell, approved := range approveMap {
-		f.SetCellValue(sheetName, cell, approved)
-	}
-	if err := f.SaveAs(dstFile); err != nil {
-		log.Fatal(err)
+	case len(possibleNames) > 1:
+		return 0, fmt.Errorf("Multiple possibilities found for: %s --> %v", name, possibleNames)
 	}
+	return studentMap[possibleNames[0]], nil
 }
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"runtime"
+	"syscall"
+
+	"github.com/autograde/quickfeed/agent"
+	"github.com/autograde/quickfeed/ci"
+	logq "github.com/autograde/quickfeed/log"
+	"github.com/urfave/cli"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// Example usage (to run up to 4 builds at a time in docker containers with the quickfeed:go image):
+// QUICKFEED_AGENT_TOKEN=secret quickfeed-agent -server uis.itest.run:9091 -capacity 4 -image quickfeed:go
+//
+// Example usage (to run builds locally in new namespaces, on a machine without docker):
+// QUICKFEED_AGENT_TOKEN=secret quickfeed-agent -server uis.itest.run:9091 -local -unshare
+
+func main() {
+	app := cli.NewApp()
+	app.Name = "quickfeed-agent"
+	app.Usage = "Build agent that runs the builds of a QuickFeed server."
+	hostname, _ := os.Hostname()
+	app.Flags = []cli.Flag{
+		cli.StringFlag{
+			Name:  "server",
+			Usage: "Address of the QuickFeed server's agent service",
+			Value: ":9091",
+		},
+		cli.StringFlag{
+			Name:   "token",
+			Usage:  "Agent token of the QuickFeed server",
+			EnvVar: "QUICKFEED_AGENT_TOKEN",
+		},
+		cli.StringFlag{
+			Name:  "name",
+			Usage: "Name of the agent, shown in the server's logs",
+			Value: hostname,
+		},
+		cli.IntFlag{
+			Name:  "capacity",
+			Usage: "Maximum number of builds to run at the same time",
+			Value: runtime.NumCPU(),
+		},
+		cli.StringSliceFlag{
+			Name:  "image",
+			Usage: "Image of the builds to run (may be repeated); all images if not set",
+		},
+		cli.BoolFlag{
+			Name:  "local",
+			Usage: "Run builds locally instead of in docker containers, on machines without docker",
+		},
+		cli.BoolFlag{
+			Name:  "unshare",
+			Usage: "Run local builds in new linux namespaces with unshare",
+		},
+		cli.IntFlag{
+			Name:  "pool",
+			Usage: "Number of pre-warmed containers to keep per image (0 means none)",
+		},
+		cli.StringFlag{
+			Name:  "ca",
+			Usage: "Certificate file of the authority that signed the server's certificate; the system's authorities if not set",
+		},
+		cli.BoolFlag{
+			Name:  "insecure",
+			Usage: "Connect to the server without TLS, revealing the agent token to the network",
+		},
+	}
+	app.Action = run
+	if err := app.Run(os.Args); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(c *cli.Context) error {
+	if c.String("token") == "" {
+		return fmt.Errorf("missing agent token")
+	}
+	logger := logq.Zap(true)
+	defer logger.Sync()
+
+	var runner ci.Runner = &ci.Local{Unshare: c.Bool("unshare")}
+	if !c.Bool("local") {
+		docker, err := ci.NewDockerCI(logger, ci.DockerOptions{PoolSize: c.Int("pool")})
+		if err != nil {
+			return fmt.Errorf("failed to set up docker client: %w", err)
+		}
+		defer docker.Close()
+		runner = docker
+	}
+
+	transport := grpc.WithInsecure()
+	if !c.Bool("insecure") {
+		creds := credentials.NewTLS(nil)
+		if ca := c.String("ca"); ca != "" {
+			var err error
+			if creds, err = credentials.NewClientTLSFromFile(ca, ""); err != nil {
+				return err
+			}
+		}
+		transport = grpc.WithTransportCredentials(creds)
+	}
+	conn, err := grpc.Dial(c.String("server"), transport, grpc.WithPerRPCCredentials(agent.TokenCredentials(c.String("token"), c.Bool("insecure"))))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, stop := context.WithCancel(context.Background())
+	defer stop()
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-signals
+		stop()
+	}()
+
+	a := agent.NewAgent(logger.Sugar(), conn, runner, agent.AgentOptions{
+		Name:     c.String("name"),
+		Capacity: c.Int("capacity"),
+		Images:   c.StringSlice("image"),
+	})
+	if err := a.Run(ctx); err != context.Canceled {
+		return err
+	}
+	return nil
+}
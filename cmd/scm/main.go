@@ -61,7 +61,7 @@ func main() {
 		},
 		cli.StringFlag{
 			Name:  "database",
-			Usage: "Path to the autograder database",
+			Usage: "Path or data source name of the autograder database",
 			Value: tempFile("ag.db"),
 		},
 		cli.Uint64Flag{
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/autograde/quickfeed/kit/score"
+	"github.com/urfave/cli"
+)
+
+// Example usage (to check the score lines printed by the tests of a Python assignment):
+// python test/lab1/run_tests.py | scorecheck -secret my-secret
+//
+// Example usage (to check the score lines in a saved build log):
+// scorecheck -secret my-secret build.log
+
+func main() {
+	app := cli.NewApp()
+	app.Name = "scorecheck"
+	app.Usage = "Verify that the score lines of test output conform to the QuickFeed score line format."
+	app.ArgsUsage = "[file...]"
+	app.Flags = []cli.Flag{
+		cli.StringFlag{
+			Name:   "secret",
+			Usage:  "Session secret expected in the score lines",
+			EnvVar: "QUICKFEED_SESSION_SECRET",
+		},
+		cli.BoolFlag{
+			Name:  "quiet",
+			Usage: "Only print the score lines that do not conform to the format",
+		},
+	}
+	app.Action = run
+	if err := app.Run(os.Args); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(c *cli.Context) error {
+	if c.String("secret") == "" {
+		return errors.New("missing session secret")
+	}
+	var valid, invalid int
+	check := func(name string, r io.Reader) error {
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(nil, 1024*1024)
+		for n := 1; scanner.Scan(); n++ {
+			line := scanner.Text()
+			if !score.HasPrefix(line) {
+				continue
+			}
+			sc, err := score.Verify(line, c.String("secret"))
+			if err != nil {
+				invalid++
+				fmt.Printf("%s:%d: invalid score line: %v\n", name, n, err)
+				continue
+			}
+			valid++
+			if !c.Bool("quiet") {
+				fmt.Printf("%s:%d: %s: score %d/%d, weight %d\n", name, n, sc.TestName, sc.Score, sc.MaxScore, sc.Weight)
+			}
+		}
+		return scanner.Err()
+	}
+
+	if c.NArg() == 0 {
+		if err := check("stdin", os.Stdin); err != nil {
+			return err
+		}
+	}
+	for _, name := range c.Args() {
+		f, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		err = check(name, f)
+		f.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case invalid > 0:
+		return fmt.Errorf("%d of %d score lines do not conform to the format", invalid, valid+invalid)
+	case valid == 0:
+		return errors.New("no score lines found")
+	}
+	fmt.Printf("%d score lines conform to the format\n", valid)
+	return nil
+}
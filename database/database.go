@@ -1,6 +1,8 @@
 package database
 
 import (
+	"time"
+
 	pb "github.com/autograde/quickfeed/ag"
 )
 
@@ -46,6 +48,11 @@ type Database interface {
 	GetCoursesByUser(userID uint64, statuses ...pb.Enrollment_UserStatus) ([]*pb.Course, error)
 	// UpdateCourse updates course information.
 	UpdateCourse(*pb.Course) error
+	// UpdateCourseSlipDays updates the number of slip days of the given course.
+	UpdateCourseSlipDays(courseID uint64, slipDays uint32) error
+	// GetCourseStatistics returns the statistics of the students and assignments of the given course.
+	// Students are active if they have activity or built attempts on or after activeSince.
+	GetCourseStatistics(courseID uint64, activeSince time.Time) (*pb.CourseStatistics, error)
 
 	// CreateEnrollment creates a new pending enrollment.
 	CreateEnrollment(*pb.Enrollment) error
@@ -81,6 +88,24 @@ type Database interface {
 	GetAssignmentsByCourse(uint64, bool) ([]*pb.Assignment, error)
 	// UpdateAssignments updates the specified list of assignments.
 	UpdateAssignments([]*pb.Assignment) error
+	// CreateDeadlineExtension creates the given deadline extension, or replaces the existing
+	// extension for the same assignment and student or group.
+	CreateDeadlineExtension(*pb.DeadlineExtension) error
+	// GetDeadlineExtensions returns the deadline extensions of the assignments of the given course.
+	GetDeadlineExtensions(courseID uint64) ([]*pb.DeadlineExtension, error)
+	// DeleteDeadlineExtension removes the given deadline extension of an assignment of the given course.
+	DeleteDeadlineExtension(courseID, extensionID uint64) error
+	// CreateDeadlineSnapshot creates the given deadline snapshot, or replaces the existing snapshot
+	// for the same assignment and student or group. A frozen snapshot's commit becomes graded.
+	CreateDeadlineSnapshot(*pb.DeadlineSnapshot) error
+	// GetDeadlineSnapshots returns the deadline snapshots of the given assignment.
+	GetDeadlineSnapshots(assignmentID uint64) ([]*pb.DeadlineSnapshot, error)
+	// GetDeadlineSnapshot returns the deadline snapshot of the given assignment for the given student or group.
+	GetDeadlineSnapshot(assignmentID, userID, groupID uint64) (*pb.DeadlineSnapshot, error)
+	// DeleteDeadlineSnapshot removes the deadline snapshot with the given ID.
+	DeleteDeadlineSnapshot(snapshotID uint64) error
+	// ReleaseReviews releases the reviews of all submissions for the given assignment and records the release date.
+	ReleaseReviews(assignmentID uint64, releasedDate string) error
 	// CreateBenchmark creates a new grading benchmark.
 	CreateBenchmark(*pb.GradingBenchmark) error
 	// UpdateBenchmark updates the given benchmark.
@@ -94,9 +119,10 @@ type Database interface {
 	// DeleteCriterion deletes the given criterion.
 	DeleteCriterion(*pb.GradingCriterion) error
 
-	// CreateSubmission creates a new submission record or updates the most
-	// recent submission, as defined by the provided submissionQuery.
-	// The submissionQuery must always specify the assignment, and may specify the ID of
+	// CreateSubmission records the results of the given submission as a new attempt,
+	// and makes it the graded attempt of the submission record for the assignment and
+	// student or group. The submission record is created if it does not exist.
+	// The submission must always specify the assignment, and may specify the ID of
 	// either an individual student or a group, but not both.
 	CreateSubmission(*pb.Submission) error
 	// GetSubmission returns a single submission matching the given query.
@@ -107,10 +133,25 @@ type Database interface {
 	GetSubmissions(*pb.Submission) ([]*pb.Submission, error)
 	// GetAssignmentsWithSubmissions returns a list of assignments with the latest submissions for the given course.
 	GetAssignmentsWithSubmissions(courseID uint64, requestType pb.SubmissionsForCourseRequest_Type) ([]*pb.Assignment, error)
+	// GetCourseAssignmentsWithSubmissionsNoBuildInfo returns a list of assignments with the latest submissions
+	// for the given course, without the build info of the submissions.
+	GetCourseAssignmentsWithSubmissionsNoBuildInfo(courseID uint64, requestType pb.SubmissionsForCourseRequest_Type) ([]*pb.Assignment, error)
 	// UpdateSubmission updates the specified submission with approved or not approved.
 	UpdateSubmission(*pb.Submission) error
 	// UpdateSubmissions releases and/or approves all submissions with a certain score
 	UpdateSubmissions(uint64, *pb.Submission) error
+	// GetSubmissionAttempts returns the attempts of the given submission, without their build info.
+	GetSubmissionAttempts(submissionID uint64) ([]*pb.SubmissionAttempt, error)
+	// GetCourseSubmissionAttempts returns the attempts of the submissions for the assignments of the
+	// given course, in the order they were built, without their build info and score objects.
+	GetCourseSubmissionAttempts(courseID uint64) ([]*pb.SubmissionAttempt, error)
+	// GetSubmissionAttempt returns a single submission attempt matching the given query.
+	GetSubmissionAttempt(query *pb.SubmissionAttempt) (*pb.SubmissionAttempt, error)
+	// UpdateGradedAttempt makes the given attempt the graded attempt of the given submission.
+	UpdateGradedAttempt(submissionID, attemptID uint64) error
+	// GetTestStatistics returns the statistics of each test case of the given assignment,
+	// computed from the graded attempts, or from all attempts if allAttempts is true.
+	GetTestStatistics(assignmentID uint64, allAttempts bool) ([]*pb.TestCaseStatistics, error)
 	// CreateReview adds a new submission review.
 	CreateReview(*pb.Review) error
 	// UpdateReview updates the given review.
@@ -129,4 +170,32 @@ type Database interface {
 
 	// UpdateSlipDays updates used slipdays for the given course enrollment
 	UpdateSlipDays([]*pb.UsedSlipDays) error
+
+	// CreateBuildJob creates a new build job.
+	CreateBuildJob(*pb.BuildJob) error
+	// GetBuildJob returns the build job with the given ID.
+	GetBuildJob(uint64) (*pb.BuildJob, error)
+	// GetBuildJobs returns the build jobs for the given course (or all courses if 0)
+	// with one of the given statuses, in the order they were queued.
+	GetBuildJobs(courseID uint64, statuses ...pb.BuildJob_Status) ([]*pb.BuildJob, error)
+	// UpdateBuildJob updates the given build job.
+	UpdateBuildJob(*pb.BuildJob) error
+	// GetStartedBuildJobs returns the build jobs of the given assignment and repository (or all
+	// repositories if 0) started at or after the given date, in the order they were started.
+	GetStartedBuildJobs(assignmentID, repositoryID uint64, since string) ([]*pb.BuildJob, error)
+	// CreateBuildQuota creates the given build quota, or replaces the reset date of
+	// the existing quota for the same assignment and repository.
+	CreateBuildQuota(*pb.BuildQuota) error
+	// GetBuildQuotas returns the build quotas of the given assignment.
+	GetBuildQuotas(assignmentID uint64) ([]*pb.BuildQuota, error)
+
+	// UpdateSimilarityPairs replaces the similarity pairs of the given assignment.
+	UpdateSimilarityPairs(assignmentID uint64, pairs []*pb.SimilarityPair) error
+	// GetSimilarityPairs returns the similarity pairs of the given assignment, most similar first.
+	GetSimilarityPairs(assignmentID uint64) ([]*pb.SimilarityPair, error)
+
+	// Transaction runs fn in a transaction; fn must use only the given tx to access the database.
+	// The transaction is committed if fn returns nil, and rolled back if fn returns an error or panics.
+	// Transactions may be nested; a nested transaction that fails only rolls back its own changes.
+	Transaction(fn func(tx Database) error) error
 }
@@ -3,8 +3,12 @@ package database
 import (
 	pb "github.com/autograde/quickfeed/ag"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// orderColumn sorts assignments by their 'order' field, quoted by the database driver.
+var orderColumn = clause.OrderByColumn{Column: clause.Column{Name: "order"}}
+
 /// Assignments ///
 
 // CreateAssignment creates a new assignment record.
@@ -30,16 +34,32 @@ func (db *GormDB) CreateAssignment(assignment *pb.Assignment) error {
 			Order:    assignment.Order,
 		}).
 		Assign(map[string]interface{}{
-			"name":              assignment.Name,
-			"order":             assignment.Order,
-			"script_file":       assignment.ScriptFile,
-			"deadline":          assignment.Deadline,
-			"auto_approve":      assignment.AutoApprove,
-			"score_limit":       assignment.ScoreLimit,
-			"is_group_lab":      assignment.IsGroupLab,
-			"reviewers":         assignment.Reviewers,
-			"container_timeout": assignment.ContainerTimeout,
-			"skip_tests":        assignment.SkipTests,
+			"name":               assignment.Name,
+			"order":              assignment.Order,
+			"script_file":        assignment.ScriptFile,
+			"deadline":           assignment.Deadline,
+			"auto_approve":       assignment.AutoApprove,
+			"score_limit":        assignment.ScoreLimit,
+			"is_group_lab":       assignment.IsGroupLab,
+			"reviewers":          assignment.Reviewers,
+			"container_timeout":  assignment.ContainerTimeout,
+			"skip_tests":         assignment.SkipTests,
+			"late_policy":        assignment.LatePolicy,
+			"late_penalty":       assignment.LatePenalty,
+			"freeze_at_deadline": assignment.FreezeAtDeadline,
+			"final_build":        assignment.FinalBuild,
+			"release_date":       assignment.ReleaseDate,
+			"hidden_tests":       assignment.HiddenTests,
+			"hidden_at_deadline": assignment.HiddenAtDeadline,
+			"builds_per_hour":    assignment.BuildsPerHour,
+			"build_interval":     assignment.BuildInterval,
+			"memory_limit":       assignment.MemoryLimit,
+			"cpu_limit":          assignment.CpuLimit,
+			"pids_limit":         assignment.PidsLimit,
+			"disk_limit":         assignment.DiskLimit,
+			"network_disabled":   assignment.NetworkDisabled,
+			"read_only_root":     assignment.ReadOnlyRoot,
+			"pipeline":           assignment.Pipeline,
 		}).FirstOrCreate(assignment).Error
 }
 
@@ -87,25 +107,26 @@ func (db *GormDB) GetAssignmentsByCourse(courseID uint64, withGrading bool) ([]*
 
 // UpdateAssignments updates assignment information.
 func (db *GormDB) UpdateAssignments(assignments []*pb.Assignment) error {
-	// TODO(meling) Updating the database may need locking?? Or maybe rewrite as a single query or txn.
-	for _, v := range assignments {
-		// this will create or update an existing assignment
-		if err := db.CreateAssignment(v); err != nil {
-			return err
+	return db.Transaction(func(tx Database) error {
+		for _, v := range assignments {
+			// this will create or update an existing assignment
+			if err := tx.CreateAssignment(v); err != nil {
+				return err
+			}
 		}
-	}
-	return nil
+		return nil
+	})
 }
 
 // GetAssignmentsWithSubmissions returns all course assignments
 // of requested type with preloaded submissions.
 func (db *GormDB) GetAssignmentsWithSubmissions(courseID uint64, submissionType pb.SubmissionsForCourseRequest_Type) ([]*pb.Assignment, error) {
 	var assignments []*pb.Assignment
-	// the 'order' field of pb.Assignment must be in 'quotes' since otherwise it will be interpreted as SQL
+	// the 'order' field of pb.Assignment must be quoted since otherwise it will be interpreted as SQL
 	if err := db.conn.Preload("Submissions").
 		Preload("Submissions.Reviews").
 		Where(&pb.Assignment{CourseID: courseID}).
-		Order("'order'").
+		Order(orderColumn).
 		Find(&assignments).Error; err != nil {
 		return nil, err
 	}
@@ -126,10 +147,10 @@ func (db *GormDB) GetAssignmentsWithSubmissions(courseID uint64, submissionType
 // returns data required for results page (score and status)
 func (db *GormDB) GetCourseAssignmentsWithSubmissionsNoBuildInfo(courseID uint64, submissionType pb.SubmissionsForCourseRequest_Type) ([]*pb.Assignment, error) {
 	var assignments []*pb.Assignment
-	// the 'order' field of pb.Assignment must be in 'quotes' since otherwise it will be interpreted as SQL
+	// the 'order' field of pb.Assignment must be quoted since otherwise it will be interpreted as SQL
 	if err := db.conn.Preload("Submissions").
 		Where(&pb.Assignment{CourseID: courseID}).
-		Order("'order'").
+		Order(orderColumn).
 		Find(&assignments).Error; err != nil {
 		return nil, err
 	}
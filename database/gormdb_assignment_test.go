@@ -121,6 +121,8 @@ func TestUpdateAssignment(t *testing.T) {
 		a.Reviewers = 0
 		a.AutoApprove = !a.AutoApprove
 		a.IsGroupLab = !a.IsGroupLab
+		a.LatePolicy = pb.Course_LINEAR_PENALTY
+		a.LatePenalty = 10
 		wantAssignments[i] = (proto.Clone(assignments[i])).(*pb.Assignment)
 	}
 
@@ -190,3 +192,30 @@ func TestGetAssignmentsWithSubmissions(t *testing.T) {
 		t.Errorf("GetAssignmentsWithSubmissions() mismatch (-want +got):\n%s", diff)
 	}
 }
+
+func TestUpdateAssignmentsRollback(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	course := &pb.Course{}
+	admin := createFakeUser(t, db, 10)
+	if err := db.CreateCourse(admin.ID, course); err != nil {
+		t.Fatal(err)
+	}
+
+	assignments := []*pb.Assignment{
+		{CourseID: course.ID, Name: "lab1", Order: 1},
+		// missing order; fails halfway through the update
+		{CourseID: course.ID, Name: "lab2"},
+	}
+	if err := db.UpdateAssignments(assignments); err != gorm.ErrRecordNotFound {
+		t.Errorf("UpdateAssignments() = %v, want %v", err, gorm.ErrRecordNotFound)
+	}
+	gotAssignments, err := db.GetAssignmentsByCourse(course.ID, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(gotAssignments) != 0 {
+		t.Errorf("got %d assignments after rollback, want 0", len(gotAssignments))
+	}
+}
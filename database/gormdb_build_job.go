@@ -0,0 +1,84 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+	"gorm.io/gorm/clause"
+)
+
+/// Build Jobs ///
+
+// CreateBuildJob creates a new build job record.
+func (db *GormDB) CreateBuildJob(job *pb.BuildJob) error {
+	return db.conn.Create(job).Error
+}
+
+// GetBuildJob returns the build job with the given ID.
+func (db *GormDB) GetBuildJob(jobID uint64) (*pb.BuildJob, error) {
+	var job pb.BuildJob
+	if err := db.conn.First(&job, jobID).Error; err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// GetBuildJobs returns the build jobs with one of the given statuses for
+// the given course, ordered by the time they were queued. If courseID is 0,
+// build jobs for all courses are returned. If no statuses are provided,
+// queued and running jobs are returned.
+func (db *GormDB) GetBuildJobs(courseID uint64, statuses ...pb.BuildJob_Status) ([]*pb.BuildJob, error) {
+	if len(statuses) == 0 {
+		statuses = []pb.BuildJob_Status{
+			pb.BuildJob_QUEUED,
+			pb.BuildJob_RUNNING,
+		}
+	}
+	var jobs []*pb.BuildJob
+	if err := db.conn.
+		Where(&pb.BuildJob{CourseID: courseID}).
+		Where("status in (?)", statuses).
+		Order("id").
+		Find(&jobs).Error; err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// UpdateBuildJob updates all fields of the given build job.
+func (db *GormDB) UpdateBuildJob(job *pb.BuildJob) error {
+	return db.conn.Save(job).Error
+}
+
+// GetStartedBuildJobs returns the build jobs of the given assignment and repository
+// started at or after the given date, in the order they were started.
+// If repositoryID is 0, build jobs for all repositories are returned.
+func (db *GormDB) GetStartedBuildJobs(assignmentID, repositoryID uint64, since string) ([]*pb.BuildJob, error) {
+	var jobs []*pb.BuildJob
+	if err := db.conn.
+		Where(&pb.BuildJob{AssignmentID: assignmentID, RepositoryID: repositoryID}).
+		Where("started_date >= ?", since).
+		Order("started_date").
+		Find(&jobs).Error; err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+/// Build Quotas ///
+
+// CreateBuildQuota creates the given build quota, or replaces the reset date
+// of the existing quota for the same assignment and repository.
+func (db *GormDB) CreateBuildQuota(quota *pb.BuildQuota) error {
+	return db.conn.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "assignment_id"}, {Name: "repository_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"reset_date"}),
+	}).Create(quota).Error
+}
+
+// GetBuildQuotas returns the build quotas of the given assignment.
+func (db *GormDB) GetBuildQuotas(assignmentID uint64) ([]*pb.BuildQuota, error) {
+	var quotas []*pb.BuildQuota
+	if err := db.conn.Where(&pb.BuildQuota{AssignmentID: assignmentID}).Order("id").Find(&quotas).Error; err != nil {
+		return nil, err
+	}
+	return quotas, nil
+}
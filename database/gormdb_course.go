@@ -1,7 +1,12 @@
 package database
 
 import (
+	"math"
+	"sort"
+	"time"
+
 	pb "github.com/autograde/quickfeed/ag"
+	"gorm.io/gorm"
 )
 
 // CreateCourse creates a new course if user with given ID is admin, enrolls user as course teacher.
@@ -24,22 +29,20 @@ func (db *GormDB) CreateCourse(userID uint64, course *pb.Course) error {
 		return ErrCourseExists
 	}
 
-	// TODO(meling) these db updates should be done as a transaction
-	if err := db.conn.Create(course).Error; err != nil {
-		return err
-	}
-	if err := db.CreateEnrollment(&pb.Enrollment{UserID: userID, CourseID: course.ID}); err != nil {
-		return err
-	}
-	query := &pb.Enrollment{
-		UserID:   user.ID,
-		CourseID: course.ID,
-		Status:   pb.Enrollment_TEACHER,
-	}
-	if err := db.UpdateEnrollment(query); err != nil {
-		return err
-	}
-	return nil
+	return db.conn.Transaction(func(conn *gorm.DB) error {
+		if err := conn.Create(course).Error; err != nil {
+			return err
+		}
+		tx := &GormDB{conn}
+		if err := tx.CreateEnrollment(&pb.Enrollment{UserID: userID, CourseID: course.ID}); err != nil {
+			return err
+		}
+		return tx.UpdateEnrollment(&pb.Enrollment{
+			UserID:   user.ID,
+			CourseID: course.ID,
+			Status:   pb.Enrollment_TEACHER,
+		})
+	})
 }
 
 // GetCourse fetches course by ID. If withInfo is true, preloads course
@@ -141,3 +144,183 @@ func (db *GormDB) UpdateCourse(course *pb.Course) error {
 		Where(&pb.Course{ID: course.GetID()}).
 		Updates(course).Error
 }
+
+// UpdateCourseSlipDays updates the number of slip days of the given course.
+func (db *GormDB) UpdateCourseSlipDays(courseID uint64, slipDays uint32) error {
+	return db.conn.Model(&pb.Course{ID: courseID}).Update("slip_days", slipDays).Error
+}
+
+const (
+	// scoreBuckets is the number of score ranges in the score distribution of an assignment.
+	scoreBuckets = 10
+)
+
+// GetCourseStatistics returns the number of students of the given course, the number of
+// students with activity on or after activeSince, and the score distribution,
+// approvals, median time to approval, used slip days and push activity of each assignment.
+// The counts are aggregated by the database; only the approval times of approved
+// submissions are loaded to compute their median.
+func (db *GormDB) GetCourseStatistics(courseID uint64, activeSince time.Time) (*pb.CourseStatistics, error) {
+	var assignments []*pb.Assignment
+	if err := db.conn.Where(&pb.Assignment{CourseID: courseID}).Order(orderColumn).Find(&assignments).Error; err != nil {
+		return nil, err
+	}
+	var students struct {
+		Students       uint32
+		ActiveStudents uint32
+	}
+	// students are active if their stored last activity date, or the build date of
+	// one of their or their group's attempts, is on or after activeSince
+	recentAttempts := db.conn.Model(&pb.SubmissionAttempt{}).
+		Select("1").
+		Joins("JOIN submissions ON submissions.id = submission_attempts.submission_id").
+		Where("submissions.user_id = enrollments.user_id OR (enrollments.group_id > 0 AND submissions.group_id = enrollments.group_id)").
+		Where("submission_attempts.created_date >= ?", activeSince.Format(pb.TimeLayout))
+	if err := db.conn.Model(&pb.Enrollment{}).
+		Select("COUNT(*) AS students, "+
+			"COALESCE(SUM(CASE WHEN enrollments.last_activity_date >= ? OR EXISTS (?) THEN 1 ELSE 0 END), 0) AS active_students",
+			activeSince.Format(pb.DateLayout), recentAttempts).
+		Where("enrollments.course_id = ? AND enrollments.status = ?", courseID, pb.Enrollment_STUDENT).
+		Scan(&students).Error; err != nil {
+		return nil, err
+	}
+	statistics := &pb.CourseStatistics{Students: students.Students, ActiveStudents: students.ActiveStudents}
+	byAssignment := make(map[uint64]*pb.AssignmentStatistics)
+	for _, assignment := range assignments {
+		stats := &pb.AssignmentStatistics{
+			AssignmentID:      assignment.GetID(),
+			Name:              assignment.GetName(),
+			ScoreDistribution: make([]uint32, scoreBuckets),
+		}
+		byAssignment[assignment.GetID()] = stats
+		statistics.Assignments = append(statistics.Assignments, stats)
+	}
+
+	var scores []struct {
+		AssignmentID uint64
+		Bucket       int
+		Submissions  uint32
+		Approved     uint32
+	}
+	if err := db.conn.Model(&pb.Submission{}).
+		Select("submissions.assignment_id, "+
+			"CASE WHEN submissions.score >= 100 THEN 9 ELSE submissions.score / 10 END AS bucket, "+
+			"COUNT(*) AS submissions, SUM(CASE WHEN submissions.status = ? THEN 1 ELSE 0 END) AS approved", pb.Submission_APPROVED).
+		Joins("JOIN assignments ON assignments.id = submissions.assignment_id").
+		Where("assignments.course_id = ?", courseID).
+		Group("submissions.assignment_id, bucket").
+		Scan(&scores).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range scores {
+		if stats, ok := byAssignment[row.AssignmentID]; ok {
+			stats.ScoreDistribution[row.Bucket] += row.Submissions
+			stats.Submissions += row.Submissions
+			stats.Approved += row.Approved
+		}
+	}
+
+	var slipDays []struct {
+		AssignmentID uint64
+		UsedSlipDays uint32
+	}
+	if err := db.conn.Model(&pb.UsedSlipDays{}).
+		Select("used_slip_days.assignment_id, SUM(used_slip_days.used_slip_days) AS used_slip_days").
+		Joins("JOIN enrollments ON enrollments.id = used_slip_days.enrollment_id").
+		Where("enrollments.course_id = ?", courseID).
+		Group("used_slip_days.assignment_id").
+		Scan(&slipDays).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range slipDays {
+		if stats, ok := byAssignment[row.AssignmentID]; ok {
+			stats.UsedSlipDays = row.UsedSlipDays
+		}
+	}
+
+	// the time to approval is measured from the first attempt with a known build date
+	var approvals []struct {
+		AssignmentID uint64
+		FirstAttempt string
+		ApprovedDate string
+	}
+	if err := db.conn.Model(&pb.Submission{}).
+		Select("submissions.assignment_id, MIN(submission_attempts.created_date) AS first_attempt, submissions.approved_date").
+		Joins("JOIN assignments ON assignments.id = submissions.assignment_id").
+		Joins("JOIN submission_attempts ON submission_attempts.submission_id = submissions.id AND submission_attempts.created_date <> ''").
+		Where("assignments.course_id = ? AND submissions.status = ? AND submissions.approved_date <> ''", courseID, pb.Submission_APPROVED).
+		Group("submissions.id, submissions.assignment_id, submissions.approved_date").
+		Scan(&approvals).Error; err != nil {
+		return nil, err
+	}
+	approvalTimes := make(map[uint64][]int64)
+	for _, row := range approvals {
+		first, err := time.Parse(pb.TimeLayout, row.FirstAttempt)
+		if err != nil {
+			continue
+		}
+		approved, err := time.Parse(pb.TimeLayout, row.ApprovedDate)
+		if err != nil || approved.Before(first) {
+			continue
+		}
+		approvalTimes[row.AssignmentID] = append(approvalTimes[row.AssignmentID], int64(approved.Sub(first).Seconds()))
+	}
+
+	var pushes []struct {
+		AssignmentID uint64
+		BuildDate    string
+		Attempts     uint32
+	}
+	if err := db.conn.Model(&pb.SubmissionAttempt{}).
+		Select("submissions.assignment_id, SUBSTR(submission_attempts.created_date, 1, 10) AS build_date, COUNT(*) AS attempts").
+		Joins("JOIN submissions ON submissions.id = submission_attempts.submission_id").
+		Joins("JOIN assignments ON assignments.id = submissions.assignment_id").
+		Where("assignments.course_id = ? AND submission_attempts.created_date <> ''", courseID).
+		Group("submissions.assignment_id, build_date").
+		Order("build_date").
+		Scan(&pushes).Error; err != nil {
+		return nil, err
+	}
+	deadlines := make(map[uint64]time.Time)
+	for _, assignment := range assignments {
+		if deadline, err := time.Parse(pb.TimeLayout, assignment.GetDeadline()); err == nil {
+			deadlines[assignment.GetID()] = deadline.Truncate(24 * time.Hour)
+		}
+	}
+	for _, row := range pushes {
+		stats, ok := byAssignment[row.AssignmentID]
+		deadline, hasDeadline := deadlines[row.AssignmentID]
+		if !ok || !hasDeadline {
+			continue
+		}
+		buildDate, err := time.Parse(pb.DateLayout, row.BuildDate)
+		if err != nil {
+			continue
+		}
+		stats.PushActivity = append(stats.PushActivity, &pb.PushActivity{
+			DaysToDeadline: int32(deadline.Sub(buildDate).Hours() / 24),
+			Attempts:       row.Attempts,
+		})
+	}
+
+	for _, stats := range statistics.Assignments {
+		if stats.Submissions > 0 {
+			stats.ApprovalRate = uint32(math.Round(float64(stats.Approved) * 100 / float64(stats.Submissions)))
+		}
+		stats.MedianTimeToApproval = median(approvalTimes[stats.AssignmentID])
+	}
+	return statistics, nil
+}
+
+// median returns the median of the given values, or 0 if there are none.
+func median(values []int64) int64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	middle := len(values) / 2
+	if len(values)%2 == 0 {
+		return (values[middle-1] + values[middle]) / 2
+	}
+	return values[middle]
+}
@@ -0,0 +1,54 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+/// Deadline Extensions ///
+
+// CreateDeadlineExtension creates the given deadline extension, or replaces the
+// deadline and reason of the existing extension for the same assignment and student or group.
+func (db *GormDB) CreateDeadlineExtension(extension *pb.DeadlineExtension) error {
+	if err := db.conn.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "assignment_id"}, {Name: "user_id"}, {Name: "group_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"deadline", "reason"}),
+	}).Create(extension).Error; err != nil {
+		return err
+	}
+	// the ID of a replaced extension is not returned by all databases
+	extension.ID = 0
+	return db.conn.Where(map[string]interface{}{
+		"assignment_id": extension.GetAssignmentID(),
+		"user_id":       extension.GetUserID(),
+		"group_id":      extension.GetGroupID(),
+	}).First(extension).Error
+}
+
+// GetDeadlineExtensions returns the deadline extensions of the assignments of the given course.
+func (db *GormDB) GetDeadlineExtensions(courseID uint64) ([]*pb.DeadlineExtension, error) {
+	var extensions []*pb.DeadlineExtension
+	if err := db.conn.
+		Joins("JOIN assignments ON assignments.id = deadline_extensions.assignment_id").
+		Where("assignments.course_id = ?", courseID).
+		Order("deadline_extensions.id").
+		Find(&extensions).Error; err != nil {
+		return nil, err
+	}
+	return extensions, nil
+}
+
+// DeleteDeadlineExtension removes the given deadline extension of an assignment of the given course.
+// It returns gorm.ErrRecordNotFound if the course has no such extension.
+func (db *GormDB) DeleteDeadlineExtension(courseID, extensionID uint64) error {
+	courseAssignments := db.conn.Model(&pb.Assignment{}).Select("id").Where("course_id = ?", courseID)
+	result := db.conn.Where("assignment_id IN (?)", courseAssignments).Delete(&pb.DeadlineExtension{}, extensionID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+/// Deadline Snapshots ///
+
+// CreateDeadlineSnapshot creates the given deadline snapshot, or replaces the existing
+// snapshot for the same assignment and student or group. If the snapshot is frozen, the
+// latest attempt of the snapshot's commit, if any, becomes the graded attempt of the
+// student's or group's submission for the assignment.
+func (db *GormDB) CreateDeadlineSnapshot(snapshot *pb.DeadlineSnapshot) error {
+	var attempts []*pb.SubmissionAttempt
+	if snapshot.GetFrozen() {
+		if err := db.conn.
+			Joins("JOIN submissions ON submissions.id = submission_attempts.submission_id").
+			Where("submissions.assignment_id = ?", snapshot.GetAssignmentID()).
+			Where("submissions.user_id = ? AND submissions.group_id = ?", snapshot.GetUserID(), snapshot.GetGroupID()).
+			Where("submission_attempts.commit_hash = ?", snapshot.GetCommitHash()).
+			Order("submission_attempts.id DESC").
+			Limit(1).
+			Find(&attempts).Error; err != nil {
+			return err
+		}
+	}
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "assignment_id"}, {Name: "user_id"}, {Name: "group_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"commit_hash", "deadline", "snapshot_date", "frozen", "build_job_id"}),
+		}).Create(snapshot).Error; err != nil {
+			return err
+		}
+		// the ID of a replaced snapshot is not returned by all databases
+		snapshot.ID = 0
+		if err := tx.Where(snapshotKey(snapshot)).First(snapshot).Error; err != nil {
+			return err
+		}
+		if len(attempts) == 0 {
+			return nil
+		}
+		return tx.Model(&pb.Submission{ID: attempts[0].GetSubmissionID()}).Updates(gradedAttempt(attempts[0])).Error
+	})
+}
+
+// GetDeadlineSnapshots returns the deadline snapshots of the given assignment.
+func (db *GormDB) GetDeadlineSnapshots(assignmentID uint64) ([]*pb.DeadlineSnapshot, error) {
+	var snapshots []*pb.DeadlineSnapshot
+	if err := db.conn.Where(&pb.DeadlineSnapshot{AssignmentID: assignmentID}).Order("id").Find(&snapshots).Error; err != nil {
+		return nil, err
+	}
+	return snapshots, nil
+}
+
+// GetDeadlineSnapshot returns the deadline snapshot of the given assignment
+// for the given student or group.
+func (db *GormDB) GetDeadlineSnapshot(assignmentID, userID, groupID uint64) (*pb.DeadlineSnapshot, error) {
+	var snapshot pb.DeadlineSnapshot
+	if err := db.conn.Where(snapshotKey(&pb.DeadlineSnapshot{
+		AssignmentID: assignmentID,
+		UserID:       userID,
+		GroupID:      groupID,
+	})).First(&snapshot).Error; err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+// DeleteDeadlineSnapshot removes the deadline snapshot with the given ID.
+func (db *GormDB) DeleteDeadlineSnapshot(snapshotID uint64) error {
+	return db.conn.Delete(&pb.DeadlineSnapshot{}, snapshotID).Error
+}
+
+// ReleaseReviews releases the reviews of all submissions for the given assignment,
+// and records the date of the release.
+func (db *GormDB) ReleaseReviews(assignmentID uint64, releasedDate string) error {
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&pb.Submission{}).
+			Where("assignment_id = ?", assignmentID).
+			Update("released", true).Error; err != nil {
+			return err
+		}
+		return tx.Model(&pb.Assignment{ID: assignmentID}).Update("released_date", releasedDate).Error
+	})
+}
+
+// snapshotKey returns the query matching the snapshot for the same
+// assignment and student or group as the given snapshot.
+func snapshotKey(snapshot *pb.DeadlineSnapshot) map[string]interface{} {
+	return map[string]interface{}{
+		"assignment_id": snapshot.GetAssignmentID(),
+		"user_id":       snapshot.GetUserID(),
+		"group_id":      snapshot.GetGroupID(),
+	}
+}
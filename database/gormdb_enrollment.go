@@ -93,15 +93,13 @@ func (db *GormDB) getEnrollments(model interface{}, statuses ...pb.Enrollment_Us
 
 // UpdateSlipDays updates used slip days for the given course enrollment
 func (db *GormDB) UpdateSlipDays(usedSlipDays []*pb.UsedSlipDays) error {
-	for _, slipDaysForAssignment := range usedSlipDays {
-		if err := db.updateSlipDays(slipDaysForAssignment); err != nil {
-			return err
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		for _, slipDaysForAssignment := range usedSlipDays {
+			// this will create or update the used slip days record
+			if err := tx.Save(slipDaysForAssignment).Error; err != nil {
+				return err
+			}
 		}
-	}
-	return nil
-}
-
-// updateSlipdays updates or creates UsedSlipDays record
-func (db *GormDB) updateSlipDays(query *pb.UsedSlipDays) error {
-	return db.conn.Save(query).Error
+		return nil
+	})
 }
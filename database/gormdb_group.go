@@ -2,7 +2,6 @@ package database
 
 import (
 	"fmt"
-	"strings"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"gorm.io/gorm"
@@ -26,35 +25,15 @@ func (db *GormDB) CreateGroup(group *pb.Group) error {
 		return gorm.ErrRecordNotFound
 	}
 
-	tx := db.conn.Begin()
-	if err := tx.Model(&pb.Group{}).Create(group).Error; err != nil {
-		tx.Rollback()
-		if strings.HasPrefix(err.Error(), "UNIQUE constraint failed") {
-			return ErrDuplicateGroup
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&pb.Group{}).Create(group).Error; err != nil {
+			if isUniqueViolation(err) {
+				return ErrDuplicateGroup
+			}
+			return err
 		}
-		return err
-	}
-
-	var userids []uint64
-	for _, u := range group.Users {
-		userids = append(userids, u.ID)
-	}
-	query := tx.Model(&pb.Enrollment{}).
-		Where(&pb.Enrollment{CourseID: group.CourseID}).
-		Where("user_id IN (?) AND status IN (?)", userids,
-			[]pb.Enrollment_UserStatus{pb.Enrollment_STUDENT, pb.Enrollment_TEACHER}).
-		Updates(&pb.Enrollment{GroupID: group.ID})
-	if query.Error != nil {
-		tx.Rollback()
-		return query.Error
-	}
-	if query.RowsAffected != int64(len(userids)) {
-		tx.Rollback()
-		return ErrUpdateGroup
-	}
-
-	tx.Commit()
-	return nil
+		return updateGroupEnrollments(tx, group)
+	})
 }
 
 // UpdateGroup updates a group with the specified users and enrollments.
@@ -72,19 +51,22 @@ func (db *GormDB) UpdateGroup(group *pb.Group) error {
 		return gorm.ErrRecordNotFound
 	}
 
-	tx := db.conn.Begin()
-	if err := tx.Model(group).Updates(group).Error; err != nil {
-		tx.Rollback()
-		if strings.HasPrefix(err.Error(), "UNIQUE constraint failed") {
-			return ErrDuplicateGroup
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(group).Updates(group).Error; err != nil {
+			if isUniqueViolation(err) {
+				return ErrDuplicateGroup
+			}
+			return err
 		}
-		return err
-	}
-	if err := tx.Exec("UPDATE enrollments SET group_id= ? WHERE group_id= ?", 0, group.ID).Error; err != nil {
-		tx.Rollback()
-		return err
-	}
+		if err := tx.Exec("UPDATE enrollments SET group_id= ? WHERE group_id= ?", 0, group.ID).Error; err != nil {
+			return err
+		}
+		return updateGroupEnrollments(tx, group)
+	})
+}
 
+// updateGroupEnrollments sets the group ID of the enrollments of the group's users.
+func updateGroupEnrollments(tx *gorm.DB, group *pb.Group) error {
 	var userids []uint64
 	for _, u := range group.Users {
 		userids = append(userids, u.ID)
@@ -95,15 +77,11 @@ func (db *GormDB) UpdateGroup(group *pb.Group) error {
 			[]pb.Enrollment_UserStatus{pb.Enrollment_STUDENT, pb.Enrollment_TEACHER}).
 		Updates(&pb.Enrollment{GroupID: group.ID})
 	if query.Error != nil {
-		tx.Rollback()
 		return query.Error
 	}
 	if query.RowsAffected != int64(len(userids)) {
-		tx.Rollback()
 		return ErrUpdateGroup
 	}
-
-	tx.Commit()
 	return nil
 }
 
@@ -119,17 +97,12 @@ func (db *GormDB) DeleteGroup(groupID uint64) error {
 		return err
 	}
 
-	tx := db.conn.Begin()
-	if err := tx.Delete(group).Error; err != nil {
-		tx.Rollback()
-		return err
-	}
-	if err := tx.Exec("UPDATE enrollments SET group_id= ? WHERE group_id= ?", 0, groupID).Error; err != nil {
-		tx.Rollback()
-		return err
-	}
-	tx.Commit()
-	return nil
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(group).Error; err != nil {
+			return err
+		}
+		return tx.Exec("UPDATE enrollments SET group_id= ? WHERE group_id= ?", 0, groupID).Error
+	})
 }
 
 // GetGroup returns the group with the specified group id.
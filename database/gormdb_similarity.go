@@ -0,0 +1,53 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+	"gorm.io/gorm"
+)
+
+/// Similarity Detection ///
+
+// UpdateSimilarityPairs replaces the similarity pairs of the given assignment
+// with the given pairs and their matches.
+func (db *GormDB) UpdateSimilarityPairs(assignmentID uint64, pairs []*pb.SimilarityPair) error {
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		var oldPairs []uint64
+		if err := tx.Model(&pb.SimilarityPair{}).
+			Where(&pb.SimilarityPair{AssignmentID: assignmentID}).
+			Pluck("id", &oldPairs).Error; err != nil {
+			return err
+		}
+		if len(oldPairs) > 0 {
+			if err := tx.Where("pair_id in (?)", oldPairs).Delete(&pb.SimilarityMatch{}).Error; err != nil {
+				return err
+			}
+			if err := tx.Delete(&pb.SimilarityPair{}, oldPairs).Error; err != nil {
+				return err
+			}
+		}
+		for _, pair := range pairs {
+			pair.AssignmentID = assignmentID
+			if err := tx.Create(pair).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GetSimilarityPairs returns the similarity pairs of the given assignment with their matches,
+// ordered by decreasing similarity.
+func (db *GormDB) GetSimilarityPairs(assignmentID uint64) ([]*pb.SimilarityPair, error) {
+	var pairs []*pb.SimilarityPair
+	if err := db.conn.
+		Where(&pb.SimilarityPair{AssignmentID: assignmentID}).
+		Preload("Matches", func(tx *gorm.DB) *gorm.DB {
+			return tx.Order("id")
+		}).
+		Order("similarity desc").
+		Order("id").
+		Find(&pairs).Error; err != nil {
+		return nil, err
+	}
+	return pairs, nil
+}
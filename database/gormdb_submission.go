@@ -1,13 +1,20 @@
 package database
 
 import (
+	"math"
+	"time"
+
 	pb "github.com/autograde/quickfeed/ag"
 	"gorm.io/gorm"
 )
 
-// CreateSubmission creates a new submission record or updates the most
-// recent submission, as defined by the provided submissionQuery.
-// The submissionQuery must always specify the assignment, and may specify the ID of
+// CreateSubmission records the results of the given submission as a new attempt,
+// and makes it the graded attempt of the submission record for the assignment and
+// student or group. The most recent submission record is updated with the attempt's
+// results, or created if it does not exist. If the student's or group's deadline
+// snapshot for the assignment freezes the submission's commit, the attempt is
+// recorded without changing the graded attempt or the submission record's results.
+// The submission must always specify the assignment, and may specify the ID of
 // either an individual student or a group, but not both.
 func (db *GormDB) CreateSubmission(submission *pb.Submission) error {
 	// Primary key must be greater than 0.
@@ -63,24 +70,189 @@ func (db *GormDB) CreateSubmission(submission *pb.Submission) error {
 		return err
 	}
 
+	var snapshots []*pb.DeadlineSnapshot
+	if err := db.conn.Where(snapshotKey(&pb.DeadlineSnapshot{
+		AssignmentID: submission.GetAssignmentID(),
+		UserID:       submission.GetUserID(),
+		GroupID:      submission.GetGroupID(),
+	})).Limit(1).Find(&snapshots).Error; err != nil {
+		return err
+	}
+	frozen := len(snapshots) > 0 && snapshots[0].Freezes(submission.GetCommitHash())
+
 	// If a submission for the given assignment and student/group already exists, update it.
 	// Otherwise create a new submission record. Note that Submission.Reviews will not be updated;
 	// to update Submission.Reviews, use UpdateReview().
-	var labSubmission pb.Submission
-	err := db.conn.Where(query).Assign(submission).FirstOrCreate(&labSubmission).Error
+	// The transaction only writes, since SQLite fails transactions that must upgrade
+	// from a read lock to a write lock while another connection is writing.
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		// the test results are created with the attempt
+		switch {
+		case frozen && query.GetID() > 0:
+			submission.ID = query.GetID()
+		case frozen:
+			// the results of a frozen submission's attempts are not copied to the record
+			record := &pb.Submission{
+				AssignmentID: submission.GetAssignmentID(),
+				UserID:       submission.GetUserID(),
+				GroupID:      submission.GetGroupID(),
+			}
+			if err := tx.Create(record).Error; err != nil {
+				return err
+			}
+			submission.ID = record.GetID()
+		case query.GetID() > 0:
+			if err := tx.Model(&pb.Submission{ID: query.GetID()}).Omit("TestResults").Updates(submission).Error; err != nil {
+				return err
+			}
+			submission.ID = query.GetID()
+		default:
+			if err := tx.Omit("TestResults").Create(submission).Error; err != nil {
+				return err
+			}
+		}
+		for _, result := range submission.GetTestResults() {
+			result.SubmissionID = submission.GetID()
+			result.AssignmentID = submission.GetAssignmentID()
+		}
+		attempt := &pb.SubmissionAttempt{
+			SubmissionID:       submission.GetID(),
+			CommitHash:         submission.GetCommitHash(),
+			Score:              submission.GetScore(),
+			RawScore:           submission.GetRawScore(),
+			ScoreObjects:       submission.GetScoreObjects(),
+			BuildInfo:          submission.GetBuildInfo(),
+			CreatedDate:        time.Now().Format(pb.TimeLayout),
+			TestResults:        submission.GetTestResults(),
+			HiddenScore:        submission.GetHiddenScore(),
+			HiddenScoreObjects: submission.GetHiddenScoreObjects(),
+			HiddenBuildInfo:    submission.GetHiddenBuildInfo(),
+		}
+		if err := tx.Create(attempt).Error; err != nil {
+			return err
+		}
+		if frozen {
+			submission.GradedAttemptID = query.GetGradedAttemptID()
+			return nil
+		}
+		if err := tx.Model(&pb.Submission{ID: submission.GetID()}).Updates(gradedAttempt(attempt)).Error; err != nil {
+			return err
+		}
+		submission.GradedAttemptID = attempt.GetID()
+		return nil
+	})
+}
+
+// GetSubmissionAttempts returns the attempts of the given submission in the order they were
+// recorded. The build info is left out, since the build logs of all attempts may be large.
+func (db *GormDB) GetSubmissionAttempts(submissionID uint64) ([]*pb.SubmissionAttempt, error) {
+	var attempts []*pb.SubmissionAttempt
+	if err := db.conn.
+		Where(&pb.SubmissionAttempt{SubmissionID: submissionID}).
+		Omit("build_info", "hidden_build_info").
+		Order("id").
+		Find(&attempts).Error; err != nil {
+		return nil, err
+	}
+	return attempts, nil
+}
+
+// GetCourseSubmissionAttempts returns the attempts of the submissions for the assignments
+// of the given course, in the order they were built, without their build info and score objects.
+// The IDs of concurrently recorded attempts need not follow the order of their build dates.
+func (db *GormDB) GetCourseSubmissionAttempts(courseID uint64) ([]*pb.SubmissionAttempt, error) {
+	var attempts []*pb.SubmissionAttempt
+	if err := db.conn.
+		Select("submission_attempts.id, submission_attempts.submission_id, submission_attempts.commit_hash, "+
+			"submission_attempts.score, submission_attempts.created_date").
+		Joins("JOIN submissions ON submissions.id = submission_attempts.submission_id").
+		Joins("JOIN assignments ON assignments.id = submissions.assignment_id").
+		Where("assignments.course_id = ?", courseID).
+		Order("submission_attempts.created_date, submission_attempts.id").
+		Find(&attempts).Error; err != nil {
+		return nil, err
+	}
+	return attempts, nil
+}
+
+// GetSubmissionAttempt returns a single submission attempt matching the given query,
+// with its test results.
+func (db *GormDB) GetSubmissionAttempt(query *pb.SubmissionAttempt) (*pb.SubmissionAttempt, error) {
+	var attempt pb.SubmissionAttempt
+	if err := db.conn.Preload("TestResults").Where(query).First(&attempt).Error; err != nil {
+		return nil, err
+	}
+	return &attempt, nil
+}
+
+// UpdateGradedAttempt makes the given attempt the graded attempt of the given submission,
+// and updates the submission with the attempt's results. The submission's status and
+// reviews are kept. An error is returned if the attempt does not belong to the submission.
+func (db *GormDB) UpdateGradedAttempt(submissionID, attemptID uint64) error {
+	attempt, err := db.GetSubmissionAttempt(&pb.SubmissionAttempt{ID: attemptID, SubmissionID: submissionID})
+	if err != nil {
+		return err
+	}
+	return db.conn.Model(&pb.Submission{ID: submissionID}).Updates(gradedAttempt(attempt)).Error
+}
+
+// GetTestStatistics returns the statistics of each test case of the given assignment,
+// ordered by decreasing number of failures. The statistics are computed from the test
+// results of the graded attempts, or from the test results of all attempts if allAttempts is true.
+func (db *GormDB) GetTestStatistics(assignmentID uint64, allAttempts bool) ([]*pb.TestCaseStatistics, error) {
+	query := db.conn.Model(&pb.TestResult{}).
+		Select("test_name, COUNT(*) AS runs, "+
+			"SUM(CASE WHEN score < max_score THEN 1 ELSE 0 END) AS failures, "+
+			"AVG(CASE WHEN score >= max_score THEN 100.0 ELSE score * 100.0 / max_score END) AS average_score, "+
+			"AVG(duration) AS average_duration").
+		Where("assignment_id = ?", assignmentID)
+	if !allAttempts {
+		gradedAttempts := db.conn.Model(&pb.Submission{}).Select("graded_attempt_id").Where("assignment_id = ?", assignmentID)
+		query = query.Where("attempt_id IN (?)", gradedAttempts)
+	}
+	var rows []struct {
+		TestName        string
+		Runs            uint32
+		Failures        uint32
+		AverageScore    float64
+		AverageDuration float64
+	}
+	if err := query.Group("test_name").Order("failures desc").Order("test_name").Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	statistics := make([]*pb.TestCaseStatistics, len(rows))
+	for i, row := range rows {
+		statistics[i] = &pb.TestCaseStatistics{
+			TestName:        row.TestName,
+			Runs:            row.Runs,
+			Failures:        row.Failures,
+			AverageScore:    uint32(math.Round(row.AverageScore)),
+			AverageDuration: int64(math.Round(row.AverageDuration)),
+		}
+	}
+	return statistics, nil
+}
 
-	if submission.GetScore() == 0 {
-		// GORM doesn't update zero value fields, unless forced:
-		err = db.conn.Model(submission).Where(query).Update("score", 0).Error
+// gradedAttempt returns the submission columns to update when the given attempt is graded.
+// A map is used since GORM does not update zero value fields of structs, such as a zero score.
+func gradedAttempt(attempt *pb.SubmissionAttempt) map[string]interface{} {
+	return map[string]interface{}{
+		"graded_attempt_id":    attempt.GetID(),
+		"commit_hash":          attempt.GetCommitHash(),
+		"score":                attempt.GetScore(),
+		"raw_score":            attempt.GetRawScore(),
+		"score_objects":        attempt.GetScoreObjects(),
+		"build_info":           attempt.GetBuildInfo(),
+		"hidden_score":         attempt.GetHiddenScore(),
+		"hidden_score_objects": attempt.GetHiddenScoreObjects(),
+		"hidden_build_info":    attempt.GetHiddenBuildInfo(),
 	}
-	submission.ID = labSubmission.GetID()
-	return err
 }
 
-// GetSubmission fetches a submission record.
+// GetSubmission fetches a submission record with its reviews and the test results of its graded attempt.
 func (db *GormDB) GetSubmission(query *pb.Submission) (*pb.Submission, error) {
 	var submission pb.Submission
-	if err := db.conn.Preload("Reviews").Where(query).Last(&submission).Error; err != nil {
+	if err := db.conn.Preload("Reviews").Preload("TestResults").Where(query).Last(&submission).Error; err != nil {
 		return nil, err
 	}
 	return &submission, nil
@@ -119,8 +291,9 @@ func (db *GormDB) GetSubmissions(query *pb.Submission) ([]*pb.Submission, error)
 }
 
 // UpdateSubmission updates submission with the given approved status.
+// The test results of the submission are never updated.
 func (db *GormDB) UpdateSubmission(query *pb.Submission) error {
-	return db.conn.Save(query).Error
+	return db.conn.Omit("TestResults").Save(query).Error
 }
 
 // UpdateSubmissions approves and/or releases all submissions that have score
@@ -8,6 +8,7 @@ import (
 	"github.com/autograde/quickfeed/ag"
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/log"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"gorm.io/gorm"
@@ -83,6 +84,9 @@ func TestGormDBUpdateSubmissionZeroScore(t *testing.T) {
 		Score:        80,
 		Status:       pb.Submission_NONE,
 		Reviews:      []*ag.Review{},
+		TestResults:  []*ag.TestResult{},
+		// each recorded submission is a new attempt
+		GradedAttemptID: 1,
 	}
 	if diff := cmp.Diff(submissions[0], want, cmpopts.IgnoreUnexported(pb.Submission{})); diff != "" {
 		t.Errorf("Expected same submission, but got (-sub +want):\n%s", diff)
@@ -102,12 +106,14 @@ func TestGormDBUpdateSubmissionZeroScore(t *testing.T) {
 		t.Fatal(err)
 	}
 	want = &pb.Submission{
-		ID:           submissions[0].ID,
-		AssignmentID: assignment.ID,
-		UserID:       user.ID,
-		Score:        0,
-		Status:       pb.Submission_NONE,
-		Reviews:      []*ag.Review{},
+		ID:              submissions[0].ID,
+		AssignmentID:    assignment.ID,
+		UserID:          user.ID,
+		Score:           0,
+		Status:          pb.Submission_NONE,
+		Reviews:         []*ag.Review{},
+		TestResults:     []*ag.TestResult{},
+		GradedAttemptID: 2,
 	}
 	if diff := cmp.Diff(submissions[0], want, cmpopts.IgnoreUnexported(pb.Submission{})); diff != "" {
 		t.Errorf("Expected same submission, but got (-sub +want):\n%s", diff)
@@ -140,11 +146,13 @@ func TestGormDBUpdateSubmission(t *testing.T) {
 		t.Fatalf("have %d submissions want %d", len(submissions), 1)
 	}
 	want := &pb.Submission{
-		ID:           submissions[0].ID,
-		AssignmentID: assignment.ID,
-		UserID:       user.ID,
-		Status:       pb.Submission_NONE,
-		Reviews:      []*pb.Review{},
+		ID:              submissions[0].ID,
+		AssignmentID:    assignment.ID,
+		UserID:          user.ID,
+		Status:          pb.Submission_NONE,
+		Reviews:         []*pb.Review{},
+		TestResults:     []*pb.TestResult{},
+		GradedAttemptID: 1,
 	}
 	if diff := cmp.Diff(submissions[0], want, cmpopts.IgnoreUnexported(pb.Submission{})); diff != "" {
 		t.Errorf("Expected same submission, but got (-sub +want):\n%s", diff)
@@ -180,6 +188,172 @@ func TestGormDBUpdateSubmission(t *testing.T) {
 	}
 }
 
+func TestGormDBSubmissionAttempts(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+	user, _, assignment := setupCourseAssignment(t, db)
+
+	first := &pb.Submission{AssignmentID: assignment.ID, UserID: user.ID, CommitHash: "abc", Score: 60, BuildInfo: "first build"}
+	if err := db.CreateSubmission(first); err != nil {
+		t.Fatal(err)
+	}
+	// approval is kept when a new attempt is recorded
+	submission, err := db.GetSubmission(&pb.Submission{ID: first.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	submission.Status = pb.Submission_APPROVED
+	if err := db.UpdateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+	second := &pb.Submission{AssignmentID: assignment.ID, UserID: user.ID, CommitHash: "def", Score: 0, BuildInfo: "second build"}
+	if err := db.CreateSubmission(second); err != nil {
+		t.Fatal(err)
+	}
+	if second.ID != first.ID || second.GradedAttemptID == first.GradedAttemptID {
+		t.Errorf("have submission %d with attempt %d, want submission %d with a new attempt", second.ID, second.GradedAttemptID, first.ID)
+	}
+
+	attempts, err := db.GetSubmissionAttempts(first.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, attempt := range attempts {
+		got = append(got, fmt.Sprintf("%d:%s:%d:%q", attempt.GetID(), attempt.GetCommitHash(), attempt.GetScore(), attempt.GetBuildInfo()))
+	}
+	wantAttempts := []string{
+		fmt.Sprintf("%d:abc:60:\"\"", first.GradedAttemptID),
+		fmt.Sprintf("%d:def:0:\"\"", second.GradedAttemptID),
+	}
+	if diff := cmp.Diff(wantAttempts, got); diff != "" {
+		t.Errorf("GetSubmissionAttempts() mismatch (-want +got):\n%s", diff)
+	}
+	attempt, err := db.GetSubmissionAttempt(&pb.SubmissionAttempt{ID: first.GradedAttemptID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attempt.GetBuildInfo() != "first build" || attempt.GetCreatedDate() == "" {
+		t.Errorf("GetSubmissionAttempt() = %v, want the first build with its created date", attempt)
+	}
+
+	// grading the first attempt again restores its results
+	if err := db.UpdateGradedAttempt(first.ID, first.GradedAttemptID); err != nil {
+		t.Fatal(err)
+	}
+	submission, err = db.GetSubmission(&pb.Submission{ID: first.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if submission.GetGradedAttemptID() != first.GradedAttemptID || submission.GetScore() != 60 ||
+		submission.GetCommitHash() != "abc" || submission.GetBuildInfo() != "first build" || !submission.IsApproved() {
+		t.Errorf("have submission %v, want the approved results of the first attempt", submission)
+	}
+	if err := db.UpdateGradedAttempt(first.ID+1, first.GradedAttemptID); err != gorm.ErrRecordNotFound {
+		t.Errorf("UpdateGradedAttempt() of another submission's attempt: have error %v, want %v", err, gorm.ErrRecordNotFound)
+	}
+}
+
+func TestGormDBCourseSubmissionAttemptsOrder(t *testing.T) {
+	path, cleanup := newFixtureDB(t, "")
+	defer cleanup()
+	db, err := database.NewGormDB(path, log.Zap(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	user, course, assignment := setupCourseAssignment(t, db)
+
+	var attemptIDs []uint64
+	for _, commit := range []string{"abc", "def", "ghi"} {
+		submission := &pb.Submission{AssignmentID: assignment.ID, UserID: user.ID, CommitHash: commit}
+		if err := db.CreateSubmission(submission); err != nil {
+			t.Fatal(err)
+		}
+		attemptIDs = append(attemptIDs, submission.GradedAttemptID)
+	}
+	// the first attempt was recorded first, but built last
+	conn := openSQLite(t, path)
+	for i, date := range []string{"2021-03-01T00:00:00", "2021-02-01T00:00:00", "2021-02-01T00:00:00"} {
+		if err := conn.Model(&pb.SubmissionAttempt{ID: attemptIDs[i]}).Update("created_date", date).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	attempts, err := db.GetCourseSubmissionAttempts(course.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []uint64
+	for _, attempt := range attempts {
+		got = append(got, attempt.GetID())
+	}
+	want := []uint64{attemptIDs[1], attemptIDs[2], attemptIDs[0]}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GetCourseSubmissionAttempts() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestGormDBTestStatistics(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+	user, course, assignment := setupCourseAssignment(t, db)
+	other := createFakeUser(t, db, 12)
+	if err := db.CreateEnrollment(&pb.Enrollment{CourseID: course.ID, UserID: other.ID}); err != nil {
+		t.Fatal(err)
+	}
+
+	results := func(fib, sum int32) []*pb.TestResult {
+		return []*pb.TestResult{
+			{TestName: "TestFib", Score: fib, MaxScore: 10, Weight: 1, Duration: 20},
+			{TestName: "TestSum", Score: sum, MaxScore: 10, Weight: 1, Duration: 10},
+		}
+	}
+	for _, submission := range []*pb.Submission{
+		{AssignmentID: assignment.ID, UserID: user.ID, TestResults: results(0, 0)},
+		{AssignmentID: assignment.ID, UserID: user.ID, TestResults: results(5, 10)},
+		{AssignmentID: assignment.ID, UserID: other.ID, TestResults: results(10, 10)},
+	} {
+		if err := db.CreateSubmission(submission); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	submission, err := db.GetSubmission(&pb.Submission{UserID: user.ID, AssignmentID: assignment.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var scores []int32
+	for _, result := range submission.GetTestResults() {
+		scores = append(scores, result.GetScore())
+	}
+	if diff := cmp.Diff([]int32{5, 10}, scores); diff != "" {
+		t.Errorf("GetSubmission() test results of graded attempt mismatch (-want +got):\n%s", diff)
+	}
+
+	tests := []struct {
+		allAttempts bool
+		want        []*pb.TestCaseStatistics
+	}{
+		{false, []*pb.TestCaseStatistics{
+			{TestName: "TestFib", Runs: 2, Failures: 1, AverageScore: 75, AverageDuration: 20},
+			{TestName: "TestSum", Runs: 2, Failures: 0, AverageScore: 100, AverageDuration: 10},
+		}},
+		{true, []*pb.TestCaseStatistics{
+			{TestName: "TestFib", Runs: 3, Failures: 2, AverageScore: 50, AverageDuration: 20},
+			{TestName: "TestSum", Runs: 3, Failures: 1, AverageScore: 67, AverageDuration: 10},
+		}},
+	}
+	for _, test := range tests {
+		got, err := db.GetTestStatistics(assignment.ID, test.allAttempts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(test.want, got, cmpopts.IgnoreUnexported(pb.TestCaseStatistics{})); diff != "" {
+			t.Errorf("GetTestStatistics(allAttempts=%t) mismatch (-want +got):\n%s", test.allAttempts, diff)
+		}
+	}
+}
+
 func TestGormDBGetNonExistingSubmissions(t *testing.T) {
 	db, cleanup := setup(t)
 	defer cleanup()
@@ -228,10 +402,12 @@ func TestGormDBInsertSubmissions(t *testing.T) {
 		t.Fatalf("have %d submissions want %d", len(submissions), 1)
 	}
 	want := &pb.Submission{
-		ID:           submissions[0].ID,
-		AssignmentID: assignment.ID,
-		UserID:       user.ID,
-		Reviews:      []*pb.Review{},
+		ID:              submissions[0].ID,
+		AssignmentID:    assignment.ID,
+		UserID:          user.ID,
+		Reviews:         []*pb.Review{},
+		TestResults:     []*pb.TestResult{},
+		GradedAttemptID: 1,
 	}
 	if !reflect.DeepEqual(submissions[0], want) {
 		t.Errorf("have %#v want %#v", submissions[0], want)
@@ -301,6 +477,7 @@ func TestGormDBGetInsertSubmissions(t *testing.T) {
 		UserID:       user.ID,
 		AssignmentID: assignment1.ID,
 		Reviews:      []*pb.Review{},
+		TestResults:  []*pb.TestResult{},
 	}
 	if err := db.CreateSubmission(&submission1); err != nil {
 		t.Fatal(err)
@@ -310,6 +487,7 @@ func TestGormDBGetInsertSubmissions(t *testing.T) {
 		UserID:       user.ID,
 		AssignmentID: assignment1.ID,
 		Reviews:      []*pb.Review{},
+		TestResults:  []*pb.TestResult{},
 	}
 	if err := db.CreateSubmission(&submission2); err != nil {
 		t.Fatal(err)
@@ -319,6 +497,7 @@ func TestGormDBGetInsertSubmissions(t *testing.T) {
 		UserID:       user.ID,
 		AssignmentID: assignment2.ID,
 		Reviews:      []*pb.Review{},
+		TestResults:  []*pb.TestResult{},
 	}
 	if err := db.CreateSubmission(&submission3); err != nil {
 		t.Fatal(err)
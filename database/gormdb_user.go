@@ -2,6 +2,7 @@ package database
 
 import (
 	pb "github.com/autograde/quickfeed/ag"
+	"gorm.io/gorm"
 )
 
 // GetUser fetches a user by ID with remote identities.
@@ -15,28 +16,22 @@ func (db *GormDB) GetUser(userID uint64) (*pb.User, error) {
 
 // GetUserByRemoteIdentity fetches user by remote identity.
 func (db *GormDB) GetUserByRemoteIdentity(remote *pb.RemoteIdentity) (*pb.User, error) {
-	tx := db.conn.Begin()
-
-	// Get the remote identity.
-	var remoteIdentity pb.RemoteIdentity
-	if err := tx.
-		Where(&pb.RemoteIdentity{
-			Provider: remote.Provider,
-			RemoteID: remote.RemoteID,
-		}).
-		First(&remoteIdentity).Error; err != nil {
-		tx.Rollback()
-		return nil, err
-	}
-
-	// Get the user.
 	var user pb.User
-	if err := tx.Preload("RemoteIdentities").First(&user, remoteIdentity.UserID).Error; err != nil {
-		tx.Rollback()
-		return nil, err
-	}
+	if err := db.conn.Transaction(func(tx *gorm.DB) error {
+		// Get the remote identity.
+		var remoteIdentity pb.RemoteIdentity
+		if err := tx.
+			Where(&pb.RemoteIdentity{
+				Provider: remote.Provider,
+				RemoteID: remote.RemoteID,
+			}).
+			First(&remoteIdentity).Error; err != nil {
+			return err
+		}
 
-	if err := tx.Commit().Error; err != nil {
+		// Get the user.
+		return tx.Preload("RemoteIdentities").First(&user, remoteIdentity.UserID).Error
+	}); err != nil {
 		return nil, err
 	}
 	return &user, nil
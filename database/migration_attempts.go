@@ -0,0 +1,65 @@
+package database
+
+import "gorm.io/gorm"
+
+// The submission attempts table stores the results of every build of a submission,
+// and the submissions table points to the graded attempt. The results of existing
+// submissions become their first and graded attempt.
+
+type submissionAttempt struct {
+	ID           uint64
+	SubmissionID uint64 `gorm:"index"`
+	CommitHash   string
+	Score        uint32
+	ScoreObjects string
+	BuildInfo    string
+	CreatedDate  string
+}
+
+type attemptSubmission struct {
+	ID              uint64
+	CommitHash      string
+	Score           uint32
+	ScoreObjects    string
+	BuildInfo       string
+	GradedAttemptID uint64
+}
+
+func (submissionAttempt) TableName() string { return "submission_attempts" }
+func (attemptSubmission) TableName() string { return "submissions" }
+
+func createSubmissionAttempts(tx *gorm.DB) error {
+	if err := tx.AutoMigrate(&submissionAttempt{}); err != nil {
+		return err
+	}
+	if err := tx.Migrator().AddColumn(&attemptSubmission{}, "GradedAttemptID"); err != nil {
+		return err
+	}
+	var submissions []*attemptSubmission
+	if err := tx.Find(&submissions).Error; err != nil {
+		return err
+	}
+	for _, submission := range submissions {
+		attempt := &submissionAttempt{
+			SubmissionID: submission.ID,
+			CommitHash:   submission.CommitHash,
+			Score:        submission.Score,
+			ScoreObjects: submission.ScoreObjects,
+			BuildInfo:    submission.BuildInfo,
+		}
+		if err := tx.Create(attempt).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(submission).Update("graded_attempt_id", attempt.ID).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func dropSubmissionAttempts(tx *gorm.DB) error {
+	if err := tx.Migrator().DropColumn(&attemptSubmission{}, "GradedAttemptID"); err != nil {
+		return err
+	}
+	return tx.Migrator().DropTable(&submissionAttempt{})
+}
@@ -0,0 +1,57 @@
+package database
+
+import "gorm.io/gorm"
+
+// Assignments may limit the builds of pushes to each repository per hour and
+// by a minimum interval, build jobs of excess pushes are delayed until the
+// quota allows them to start, and the build quota table records the date
+// at which a teacher last reset the quota of a repository for an assignment.
+
+type buildQuota struct {
+	ID           uint64
+	AssignmentID uint64 `gorm:"uniqueIndex:idx_unique_quota"`
+	RepositoryID uint64 `gorm:"uniqueIndex:idx_unique_quota"`
+	ResetDate    string
+}
+
+type quotaAssignment struct {
+	ID            uint64
+	BuildsPerHour uint32
+	BuildInterval string
+}
+
+type delayedBuildJob struct {
+	ID           uint64
+	DelayedUntil string
+}
+
+// gorm names the table of pb.BuildQuota with the plural of quota, which is quota
+func (buildQuota) TableName() string      { return "build_quota" }
+func (quotaAssignment) TableName() string { return "assignments" }
+func (delayedBuildJob) TableName() string { return "build_jobs" }
+
+var quotaAssignmentColumns = []string{"BuildsPerHour", "BuildInterval"}
+
+func createBuildQuotas(tx *gorm.DB) error {
+	if err := tx.AutoMigrate(&buildQuota{}); err != nil {
+		return err
+	}
+	for _, column := range quotaAssignmentColumns {
+		if err := tx.Migrator().AddColumn(&quotaAssignment{}, column); err != nil {
+			return err
+		}
+	}
+	return tx.Migrator().AddColumn(&delayedBuildJob{}, "DelayedUntil")
+}
+
+func dropBuildQuotas(tx *gorm.DB) error {
+	if err := tx.Migrator().DropColumn(&delayedBuildJob{}, "DelayedUntil"); err != nil {
+		return err
+	}
+	for _, column := range quotaAssignmentColumns {
+		if err := tx.Migrator().DropColumn(&quotaAssignment{}, column); err != nil {
+			return err
+		}
+	}
+	return tx.Migrator().DropTable(&buildQuota{})
+}
@@ -0,0 +1,38 @@
+package database
+
+import "gorm.io/gorm"
+
+// The deadline extensions table stores extended deadlines of assignments for
+// students and groups, and the grace period of each course is configurable.
+// Existing courses keep the default grace period of two hours.
+
+type deadlineExtension struct {
+	ID           uint64
+	AssignmentID uint64 `gorm:"uniqueIndex:idx_unique_extension"`
+	UserID       uint64 `gorm:"uniqueIndex:idx_unique_extension"`
+	GroupID      uint64 `gorm:"uniqueIndex:idx_unique_extension"`
+	Deadline     string
+	Reason       string
+}
+
+type gracePeriodCourse struct {
+	ID          uint64
+	GracePeriod string
+}
+
+func (deadlineExtension) TableName() string { return "deadline_extensions" }
+func (gracePeriodCourse) TableName() string { return "courses" }
+
+func createDeadlineExtensions(tx *gorm.DB) error {
+	if err := tx.AutoMigrate(&deadlineExtension{}); err != nil {
+		return err
+	}
+	return tx.Migrator().AddColumn(&gracePeriodCourse{}, "GracePeriod")
+}
+
+func dropDeadlineExtensions(tx *gorm.DB) error {
+	if err := tx.Migrator().DropColumn(&gracePeriodCourse{}, "GracePeriod"); err != nil {
+		return err
+	}
+	return tx.Migrator().DropTable(&deadlineExtension{})
+}
@@ -0,0 +1,54 @@
+package database
+
+import "gorm.io/gorm"
+
+// The deadline snapshots table records the commit of each student and group
+// repository at the deadline of an assignment, and assignments can freeze the
+// graded attempt at the snapshot, build repositories without any build at the
+// deadline, and release the reviews of submissions at a release date.
+
+type deadlineSnapshot struct {
+	ID           uint64
+	AssignmentID uint64 `gorm:"uniqueIndex:idx_unique_snapshot"`
+	UserID       uint64 `gorm:"uniqueIndex:idx_unique_snapshot"`
+	GroupID      uint64 `gorm:"uniqueIndex:idx_unique_snapshot"`
+	CommitHash   string
+	Deadline     string
+	SnapshotDate string
+	Frozen       bool
+	BuildJobID   uint64
+}
+
+type snapshotAssignment struct {
+	ID               uint64
+	FreezeAtDeadline bool
+	FinalBuild       bool
+	ReleaseDate      string
+	ReleasedDate     string
+}
+
+func (deadlineSnapshot) TableName() string   { return "deadline_snapshots" }
+func (snapshotAssignment) TableName() string { return "assignments" }
+
+var snapshotAssignmentColumns = []string{"FreezeAtDeadline", "FinalBuild", "ReleaseDate", "ReleasedDate"}
+
+func createDeadlineSnapshots(tx *gorm.DB) error {
+	if err := tx.AutoMigrate(&deadlineSnapshot{}); err != nil {
+		return err
+	}
+	for _, column := range snapshotAssignmentColumns {
+		if err := tx.Migrator().AddColumn(&snapshotAssignment{}, column); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func dropDeadlineSnapshots(tx *gorm.DB) error {
+	for _, column := range snapshotAssignmentColumns {
+		if err := tx.Migrator().DropColumn(&snapshotAssignment{}, column); err != nil {
+			return err
+		}
+	}
+	return tx.Migrator().DropTable(&deadlineSnapshot{})
+}
@@ -0,0 +1,80 @@
+package database
+
+import "gorm.io/gorm"
+
+// Assignments may have hidden tests, whose score, build log and test results
+// are recorded separately for submissions and their attempts, and build jobs
+// may build the commit of a deadline snapshot.
+
+type hiddenTestsAssignment struct {
+	ID               uint64
+	HiddenTests      string
+	HiddenAtDeadline bool
+}
+
+type hiddenResultsSubmission struct {
+	ID                 uint64
+	HiddenScore        uint32
+	HiddenScoreObjects string
+	HiddenBuildInfo    string
+}
+
+type hiddenResultsAttempt struct {
+	ID                 uint64
+	HiddenScore        uint32
+	HiddenScoreObjects string
+	HiddenBuildInfo    string
+}
+
+type hiddenTestResult struct {
+	ID     uint64
+	Hidden bool
+}
+
+type deadlineBuildJob struct {
+	ID       uint64
+	Deadline bool
+}
+
+func (hiddenTestsAssignment) TableName() string   { return "assignments" }
+func (hiddenResultsSubmission) TableName() string { return "submissions" }
+func (hiddenResultsAttempt) TableName() string    { return "submission_attempts" }
+func (hiddenTestResult) TableName() string        { return "test_results" }
+func (deadlineBuildJob) TableName() string        { return "build_jobs" }
+
+// hiddenTestsColumns are the columns added to each table.
+var hiddenTestsColumns = []struct {
+	model   interface{}
+	columns []string
+}{
+	{&hiddenTestsAssignment{}, []string{"HiddenTests", "HiddenAtDeadline"}},
+	{&hiddenResultsSubmission{}, []string{"HiddenScore", "HiddenScoreObjects", "HiddenBuildInfo"}},
+	{&hiddenResultsAttempt{}, []string{"HiddenScore", "HiddenScoreObjects", "HiddenBuildInfo"}},
+	{&hiddenTestResult{}, []string{"Hidden"}},
+	{&deadlineBuildJob{}, []string{"Deadline"}},
+}
+
+func createHiddenTests(tx *gorm.DB) error {
+	migrator := tx.Migrator()
+	for _, table := range hiddenTestsColumns {
+		for _, column := range table.columns {
+			if err := migrator.AddColumn(table.model, column); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func dropHiddenTests(tx *gorm.DB) error {
+	migrator := tx.Migrator()
+	for i := len(hiddenTestsColumns) - 1; i >= 0; i-- {
+		table := hiddenTestsColumns[i]
+		for _, column := range table.columns {
+			if err := migrator.DropColumn(table.model, column); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+package database
+
+import "gorm.io/gorm"
+
+// The initial schema is the schema created by AutoMigrate before versioned
+// migrations were introduced. The tables are declared by copies of the pb
+// types as they were at the time, so that later changes to the pb types do
+// not change this migration. Applying it to a database created by
+// AutoMigrate leaves the existing tables and their data unchanged.
+
+type initialUser struct {
+	ID        uint64
+	IsAdmin   bool
+	Name      string
+	StudentID string
+	Email     string
+	AvatarURL string
+	Login     string
+}
+
+type initialRemoteIdentity struct {
+	ID          uint64
+	Provider    string `gorm:"uniqueIndex:uid_provider_remote_id"`
+	RemoteID    uint64 `gorm:"uniqueIndex:uid_provider_remote_id"`
+	AccessToken string
+	UserID      uint64
+}
+
+type initialCourse struct {
+	ID               uint64
+	CourseCreatorID  uint64
+	Name             string
+	Code             string
+	Year             uint32
+	Tag              string
+	Provider         string
+	OrganizationID   uint64
+	OrganizationPath string
+	SlipDays         uint32
+	Enrolled         int32
+}
+
+type initialEnrollment struct {
+	ID                uint64
+	CourseID          uint64 `gorm:"uniqueIndex:idx_unique_enrollment"`
+	UserID            uint64 `gorm:"uniqueIndex:idx_unique_enrollment"`
+	GroupID           uint64
+	HasTeacherScopes  bool
+	Status            int32
+	State             int32
+	SlipDaysRemaining uint32
+	LastActivityDate  string
+	TotalApproved     uint64
+}
+
+type initialAssignment struct {
+	ID               uint64
+	CourseID         uint64
+	Name             string
+	ScriptFile       string
+	Deadline         string
+	AutoApprove      bool
+	Order            uint32
+	IsGroupLab       bool
+	ScoreLimit       uint32
+	Reviewers        uint32
+	SkipTests        bool
+	ContainerTimeout uint32
+}
+
+type initialSubmission struct {
+	ID           uint64
+	AssignmentID uint64
+	UserID       uint64
+	GroupID      uint64
+	Score        uint32
+	ScoreObjects string
+	BuildInfo    string
+	CommitHash   string
+	Released     bool
+	Status       int32
+	ApprovedDate string
+}
+
+type initialGroup struct {
+	ID       uint64
+	Name     string `gorm:"uniqueIndex:idx_unique_group_name"`
+	CourseID uint64 `gorm:"uniqueIndex:idx_unique_group_name"`
+	TeamID   uint64
+	Status   int32
+}
+
+type initialGroupUser struct {
+	GroupID uint64 `gorm:"primaryKey;autoIncrement:false"`
+	UserID  uint64 `gorm:"primaryKey;autoIncrement:false"`
+}
+
+type initialRepository struct {
+	ID             uint64
+	OrganizationID uint64 `gorm:"uniqueIndex:uid_gid_org_type"`
+	RepositoryID   uint64
+	UserID         uint64 `gorm:"uniqueIndex:uid_gid_org_type"`
+	GroupID        uint64 `gorm:"uniqueIndex:uid_gid_org_type"`
+	HTMLURL        string
+	RepoType       int32 `gorm:"uniqueIndex:uid_gid_org_type"`
+}
+
+type initialUsedSlipDays struct {
+	ID           uint64
+	EnrollmentID uint64
+	AssignmentID uint64
+	UsedSlipDays uint32
+}
+
+type initialGradingBenchmark struct {
+	ID           uint64
+	AssignmentID uint64
+	Heading      string
+	Comment      string
+}
+
+type initialGradingCriterion struct {
+	ID          uint64
+	Points      uint64
+	BenchmarkID uint64
+	Description string
+	Grade       int32
+	Comment     string
+}
+
+type initialReview struct {
+	ID           uint64
+	SubmissionID uint64
+	ReviewerID   uint64
+	Review       string
+	Feedback     string
+	Ready        bool
+	Score        uint64
+	Edited       string
+}
+
+type initialBuildJob struct {
+	ID           uint64
+	CourseID     uint64
+	AssignmentID uint64
+	RepositoryID uint64
+	CommitID     string
+	JobOwner     string
+	Status       int32
+	QueuedDate   string
+	StartedDate  string
+	FinishedDate string
+	ErrorMessage string
+}
+
+func (initialUser) TableName() string             { return "users" }
+func (initialRemoteIdentity) TableName() string   { return "remote_identities" }
+func (initialCourse) TableName() string           { return "courses" }
+func (initialEnrollment) TableName() string       { return "enrollments" }
+func (initialAssignment) TableName() string       { return "assignments" }
+func (initialSubmission) TableName() string       { return "submissions" }
+func (initialGroup) TableName() string            { return "groups" }
+func (initialGroupUser) TableName() string        { return "group_users" }
+func (initialRepository) TableName() string       { return "repositories" }
+func (initialUsedSlipDays) TableName() string     { return "used_slip_days" }
+func (initialGradingBenchmark) TableName() string { return "grading_benchmarks" }
+func (initialGradingCriterion) TableName() string { return "grading_criterions" }
+func (initialReview) TableName() string           { return "reviews" }
+func (initialBuildJob) TableName() string         { return "build_jobs" }
+
+var initialSchema = []interface{}{
+	&initialUser{},
+	&initialRemoteIdentity{},
+	&initialCourse{},
+	&initialEnrollment{},
+	&initialAssignment{},
+	&initialSubmission{},
+	&initialGroup{},
+	&initialGroupUser{},
+	&initialRepository{},
+	&initialUsedSlipDays{},
+	&initialGradingBenchmark{},
+	&initialGradingCriterion{},
+	&initialReview{},
+	&initialBuildJob{},
+}
+
+func createInitialSchema(tx *gorm.DB) error {
+	return tx.AutoMigrate(initialSchema...)
+}
+
+func dropInitialSchema(tx *gorm.DB) error {
+	return tx.Migrator().DropTable(initialSchema...)
+}
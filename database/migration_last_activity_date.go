@@ -0,0 +1,80 @@
+package database
+
+import (
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// The last activity date of enrollments was stored as '02 Jan', without a year,
+// which cannot be compared with other dates. It is now stored as '2006-01-02';
+// the year of existing dates is the year of the course, or the year before
+// if the date would otherwise be in the future.
+
+const (
+	legacyActivityLayout = "02 Jan"
+	activityLayout       = "2006-01-02"
+)
+
+type activityEnrollment struct {
+	ID               uint64
+	LastActivityDate string
+	Year             uint32
+}
+
+func isoLastActivityDates(tx *gorm.DB) error {
+	now := time.Now()
+	return convertLastActivityDates(tx, func(e *activityEnrollment) (string, error) {
+		if _, err := time.Parse(activityLayout, e.LastActivityDate); err == nil {
+			return e.LastActivityDate, nil
+		}
+		t, err := time.Parse(legacyActivityLayout, e.LastActivityDate)
+		if err != nil {
+			return "", err
+		}
+		date := time.Date(int(e.Year), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+		if date.After(now) {
+			date = date.AddDate(-1, 0, 0)
+		}
+		return date.Format(activityLayout), nil
+	})
+}
+
+func legacyLastActivityDates(tx *gorm.DB) error {
+	return convertLastActivityDates(tx, func(e *activityEnrollment) (string, error) {
+		t, err := time.Parse(activityLayout, e.LastActivityDate)
+		if err != nil {
+			return "", err
+		}
+		return t.Format(legacyActivityLayout), nil
+	})
+}
+
+// convertLastActivityDates replaces the non-empty last activity dates of all enrollments with the converted dates.
+// Dates that cannot be converted are logged and cleared, so that they do not fail the migration.
+func convertLastActivityDates(tx *gorm.DB, convert func(*activityEnrollment) (string, error)) error {
+	var enrollments []*activityEnrollment
+	if err := tx.Table("enrollments").
+		Select("enrollments.id, enrollments.last_activity_date, courses.year").
+		Joins("JOIN courses ON courses.id = enrollments.course_id").
+		Where("enrollments.last_activity_date <> ''").
+		Scan(&enrollments).Error; err != nil {
+		return err
+	}
+	for _, e := range enrollments {
+		date, err := convert(e)
+		if err != nil {
+			// the date cannot be recovered; clear it, as for enrollments without activity
+			log.Printf("enrollment %d: clearing invalid last activity date %q: %v", e.ID, e.LastActivityDate, err)
+			date = ""
+		}
+		if date == e.LastActivityDate {
+			continue
+		}
+		if err := tx.Table("enrollments").Where("id = ?", e.ID).Update("last_activity_date", date).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package database
+
+import "gorm.io/gorm"
+
+// Courses and assignments have late policies, and submissions and their attempts
+// store the raw score before any late penalty besides the effective score.
+// Existing courses keep using slip days, and the raw score of existing
+// submissions and attempts is their score.
+
+type latePolicyCourse struct {
+	ID          uint64
+	LatePolicy  int32
+	LatePenalty uint32
+}
+
+type latePolicyAssignment struct {
+	ID          uint64
+	LatePolicy  int32
+	LatePenalty uint32
+}
+
+type rawScoreSubmission struct {
+	ID       uint64
+	RawScore uint32
+}
+
+type rawScoreAttempt struct {
+	ID       uint64
+	RawScore uint32
+}
+
+func (latePolicyCourse) TableName() string     { return "courses" }
+func (latePolicyAssignment) TableName() string { return "assignments" }
+func (rawScoreSubmission) TableName() string   { return "submissions" }
+func (rawScoreAttempt) TableName() string      { return "submission_attempts" }
+
+func createLatePolicies(tx *gorm.DB) error {
+	migrator := tx.Migrator()
+	for _, model := range []interface{}{&latePolicyCourse{}, &latePolicyAssignment{}} {
+		for _, column := range []string{"LatePolicy", "LatePenalty"} {
+			if err := migrator.AddColumn(model, column); err != nil {
+				return err
+			}
+		}
+	}
+	for _, model := range []interface{}{&rawScoreSubmission{}, &rawScoreAttempt{}} {
+		if err := migrator.AddColumn(model, "RawScore"); err != nil {
+			return err
+		}
+		if err := tx.Model(model).Where("1 = 1").Update("raw_score", gorm.Expr("score")).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func dropLatePolicies(tx *gorm.DB) error {
+	migrator := tx.Migrator()
+	for _, model := range []interface{}{&rawScoreAttempt{}, &rawScoreSubmission{}} {
+		if err := migrator.DropColumn(model, "RawScore"); err != nil {
+			return err
+		}
+	}
+	for _, model := range []interface{}{&latePolicyAssignment{}, &latePolicyCourse{}} {
+		for _, column := range []string{"LatePenalty", "LatePolicy"} {
+			if err := migrator.DropColumn(model, column); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
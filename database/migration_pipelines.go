@@ -0,0 +1,21 @@
+package database
+
+import "gorm.io/gorm"
+
+// Assignments may run their tests in a pipeline of steps
+// described in the tests repository instead of a script file.
+
+type pipelineAssignment struct {
+	ID       uint64
+	Pipeline string
+}
+
+func (pipelineAssignment) TableName() string { return "assignments" }
+
+func createPipelines(tx *gorm.DB) error {
+	return tx.Migrator().AddColumn(&pipelineAssignment{}, "Pipeline")
+}
+
+func dropPipelines(tx *gorm.DB) error {
+	return tx.Migrator().DropColumn(&pipelineAssignment{}, "Pipeline")
+}
@@ -0,0 +1,39 @@
+package database
+
+import "gorm.io/gorm"
+
+// Assignments may limit the memory, CPUs, processes, disk usage and network
+// access of the containers running their tests, and run the tests with a
+// read-only root filesystem.
+
+type resourceLimitsAssignment struct {
+	ID              uint64
+	MemoryLimit     uint64
+	CpuLimit        float64
+	PidsLimit       uint32
+	DiskLimit       uint64
+	NetworkDisabled bool
+	ReadOnlyRoot    bool
+}
+
+func (resourceLimitsAssignment) TableName() string { return "assignments" }
+
+var resourceLimitsColumns = []string{"MemoryLimit", "CpuLimit", "PidsLimit", "DiskLimit", "NetworkDisabled", "ReadOnlyRoot"}
+
+func createResourceLimits(tx *gorm.DB) error {
+	for _, column := range resourceLimitsColumns {
+		if err := tx.Migrator().AddColumn(&resourceLimitsAssignment{}, column); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func dropResourceLimits(tx *gorm.DB) error {
+	for _, column := range resourceLimitsColumns {
+		if err := tx.Migrator().DropColumn(&resourceLimitsAssignment{}, column); err != nil {
+			return err
+		}
+	}
+	return nil
+}
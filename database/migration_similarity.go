@@ -0,0 +1,39 @@
+package database
+
+import "gorm.io/gorm"
+
+// The similarity tables store the pairs of similar submissions
+// found by the similarity detection, and their matching code regions.
+
+type similarityPair struct {
+	ID           uint64
+	AssignmentID uint64
+	SubmissionA  uint64
+	SubmissionB  uint64
+	OwnerA       string
+	OwnerB       string
+	Similarity   uint32
+	CheckedDate  string
+}
+
+type similarityMatch struct {
+	ID         uint64
+	PairID     uint64
+	FileA      string
+	StartLineA uint32
+	EndLineA   uint32
+	FileB      string
+	StartLineB uint32
+	EndLineB   uint32
+}
+
+func (similarityPair) TableName() string  { return "similarity_pairs" }
+func (similarityMatch) TableName() string { return "similarity_matches" }
+
+func createSimilarityTables(tx *gorm.DB) error {
+	return tx.AutoMigrate(&similarityPair{}, &similarityMatch{})
+}
+
+func dropSimilarityTables(tx *gorm.DB) error {
+	return tx.Migrator().DropTable(&similarityPair{}, &similarityMatch{})
+}
@@ -0,0 +1,78 @@
+package database
+
+import (
+	"encoding/json"
+
+	"gorm.io/gorm"
+)
+
+// The test results table stores the result of each test case of a submission attempt.
+// The test results of existing attempts are extracted from their score objects,
+// which do not record the duration and failure output of the tests.
+
+type testResult struct {
+	ID            uint64
+	AttemptID     uint64 `gorm:"index"`
+	SubmissionID  uint64
+	AssignmentID  uint64 `gorm:"index"`
+	TestName      string
+	Score         int32
+	MaxScore      int32
+	Weight        int32
+	Duration      int64
+	FailureOutput string
+}
+
+func (testResult) TableName() string { return "test_results" }
+
+// scoreObject is the JSON encoding of a test score in the score objects of an attempt.
+type scoreObject struct {
+	TestName string
+	Score    int32
+	MaxScore int32
+	Weight   int32
+}
+
+func createTestResults(tx *gorm.DB) error {
+	if err := tx.AutoMigrate(&testResult{}); err != nil {
+		return err
+	}
+	var attempts []struct {
+		ID           uint64
+		SubmissionID uint64
+		AssignmentID uint64
+		ScoreObjects string
+	}
+	if err := tx.Table("submission_attempts").
+		Select("submission_attempts.id, submission_attempts.submission_id, submissions.assignment_id, submission_attempts.score_objects").
+		Joins("JOIN submissions ON submissions.id = submission_attempts.submission_id").
+		Where("submission_attempts.score_objects <> ''").
+		Scan(&attempts).Error; err != nil {
+		return err
+	}
+	for _, attempt := range attempts {
+		var scores []*scoreObject
+		if err := json.Unmarshal([]byte(attempt.ScoreObjects), &scores); err != nil {
+			// score objects that cannot be parsed are left out
+			continue
+		}
+		for _, sc := range scores {
+			if err := tx.Create(&testResult{
+				AttemptID:    attempt.ID,
+				SubmissionID: attempt.SubmissionID,
+				AssignmentID: attempt.AssignmentID,
+				TestName:     sc.TestName,
+				Score:        sc.Score,
+				MaxScore:     sc.MaxScore,
+				Weight:       sc.Weight,
+			}).Error; err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func dropTestResults(tx *gorm.DB) error {
+	return tx.Migrator().DropTable(&testResult{})
+}
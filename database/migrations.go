@@ -0,0 +1,282 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrIrreversibleMigration is returned when rolling back a migration without a down migration.
+	ErrIrreversibleMigration = errors.New("migration cannot be rolled back")
+	// ErrUnknownSchemaVersion is returned when the database has been migrated
+	// to a schema version that is unknown to this version of QuickFeed.
+	ErrUnknownSchemaVersion = errors.New("database has unknown schema version")
+)
+
+// Migration is a versioned change of the database schema or its data.
+// Up applies the change and Down reverts it. Each runs in a transaction
+// together with the update of the schema version table.
+// Migrations that transform data should do so with the tx passed to them,
+// and must not depend on the pb types, since these change over time.
+type Migration struct {
+	Version     uint
+	Description string
+	Up          func(tx *gorm.DB) error
+	Down        func(tx *gorm.DB) error
+}
+
+// SchemaVersion records a migration that has been applied to the database.
+type SchemaVersion struct {
+	Version     uint `gorm:"primaryKey;autoIncrement:false"`
+	Description string
+	AppliedAt   time.Time
+}
+
+// MigrationStatus describes a migration and whether it has been applied to the database.
+type MigrationStatus struct {
+	Version     uint
+	Description string
+	Applied     bool
+	AppliedAt   time.Time
+}
+
+// Migrations are the migrations of the QuickFeed database in the order they are applied.
+// New migrations must be appended with the next version number.
+// A released migration must never be changed, since it may already have been applied.
+var Migrations = []Migration{
+	{
+		Version:     1,
+		Description: "create initial schema",
+		Up:          createInitialSchema,
+		Down:        dropInitialSchema,
+	},
+	{
+		Version:     2,
+		Description: "create similarity detection tables",
+		Up:          createSimilarityTables,
+		Down:        dropSimilarityTables,
+	},
+	{
+		Version:     3,
+		Description: "keep all submission attempts",
+		Up:          createSubmissionAttempts,
+		Down:        dropSubmissionAttempts,
+	},
+	{
+		Version:     4,
+		Description: "store test results of attempts",
+		Up:          createTestResults,
+		Down:        dropTestResults,
+	},
+	{
+		Version:     5,
+		Description: "create deadline extensions and course grace periods",
+		Up:          createDeadlineExtensions,
+		Down:        dropDeadlineExtensions,
+	},
+	{
+		Version:     6,
+		Description: "add late policies and raw scores",
+		Up:          createLatePolicies,
+		Down:        dropLatePolicies,
+	},
+	{
+		Version:     7,
+		Description: "create deadline snapshots and release dates",
+		Up:          createDeadlineSnapshots,
+		Down:        dropDeadlineSnapshots,
+	},
+	{
+		Version:     8,
+		Description: "add hidden tests and deadline builds",
+		Up:          createHiddenTests,
+		Down:        dropHiddenTests,
+	},
+	{
+		Version:     9,
+		Description: "create build quotas",
+		Up:          createBuildQuotas,
+		Down:        dropBuildQuotas,
+	},
+	{
+		Version:     10,
+		Description: "add container resource limits",
+		Up:          createResourceLimits,
+		Down:        dropResourceLimits,
+	},
+	{
+		Version:     11,
+		Description: "add build pipelines",
+		Up:          createPipelines,
+		Down:        dropPipelines,
+	},
+	{
+		Version:     12,
+		Description: "store last activity dates as ISO dates",
+		Up:          isoLastActivityDates,
+		Down:        legacyLastActivityDates,
+	},
+}
+
+// Migrator applies and reverts migrations of a database.
+type Migrator struct {
+	conn       *gorm.DB
+	migrations []Migration
+}
+
+// NewMigrator returns a migrator applying the given migrations to db,
+// creating the schema version table if necessary.
+// The migrations must be ordered by increasing version numbers.
+func NewMigrator(db *GormDB, migrations []Migration) (*Migrator, error) {
+	var previous uint
+	for _, m := range migrations {
+		if m.Version <= previous {
+			return nil, fmt.Errorf("migration %d: version must be greater than %d", m.Version, previous)
+		}
+		if m.Up == nil {
+			return nil, fmt.Errorf("migration %d: missing up migration", m.Version)
+		}
+		previous = m.Version
+	}
+	if err := db.conn.AutoMigrate(&SchemaVersion{}); err != nil {
+		return nil, err
+	}
+	return &Migrator{conn: db.conn, migrations: migrations}, nil
+}
+
+// Version returns the schema version of the database,
+// that is, the version of the most recently applied migration.
+// Version 0 means that no migrations have been applied.
+func (m *Migrator) Version() (uint, error) {
+	var versions []*SchemaVersion
+	if err := m.conn.Order("version desc").Limit(1).Find(&versions).Error; err != nil {
+		return 0, err
+	}
+	if len(versions) == 0 {
+		return 0, nil
+	}
+	return versions[0].Version, nil
+}
+
+// Status returns the status of all known migrations.
+func (m *Migrator) Status() ([]*MigrationStatus, error) {
+	applied, err := m.applied()
+	if err != nil {
+		return nil, err
+	}
+	status := make([]*MigrationStatus, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		version, ok := applied[migration.Version]
+		status = append(status, &MigrationStatus{
+			Version:     migration.Version,
+			Description: migration.Description,
+			Applied:     ok,
+			AppliedAt:   version.GetAppliedAt(),
+		})
+	}
+	return status, nil
+}
+
+// Migrate applies all pending migrations up to and including the given version,
+// and returns the applied migrations. If version is 0, all pending migrations are applied.
+func (m *Migrator) Migrate(version uint) ([]Migration, error) {
+	applied, err := m.applied()
+	if err != nil {
+		return nil, err
+	}
+	var done []Migration
+	for _, migration := range m.migrations {
+		if version > 0 && migration.Version > version {
+			break
+		}
+		if _, ok := applied[migration.Version]; ok {
+			continue
+		}
+		if err := m.conn.Transaction(func(tx *gorm.DB) error {
+			if err := migration.Up(tx); err != nil {
+				return err
+			}
+			return tx.Create(&SchemaVersion{
+				Version:     migration.Version,
+				Description: migration.Description,
+				AppliedAt:   time.Now(),
+			}).Error
+		}); err != nil {
+			return done, fmt.Errorf("migration %d (%s) failed: %w", migration.Version, migration.Description, err)
+		}
+		done = append(done, migration)
+	}
+	return done, nil
+}
+
+// Rollback reverts the given number of most recently applied migrations,
+// and returns the reverted migrations.
+func (m *Migrator) Rollback(steps int) ([]Migration, error) {
+	applied, err := m.applied()
+	if err != nil {
+		return nil, err
+	}
+	versions := make([]uint, 0, len(applied))
+	for version := range applied {
+		versions = append(versions, version)
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] > versions[j] })
+
+	var done []Migration
+	for i := 0; i < steps && i < len(versions); i++ {
+		migration := m.migration(versions[i])
+		if migration.Down == nil {
+			return done, fmt.Errorf("migration %d (%s): %w", migration.Version, migration.Description, ErrIrreversibleMigration)
+		}
+		if err := m.conn.Transaction(func(tx *gorm.DB) error {
+			if err := migration.Down(tx); err != nil {
+				return err
+			}
+			return tx.Delete(&SchemaVersion{Version: migration.Version}).Error
+		}); err != nil {
+			return done, fmt.Errorf("rollback of migration %d (%s) failed: %w", migration.Version, migration.Description, err)
+		}
+		done = append(done, *migration)
+	}
+	return done, nil
+}
+
+// applied returns the applied migrations by version.
+// It returns ErrUnknownSchemaVersion if an applied migration is unknown to the migrator.
+func (m *Migrator) applied() (map[uint]*SchemaVersion, error) {
+	var versions []*SchemaVersion
+	if err := m.conn.Find(&versions).Error; err != nil {
+		return nil, err
+	}
+	applied := make(map[uint]*SchemaVersion)
+	for _, version := range versions {
+		if m.migration(version.Version) == nil {
+			return nil, fmt.Errorf("%w %d", ErrUnknownSchemaVersion, version.Version)
+		}
+		applied[version.Version] = version
+	}
+	return applied, nil
+}
+
+// migration returns the migration with the given version, or nil if there is none.
+func (m *Migrator) migration(version uint) *Migration {
+	for i := range m.migrations {
+		if m.migrations[i].Version == version {
+			return &m.migrations[i]
+		}
+	}
+	return nil
+}
+
+// GetAppliedAt returns the time the migration was applied,
+// or the zero time if v is nil.
+func (v *SchemaVersion) GetAppliedAt() time.Time {
+	if v == nil {
+		return time.Time{}
+	}
+	return v.AppliedAt
+}
@@ -0,0 +1,377 @@
+package database_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/log"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// newFixtureDB creates an SQLite database file from the given SQL file in testdata,
+// and returns the path of the database file. If sqlFile is empty, the database is empty.
+func newFixtureDB(t *testing.T, sqlFile string) (string, func()) {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "testdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cleanup := func() { os.RemoveAll(dir) }
+	path := filepath.Join(dir, "qf.db")
+	conn := openSQLite(t, path)
+	if sqlFile == "" {
+		return path, cleanup
+	}
+	content, err := ioutil.ReadFile(filepath.Join("testdata", sqlFile))
+	if err != nil {
+		cleanup()
+		t.Fatal(err)
+	}
+	var lines []string
+	for _, line := range strings.Split(string(content), "\n") {
+		if !strings.HasPrefix(line, "--") {
+			lines = append(lines, line)
+		}
+	}
+	for _, stmt := range strings.Split(strings.Join(lines, "\n"), ";\n") {
+		if stmt = strings.TrimSpace(stmt); stmt == "" {
+			continue
+		}
+		if err := conn.Exec(stmt).Error; err != nil {
+			cleanup()
+			t.Fatal(err)
+		}
+	}
+	return path, cleanup
+}
+
+// openSQLite opens the SQLite database file directly, bypassing the migrations.
+func openSQLite(t *testing.T, path string) *gorm.DB {
+	t.Helper()
+	conn, err := gorm.Open(sqlite.Open(path), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return conn
+}
+
+func newMigrator(t *testing.T, path string, migrations []database.Migration) (*database.GormDB, *database.Migrator) {
+	t.Helper()
+	db, err := database.OpenGormDB(path, log.Zap(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	migrator, err := database.NewMigrator(db, migrations)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db, migrator
+}
+
+func checkVersion(t *testing.T, migrator *database.Migrator, want uint) {
+	t.Helper()
+	version, err := migrator.Version()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != want {
+		t.Errorf("Version() = %d, want %d", version, want)
+	}
+}
+
+func latestVersion() uint {
+	return database.Migrations[len(database.Migrations)-1].Version
+}
+
+func TestMigrateLegacyDatabase(t *testing.T) {
+	path, cleanup := newFixtureDB(t, "legacy.sql")
+	defer cleanup()
+
+	db, migrator := newMigrator(t, path, database.Migrations)
+	checkVersion(t, migrator, 0)
+	applied, err := migrator.Migrate(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(applied) != len(database.Migrations) {
+		t.Errorf("Migrate() applied %d migrations, want %d", len(applied), len(database.Migrations))
+	}
+	checkVersion(t, migrator, latestVersion())
+
+	// the data of the legacy database must be preserved
+	user, err := db.GetUserByRemoteIdentity(&pb.RemoteIdentity{Provider: "fake", RemoteID: 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.GetLogin() != "student" {
+		t.Errorf("have user %q, want %q", user.GetLogin(), "student")
+	}
+	submission, err := db.GetSubmission(&pb.Submission{AssignmentID: 1, UserID: user.GetID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if submission.GetScore() != 90 || submission.GetStatus() != pb.Submission_APPROVED {
+		t.Errorf("have submission %v, want score 90 and status APPROVED", submission)
+	}
+	// the legacy submission's results become its graded attempt
+	attempts, err := db.GetSubmissionAttempts(submission.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(attempts) != 1 || attempts[0].GetID() != submission.GetGradedAttemptID() || attempts[0].GetScore() != 90 {
+		t.Errorf("have attempts %v, want a single graded attempt with score 90", attempts)
+	}
+	// and the test results are extracted from its score objects
+	results := submission.GetTestResults()
+	if len(results) != 1 || results[0].GetTestName() != "TestFib" || results[0].GetScore() != 9 ||
+		results[0].GetMaxScore() != 10 || results[0].GetAssignmentID() != 1 {
+		t.Errorf("have test results %v, want the result of TestFib", results)
+	}
+
+	status, err := migrator.Status()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range status {
+		if !s.Applied || s.AppliedAt.IsZero() {
+			t.Errorf("migration %d (%s) not applied", s.Version, s.Description)
+		}
+	}
+
+	// migrating again is a no-op
+	if applied, err = migrator.Migrate(0); err != nil || len(applied) != 0 {
+		t.Errorf("Migrate() = %d migrations, %v, want 0 migrations", len(applied), err)
+	}
+
+	// rolling back all migrations drops the tables
+	if _, err := migrator.Rollback(len(database.Migrations)); err != nil {
+		t.Fatal(err)
+	}
+	checkVersion(t, migrator, 0)
+	if _, err := db.GetUsers(); err == nil {
+		t.Error("GetUsers() succeeded after rollback, want error")
+	}
+
+	// and migrating forward again creates empty tables
+	if _, err := migrator.Migrate(0); err != nil {
+		t.Fatal(err)
+	}
+	users, err := db.GetUsers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 0 {
+		t.Errorf("have %d users, want 0", len(users))
+	}
+}
+
+func TestMigrateDataTransformation(t *testing.T) {
+	path, cleanup := newFixtureDB(t, "legacy.sql")
+	defer cleanup()
+
+	// the migration storing last activity dates as ISO dates transforms the legacy dates
+	const version = 12
+	db, migrator := newMigrator(t, path, database.Migrations)
+
+	lastActivityDate := func() string {
+		t.Helper()
+		enrollment, err := db.GetEnrollmentByCourseAndUser(1, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return enrollment.GetLastActivityDate()
+	}
+
+	// migrate to the version before the data transformation
+	if _, err := migrator.Migrate(version - 1); err != nil {
+		t.Fatal(err)
+	}
+	checkVersion(t, migrator, version-1)
+	if got := lastActivityDate(); got != "05 Mar" {
+		t.Errorf("have last activity date %q, want %q", got, "05 Mar")
+	}
+
+	if _, err := migrator.Migrate(version); err != nil {
+		t.Fatal(err)
+	}
+	checkVersion(t, migrator, version)
+	if got := lastActivityDate(); got != "2021-03-05" {
+		t.Errorf("have last activity date %q, want %q", got, "2021-03-05")
+	}
+
+	reverted, err := migrator.Rollback(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reverted) != 1 || reverted[0].Version != version {
+		t.Errorf("Rollback() = %v, want migration %d", reverted, version)
+	}
+	checkVersion(t, migrator, version-1)
+	if got := lastActivityDate(); got != "05 Mar" {
+		t.Errorf("have last activity date %q, want %q", got, "05 Mar")
+	}
+}
+
+func TestMigrateUnparseableLastActivityDate(t *testing.T) {
+	path, cleanup := newFixtureDB(t, "legacy.sql")
+	defer cleanup()
+	if err := openSQLite(t, path).Exec("UPDATE enrollments SET last_activity_date = 'someday' WHERE id = 1").Error; err != nil {
+		t.Fatal(err)
+	}
+
+	// the migration storing last activity dates as ISO dates clears unparseable dates
+	const version = 12
+	db, migrator := newMigrator(t, path, database.Migrations)
+	if _, err := migrator.Migrate(version); err != nil {
+		t.Fatal(err)
+	}
+	checkVersion(t, migrator, version)
+	for _, want := range []struct {
+		userID           uint64
+		lastActivityDate string
+	}{
+		{userID: 1, lastActivityDate: ""},
+		{userID: 2, lastActivityDate: "2021-03-05"},
+	} {
+		enrollment, err := db.GetEnrollmentByCourseAndUser(1, want.userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := enrollment.GetLastActivityDate(); got != want.lastActivityDate {
+			t.Errorf("user %d: have last activity date %q, want %q", want.userID, got, want.lastActivityDate)
+		}
+	}
+}
+
+func TestMigrateFailure(t *testing.T) {
+	path, cleanup := newFixtureDB(t, "")
+	defer cleanup()
+
+	failing := database.Migration{
+		Version:     latestVersion() + 1,
+		Description: "fail after creating a table",
+		Up: func(tx *gorm.DB) error {
+			if err := tx.Exec("CREATE TABLE partial (id integer)").Error; err != nil {
+				return err
+			}
+			return errors.New("failure")
+		},
+	}
+	migrations := append(append([]database.Migration{}, database.Migrations...), failing)
+	_, migrator := newMigrator(t, path, migrations)
+
+	applied, err := migrator.Migrate(0)
+	if err == nil {
+		t.Fatal("Migrate() succeeded, want error")
+	}
+	if len(applied) != len(database.Migrations) {
+		t.Errorf("Migrate() applied %d migrations, want %d", len(applied), len(database.Migrations))
+	}
+	checkVersion(t, migrator, latestVersion())
+	if openSQLite(t, path).Migrator().HasTable("partial") {
+		t.Error("failed migration was not rolled back")
+	}
+
+	// a migration without a down migration cannot be rolled back
+	irreversible := database.Migration{
+		Version:     latestVersion() + 1,
+		Description: "irreversible",
+		Up:          func(tx *gorm.DB) error { return nil },
+	}
+	migrations = append(append([]database.Migration{}, database.Migrations...), irreversible)
+	_, migrator = newMigrator(t, path, migrations)
+	if _, err := migrator.Migrate(0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := migrator.Rollback(1); !errors.Is(err, database.ErrIrreversibleMigration) {
+		t.Errorf("Rollback() = %v, want %v", err, database.ErrIrreversibleMigration)
+	}
+	checkVersion(t, migrator, irreversible.Version)
+
+	// the database has been migrated beyond the QuickFeed migrations
+	_, migrator = newMigrator(t, path, database.Migrations)
+	if _, err := migrator.Migrate(0); !errors.Is(err, database.ErrUnknownSchemaVersion) {
+		t.Errorf("Migrate() = %v, want %v", err, database.ErrUnknownSchemaVersion)
+	}
+}
+
+func TestNewMigratorInvalidMigrations(t *testing.T) {
+	path, cleanup := newFixtureDB(t, "")
+	defer cleanup()
+	db, err := database.OpenGormDB(path, log.Zap(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	up := func(tx *gorm.DB) error { return nil }
+	tests := map[string][]database.Migration{
+		"zero version":      {{Version: 0, Up: up}},
+		"unordered":         {{Version: 2, Up: up}, {Version: 1, Up: up}},
+		"duplicate version": {{Version: 1, Up: up}, {Version: 1, Up: up}},
+		"missing up":        {{Version: 1}},
+	}
+	for name, migrations := range tests {
+		if _, err := database.NewMigrator(db, migrations); err == nil {
+			t.Errorf("NewMigrator(%s) succeeded, want error", name)
+		}
+	}
+}
+
+// TestMigrationsMatchModels checks that the migrations create
+// the tables and columns of all database models.
+func TestMigrationsMatchModels(t *testing.T) {
+	path, cleanup := newFixtureDB(t, "")
+	defer cleanup()
+	if _, err := database.NewGormDB(path, log.Zap(false)); err != nil {
+		t.Fatal(err)
+	}
+
+	conn := openSQLite(t, path)
+	models := []interface{}{
+		&pb.User{},
+		&pb.RemoteIdentity{},
+		&pb.Course{},
+		&pb.Enrollment{},
+		&pb.Assignment{},
+		&pb.Submission{},
+		&pb.Group{},
+		&pb.Repository{},
+		&pb.UsedSlipDays{},
+		&pb.GradingBenchmark{},
+		&pb.GradingCriterion{},
+		&pb.Review{},
+		&pb.BuildJob{},
+		&pb.SimilarityPair{},
+		&pb.SimilarityMatch{},
+		&pb.SubmissionAttempt{},
+		&pb.TestResult{},
+		&pb.DeadlineExtension{},
+		&pb.DeadlineSnapshot{},
+		&pb.BuildQuota{},
+	}
+	for _, model := range models {
+		stmt := &gorm.Statement{DB: conn}
+		if err := stmt.Parse(model); err != nil {
+			t.Fatal(err)
+		}
+		if !conn.Migrator().HasTable(model) {
+			t.Errorf("missing table %s", stmt.Schema.Table)
+			continue
+		}
+		for _, field := range stmt.Schema.Fields {
+			if field.DBName != "" && !conn.Migrator().HasColumn(model, field.DBName) {
+				t.Errorf("missing column %s.%s", stmt.Schema.Table, field.DBName)
+			}
+		}
+	}
+	if !conn.Migrator().HasTable("group_users") {
+		t.Error("missing table group_users")
+	}
+}
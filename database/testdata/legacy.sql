@@ -0,0 +1,25 @@
+-- Database created by AutoMigrate before versioned migrations were introduced.
+CREATE TABLE `assignments` (`id` integer,`course_id` integer,`name` text,`script_file` text,`deadline` text,`auto_approve` numeric,`order` integer,`is_group_lab` numeric,`score_limit` integer,`reviewers` integer,`skip_tests` numeric,`container_timeout` integer,PRIMARY KEY (`id`));
+CREATE TABLE `build_jobs` (`id` integer,`course_id` integer,`assignment_id` integer,`repository_id` integer,`commit_id` text,`job_owner` text,`status` integer,`queued_date` text,`started_date` text,`finished_date` text,`error_message` text,PRIMARY KEY (`id`));
+CREATE TABLE `courses` (`id` integer,`course_creator_id` integer,`name` text,`code` text,`year` integer,`tag` text,`provider` text,`organization_id` integer,`organization_path` text,`slip_days` integer,`enrolled` integer,PRIMARY KEY (`id`));
+CREATE TABLE `enrollments` (`id` integer,`course_id` integer,`user_id` integer,`group_id` integer,`has_teacher_scopes` numeric,`status` integer,`state` integer,`slip_days_remaining` integer,`last_activity_date` text,`total_approved` integer,PRIMARY KEY (`id`));
+CREATE UNIQUE INDEX `idx_unique_enrollment` ON `enrollments`(`course_id`,`user_id`);
+CREATE TABLE `grading_benchmarks` (`id` integer,`assignment_id` integer,`heading` text,`comment` text,PRIMARY KEY (`id`));
+CREATE TABLE `grading_criterions` (`id` integer,`points` integer,`benchmark_id` integer,`description` text,`grade` integer,`comment` text,PRIMARY KEY (`id`));
+CREATE TABLE `group_users` (`group_id` integer,`user_id` integer,PRIMARY KEY (`group_id`,`user_id`));
+CREATE TABLE `groups` (`id` integer,`name` text,`course_id` integer,`team_id` integer,`status` integer,PRIMARY KEY (`id`));
+CREATE UNIQUE INDEX `idx_unique_group_name` ON `groups`(`name`,`course_id`);
+CREATE TABLE `remote_identities` (`id` integer,`provider` text,`remote_id` integer,`access_token` text,`user_id` integer,PRIMARY KEY (`id`));
+CREATE UNIQUE INDEX `uid_provider_remote_id` ON `remote_identities`(`provider`,`remote_id`);
+CREATE TABLE `repositories` (`id` integer,`organization_id` integer,`repository_id` integer,`user_id` integer,`group_id` integer,`html_url` text,`repo_type` integer,PRIMARY KEY (`id`));
+CREATE UNIQUE INDEX `uid_gid_org_type` ON `repositories`(`organization_id`,`user_id`,`group_id`,`repo_type`);
+CREATE TABLE `reviews` (`id` integer,`submission_id` integer,`reviewer_id` integer,`review` text,`feedback` text,`ready` numeric,`score` integer,`edited` text,PRIMARY KEY (`id`));
+CREATE TABLE `submissions` (`id` integer,`assignment_id` integer,`user_id` integer,`group_id` integer,`score` integer,`score_objects` text,`build_info` text,`commit_hash` text,`released` numeric,`status` integer,`approved_date` text,PRIMARY KEY (`id`));
+CREATE TABLE `used_slip_days` (`id` integer,`enrollment_id` integer,`assignment_id` integer,`used_slip_days` integer,PRIMARY KEY (`id`));
+CREATE TABLE `users` (`id` integer,`is_admin` numeric,`name` text,`student_id` text,`email` text,`avatar_url` text,`login` text,PRIMARY KEY (`id`));
+INSERT INTO `users` (`id`,`is_admin`,`name`,`student_id`,`email`,`avatar_url`,`login`) VALUES (1,1,'Admin','1','admin@example.com','','admin'),(2,0,'Student','2','student@example.com','','student');
+INSERT INTO `remote_identities` (`id`,`provider`,`remote_id`,`access_token`,`user_id`) VALUES (1,'fake',10,'token1',1),(2,'fake',20,'token2',2);
+INSERT INTO `courses` (`id`,`course_creator_id`,`name`,`code`,`year`,`tag`,`provider`,`organization_id`,`organization_path`,`slip_days`,`enrolled`) VALUES (1,1,'Distributed Systems','DAT520',2021,'Spring','fake',1,'dat520',5,0);
+INSERT INTO `enrollments` (`id`,`course_id`,`user_id`,`group_id`,`has_teacher_scopes`,`status`,`state`,`slip_days_remaining`,`last_activity_date`,`total_approved`) VALUES (1,1,1,0,1,3,0,5,'',0),(2,1,2,0,0,2,0,5,'05 Mar',1);
+INSERT INTO `assignments` (`id`,`course_id`,`name`,`script_file`,`deadline`,`auto_approve`,`order`,`is_group_lab`,`score_limit`,`reviewers`,`skip_tests`,`container_timeout`) VALUES (1,1,'lab1','go.sh','2021-03-01T23:59:00',1,1,0,80,0,0,0);
+INSERT INTO `submissions` (`id`,`assignment_id`,`user_id`,`group_id`,`score`,`score_objects`,`build_info`,`commit_hash`,`released`,`status`,`approved_date`) VALUES (1,1,2,0,90,'[{"Secret":"hidden","TestName":"TestFib","Score":9,"MaxScore":10,"Weight":1}]','','abc123',0,1,'2021-03-05');
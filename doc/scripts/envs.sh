@@ -5,6 +5,12 @@
 export GITHUB_KEY="KEY"
 export GITHUB_SECRET="SECRET"
 
+# GitLab OAUTH App keys (optional)
+# export GITLAB_KEY="KEY"
+# export GITLAB_SECRET="SECRET"
+# Base URL of a self-hosted GitLab instance; defaults to gitlab.com
+# export GITLAB_URL="https://gitlab.example.com"
+
 # Envoy Config
 export ENVOY_CONFIG="envoy/envoy-example.yaml"
 export DOMAIN="www.example.com"
@@ -0,0 +1,160 @@
+package events
+
+import (
+	"sync"
+)
+
+// DefaultBuildLogBufferSize is the number of build log events buffered
+// for each subscriber, unless another buffer size is given.
+const DefaultBuildLogBufferSize = 1024
+
+// BuildLogEvent is a line of output from a running build job,
+// or the end of the build job's output if Done is true.
+type BuildLogEvent struct {
+	JobID uint64
+	Line  string
+	Done  bool
+}
+
+// BuildLogHub fans out the output of running build jobs to subscribers.
+// The hub keeps the output of each build job until the job ends,
+// so that subscribers joining while a job is running receive all of its output.
+//
+// Like SubmissionHub, publishing never blocks, and a subscriber whose buffer
+// is full is unsubscribed.
+//
+// A nil hub discards all events.
+type BuildLogHub struct {
+	bufferSize int
+
+	mu      sync.Mutex
+	backlog map[uint64][]string // job ID -> output published so far
+	subs    map[*BuildLogSubscription]struct{}
+}
+
+// NewBuildLogHub returns a hub that buffers up to bufferSize events for each
+// subscriber, in addition to the output of running jobs when subscribing.
+// If bufferSize is not positive, DefaultBuildLogBufferSize is used.
+func NewBuildLogHub(bufferSize int) *BuildLogHub {
+	if bufferSize <= 0 {
+		bufferSize = DefaultBuildLogBufferSize
+	}
+	return &BuildLogHub{
+		bufferSize: bufferSize,
+		backlog:    make(map[uint64][]string),
+		subs:       make(map[*BuildLogSubscription]struct{}),
+	}
+}
+
+// Subscribe returns a subscription to the output of the given build jobs.
+// The subscription first receives the output that the jobs have produced so far.
+// The subscription must be closed when no longer used.
+func (h *BuildLogHub) Subscribe(jobIDs ...uint64) *BuildLogSubscription {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	jobs := make(map[uint64]bool)
+	backlog := 0
+	for _, jobID := range jobIDs {
+		jobs[jobID] = true
+		backlog += len(h.backlog[jobID])
+	}
+	sub := &BuildLogSubscription{
+		hub:    h,
+		jobs:   jobs,
+		events: make(chan *BuildLogEvent, h.bufferSize+backlog),
+	}
+	for _, jobID := range jobIDs {
+		for _, line := range h.backlog[jobID] {
+			sub.events <- &BuildLogEvent{JobID: jobID, Line: line}
+		}
+	}
+	h.subs[sub] = struct{}{}
+	return sub
+}
+
+// Publish sends a line of output from the given build job to its subscribers.
+func (h *BuildLogHub) Publish(jobID uint64, line string) {
+	if h == nil {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.backlog[jobID] = append(h.backlog[jobID], line)
+	h.send(&BuildLogEvent{JobID: jobID, Line: line})
+}
+
+// End notifies the subscribers of the given build job that the job has ended,
+// and discards the job's output.
+func (h *BuildLogHub) End(jobID uint64) {
+	if h == nil {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.backlog, jobID)
+	h.send(&BuildLogEvent{JobID: jobID, Done: true})
+}
+
+// Subscribers returns the current number of subscribers.
+func (h *BuildLogHub) Subscribers() int {
+	if h == nil {
+		return 0
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.subs)
+}
+
+// send sends the event to the subscribers of the event's job.
+// The caller must hold h.mu.
+func (h *BuildLogHub) send(event *BuildLogEvent) {
+	for sub := range h.subs {
+		if !sub.jobs[event.JobID] {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			sub.err = ErrSlowSubscriber
+			h.unsubscribe(sub)
+		}
+	}
+}
+
+// unsubscribe removes the subscription and closes its events channel.
+// The caller must hold h.mu.
+func (h *BuildLogHub) unsubscribe(sub *BuildLogSubscription) {
+	if _, ok := h.subs[sub]; ok {
+		delete(h.subs, sub)
+		close(sub.events)
+	}
+}
+
+// BuildLogSubscription is a subscription to the output of build jobs.
+type BuildLogSubscription struct {
+	hub    *BuildLogHub
+	jobs   map[uint64]bool
+	events chan *BuildLogEvent
+	err    error // guarded by hub.mu
+}
+
+// Events returns the channel on which events are delivered.
+// The channel is closed when the subscription is closed.
+func (s *BuildLogSubscription) Events() <-chan *BuildLogEvent {
+	return s.events
+}
+
+// Err returns ErrSlowSubscriber if the subscription was closed by the hub
+// because the subscriber did not keep up, and nil otherwise.
+func (s *BuildLogSubscription) Err() error {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	return s.err
+}
+
+// Close unsubscribes from the hub. Close may be called more than once.
+func (s *BuildLogSubscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	s.hub.unsubscribe(s)
+}
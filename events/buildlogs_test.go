@@ -0,0 +1,72 @@
+package events_test
+
+import (
+	"testing"
+
+	"github.com/autograde/quickfeed/events"
+)
+
+func recvLine(t *testing.T, sub *events.BuildLogSubscription, wantJob uint64, wantLine string) {
+	t.Helper()
+	event := <-sub.Events()
+	if event.JobID != wantJob || event.Line != wantLine || event.Done {
+		t.Errorf("got event %+v, want line %q from job %d", event, wantLine, wantJob)
+	}
+}
+
+func TestBuildLogHubBacklog(t *testing.T) {
+	hub := events.NewBuildLogHub(0)
+	hub.Publish(1, "job 1 line 1")
+	hub.Publish(2, "job 2 line 1")
+
+	// a subscriber joining a running job receives the output so far
+	sub := hub.Subscribe(1)
+	defer sub.Close()
+	recvLine(t, sub, 1, "job 1 line 1")
+
+	hub.Publish(2, "job 2 line 2")
+	hub.Publish(1, "job 1 line 2")
+	recvLine(t, sub, 1, "job 1 line 2")
+
+	hub.End(1)
+	if event := <-sub.Events(); event.JobID != 1 || !event.Done {
+		t.Errorf("got event %+v, want end of job 1", event)
+	}
+
+	// the output of an ended job is discarded
+	late := hub.Subscribe(1, 2)
+	defer late.Close()
+	recvLine(t, late, 2, "job 2 line 1")
+	recvLine(t, late, 2, "job 2 line 2")
+	if n := len(late.Events()); n != 0 {
+		t.Errorf("got %d more events, want 0", n)
+	}
+}
+
+func TestBuildLogHubSlowSubscriber(t *testing.T) {
+	const bufferSize = 2
+	hub := events.NewBuildLogHub(bufferSize)
+	hub.Publish(1, "backlog")
+	slow := hub.Subscribe(1)
+	defer slow.Close()
+
+	for i := 0; i <= bufferSize; i++ {
+		hub.Publish(1, "line")
+	}
+	var got int
+	for range slow.Events() {
+		got++
+	}
+	// the backlog is buffered in addition to the buffer size
+	if got != bufferSize+1 {
+		t.Errorf("got %d events, want %d", got, bufferSize+1)
+	}
+	if err := slow.Err(); err != events.ErrSlowSubscriber {
+		t.Errorf("Err() = %v, want %v", err, events.ErrSlowSubscriber)
+	}
+
+	// publishing on a nil hub must not block
+	var nilHub *events.BuildLogHub
+	nilHub.Publish(1, "line")
+	nilHub.End(1)
+}
@@ -0,0 +1,142 @@
+// Package events fans out events to subscribers, such as streaming RPCs.
+package events
+
+import (
+	"errors"
+	"sync"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultBufferSize is the number of events buffered for each subscriber,
+// unless another buffer size is given.
+const DefaultBufferSize = 64
+
+// ErrSlowSubscriber is returned by Err for a subscription that was closed
+// because the subscriber did not keep up with the published events.
+var ErrSlowSubscriber = errors.New("subscriber did not keep up with published events")
+
+// SubmissionEvent is published when a submission is created or updated.
+type SubmissionEvent struct {
+	CourseID   uint64
+	Submission *pb.Submission
+}
+
+// SubmissionFilter returns true if the subscriber should receive the event.
+// Filters are called while publishing, and must not block.
+type SubmissionFilter func(*SubmissionEvent) bool
+
+// SubmissionHub fans out submission events to subscribers.
+//
+// Publishing never blocks. If a subscriber's buffer is full, the subscriber
+// is unsubscribed and its Events channel is closed, since it has missed an
+// event. The subscriber may then subscribe again and fetch the current state.
+//
+// A nil hub discards all events.
+type SubmissionHub struct {
+	bufferSize int
+
+	mu   sync.Mutex
+	subs map[*SubmissionSubscription]struct{}
+}
+
+// NewSubmissionHub returns a hub that buffers up to bufferSize events for
+// each subscriber. If bufferSize is not positive, DefaultBufferSize is used.
+func NewSubmissionHub(bufferSize int) *SubmissionHub {
+	if bufferSize <= 0 {
+		bufferSize = DefaultBufferSize
+	}
+	return &SubmissionHub{
+		bufferSize: bufferSize,
+		subs:       make(map[*SubmissionSubscription]struct{}),
+	}
+}
+
+// Subscribe returns a subscription to the events accepted by filter.
+// The subscription must be closed when no longer used.
+func (h *SubmissionHub) Subscribe(filter SubmissionFilter) *SubmissionSubscription {
+	sub := &SubmissionSubscription{
+		hub:    h,
+		filter: filter,
+		events: make(chan *SubmissionEvent, h.bufferSize),
+	}
+	h.mu.Lock()
+	h.subs[sub] = struct{}{}
+	h.mu.Unlock()
+	return sub
+}
+
+// Publish sends the submission of the given course to all subscribers
+// accepting it. The submission is copied, so that the caller may
+// continue to modify it.
+func (h *SubmissionHub) Publish(courseID uint64, submission *pb.Submission) {
+	if h == nil {
+		return
+	}
+	event := &SubmissionEvent{
+		CourseID:   courseID,
+		Submission: proto.Clone(submission).(*pb.Submission),
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for sub := range h.subs {
+		if sub.filter != nil && !sub.filter(event) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			sub.err = ErrSlowSubscriber
+			h.unsubscribe(sub)
+		}
+	}
+}
+
+// Subscribers returns the current number of subscribers.
+func (h *SubmissionHub) Subscribers() int {
+	if h == nil {
+		return 0
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.subs)
+}
+
+// unsubscribe removes the subscription and closes its events channel.
+// The caller must hold h.mu.
+func (h *SubmissionHub) unsubscribe(sub *SubmissionSubscription) {
+	if _, ok := h.subs[sub]; ok {
+		delete(h.subs, sub)
+		close(sub.events)
+	}
+}
+
+// SubmissionSubscription is a subscription to submission events.
+type SubmissionSubscription struct {
+	hub    *SubmissionHub
+	filter SubmissionFilter
+	events chan *SubmissionEvent
+	err    error // guarded by hub.mu
+}
+
+// Events returns the channel on which events are delivered.
+// The channel is closed when the subscription is closed.
+func (s *SubmissionSubscription) Events() <-chan *SubmissionEvent {
+	return s.events
+}
+
+// Err returns ErrSlowSubscriber if the subscription was closed by the hub
+// because the subscriber did not keep up, and nil otherwise.
+func (s *SubmissionSubscription) Err() error {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	return s.err
+}
+
+// Close unsubscribes from the hub. Close may be called more than once.
+func (s *SubmissionSubscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	s.hub.unsubscribe(s)
+}
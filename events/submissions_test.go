@@ -0,0 +1,100 @@
+package events_test
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/events"
+)
+
+func courseFilter(courseID uint64) events.SubmissionFilter {
+	return func(e *events.SubmissionEvent) bool {
+		return e.CourseID == courseID
+	}
+}
+
+func TestSubmissionHubFanOut(t *testing.T) {
+	hub := events.NewSubmissionHub(0)
+	course1 := hub.Subscribe(courseFilter(1))
+	defer course1.Close()
+	course2 := hub.Subscribe(courseFilter(2))
+	defer course2.Close()
+	all := hub.Subscribe(nil)
+	defer all.Close()
+
+	submission := &pb.Submission{ID: 1, Score: 50}
+	hub.Publish(1, submission)
+	// modifying the submission after publishing must not affect the event
+	submission.Score = 100
+	hub.Publish(2, &pb.Submission{ID: 2})
+
+	for _, sub := range []*events.SubmissionSubscription{course1, all} {
+		event := <-sub.Events()
+		if event.CourseID != 1 || event.Submission.GetID() != 1 || event.Submission.GetScore() != 50 {
+			t.Errorf("got event for course %d and submission %v, want course 1 and submission 1 with score 50", event.CourseID, event.Submission)
+		}
+	}
+	for _, sub := range []*events.SubmissionSubscription{course2, all} {
+		if event := <-sub.Events(); event.CourseID != 2 || event.Submission.GetID() != 2 {
+			t.Errorf("got event for course %d and submission %d, want course 2 and submission 2", event.CourseID, event.Submission.GetID())
+		}
+	}
+	if n := len(course1.Events()); n != 0 {
+		t.Errorf("course 1 subscriber got %d events for course 2", n)
+	}
+}
+
+func TestSubmissionHubSlowSubscriber(t *testing.T) {
+	const bufferSize = 2
+	hub := events.NewSubmissionHub(bufferSize)
+	slow := hub.Subscribe(nil)
+	defer slow.Close()
+	fast := hub.Subscribe(nil)
+	defer fast.Close()
+
+	for i := uint64(1); i <= bufferSize+1; i++ {
+		hub.Publish(1, &pb.Submission{ID: i})
+		// the fast subscriber keeps up with the events
+		if event := <-fast.Events(); event.Submission.GetID() != i {
+			t.Errorf("fast subscriber got submission %d, want %d", event.Submission.GetID(), i)
+		}
+	}
+
+	// the slow subscriber gets the buffered events before the channel is closed
+	var got int
+	for range slow.Events() {
+		got++
+	}
+	if got != bufferSize {
+		t.Errorf("slow subscriber got %d events, want %d", got, bufferSize)
+	}
+	if err := slow.Err(); err != events.ErrSlowSubscriber {
+		t.Errorf("slow subscriber Err() = %v, want %v", err, events.ErrSlowSubscriber)
+	}
+	if err := fast.Err(); err != nil {
+		t.Errorf("fast subscriber Err() = %v, want nil", err)
+	}
+	if n := hub.Subscribers(); n != 1 {
+		t.Errorf("hub has %d subscribers, want 1", n)
+	}
+}
+
+func TestSubmissionHubClose(t *testing.T) {
+	hub := events.NewSubmissionHub(0)
+	sub := hub.Subscribe(nil)
+	sub.Close()
+	sub.Close()
+	if _, ok := <-sub.Events(); ok {
+		t.Error("got event on closed subscription")
+	}
+	if err := sub.Err(); err != nil {
+		t.Errorf("Err() = %v, want nil", err)
+	}
+	// publishing without subscribers, or on a nil hub, must not block
+	hub.Publish(1, &pb.Submission{})
+	var nilHub *events.SubmissionHub
+	nilHub.Publish(1, &pb.Submission{})
+	if n := hub.Subscribers(); n != 0 {
+		t.Errorf("hub has %d subscribers, want 0", n)
+	}
+}
@@ -0,0 +1,186 @@
+package gradebook
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/360EntSecGroup-Skylar/excelize"
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// ErrGroupsUnsupported is returned when exporting a group gradebook to a format
+// for learning management systems, which only import grades for students.
+var ErrGroupsUnsupported = errors.New("format supports only student gradebooks")
+
+// sheetName is the name of the XLSX gradebook sheet.
+const sheetName = "Gradebook"
+
+// Export returns the gradebook as a file in the given format.
+func (gb *Gradebook) Export(format pb.GradebookRequest_Format) (*pb.GradebookFile, error) {
+	var buf bytes.Buffer
+	var err error
+	contentType, ext := "text/csv", "csv"
+	switch format {
+	case pb.GradebookRequest_CSV:
+		err = gb.WriteCSV(&buf)
+	case pb.GradebookRequest_XLSX:
+		contentType, ext = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", "xlsx"
+		err = gb.WriteXLSX(&buf)
+	case pb.GradebookRequest_CANVAS:
+		err = gb.WriteCanvas(&buf)
+	case pb.GradebookRequest_MOODLE:
+		err = gb.WriteMoodle(&buf)
+	default:
+		err = fmt.Errorf("unknown gradebook format %v", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GradebookFile{
+		Name:        gb.fileName(format, ext),
+		ContentType: contentType,
+		Content:     buf.Bytes(),
+	}, nil
+}
+
+// fileName returns the name of the exported file, such as dat320-2021-canvas.csv.
+func (gb *Gradebook) fileName(format pb.GradebookRequest_Format, ext string) string {
+	parts := []string{strings.ToLower(gb.Course.GetCode()), fmt.Sprint(gb.Course.GetYear())}
+	if gb.Groups {
+		parts = append(parts, "groups")
+	}
+	parts = append(parts, strings.ToLower(format.String()))
+	return strings.Join(parts, "-") + "." + ext
+}
+
+// WriteCSV writes the gradebook as CSV, with the score, status and review score of each assignment.
+func (gb *Gradebook) WriteCSV(w io.Writer) error {
+	return writeCSV(w, gb.table())
+}
+
+// WriteXLSX writes the gradebook as an XLSX spreadsheet with the same columns as WriteCSV.
+func (gb *Gradebook) WriteXLSX(w io.Writer) error {
+	f := excelize.NewFile()
+	f.SetSheetName("Sheet1", sheetName)
+	for i, row := range gb.table() {
+		for j, value := range row {
+			f.SetCellValue(sheetName, fmt.Sprintf("%s%d", excelize.ToAlphaString(j), i+1), value)
+		}
+	}
+	return f.Write(w)
+}
+
+// WriteCanvas writes the gradebook in the Canvas gradebook import format,
+// identifying the students by their student ID (SIS User ID) and login (SIS Login ID).
+func (gb *Gradebook) WriteCanvas(w io.Writer) error {
+	if gb.Groups {
+		return ErrGroupsUnsupported
+	}
+	header := []interface{}{"Student", "ID", "SIS User ID", "SIS Login ID", "Section"}
+	pointsPossible := []interface{}{"    Points Possible", "", "", "", ""}
+	for _, assignment := range gb.Assignments {
+		header = append(header, assignment.GetName())
+		pointsPossible = append(pointsPossible, 100)
+	}
+	table := [][]interface{}{header, pointsPossible}
+	for _, row := range gb.Rows {
+		line := []interface{}{row.Name, "", row.StudentID, row.Login, ""}
+		table = append(table, append(line, scores(row)...))
+	}
+	return writeCSV(w, table)
+}
+
+// WriteMoodle writes the gradebook in the Moodle grade import format,
+// identifying the students by their ID number and email address.
+func (gb *Gradebook) WriteMoodle(w io.Writer) error {
+	if gb.Groups {
+		return ErrGroupsUnsupported
+	}
+	header := []interface{}{"ID number", "Email address", "Full name"}
+	for _, assignment := range gb.Assignments {
+		header = append(header, assignment.GetName())
+	}
+	header = append(header, "Approved assignments")
+	table := [][]interface{}{header}
+	for _, row := range gb.Rows {
+		line := []interface{}{row.StudentID, row.Email, row.Name}
+		line = append(line, scores(row)...)
+		table = append(table, append(line, row.Approved))
+	}
+	return writeCSV(w, table)
+}
+
+// table returns the header and rows of the CSV and XLSX gradebooks.
+func (gb *Gradebook) table() [][]interface{} {
+	var header []interface{}
+	if gb.Groups {
+		header = []interface{}{"Group", "Members"}
+	} else {
+		header = []interface{}{"Name", "Student ID", "Login", "Email"}
+	}
+	for _, assignment := range gb.Assignments {
+		name := assignment.GetName()
+		header = append(header, name+" Score", name+" Status", name+" Review")
+	}
+	header = append(header, "Slip Days Used", "Approved", "Passed")
+
+	table := [][]interface{}{header}
+	for _, row := range gb.Rows {
+		var line []interface{}
+		if gb.Groups {
+			line = []interface{}{row.Name, row.Login}
+		} else {
+			line = []interface{}{row.Name, row.StudentID, row.Login, row.Email}
+		}
+		for _, result := range row.Results {
+			if !result.Submitted {
+				line = append(line, "", "", "")
+				continue
+			}
+			var review interface{} = ""
+			if result.Reviewed {
+				review = result.ReviewScore
+			}
+			line = append(line, result.Score, result.Status.String(), review)
+		}
+		table = append(table, append(line, row.SlipDaysUsed, row.Approved, row.Passed))
+	}
+	return table
+}
+
+// scores returns the scores of the row's submitted assignments, leaving the others empty.
+func scores(row *Row) []interface{} {
+	line := make([]interface{}, 0, len(row.Results))
+	for _, result := range row.Results {
+		if result.Submitted {
+			line = append(line, result.Score)
+		} else {
+			line = append(line, "")
+		}
+	}
+	return line
+}
+
+// writeCSV writes the table as CSV. Text starting with a formula character is prefixed
+// with a quote, so that spreadsheets do not evaluate names chosen by students as formulas.
+func writeCSV(w io.Writer, table [][]interface{}) error {
+	cw := csv.NewWriter(w)
+	for _, row := range table {
+		record := make([]string, len(row))
+		for i, value := range row {
+			record[i] = fmt.Sprint(value)
+			if text, ok := value.(string); ok && text != "" && strings.ContainsRune("=+-@", rune(text[0])) {
+				record[i] = "'" + text
+			}
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,202 @@
+// Package gradebook builds gradebooks from the course submissions,
+// and exports them to spreadsheets and learning management systems.
+package gradebook
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// Rules are the requirements for passing a course.
+type Rules struct {
+	// MinApproved is the number of approved assignments required to pass.
+	MinApproved int
+	// Required are the names of the assignments that must be approved to pass.
+	Required []string
+}
+
+// Passed returns true if the given approved assignments meet the requirements.
+func (r Rules) Passed(approved map[string]bool) bool {
+	for _, name := range r.Required {
+		if !approved[name] {
+			return false
+		}
+	}
+	return len(approved) >= r.MinApproved
+}
+
+// Gradebook is a table of the results of each student or group in a course.
+type Gradebook struct {
+	Course *pb.Course
+	// Groups is true if the rows are groups, and false if the rows are students.
+	Groups bool
+	// Assignments are the columns of the gradebook, ordered by assignment order.
+	Assignments []*pb.Assignment
+	Rows        []*Row
+}
+
+// Row is the results of a student or group.
+type Row struct {
+	// Name is the student's name or the group's name.
+	Name string
+	// StudentID is the student's ID; it is empty for groups.
+	StudentID string
+	// Login is the student's login, or the members' logins for groups.
+	Login string
+	// Email is the student's email; it is empty for groups.
+	Email string
+	// Results are the results of the assignments, in the gradebook's assignment order.
+	Results      []*Result
+	SlipDaysUsed uint32
+	Approved     int
+	Passed       bool
+}
+
+// Result is the result of an assignment for a student or group.
+type Result struct {
+	Submitted bool
+	Score     uint32
+	Status    pb.Submission_Status
+	// Reviewed is true if the submission has a ready manual review,
+	// in which case ReviewScore is the average score of the ready reviews.
+	Reviewed    bool
+	ReviewScore uint32
+}
+
+// New returns the gradebook of the given course submissions, as returned by GetSubmissionsByCourse.
+// If groups is true, the submissions must be group submissions, and only group assignments are included.
+// Teachers and users with the ignored logins are left out, and only the slip days
+// used on the gradebook's assignments are counted.
+// An error is returned if a required assignment is not one of the gradebook's assignments.
+func New(courseSubmissions *pb.CourseSubmissions, groups bool, rules Rules, ignoredLogins ...string) (*Gradebook, error) {
+	ignored := make(map[string]bool)
+	for _, login := range ignoredLogins {
+		ignored[login] = true
+	}
+	course := courseSubmissions.GetCourse()
+	gb := &Gradebook{Course: course, Groups: groups}
+	for _, assignment := range course.GetAssignments() {
+		if !groups || assignment.GetIsGroupLab() {
+			gb.Assignments = append(gb.Assignments, assignment)
+		}
+	}
+	sort.SliceStable(gb.Assignments, func(i, j int) bool {
+		return gb.Assignments[i].GetOrder() < gb.Assignments[j].GetOrder()
+	})
+	names := make(map[string]bool)
+	included := make(map[uint64]bool)
+	for _, assignment := range gb.Assignments {
+		names[assignment.GetName()] = true
+		included[assignment.GetID()] = true
+	}
+	for _, name := range rules.Required {
+		if !names[name] {
+			return nil, fmt.Errorf("unknown required assignment %q", name)
+		}
+	}
+
+	seenGroups := make(map[uint64]bool)
+	for _, link := range courseSubmissions.GetLinks() {
+		enrollment := link.GetEnrollment()
+		var row *Row
+		if groups {
+			if seenGroups[enrollment.GetGroupID()] {
+				// the links may contain an enrollment for each group member
+				continue
+			}
+			seenGroups[enrollment.GetGroupID()] = true
+			row = groupRow(course, enrollment, ignored)
+		} else {
+			row = studentRow(enrollment, ignored)
+		}
+		if row == nil {
+			continue
+		}
+		submissions := make(map[uint64]*pb.Submission)
+		for _, submissionLink := range link.GetSubmissions() {
+			if submissionLink.GetSubmission() != nil {
+				submissions[submissionLink.GetAssignment().GetID()] = submissionLink.GetSubmission()
+			}
+		}
+		approved := make(map[string]bool)
+		for _, assignment := range gb.Assignments {
+			result := newResult(submissions[assignment.GetID()])
+			if result.Status == pb.Submission_APPROVED {
+				approved[assignment.GetName()] = true
+			}
+			row.Results = append(row.Results, result)
+		}
+		for _, used := range enrollment.GetUsedSlipDays() {
+			if included[used.GetAssignmentID()] {
+				row.SlipDaysUsed += used.GetUsedSlipDays()
+			}
+		}
+		row.Approved = len(approved)
+		row.Passed = rules.Passed(approved)
+		gb.Rows = append(gb.Rows, row)
+	}
+	sort.SliceStable(gb.Rows, func(i, j int) bool {
+		return gb.Rows[i].Name < gb.Rows[j].Name
+	})
+	return gb, nil
+}
+
+// studentRow returns the row of the enrolled student, or nil if the enrollment
+// is not a student's or the student is ignored.
+func studentRow(enrollment *pb.Enrollment, ignored map[string]bool) *Row {
+	user := enrollment.GetUser()
+	if !enrollment.IsStudent() || user == nil || ignored[user.GetLogin()] {
+		return nil
+	}
+	return &Row{
+		Name:      user.GetName(),
+		StudentID: user.GetStudentID(),
+		Login:     user.GetLogin(),
+		Email:     user.GetEmail(),
+	}
+}
+
+// groupRow returns the row of the enrollment's group, or nil if there is no group.
+// Ignored users are not listed among the group members.
+func groupRow(course *pb.Course, enrollment *pb.Enrollment, ignored map[string]bool) *Row {
+	if enrollment.GetGroupID() == 0 {
+		return nil
+	}
+	var logins []string
+	for _, member := range course.GetEnrollments() {
+		if member.GetGroupID() == enrollment.GetGroupID() && member.IsStudent() && !ignored[member.GetUser().GetLogin()] {
+			logins = append(logins, member.GetUser().GetLogin())
+		}
+	}
+	sort.Strings(logins)
+	return &Row{
+		Name:  enrollment.GetGroup().GetName(),
+		Login: strings.Join(logins, " "),
+	}
+}
+
+func newResult(submission *pb.Submission) *Result {
+	if submission == nil {
+		return &Result{}
+	}
+	result := &Result{
+		Submitted: true,
+		Score:     submission.GetScore(),
+		Status:    submission.GetStatus(),
+	}
+	var total, ready uint64
+	for _, review := range submission.GetReviews() {
+		if review.GetReady() {
+			total += review.GetScore()
+			ready++
+		}
+	}
+	if ready > 0 {
+		result.Reviewed = true
+		result.ReviewScore = uint32(total / ready)
+	}
+	return result
+}
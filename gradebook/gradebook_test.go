@@ -0,0 +1,213 @@
+package gradebook
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/360EntSecGroup-Skylar/excelize"
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/google/go-cmp/cmp"
+)
+
+var (
+	lab1 = &pb.Assignment{ID: 1, Name: "lab1", Order: 1}
+	lab2 = &pb.Assignment{ID: 2, Name: "lab2", Order: 2}
+	lab3 = &pb.Assignment{ID: 3, Name: "lab3", Order: 3, IsGroupLab: true}
+)
+
+func link(enrollment *pb.Enrollment, submissions map[*pb.Assignment]*pb.Submission) *pb.EnrollmentLink {
+	l := &pb.EnrollmentLink{Enrollment: enrollment}
+	for _, assignment := range []*pb.Assignment{lab1, lab2, lab3} {
+		l.Submissions = append(l.Submissions, &pb.SubmissionLink{Assignment: assignment, Submission: submissions[assignment]})
+	}
+	return l
+}
+
+func testCourseSubmissions() *pb.CourseSubmissions {
+	group := &pb.Group{ID: 1, Name: "=cmd"}
+	alice := &pb.Enrollment{
+		Status: pb.Enrollment_STUDENT, GroupID: 1, Group: group,
+		User:         &pb.User{Name: "Alice", StudentID: "101", Login: "alice", Email: "alice@example.com"},
+		UsedSlipDays: []*pb.UsedSlipDays{{AssignmentID: 1, UsedSlipDays: 2}, {AssignmentID: 3, UsedSlipDays: 1}},
+	}
+	bob := &pb.Enrollment{
+		Status: pb.Enrollment_STUDENT, GroupID: 1, Group: group,
+		User:         &pb.User{Name: "Bob", StudentID: "102", Login: "bob", Email: "bob@example.com"},
+		UsedSlipDays: []*pb.UsedSlipDays{{AssignmentID: 3, UsedSlipDays: 1}},
+	}
+	teacher := &pb.Enrollment{Status: pb.Enrollment_TEACHER, User: &pb.User{Name: "Teacher", Login: "teacher"}}
+	test := &pb.Enrollment{Status: pb.Enrollment_STUDENT, User: &pb.User{Name: "Test", Login: "test"}}
+	course := &pb.Course{
+		Code:        "DAT320",
+		Year:        2021,
+		Assignments: []*pb.Assignment{lab3, lab2, lab1},
+		Enrollments: []*pb.Enrollment{alice, bob, teacher, test},
+	}
+	groupSubmission := &pb.Submission{Score: 90, Status: pb.Submission_APPROVED}
+	return &pb.CourseSubmissions{
+		Course: course,
+		Links: []*pb.EnrollmentLink{
+			link(bob, map[*pb.Assignment]*pb.Submission{
+				lab1: {Score: 40, Status: pb.Submission_REJECTED},
+				lab3: groupSubmission,
+			}),
+			link(alice, map[*pb.Assignment]*pb.Submission{
+				lab1: {Score: 80, Status: pb.Submission_APPROVED, Reviews: []*pb.Review{
+					{Score: 70, Ready: true}, {Score: 90, Ready: true}, {Score: 10},
+				}},
+				lab2: {Score: 100, Status: pb.Submission_APPROVED},
+				lab3: groupSubmission,
+			}),
+			link(teacher, nil),
+			link(test, nil),
+		},
+	}
+}
+
+func TestNew(t *testing.T) {
+	gb, err := New(testCourseSubmissions(), false, Rules{MinApproved: 2, Required: []string{"lab1"}}, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, assignment := range gb.Assignments {
+		names = append(names, assignment.GetName())
+	}
+	if diff := cmp.Diff([]string{"lab1", "lab2", "lab3"}, names); diff != "" {
+		t.Errorf("assignments mismatch (-want +got):\n%s", diff)
+	}
+	want := []*Row{
+		{
+			Name: "Alice", StudentID: "101", Login: "alice", Email: "alice@example.com",
+			Results: []*Result{
+				{Submitted: true, Score: 80, Status: pb.Submission_APPROVED, Reviewed: true, ReviewScore: 80},
+				{Submitted: true, Score: 100, Status: pb.Submission_APPROVED},
+				{Submitted: true, Score: 90, Status: pb.Submission_APPROVED},
+			},
+			SlipDaysUsed: 3, Approved: 3, Passed: true,
+		},
+		{
+			Name: "Bob", StudentID: "102", Login: "bob", Email: "bob@example.com",
+			Results: []*Result{
+				{Submitted: true, Score: 40, Status: pb.Submission_REJECTED},
+				{},
+				{Submitted: true, Score: 90, Status: pb.Submission_APPROVED},
+			},
+			SlipDaysUsed: 1, Approved: 1,
+		},
+	}
+	if diff := cmp.Diff(want, gb.Rows); diff != "" {
+		t.Errorf("rows mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := New(testCourseSubmissions(), false, Rules{Required: []string{"lab4"}}); err == nil {
+		t.Error("New() with unknown required assignment: want error")
+	}
+	// lab1 is not a group assignment
+	if _, err := New(testCourseSubmissions(), true, Rules{Required: []string{"lab1"}}); err == nil {
+		t.Error("New() of groups with individual required assignment: want error")
+	}
+}
+
+func TestRulesPassed(t *testing.T) {
+	tests := []struct {
+		rules    Rules
+		approved map[string]bool
+		want     bool
+	}{
+		{Rules{}, nil, true},
+		{Rules{MinApproved: 2}, map[string]bool{"lab1": true}, false},
+		{Rules{MinApproved: 2}, map[string]bool{"lab1": true, "lab2": true}, true},
+		{Rules{MinApproved: 1, Required: []string{"lab2"}}, map[string]bool{"lab1": true}, false},
+		{Rules{MinApproved: 1, Required: []string{"lab2"}}, map[string]bool{"lab2": true}, true},
+	}
+	for _, test := range tests {
+		if got := test.rules.Passed(test.approved); got != test.want {
+			t.Errorf("%+v.Passed(%v) = %t, want %t", test.rules, test.approved, got, test.want)
+		}
+	}
+}
+
+func TestExport(t *testing.T) {
+	gb, err := New(testCourseSubmissions(), false, Rules{MinApproved: 2}, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	groups, err := New(testCourseSubmissions(), true, Rules{MinApproved: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		gb     *Gradebook
+		format pb.GradebookRequest_Format
+		name   string
+		want   string
+	}{
+		{gb, pb.GradebookRequest_CSV, "dat320-2021-csv.csv", `Name,Student ID,Login,Email,lab1 Score,lab1 Status,lab1 Review,lab2 Score,lab2 Status,lab2 Review,lab3 Score,lab3 Status,lab3 Review,Slip Days Used,Approved,Passed
+Alice,101,alice,alice@example.com,80,APPROVED,80,100,APPROVED,,90,APPROVED,,3,3,true
+Bob,102,bob,bob@example.com,40,REJECTED,,,,,90,APPROVED,,1,1,false
+`},
+		// the group is listed once, and its name is escaped since it starts with a formula character
+		{groups, pb.GradebookRequest_CSV, "dat320-2021-groups-csv.csv", `Group,Members,lab3 Score,lab3 Status,lab3 Review,Slip Days Used,Approved,Passed
+'=cmd,alice bob,90,APPROVED,,1,1,true
+`},
+		{gb, pb.GradebookRequest_CANVAS, "dat320-2021-canvas.csv", `Student,ID,SIS User ID,SIS Login ID,Section,lab1,lab2,lab3
+"    Points Possible",,,,,100,100,100
+Alice,,101,alice,,80,100,90
+Bob,,102,bob,,40,,90
+`},
+		{gb, pb.GradebookRequest_MOODLE, "dat320-2021-moodle.csv", `ID number,Email address,Full name,lab1,lab2,lab3,Approved assignments
+101,alice@example.com,Alice,80,100,90,3
+102,bob@example.com,Bob,40,,90,1
+`},
+	}
+	for _, test := range tests {
+		file, err := test.gb.Export(test.format)
+		if err != nil {
+			t.Fatalf("Export(%v) failed: %v", test.format, err)
+		}
+		if file.GetName() != test.name {
+			t.Errorf("Export(%v) file name = %q, want %q", test.format, file.GetName(), test.name)
+		}
+		if diff := cmp.Diff(test.want, string(file.GetContent())); diff != "" {
+			t.Errorf("Export(%v) mismatch (-want +got):\n%s", test.format, diff)
+		}
+	}
+
+	for _, format := range []pb.GradebookRequest_Format{pb.GradebookRequest_CANVAS, pb.GradebookRequest_MOODLE} {
+		if _, err := groups.Export(format); err != ErrGroupsUnsupported {
+			t.Errorf("Export(%v) of groups: have error %v, want %v", format, err, ErrGroupsUnsupported)
+		}
+	}
+}
+
+func TestWriteXLSX(t *testing.T) {
+	gb, err := New(testCourseSubmissions(), false, Rules{MinApproved: 2}, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var xlsxBuf bytes.Buffer
+	if err := gb.WriteXLSX(&xlsxBuf); err != nil {
+		t.Fatal(err)
+	}
+	f, err := excelize.OpenReader(&xlsxBuf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want [][]string
+	for _, row := range gb.table() {
+		var line []string
+		for _, value := range row {
+			// spreadsheets show boolean cells as 1 and 0
+			if b, ok := value.(bool); ok {
+				value = map[bool]int{false: 0, true: 1}[b]
+			}
+			line = append(line, fmt.Sprint(value))
+		}
+		want = append(want, line)
+	}
+	if diff := cmp.Diff(want, f.GetRows(sheetName)); diff != "" {
+		t.Errorf("WriteXLSX() mismatch (-want +got):\n%s", diff)
+	}
+}
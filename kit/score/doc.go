@@ -24,4 +24,8 @@
 // session's secret value. If such a JSON Score object is found, Quickfeed
 // extracts and records the Score object to be used in the above calculation.
 // All other output is ignored when computing the score.
+//
+// The format of the JSON Score objects is described in doc/score-format.md,
+// for score reporting libraries for other languages, and Verify checks that
+// a line conforms to it.
 package score
@@ -0,0 +1,98 @@
+package score
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// fields are the names of the fields of a score line, in the order printed by this package.
+var fields = []string{"Secret", "TestName", "Score", "MaxScore", "Weight"}
+
+// Verify returns the score object of the given score line if the line conforms
+// to the score line format described in doc/score-format.md, and an error
+// describing the first violation otherwise. That is, the line must hold a
+// single JSON object with exactly the fields of the Score struct, whose
+// Secret is the given secret, whose TestName is not empty, whose MaxScore is
+// positive, whose Score is between zero and MaxScore, and whose Weight is not
+// negative. Unlike Parse, Verify is strict, and is intended for checking the
+// output of score reporting libraries for other languages than Go.
+// The returned errors never reveal the secret.
+func Verify(s, secret string) (*Score, error) {
+	sc, err := verify(s, secret)
+	if err != nil && secret != "" && strings.Contains(err.Error(), secret) {
+		return nil, errors.New("error suppressed to avoid revealing secret")
+	}
+	return sc, err
+}
+
+func verify(s, secret string) (*Score, error) {
+	if !HasPrefix(s) {
+		return nil, ErrScoreNotFound
+	}
+	dec := json.NewDecoder(strings.NewReader(s))
+	var obj map[string]json.RawMessage
+	if err := dec.Decode(&obj); err != nil {
+		return nil, fmt.Errorf("invalid JSON object: %w", err)
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, errors.New("unexpected text after the JSON object")
+	}
+	var unknown []string
+	for name := range obj {
+		if !contains(fields, name) {
+			unknown = append(unknown, fmt.Sprintf("%q", name))
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("unknown fields %s", strings.Join(unknown, ", "))
+	}
+
+	var sc Score
+	targets := []interface{}{&sc.Secret, &sc.TestName, &sc.Score, &sc.MaxScore, &sc.Weight}
+	for i, name := range fields {
+		value, ok := obj[name]
+		if !ok {
+			return nil, fmt.Errorf("missing field %q", name)
+		}
+		if bytes.Equal(value, []byte("null")) {
+			return nil, fmt.Errorf("field %q is null", name)
+		}
+		if err := json.Unmarshal(value, targets[i]); err != nil {
+			kind := "an integer"
+			if i < 2 {
+				kind = "a string"
+			}
+			return nil, fmt.Errorf("field %q must be %s", name, kind)
+		}
+	}
+
+	switch {
+	case sc.Secret != secret:
+		return nil, errors.New("field \"Secret\" does not match the session secret")
+	case strings.TrimSpace(sc.TestName) == "":
+		return nil, errors.New("field \"TestName\" is empty")
+	case sc.MaxScore <= 0:
+		return nil, fmt.Errorf("field \"MaxScore\" must be positive, got %d", sc.MaxScore)
+	case sc.Score < 0 || sc.Score > sc.MaxScore:
+		return nil, fmt.Errorf("field \"Score\" must be between 0 and MaxScore %d, got %d", sc.MaxScore, sc.Score)
+	case sc.Weight < 0:
+		return nil, fmt.Errorf("field \"Weight\" must not be negative, got %d", sc.Weight)
+	}
+	sc.Secret = hiddenSecret // overwrite secret
+	return &sc, nil
+}
+
+func contains(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,136 @@
+package score
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestVerify(t *testing.T) {
+	const secret = "59fd5fe1c4f741604c1beeab875b9c789d2a7c73"
+	tests := []struct {
+		name    string
+		line    string
+		want    *Score
+		wantErr string
+	}{
+		{
+			name: "valid",
+			line: `{"Secret":"` + secret + `","TestName":"TestFib","Score":3,"MaxScore":10,"Weight":2}`,
+			want: &Score{Secret: hiddenSecret, TestName: "TestFib", Score: 3, MaxScore: 10, Weight: 2},
+		},
+		{
+			name: "valid with other field order and spaces",
+			line: `  {"TestName": "test_fib.py::test_fib", "Weight": 0, "MaxScore": 1, "Score": 1, "Secret": "` + secret + `"}  `,
+			want: &Score{Secret: hiddenSecret, TestName: "test_fib.py::test_fib", Score: 1, MaxScore: 1, Weight: 0},
+		},
+		{
+			name:    "not a score line",
+			line:    `=== RUN   TestFib`,
+			wantErr: ErrScoreNotFound.Error(),
+		},
+		{
+			name:    "invalid json",
+			line:    `{"Secret":"` + secret + `","TestName":"TestFib",`,
+			wantErr: "invalid JSON object",
+		},
+		{
+			name:    "text after object",
+			line:    `{"Secret":"` + secret + `","TestName":"TestFib","Score":3,"MaxScore":10,"Weight":2} passed`,
+			wantErr: "unexpected text after the JSON object",
+		},
+		{
+			name:    "unknown field",
+			line:    `{"Secret":"` + secret + `","TestName":"TestFib","Score":3,"MaxScore":10,"Weight":2,"Points":3}`,
+			wantErr: `unknown fields "Points"`,
+		},
+		{
+			name:    "lowercase field",
+			line:    `{"Secret":"` + secret + `","testName":"TestFib","Score":3,"MaxScore":10,"Weight":2}`,
+			wantErr: `unknown fields "testName"`,
+		},
+		{
+			name:    "missing field",
+			line:    `{"Secret":"` + secret + `","TestName":"TestFib","Score":3,"MaxScore":10}`,
+			wantErr: `missing field "Weight"`,
+		},
+		{
+			name:    "null field",
+			line:    `{"Secret":"` + secret + `","TestName":null,"Score":3,"MaxScore":10,"Weight":2}`,
+			wantErr: `field "TestName" is null`,
+		},
+		{
+			name:    "fractional score",
+			line:    `{"Secret":"` + secret + `","TestName":"TestFib","Score":2.5,"MaxScore":10,"Weight":2}`,
+			wantErr: `field "Score" must be an integer`,
+		},
+		{
+			name:    "numeric test name",
+			line:    `{"Secret":"` + secret + `","TestName":1,"Score":3,"MaxScore":10,"Weight":2}`,
+			wantErr: `field "TestName" must be a string`,
+		},
+		{
+			name:    "wrong secret",
+			line:    `{"Secret":"guess","TestName":"TestFib","Score":3,"MaxScore":10,"Weight":2}`,
+			wantErr: `field "Secret" does not match the session secret`,
+		},
+		{
+			name:    "empty test name",
+			line:    `{"Secret":"` + secret + `","TestName":" ","Score":3,"MaxScore":10,"Weight":2}`,
+			wantErr: `field "TestName" is empty`,
+		},
+		{
+			name:    "zero max score",
+			line:    `{"Secret":"` + secret + `","TestName":"TestFib","Score":0,"MaxScore":0,"Weight":2}`,
+			wantErr: `field "MaxScore" must be positive`,
+		},
+		{
+			name:    "score above max score",
+			line:    `{"Secret":"` + secret + `","TestName":"TestFib","Score":11,"MaxScore":10,"Weight":2}`,
+			wantErr: `field "Score" must be between 0 and MaxScore 10, got 11`,
+		},
+		{
+			name:    "negative score",
+			line:    `{"Secret":"` + secret + `","TestName":"TestFib","Score":-1,"MaxScore":10,"Weight":2}`,
+			wantErr: `field "Score" must be between 0 and MaxScore 10, got -1`,
+		},
+		{
+			name:    "negative weight",
+			line:    `{"Secret":"` + secret + `","TestName":"TestFib","Score":3,"MaxScore":10,"Weight":-2}`,
+			wantErr: `field "Weight" must not be negative`,
+		},
+		{
+			name:    "secret in error",
+			line:    `{"Secret":"` + secret + `","TestName":"TestFib","Score":3,"MaxScore":10,"Weight":2,"` + secret + `":1}`,
+			wantErr: "error suppressed to avoid revealing secret",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Verify(tt.line, secret)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Verify() = (%+v, %v), want error containing %q", got, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Verify() returned unexpected error: %v", err)
+			}
+			if *got != *tt.want {
+				t.Errorf("Verify() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerifyPrintedScore(t *testing.T) {
+	sc := NewScoreMax(t, 10, 2)
+	sc.Dec()
+	got, err := Verify(sc.json(), sessionSecret)
+	if err != nil {
+		t.Fatalf("Verify() rejected a score line printed by this package: %v", err)
+	}
+	if got.Score != 9 || got.MaxScore != 10 || got.Weight != 2 || got.TestName != t.Name() {
+		t.Errorf("Verify() = %+v, want the printed score", got)
+	}
+}
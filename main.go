@@ -10,17 +10,25 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"os/signal"
+	"runtime"
 	"strconv"
+	"strings"
+	"syscall"
 
+	"github.com/autograde/quickfeed/agent"
 	"github.com/autograde/quickfeed/ci"
 	logq "github.com/autograde/quickfeed/log"
+	"github.com/autograde/quickfeed/scheduler"
 	"github.com/autograde/quickfeed/web"
 	"github.com/autograde/quickfeed/web/auth"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/database"
 
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
 
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
@@ -53,6 +61,7 @@ func init() {
 		pb.AgFailedMethodsMetric,
 		pb.AgMethodSuccessRateMetric,
 		pb.AgResponseTimeByMethodsMetric,
+		ci.CacheRequestsMetric,
 	)
 }
 
@@ -62,12 +71,22 @@ var reg = prometheus.NewRegistry()
 func main() {
 	var (
 		baseURL    = flag.String("service.url", "", "base service DNS name")
-		dbFile     = flag.String("database.file", "qf.db", "database file")
+		dbFile     = flag.String("database.file", "qf.db", "database file or data source name (sqlite://qf.db, postgres://...)")
 		public     = flag.String("http.public", "public", "path to content to serve")
 		httpAddr   = flag.String("http.addr", ":8081", "HTTP listen address")
 		grpcAddr   = flag.String("grpc.addr", ":9090", "gRPC listen address")
 		scriptPath = flag.String("script.path", "ci/scripts", "path to continuous integration scripts")
 		fake       = flag.Bool("provider.fake", false, "enable fake provider")
+		workers    = flag.Int("build.workers", runtime.NumCPU(), "maximum number of concurrent builds")
+		perCourse  = flag.Int("build.course-limit", 0, "maximum number of concurrent builds per course (0 means no limit)")
+		cache      = flag.Bool("build.cache", true, "cache dependencies and tests repositories of courses between builds")
+		poolSize   = flag.Int("build.pool", 0, "number of pre-warmed containers to keep per image (0 means none)")
+		local      = flag.Bool("build.local", false, "run builds locally instead of in docker containers, on machines without docker")
+		unshare    = flag.Bool("build.unshare", false, "run local builds in new linux namespaces with unshare")
+		agentAddr  = flag.String("agent.addr", "", "listen address for build agents; builds run on remote agents if set")
+		agentCert  = flag.String("agent.cert", "", "certificate file of the TLS listener for build agents")
+		agentKey   = flag.String("agent.key", "", "key file of the TLS listener for build agents")
+		agentInsec = flag.Bool("agent.insecure", false, "listen for build agents without TLS, revealing agent tokens and course access tokens to the network")
 	)
 	flag.Parse()
 
@@ -89,21 +108,51 @@ func main() {
 		Secret:  os.Getenv("WEBHOOK_SECRET"),
 	}
 
-	runner, err := ci.NewDockerCI(logger)
-	if err != nil {
-		log.Fatalf("failed to set up docker client: %v\n", err)
+	var runner ci.Runner = &ci.Local{Unshare: *unshare}
+	switch {
+	case *agentAddr != "":
+		remote, err := startAgentServer(logger.Sugar(), *agentAddr, *agentCert, *agentKey, *agentInsec)
+		if err != nil {
+			log.Fatalf("failed to start agent server: %v\n", err)
+		}
+		defer remote.Close()
+		runner = remote
+	case !*local:
+		docker, err := ci.NewDockerCI(logger, ci.DockerOptions{PoolSize: *poolSize})
+		if err != nil {
+			log.Fatalf("failed to set up docker client: %v\n", err)
+		}
+		defer docker.Close()
+		runner = docker
 	}
-	defer runner.Close()
 
-	agService := web.NewAutograderService(logger, db, scms, bh, runner)
+	queue := ci.NewQueue(logger.Sugar(), db, runner, ci.QueueOptions{
+		Workers:     *workers,
+		CourseLimit: *perCourse,
+		ScriptPath:  *scriptPath,
+		Cache:       *cache,
+	})
+	// the build queue and the scheduler stop when the server shuts down
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := queue.Start(ctx); err != nil {
+		log.Fatalf("failed to start build queue: %v\n", err)
+	}
+
+	// take deadline snapshots and release reviews as deadlines and release dates pass
+	scheduler.New(logger.Sugar(), db, queue, scheduler.Options{}).Start(ctx)
+
+	agService := web.NewAutograderService(logger, db, scms, bh, queue)
 	go web.New(agService, *public, *httpAddr, *scriptPath, *fake)
 
 	lis, err := net.Listen("tcp", *grpcAddr)
 	if err != nil {
 		log.Fatalf("failed to start tcp listener: %v\n", err)
 	}
-	opt := grpc.ChainUnaryInterceptor(UserVerifier(), pb.Interceptor(logger))
-	grpcServer := grpc.NewServer(opt)
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(UserVerifier(), pb.Interceptor(logger)),
+		grpc.ChainStreamInterceptor(StreamUserVerifier()),
+	)
 
 	// Create a HTTP server for prometheus.
 	httpServer := &http.Server{
@@ -116,10 +165,63 @@ func main() {
 		}
 	}()
 
+	// shut down on interrupt or termination
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		sig := <-signals
+		logger.Sugar().Infof("Received %v; shutting down", sig)
+		grpcServer.Stop()
+	}()
+
 	pb.RegisterAutograderServiceServer(grpcServer, agService)
 	if err := grpcServer.Serve(lis); err != nil {
 		log.Fatalf("failed to start grpc server: %v\n", err)
 	}
+
+	// interrupt running builds; they are requeued when the server restarts
+	cancel()
+	queue.Wait()
+}
+
+// startAgentServer returns a runner that runs builds on the build agents connecting to the given address,
+// which must present one of the agent tokens in the AGENT_TOKENS environment variable, separated by commas.
+// The agents connect with TLS, using the given certificate and key files, unless insecure is set;
+// the agent tokens and the course access tokens in the build jobs are otherwise revealed to the network.
+func startAgentServer(logger *zap.SugaredLogger, addr, certFile, keyFile string, insecure bool) (*agent.RemoteRunner, error) {
+	var tokens []string
+	for _, token := range strings.Split(os.Getenv("AGENT_TOKENS"), ",") {
+		if token = strings.TrimSpace(token); token != "" {
+			tokens = append(tokens, token)
+		}
+	}
+	if len(tokens) == 0 {
+		return nil, errors.New("missing agent tokens in AGENT_TOKENS")
+	}
+	var opts []grpc.ServerOption
+	switch {
+	case certFile != "" || keyFile != "":
+		creds, err := credentials.NewServerTLSFromFile(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.Creds(creds))
+	case insecure:
+		logger.Warn("Build agents connect without TLS; agent tokens and course access tokens are revealed to the network")
+	default:
+		return nil, errors.New("missing certificate and key files of the TLS listener; use -agent.insecure to listen without TLS")
+	}
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	remote := agent.NewRemoteRunner(logger, agent.RemoteOptions{Tokens: tokens})
+	go func() {
+		if err := remote.NewServer(opts...).Serve(lis); err != nil {
+			log.Fatalf("failed to start agent server: %v\n", err)
+		}
+	}()
+	return remote, nil
 }
 
 func UserVerifier() grpc.UnaryServerInterceptor {
@@ -138,6 +240,30 @@ func UserVerifier() grpc.UnaryServerInterceptor {
 	}
 }
 
+func StreamUserVerifier() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		meta, ok := metadata.FromIncomingContext(ss.Context())
+		if !ok {
+			return errors.New("Could not grab metadata from context")
+		}
+		meta, err := userValidation(meta)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &userStream{ServerStream: ss, ctx: metadata.NewIncomingContext(ss.Context(), meta)})
+	}
+}
+
+// userStream is a server stream whose context holds the metadata of the verified user.
+type userStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *userStream) Context() context.Context {
+	return s.ctx
+}
+
 // userValidation returns modified metadata containing a valid user. An error is returned if the user is not authenticated.
 func userValidation(meta metadata.MD) (metadata.MD, error) {
 	for _, cookie := range meta.Get(auth.Cookie) {
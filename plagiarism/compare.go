@@ -0,0 +1,126 @@
+package plagiarism
+
+import (
+	"sort"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// location is the region of a source file spanned by a fingerprint.
+type location struct {
+	file  string
+	start int
+	end   int
+}
+
+// document is the fingerprinted source code of a submission.
+type document struct {
+	submission *pb.Submission
+	owner      string
+	// fingerprints maps the hash of each selected fingerprint to its locations.
+	fingerprints map[uint64][]location
+}
+
+// template is the set of k-gram hashes of the template code handed out to the students.
+type template map[uint64]bool
+
+// newTemplate returns the hashes of all k-grams of the given template files.
+// All k-grams are used, rather than only the winnowed ones, so that
+// template code is excluded regardless of which k-grams winnowing selects
+// in the students' code.
+func newTemplate(files map[string]string, k int) template {
+	t := make(template)
+	for path, src := range files {
+		for _, fp := range kgrams(tokenize(languageOf(path), src), k) {
+			t[fp.hash] = true
+		}
+	}
+	return t
+}
+
+// newDocument returns the fingerprints of the given source files,
+// except the fingerprints found in the template.
+func newDocument(submission *pb.Submission, owner string, files map[string]string, tmpl template, k, w int) *document {
+	doc := &document{
+		submission:   submission,
+		owner:        owner,
+		fingerprints: make(map[uint64][]location),
+	}
+	for path, src := range files {
+		for _, fp := range winnow(kgrams(tokenize(languageOf(path), src), k), w) {
+			if tmpl[fp.hash] {
+				continue
+			}
+			doc.fingerprints[fp.hash] = append(doc.fingerprints[fp.hash], location{file: path, start: fp.start, end: fp.end})
+		}
+	}
+	return doc
+}
+
+// compare returns the percentage of the smaller document's fingerprints
+// that are found in the other document, and the matching regions of the two documents.
+func compare(a, b *document) (uint32, []*pb.SimilarityMatch) {
+	smaller := len(a.fingerprints)
+	if len(b.fingerprints) < smaller {
+		smaller = len(b.fingerprints)
+	}
+	if smaller == 0 {
+		return 0, nil
+	}
+	var matches []*pb.SimilarityMatch
+	for hash, locsA := range a.fingerprints {
+		locsB, ok := b.fingerprints[hash]
+		if !ok {
+			continue
+		}
+		matches = append(matches, &pb.SimilarityMatch{
+			FileA:      locsA[0].file,
+			StartLineA: uint32(locsA[0].start),
+			EndLineA:   uint32(locsA[0].end),
+			FileB:      locsB[0].file,
+			StartLineB: uint32(locsB[0].start),
+			EndLineB:   uint32(locsB[0].end),
+		})
+	}
+	similarity := uint32(100 * len(matches) / smaller)
+	return similarity, mergeMatches(matches)
+}
+
+// mergeMatches merges overlapping and adjacent matches between the same files
+// into larger regions, and returns them ordered by file and line.
+func mergeMatches(matches []*pb.SimilarityMatch) []*pb.SimilarityMatch {
+	sort.Slice(matches, func(i, j int) bool {
+		mi, mj := matches[i], matches[j]
+		if mi.FileA != mj.FileA {
+			return mi.FileA < mj.FileA
+		}
+		if mi.FileB != mj.FileB {
+			return mi.FileB < mj.FileB
+		}
+		if mi.StartLineA != mj.StartLineA {
+			return mi.StartLineA < mj.StartLineA
+		}
+		return mi.StartLineB < mj.StartLineB
+	})
+	var merged []*pb.SimilarityMatch
+	for _, m := range matches {
+		if n := len(merged); n > 0 {
+			last := merged[n-1]
+			if last.FileA == m.FileA && last.FileB == m.FileB &&
+				m.StartLineA <= last.EndLineA+1 && m.StartLineB <= last.EndLineB+1 && m.EndLineB+1 >= last.StartLineB {
+				if m.EndLineA > last.EndLineA {
+					last.EndLineA = m.EndLineA
+				}
+				if m.StartLineB < last.StartLineB {
+					last.StartLineB = m.StartLineB
+				}
+				if m.EndLineB > last.EndLineB {
+					last.EndLineB = m.EndLineB
+				}
+				continue
+			}
+		}
+		merged = append(merged, m)
+	}
+	return merged
+}
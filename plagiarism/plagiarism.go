@@ -0,0 +1,262 @@
+// Package plagiarism detects similar code in the submissions for an assignment.
+//
+// The source code of each submission is tokenized, and identifiers and literals
+// are normalized so that renaming variables does not hide copied code. The token
+// stream is fingerprinted using winnowing (Schleimer, Wilkerson and Aiken, 2003),
+// and the fingerprints of the template code handed out in the assignments
+// repository are removed. Pairs of submissions sharing many fingerprints are
+// reported as similar, together with the matching regions of code.
+package plagiarism
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/scm"
+	"go.uber.org/zap"
+)
+
+// maxFileSize is the size of the largest source file that is compared.
+// Larger files are typically generated or data files.
+const maxFileSize = 512 * 1024
+
+// Options configures the similarity detection.
+type Options struct {
+	// K is the number of tokens in each fingerprinted k-gram;
+	// shorter matches are not detected. Defaults to 12.
+	K int
+	// Window is the winnowing window size; any match of at least
+	// K+Window-1 tokens is detected. Defaults to 8.
+	Window int
+	// Threshold is the minimum similarity, in percent, of the reported pairs.
+	// Defaults to 30.
+	Threshold uint32
+}
+
+func (o *Options) setDefaults() {
+	if o.K <= 0 {
+		o.K = 12
+	}
+	if o.Window <= 0 {
+		o.Window = 8
+	}
+	if o.Threshold == 0 {
+		o.Threshold = 30
+	}
+}
+
+// Check compares the code of the latest submissions for the given assignment,
+// and replaces the stored similarity pairs of the assignment with the pairs
+// whose similarity is at least the threshold, ordered by decreasing similarity.
+// The student and group repositories are cloned at the commit of their latest
+// submission, and the assignment's template code in the assignments repository
+// is excluded from the comparison. Repositories that cannot be cloned are skipped.
+func Check(ctx context.Context, logger *zap.SugaredLogger, db database.Database, sc scm.SCM, course *pb.Course, assignment *pb.Assignment, opts Options) ([]*pb.SimilarityPair, error) {
+	opts.setDefaults()
+	if course.GetOrganizationPath() == "" {
+		org, err := sc.GetOrganization(ctx, &scm.GetOrgOptions{ID: course.GetOrganizationID()})
+		if err != nil {
+			return nil, err
+		}
+		course.OrganizationPath = org.GetPath()
+	}
+	submissions, err := latestSubmissions(db, assignment.GetID())
+	if err != nil {
+		return nil, err
+	}
+
+	cloneDir, err := ioutil.TempDir("", "similarity")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(cloneDir)
+
+	templateDir := filepath.Join(cloneDir, pb.AssignmentRepo)
+	if err := clone(ctx, sc, course, pb.AssignmentRepo, "", templateDir); err != nil {
+		return nil, err
+	}
+	templateFiles, err := readSourceFiles(filepath.Join(templateDir, assignment.GetName()))
+	if err != nil {
+		return nil, err
+	}
+	tmpl := newTemplate(templateFiles, opts.K)
+
+	var docs []*document
+	for i, submission := range submissions {
+		owner, repoName, err := submissionRepo(db, course, submission)
+		if err != nil {
+			logger.Errorf("Skipping submission %d: %v", submission.GetID(), err)
+			continue
+		}
+		dir := filepath.Join(cloneDir, strconv.Itoa(i))
+		if err := clone(ctx, sc, course, repoName, submission.GetCommitHash(), dir); err != nil {
+			logger.Errorf("Skipping submission %d: %v", submission.GetID(), err)
+			continue
+		}
+		files, err := readSourceFiles(filepath.Join(dir, assignment.GetName()))
+		if err != nil {
+			logger.Errorf("Skipping submission %d: %v", submission.GetID(), err)
+			continue
+		}
+		docs = append(docs, newDocument(submission, owner, files, tmpl, opts.K, opts.Window))
+	}
+
+	pairs := comparePairs(docs, opts.Threshold)
+	if err := db.UpdateSimilarityPairs(assignment.GetID(), pairs); err != nil {
+		return nil, err
+	}
+	return pairs, nil
+}
+
+// comparePairs compares all pairs of documents and returns the pairs
+// whose similarity is at least threshold, ordered by decreasing similarity.
+func comparePairs(docs []*document, threshold uint32) []*pb.SimilarityPair {
+	now := time.Now().Format(pb.TimeLayout)
+	var pairs []*pb.SimilarityPair
+	for i, a := range docs {
+		for _, b := range docs[i+1:] {
+			similarity, matches := compare(a, b)
+			if similarity < threshold {
+				continue
+			}
+			pairs = append(pairs, &pb.SimilarityPair{
+				SubmissionA: a.submission.GetID(),
+				SubmissionB: b.submission.GetID(),
+				OwnerA:      a.owner,
+				OwnerB:      b.owner,
+				Similarity:  similarity,
+				CheckedDate: now,
+				Matches:     matches,
+			})
+		}
+	}
+	sort.SliceStable(pairs, func(i, j int) bool {
+		return pairs[i].GetSimilarity() > pairs[j].GetSimilarity()
+	})
+	return pairs
+}
+
+// latestSubmissions returns the latest submission of each student and group for the given assignment.
+func latestSubmissions(db database.Database, assignmentID uint64) ([]*pb.Submission, error) {
+	all, err := db.GetSubmissions(&pb.Submission{AssignmentID: assignmentID})
+	if err != nil {
+		return nil, err
+	}
+	type owner struct{ userID, groupID uint64 }
+	latest := make(map[owner]*pb.Submission)
+	for _, submission := range all {
+		key := owner{submission.GetUserID(), submission.GetGroupID()}
+		if current, ok := latest[key]; !ok || submission.GetID() > current.GetID() {
+			latest[key] = submission
+		}
+	}
+	submissions := make([]*pb.Submission, 0, len(latest))
+	for _, submission := range latest {
+		submissions = append(submissions, submission)
+	}
+	sort.Slice(submissions, func(i, j int) bool {
+		return submissions[i].GetID() < submissions[j].GetID()
+	})
+	return submissions, nil
+}
+
+// submissionRepo returns the name of the student or group owning the given submission,
+// and the name of the owner's repository.
+func submissionRepo(db database.Database, course *pb.Course, submission *pb.Submission) (string, string, error) {
+	query := &pb.Repository{OrganizationID: course.GetOrganizationID()}
+	var owner string
+	if submission.GetGroupID() > 0 {
+		group, err := db.GetGroup(submission.GetGroupID())
+		if err != nil {
+			return "", "", err
+		}
+		owner = group.GetName()
+		query.GroupID = group.GetID()
+		query.RepoType = pb.Repository_GROUP
+	} else {
+		user, err := db.GetUser(submission.GetUserID())
+		if err != nil {
+			return "", "", err
+		}
+		owner = user.GetLogin()
+		query.UserID = user.GetID()
+		query.RepoType = pb.Repository_USER
+	}
+	repos, err := db.GetRepositories(query)
+	if err != nil {
+		return "", "", err
+	}
+	if len(repos) != 1 {
+		return "", "", fmt.Errorf("found %d repositories for %s", len(repos), owner)
+	}
+	return owner, path.Base(repos[0].GetHTMLURL()), nil
+}
+
+// clone clones the given course repository into dir, and checks out the given commit.
+// If commit is empty, the default branch is used.
+// The clone URL is not included in errors, since it may contain an access token.
+func clone(ctx context.Context, sc scm.SCM, course *pb.Course, repoName, commit, dir string) error {
+	cloneURL := sc.CreateCloneURL(&scm.CreateClonePathOptions{
+		Organization: course.GetOrganizationPath(),
+		Repository:   repoName,
+	})
+	if err := exec.CommandContext(ctx, "git", "clone", "--quiet", cloneURL, dir).Run(); err != nil {
+		return fmt.Errorf("failed to clone repository %s: %w", repoName, err)
+	}
+	if commit == "" {
+		return nil
+	}
+	if strings.HasPrefix(commit, "-") {
+		return fmt.Errorf("invalid commit %q for repository %s", commit, repoName)
+	}
+	if err := exec.CommandContext(ctx, "git", "-C", dir, "checkout", "--quiet", commit, "--").Run(); err != nil {
+		return fmt.Errorf("failed to check out commit %s of repository %s: %w", commit, repoName, err)
+	}
+	return nil
+}
+
+// readSourceFiles returns the contents of the source files in the given directory
+// and its subdirectories, keyed by their slash-separated path relative to dir.
+// If the directory does not exist, no files are returned.
+func readSourceFiles(dir string) (map[string]string, error) {
+	files := make(map[string]string)
+	err := filepath.Walk(dir, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && file == dir {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if info.IsDir() {
+			if info.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if languageOf(file) == Unknown || info.Size() > maxFileSize {
+			return nil
+		}
+		b, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, file)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = string(b)
+		return nil
+	})
+	return files, err
+}
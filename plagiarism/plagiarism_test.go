@@ -0,0 +1,218 @@
+package plagiarism
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/google/go-cmp/cmp"
+)
+
+func texts(tokens []token) []string {
+	var s []string
+	for _, t := range tokens {
+		s = append(s, t.text)
+	}
+	return s
+}
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		lang Language
+		src  string
+		want []string
+	}{
+		{Go, "// Sum sums.\nfunc Sum(a, b int) int { /* add */ return a + b }", []string{
+			"func", "I", "(", "I", ",", "I", "I", ")", "I", "{", "return", "I", "+", "I", "}",
+		}},
+		{Go, "x := `raw\nstring` + \"s\\\"\" + 'c' + 0x1F", []string{"I", ":", "=", "L", "+", "L", "+", "L", "+", "L"}},
+		{Python, "# comment\ndef f(x):\n    return f\"{x}\" + '''doc\n'''  # end", []string{
+			"def", "I", "(", "I", ")", ":", "return", "L", "+", "L",
+		}},
+		{Java, "public int get() { return this.value; } // getter", []string{
+			"public", "I", "I", "(", ")", "{", "return", "this", ".", "I", ";", "}",
+		}},
+	}
+	for _, test := range tests {
+		if diff := cmp.Diff(test.want, texts(tokenize(test.lang, test.src))); diff != "" {
+			t.Errorf("tokenize(%q) mismatch (-want +got):\n%s", test.src, diff)
+		}
+	}
+
+	tokens := tokenize(Go, "a\n/* multi\nline */ b\n\"s\"\nc")
+	var lines []int
+	for _, tok := range tokens {
+		lines = append(lines, tok.line)
+	}
+	if diff := cmp.Diff([]int{1, 3, 4, 5}, lines); diff != "" {
+		t.Errorf("token lines mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestWinnow(t *testing.T) {
+	grams := []fingerprint{{hash: 77}, {hash: 74}, {hash: 42}, {hash: 17}, {hash: 98}, {hash: 50}, {hash: 17}, {hash: 98}, {hash: 8}, {hash: 88}, {hash: 67}, {hash: 39}, {hash: 77}, {hash: 74}, {hash: 42}, {hash: 17}, {hash: 98}}
+	// the example from the winnowing paper, with window size 4
+	var got []uint64
+	for _, fp := range winnow(grams, 4) {
+		got = append(got, fp.hash)
+	}
+	if diff := cmp.Diff([]uint64{17, 17, 8, 39, 17}, got); diff != "" {
+		t.Errorf("winnow() mismatch (-want +got):\n%s", diff)
+	}
+	if fps := winnow(grams[:2], 4); len(fps) != 1 || fps[0].hash != 74 {
+		t.Errorf("winnow() of short input = %v, want the smallest hash", fps)
+	}
+}
+
+const (
+	templateCode = `package lab1
+
+// Fib returns the n-th Fibonacci number.
+func Fib(n int) int {
+	// TODO(student): implement Fib
+	return 0
+}
+
+func Usage() string {
+	return "usage: lab1 [options] <number> <count> <format> <output>"
+}
+`
+	aliceCode = `package lab1
+
+// Fib returns the n-th Fibonacci number.
+func Fib(n int) int {
+	if n <= 1 {
+		return n
+	}
+	prev, cur := 0, 1
+	for i := 2; i <= n; i++ {
+		prev, cur = cur, prev+cur
+	}
+	return cur
+}
+
+func Usage() string {
+	return "usage: lab1 [options] <number> <count> <format> <output>"
+}
+`
+	// bobCode is aliceCode with renamed variables and different comments
+	bobCode = `package lab1
+
+// Fib computes Fibonacci numbers.
+func Fib(num int) int {
+	if num <= 1 {
+		return num // base case
+	}
+	a, b := 0, 1
+	for k := 2; k <= num; k++ {
+		a, b = b, a+b
+	}
+	return b
+}
+
+func Usage() string {
+	return "usage: lab1 [options] <number> <count> <format> <output>"
+}
+`
+	carolCode = `package lab1
+
+// Fib returns the n-th Fibonacci number.
+func Fib(n int) int {
+	switch {
+	case n == 0:
+		return 0
+	case n == 1:
+		return 1
+	default:
+		return Fib(n-1) + Fib(n-2)
+	}
+}
+
+func Usage() string {
+	return "usage: lab1 [options] <number> <count> <format> <output>"
+}
+`
+)
+
+func TestComparePairs(t *testing.T) {
+	const k, w = 5, 4
+	tmpl := newTemplate(map[string]string{"fib.go": templateCode}, k)
+	alice := newDocument(&pb.Submission{ID: 1}, "alice", map[string]string{"fib.go": aliceCode}, tmpl, k, w)
+	bob := newDocument(&pb.Submission{ID: 2}, "bob", map[string]string{"lab1/fib.go": bobCode}, tmpl, k, w)
+	carol := newDocument(&pb.Submission{ID: 3}, "carol", map[string]string{"fib.go": carolCode}, tmpl, k, w)
+
+	pairs := comparePairs([]*document{alice, bob, carol}, 50)
+	if len(pairs) != 1 {
+		t.Fatalf("got %d pairs, want 1: %v", len(pairs), pairs)
+	}
+	pair := pairs[0]
+	if pair.GetOwnerA() != "alice" || pair.GetOwnerB() != "bob" || pair.GetSubmissionA() != 1 || pair.GetSubmissionB() != 2 {
+		t.Errorf("got pair %s and %s, want alice and bob", pair.GetOwnerA(), pair.GetOwnerB())
+	}
+	if pair.GetSimilarity() != 100 {
+		t.Errorf("got similarity %d, want 100", pair.GetSimilarity())
+	}
+	// the matching region is the implementation of Fib, including the k-grams
+	// spanning its signature and the next function, but not the body of Usage
+	want := []*pb.SimilarityMatch{{
+		FileA: "fib.go", StartLineA: 4, EndLineA: 15,
+		FileB: "lab1/fib.go", StartLineB: 4, EndLineB: 15,
+	}}
+	if diff := cmp.Diff(want, pair.GetMatches(), cmp.Comparer(func(a, b *pb.SimilarityMatch) bool {
+		return a.String() == b.String()
+	})); diff != "" {
+		t.Errorf("matches mismatch (-want +got):\n%s", diff)
+	}
+
+	// the template code alone is never similar
+	template := newDocument(&pb.Submission{ID: 4}, "dave", map[string]string{"fib.go": templateCode}, tmpl, k, w)
+	if similarity, matches := compare(alice, template); similarity != 0 || len(matches) != 0 {
+		t.Errorf("compare(alice, template) = %d, %v, want 0 and no matches", similarity, matches)
+	}
+	if pairs := comparePairs([]*document{alice, carol}, 1); len(pairs) == 1 && pairs[0].GetSimilarity() >= 50 {
+		t.Errorf("got similarity %d for different implementations, want less than 50", pairs[0].GetSimilarity())
+	}
+}
+
+func TestReadSourceFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "similarity")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for path, content := range map[string]string{
+		"lab1/fib.go":         "package lab1",
+		"lab1/main.py":        "print()",
+		"lab1/java/Main.java": "class Main {}",
+		"lab1/README.md":      "# Lab 1",
+		"lab1/.git/hook.go":   "package hook",
+		"lab2/fib.go":         "package lab2",
+	} {
+		file := filepath.Join(dir, filepath.FromSlash(path))
+		if err := os.MkdirAll(filepath.Dir(file), 0o700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(file, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	files, err := readSourceFiles(filepath.Join(dir, "lab1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		"fib.go":         "package lab1",
+		"main.py":        "print()",
+		"java/Main.java": "class Main {}",
+	}
+	if diff := cmp.Diff(want, files); diff != "" {
+		t.Errorf("readSourceFiles() mismatch (-want +got):\n%s", diff)
+	}
+
+	files, err = readSourceFiles(filepath.Join(dir, "missing"))
+	if err != nil || len(files) != 0 {
+		t.Errorf("readSourceFiles(missing) = %v, %v, want no files", files, err)
+	}
+}
@@ -0,0 +1,225 @@
+package plagiarism
+
+import (
+	"path/filepath"
+	"strings"
+	"unicode"
+)
+
+// Language is a programming language supported by the similarity detection.
+type Language int
+
+// The supported languages are those supported by the test scripts in ci/scripts.
+const (
+	Unknown Language = iota
+	Go
+	Python
+	Java
+)
+
+// languageOf returns the language of the given source file, based on its extension.
+func languageOf(path string) Language {
+	switch filepath.Ext(path) {
+	case ".go":
+		return Go
+	case ".py":
+		return Python
+	case ".java":
+		return Java
+	}
+	return Unknown
+}
+
+var keywords = map[Language]map[string]bool{
+	Go: set("break", "case", "chan", "const", "continue", "default", "defer", "else",
+		"fallthrough", "for", "func", "go", "goto", "if", "import", "interface", "map",
+		"package", "range", "return", "select", "struct", "switch", "type", "var"),
+	Python: set("and", "as", "assert", "async", "await", "break", "class", "continue",
+		"def", "del", "elif", "else", "except", "finally", "for", "from", "global", "if",
+		"import", "in", "is", "lambda", "nonlocal", "not", "or", "pass", "raise", "return",
+		"try", "while", "with", "yield"),
+	Java: set("abstract", "assert", "break", "case", "catch", "class", "continue",
+		"default", "do", "else", "enum", "extends", "final", "finally", "for", "if",
+		"implements", "import", "instanceof", "interface", "new", "package", "private",
+		"protected", "public", "return", "static", "super", "switch", "synchronized",
+		"this", "throw", "throws", "try", "void", "while"),
+}
+
+func set(words ...string) map[string]bool {
+	m := make(map[string]bool, len(words))
+	for _, w := range words {
+		m[w] = true
+	}
+	return m
+}
+
+// Normalized tokens. Identifiers and literals are normalized, so that renaming
+// variables or changing constants does not hide copied code.
+const (
+	identToken   = "I"
+	literalToken = "L"
+)
+
+// token is a normalized token and the line it starts on.
+type token struct {
+	text string
+	line int
+}
+
+// tokenize returns the normalized tokens of the given source code.
+// Comments and white space are skipped, keywords and operators are kept as is,
+// and identifiers and literals are replaced by identToken and literalToken.
+func tokenize(lang Language, src string) []token {
+	var tokens []token
+	s := &scanner{src: []rune(src), line: 1}
+	for {
+		s.skipSpaceAndComments(lang)
+		if s.done() {
+			return tokens
+		}
+		line := s.line
+		r := s.peek(0)
+		switch {
+		case unicode.IsLetter(r) || r == '_':
+			word := s.word()
+			if keywords[lang][word] {
+				tokens = append(tokens, token{text: word, line: line})
+			} else if lang == Python && isStringPrefix(word) && isQuote(s.peek(0)) {
+				s.str(lang)
+				tokens = append(tokens, token{text: literalToken, line: line})
+			} else {
+				tokens = append(tokens, token{text: identToken, line: line})
+			}
+		case unicode.IsDigit(r):
+			s.number()
+			tokens = append(tokens, token{text: literalToken, line: line})
+		case isQuote(r) || r == '`' && lang == Go:
+			s.str(lang)
+			tokens = append(tokens, token{text: literalToken, line: line})
+		default:
+			s.next()
+			tokens = append(tokens, token{text: string(r), line: line})
+		}
+	}
+}
+
+func isQuote(r rune) bool {
+	return r == '"' || r == '\''
+}
+
+// isStringPrefix returns true if word is a Python string prefix, such as f in f"...".
+func isStringPrefix(word string) bool {
+	switch strings.ToLower(word) {
+	case "r", "u", "b", "f", "br", "rb", "fr", "rf":
+		return true
+	}
+	return false
+}
+
+// scanner is a minimal scanner for the supported languages.
+type scanner struct {
+	src  []rune
+	pos  int
+	line int
+}
+
+func (s *scanner) done() bool {
+	return s.pos >= len(s.src)
+}
+
+// peek returns the rune at offset i from the current position, or 0 at the end of the source.
+func (s *scanner) peek(i int) rune {
+	if s.pos+i >= len(s.src) {
+		return 0
+	}
+	return s.src[s.pos+i]
+}
+
+func (s *scanner) next() rune {
+	r := s.src[s.pos]
+	s.pos++
+	if r == '\n' {
+		s.line++
+	}
+	return r
+}
+
+func (s *scanner) hasPrefix(prefix string) bool {
+	for i, r := range []rune(prefix) {
+		if s.peek(i) != r {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *scanner) skipSpaceAndComments(lang Language) {
+	for !s.done() {
+		switch {
+		case unicode.IsSpace(s.peek(0)):
+			s.next()
+		case lang == Python && s.hasPrefix("#"),
+			lang != Python && s.hasPrefix("//"):
+			for !s.done() && s.peek(0) != '\n' {
+				s.next()
+			}
+		case lang != Python && s.hasPrefix("/*"):
+			s.next()
+			s.next()
+			for !s.done() && !s.hasPrefix("*/") {
+				s.next()
+			}
+			if !s.done() {
+				s.next()
+				s.next()
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (s *scanner) word() string {
+	start := s.pos
+	for !s.done() && (unicode.IsLetter(s.peek(0)) || unicode.IsDigit(s.peek(0)) || s.peek(0) == '_') {
+		s.next()
+	}
+	return string(s.src[start:s.pos])
+}
+
+func (s *scanner) number() {
+	for !s.done() && (unicode.IsLetter(s.peek(0)) || unicode.IsDigit(s.peek(0)) || s.peek(0) == '_' || s.peek(0) == '.') {
+		s.next()
+	}
+}
+
+// str skips a string or character literal, including Python's triple-quoted strings.
+func (s *scanner) str(lang Language) {
+	quote := s.next()
+	if lang == Python && s.peek(0) == quote && s.peek(1) == quote {
+		s.next()
+		s.next()
+		end := strings.Repeat(string(quote), 3)
+		for !s.done() && !s.hasPrefix(end) {
+			if s.next() == '\\' && !s.done() {
+				s.next()
+			}
+		}
+		for i := 0; i < 3 && !s.done(); i++ {
+			s.next()
+		}
+		return
+	}
+	for !s.done() {
+		r := s.next()
+		switch {
+		case r == quote:
+			return
+		case r == '\\' && quote != '`' && !s.done():
+			s.next()
+		case r == '\n' && quote != '`':
+			// unterminated literal
+			return
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package plagiarism
+
+import (
+	"hash/fnv"
+)
+
+// fingerprint is the hash of a k-gram of tokens and the lines spanned by the k-gram.
+type fingerprint struct {
+	hash  uint64
+	start int
+	end   int
+}
+
+// kgrams returns the fingerprints of all k-grams of the given tokens.
+// If there are fewer than k tokens, no fingerprints are returned.
+func kgrams(tokens []token, k int) []fingerprint {
+	if len(tokens) < k {
+		return nil
+	}
+	fps := make([]fingerprint, 0, len(tokens)-k+1)
+	for i := 0; i+k <= len(tokens); i++ {
+		h := fnv.New64a()
+		for _, t := range tokens[i : i+k] {
+			h.Write([]byte(t.text))
+			h.Write([]byte{0})
+		}
+		fps = append(fps, fingerprint{hash: h.Sum64(), start: tokens[i].line, end: tokens[i+k-1].line})
+	}
+	return fps
+}
+
+// winnow selects the fingerprints of the given k-grams using the winnowing algorithm:
+// in each window of w consecutive k-grams, the k-gram with the smallest hash is selected,
+// preferring the rightmost one on ties. Any match of at least w+k-1 tokens is thereby
+// guaranteed to share a fingerprint, while only a fraction of the k-grams is kept.
+func winnow(grams []fingerprint, w int) []fingerprint {
+	if len(grams) == 0 {
+		return nil
+	}
+	if len(grams) < w {
+		w = len(grams)
+	}
+	var selected []fingerprint
+	last := -1
+	for i := 0; i+w <= len(grams); i++ {
+		min := i
+		for j := i + 1; j < i+w; j++ {
+			if grams[j].hash <= grams[min].hash {
+				min = j
+			}
+		}
+		if min != last {
+			selected = append(selected, grams[min])
+			last = min
+		}
+	}
+	return selected
+}
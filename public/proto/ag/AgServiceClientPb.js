@@ -70,6 +70,18 @@ var AutograderServiceClient = /** @class */ (function () {
         this.methodInfoUpdateAssignments = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Void, function (request) {
             return request.serializeBinary();
         }, ag_pb_1.Void.deserializeBinary);
+        this.methodInfoGetDeadlineExtensions = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.DeadlineExtensions, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.DeadlineExtensions.deserializeBinary);
+        this.methodInfoGrantDeadlineExtension = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.DeadlineExtension, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.DeadlineExtension.deserializeBinary);
+        this.methodInfoRevokeDeadlineExtension = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Void, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.Void.deserializeBinary);
+        this.methodInfoRecomputeSlipDays = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.SlipDaysRecomputation, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.SlipDaysRecomputation.deserializeBinary);
         this.methodInfoGetEnrollmentsByUser = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Enrollments, function (request) {
             return request.serializeBinary();
         }, ag_pb_1.Enrollments.deserializeBinary);
@@ -100,6 +112,48 @@ var AutograderServiceClient = /** @class */ (function () {
         this.methodInfoRebuildSubmission = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Submission, function (request) {
             return request.serializeBinary();
         }, ag_pb_1.Submission.deserializeBinary);
+        this.methodInfoGetSubmissionAttempts = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.SubmissionAttempts, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.SubmissionAttempts.deserializeBinary);
+        this.methodInfoGetSubmissionAttempt = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.SubmissionAttempt, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.SubmissionAttempt.deserializeBinary);
+        this.methodInfoUpdateGradedAttempt = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Submission, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.Submission.deserializeBinary);
+        this.methodInfoSubmissionStream = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Submission, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.Submission.deserializeBinary);
+        this.methodInfoGetTestStatistics = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.TestStatistics, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.TestStatistics.deserializeBinary);
+        this.methodInfoGetCourseStatistics = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.CourseStatistics, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.CourseStatistics.deserializeBinary);
+        this.methodInfoExportGradebook = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.GradebookFile, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.GradebookFile.deserializeBinary);
+        this.methodInfoGetBuildJobs = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.BuildJobs, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.BuildJobs.deserializeBinary);
+        this.methodInfoCancelBuildJob = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Void, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.Void.deserializeBinary);
+        this.methodInfoBuildLogStream = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.BuildLogLine, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.BuildLogLine.deserializeBinary);
+        this.methodInfoGetBuildQuotas = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.BuildQuotas, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.BuildQuotas.deserializeBinary);
+        this.methodInfoResetBuildQuota = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.Void, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.Void.deserializeBinary);
+        this.methodInfoCheckSimilarity = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.SimilarityPairs, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.SimilarityPairs.deserializeBinary);
+        this.methodInfoGetSimilarityPairs = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.SimilarityPairs, function (request) {
+            return request.serializeBinary();
+        }, ag_pb_1.SimilarityPairs.deserializeBinary);
         this.methodInfoCreateBenchmark = new grpcWeb.AbstractClientBase.MethodInfo(ag_pb_1.GradingBenchmark, function (request) {
             return request.serializeBinary();
         }, ag_pb_1.GradingBenchmark.deserializeBinary);
@@ -285,6 +339,34 @@ var AutograderServiceClient = /** @class */ (function () {
         return this.client_.unaryCall(this.hostname_ +
             '/ag.AutograderService/UpdateAssignments', request, metadata || {}, this.methodInfoUpdateAssignments);
     };
+    AutograderServiceClient.prototype.getDeadlineExtensions = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/ag.AutograderService/GetDeadlineExtensions', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetDeadlineExtensions, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/ag.AutograderService/GetDeadlineExtensions', request, metadata || {}, this.methodInfoGetDeadlineExtensions);
+    };
+    AutograderServiceClient.prototype.grantDeadlineExtension = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/ag.AutograderService/GrantDeadlineExtension', this.hostname_).toString(), request, metadata || {}, this.methodInfoGrantDeadlineExtension, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/ag.AutograderService/GrantDeadlineExtension', request, metadata || {}, this.methodInfoGrantDeadlineExtension);
+    };
+    AutograderServiceClient.prototype.revokeDeadlineExtension = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/ag.AutograderService/RevokeDeadlineExtension', this.hostname_).toString(), request, metadata || {}, this.methodInfoRevokeDeadlineExtension, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/ag.AutograderService/RevokeDeadlineExtension', request, metadata || {}, this.methodInfoRevokeDeadlineExtension);
+    };
+    AutograderServiceClient.prototype.recomputeSlipDays = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/ag.AutograderService/RecomputeSlipDays', this.hostname_).toString(), request, metadata || {}, this.methodInfoRecomputeSlipDays, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/ag.AutograderService/RecomputeSlipDays', request, metadata || {}, this.methodInfoRecomputeSlipDays);
+    };
     AutograderServiceClient.prototype.getEnrollmentsByUser = function (request, metadata, callback) {
         if (callback !== undefined) {
             return this.client_.rpcCall(new URL('/ag.AutograderService/GetEnrollmentsByUser', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetEnrollmentsByUser, callback);
@@ -355,6 +437,98 @@ var AutograderServiceClient = /** @class */ (function () {
         return this.client_.unaryCall(this.hostname_ +
             '/ag.AutograderService/RebuildSubmission', request, metadata || {}, this.methodInfoRebuildSubmission);
     };
+    AutograderServiceClient.prototype.getSubmissionAttempts = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/ag.AutograderService/GetSubmissionAttempts', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetSubmissionAttempts, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/ag.AutograderService/GetSubmissionAttempts', request, metadata || {}, this.methodInfoGetSubmissionAttempts);
+    };
+    AutograderServiceClient.prototype.getSubmissionAttempt = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/ag.AutograderService/GetSubmissionAttempt', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetSubmissionAttempt, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/ag.AutograderService/GetSubmissionAttempt', request, metadata || {}, this.methodInfoGetSubmissionAttempt);
+    };
+    AutograderServiceClient.prototype.updateGradedAttempt = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/ag.AutograderService/UpdateGradedAttempt', this.hostname_).toString(), request, metadata || {}, this.methodInfoUpdateGradedAttempt, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/ag.AutograderService/UpdateGradedAttempt', request, metadata || {}, this.methodInfoUpdateGradedAttempt);
+    };
+    AutograderServiceClient.prototype.submissionStream = function (request, metadata) {
+        return this.client_.serverStreaming(this.hostname_ +
+            '/ag.AutograderService/SubmissionStream', request, metadata || {}, this.methodInfoSubmissionStream);
+    };
+    AutograderServiceClient.prototype.getTestStatistics = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/ag.AutograderService/GetTestStatistics', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetTestStatistics, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/ag.AutograderService/GetTestStatistics', request, metadata || {}, this.methodInfoGetTestStatistics);
+    };
+    AutograderServiceClient.prototype.getCourseStatistics = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/ag.AutograderService/GetCourseStatistics', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetCourseStatistics, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/ag.AutograderService/GetCourseStatistics', request, metadata || {}, this.methodInfoGetCourseStatistics);
+    };
+    AutograderServiceClient.prototype.exportGradebook = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/ag.AutograderService/ExportGradebook', this.hostname_).toString(), request, metadata || {}, this.methodInfoExportGradebook, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/ag.AutograderService/ExportGradebook', request, metadata || {}, this.methodInfoExportGradebook);
+    };
+    AutograderServiceClient.prototype.getBuildJobs = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/ag.AutograderService/GetBuildJobs', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetBuildJobs, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/ag.AutograderService/GetBuildJobs', request, metadata || {}, this.methodInfoGetBuildJobs);
+    };
+    AutograderServiceClient.prototype.cancelBuildJob = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/ag.AutograderService/CancelBuildJob', this.hostname_).toString(), request, metadata || {}, this.methodInfoCancelBuildJob, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/ag.AutograderService/CancelBuildJob', request, metadata || {}, this.methodInfoCancelBuildJob);
+    };
+    AutograderServiceClient.prototype.buildLogStream = function (request, metadata) {
+        return this.client_.serverStreaming(this.hostname_ +
+            '/ag.AutograderService/BuildLogStream', request, metadata || {}, this.methodInfoBuildLogStream);
+    };
+    AutograderServiceClient.prototype.getBuildQuotas = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/ag.AutograderService/GetBuildQuotas', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetBuildQuotas, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/ag.AutograderService/GetBuildQuotas', request, metadata || {}, this.methodInfoGetBuildQuotas);
+    };
+    AutograderServiceClient.prototype.resetBuildQuota = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/ag.AutograderService/ResetBuildQuota', this.hostname_).toString(), request, metadata || {}, this.methodInfoResetBuildQuota, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/ag.AutograderService/ResetBuildQuota', request, metadata || {}, this.methodInfoResetBuildQuota);
+    };
+    AutograderServiceClient.prototype.checkSimilarity = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/ag.AutograderService/CheckSimilarity', this.hostname_).toString(), request, metadata || {}, this.methodInfoCheckSimilarity, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/ag.AutograderService/CheckSimilarity', request, metadata || {}, this.methodInfoCheckSimilarity);
+    };
+    AutograderServiceClient.prototype.getSimilarityPairs = function (request, metadata, callback) {
+        if (callback !== undefined) {
+            return this.client_.rpcCall(new URL('/ag.AutograderService/GetSimilarityPairs', this.hostname_).toString(), request, metadata || {}, this.methodInfoGetSimilarityPairs, callback);
+        }
+        return this.client_.unaryCall(this.hostname_ +
+            '/ag.AutograderService/GetSimilarityPairs', request, metadata || {}, this.methodInfoGetSimilarityPairs);
+    };
     AutograderServiceClient.prototype.createBenchmark = function (request, metadata, callback) {
         if (callback !== undefined) {
             return this.client_.rpcCall(new URL('/ag.AutograderService/CreateBenchmark', this.hostname_).toString(), request, metadata || {}, this.methodInfoCreateBenchmark, callback);
@@ -18,16 +18,28 @@ import {
   Assignments,
   AuthorizationResponse,
   Benchmarks,
+  BuildJobRequest,
+  BuildJobs,
+  BuildLogLine,
+  BuildLogRequest,
+  BuildQuotaRequest,
+  BuildQuotas,
   Course,
   CourseRequest,
+  CourseStatistics,
   CourseSubmissions,
   CourseUserRequest,
   Courses,
+  DeadlineExtension,
+  DeadlineExtensionRequest,
+  DeadlineExtensions,
   Enrollment,
   EnrollmentRequest,
   EnrollmentStatusRequest,
   Enrollments,
   GetGroupRequest,
+  GradebookFile,
+  GradebookRequest,
   GradingBenchmark,
   GradingCriterion,
   Group,
@@ -43,11 +55,20 @@ import {
   Review,
   ReviewRequest,
   Reviewers,
+  SimilarityPairs,
+  SimilarityRequest,
+  SlipDaysRecomputation,
+  SlipDaysRequest,
   Submission,
+  SubmissionAttempt,
+  SubmissionAttemptRequest,
+  SubmissionAttempts,
   SubmissionRequest,
   SubmissionReviewersRequest,
   Submissions,
   SubmissionsForCourseRequest,
+  TestStatistics,
+  TestStatisticsRequest,
   URLRequest,
   UpdateSubmissionRequest,
   UpdateSubmissionsRequest,
@@ -815,6 +836,162 @@ export class AutograderServiceClient {
     this.methodInfoUpdateAssignments);
   }
 
+  methodInfoGetDeadlineExtensions = new grpcWeb.AbstractClientBase.MethodInfo(
+    DeadlineExtensions,
+    (request: CourseRequest) => {
+      return request.serializeBinary();
+    },
+    DeadlineExtensions.deserializeBinary
+  );
+
+  getDeadlineExtensions(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null): Promise<DeadlineExtensions>;
+
+  getDeadlineExtensions(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: DeadlineExtensions) => void): grpcWeb.ClientReadableStream<DeadlineExtensions>;
+
+  getDeadlineExtensions(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: DeadlineExtensions) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/ag.AutograderService/GetDeadlineExtensions', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGetDeadlineExtensions,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/ag.AutograderService/GetDeadlineExtensions',
+    request,
+    metadata || {},
+    this.methodInfoGetDeadlineExtensions);
+  }
+
+  methodInfoGrantDeadlineExtension = new grpcWeb.AbstractClientBase.MethodInfo(
+    DeadlineExtension,
+    (request: DeadlineExtensionRequest) => {
+      return request.serializeBinary();
+    },
+    DeadlineExtension.deserializeBinary
+  );
+
+  grantDeadlineExtension(
+    request: DeadlineExtensionRequest,
+    metadata: grpcWeb.Metadata | null): Promise<DeadlineExtension>;
+
+  grantDeadlineExtension(
+    request: DeadlineExtensionRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: DeadlineExtension) => void): grpcWeb.ClientReadableStream<DeadlineExtension>;
+
+  grantDeadlineExtension(
+    request: DeadlineExtensionRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: DeadlineExtension) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/ag.AutograderService/GrantDeadlineExtension', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGrantDeadlineExtension,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/ag.AutograderService/GrantDeadlineExtension',
+    request,
+    metadata || {},
+    this.methodInfoGrantDeadlineExtension);
+  }
+
+  methodInfoRevokeDeadlineExtension = new grpcWeb.AbstractClientBase.MethodInfo(
+    Void,
+    (request: DeadlineExtensionRequest) => {
+      return request.serializeBinary();
+    },
+    Void.deserializeBinary
+  );
+
+  revokeDeadlineExtension(
+    request: DeadlineExtensionRequest,
+    metadata: grpcWeb.Metadata | null): Promise<Void>;
+
+  revokeDeadlineExtension(
+    request: DeadlineExtensionRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: Void) => void): grpcWeb.ClientReadableStream<Void>;
+
+  revokeDeadlineExtension(
+    request: DeadlineExtensionRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: Void) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/ag.AutograderService/RevokeDeadlineExtension', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoRevokeDeadlineExtension,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/ag.AutograderService/RevokeDeadlineExtension',
+    request,
+    metadata || {},
+    this.methodInfoRevokeDeadlineExtension);
+  }
+
+  methodInfoRecomputeSlipDays = new grpcWeb.AbstractClientBase.MethodInfo(
+    SlipDaysRecomputation,
+    (request: SlipDaysRequest) => {
+      return request.serializeBinary();
+    },
+    SlipDaysRecomputation.deserializeBinary
+  );
+
+  recomputeSlipDays(
+    request: SlipDaysRequest,
+    metadata: grpcWeb.Metadata | null): Promise<SlipDaysRecomputation>;
+
+  recomputeSlipDays(
+    request: SlipDaysRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: SlipDaysRecomputation) => void): grpcWeb.ClientReadableStream<SlipDaysRecomputation>;
+
+  recomputeSlipDays(
+    request: SlipDaysRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: SlipDaysRecomputation) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/ag.AutograderService/RecomputeSlipDays', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoRecomputeSlipDays,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/ag.AutograderService/RecomputeSlipDays',
+    request,
+    metadata || {},
+    this.methodInfoRecomputeSlipDays);
+  }
+
   methodInfoGetEnrollmentsByUser = new grpcWeb.AbstractClientBase.MethodInfo(
     Enrollments,
     (request: EnrollmentStatusRequest) => {
@@ -1205,6 +1382,512 @@ export class AutograderServiceClient {
     this.methodInfoRebuildSubmission);
   }
 
+  methodInfoGetSubmissionAttempts = new grpcWeb.AbstractClientBase.MethodInfo(
+    SubmissionAttempts,
+    (request: SubmissionAttemptRequest) => {
+      return request.serializeBinary();
+    },
+    SubmissionAttempts.deserializeBinary
+  );
+
+  getSubmissionAttempts(
+    request: SubmissionAttemptRequest,
+    metadata: grpcWeb.Metadata | null): Promise<SubmissionAttempts>;
+
+  getSubmissionAttempts(
+    request: SubmissionAttemptRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: SubmissionAttempts) => void): grpcWeb.ClientReadableStream<SubmissionAttempts>;
+
+  getSubmissionAttempts(
+    request: SubmissionAttemptRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: SubmissionAttempts) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/ag.AutograderService/GetSubmissionAttempts', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGetSubmissionAttempts,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/ag.AutograderService/GetSubmissionAttempts',
+    request,
+    metadata || {},
+    this.methodInfoGetSubmissionAttempts);
+  }
+
+  methodInfoGetSubmissionAttempt = new grpcWeb.AbstractClientBase.MethodInfo(
+    SubmissionAttempt,
+    (request: SubmissionAttemptRequest) => {
+      return request.serializeBinary();
+    },
+    SubmissionAttempt.deserializeBinary
+  );
+
+  getSubmissionAttempt(
+    request: SubmissionAttemptRequest,
+    metadata: grpcWeb.Metadata | null): Promise<SubmissionAttempt>;
+
+  getSubmissionAttempt(
+    request: SubmissionAttemptRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: SubmissionAttempt) => void): grpcWeb.ClientReadableStream<SubmissionAttempt>;
+
+  getSubmissionAttempt(
+    request: SubmissionAttemptRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: SubmissionAttempt) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/ag.AutograderService/GetSubmissionAttempt', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGetSubmissionAttempt,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/ag.AutograderService/GetSubmissionAttempt',
+    request,
+    metadata || {},
+    this.methodInfoGetSubmissionAttempt);
+  }
+
+  methodInfoUpdateGradedAttempt = new grpcWeb.AbstractClientBase.MethodInfo(
+    Submission,
+    (request: SubmissionAttemptRequest) => {
+      return request.serializeBinary();
+    },
+    Submission.deserializeBinary
+  );
+
+  updateGradedAttempt(
+    request: SubmissionAttemptRequest,
+    metadata: grpcWeb.Metadata | null): Promise<Submission>;
+
+  updateGradedAttempt(
+    request: SubmissionAttemptRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: Submission) => void): grpcWeb.ClientReadableStream<Submission>;
+
+  updateGradedAttempt(
+    request: SubmissionAttemptRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: Submission) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/ag.AutograderService/UpdateGradedAttempt', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoUpdateGradedAttempt,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/ag.AutograderService/UpdateGradedAttempt',
+    request,
+    metadata || {},
+    this.methodInfoUpdateGradedAttempt);
+  }
+
+  methodInfoSubmissionStream = new grpcWeb.AbstractClientBase.MethodInfo(
+    Submission,
+    (request: CourseRequest) => {
+      return request.serializeBinary();
+    },
+    Submission.deserializeBinary
+  );
+
+  submissionStream(
+    request: CourseRequest,
+    metadata?: grpcWeb.Metadata) {
+    return this.client_.serverStreaming(
+      this.hostname_ +
+        '/ag.AutograderService/SubmissionStream',
+      request,
+      metadata || {},
+      this.methodInfoSubmissionStream);
+  }
+
+  methodInfoGetTestStatistics = new grpcWeb.AbstractClientBase.MethodInfo(
+    TestStatistics,
+    (request: TestStatisticsRequest) => {
+      return request.serializeBinary();
+    },
+    TestStatistics.deserializeBinary
+  );
+
+  getTestStatistics(
+    request: TestStatisticsRequest,
+    metadata: grpcWeb.Metadata | null): Promise<TestStatistics>;
+
+  getTestStatistics(
+    request: TestStatisticsRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: TestStatistics) => void): grpcWeb.ClientReadableStream<TestStatistics>;
+
+  getTestStatistics(
+    request: TestStatisticsRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: TestStatistics) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/ag.AutograderService/GetTestStatistics', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGetTestStatistics,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/ag.AutograderService/GetTestStatistics',
+    request,
+    metadata || {},
+    this.methodInfoGetTestStatistics);
+  }
+
+  methodInfoGetCourseStatistics = new grpcWeb.AbstractClientBase.MethodInfo(
+    CourseStatistics,
+    (request: CourseRequest) => {
+      return request.serializeBinary();
+    },
+    CourseStatistics.deserializeBinary
+  );
+
+  getCourseStatistics(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null): Promise<CourseStatistics>;
+
+  getCourseStatistics(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: CourseStatistics) => void): grpcWeb.ClientReadableStream<CourseStatistics>;
+
+  getCourseStatistics(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: CourseStatistics) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/ag.AutograderService/GetCourseStatistics', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGetCourseStatistics,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/ag.AutograderService/GetCourseStatistics',
+    request,
+    metadata || {},
+    this.methodInfoGetCourseStatistics);
+  }
+
+  methodInfoExportGradebook = new grpcWeb.AbstractClientBase.MethodInfo(
+    GradebookFile,
+    (request: GradebookRequest) => {
+      return request.serializeBinary();
+    },
+    GradebookFile.deserializeBinary
+  );
+
+  exportGradebook(
+    request: GradebookRequest,
+    metadata: grpcWeb.Metadata | null): Promise<GradebookFile>;
+
+  exportGradebook(
+    request: GradebookRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: GradebookFile) => void): grpcWeb.ClientReadableStream<GradebookFile>;
+
+  exportGradebook(
+    request: GradebookRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: GradebookFile) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/ag.AutograderService/ExportGradebook', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoExportGradebook,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/ag.AutograderService/ExportGradebook',
+    request,
+    metadata || {},
+    this.methodInfoExportGradebook);
+  }
+
+  methodInfoGetBuildJobs = new grpcWeb.AbstractClientBase.MethodInfo(
+    BuildJobs,
+    (request: CourseRequest) => {
+      return request.serializeBinary();
+    },
+    BuildJobs.deserializeBinary
+  );
+
+  getBuildJobs(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null): Promise<BuildJobs>;
+
+  getBuildJobs(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: BuildJobs) => void): grpcWeb.ClientReadableStream<BuildJobs>;
+
+  getBuildJobs(
+    request: CourseRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: BuildJobs) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/ag.AutograderService/GetBuildJobs', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGetBuildJobs,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/ag.AutograderService/GetBuildJobs',
+    request,
+    metadata || {},
+    this.methodInfoGetBuildJobs);
+  }
+
+  methodInfoCancelBuildJob = new grpcWeb.AbstractClientBase.MethodInfo(
+    Void,
+    (request: BuildJobRequest) => {
+      return request.serializeBinary();
+    },
+    Void.deserializeBinary
+  );
+
+  cancelBuildJob(
+    request: BuildJobRequest,
+    metadata: grpcWeb.Metadata | null): Promise<Void>;
+
+  cancelBuildJob(
+    request: BuildJobRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: Void) => void): grpcWeb.ClientReadableStream<Void>;
+
+  cancelBuildJob(
+    request: BuildJobRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: Void) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/ag.AutograderService/CancelBuildJob', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoCancelBuildJob,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/ag.AutograderService/CancelBuildJob',
+    request,
+    metadata || {},
+    this.methodInfoCancelBuildJob);
+  }
+
+  methodInfoBuildLogStream = new grpcWeb.AbstractClientBase.MethodInfo(
+    BuildLogLine,
+    (request: BuildLogRequest) => {
+      return request.serializeBinary();
+    },
+    BuildLogLine.deserializeBinary
+  );
+
+  buildLogStream(
+    request: BuildLogRequest,
+    metadata?: grpcWeb.Metadata) {
+    return this.client_.serverStreaming(
+      this.hostname_ +
+        '/ag.AutograderService/BuildLogStream',
+      request,
+      metadata || {},
+      this.methodInfoBuildLogStream);
+  }
+
+  methodInfoGetBuildQuotas = new grpcWeb.AbstractClientBase.MethodInfo(
+    BuildQuotas,
+    (request: BuildQuotaRequest) => {
+      return request.serializeBinary();
+    },
+    BuildQuotas.deserializeBinary
+  );
+
+  getBuildQuotas(
+    request: BuildQuotaRequest,
+    metadata: grpcWeb.Metadata | null): Promise<BuildQuotas>;
+
+  getBuildQuotas(
+    request: BuildQuotaRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: BuildQuotas) => void): grpcWeb.ClientReadableStream<BuildQuotas>;
+
+  getBuildQuotas(
+    request: BuildQuotaRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: BuildQuotas) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/ag.AutograderService/GetBuildQuotas', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGetBuildQuotas,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/ag.AutograderService/GetBuildQuotas',
+    request,
+    metadata || {},
+    this.methodInfoGetBuildQuotas);
+  }
+
+  methodInfoResetBuildQuota = new grpcWeb.AbstractClientBase.MethodInfo(
+    Void,
+    (request: BuildQuotaRequest) => {
+      return request.serializeBinary();
+    },
+    Void.deserializeBinary
+  );
+
+  resetBuildQuota(
+    request: BuildQuotaRequest,
+    metadata: grpcWeb.Metadata | null): Promise<Void>;
+
+  resetBuildQuota(
+    request: BuildQuotaRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: Void) => void): grpcWeb.ClientReadableStream<Void>;
+
+  resetBuildQuota(
+    request: BuildQuotaRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: Void) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/ag.AutograderService/ResetBuildQuota', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoResetBuildQuota,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/ag.AutograderService/ResetBuildQuota',
+    request,
+    metadata || {},
+    this.methodInfoResetBuildQuota);
+  }
+
+  methodInfoCheckSimilarity = new grpcWeb.AbstractClientBase.MethodInfo(
+    SimilarityPairs,
+    (request: SimilarityRequest) => {
+      return request.serializeBinary();
+    },
+    SimilarityPairs.deserializeBinary
+  );
+
+  checkSimilarity(
+    request: SimilarityRequest,
+    metadata: grpcWeb.Metadata | null): Promise<SimilarityPairs>;
+
+  checkSimilarity(
+    request: SimilarityRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: SimilarityPairs) => void): grpcWeb.ClientReadableStream<SimilarityPairs>;
+
+  checkSimilarity(
+    request: SimilarityRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: SimilarityPairs) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/ag.AutograderService/CheckSimilarity', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoCheckSimilarity,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/ag.AutograderService/CheckSimilarity',
+    request,
+    metadata || {},
+    this.methodInfoCheckSimilarity);
+  }
+
+  methodInfoGetSimilarityPairs = new grpcWeb.AbstractClientBase.MethodInfo(
+    SimilarityPairs,
+    (request: SimilarityRequest) => {
+      return request.serializeBinary();
+    },
+    SimilarityPairs.deserializeBinary
+  );
+
+  getSimilarityPairs(
+    request: SimilarityRequest,
+    metadata: grpcWeb.Metadata | null): Promise<SimilarityPairs>;
+
+  getSimilarityPairs(
+    request: SimilarityRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback: (err: grpcWeb.Error,
+               response: SimilarityPairs) => void): grpcWeb.ClientReadableStream<SimilarityPairs>;
+
+  getSimilarityPairs(
+    request: SimilarityRequest,
+    metadata: grpcWeb.Metadata | null,
+    callback?: (err: grpcWeb.Error,
+               response: SimilarityPairs) => void) {
+    if (callback !== undefined) {
+      return this.client_.rpcCall(
+        new URL('/ag.AutograderService/GetSimilarityPairs', this.hostname_).toString(),
+        request,
+        metadata || {},
+        this.methodInfoGetSimilarityPairs,
+        callback);
+    }
+    return this.client_.unaryCall(
+    this.hostname_ +
+      '/ag.AutograderService/GetSimilarityPairs',
+    request,
+    metadata || {},
+    this.methodInfoGetSimilarityPairs);
+  }
+
   methodInfoCreateBenchmark = new grpcWeb.AbstractClientBase.MethodInfo(
     GradingBenchmark,
     (request: GradingBenchmark) => {
@@ -214,6 +214,15 @@ export class Course extends jspb.Message {
   getEnrolled(): Enrollment.UserStatus;
   setEnrolled(value: Enrollment.UserStatus): Course;
 
+  getGraceperiod(): string;
+  setGraceperiod(value: string): Course;
+
+  getLatepolicy(): Course.LatePolicy;
+  setLatepolicy(value: Course.LatePolicy): Course;
+
+  getLatepenalty(): number;
+  setLatepenalty(value: number): Course;
+
   getEnrollmentsList(): Array<Enrollment>;
   setEnrollmentsList(value: Array<Enrollment>): Course;
   clearEnrollmentsList(): Course;
@@ -250,10 +259,21 @@ export namespace Course {
     organizationpath: string,
     slipdays: number,
     enrolled: Enrollment.UserStatus,
+    graceperiod: string,
+    latepolicy: Course.LatePolicy,
+    latepenalty: number,
     enrollmentsList: Array<Enrollment.AsObject>,
     assignmentsList: Array<Assignment.AsObject>,
     groupsList: Array<Group.AsObject>,
   }
+
+  export enum LatePolicy { 
+    DEFAULT = 0,
+    SLIP_DAYS = 1,
+    LINEAR_PENALTY = 2,
+    STEP_PENALTY = 3,
+    HARD_CUTOFF = 4,
+  }
 }
 
 export class Courses extends jspb.Message {
@@ -449,6 +469,110 @@ export namespace UsedSlipDays {
   }
 }
 
+export class UsedSlipDaysChange extends jspb.Message {
+  getAssignmentid(): number;
+  setAssignmentid(value: number): UsedSlipDaysChange;
+
+  getOldusedslipdays(): number;
+  setOldusedslipdays(value: number): UsedSlipDaysChange;
+
+  getNewusedslipdays(): number;
+  setNewusedslipdays(value: number): UsedSlipDaysChange;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): UsedSlipDaysChange.AsObject;
+  static toObject(includeInstance: boolean, msg: UsedSlipDaysChange): UsedSlipDaysChange.AsObject;
+  static serializeBinaryToWriter(message: UsedSlipDaysChange, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): UsedSlipDaysChange;
+  static deserializeBinaryFromReader(message: UsedSlipDaysChange, reader: jspb.BinaryReader): UsedSlipDaysChange;
+}
+
+export namespace UsedSlipDaysChange {
+  export type AsObject = {
+    assignmentid: number,
+    oldusedslipdays: number,
+    newusedslipdays: number,
+  }
+}
+
+export class SlipDaysChange extends jspb.Message {
+  getEnrollmentid(): number;
+  setEnrollmentid(value: number): SlipDaysChange;
+
+  getUserid(): number;
+  setUserid(value: number): SlipDaysChange;
+
+  getLogin(): string;
+  setLogin(value: string): SlipDaysChange;
+
+  getOldremaining(): number;
+  setOldremaining(value: number): SlipDaysChange;
+
+  getNewremaining(): number;
+  setNewremaining(value: number): SlipDaysChange;
+
+  getAssignmentsList(): Array<UsedSlipDaysChange>;
+  setAssignmentsList(value: Array<UsedSlipDaysChange>): SlipDaysChange;
+  clearAssignmentsList(): SlipDaysChange;
+  addAssignments(value?: UsedSlipDaysChange, index?: number): UsedSlipDaysChange;
+
+  getKeptassignmentidsList(): Array<number>;
+  setKeptassignmentidsList(value: Array<number>): SlipDaysChange;
+  clearKeptassignmentidsList(): SlipDaysChange;
+  addKeptassignmentids(value: number, index?: number): SlipDaysChange;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SlipDaysChange.AsObject;
+  static toObject(includeInstance: boolean, msg: SlipDaysChange): SlipDaysChange.AsObject;
+  static serializeBinaryToWriter(message: SlipDaysChange, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SlipDaysChange;
+  static deserializeBinaryFromReader(message: SlipDaysChange, reader: jspb.BinaryReader): SlipDaysChange;
+}
+
+export namespace SlipDaysChange {
+  export type AsObject = {
+    enrollmentid: number,
+    userid: number,
+    login: string,
+    oldremaining: number,
+    newremaining: number,
+    assignmentsList: Array<UsedSlipDaysChange.AsObject>,
+    keptassignmentidsList: Array<number>,
+  }
+}
+
+export class SlipDaysRecomputation extends jspb.Message {
+  getOldslipdays(): number;
+  setOldslipdays(value: number): SlipDaysRecomputation;
+
+  getNewslipdays(): number;
+  setNewslipdays(value: number): SlipDaysRecomputation;
+
+  getChangesList(): Array<SlipDaysChange>;
+  setChangesList(value: Array<SlipDaysChange>): SlipDaysRecomputation;
+  clearChangesList(): SlipDaysRecomputation;
+  addChanges(value?: SlipDaysChange, index?: number): SlipDaysChange;
+
+  getApplied(): boolean;
+  setApplied(value: boolean): SlipDaysRecomputation;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SlipDaysRecomputation.AsObject;
+  static toObject(includeInstance: boolean, msg: SlipDaysRecomputation): SlipDaysRecomputation.AsObject;
+  static serializeBinaryToWriter(message: SlipDaysRecomputation, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SlipDaysRecomputation;
+  static deserializeBinaryFromReader(message: SlipDaysRecomputation, reader: jspb.BinaryReader): SlipDaysRecomputation;
+}
+
+export namespace SlipDaysRecomputation {
+  export type AsObject = {
+    oldslipdays: number,
+    newslipdays: number,
+    changesList: Array<SlipDaysChange.AsObject>,
+    applied: boolean,
+  }
+}
+
 export class Enrollments extends jspb.Message {
   getEnrollmentsList(): Array<Enrollment>;
   setEnrollmentsList(value: Array<Enrollment>): Enrollments;
@@ -594,141 +718,917 @@ export class Assignment extends jspb.Message {
   getContainertimeout(): number;
   setContainertimeout(value: number): Assignment;
 
+  getLatepolicy(): Course.LatePolicy;
+  setLatepolicy(value: Course.LatePolicy): Assignment;
+
+  getLatepenalty(): number;
+  setLatepenalty(value: number): Assignment;
+
+  getFreezeatdeadline(): boolean;
+  setFreezeatdeadline(value: boolean): Assignment;
+
+  getFinalbuild(): boolean;
+  setFinalbuild(value: boolean): Assignment;
+
+  getReleasedate(): string;
+  setReleasedate(value: string): Assignment;
+
+  getReleaseddate(): string;
+  setReleaseddate(value: string): Assignment;
+
+  getHiddentests(): string;
+  setHiddentests(value: string): Assignment;
+
+  getHiddenatdeadline(): boolean;
+  setHiddenatdeadline(value: boolean): Assignment;
+
+  getBuildsperhour(): number;
+  setBuildsperhour(value: number): Assignment;
+
+  getBuildinterval(): string;
+  setBuildinterval(value: string): Assignment;
+
+  getMemorylimit(): number;
+  setMemorylimit(value: number): Assignment;
+
+  getCpulimit(): number;
+  setCpulimit(value: number): Assignment;
+
+  getPidslimit(): number;
+  setPidslimit(value: number): Assignment;
+
+  getDisklimit(): number;
+  setDisklimit(value: number): Assignment;
+
+  getNetworkdisabled(): boolean;
+  setNetworkdisabled(value: boolean): Assignment;
+
+  getReadonlyroot(): boolean;
+  setReadonlyroot(value: boolean): Assignment;
+
+  getPipeline(): string;
+  setPipeline(value: string): Assignment;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Assignment.AsObject;
+  static toObject(includeInstance: boolean, msg: Assignment): Assignment.AsObject;
+  static serializeBinaryToWriter(message: Assignment, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Assignment;
+  static deserializeBinaryFromReader(message: Assignment, reader: jspb.BinaryReader): Assignment;
+}
+
+export namespace Assignment {
+  export type AsObject = {
+    id: number,
+    courseid: number,
+    name: string,
+    scriptfile: string,
+    deadline: string,
+    autoapprove: boolean,
+    order: number,
+    isgrouplab: boolean,
+    scorelimit: number,
+    reviewers: number,
+    skiptests: boolean,
+    submissionsList: Array<Submission.AsObject>,
+    gradingbenchmarksList: Array<GradingBenchmark.AsObject>,
+    containertimeout: number,
+    latepolicy: Course.LatePolicy,
+    latepenalty: number,
+    freezeatdeadline: boolean,
+    finalbuild: boolean,
+    releasedate: string,
+    releaseddate: string,
+    hiddentests: string,
+    hiddenatdeadline: boolean,
+    buildsperhour: number,
+    buildinterval: string,
+    memorylimit: number,
+    cpulimit: number,
+    pidslimit: number,
+    disklimit: number,
+    networkdisabled: boolean,
+    readonlyroot: boolean,
+    pipeline: string,
+  }
+}
+
+export class Assignments extends jspb.Message {
+  getAssignmentsList(): Array<Assignment>;
+  setAssignmentsList(value: Array<Assignment>): Assignments;
+  clearAssignmentsList(): Assignments;
+  addAssignments(value?: Assignment, index?: number): Assignment;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Assignments.AsObject;
+  static toObject(includeInstance: boolean, msg: Assignments): Assignments.AsObject;
+  static serializeBinaryToWriter(message: Assignments, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Assignments;
+  static deserializeBinaryFromReader(message: Assignments, reader: jspb.BinaryReader): Assignments;
+}
+
+export namespace Assignments {
+  export type AsObject = {
+    assignmentsList: Array<Assignment.AsObject>,
+  }
+}
+
+export class DeadlineExtension extends jspb.Message {
+  getId(): number;
+  setId(value: number): DeadlineExtension;
+
+  getAssignmentid(): number;
+  setAssignmentid(value: number): DeadlineExtension;
+
+  getUserid(): number;
+  setUserid(value: number): DeadlineExtension;
+
+  getGroupid(): number;
+  setGroupid(value: number): DeadlineExtension;
+
+  getDeadline(): string;
+  setDeadline(value: string): DeadlineExtension;
+
+  getReason(): string;
+  setReason(value: string): DeadlineExtension;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): DeadlineExtension.AsObject;
+  static toObject(includeInstance: boolean, msg: DeadlineExtension): DeadlineExtension.AsObject;
+  static serializeBinaryToWriter(message: DeadlineExtension, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): DeadlineExtension;
+  static deserializeBinaryFromReader(message: DeadlineExtension, reader: jspb.BinaryReader): DeadlineExtension;
+}
+
+export namespace DeadlineExtension {
+  export type AsObject = {
+    id: number,
+    assignmentid: number,
+    userid: number,
+    groupid: number,
+    deadline: string,
+    reason: string,
+  }
+}
+
+export class DeadlineExtensions extends jspb.Message {
+  getExtensionsList(): Array<DeadlineExtension>;
+  setExtensionsList(value: Array<DeadlineExtension>): DeadlineExtensions;
+  clearExtensionsList(): DeadlineExtensions;
+  addExtensions(value?: DeadlineExtension, index?: number): DeadlineExtension;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): DeadlineExtensions.AsObject;
+  static toObject(includeInstance: boolean, msg: DeadlineExtensions): DeadlineExtensions.AsObject;
+  static serializeBinaryToWriter(message: DeadlineExtensions, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): DeadlineExtensions;
+  static deserializeBinaryFromReader(message: DeadlineExtensions, reader: jspb.BinaryReader): DeadlineExtensions;
+}
+
+export namespace DeadlineExtensions {
+  export type AsObject = {
+    extensionsList: Array<DeadlineExtension.AsObject>,
+  }
+}
+
+export class DeadlineSnapshot extends jspb.Message {
+  getId(): number;
+  setId(value: number): DeadlineSnapshot;
+
+  getAssignmentid(): number;
+  setAssignmentid(value: number): DeadlineSnapshot;
+
+  getUserid(): number;
+  setUserid(value: number): DeadlineSnapshot;
+
+  getGroupid(): number;
+  setGroupid(value: number): DeadlineSnapshot;
+
+  getCommithash(): string;
+  setCommithash(value: string): DeadlineSnapshot;
+
+  getDeadline(): string;
+  setDeadline(value: string): DeadlineSnapshot;
+
+  getSnapshotdate(): string;
+  setSnapshotdate(value: string): DeadlineSnapshot;
+
+  getFrozen(): boolean;
+  setFrozen(value: boolean): DeadlineSnapshot;
+
+  getBuildjobid(): number;
+  setBuildjobid(value: number): DeadlineSnapshot;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): DeadlineSnapshot.AsObject;
+  static toObject(includeInstance: boolean, msg: DeadlineSnapshot): DeadlineSnapshot.AsObject;
+  static serializeBinaryToWriter(message: DeadlineSnapshot, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): DeadlineSnapshot;
+  static deserializeBinaryFromReader(message: DeadlineSnapshot, reader: jspb.BinaryReader): DeadlineSnapshot;
+}
+
+export namespace DeadlineSnapshot {
+  export type AsObject = {
+    id: number,
+    assignmentid: number,
+    userid: number,
+    groupid: number,
+    commithash: string,
+    deadline: string,
+    snapshotdate: string,
+    frozen: boolean,
+    buildjobid: number,
+  }
+}
+
+export class Submission extends jspb.Message {
+  getId(): number;
+  setId(value: number): Submission;
+
+  getAssignmentid(): number;
+  setAssignmentid(value: number): Submission;
+
+  getUserid(): number;
+  setUserid(value: number): Submission;
+
+  getGroupid(): number;
+  setGroupid(value: number): Submission;
+
+  getScore(): number;
+  setScore(value: number): Submission;
+
+  getScoreobjects(): string;
+  setScoreobjects(value: string): Submission;
+
+  getBuildinfo(): string;
+  setBuildinfo(value: string): Submission;
+
+  getCommithash(): string;
+  setCommithash(value: string): Submission;
+
+  getReleased(): boolean;
+  setReleased(value: boolean): Submission;
+
+  getStatus(): Submission.Status;
+  setStatus(value: Submission.Status): Submission;
+
+  getApproveddate(): string;
+  setApproveddate(value: string): Submission;
+
+  getReviewsList(): Array<Review>;
+  setReviewsList(value: Array<Review>): Submission;
+  clearReviewsList(): Submission;
+  addReviews(value?: Review, index?: number): Review;
+
+  getGradedattemptid(): number;
+  setGradedattemptid(value: number): Submission;
+
+  getTestresultsList(): Array<TestResult>;
+  setTestresultsList(value: Array<TestResult>): Submission;
+  clearTestresultsList(): Submission;
+  addTestresults(value?: TestResult, index?: number): TestResult;
+
+  getRawscore(): number;
+  setRawscore(value: number): Submission;
+
+  getHiddenscore(): number;
+  setHiddenscore(value: number): Submission;
+
+  getHiddenscoreobjects(): string;
+  setHiddenscoreobjects(value: string): Submission;
+
+  getHiddenbuildinfo(): string;
+  setHiddenbuildinfo(value: string): Submission;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Submission.AsObject;
+  static toObject(includeInstance: boolean, msg: Submission): Submission.AsObject;
+  static serializeBinaryToWriter(message: Submission, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Submission;
+  static deserializeBinaryFromReader(message: Submission, reader: jspb.BinaryReader): Submission;
+}
+
+export namespace Submission {
+  export type AsObject = {
+    id: number,
+    assignmentid: number,
+    userid: number,
+    groupid: number,
+    score: number,
+    scoreobjects: string,
+    buildinfo: string,
+    commithash: string,
+    released: boolean,
+    status: Submission.Status,
+    approveddate: string,
+    reviewsList: Array<Review.AsObject>,
+    gradedattemptid: number,
+    testresultsList: Array<TestResult.AsObject>,
+    rawscore: number,
+    hiddenscore: number,
+    hiddenscoreobjects: string,
+    hiddenbuildinfo: string,
+  }
+
+  export enum Status { 
+    NONE = 0,
+    APPROVED = 1,
+    REJECTED = 2,
+    REVISION = 3,
+  }
+}
+
+export class Submissions extends jspb.Message {
+  getSubmissionsList(): Array<Submission>;
+  setSubmissionsList(value: Array<Submission>): Submissions;
+  clearSubmissionsList(): Submissions;
+  addSubmissions(value?: Submission, index?: number): Submission;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Submissions.AsObject;
+  static toObject(includeInstance: boolean, msg: Submissions): Submissions.AsObject;
+  static serializeBinaryToWriter(message: Submissions, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Submissions;
+  static deserializeBinaryFromReader(message: Submissions, reader: jspb.BinaryReader): Submissions;
+}
+
+export namespace Submissions {
+  export type AsObject = {
+    submissionsList: Array<Submission.AsObject>,
+  }
+}
+
+export class SubmissionAttempt extends jspb.Message {
+  getId(): number;
+  setId(value: number): SubmissionAttempt;
+
+  getSubmissionid(): number;
+  setSubmissionid(value: number): SubmissionAttempt;
+
+  getCommithash(): string;
+  setCommithash(value: string): SubmissionAttempt;
+
+  getScore(): number;
+  setScore(value: number): SubmissionAttempt;
+
+  getScoreobjects(): string;
+  setScoreobjects(value: string): SubmissionAttempt;
+
+  getBuildinfo(): string;
+  setBuildinfo(value: string): SubmissionAttempt;
+
+  getCreateddate(): string;
+  setCreateddate(value: string): SubmissionAttempt;
+
+  getTestresultsList(): Array<TestResult>;
+  setTestresultsList(value: Array<TestResult>): SubmissionAttempt;
+  clearTestresultsList(): SubmissionAttempt;
+  addTestresults(value?: TestResult, index?: number): TestResult;
+
+  getRawscore(): number;
+  setRawscore(value: number): SubmissionAttempt;
+
+  getHiddenscore(): number;
+  setHiddenscore(value: number): SubmissionAttempt;
+
+  getHiddenscoreobjects(): string;
+  setHiddenscoreobjects(value: string): SubmissionAttempt;
+
+  getHiddenbuildinfo(): string;
+  setHiddenbuildinfo(value: string): SubmissionAttempt;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SubmissionAttempt.AsObject;
+  static toObject(includeInstance: boolean, msg: SubmissionAttempt): SubmissionAttempt.AsObject;
+  static serializeBinaryToWriter(message: SubmissionAttempt, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SubmissionAttempt;
+  static deserializeBinaryFromReader(message: SubmissionAttempt, reader: jspb.BinaryReader): SubmissionAttempt;
+}
+
+export namespace SubmissionAttempt {
+  export type AsObject = {
+    id: number,
+    submissionid: number,
+    commithash: string,
+    score: number,
+    scoreobjects: string,
+    buildinfo: string,
+    createddate: string,
+    testresultsList: Array<TestResult.AsObject>,
+    rawscore: number,
+    hiddenscore: number,
+    hiddenscoreobjects: string,
+    hiddenbuildinfo: string,
+  }
+}
+
+export class SubmissionAttempts extends jspb.Message {
+  getAttemptsList(): Array<SubmissionAttempt>;
+  setAttemptsList(value: Array<SubmissionAttempt>): SubmissionAttempts;
+  clearAttemptsList(): SubmissionAttempts;
+  addAttempts(value?: SubmissionAttempt, index?: number): SubmissionAttempt;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SubmissionAttempts.AsObject;
+  static toObject(includeInstance: boolean, msg: SubmissionAttempts): SubmissionAttempts.AsObject;
+  static serializeBinaryToWriter(message: SubmissionAttempts, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SubmissionAttempts;
+  static deserializeBinaryFromReader(message: SubmissionAttempts, reader: jspb.BinaryReader): SubmissionAttempts;
+}
+
+export namespace SubmissionAttempts {
+  export type AsObject = {
+    attemptsList: Array<SubmissionAttempt.AsObject>,
+  }
+}
+
+export class TestResult extends jspb.Message {
+  getId(): number;
+  setId(value: number): TestResult;
+
+  getAttemptid(): number;
+  setAttemptid(value: number): TestResult;
+
+  getSubmissionid(): number;
+  setSubmissionid(value: number): TestResult;
+
+  getAssignmentid(): number;
+  setAssignmentid(value: number): TestResult;
+
+  getTestname(): string;
+  setTestname(value: string): TestResult;
+
+  getScore(): number;
+  setScore(value: number): TestResult;
+
+  getMaxscore(): number;
+  setMaxscore(value: number): TestResult;
+
+  getWeight(): number;
+  setWeight(value: number): TestResult;
+
+  getDuration(): number;
+  setDuration(value: number): TestResult;
+
+  getFailureoutput(): string;
+  setFailureoutput(value: string): TestResult;
+
+  getHidden(): boolean;
+  setHidden(value: boolean): TestResult;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): TestResult.AsObject;
+  static toObject(includeInstance: boolean, msg: TestResult): TestResult.AsObject;
+  static serializeBinaryToWriter(message: TestResult, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TestResult;
+  static deserializeBinaryFromReader(message: TestResult, reader: jspb.BinaryReader): TestResult;
+}
+
+export namespace TestResult {
+  export type AsObject = {
+    id: number,
+    attemptid: number,
+    submissionid: number,
+    assignmentid: number,
+    testname: string,
+    score: number,
+    maxscore: number,
+    weight: number,
+    duration: number,
+    failureoutput: string,
+    hidden: boolean,
+  }
+}
+
+export class TestCaseStatistics extends jspb.Message {
+  getTestname(): string;
+  setTestname(value: string): TestCaseStatistics;
+
+  getRuns(): number;
+  setRuns(value: number): TestCaseStatistics;
+
+  getFailures(): number;
+  setFailures(value: number): TestCaseStatistics;
+
+  getAveragescore(): number;
+  setAveragescore(value: number): TestCaseStatistics;
+
+  getAverageduration(): number;
+  setAverageduration(value: number): TestCaseStatistics;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): TestCaseStatistics.AsObject;
+  static toObject(includeInstance: boolean, msg: TestCaseStatistics): TestCaseStatistics.AsObject;
+  static serializeBinaryToWriter(message: TestCaseStatistics, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TestCaseStatistics;
+  static deserializeBinaryFromReader(message: TestCaseStatistics, reader: jspb.BinaryReader): TestCaseStatistics;
+}
+
+export namespace TestCaseStatistics {
+  export type AsObject = {
+    testname: string,
+    runs: number,
+    failures: number,
+    averagescore: number,
+    averageduration: number,
+  }
+}
+
+export class TestStatistics extends jspb.Message {
+  getTestsList(): Array<TestCaseStatistics>;
+  setTestsList(value: Array<TestCaseStatistics>): TestStatistics;
+  clearTestsList(): TestStatistics;
+  addTests(value?: TestCaseStatistics, index?: number): TestCaseStatistics;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): TestStatistics.AsObject;
+  static toObject(includeInstance: boolean, msg: TestStatistics): TestStatistics.AsObject;
+  static serializeBinaryToWriter(message: TestStatistics, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TestStatistics;
+  static deserializeBinaryFromReader(message: TestStatistics, reader: jspb.BinaryReader): TestStatistics;
+}
+
+export namespace TestStatistics {
+  export type AsObject = {
+    testsList: Array<TestCaseStatistics.AsObject>,
+  }
+}
+
+export class PushActivity extends jspb.Message {
+  getDaystodeadline(): number;
+  setDaystodeadline(value: number): PushActivity;
+
+  getAttempts(): number;
+  setAttempts(value: number): PushActivity;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PushActivity.AsObject;
+  static toObject(includeInstance: boolean, msg: PushActivity): PushActivity.AsObject;
+  static serializeBinaryToWriter(message: PushActivity, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PushActivity;
+  static deserializeBinaryFromReader(message: PushActivity, reader: jspb.BinaryReader): PushActivity;
+}
+
+export namespace PushActivity {
+  export type AsObject = {
+    daystodeadline: number,
+    attempts: number,
+  }
+}
+
+export class AssignmentStatistics extends jspb.Message {
+  getAssignmentid(): number;
+  setAssignmentid(value: number): AssignmentStatistics;
+
+  getName(): string;
+  setName(value: string): AssignmentStatistics;
+
+  getSubmissions(): number;
+  setSubmissions(value: number): AssignmentStatistics;
+
+  getApproved(): number;
+  setApproved(value: number): AssignmentStatistics;
+
+  getApprovalrate(): number;
+  setApprovalrate(value: number): AssignmentStatistics;
+
+  getScoredistributionList(): Array<number>;
+  setScoredistributionList(value: Array<number>): AssignmentStatistics;
+  clearScoredistributionList(): AssignmentStatistics;
+  addScoredistribution(value: number, index?: number): AssignmentStatistics;
+
+  getMediantimetoapproval(): number;
+  setMediantimetoapproval(value: number): AssignmentStatistics;
+
+  getUsedslipdays(): number;
+  setUsedslipdays(value: number): AssignmentStatistics;
+
+  getPushactivityList(): Array<PushActivity>;
+  setPushactivityList(value: Array<PushActivity>): AssignmentStatistics;
+  clearPushactivityList(): AssignmentStatistics;
+  addPushactivity(value?: PushActivity, index?: number): PushActivity;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AssignmentStatistics.AsObject;
+  static toObject(includeInstance: boolean, msg: AssignmentStatistics): AssignmentStatistics.AsObject;
+  static serializeBinaryToWriter(message: AssignmentStatistics, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AssignmentStatistics;
+  static deserializeBinaryFromReader(message: AssignmentStatistics, reader: jspb.BinaryReader): AssignmentStatistics;
+}
+
+export namespace AssignmentStatistics {
+  export type AsObject = {
+    assignmentid: number,
+    name: string,
+    submissions: number,
+    approved: number,
+    approvalrate: number,
+    scoredistributionList: Array<number>,
+    mediantimetoapproval: number,
+    usedslipdays: number,
+    pushactivityList: Array<PushActivity.AsObject>,
+  }
+}
+
+export class CourseStatistics extends jspb.Message {
+  getStudents(): number;
+  setStudents(value: number): CourseStatistics;
+
+  getActivestudents(): number;
+  setActivestudents(value: number): CourseStatistics;
+
+  getAssignmentsList(): Array<AssignmentStatistics>;
+  setAssignmentsList(value: Array<AssignmentStatistics>): CourseStatistics;
+  clearAssignmentsList(): CourseStatistics;
+  addAssignments(value?: AssignmentStatistics, index?: number): AssignmentStatistics;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CourseStatistics.AsObject;
+  static toObject(includeInstance: boolean, msg: CourseStatistics): CourseStatistics.AsObject;
+  static serializeBinaryToWriter(message: CourseStatistics, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CourseStatistics;
+  static deserializeBinaryFromReader(message: CourseStatistics, reader: jspb.BinaryReader): CourseStatistics;
+}
+
+export namespace CourseStatistics {
+  export type AsObject = {
+    students: number,
+    activestudents: number,
+    assignmentsList: Array<AssignmentStatistics.AsObject>,
+  }
+}
+
+export class BuildJob extends jspb.Message {
+  getId(): number;
+  setId(value: number): BuildJob;
+
+  getCourseid(): number;
+  setCourseid(value: number): BuildJob;
+
+  getAssignmentid(): number;
+  setAssignmentid(value: number): BuildJob;
+
+  getRepositoryid(): number;
+  setRepositoryid(value: number): BuildJob;
+
+  getCommitid(): string;
+  setCommitid(value: string): BuildJob;
+
+  getJobowner(): string;
+  setJobowner(value: string): BuildJob;
+
+  getStatus(): BuildJob.Status;
+  setStatus(value: BuildJob.Status): BuildJob;
+
+  getQueueddate(): string;
+  setQueueddate(value: string): BuildJob;
+
+  getStarteddate(): string;
+  setStarteddate(value: string): BuildJob;
+
+  getFinisheddate(): string;
+  setFinisheddate(value: string): BuildJob;
+
+  getErrormessage(): string;
+  setErrormessage(value: string): BuildJob;
+
+  getDeadline(): boolean;
+  setDeadline(value: boolean): BuildJob;
+
+  getDelayeduntil(): string;
+  setDelayeduntil(value: string): BuildJob;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): BuildJob.AsObject;
+  static toObject(includeInstance: boolean, msg: BuildJob): BuildJob.AsObject;
+  static serializeBinaryToWriter(message: BuildJob, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): BuildJob;
+  static deserializeBinaryFromReader(message: BuildJob, reader: jspb.BinaryReader): BuildJob;
+}
+
+export namespace BuildJob {
+  export type AsObject = {
+    id: number,
+    courseid: number,
+    assignmentid: number,
+    repositoryid: number,
+    commitid: string,
+    jobowner: string,
+    status: BuildJob.Status,
+    queueddate: string,
+    starteddate: string,
+    finisheddate: string,
+    errormessage: string,
+    deadline: boolean,
+    delayeduntil: string,
+  }
+
+  export enum Status { 
+    QUEUED = 0,
+    RUNNING = 1,
+    DONE = 2,
+    FAILED = 3,
+    CANCELED = 4,
+  }
+}
+
+export class BuildJobs extends jspb.Message {
+  getJobsList(): Array<BuildJob>;
+  setJobsList(value: Array<BuildJob>): BuildJobs;
+  clearJobsList(): BuildJobs;
+  addJobs(value?: BuildJob, index?: number): BuildJob;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): BuildJobs.AsObject;
+  static toObject(includeInstance: boolean, msg: BuildJobs): BuildJobs.AsObject;
+  static serializeBinaryToWriter(message: BuildJobs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): BuildJobs;
+  static deserializeBinaryFromReader(message: BuildJobs, reader: jspb.BinaryReader): BuildJobs;
+}
+
+export namespace BuildJobs {
+  export type AsObject = {
+    jobsList: Array<BuildJob.AsObject>,
+  }
+}
+
+export class BuildQuota extends jspb.Message {
+  getId(): number;
+  setId(value: number): BuildQuota;
+
+  getAssignmentid(): number;
+  setAssignmentid(value: number): BuildQuota;
+
+  getRepositoryid(): number;
+  setRepositoryid(value: number): BuildQuota;
+
+  getResetdate(): string;
+  setResetdate(value: string): BuildQuota;
+
+  getBuilds(): number;
+  setBuilds(value: number): BuildQuota;
+
+  getRemaining(): number;
+  setRemaining(value: number): BuildQuota;
+
+  getNextbuild(): string;
+  setNextbuild(value: string): BuildQuota;
+
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): Assignment.AsObject;
-  static toObject(includeInstance: boolean, msg: Assignment): Assignment.AsObject;
-  static serializeBinaryToWriter(message: Assignment, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): Assignment;
-  static deserializeBinaryFromReader(message: Assignment, reader: jspb.BinaryReader): Assignment;
+  toObject(includeInstance?: boolean): BuildQuota.AsObject;
+  static toObject(includeInstance: boolean, msg: BuildQuota): BuildQuota.AsObject;
+  static serializeBinaryToWriter(message: BuildQuota, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): BuildQuota;
+  static deserializeBinaryFromReader(message: BuildQuota, reader: jspb.BinaryReader): BuildQuota;
 }
 
-export namespace Assignment {
+export namespace BuildQuota {
   export type AsObject = {
     id: number,
-    courseid: number,
-    name: string,
-    scriptfile: string,
-    deadline: string,
-    autoapprove: boolean,
-    order: number,
-    isgrouplab: boolean,
-    scorelimit: number,
-    reviewers: number,
-    skiptests: boolean,
-    submissionsList: Array<Submission.AsObject>,
-    gradingbenchmarksList: Array<GradingBenchmark.AsObject>,
-    containertimeout: number,
+    assignmentid: number,
+    repositoryid: number,
+    resetdate: string,
+    builds: number,
+    remaining: number,
+    nextbuild: string,
   }
 }
 
-export class Assignments extends jspb.Message {
-  getAssignmentsList(): Array<Assignment>;
-  setAssignmentsList(value: Array<Assignment>): Assignments;
-  clearAssignmentsList(): Assignments;
-  addAssignments(value?: Assignment, index?: number): Assignment;
+export class BuildQuotas extends jspb.Message {
+  getQuotasList(): Array<BuildQuota>;
+  setQuotasList(value: Array<BuildQuota>): BuildQuotas;
+  clearQuotasList(): BuildQuotas;
+  addQuotas(value?: BuildQuota, index?: number): BuildQuota;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): Assignments.AsObject;
-  static toObject(includeInstance: boolean, msg: Assignments): Assignments.AsObject;
-  static serializeBinaryToWriter(message: Assignments, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): Assignments;
-  static deserializeBinaryFromReader(message: Assignments, reader: jspb.BinaryReader): Assignments;
+  toObject(includeInstance?: boolean): BuildQuotas.AsObject;
+  static toObject(includeInstance: boolean, msg: BuildQuotas): BuildQuotas.AsObject;
+  static serializeBinaryToWriter(message: BuildQuotas, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): BuildQuotas;
+  static deserializeBinaryFromReader(message: BuildQuotas, reader: jspb.BinaryReader): BuildQuotas;
 }
 
-export namespace Assignments {
+export namespace BuildQuotas {
   export type AsObject = {
-    assignmentsList: Array<Assignment.AsObject>,
+    quotasList: Array<BuildQuota.AsObject>,
   }
 }
 
-export class Submission extends jspb.Message {
+export class SimilarityPair extends jspb.Message {
   getId(): number;
-  setId(value: number): Submission;
+  setId(value: number): SimilarityPair;
 
   getAssignmentid(): number;
-  setAssignmentid(value: number): Submission;
-
-  getUserid(): number;
-  setUserid(value: number): Submission;
+  setAssignmentid(value: number): SimilarityPair;
 
-  getGroupid(): number;
-  setGroupid(value: number): Submission;
-
-  getScore(): number;
-  setScore(value: number): Submission;
-
-  getScoreobjects(): string;
-  setScoreobjects(value: string): Submission;
+  getSubmissiona(): number;
+  setSubmissiona(value: number): SimilarityPair;
 
-  getBuildinfo(): string;
-  setBuildinfo(value: string): Submission;
+  getSubmissionb(): number;
+  setSubmissionb(value: number): SimilarityPair;
 
-  getCommithash(): string;
-  setCommithash(value: string): Submission;
+  getOwnera(): string;
+  setOwnera(value: string): SimilarityPair;
 
-  getReleased(): boolean;
-  setReleased(value: boolean): Submission;
+  getOwnerb(): string;
+  setOwnerb(value: string): SimilarityPair;
 
-  getStatus(): Submission.Status;
-  setStatus(value: Submission.Status): Submission;
+  getSimilarity(): number;
+  setSimilarity(value: number): SimilarityPair;
 
-  getApproveddate(): string;
-  setApproveddate(value: string): Submission;
+  getCheckeddate(): string;
+  setCheckeddate(value: string): SimilarityPair;
 
-  getReviewsList(): Array<Review>;
-  setReviewsList(value: Array<Review>): Submission;
-  clearReviewsList(): Submission;
-  addReviews(value?: Review, index?: number): Review;
+  getMatchesList(): Array<SimilarityMatch>;
+  setMatchesList(value: Array<SimilarityMatch>): SimilarityPair;
+  clearMatchesList(): SimilarityPair;
+  addMatches(value?: SimilarityMatch, index?: number): SimilarityMatch;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): Submission.AsObject;
-  static toObject(includeInstance: boolean, msg: Submission): Submission.AsObject;
-  static serializeBinaryToWriter(message: Submission, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): Submission;
-  static deserializeBinaryFromReader(message: Submission, reader: jspb.BinaryReader): Submission;
+  toObject(includeInstance?: boolean): SimilarityPair.AsObject;
+  static toObject(includeInstance: boolean, msg: SimilarityPair): SimilarityPair.AsObject;
+  static serializeBinaryToWriter(message: SimilarityPair, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SimilarityPair;
+  static deserializeBinaryFromReader(message: SimilarityPair, reader: jspb.BinaryReader): SimilarityPair;
 }
 
-export namespace Submission {
+export namespace SimilarityPair {
   export type AsObject = {
     id: number,
     assignmentid: number,
-    userid: number,
-    groupid: number,
-    score: number,
-    scoreobjects: string,
-    buildinfo: string,
-    commithash: string,
-    released: boolean,
-    status: Submission.Status,
-    approveddate: string,
-    reviewsList: Array<Review.AsObject>,
+    submissiona: number,
+    submissionb: number,
+    ownera: string,
+    ownerb: string,
+    similarity: number,
+    checkeddate: string,
+    matchesList: Array<SimilarityMatch.AsObject>,
   }
+}
 
-  export enum Status { 
-    NONE = 0,
-    APPROVED = 1,
-    REJECTED = 2,
-    REVISION = 3,
+export class SimilarityPairs extends jspb.Message {
+  getPairsList(): Array<SimilarityPair>;
+  setPairsList(value: Array<SimilarityPair>): SimilarityPairs;
+  clearPairsList(): SimilarityPairs;
+  addPairs(value?: SimilarityPair, index?: number): SimilarityPair;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SimilarityPairs.AsObject;
+  static toObject(includeInstance: boolean, msg: SimilarityPairs): SimilarityPairs.AsObject;
+  static serializeBinaryToWriter(message: SimilarityPairs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SimilarityPairs;
+  static deserializeBinaryFromReader(message: SimilarityPairs, reader: jspb.BinaryReader): SimilarityPairs;
+}
+
+export namespace SimilarityPairs {
+  export type AsObject = {
+    pairsList: Array<SimilarityPair.AsObject>,
   }
 }
 
-export class Submissions extends jspb.Message {
-  getSubmissionsList(): Array<Submission>;
-  setSubmissionsList(value: Array<Submission>): Submissions;
-  clearSubmissionsList(): Submissions;
-  addSubmissions(value?: Submission, index?: number): Submission;
+export class SimilarityMatch extends jspb.Message {
+  getId(): number;
+  setId(value: number): SimilarityMatch;
+
+  getPairid(): number;
+  setPairid(value: number): SimilarityMatch;
+
+  getFilea(): string;
+  setFilea(value: string): SimilarityMatch;
+
+  getStartlinea(): number;
+  setStartlinea(value: number): SimilarityMatch;
+
+  getEndlinea(): number;
+  setEndlinea(value: number): SimilarityMatch;
+
+  getFileb(): string;
+  setFileb(value: string): SimilarityMatch;
+
+  getStartlineb(): number;
+  setStartlineb(value: number): SimilarityMatch;
+
+  getEndlineb(): number;
+  setEndlineb(value: number): SimilarityMatch;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): Submissions.AsObject;
-  static toObject(includeInstance: boolean, msg: Submissions): Submissions.AsObject;
-  static serializeBinaryToWriter(message: Submissions, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): Submissions;
-  static deserializeBinaryFromReader(message: Submissions, reader: jspb.BinaryReader): Submissions;
+  toObject(includeInstance?: boolean): SimilarityMatch.AsObject;
+  static toObject(includeInstance: boolean, msg: SimilarityMatch): SimilarityMatch.AsObject;
+  static serializeBinaryToWriter(message: SimilarityMatch, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SimilarityMatch;
+  static deserializeBinaryFromReader(message: SimilarityMatch, reader: jspb.BinaryReader): SimilarityMatch;
 }
 
-export namespace Submissions {
+export namespace SimilarityMatch {
   export type AsObject = {
-    submissionsList: Array<Submission.AsObject>,
+    id: number,
+    pairid: number,
+    filea: string,
+    startlinea: number,
+    endlinea: number,
+    fileb: string,
+    startlineb: number,
+    endlineb: number,
   }
 }
 
@@ -928,6 +1828,60 @@ export namespace ReviewRequest {
   }
 }
 
+export class DeadlineExtensionRequest extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): DeadlineExtensionRequest;
+
+  getExtension(): DeadlineExtension | undefined;
+  setExtension(value?: DeadlineExtension): DeadlineExtensionRequest;
+  hasExtension(): boolean;
+  clearExtension(): DeadlineExtensionRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): DeadlineExtensionRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: DeadlineExtensionRequest): DeadlineExtensionRequest.AsObject;
+  static serializeBinaryToWriter(message: DeadlineExtensionRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): DeadlineExtensionRequest;
+  static deserializeBinaryFromReader(message: DeadlineExtensionRequest, reader: jspb.BinaryReader): DeadlineExtensionRequest;
+}
+
+export namespace DeadlineExtensionRequest {
+  export type AsObject = {
+    courseid: number,
+    extension?: DeadlineExtension.AsObject,
+  }
+}
+
+export class SlipDaysRequest extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): SlipDaysRequest;
+
+  getChangeslipdays(): boolean;
+  setChangeslipdays(value: boolean): SlipDaysRequest;
+
+  getSlipdays(): number;
+  setSlipdays(value: number): SlipDaysRequest;
+
+  getApply(): boolean;
+  setApply(value: boolean): SlipDaysRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SlipDaysRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SlipDaysRequest): SlipDaysRequest.AsObject;
+  static serializeBinaryToWriter(message: SlipDaysRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SlipDaysRequest;
+  static deserializeBinaryFromReader(message: SlipDaysRequest, reader: jspb.BinaryReader): SlipDaysRequest;
+}
+
+export namespace SlipDaysRequest {
+  export type AsObject = {
+    courseid: number,
+    changeslipdays: boolean,
+    slipdays: number,
+    apply: boolean,
+  }
+}
+
 export class CourseRequest extends jspb.Message {
   getCourseid(): number;
   setCourseid(value: number): CourseRequest;
@@ -1244,6 +2198,32 @@ export namespace UpdateSubmissionsRequest {
   }
 }
 
+export class SubmissionAttemptRequest extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): SubmissionAttemptRequest;
+
+  getSubmissionid(): number;
+  setSubmissionid(value: number): SubmissionAttemptRequest;
+
+  getAttemptid(): number;
+  setAttemptid(value: number): SubmissionAttemptRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SubmissionAttemptRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SubmissionAttemptRequest): SubmissionAttemptRequest.AsObject;
+  static serializeBinaryToWriter(message: SubmissionAttemptRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SubmissionAttemptRequest;
+  static deserializeBinaryFromReader(message: SubmissionAttemptRequest, reader: jspb.BinaryReader): SubmissionAttemptRequest;
+}
+
+export namespace SubmissionAttemptRequest {
+  export type AsObject = {
+    courseid: number,
+    submissionid: number,
+    attemptid: number,
+  }
+}
+
 export class SubmissionReviewersRequest extends jspb.Message {
   getSubmissionid(): number;
   setSubmissionid(value: number): SubmissionReviewersRequest;
@@ -1496,6 +2476,227 @@ export namespace LoadCriteriaRequest {
   }
 }
 
+export class BuildJobRequest extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): BuildJobRequest;
+
+  getJobid(): number;
+  setJobid(value: number): BuildJobRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): BuildJobRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: BuildJobRequest): BuildJobRequest.AsObject;
+  static serializeBinaryToWriter(message: BuildJobRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): BuildJobRequest;
+  static deserializeBinaryFromReader(message: BuildJobRequest, reader: jspb.BinaryReader): BuildJobRequest;
+}
+
+export namespace BuildJobRequest {
+  export type AsObject = {
+    courseid: number,
+    jobid: number,
+  }
+}
+
+export class BuildQuotaRequest extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): BuildQuotaRequest;
+
+  getAssignmentid(): number;
+  setAssignmentid(value: number): BuildQuotaRequest;
+
+  getRepositoryid(): number;
+  setRepositoryid(value: number): BuildQuotaRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): BuildQuotaRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: BuildQuotaRequest): BuildQuotaRequest.AsObject;
+  static serializeBinaryToWriter(message: BuildQuotaRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): BuildQuotaRequest;
+  static deserializeBinaryFromReader(message: BuildQuotaRequest, reader: jspb.BinaryReader): BuildQuotaRequest;
+}
+
+export namespace BuildQuotaRequest {
+  export type AsObject = {
+    courseid: number,
+    assignmentid: number,
+    repositoryid: number,
+  }
+}
+
+export class BuildLogRequest extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): BuildLogRequest;
+
+  getCommitid(): string;
+  setCommitid(value: string): BuildLogRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): BuildLogRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: BuildLogRequest): BuildLogRequest.AsObject;
+  static serializeBinaryToWriter(message: BuildLogRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): BuildLogRequest;
+  static deserializeBinaryFromReader(message: BuildLogRequest, reader: jspb.BinaryReader): BuildLogRequest;
+}
+
+export namespace BuildLogRequest {
+  export type AsObject = {
+    courseid: number,
+    commitid: string,
+  }
+}
+
+export class BuildLogLine extends jspb.Message {
+  getJobid(): number;
+  setJobid(value: number): BuildLogLine;
+
+  getAssignmentid(): number;
+  setAssignmentid(value: number): BuildLogLine;
+
+  getLine(): string;
+  setLine(value: string): BuildLogLine;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): BuildLogLine.AsObject;
+  static toObject(includeInstance: boolean, msg: BuildLogLine): BuildLogLine.AsObject;
+  static serializeBinaryToWriter(message: BuildLogLine, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): BuildLogLine;
+  static deserializeBinaryFromReader(message: BuildLogLine, reader: jspb.BinaryReader): BuildLogLine;
+}
+
+export namespace BuildLogLine {
+  export type AsObject = {
+    jobid: number,
+    assignmentid: number,
+    line: string,
+  }
+}
+
+export class TestStatisticsRequest extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): TestStatisticsRequest;
+
+  getAssignmentid(): number;
+  setAssignmentid(value: number): TestStatisticsRequest;
+
+  getAllattempts(): boolean;
+  setAllattempts(value: boolean): TestStatisticsRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): TestStatisticsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: TestStatisticsRequest): TestStatisticsRequest.AsObject;
+  static serializeBinaryToWriter(message: TestStatisticsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TestStatisticsRequest;
+  static deserializeBinaryFromReader(message: TestStatisticsRequest, reader: jspb.BinaryReader): TestStatisticsRequest;
+}
+
+export namespace TestStatisticsRequest {
+  export type AsObject = {
+    courseid: number,
+    assignmentid: number,
+    allattempts: boolean,
+  }
+}
+
+export class SimilarityRequest extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): SimilarityRequest;
+
+  getAssignmentid(): number;
+  setAssignmentid(value: number): SimilarityRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SimilarityRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SimilarityRequest): SimilarityRequest.AsObject;
+  static serializeBinaryToWriter(message: SimilarityRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SimilarityRequest;
+  static deserializeBinaryFromReader(message: SimilarityRequest, reader: jspb.BinaryReader): SimilarityRequest;
+}
+
+export namespace SimilarityRequest {
+  export type AsObject = {
+    courseid: number,
+    assignmentid: number,
+  }
+}
+
+export class GradebookRequest extends jspb.Message {
+  getCourseid(): number;
+  setCourseid(value: number): GradebookRequest;
+
+  getFormat(): GradebookRequest.Format;
+  setFormat(value: GradebookRequest.Format): GradebookRequest;
+
+  getGroups(): boolean;
+  setGroups(value: boolean): GradebookRequest;
+
+  getMinapproved(): number;
+  setMinapproved(value: number): GradebookRequest;
+
+  getRequiredassignmentsList(): Array<string>;
+  setRequiredassignmentsList(value: Array<string>): GradebookRequest;
+  clearRequiredassignmentsList(): GradebookRequest;
+  addRequiredassignments(value: string, index?: number): GradebookRequest;
+
+  getIgnoredloginsList(): Array<string>;
+  setIgnoredloginsList(value: Array<string>): GradebookRequest;
+  clearIgnoredloginsList(): GradebookRequest;
+  addIgnoredlogins(value: string, index?: number): GradebookRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GradebookRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: GradebookRequest): GradebookRequest.AsObject;
+  static serializeBinaryToWriter(message: GradebookRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GradebookRequest;
+  static deserializeBinaryFromReader(message: GradebookRequest, reader: jspb.BinaryReader): GradebookRequest;
+}
+
+export namespace GradebookRequest {
+  export type AsObject = {
+    courseid: number,
+    format: GradebookRequest.Format,
+    groups: boolean,
+    minapproved: number,
+    requiredassignmentsList: Array<string>,
+    ignoredloginsList: Array<string>,
+  }
+
+  export enum Format { 
+    CSV = 0,
+    XLSX = 1,
+    CANVAS = 2,
+    MOODLE = 3,
+  }
+}
+
+export class GradebookFile extends jspb.Message {
+  getName(): string;
+  setName(value: string): GradebookFile;
+
+  getContenttype(): string;
+  setContenttype(value: string): GradebookFile;
+
+  getContent(): Uint8Array | string;
+  getContent_asU8(): Uint8Array;
+  getContent_asB64(): string;
+  setContent(value: Uint8Array | string): GradebookFile;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GradebookFile.AsObject;
+  static toObject(includeInstance: boolean, msg: GradebookFile): GradebookFile.AsObject;
+  static serializeBinaryToWriter(message: GradebookFile, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GradebookFile;
+  static deserializeBinaryFromReader(message: GradebookFile, reader: jspb.BinaryReader): GradebookFile;
+}
+
+export namespace GradebookFile {
+  export type AsObject = {
+    name: string,
+    contenttype: string,
+    content: Uint8Array | string,
+  }
+}
+
 export class Void extends jspb.Message {
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): Void.AsObject;
@@ -16,14 +16,30 @@ var goog = jspb;
 var global = Function('return this')();
 
 goog.exportSymbol('proto.ag.Assignment', null, global);
+goog.exportSymbol('proto.ag.AssignmentStatistics', null, global);
 goog.exportSymbol('proto.ag.Assignments', null, global);
 goog.exportSymbol('proto.ag.AuthorizationResponse', null, global);
 goog.exportSymbol('proto.ag.Benchmarks', null, global);
+goog.exportSymbol('proto.ag.BuildJob', null, global);
+goog.exportSymbol('proto.ag.BuildJob.Status', null, global);
+goog.exportSymbol('proto.ag.BuildJobRequest', null, global);
+goog.exportSymbol('proto.ag.BuildJobs', null, global);
+goog.exportSymbol('proto.ag.BuildLogLine', null, global);
+goog.exportSymbol('proto.ag.BuildLogRequest', null, global);
+goog.exportSymbol('proto.ag.BuildQuota', null, global);
+goog.exportSymbol('proto.ag.BuildQuotaRequest', null, global);
+goog.exportSymbol('proto.ag.BuildQuotas', null, global);
 goog.exportSymbol('proto.ag.Course', null, global);
+goog.exportSymbol('proto.ag.Course.LatePolicy', null, global);
 goog.exportSymbol('proto.ag.CourseRequest', null, global);
+goog.exportSymbol('proto.ag.CourseStatistics', null, global);
 goog.exportSymbol('proto.ag.CourseSubmissions', null, global);
 goog.exportSymbol('proto.ag.CourseUserRequest', null, global);
 goog.exportSymbol('proto.ag.Courses', null, global);
+goog.exportSymbol('proto.ag.DeadlineExtension', null, global);
+goog.exportSymbol('proto.ag.DeadlineExtensionRequest', null, global);
+goog.exportSymbol('proto.ag.DeadlineExtensions', null, global);
+goog.exportSymbol('proto.ag.DeadlineSnapshot', null, global);
 goog.exportSymbol('proto.ag.Enrollment', null, global);
 goog.exportSymbol('proto.ag.Enrollment.DisplayState', null, global);
 goog.exportSymbol('proto.ag.Enrollment.UserStatus', null, global);
@@ -32,6 +48,9 @@ goog.exportSymbol('proto.ag.EnrollmentRequest', null, global);
 goog.exportSymbol('proto.ag.EnrollmentStatusRequest', null, global);
 goog.exportSymbol('proto.ag.Enrollments', null, global);
 goog.exportSymbol('proto.ag.GetGroupRequest', null, global);
+goog.exportSymbol('proto.ag.GradebookFile', null, global);
+goog.exportSymbol('proto.ag.GradebookRequest', null, global);
+goog.exportSymbol('proto.ag.GradebookRequest.Format', null, global);
 goog.exportSymbol('proto.ag.GradingBenchmark', null, global);
 goog.exportSymbol('proto.ag.GradingCriterion', null, global);
 goog.exportSymbol('proto.ag.GradingCriterion.Grade', null, global);
@@ -45,6 +64,7 @@ goog.exportSymbol('proto.ag.Organization', null, global);
 goog.exportSymbol('proto.ag.Organizations', null, global);
 goog.exportSymbol('proto.ag.Provider', null, global);
 goog.exportSymbol('proto.ag.Providers', null, global);
+goog.exportSymbol('proto.ag.PushActivity', null, global);
 goog.exportSymbol('proto.ag.RebuildRequest', null, global);
 goog.exportSymbol('proto.ag.RemoteIdentity', null, global);
 goog.exportSymbol('proto.ag.Repositories', null, global);
@@ -54,19 +74,34 @@ goog.exportSymbol('proto.ag.RepositoryRequest', null, global);
 goog.exportSymbol('proto.ag.Review', null, global);
 goog.exportSymbol('proto.ag.ReviewRequest', null, global);
 goog.exportSymbol('proto.ag.Reviewers', null, global);
+goog.exportSymbol('proto.ag.SimilarityMatch', null, global);
+goog.exportSymbol('proto.ag.SimilarityPair', null, global);
+goog.exportSymbol('proto.ag.SimilarityPairs', null, global);
+goog.exportSymbol('proto.ag.SimilarityRequest', null, global);
+goog.exportSymbol('proto.ag.SlipDaysChange', null, global);
+goog.exportSymbol('proto.ag.SlipDaysRecomputation', null, global);
+goog.exportSymbol('proto.ag.SlipDaysRequest', null, global);
 goog.exportSymbol('proto.ag.Status', null, global);
 goog.exportSymbol('proto.ag.Submission', null, global);
 goog.exportSymbol('proto.ag.Submission.Status', null, global);
+goog.exportSymbol('proto.ag.SubmissionAttempt', null, global);
+goog.exportSymbol('proto.ag.SubmissionAttemptRequest', null, global);
+goog.exportSymbol('proto.ag.SubmissionAttempts', null, global);
 goog.exportSymbol('proto.ag.SubmissionLink', null, global);
 goog.exportSymbol('proto.ag.SubmissionRequest', null, global);
 goog.exportSymbol('proto.ag.SubmissionReviewersRequest', null, global);
 goog.exportSymbol('proto.ag.Submissions', null, global);
 goog.exportSymbol('proto.ag.SubmissionsForCourseRequest', null, global);
 goog.exportSymbol('proto.ag.SubmissionsForCourseRequest.Type', null, global);
+goog.exportSymbol('proto.ag.TestCaseStatistics', null, global);
+goog.exportSymbol('proto.ag.TestResult', null, global);
+goog.exportSymbol('proto.ag.TestStatistics', null, global);
+goog.exportSymbol('proto.ag.TestStatisticsRequest', null, global);
 goog.exportSymbol('proto.ag.URLRequest', null, global);
 goog.exportSymbol('proto.ag.UpdateSubmissionRequest', null, global);
 goog.exportSymbol('proto.ag.UpdateSubmissionsRequest', null, global);
 goog.exportSymbol('proto.ag.UsedSlipDays', null, global);
+goog.exportSymbol('proto.ag.UsedSlipDaysChange', null, global);
 goog.exportSymbol('proto.ag.User', null, global);
 goog.exportSymbol('proto.ag.UserRequest', null, global);
 goog.exportSymbol('proto.ag.Users', null, global);
@@ -281,6 +316,69 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.ag.UsedSlipDays.displayName = 'proto.ag.UsedSlipDays';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.UsedSlipDaysChange = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.UsedSlipDaysChange, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.UsedSlipDaysChange.displayName = 'proto.ag.UsedSlipDaysChange';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.SlipDaysChange = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.SlipDaysChange.repeatedFields_, null);
+};
+goog.inherits(proto.ag.SlipDaysChange, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.SlipDaysChange.displayName = 'proto.ag.SlipDaysChange';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.SlipDaysRecomputation = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.SlipDaysRecomputation.repeatedFields_, null);
+};
+goog.inherits(proto.ag.SlipDaysRecomputation, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.SlipDaysRecomputation.displayName = 'proto.ag.SlipDaysRecomputation';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -417,16 +515,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.Submission = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.Submission.repeatedFields_, null);
+proto.ag.DeadlineExtension = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.Submission, jspb.Message);
+goog.inherits(proto.ag.DeadlineExtension, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.Submission.displayName = 'proto.ag.Submission';
+  proto.ag.DeadlineExtension.displayName = 'proto.ag.DeadlineExtension';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -438,16 +536,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.Submissions = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.Submissions.repeatedFields_, null);
+proto.ag.DeadlineExtensions = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.DeadlineExtensions.repeatedFields_, null);
 };
-goog.inherits(proto.ag.Submissions, jspb.Message);
+goog.inherits(proto.ag.DeadlineExtensions, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.Submissions.displayName = 'proto.ag.Submissions';
+  proto.ag.DeadlineExtensions.displayName = 'proto.ag.DeadlineExtensions';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -459,16 +557,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.GradingBenchmark = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.GradingBenchmark.repeatedFields_, null);
+proto.ag.DeadlineSnapshot = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.GradingBenchmark, jspb.Message);
+goog.inherits(proto.ag.DeadlineSnapshot, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.GradingBenchmark.displayName = 'proto.ag.GradingBenchmark';
+  proto.ag.DeadlineSnapshot.displayName = 'proto.ag.DeadlineSnapshot';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -480,16 +578,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.Benchmarks = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.Benchmarks.repeatedFields_, null);
+proto.ag.Submission = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.Submission.repeatedFields_, null);
 };
-goog.inherits(proto.ag.Benchmarks, jspb.Message);
+goog.inherits(proto.ag.Submission, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.Benchmarks.displayName = 'proto.ag.Benchmarks';
+  proto.ag.Submission.displayName = 'proto.ag.Submission';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -501,16 +599,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.GradingCriterion = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.ag.Submissions = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.Submissions.repeatedFields_, null);
 };
-goog.inherits(proto.ag.GradingCriterion, jspb.Message);
+goog.inherits(proto.ag.Submissions, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.GradingCriterion.displayName = 'proto.ag.GradingCriterion';
+  proto.ag.Submissions.displayName = 'proto.ag.Submissions';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -522,16 +620,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.Review = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.Review.repeatedFields_, null);
+proto.ag.SubmissionAttempt = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.SubmissionAttempt.repeatedFields_, null);
 };
-goog.inherits(proto.ag.Review, jspb.Message);
+goog.inherits(proto.ag.SubmissionAttempt, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.Review.displayName = 'proto.ag.Review';
+  proto.ag.SubmissionAttempt.displayName = 'proto.ag.SubmissionAttempt';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -543,16 +641,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.Reviewers = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.Reviewers.repeatedFields_, null);
+proto.ag.SubmissionAttempts = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.SubmissionAttempts.repeatedFields_, null);
 };
-goog.inherits(proto.ag.Reviewers, jspb.Message);
+goog.inherits(proto.ag.SubmissionAttempts, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.Reviewers.displayName = 'proto.ag.Reviewers';
+  proto.ag.SubmissionAttempts.displayName = 'proto.ag.SubmissionAttempts';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -564,16 +662,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.ReviewRequest = function(opt_data) {
+proto.ag.TestResult = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.ReviewRequest, jspb.Message);
+goog.inherits(proto.ag.TestResult, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.ReviewRequest.displayName = 'proto.ag.ReviewRequest';
+  proto.ag.TestResult.displayName = 'proto.ag.TestResult';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -585,16 +683,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.CourseRequest = function(opt_data) {
+proto.ag.TestCaseStatistics = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.CourseRequest, jspb.Message);
+goog.inherits(proto.ag.TestCaseStatistics, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.CourseRequest.displayName = 'proto.ag.CourseRequest';
+  proto.ag.TestCaseStatistics.displayName = 'proto.ag.TestCaseStatistics';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -606,16 +704,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.UserRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.ag.TestStatistics = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.TestStatistics.repeatedFields_, null);
 };
-goog.inherits(proto.ag.UserRequest, jspb.Message);
+goog.inherits(proto.ag.TestStatistics, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.UserRequest.displayName = 'proto.ag.UserRequest';
+  proto.ag.TestStatistics.displayName = 'proto.ag.TestStatistics';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -627,16 +725,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.GetGroupRequest = function(opt_data) {
+proto.ag.PushActivity = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.GetGroupRequest, jspb.Message);
+goog.inherits(proto.ag.PushActivity, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.GetGroupRequest.displayName = 'proto.ag.GetGroupRequest';
+  proto.ag.PushActivity.displayName = 'proto.ag.PushActivity';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -648,16 +746,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.GroupRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.ag.AssignmentStatistics = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.AssignmentStatistics.repeatedFields_, null);
 };
-goog.inherits(proto.ag.GroupRequest, jspb.Message);
+goog.inherits(proto.ag.AssignmentStatistics, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.GroupRequest.displayName = 'proto.ag.GroupRequest';
+  proto.ag.AssignmentStatistics.displayName = 'proto.ag.AssignmentStatistics';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -669,16 +767,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.Provider = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.ag.CourseStatistics = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.CourseStatistics.repeatedFields_, null);
 };
-goog.inherits(proto.ag.Provider, jspb.Message);
+goog.inherits(proto.ag.CourseStatistics, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.Provider.displayName = 'proto.ag.Provider';
+  proto.ag.CourseStatistics.displayName = 'proto.ag.CourseStatistics';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -690,16 +788,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.OrgRequest = function(opt_data) {
+proto.ag.BuildJob = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.OrgRequest, jspb.Message);
+goog.inherits(proto.ag.BuildJob, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.OrgRequest.displayName = 'proto.ag.OrgRequest';
+  proto.ag.BuildJob.displayName = 'proto.ag.BuildJob';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -711,16 +809,37 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.Organization = function(opt_data) {
+proto.ag.BuildJobs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.BuildJobs.repeatedFields_, null);
+};
+goog.inherits(proto.ag.BuildJobs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.BuildJobs.displayName = 'proto.ag.BuildJobs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.BuildQuota = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.Organization, jspb.Message);
+goog.inherits(proto.ag.BuildQuota, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.Organization.displayName = 'proto.ag.Organization';
+  proto.ag.BuildQuota.displayName = 'proto.ag.BuildQuota';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -732,16 +851,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.Organizations = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.Organizations.repeatedFields_, null);
+proto.ag.BuildQuotas = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.BuildQuotas.repeatedFields_, null);
 };
-goog.inherits(proto.ag.Organizations, jspb.Message);
+goog.inherits(proto.ag.BuildQuotas, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.Organizations.displayName = 'proto.ag.Organizations';
+  proto.ag.BuildQuotas.displayName = 'proto.ag.BuildQuotas';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -753,16 +872,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.EnrollmentRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.EnrollmentRequest.repeatedFields_, null);
+proto.ag.SimilarityPair = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.SimilarityPair.repeatedFields_, null);
 };
-goog.inherits(proto.ag.EnrollmentRequest, jspb.Message);
+goog.inherits(proto.ag.SimilarityPair, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.EnrollmentRequest.displayName = 'proto.ag.EnrollmentRequest';
+  proto.ag.SimilarityPair.displayName = 'proto.ag.SimilarityPair';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -774,16 +893,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.EnrollmentStatusRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.EnrollmentStatusRequest.repeatedFields_, null);
+proto.ag.SimilarityPairs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.SimilarityPairs.repeatedFields_, null);
 };
-goog.inherits(proto.ag.EnrollmentStatusRequest, jspb.Message);
+goog.inherits(proto.ag.SimilarityPairs, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.EnrollmentStatusRequest.displayName = 'proto.ag.EnrollmentStatusRequest';
+  proto.ag.SimilarityPairs.displayName = 'proto.ag.SimilarityPairs';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -795,16 +914,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.SubmissionRequest = function(opt_data) {
+proto.ag.SimilarityMatch = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.SubmissionRequest, jspb.Message);
+goog.inherits(proto.ag.SimilarityMatch, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.SubmissionRequest.displayName = 'proto.ag.SubmissionRequest';
+  proto.ag.SimilarityMatch.displayName = 'proto.ag.SimilarityMatch';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -816,16 +935,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.UpdateSubmissionRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.ag.GradingBenchmark = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.GradingBenchmark.repeatedFields_, null);
 };
-goog.inherits(proto.ag.UpdateSubmissionRequest, jspb.Message);
+goog.inherits(proto.ag.GradingBenchmark, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.UpdateSubmissionRequest.displayName = 'proto.ag.UpdateSubmissionRequest';
+  proto.ag.GradingBenchmark.displayName = 'proto.ag.GradingBenchmark';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -837,16 +956,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.UpdateSubmissionsRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.ag.Benchmarks = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.Benchmarks.repeatedFields_, null);
 };
-goog.inherits(proto.ag.UpdateSubmissionsRequest, jspb.Message);
+goog.inherits(proto.ag.Benchmarks, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.UpdateSubmissionsRequest.displayName = 'proto.ag.UpdateSubmissionsRequest';
+  proto.ag.Benchmarks.displayName = 'proto.ag.Benchmarks';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -858,16 +977,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.SubmissionReviewersRequest = function(opt_data) {
+proto.ag.GradingCriterion = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.SubmissionReviewersRequest, jspb.Message);
+goog.inherits(proto.ag.GradingCriterion, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.SubmissionReviewersRequest.displayName = 'proto.ag.SubmissionReviewersRequest';
+  proto.ag.GradingCriterion.displayName = 'proto.ag.GradingCriterion';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -879,16 +998,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.Providers = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.Providers.repeatedFields_, null);
+proto.ag.Review = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.Review.repeatedFields_, null);
 };
-goog.inherits(proto.ag.Providers, jspb.Message);
+goog.inherits(proto.ag.Review, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.Providers.displayName = 'proto.ag.Providers';
+  proto.ag.Review.displayName = 'proto.ag.Review';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -900,16 +1019,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.URLRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.URLRequest.repeatedFields_, null);
+proto.ag.Reviewers = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.Reviewers.repeatedFields_, null);
 };
-goog.inherits(proto.ag.URLRequest, jspb.Message);
+goog.inherits(proto.ag.Reviewers, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.URLRequest.displayName = 'proto.ag.URLRequest';
+  proto.ag.Reviewers.displayName = 'proto.ag.Reviewers';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -921,16 +1040,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.RepositoryRequest = function(opt_data) {
+proto.ag.ReviewRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.RepositoryRequest, jspb.Message);
+goog.inherits(proto.ag.ReviewRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.RepositoryRequest.displayName = 'proto.ag.RepositoryRequest';
+  proto.ag.ReviewRequest.displayName = 'proto.ag.ReviewRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -942,16 +1061,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.Repositories = function(opt_data) {
+proto.ag.DeadlineExtensionRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.Repositories, jspb.Message);
+goog.inherits(proto.ag.DeadlineExtensionRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.Repositories.displayName = 'proto.ag.Repositories';
+  proto.ag.DeadlineExtensionRequest.displayName = 'proto.ag.DeadlineExtensionRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -963,16 +1082,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.AuthorizationResponse = function(opt_data) {
+proto.ag.SlipDaysRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.AuthorizationResponse, jspb.Message);
+goog.inherits(proto.ag.SlipDaysRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.AuthorizationResponse.displayName = 'proto.ag.AuthorizationResponse';
+  proto.ag.SlipDaysRequest.displayName = 'proto.ag.SlipDaysRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -984,16 +1103,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.Status = function(opt_data) {
+proto.ag.CourseRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.Status, jspb.Message);
+goog.inherits(proto.ag.CourseRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.Status.displayName = 'proto.ag.Status';
+  proto.ag.CourseRequest.displayName = 'proto.ag.CourseRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1005,16 +1124,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.SubmissionsForCourseRequest = function(opt_data) {
+proto.ag.UserRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.SubmissionsForCourseRequest, jspb.Message);
+goog.inherits(proto.ag.UserRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.SubmissionsForCourseRequest.displayName = 'proto.ag.SubmissionsForCourseRequest';
+  proto.ag.UserRequest.displayName = 'proto.ag.UserRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1026,16 +1145,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.RebuildRequest = function(opt_data) {
+proto.ag.GetGroupRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.RebuildRequest, jspb.Message);
+goog.inherits(proto.ag.GetGroupRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.RebuildRequest.displayName = 'proto.ag.RebuildRequest';
+  proto.ag.GetGroupRequest.displayName = 'proto.ag.GetGroupRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1047,16 +1166,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.CourseUserRequest = function(opt_data) {
+proto.ag.GroupRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.CourseUserRequest, jspb.Message);
+goog.inherits(proto.ag.GroupRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.CourseUserRequest.displayName = 'proto.ag.CourseUserRequest';
+  proto.ag.GroupRequest.displayName = 'proto.ag.GroupRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1068,16 +1187,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.LoadCriteriaRequest = function(opt_data) {
+proto.ag.Provider = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.LoadCriteriaRequest, jspb.Message);
+goog.inherits(proto.ag.Provider, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.LoadCriteriaRequest.displayName = 'proto.ag.LoadCriteriaRequest';
+  proto.ag.Provider.displayName = 'proto.ag.Provider';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1089,32 +1208,9160 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.ag.Void = function(opt_data) {
+proto.ag.OrgRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.ag.Void, jspb.Message);
+goog.inherits(proto.ag.OrgRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.ag.Void.displayName = 'proto.ag.Void';
+  proto.ag.OrgRequest.displayName = 'proto.ag.OrgRequest';
 }
-
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.ag.User.repeatedFields_ = [8,9];
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
-/**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
+proto.ag.Organization = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.Organization, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.Organization.displayName = 'proto.ag.Organization';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.Organizations = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.Organizations.repeatedFields_, null);
+};
+goog.inherits(proto.ag.Organizations, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.Organizations.displayName = 'proto.ag.Organizations';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.EnrollmentRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.EnrollmentRequest.repeatedFields_, null);
+};
+goog.inherits(proto.ag.EnrollmentRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.EnrollmentRequest.displayName = 'proto.ag.EnrollmentRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.EnrollmentStatusRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.EnrollmentStatusRequest.repeatedFields_, null);
+};
+goog.inherits(proto.ag.EnrollmentStatusRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.EnrollmentStatusRequest.displayName = 'proto.ag.EnrollmentStatusRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.SubmissionRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.SubmissionRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.SubmissionRequest.displayName = 'proto.ag.SubmissionRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.UpdateSubmissionRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.UpdateSubmissionRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.UpdateSubmissionRequest.displayName = 'proto.ag.UpdateSubmissionRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.UpdateSubmissionsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.UpdateSubmissionsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.UpdateSubmissionsRequest.displayName = 'proto.ag.UpdateSubmissionsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.SubmissionAttemptRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.SubmissionAttemptRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.SubmissionAttemptRequest.displayName = 'proto.ag.SubmissionAttemptRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.SubmissionReviewersRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.SubmissionReviewersRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.SubmissionReviewersRequest.displayName = 'proto.ag.SubmissionReviewersRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.Providers = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.Providers.repeatedFields_, null);
+};
+goog.inherits(proto.ag.Providers, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.Providers.displayName = 'proto.ag.Providers';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.URLRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.URLRequest.repeatedFields_, null);
+};
+goog.inherits(proto.ag.URLRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.URLRequest.displayName = 'proto.ag.URLRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.RepositoryRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.RepositoryRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.RepositoryRequest.displayName = 'proto.ag.RepositoryRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.Repositories = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.Repositories, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.Repositories.displayName = 'proto.ag.Repositories';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.AuthorizationResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.AuthorizationResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.AuthorizationResponse.displayName = 'proto.ag.AuthorizationResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.Status = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.Status, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.Status.displayName = 'proto.ag.Status';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.SubmissionsForCourseRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.SubmissionsForCourseRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.SubmissionsForCourseRequest.displayName = 'proto.ag.SubmissionsForCourseRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.RebuildRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.RebuildRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.RebuildRequest.displayName = 'proto.ag.RebuildRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.CourseUserRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.CourseUserRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.CourseUserRequest.displayName = 'proto.ag.CourseUserRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.LoadCriteriaRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.LoadCriteriaRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.LoadCriteriaRequest.displayName = 'proto.ag.LoadCriteriaRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.BuildJobRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.BuildJobRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.BuildJobRequest.displayName = 'proto.ag.BuildJobRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.BuildQuotaRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.BuildQuotaRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.BuildQuotaRequest.displayName = 'proto.ag.BuildQuotaRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.BuildLogRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.BuildLogRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.BuildLogRequest.displayName = 'proto.ag.BuildLogRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.BuildLogLine = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.BuildLogLine, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.BuildLogLine.displayName = 'proto.ag.BuildLogLine';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.TestStatisticsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.TestStatisticsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.TestStatisticsRequest.displayName = 'proto.ag.TestStatisticsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.SimilarityRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.SimilarityRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.SimilarityRequest.displayName = 'proto.ag.SimilarityRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.GradebookRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ag.GradebookRequest.repeatedFields_, null);
+};
+goog.inherits(proto.ag.GradebookRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.GradebookRequest.displayName = 'proto.ag.GradebookRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.GradebookFile = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.GradebookFile, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.GradebookFile.displayName = 'proto.ag.GradebookFile';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ag.Void = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ag.Void, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ag.Void.displayName = 'proto.ag.Void';
+}
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.User.repeatedFields_ = [8,9];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.User.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.User.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.User} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.User.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    isadmin: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    name: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    studentid: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    email: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    avatarurl: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    login: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    remoteidentitiesList: jspb.Message.toObjectList(msg.getRemoteidentitiesList(),
+    proto.ag.RemoteIdentity.toObject, includeInstance),
+    enrollmentsList: jspb.Message.toObjectList(msg.getEnrollmentsList(),
+    proto.ag.Enrollment.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.User}
+ */
+proto.ag.User.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.User;
+  return proto.ag.User.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.User} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.User}
+ */
+proto.ag.User.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsadmin(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStudentid(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEmail(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAvatarurl(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLogin(value);
+      break;
+    case 8:
+      var value = new proto.ag.RemoteIdentity;
+      reader.readMessage(value,proto.ag.RemoteIdentity.deserializeBinaryFromReader);
+      msg.addRemoteidentities(value);
+      break;
+    case 9:
+      var value = new proto.ag.Enrollment;
+      reader.readMessage(value,proto.ag.Enrollment.deserializeBinaryFromReader);
+      msg.addEnrollments(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.User.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.User.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.User} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.User.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getIsadmin();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getStudentid();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getEmail();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getAvatarurl();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getLogin();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getRemoteidentitiesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      8,
+      f,
+      proto.ag.RemoteIdentity.serializeBinaryToWriter
+    );
+  }
+  f = message.getEnrollmentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      9,
+      f,
+      proto.ag.Enrollment.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.ag.User.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.User} returns this
+ */
+proto.ag.User.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional bool isAdmin = 2;
+ * @return {boolean}
+ */
+proto.ag.User.prototype.getIsadmin = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.User} returns this
+ */
+proto.ag.User.prototype.setIsadmin = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+/**
+ * optional string name = 3;
+ * @return {string}
+ */
+proto.ag.User.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.User} returns this
+ */
+proto.ag.User.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string studentID = 4;
+ * @return {string}
+ */
+proto.ag.User.prototype.getStudentid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.User} returns this
+ */
+proto.ag.User.prototype.setStudentid = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string email = 5;
+ * @return {string}
+ */
+proto.ag.User.prototype.getEmail = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.User} returns this
+ */
+proto.ag.User.prototype.setEmail = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional string avatarURL = 6;
+ * @return {string}
+ */
+proto.ag.User.prototype.getAvatarurl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.User} returns this
+ */
+proto.ag.User.prototype.setAvatarurl = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional string login = 7;
+ * @return {string}
+ */
+proto.ag.User.prototype.getLogin = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.User} returns this
+ */
+proto.ag.User.prototype.setLogin = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * repeated RemoteIdentity remoteIdentities = 8;
+ * @return {!Array<!proto.ag.RemoteIdentity>}
+ */
+proto.ag.User.prototype.getRemoteidentitiesList = function() {
+  return /** @type{!Array<!proto.ag.RemoteIdentity>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.RemoteIdentity, 8));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.RemoteIdentity>} value
+ * @return {!proto.ag.User} returns this
+*/
+proto.ag.User.prototype.setRemoteidentitiesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 8, value);
+};
+
+
+/**
+ * @param {!proto.ag.RemoteIdentity=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.RemoteIdentity}
+ */
+proto.ag.User.prototype.addRemoteidentities = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 8, opt_value, proto.ag.RemoteIdentity, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.User} returns this
+ */
+proto.ag.User.prototype.clearRemoteidentitiesList = function() {
+  return this.setRemoteidentitiesList([]);
+};
+
+
+/**
+ * repeated Enrollment enrollments = 9;
+ * @return {!Array<!proto.ag.Enrollment>}
+ */
+proto.ag.User.prototype.getEnrollmentsList = function() {
+  return /** @type{!Array<!proto.ag.Enrollment>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.Enrollment, 9));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.Enrollment>} value
+ * @return {!proto.ag.User} returns this
+*/
+proto.ag.User.prototype.setEnrollmentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 9, value);
+};
+
+
+/**
+ * @param {!proto.ag.Enrollment=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.Enrollment}
+ */
+proto.ag.User.prototype.addEnrollments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 9, opt_value, proto.ag.Enrollment, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.User} returns this
+ */
+proto.ag.User.prototype.clearEnrollmentsList = function() {
+  return this.setEnrollmentsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.Users.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.Users.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Users.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.Users} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Users.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    usersList: jspb.Message.toObjectList(msg.getUsersList(),
+    proto.ag.User.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.Users}
+ */
+proto.ag.Users.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.Users;
+  return proto.ag.Users.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.Users} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.Users}
+ */
+proto.ag.Users.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.ag.User;
+      reader.readMessage(value,proto.ag.User.deserializeBinaryFromReader);
+      msg.addUsers(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.Users.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.Users.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.Users} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Users.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUsersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.ag.User.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated User users = 1;
+ * @return {!Array<!proto.ag.User>}
+ */
+proto.ag.Users.prototype.getUsersList = function() {
+  return /** @type{!Array<!proto.ag.User>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.User, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.User>} value
+ * @return {!proto.ag.Users} returns this
+*/
+proto.ag.Users.prototype.setUsersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.ag.User=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.User}
+ */
+proto.ag.Users.prototype.addUsers = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.User, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.Users} returns this
+ */
+proto.ag.Users.prototype.clearUsersList = function() {
+  return this.setUsersList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.RemoteIdentity.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.RemoteIdentity.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.RemoteIdentity} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.RemoteIdentity.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    provider: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    remoteid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    accesstoken: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    userid: jspb.Message.getFieldWithDefault(msg, 5, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.RemoteIdentity}
+ */
+proto.ag.RemoteIdentity.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.RemoteIdentity;
+  return proto.ag.RemoteIdentity.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.RemoteIdentity} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.RemoteIdentity}
+ */
+proto.ag.RemoteIdentity.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setProvider(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setRemoteid(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAccesstoken(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setUserid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.RemoteIdentity.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.RemoteIdentity.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.RemoteIdentity} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.RemoteIdentity.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getProvider();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getRemoteid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getAccesstoken();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getUserid();
+  if (f !== 0) {
+    writer.writeUint64(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.ag.RemoteIdentity.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.RemoteIdentity} returns this
+ */
+proto.ag.RemoteIdentity.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional string provider = 2;
+ * @return {string}
+ */
+proto.ag.RemoteIdentity.prototype.getProvider = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.RemoteIdentity} returns this
+ */
+proto.ag.RemoteIdentity.prototype.setProvider = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 remoteID = 3;
+ * @return {number}
+ */
+proto.ag.RemoteIdentity.prototype.getRemoteid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.RemoteIdentity} returns this
+ */
+proto.ag.RemoteIdentity.prototype.setRemoteid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional string accessToken = 4;
+ * @return {string}
+ */
+proto.ag.RemoteIdentity.prototype.getAccesstoken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.RemoteIdentity} returns this
+ */
+proto.ag.RemoteIdentity.prototype.setAccesstoken = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional uint64 userID = 5;
+ * @return {number}
+ */
+proto.ag.RemoteIdentity.prototype.getUserid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.RemoteIdentity} returns this
+ */
+proto.ag.RemoteIdentity.prototype.setUserid = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.Group.repeatedFields_ = [6,7];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.Group.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Group.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.Group} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Group.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    courseid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    teamid: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    status: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    usersList: jspb.Message.toObjectList(msg.getUsersList(),
+    proto.ag.User.toObject, includeInstance),
+    enrollmentsList: jspb.Message.toObjectList(msg.getEnrollmentsList(),
+    proto.ag.Enrollment.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.Group}
+ */
+proto.ag.Group.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.Group;
+  return proto.ag.Group.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.Group} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.Group}
+ */
+proto.ag.Group.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setTeamid(value);
+      break;
+    case 5:
+      var value = /** @type {!proto.ag.Group.GroupStatus} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    case 6:
+      var value = new proto.ag.User;
+      reader.readMessage(value,proto.ag.User.deserializeBinaryFromReader);
+      msg.addUsers(value);
+      break;
+    case 7:
+      var value = new proto.ag.Enrollment;
+      reader.readMessage(value,proto.ag.Enrollment.deserializeBinaryFromReader);
+      msg.addEnrollments(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.Group.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.Group.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.Group} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Group.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getTeamid();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      5,
+      f
+    );
+  }
+  f = message.getUsersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      6,
+      f,
+      proto.ag.User.serializeBinaryToWriter
+    );
+  }
+  f = message.getEnrollmentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      7,
+      f,
+      proto.ag.Enrollment.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.ag.Group.GroupStatus = {
+  PENDING: 0,
+  APPROVED: 1
+};
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.ag.Group.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Group} returns this
+ */
+proto.ag.Group.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.ag.Group.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Group} returns this
+ */
+proto.ag.Group.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 courseID = 3;
+ * @return {number}
+ */
+proto.ag.Group.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Group} returns this
+ */
+proto.ag.Group.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional uint64 teamID = 4;
+ * @return {number}
+ */
+proto.ag.Group.prototype.getTeamid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Group} returns this
+ */
+proto.ag.Group.prototype.setTeamid = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional GroupStatus status = 5;
+ * @return {!proto.ag.Group.GroupStatus}
+ */
+proto.ag.Group.prototype.getStatus = function() {
+  return /** @type {!proto.ag.Group.GroupStatus} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {!proto.ag.Group.GroupStatus} value
+ * @return {!proto.ag.Group} returns this
+ */
+proto.ag.Group.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 5, value);
+};
+
+
+/**
+ * repeated User users = 6;
+ * @return {!Array<!proto.ag.User>}
+ */
+proto.ag.Group.prototype.getUsersList = function() {
+  return /** @type{!Array<!proto.ag.User>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.User, 6));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.User>} value
+ * @return {!proto.ag.Group} returns this
+*/
+proto.ag.Group.prototype.setUsersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 6, value);
+};
+
+
+/**
+ * @param {!proto.ag.User=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.User}
+ */
+proto.ag.Group.prototype.addUsers = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 6, opt_value, proto.ag.User, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.Group} returns this
+ */
+proto.ag.Group.prototype.clearUsersList = function() {
+  return this.setUsersList([]);
+};
+
+
+/**
+ * repeated Enrollment enrollments = 7;
+ * @return {!Array<!proto.ag.Enrollment>}
+ */
+proto.ag.Group.prototype.getEnrollmentsList = function() {
+  return /** @type{!Array<!proto.ag.Enrollment>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.Enrollment, 7));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.Enrollment>} value
+ * @return {!proto.ag.Group} returns this
+*/
+proto.ag.Group.prototype.setEnrollmentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 7, value);
+};
+
+
+/**
+ * @param {!proto.ag.Enrollment=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.Enrollment}
+ */
+proto.ag.Group.prototype.addEnrollments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 7, opt_value, proto.ag.Enrollment, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.Group} returns this
+ */
+proto.ag.Group.prototype.clearEnrollmentsList = function() {
+  return this.setEnrollmentsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.Groups.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.Groups.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Groups.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.Groups} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Groups.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    groupsList: jspb.Message.toObjectList(msg.getGroupsList(),
+    proto.ag.Group.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.Groups}
+ */
+proto.ag.Groups.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.Groups;
+  return proto.ag.Groups.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.Groups} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.Groups}
+ */
+proto.ag.Groups.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.ag.Group;
+      reader.readMessage(value,proto.ag.Group.deserializeBinaryFromReader);
+      msg.addGroups(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.Groups.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.Groups.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.Groups} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Groups.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getGroupsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.ag.Group.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated Group groups = 1;
+ * @return {!Array<!proto.ag.Group>}
+ */
+proto.ag.Groups.prototype.getGroupsList = function() {
+  return /** @type{!Array<!proto.ag.Group>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.Group, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.Group>} value
+ * @return {!proto.ag.Groups} returns this
+*/
+proto.ag.Groups.prototype.setGroupsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.ag.Group=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.Group}
+ */
+proto.ag.Groups.prototype.addGroups = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.Group, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.Groups} returns this
+ */
+proto.ag.Groups.prototype.clearGroupsList = function() {
+  return this.setGroupsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.Course.repeatedFields_ = [12,13,14];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.Course.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Course.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.Course} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Course.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    coursecreatorid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    name: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    code: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    year: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    tag: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    provider: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    organizationid: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    organizationpath: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    slipdays: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    enrolled: jspb.Message.getFieldWithDefault(msg, 11, 0),
+    graceperiod: jspb.Message.getFieldWithDefault(msg, 15, ""),
+    latepolicy: jspb.Message.getFieldWithDefault(msg, 16, 0),
+    latepenalty: jspb.Message.getFieldWithDefault(msg, 17, 0),
+    enrollmentsList: jspb.Message.toObjectList(msg.getEnrollmentsList(),
+    proto.ag.Enrollment.toObject, includeInstance),
+    assignmentsList: jspb.Message.toObjectList(msg.getAssignmentsList(),
+    proto.ag.Assignment.toObject, includeInstance),
+    groupsList: jspb.Message.toObjectList(msg.getGroupsList(),
+    proto.ag.Group.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.Course}
+ */
+proto.ag.Course.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.Course;
+  return proto.ag.Course.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.Course} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.Course}
+ */
+proto.ag.Course.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCoursecreatorid(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCode(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setYear(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTag(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setProvider(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setOrganizationid(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOrganizationpath(value);
+      break;
+    case 10:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setSlipdays(value);
+      break;
+    case 11:
+      var value = /** @type {!proto.ag.Enrollment.UserStatus} */ (reader.readEnum());
+      msg.setEnrolled(value);
+      break;
+    case 15:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setGraceperiod(value);
+      break;
+    case 16:
+      var value = /** @type {!proto.ag.Course.LatePolicy} */ (reader.readEnum());
+      msg.setLatepolicy(value);
+      break;
+    case 17:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setLatepenalty(value);
+      break;
+    case 12:
+      var value = new proto.ag.Enrollment;
+      reader.readMessage(value,proto.ag.Enrollment.deserializeBinaryFromReader);
+      msg.addEnrollments(value);
+      break;
+    case 13:
+      var value = new proto.ag.Assignment;
+      reader.readMessage(value,proto.ag.Assignment.deserializeBinaryFromReader);
+      msg.addAssignments(value);
+      break;
+    case 14:
+      var value = new proto.ag.Group;
+      reader.readMessage(value,proto.ag.Group.deserializeBinaryFromReader);
+      msg.addGroups(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.Course.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.Course.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.Course} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Course.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getCoursecreatorid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getCode();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getYear();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
+  f = message.getTag();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getProvider();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getOrganizationid();
+  if (f !== 0) {
+    writer.writeUint64(
+      8,
+      f
+    );
+  }
+  f = message.getOrganizationpath();
+  if (f.length > 0) {
+    writer.writeString(
+      9,
+      f
+    );
+  }
+  f = message.getSlipdays();
+  if (f !== 0) {
+    writer.writeUint32(
+      10,
+      f
+    );
+  }
+  f = message.getEnrolled();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      11,
+      f
+    );
+  }
+  f = message.getGraceperiod();
+  if (f.length > 0) {
+    writer.writeString(
+      15,
+      f
+    );
+  }
+  f = message.getLatepolicy();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      16,
+      f
+    );
+  }
+  f = message.getLatepenalty();
+  if (f !== 0) {
+    writer.writeUint32(
+      17,
+      f
+    );
+  }
+  f = message.getEnrollmentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      12,
+      f,
+      proto.ag.Enrollment.serializeBinaryToWriter
+    );
+  }
+  f = message.getAssignmentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      13,
+      f,
+      proto.ag.Assignment.serializeBinaryToWriter
+    );
+  }
+  f = message.getGroupsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      14,
+      f,
+      proto.ag.Group.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.ag.Course.LatePolicy = {
+  DEFAULT: 0,
+  SLIP_DAYS: 1,
+  LINEAR_PENALTY: 2,
+  STEP_PENALTY: 3,
+  HARD_CUTOFF: 4
+};
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.ag.Course.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Course} returns this
+ */
+proto.ag.Course.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 courseCreatorID = 2;
+ * @return {number}
+ */
+proto.ag.Course.prototype.getCoursecreatorid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Course} returns this
+ */
+proto.ag.Course.prototype.setCoursecreatorid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string name = 3;
+ * @return {string}
+ */
+proto.ag.Course.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Course} returns this
+ */
+proto.ag.Course.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string code = 4;
+ * @return {string}
+ */
+proto.ag.Course.prototype.getCode = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Course} returns this
+ */
+proto.ag.Course.prototype.setCode = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional uint32 year = 5;
+ * @return {number}
+ */
+proto.ag.Course.prototype.getYear = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Course} returns this
+ */
+proto.ag.Course.prototype.setYear = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional string tag = 6;
+ * @return {string}
+ */
+proto.ag.Course.prototype.getTag = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Course} returns this
+ */
+proto.ag.Course.prototype.setTag = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional string provider = 7;
+ * @return {string}
+ */
+proto.ag.Course.prototype.getProvider = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Course} returns this
+ */
+proto.ag.Course.prototype.setProvider = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * optional uint64 organizationID = 8;
+ * @return {number}
+ */
+proto.ag.Course.prototype.getOrganizationid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Course} returns this
+ */
+proto.ag.Course.prototype.setOrganizationid = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
+};
+
+
+/**
+ * optional string organizationPath = 9;
+ * @return {string}
+ */
+proto.ag.Course.prototype.getOrganizationpath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Course} returns this
+ */
+proto.ag.Course.prototype.setOrganizationpath = function(value) {
+  return jspb.Message.setProto3StringField(this, 9, value);
+};
+
+
+/**
+ * optional uint32 slipDays = 10;
+ * @return {number}
+ */
+proto.ag.Course.prototype.getSlipdays = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Course} returns this
+ */
+proto.ag.Course.prototype.setSlipdays = function(value) {
+  return jspb.Message.setProto3IntField(this, 10, value);
+};
+
+
+/**
+ * optional Enrollment.UserStatus enrolled = 11;
+ * @return {!proto.ag.Enrollment.UserStatus}
+ */
+proto.ag.Course.prototype.getEnrolled = function() {
+  return /** @type {!proto.ag.Enrollment.UserStatus} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+};
+
+
+/**
+ * @param {!proto.ag.Enrollment.UserStatus} value
+ * @return {!proto.ag.Course} returns this
+ */
+proto.ag.Course.prototype.setEnrolled = function(value) {
+  return jspb.Message.setProto3EnumField(this, 11, value);
+};
+
+
+/**
+ * optional string gracePeriod = 15;
+ * @return {string}
+ */
+proto.ag.Course.prototype.getGraceperiod = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 15, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Course} returns this
+ */
+proto.ag.Course.prototype.setGraceperiod = function(value) {
+  return jspb.Message.setProto3StringField(this, 15, value);
+};
+
+
+/**
+ * optional LatePolicy latePolicy = 16;
+ * @return {!proto.ag.Course.LatePolicy}
+ */
+proto.ag.Course.prototype.getLatepolicy = function() {
+  return /** @type {!proto.ag.Course.LatePolicy} */ (jspb.Message.getFieldWithDefault(this, 16, 0));
+};
+
+
+/**
+ * @param {!proto.ag.Course.LatePolicy} value
+ * @return {!proto.ag.Course} returns this
+ */
+proto.ag.Course.prototype.setLatepolicy = function(value) {
+  return jspb.Message.setProto3EnumField(this, 16, value);
+};
+
+
+/**
+ * optional uint32 latePenalty = 17;
+ * @return {number}
+ */
+proto.ag.Course.prototype.getLatepenalty = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 17, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Course} returns this
+ */
+proto.ag.Course.prototype.setLatepenalty = function(value) {
+  return jspb.Message.setProto3IntField(this, 17, value);
+};
+
+
+/**
+ * repeated Enrollment enrollments = 12;
+ * @return {!Array<!proto.ag.Enrollment>}
+ */
+proto.ag.Course.prototype.getEnrollmentsList = function() {
+  return /** @type{!Array<!proto.ag.Enrollment>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.Enrollment, 12));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.Enrollment>} value
+ * @return {!proto.ag.Course} returns this
+*/
+proto.ag.Course.prototype.setEnrollmentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 12, value);
+};
+
+
+/**
+ * @param {!proto.ag.Enrollment=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.Enrollment}
+ */
+proto.ag.Course.prototype.addEnrollments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 12, opt_value, proto.ag.Enrollment, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.Course} returns this
+ */
+proto.ag.Course.prototype.clearEnrollmentsList = function() {
+  return this.setEnrollmentsList([]);
+};
+
+
+/**
+ * repeated Assignment assignments = 13;
+ * @return {!Array<!proto.ag.Assignment>}
+ */
+proto.ag.Course.prototype.getAssignmentsList = function() {
+  return /** @type{!Array<!proto.ag.Assignment>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.Assignment, 13));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.Assignment>} value
+ * @return {!proto.ag.Course} returns this
+*/
+proto.ag.Course.prototype.setAssignmentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 13, value);
+};
+
+
+/**
+ * @param {!proto.ag.Assignment=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.Assignment}
+ */
+proto.ag.Course.prototype.addAssignments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 13, opt_value, proto.ag.Assignment, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.Course} returns this
+ */
+proto.ag.Course.prototype.clearAssignmentsList = function() {
+  return this.setAssignmentsList([]);
+};
+
+
+/**
+ * repeated Group groups = 14;
+ * @return {!Array<!proto.ag.Group>}
+ */
+proto.ag.Course.prototype.getGroupsList = function() {
+  return /** @type{!Array<!proto.ag.Group>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.Group, 14));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.Group>} value
+ * @return {!proto.ag.Course} returns this
+*/
+proto.ag.Course.prototype.setGroupsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 14, value);
+};
+
+
+/**
+ * @param {!proto.ag.Group=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.Group}
+ */
+proto.ag.Course.prototype.addGroups = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 14, opt_value, proto.ag.Group, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.Course} returns this
+ */
+proto.ag.Course.prototype.clearGroupsList = function() {
+  return this.setGroupsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.Courses.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.Courses.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Courses.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.Courses} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Courses.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    coursesList: jspb.Message.toObjectList(msg.getCoursesList(),
+    proto.ag.Course.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.Courses}
+ */
+proto.ag.Courses.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.Courses;
+  return proto.ag.Courses.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.Courses} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.Courses}
+ */
+proto.ag.Courses.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.ag.Course;
+      reader.readMessage(value,proto.ag.Course.deserializeBinaryFromReader);
+      msg.addCourses(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.Courses.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.Courses.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.Courses} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Courses.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCoursesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.ag.Course.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated Course courses = 1;
+ * @return {!Array<!proto.ag.Course>}
+ */
+proto.ag.Courses.prototype.getCoursesList = function() {
+  return /** @type{!Array<!proto.ag.Course>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.Course, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.Course>} value
+ * @return {!proto.ag.Courses} returns this
+*/
+proto.ag.Courses.prototype.setCoursesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.ag.Course=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.Course}
+ */
+proto.ag.Courses.prototype.addCourses = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.Course, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.Courses} returns this
+ */
+proto.ag.Courses.prototype.clearCoursesList = function() {
+  return this.setCoursesList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.Repository.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Repository.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.Repository} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Repository.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    organizationid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    repositoryid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    userid: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    groupid: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    htmlurl: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    repotype: jspb.Message.getFieldWithDefault(msg, 7, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.Repository}
+ */
+proto.ag.Repository.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.Repository;
+  return proto.ag.Repository.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.Repository} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.Repository}
+ */
+proto.ag.Repository.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setOrganizationid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setRepositoryid(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setUserid(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setGroupid(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setHtmlurl(value);
+      break;
+    case 7:
+      var value = /** @type {!proto.ag.Repository.Type} */ (reader.readEnum());
+      msg.setRepotype(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.Repository.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.Repository.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.Repository} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Repository.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getOrganizationid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getRepositoryid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getUserid();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
+  f = message.getGroupid();
+  if (f !== 0) {
+    writer.writeUint64(
+      5,
+      f
+    );
+  }
+  f = message.getHtmlurl();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getRepotype();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      7,
+      f
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.ag.Repository.Type = {
+  NONE: 0,
+  COURSEINFO: 1,
+  ASSIGNMENTS: 2,
+  TESTS: 3,
+  USER: 4,
+  GROUP: 5
+};
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.ag.Repository.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Repository} returns this
+ */
+proto.ag.Repository.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 organizationID = 2;
+ * @return {number}
+ */
+proto.ag.Repository.prototype.getOrganizationid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Repository} returns this
+ */
+proto.ag.Repository.prototype.setOrganizationid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 repositoryID = 3;
+ * @return {number}
+ */
+proto.ag.Repository.prototype.getRepositoryid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Repository} returns this
+ */
+proto.ag.Repository.prototype.setRepositoryid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional uint64 userID = 4;
+ * @return {number}
+ */
+proto.ag.Repository.prototype.getUserid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Repository} returns this
+ */
+proto.ag.Repository.prototype.setUserid = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional uint64 groupID = 5;
+ * @return {number}
+ */
+proto.ag.Repository.prototype.getGroupid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Repository} returns this
+ */
+proto.ag.Repository.prototype.setGroupid = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional string HTMLURL = 6;
+ * @return {string}
+ */
+proto.ag.Repository.prototype.getHtmlurl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Repository} returns this
+ */
+proto.ag.Repository.prototype.setHtmlurl = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional Type repoType = 7;
+ * @return {!proto.ag.Repository.Type}
+ */
+proto.ag.Repository.prototype.getRepotype = function() {
+  return /** @type {!proto.ag.Repository.Type} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {!proto.ag.Repository.Type} value
+ * @return {!proto.ag.Repository} returns this
+ */
+proto.ag.Repository.prototype.setRepotype = function(value) {
+  return jspb.Message.setProto3EnumField(this, 7, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.Enrollment.repeatedFields_ = [14];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.Enrollment.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Enrollment.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.Enrollment} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Enrollment.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    courseid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    userid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    groupid: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    hasteacherscopes: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+    user: (f = msg.getUser()) && proto.ag.User.toObject(includeInstance, f),
+    course: (f = msg.getCourse()) && proto.ag.Course.toObject(includeInstance, f),
+    group: (f = msg.getGroup()) && proto.ag.Group.toObject(includeInstance, f),
+    status: jspb.Message.getFieldWithDefault(msg, 9, 0),
+    state: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    slipdaysremaining: jspb.Message.getFieldWithDefault(msg, 11, 0),
+    lastactivitydate: jspb.Message.getFieldWithDefault(msg, 12, ""),
+    totalapproved: jspb.Message.getFieldWithDefault(msg, 13, 0),
+    usedslipdaysList: jspb.Message.toObjectList(msg.getUsedslipdaysList(),
+    proto.ag.UsedSlipDays.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.Enrollment}
+ */
+proto.ag.Enrollment.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.Enrollment;
+  return proto.ag.Enrollment.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.Enrollment} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.Enrollment}
+ */
+proto.ag.Enrollment.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setUserid(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setGroupid(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setHasteacherscopes(value);
+      break;
+    case 6:
+      var value = new proto.ag.User;
+      reader.readMessage(value,proto.ag.User.deserializeBinaryFromReader);
+      msg.setUser(value);
+      break;
+    case 7:
+      var value = new proto.ag.Course;
+      reader.readMessage(value,proto.ag.Course.deserializeBinaryFromReader);
+      msg.setCourse(value);
+      break;
+    case 8:
+      var value = new proto.ag.Group;
+      reader.readMessage(value,proto.ag.Group.deserializeBinaryFromReader);
+      msg.setGroup(value);
+      break;
+    case 9:
+      var value = /** @type {!proto.ag.Enrollment.UserStatus} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    case 10:
+      var value = /** @type {!proto.ag.Enrollment.DisplayState} */ (reader.readEnum());
+      msg.setState(value);
+      break;
+    case 11:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setSlipdaysremaining(value);
+      break;
+    case 12:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLastactivitydate(value);
+      break;
+    case 13:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setTotalapproved(value);
+      break;
+    case 14:
+      var value = new proto.ag.UsedSlipDays;
+      reader.readMessage(value,proto.ag.UsedSlipDays.deserializeBinaryFromReader);
+      msg.addUsedslipdays(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.Enrollment.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.Enrollment.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.Enrollment} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Enrollment.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getUserid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getGroupid();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
+  f = message.getHasteacherscopes();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+  f = message.getUser();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      proto.ag.User.serializeBinaryToWriter
+    );
+  }
+  f = message.getCourse();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      proto.ag.Course.serializeBinaryToWriter
+    );
+  }
+  f = message.getGroup();
+  if (f != null) {
+    writer.writeMessage(
+      8,
+      f,
+      proto.ag.Group.serializeBinaryToWriter
+    );
+  }
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      9,
+      f
+    );
+  }
+  f = message.getState();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      10,
+      f
+    );
+  }
+  f = message.getSlipdaysremaining();
+  if (f !== 0) {
+    writer.writeUint32(
+      11,
+      f
+    );
+  }
+  f = message.getLastactivitydate();
+  if (f.length > 0) {
+    writer.writeString(
+      12,
+      f
+    );
+  }
+  f = message.getTotalapproved();
+  if (f !== 0) {
+    writer.writeUint64(
+      13,
+      f
+    );
+  }
+  f = message.getUsedslipdaysList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      14,
+      f,
+      proto.ag.UsedSlipDays.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.ag.Enrollment.UserStatus = {
+  NONE: 0,
+  PENDING: 1,
+  STUDENT: 2,
+  TEACHER: 3
+};
+
+/**
+ * @enum {number}
+ */
+proto.ag.Enrollment.DisplayState = {
+  UNSET: 0,
+  HIDDEN: 1,
+  VISIBLE: 2,
+  FAVORITE: 3
+};
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.ag.Enrollment.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Enrollment} returns this
+ */
+proto.ag.Enrollment.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 courseID = 2;
+ * @return {number}
+ */
+proto.ag.Enrollment.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Enrollment} returns this
+ */
+proto.ag.Enrollment.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 userID = 3;
+ * @return {number}
+ */
+proto.ag.Enrollment.prototype.getUserid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Enrollment} returns this
+ */
+proto.ag.Enrollment.prototype.setUserid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional uint64 groupID = 4;
+ * @return {number}
+ */
+proto.ag.Enrollment.prototype.getGroupid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Enrollment} returns this
+ */
+proto.ag.Enrollment.prototype.setGroupid = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional bool hasTeacherScopes = 5;
+ * @return {boolean}
+ */
+proto.ag.Enrollment.prototype.getHasteacherscopes = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.Enrollment} returns this
+ */
+proto.ag.Enrollment.prototype.setHasteacherscopes = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
+
+/**
+ * optional User user = 6;
+ * @return {?proto.ag.User}
+ */
+proto.ag.Enrollment.prototype.getUser = function() {
+  return /** @type{?proto.ag.User} */ (
+    jspb.Message.getWrapperField(this, proto.ag.User, 6));
+};
+
+
+/**
+ * @param {?proto.ag.User|undefined} value
+ * @return {!proto.ag.Enrollment} returns this
+*/
+proto.ag.Enrollment.prototype.setUser = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ag.Enrollment} returns this
+ */
+proto.ag.Enrollment.prototype.clearUser = function() {
+  return this.setUser(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ag.Enrollment.prototype.hasUser = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * optional Course course = 7;
+ * @return {?proto.ag.Course}
+ */
+proto.ag.Enrollment.prototype.getCourse = function() {
+  return /** @type{?proto.ag.Course} */ (
+    jspb.Message.getWrapperField(this, proto.ag.Course, 7));
+};
+
+
+/**
+ * @param {?proto.ag.Course|undefined} value
+ * @return {!proto.ag.Enrollment} returns this
+*/
+proto.ag.Enrollment.prototype.setCourse = function(value) {
+  return jspb.Message.setWrapperField(this, 7, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ag.Enrollment} returns this
+ */
+proto.ag.Enrollment.prototype.clearCourse = function() {
+  return this.setCourse(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ag.Enrollment.prototype.hasCourse = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+/**
+ * optional Group group = 8;
+ * @return {?proto.ag.Group}
+ */
+proto.ag.Enrollment.prototype.getGroup = function() {
+  return /** @type{?proto.ag.Group} */ (
+    jspb.Message.getWrapperField(this, proto.ag.Group, 8));
+};
+
+
+/**
+ * @param {?proto.ag.Group|undefined} value
+ * @return {!proto.ag.Enrollment} returns this
+*/
+proto.ag.Enrollment.prototype.setGroup = function(value) {
+  return jspb.Message.setWrapperField(this, 8, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ag.Enrollment} returns this
+ */
+proto.ag.Enrollment.prototype.clearGroup = function() {
+  return this.setGroup(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ag.Enrollment.prototype.hasGroup = function() {
+  return jspb.Message.getField(this, 8) != null;
+};
+
+
+/**
+ * optional UserStatus status = 9;
+ * @return {!proto.ag.Enrollment.UserStatus}
+ */
+proto.ag.Enrollment.prototype.getStatus = function() {
+  return /** @type {!proto.ag.Enrollment.UserStatus} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {!proto.ag.Enrollment.UserStatus} value
+ * @return {!proto.ag.Enrollment} returns this
+ */
+proto.ag.Enrollment.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 9, value);
+};
+
+
+/**
+ * optional DisplayState state = 10;
+ * @return {!proto.ag.Enrollment.DisplayState}
+ */
+proto.ag.Enrollment.prototype.getState = function() {
+  return /** @type {!proto.ag.Enrollment.DisplayState} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/**
+ * @param {!proto.ag.Enrollment.DisplayState} value
+ * @return {!proto.ag.Enrollment} returns this
+ */
+proto.ag.Enrollment.prototype.setState = function(value) {
+  return jspb.Message.setProto3EnumField(this, 10, value);
+};
+
+
+/**
+ * optional uint32 slipDaysRemaining = 11;
+ * @return {number}
+ */
+proto.ag.Enrollment.prototype.getSlipdaysremaining = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Enrollment} returns this
+ */
+proto.ag.Enrollment.prototype.setSlipdaysremaining = function(value) {
+  return jspb.Message.setProto3IntField(this, 11, value);
+};
+
+
+/**
+ * optional string lastActivityDate = 12;
+ * @return {string}
+ */
+proto.ag.Enrollment.prototype.getLastactivitydate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 12, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Enrollment} returns this
+ */
+proto.ag.Enrollment.prototype.setLastactivitydate = function(value) {
+  return jspb.Message.setProto3StringField(this, 12, value);
+};
+
+
+/**
+ * optional uint64 totalApproved = 13;
+ * @return {number}
+ */
+proto.ag.Enrollment.prototype.getTotalapproved = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 13, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Enrollment} returns this
+ */
+proto.ag.Enrollment.prototype.setTotalapproved = function(value) {
+  return jspb.Message.setProto3IntField(this, 13, value);
+};
+
+
+/**
+ * repeated UsedSlipDays usedSlipDays = 14;
+ * @return {!Array<!proto.ag.UsedSlipDays>}
+ */
+proto.ag.Enrollment.prototype.getUsedslipdaysList = function() {
+  return /** @type{!Array<!proto.ag.UsedSlipDays>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.UsedSlipDays, 14));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.UsedSlipDays>} value
+ * @return {!proto.ag.Enrollment} returns this
+*/
+proto.ag.Enrollment.prototype.setUsedslipdaysList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 14, value);
+};
+
+
+/**
+ * @param {!proto.ag.UsedSlipDays=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.UsedSlipDays}
+ */
+proto.ag.Enrollment.prototype.addUsedslipdays = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 14, opt_value, proto.ag.UsedSlipDays, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.Enrollment} returns this
+ */
+proto.ag.Enrollment.prototype.clearUsedslipdaysList = function() {
+  return this.setUsedslipdaysList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.UsedSlipDays.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.UsedSlipDays.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.UsedSlipDays} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.UsedSlipDays.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    enrollmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    usedslipdays: jspb.Message.getFieldWithDefault(msg, 4, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.UsedSlipDays}
+ */
+proto.ag.UsedSlipDays.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.UsedSlipDays;
+  return proto.ag.UsedSlipDays.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.UsedSlipDays} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.UsedSlipDays}
+ */
+proto.ag.UsedSlipDays.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setEnrollmentid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setUsedslipdays(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.UsedSlipDays.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.UsedSlipDays.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.UsedSlipDays} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.UsedSlipDays.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getEnrollmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getUsedslipdays();
+  if (f !== 0) {
+    writer.writeUint32(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.ag.UsedSlipDays.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.UsedSlipDays} returns this
+ */
+proto.ag.UsedSlipDays.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 enrollmentID = 2;
+ * @return {number}
+ */
+proto.ag.UsedSlipDays.prototype.getEnrollmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.UsedSlipDays} returns this
+ */
+proto.ag.UsedSlipDays.prototype.setEnrollmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 assignmentID = 3;
+ * @return {number}
+ */
+proto.ag.UsedSlipDays.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.UsedSlipDays} returns this
+ */
+proto.ag.UsedSlipDays.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional uint32 usedSlipDays = 4;
+ * @return {number}
+ */
+proto.ag.UsedSlipDays.prototype.getUsedslipdays = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.UsedSlipDays} returns this
+ */
+proto.ag.UsedSlipDays.prototype.setUsedslipdays = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.UsedSlipDaysChange.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.UsedSlipDaysChange.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.UsedSlipDaysChange} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.UsedSlipDaysChange.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    oldusedslipdays: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    newusedslipdays: jspb.Message.getFieldWithDefault(msg, 3, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.UsedSlipDaysChange}
+ */
+proto.ag.UsedSlipDaysChange.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.UsedSlipDaysChange;
+  return proto.ag.UsedSlipDaysChange.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.UsedSlipDaysChange} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.UsedSlipDaysChange}
+ */
+proto.ag.UsedSlipDaysChange.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setOldusedslipdays(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setNewusedslipdays(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.UsedSlipDaysChange.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.UsedSlipDaysChange.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.UsedSlipDaysChange} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.UsedSlipDaysChange.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getOldusedslipdays();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+  f = message.getNewusedslipdays();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 assignmentID = 1;
+ * @return {number}
+ */
+proto.ag.UsedSlipDaysChange.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.UsedSlipDaysChange} returns this
+ */
+proto.ag.UsedSlipDaysChange.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint32 oldUsedSlipDays = 2;
+ * @return {number}
+ */
+proto.ag.UsedSlipDaysChange.prototype.getOldusedslipdays = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.UsedSlipDaysChange} returns this
+ */
+proto.ag.UsedSlipDaysChange.prototype.setOldusedslipdays = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint32 newUsedSlipDays = 3;
+ * @return {number}
+ */
+proto.ag.UsedSlipDaysChange.prototype.getNewusedslipdays = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.UsedSlipDaysChange} returns this
+ */
+proto.ag.UsedSlipDaysChange.prototype.setNewusedslipdays = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.SlipDaysChange.repeatedFields_ = [6,7];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.SlipDaysChange.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.SlipDaysChange.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.SlipDaysChange} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.SlipDaysChange.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enrollmentid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    userid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    login: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    oldremaining: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    newremaining: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    assignmentsList: jspb.Message.toObjectList(msg.getAssignmentsList(),
+    proto.ag.UsedSlipDaysChange.toObject, includeInstance),
+    keptassignmentidsList: (f = jspb.Message.getRepeatedField(msg, 7)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.SlipDaysChange}
+ */
+proto.ag.SlipDaysChange.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.SlipDaysChange;
+  return proto.ag.SlipDaysChange.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.SlipDaysChange} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.SlipDaysChange}
+ */
+proto.ag.SlipDaysChange.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setEnrollmentid(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setUserid(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLogin(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setOldremaining(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setNewremaining(value);
+      break;
+    case 6:
+      var value = new proto.ag.UsedSlipDaysChange;
+      reader.readMessage(value,proto.ag.UsedSlipDaysChange.deserializeBinaryFromReader);
+      msg.addAssignments(value);
+      break;
+    case 7:
+      var values = /** @type {!Array<number>} */ (reader.isDelimited() ? reader.readPackedUint64() : [reader.readUint64()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addKeptassignmentids(values[i]);
+      }
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.SlipDaysChange.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.SlipDaysChange.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.SlipDaysChange} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.SlipDaysChange.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnrollmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getUserid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getLogin();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getOldremaining();
+  if (f !== 0) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
+  f = message.getNewremaining();
+  if (f !== 0) {
+    writer.writeInt32(
+      5,
+      f
+    );
+  }
+  f = message.getAssignmentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      6,
+      f,
+      proto.ag.UsedSlipDaysChange.serializeBinaryToWriter
+    );
+  }
+  f = message.getKeptassignmentidsList();
+  if (f.length > 0) {
+    writer.writePackedUint64(
+      7,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 enrollmentID = 1;
+ * @return {number}
+ */
+proto.ag.SlipDaysChange.prototype.getEnrollmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.SlipDaysChange} returns this
+ */
+proto.ag.SlipDaysChange.prototype.setEnrollmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 userID = 2;
+ * @return {number}
+ */
+proto.ag.SlipDaysChange.prototype.getUserid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.SlipDaysChange} returns this
+ */
+proto.ag.SlipDaysChange.prototype.setUserid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string login = 3;
+ * @return {string}
+ */
+proto.ag.SlipDaysChange.prototype.getLogin = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.SlipDaysChange} returns this
+ */
+proto.ag.SlipDaysChange.prototype.setLogin = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional int32 oldRemaining = 4;
+ * @return {number}
+ */
+proto.ag.SlipDaysChange.prototype.getOldremaining = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.SlipDaysChange} returns this
+ */
+proto.ag.SlipDaysChange.prototype.setOldremaining = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional int32 newRemaining = 5;
+ * @return {number}
+ */
+proto.ag.SlipDaysChange.prototype.getNewremaining = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.SlipDaysChange} returns this
+ */
+proto.ag.SlipDaysChange.prototype.setNewremaining = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * repeated UsedSlipDaysChange assignments = 6;
+ * @return {!Array<!proto.ag.UsedSlipDaysChange>}
+ */
+proto.ag.SlipDaysChange.prototype.getAssignmentsList = function() {
+  return /** @type{!Array<!proto.ag.UsedSlipDaysChange>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.UsedSlipDaysChange, 6));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.UsedSlipDaysChange>} value
+ * @return {!proto.ag.SlipDaysChange} returns this
+*/
+proto.ag.SlipDaysChange.prototype.setAssignmentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 6, value);
+};
+
+
+/**
+ * @param {!proto.ag.UsedSlipDaysChange=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.UsedSlipDaysChange}
+ */
+proto.ag.SlipDaysChange.prototype.addAssignments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 6, opt_value, proto.ag.UsedSlipDaysChange, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.SlipDaysChange} returns this
+ */
+proto.ag.SlipDaysChange.prototype.clearAssignmentsList = function() {
+  return this.setAssignmentsList([]);
+};
+
+
+/**
+ * repeated uint64 keptAssignmentIDs = 7;
+ * @return {!Array<number>}
+ */
+proto.ag.SlipDaysChange.prototype.getKeptassignmentidsList = function() {
+  return /** @type {!Array<number>} */ (jspb.Message.getRepeatedField(this, 7));
+};
+
+
+/**
+ * @param {!Array<number>} value
+ * @return {!proto.ag.SlipDaysChange} returns this
+ */
+proto.ag.SlipDaysChange.prototype.setKeptassignmentidsList = function(value) {
+  return jspb.Message.setField(this, 7, value || []);
+};
+
+
+/**
+ * @param {number} value
+ * @param {number=} opt_index
+ * @return {!proto.ag.SlipDaysChange} returns this
+ */
+proto.ag.SlipDaysChange.prototype.addKeptassignmentids = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 7, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.SlipDaysChange} returns this
+ */
+proto.ag.SlipDaysChange.prototype.clearKeptassignmentidsList = function() {
+  return this.setKeptassignmentidsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.SlipDaysRecomputation.repeatedFields_ = [3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.SlipDaysRecomputation.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.SlipDaysRecomputation.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.SlipDaysRecomputation} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.SlipDaysRecomputation.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    oldslipdays: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    newslipdays: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    changesList: jspb.Message.toObjectList(msg.getChangesList(),
+    proto.ag.SlipDaysChange.toObject, includeInstance),
+    applied: jspb.Message.getBooleanFieldWithDefault(msg, 4, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.SlipDaysRecomputation}
+ */
+proto.ag.SlipDaysRecomputation.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.SlipDaysRecomputation;
+  return proto.ag.SlipDaysRecomputation.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.SlipDaysRecomputation} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.SlipDaysRecomputation}
+ */
+proto.ag.SlipDaysRecomputation.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setOldslipdays(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setNewslipdays(value);
+      break;
+    case 3:
+      var value = new proto.ag.SlipDaysChange;
+      reader.readMessage(value,proto.ag.SlipDaysChange.deserializeBinaryFromReader);
+      msg.addChanges(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setApplied(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.SlipDaysRecomputation.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.SlipDaysRecomputation.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.SlipDaysRecomputation} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.SlipDaysRecomputation.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getOldslipdays();
+  if (f !== 0) {
+    writer.writeUint32(
+      1,
+      f
+    );
+  }
+  f = message.getNewslipdays();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+  f = message.getChangesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.ag.SlipDaysChange.serializeBinaryToWriter
+    );
+  }
+  f = message.getApplied();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint32 oldSlipDays = 1;
+ * @return {number}
+ */
+proto.ag.SlipDaysRecomputation.prototype.getOldslipdays = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.SlipDaysRecomputation} returns this
+ */
+proto.ag.SlipDaysRecomputation.prototype.setOldslipdays = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint32 newSlipDays = 2;
+ * @return {number}
+ */
+proto.ag.SlipDaysRecomputation.prototype.getNewslipdays = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.SlipDaysRecomputation} returns this
+ */
+proto.ag.SlipDaysRecomputation.prototype.setNewslipdays = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * repeated SlipDaysChange changes = 3;
+ * @return {!Array<!proto.ag.SlipDaysChange>}
+ */
+proto.ag.SlipDaysRecomputation.prototype.getChangesList = function() {
+  return /** @type{!Array<!proto.ag.SlipDaysChange>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.SlipDaysChange, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.SlipDaysChange>} value
+ * @return {!proto.ag.SlipDaysRecomputation} returns this
+*/
+proto.ag.SlipDaysRecomputation.prototype.setChangesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.ag.SlipDaysChange=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.SlipDaysChange}
+ */
+proto.ag.SlipDaysRecomputation.prototype.addChanges = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.ag.SlipDaysChange, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.SlipDaysRecomputation} returns this
+ */
+proto.ag.SlipDaysRecomputation.prototype.clearChangesList = function() {
+  return this.setChangesList([]);
+};
+
+
+/**
+ * optional bool applied = 4;
+ * @return {boolean}
+ */
+proto.ag.SlipDaysRecomputation.prototype.getApplied = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.SlipDaysRecomputation} returns this
+ */
+proto.ag.SlipDaysRecomputation.prototype.setApplied = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.Enrollments.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.Enrollments.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Enrollments.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.Enrollments} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Enrollments.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enrollmentsList: jspb.Message.toObjectList(msg.getEnrollmentsList(),
+    proto.ag.Enrollment.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.Enrollments}
+ */
+proto.ag.Enrollments.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.Enrollments;
+  return proto.ag.Enrollments.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.Enrollments} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.Enrollments}
+ */
+proto.ag.Enrollments.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.ag.Enrollment;
+      reader.readMessage(value,proto.ag.Enrollment.deserializeBinaryFromReader);
+      msg.addEnrollments(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.Enrollments.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.Enrollments.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.Enrollments} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Enrollments.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnrollmentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.ag.Enrollment.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated Enrollment enrollments = 1;
+ * @return {!Array<!proto.ag.Enrollment>}
+ */
+proto.ag.Enrollments.prototype.getEnrollmentsList = function() {
+  return /** @type{!Array<!proto.ag.Enrollment>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.Enrollment, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.Enrollment>} value
+ * @return {!proto.ag.Enrollments} returns this
+*/
+proto.ag.Enrollments.prototype.setEnrollmentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.ag.Enrollment=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.Enrollment}
+ */
+proto.ag.Enrollments.prototype.addEnrollments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.Enrollment, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.Enrollments} returns this
+ */
+proto.ag.Enrollments.prototype.clearEnrollmentsList = function() {
+  return this.setEnrollmentsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.SubmissionLink.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.SubmissionLink.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.SubmissionLink} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.SubmissionLink.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    assignment: (f = msg.getAssignment()) && proto.ag.Assignment.toObject(includeInstance, f),
+    submission: (f = msg.getSubmission()) && proto.ag.Submission.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.SubmissionLink}
+ */
+proto.ag.SubmissionLink.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.SubmissionLink;
+  return proto.ag.SubmissionLink.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.SubmissionLink} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.SubmissionLink}
+ */
+proto.ag.SubmissionLink.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.ag.Assignment;
+      reader.readMessage(value,proto.ag.Assignment.deserializeBinaryFromReader);
+      msg.setAssignment(value);
+      break;
+    case 2:
+      var value = new proto.ag.Submission;
+      reader.readMessage(value,proto.ag.Submission.deserializeBinaryFromReader);
+      msg.setSubmission(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.SubmissionLink.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.SubmissionLink.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.SubmissionLink} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.SubmissionLink.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAssignment();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.ag.Assignment.serializeBinaryToWriter
+    );
+  }
+  f = message.getSubmission();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.ag.Submission.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional Assignment assignment = 1;
+ * @return {?proto.ag.Assignment}
+ */
+proto.ag.SubmissionLink.prototype.getAssignment = function() {
+  return /** @type{?proto.ag.Assignment} */ (
+    jspb.Message.getWrapperField(this, proto.ag.Assignment, 1));
+};
+
+
+/**
+ * @param {?proto.ag.Assignment|undefined} value
+ * @return {!proto.ag.SubmissionLink} returns this
+*/
+proto.ag.SubmissionLink.prototype.setAssignment = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ag.SubmissionLink} returns this
+ */
+proto.ag.SubmissionLink.prototype.clearAssignment = function() {
+  return this.setAssignment(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ag.SubmissionLink.prototype.hasAssignment = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional Submission submission = 2;
+ * @return {?proto.ag.Submission}
+ */
+proto.ag.SubmissionLink.prototype.getSubmission = function() {
+  return /** @type{?proto.ag.Submission} */ (
+    jspb.Message.getWrapperField(this, proto.ag.Submission, 2));
+};
+
+
+/**
+ * @param {?proto.ag.Submission|undefined} value
+ * @return {!proto.ag.SubmissionLink} returns this
+*/
+proto.ag.SubmissionLink.prototype.setSubmission = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ag.SubmissionLink} returns this
+ */
+proto.ag.SubmissionLink.prototype.clearSubmission = function() {
+  return this.setSubmission(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ag.SubmissionLink.prototype.hasSubmission = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.EnrollmentLink.repeatedFields_ = [3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.EnrollmentLink.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.EnrollmentLink.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.EnrollmentLink} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.EnrollmentLink.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enrollment: (f = msg.getEnrollment()) && proto.ag.Enrollment.toObject(includeInstance, f),
+    submissionsList: jspb.Message.toObjectList(msg.getSubmissionsList(),
+    proto.ag.SubmissionLink.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.EnrollmentLink}
+ */
+proto.ag.EnrollmentLink.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.EnrollmentLink;
+  return proto.ag.EnrollmentLink.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.EnrollmentLink} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.EnrollmentLink}
+ */
+proto.ag.EnrollmentLink.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 2:
+      var value = new proto.ag.Enrollment;
+      reader.readMessage(value,proto.ag.Enrollment.deserializeBinaryFromReader);
+      msg.setEnrollment(value);
+      break;
+    case 3:
+      var value = new proto.ag.SubmissionLink;
+      reader.readMessage(value,proto.ag.SubmissionLink.deserializeBinaryFromReader);
+      msg.addSubmissions(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.EnrollmentLink.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.EnrollmentLink.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.EnrollmentLink} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.EnrollmentLink.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnrollment();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.ag.Enrollment.serializeBinaryToWriter
+    );
+  }
+  f = message.getSubmissionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.ag.SubmissionLink.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional Enrollment enrollment = 2;
+ * @return {?proto.ag.Enrollment}
+ */
+proto.ag.EnrollmentLink.prototype.getEnrollment = function() {
+  return /** @type{?proto.ag.Enrollment} */ (
+    jspb.Message.getWrapperField(this, proto.ag.Enrollment, 2));
+};
+
+
+/**
+ * @param {?proto.ag.Enrollment|undefined} value
+ * @return {!proto.ag.EnrollmentLink} returns this
+*/
+proto.ag.EnrollmentLink.prototype.setEnrollment = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ag.EnrollmentLink} returns this
+ */
+proto.ag.EnrollmentLink.prototype.clearEnrollment = function() {
+  return this.setEnrollment(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ag.EnrollmentLink.prototype.hasEnrollment = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * repeated SubmissionLink submissions = 3;
+ * @return {!Array<!proto.ag.SubmissionLink>}
+ */
+proto.ag.EnrollmentLink.prototype.getSubmissionsList = function() {
+  return /** @type{!Array<!proto.ag.SubmissionLink>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.SubmissionLink, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.SubmissionLink>} value
+ * @return {!proto.ag.EnrollmentLink} returns this
+*/
+proto.ag.EnrollmentLink.prototype.setSubmissionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.ag.SubmissionLink=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.SubmissionLink}
+ */
+proto.ag.EnrollmentLink.prototype.addSubmissions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.ag.SubmissionLink, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.EnrollmentLink} returns this
+ */
+proto.ag.EnrollmentLink.prototype.clearSubmissionsList = function() {
+  return this.setSubmissionsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.CourseSubmissions.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.CourseSubmissions.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.CourseSubmissions.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.CourseSubmissions} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.CourseSubmissions.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    course: (f = msg.getCourse()) && proto.ag.Course.toObject(includeInstance, f),
+    linksList: jspb.Message.toObjectList(msg.getLinksList(),
+    proto.ag.EnrollmentLink.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.CourseSubmissions}
+ */
+proto.ag.CourseSubmissions.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.CourseSubmissions;
+  return proto.ag.CourseSubmissions.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.CourseSubmissions} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.CourseSubmissions}
+ */
+proto.ag.CourseSubmissions.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.ag.Course;
+      reader.readMessage(value,proto.ag.Course.deserializeBinaryFromReader);
+      msg.setCourse(value);
+      break;
+    case 2:
+      var value = new proto.ag.EnrollmentLink;
+      reader.readMessage(value,proto.ag.EnrollmentLink.deserializeBinaryFromReader);
+      msg.addLinks(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.CourseSubmissions.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.CourseSubmissions.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.CourseSubmissions} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.CourseSubmissions.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCourse();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.ag.Course.serializeBinaryToWriter
+    );
+  }
+  f = message.getLinksList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.ag.EnrollmentLink.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional Course course = 1;
+ * @return {?proto.ag.Course}
+ */
+proto.ag.CourseSubmissions.prototype.getCourse = function() {
+  return /** @type{?proto.ag.Course} */ (
+    jspb.Message.getWrapperField(this, proto.ag.Course, 1));
+};
+
+
+/**
+ * @param {?proto.ag.Course|undefined} value
+ * @return {!proto.ag.CourseSubmissions} returns this
+*/
+proto.ag.CourseSubmissions.prototype.setCourse = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ag.CourseSubmissions} returns this
+ */
+proto.ag.CourseSubmissions.prototype.clearCourse = function() {
+  return this.setCourse(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ag.CourseSubmissions.prototype.hasCourse = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * repeated EnrollmentLink links = 2;
+ * @return {!Array<!proto.ag.EnrollmentLink>}
+ */
+proto.ag.CourseSubmissions.prototype.getLinksList = function() {
+  return /** @type{!Array<!proto.ag.EnrollmentLink>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.EnrollmentLink, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.EnrollmentLink>} value
+ * @return {!proto.ag.CourseSubmissions} returns this
+*/
+proto.ag.CourseSubmissions.prototype.setLinksList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.ag.EnrollmentLink=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.EnrollmentLink}
+ */
+proto.ag.CourseSubmissions.prototype.addLinks = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.ag.EnrollmentLink, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.CourseSubmissions} returns this
+ */
+proto.ag.CourseSubmissions.prototype.clearLinksList = function() {
+  return this.setLinksList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.Assignment.repeatedFields_ = [12,13];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.Assignment.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Assignment.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.Assignment} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Assignment.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    courseid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    name: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    scriptfile: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    deadline: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    autoapprove: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
+    order: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    isgrouplab: jspb.Message.getBooleanFieldWithDefault(msg, 8, false),
+    scorelimit: jspb.Message.getFieldWithDefault(msg, 9, 0),
+    reviewers: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    skiptests: jspb.Message.getBooleanFieldWithDefault(msg, 11, false),
+    submissionsList: jspb.Message.toObjectList(msg.getSubmissionsList(),
+    proto.ag.Submission.toObject, includeInstance),
+    gradingbenchmarksList: jspb.Message.toObjectList(msg.getGradingbenchmarksList(),
+    proto.ag.GradingBenchmark.toObject, includeInstance),
+    containertimeout: jspb.Message.getFieldWithDefault(msg, 14, 0),
+    latepolicy: jspb.Message.getFieldWithDefault(msg, 15, 0),
+    latepenalty: jspb.Message.getFieldWithDefault(msg, 16, 0),
+    freezeatdeadline: jspb.Message.getBooleanFieldWithDefault(msg, 17, false),
+    finalbuild: jspb.Message.getBooleanFieldWithDefault(msg, 18, false),
+    releasedate: jspb.Message.getFieldWithDefault(msg, 19, ""),
+    releaseddate: jspb.Message.getFieldWithDefault(msg, 20, ""),
+    hiddentests: jspb.Message.getFieldWithDefault(msg, 21, ""),
+    hiddenatdeadline: jspb.Message.getBooleanFieldWithDefault(msg, 22, false),
+    buildsperhour: jspb.Message.getFieldWithDefault(msg, 23, 0),
+    buildinterval: jspb.Message.getFieldWithDefault(msg, 24, ""),
+    memorylimit: jspb.Message.getFieldWithDefault(msg, 25, 0),
+    cpulimit: jspb.Message.getFloatingPointFieldWithDefault(msg, 26, 0.0),
+    pidslimit: jspb.Message.getFieldWithDefault(msg, 27, 0),
+    disklimit: jspb.Message.getFieldWithDefault(msg, 28, 0),
+    networkdisabled: jspb.Message.getBooleanFieldWithDefault(msg, 29, false),
+    readonlyroot: jspb.Message.getBooleanFieldWithDefault(msg, 30, false),
+    pipeline: jspb.Message.getFieldWithDefault(msg, 31, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.Assignment}
+ */
+proto.ag.Assignment.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.Assignment;
+  return proto.ag.Assignment.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.Assignment} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.Assignment}
+ */
+proto.ag.Assignment.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setScriptfile(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDeadline(value);
+      break;
+    case 6:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setAutoapprove(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setOrder(value);
+      break;
+    case 8:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsgrouplab(value);
+      break;
+    case 9:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setScorelimit(value);
+      break;
+    case 10:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setReviewers(value);
+      break;
+    case 11:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSkiptests(value);
+      break;
+    case 12:
+      var value = new proto.ag.Submission;
+      reader.readMessage(value,proto.ag.Submission.deserializeBinaryFromReader);
+      msg.addSubmissions(value);
+      break;
+    case 13:
+      var value = new proto.ag.GradingBenchmark;
+      reader.readMessage(value,proto.ag.GradingBenchmark.deserializeBinaryFromReader);
+      msg.addGradingbenchmarks(value);
+      break;
+    case 14:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setContainertimeout(value);
+      break;
+    case 15:
+      var value = /** @type {!proto.ag.Course.LatePolicy} */ (reader.readEnum());
+      msg.setLatepolicy(value);
+      break;
+    case 16:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setLatepenalty(value);
+      break;
+    case 17:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setFreezeatdeadline(value);
+      break;
+    case 18:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setFinalbuild(value);
+      break;
+    case 19:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setReleasedate(value);
+      break;
+    case 20:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setReleaseddate(value);
+      break;
+    case 21:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setHiddentests(value);
+      break;
+    case 22:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setHiddenatdeadline(value);
+      break;
+    case 23:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setBuildsperhour(value);
+      break;
+    case 24:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBuildinterval(value);
+      break;
+    case 25:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setMemorylimit(value);
+      break;
+    case 26:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setCpulimit(value);
+      break;
+    case 27:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPidslimit(value);
+      break;
+    case 28:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setDisklimit(value);
+      break;
+    case 29:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setNetworkdisabled(value);
+      break;
+    case 30:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setReadonlyroot(value);
+      break;
+    case 31:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPipeline(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.Assignment.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.Assignment.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.Assignment} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Assignment.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getScriptfile();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getDeadline();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getAutoapprove();
+  if (f) {
+    writer.writeBool(
+      6,
+      f
+    );
+  }
+  f = message.getOrder();
+  if (f !== 0) {
+    writer.writeUint32(
+      7,
+      f
+    );
+  }
+  f = message.getIsgrouplab();
+  if (f) {
+    writer.writeBool(
+      8,
+      f
+    );
+  }
+  f = message.getScorelimit();
+  if (f !== 0) {
+    writer.writeUint32(
+      9,
+      f
+    );
+  }
+  f = message.getReviewers();
+  if (f !== 0) {
+    writer.writeUint32(
+      10,
+      f
+    );
+  }
+  f = message.getSkiptests();
+  if (f) {
+    writer.writeBool(
+      11,
+      f
+    );
+  }
+  f = message.getSubmissionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      12,
+      f,
+      proto.ag.Submission.serializeBinaryToWriter
+    );
+  }
+  f = message.getGradingbenchmarksList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      13,
+      f,
+      proto.ag.GradingBenchmark.serializeBinaryToWriter
+    );
+  }
+  f = message.getContainertimeout();
+  if (f !== 0) {
+    writer.writeUint32(
+      14,
+      f
+    );
+  }
+  f = message.getLatepolicy();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      15,
+      f
+    );
+  }
+  f = message.getLatepenalty();
+  if (f !== 0) {
+    writer.writeUint32(
+      16,
+      f
+    );
+  }
+  f = message.getFreezeatdeadline();
+  if (f) {
+    writer.writeBool(
+      17,
+      f
+    );
+  }
+  f = message.getFinalbuild();
+  if (f) {
+    writer.writeBool(
+      18,
+      f
+    );
+  }
+  f = message.getReleasedate();
+  if (f.length > 0) {
+    writer.writeString(
+      19,
+      f
+    );
+  }
+  f = message.getReleaseddate();
+  if (f.length > 0) {
+    writer.writeString(
+      20,
+      f
+    );
+  }
+  f = message.getHiddentests();
+  if (f.length > 0) {
+    writer.writeString(
+      21,
+      f
+    );
+  }
+  f = message.getHiddenatdeadline();
+  if (f) {
+    writer.writeBool(
+      22,
+      f
+    );
+  }
+  f = message.getBuildsperhour();
+  if (f !== 0) {
+    writer.writeUint32(
+      23,
+      f
+    );
+  }
+  f = message.getBuildinterval();
+  if (f.length > 0) {
+    writer.writeString(
+      24,
+      f
+    );
+  }
+  f = message.getMemorylimit();
+  if (f !== 0) {
+    writer.writeUint64(
+      25,
+      f
+    );
+  }
+  f = message.getCpulimit();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      26,
+      f
+    );
+  }
+  f = message.getPidslimit();
+  if (f !== 0) {
+    writer.writeUint32(
+      27,
+      f
+    );
+  }
+  f = message.getDisklimit();
+  if (f !== 0) {
+    writer.writeUint64(
+      28,
+      f
+    );
+  }
+  f = message.getNetworkdisabled();
+  if (f) {
+    writer.writeBool(
+      29,
+      f
+    );
+  }
+  f = message.getReadonlyroot();
+  if (f) {
+    writer.writeBool(
+      30,
+      f
+    );
+  }
+  f = message.getPipeline();
+  if (f.length > 0) {
+    writer.writeString(
+      31,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 courseID = 2;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string name = 3;
+ * @return {string}
+ */
+proto.ag.Assignment.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string scriptFile = 4;
+ * @return {string}
+ */
+proto.ag.Assignment.prototype.getScriptfile = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setScriptfile = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string deadline = 5;
+ * @return {string}
+ */
+proto.ag.Assignment.prototype.getDeadline = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setDeadline = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional bool autoApprove = 6;
+ * @return {boolean}
+ */
+proto.ag.Assignment.prototype.getAutoapprove = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setAutoapprove = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 6, value);
+};
+
+
+/**
+ * optional uint32 order = 7;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getOrder = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setOrder = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
+/**
+ * optional bool isGroupLab = 8;
+ * @return {boolean}
+ */
+proto.ag.Assignment.prototype.getIsgrouplab = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 8, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setIsgrouplab = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 8, value);
+};
+
+
+/**
+ * optional uint32 scoreLimit = 9;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getScorelimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setScorelimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 9, value);
+};
+
+
+/**
+ * optional uint32 reviewers = 10;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getReviewers = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setReviewers = function(value) {
+  return jspb.Message.setProto3IntField(this, 10, value);
+};
+
+
+/**
+ * optional bool skipTests = 11;
+ * @return {boolean}
+ */
+proto.ag.Assignment.prototype.getSkiptests = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 11, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setSkiptests = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 11, value);
+};
+
+
+/**
+ * repeated Submission submissions = 12;
+ * @return {!Array<!proto.ag.Submission>}
+ */
+proto.ag.Assignment.prototype.getSubmissionsList = function() {
+  return /** @type{!Array<!proto.ag.Submission>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.Submission, 12));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.Submission>} value
+ * @return {!proto.ag.Assignment} returns this
+*/
+proto.ag.Assignment.prototype.setSubmissionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 12, value);
+};
+
+
+/**
+ * @param {!proto.ag.Submission=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.Submission}
+ */
+proto.ag.Assignment.prototype.addSubmissions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 12, opt_value, proto.ag.Submission, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.clearSubmissionsList = function() {
+  return this.setSubmissionsList([]);
+};
+
+
+/**
+ * repeated GradingBenchmark gradingBenchmarks = 13;
+ * @return {!Array<!proto.ag.GradingBenchmark>}
+ */
+proto.ag.Assignment.prototype.getGradingbenchmarksList = function() {
+  return /** @type{!Array<!proto.ag.GradingBenchmark>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.GradingBenchmark, 13));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.GradingBenchmark>} value
+ * @return {!proto.ag.Assignment} returns this
+*/
+proto.ag.Assignment.prototype.setGradingbenchmarksList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 13, value);
+};
+
+
+/**
+ * @param {!proto.ag.GradingBenchmark=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.GradingBenchmark}
+ */
+proto.ag.Assignment.prototype.addGradingbenchmarks = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 13, opt_value, proto.ag.GradingBenchmark, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.clearGradingbenchmarksList = function() {
+  return this.setGradingbenchmarksList([]);
+};
+
+
+/**
+ * optional uint32 containerTimeout = 14;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getContainertimeout = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 14, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setContainertimeout = function(value) {
+  return jspb.Message.setProto3IntField(this, 14, value);
+};
+
+
+/**
+ * optional Course.LatePolicy latePolicy = 15;
+ * @return {!proto.ag.Course.LatePolicy}
+ */
+proto.ag.Assignment.prototype.getLatepolicy = function() {
+  return /** @type {!proto.ag.Course.LatePolicy} */ (jspb.Message.getFieldWithDefault(this, 15, 0));
+};
+
+
+/**
+ * @param {!proto.ag.Course.LatePolicy} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setLatepolicy = function(value) {
+  return jspb.Message.setProto3EnumField(this, 15, value);
+};
+
+
+/**
+ * optional uint32 latePenalty = 16;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getLatepenalty = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 16, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setLatepenalty = function(value) {
+  return jspb.Message.setProto3IntField(this, 16, value);
+};
+
+
+/**
+ * optional bool freezeAtDeadline = 17;
+ * @return {boolean}
+ */
+proto.ag.Assignment.prototype.getFreezeatdeadline = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 17, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setFreezeatdeadline = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 17, value);
+};
+
+
+/**
+ * optional bool finalBuild = 18;
+ * @return {boolean}
+ */
+proto.ag.Assignment.prototype.getFinalbuild = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 18, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setFinalbuild = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 18, value);
+};
+
+
+/**
+ * optional string releaseDate = 19;
+ * @return {string}
+ */
+proto.ag.Assignment.prototype.getReleasedate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 19, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setReleasedate = function(value) {
+  return jspb.Message.setProto3StringField(this, 19, value);
+};
+
+
+/**
+ * optional string releasedDate = 20;
+ * @return {string}
+ */
+proto.ag.Assignment.prototype.getReleaseddate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 20, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setReleaseddate = function(value) {
+  return jspb.Message.setProto3StringField(this, 20, value);
+};
+
+
+/**
+ * optional string hiddenTests = 21;
+ * @return {string}
+ */
+proto.ag.Assignment.prototype.getHiddentests = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 21, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setHiddentests = function(value) {
+  return jspb.Message.setProto3StringField(this, 21, value);
+};
+
+
+/**
+ * optional bool hiddenAtDeadline = 22;
+ * @return {boolean}
+ */
+proto.ag.Assignment.prototype.getHiddenatdeadline = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 22, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setHiddenatdeadline = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 22, value);
+};
+
+
+/**
+ * optional uint32 buildsPerHour = 23;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getBuildsperhour = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 23, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setBuildsperhour = function(value) {
+  return jspb.Message.setProto3IntField(this, 23, value);
+};
+
+
+/**
+ * optional string buildInterval = 24;
+ * @return {string}
+ */
+proto.ag.Assignment.prototype.getBuildinterval = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 24, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setBuildinterval = function(value) {
+  return jspb.Message.setProto3StringField(this, 24, value);
+};
+
+
+/**
+ * optional uint64 memoryLimit = 25;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getMemorylimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 25, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setMemorylimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 25, value);
+};
+
+
+/**
+ * optional double cpuLimit = 26;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getCpulimit = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 26, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setCpulimit = function(value) {
+  return jspb.Message.setProto3FloatField(this, 26, value);
+};
+
+
+/**
+ * optional uint32 pidsLimit = 27;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getPidslimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 27, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setPidslimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 27, value);
+};
+
+
+/**
+ * optional uint64 diskLimit = 28;
+ * @return {number}
+ */
+proto.ag.Assignment.prototype.getDisklimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 28, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setDisklimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 28, value);
+};
+
+
+/**
+ * optional bool networkDisabled = 29;
+ * @return {boolean}
+ */
+proto.ag.Assignment.prototype.getNetworkdisabled = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 29, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setNetworkdisabled = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 29, value);
+};
+
+
+/**
+ * optional bool readOnlyRoot = 30;
+ * @return {boolean}
+ */
+proto.ag.Assignment.prototype.getReadonlyroot = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 30, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setReadonlyroot = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 30, value);
+};
+
+
+/**
+ * optional string pipeline = 31;
+ * @return {string}
+ */
+proto.ag.Assignment.prototype.getPipeline = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 31, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Assignment} returns this
+ */
+proto.ag.Assignment.prototype.setPipeline = function(value) {
+  return jspb.Message.setProto3StringField(this, 31, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.Assignments.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.Assignments.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Assignments.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.Assignments} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Assignments.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    assignmentsList: jspb.Message.toObjectList(msg.getAssignmentsList(),
+    proto.ag.Assignment.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.Assignments}
+ */
+proto.ag.Assignments.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.Assignments;
+  return proto.ag.Assignments.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.Assignments} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.Assignments}
+ */
+proto.ag.Assignments.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.ag.Assignment;
+      reader.readMessage(value,proto.ag.Assignment.deserializeBinaryFromReader);
+      msg.addAssignments(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.Assignments.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.Assignments.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.Assignments} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Assignments.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAssignmentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.ag.Assignment.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated Assignment assignments = 1;
+ * @return {!Array<!proto.ag.Assignment>}
+ */
+proto.ag.Assignments.prototype.getAssignmentsList = function() {
+  return /** @type{!Array<!proto.ag.Assignment>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.Assignment, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.Assignment>} value
+ * @return {!proto.ag.Assignments} returns this
+*/
+proto.ag.Assignments.prototype.setAssignmentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.ag.Assignment=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.Assignment}
+ */
+proto.ag.Assignments.prototype.addAssignments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.Assignment, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.Assignments} returns this
+ */
+proto.ag.Assignments.prototype.clearAssignmentsList = function() {
+  return this.setAssignmentsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.DeadlineExtension.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.DeadlineExtension.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.DeadlineExtension} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.DeadlineExtension.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    userid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    groupid: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    deadline: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    reason: jspb.Message.getFieldWithDefault(msg, 6, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.DeadlineExtension}
+ */
+proto.ag.DeadlineExtension.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.DeadlineExtension;
+  return proto.ag.DeadlineExtension.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.DeadlineExtension} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.DeadlineExtension}
+ */
+proto.ag.DeadlineExtension.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setUserid(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setGroupid(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDeadline(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setReason(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.DeadlineExtension.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.DeadlineExtension.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.DeadlineExtension} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.DeadlineExtension.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getUserid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getGroupid();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
+  f = message.getDeadline();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getReason();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.ag.DeadlineExtension.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.DeadlineExtension} returns this
+ */
+proto.ag.DeadlineExtension.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 assignmentID = 2;
+ * @return {number}
+ */
+proto.ag.DeadlineExtension.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.DeadlineExtension} returns this
+ */
+proto.ag.DeadlineExtension.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 userID = 3;
+ * @return {number}
+ */
+proto.ag.DeadlineExtension.prototype.getUserid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.DeadlineExtension} returns this
+ */
+proto.ag.DeadlineExtension.prototype.setUserid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional uint64 groupID = 4;
+ * @return {number}
+ */
+proto.ag.DeadlineExtension.prototype.getGroupid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.DeadlineExtension} returns this
+ */
+proto.ag.DeadlineExtension.prototype.setGroupid = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional string deadline = 5;
+ * @return {string}
+ */
+proto.ag.DeadlineExtension.prototype.getDeadline = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.DeadlineExtension} returns this
+ */
+proto.ag.DeadlineExtension.prototype.setDeadline = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional string reason = 6;
+ * @return {string}
+ */
+proto.ag.DeadlineExtension.prototype.getReason = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.DeadlineExtension} returns this
+ */
+proto.ag.DeadlineExtension.prototype.setReason = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.DeadlineExtensions.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.DeadlineExtensions.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.DeadlineExtensions.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.DeadlineExtensions} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.DeadlineExtensions.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    extensionsList: jspb.Message.toObjectList(msg.getExtensionsList(),
+    proto.ag.DeadlineExtension.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.DeadlineExtensions}
+ */
+proto.ag.DeadlineExtensions.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.DeadlineExtensions;
+  return proto.ag.DeadlineExtensions.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.DeadlineExtensions} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.DeadlineExtensions}
+ */
+proto.ag.DeadlineExtensions.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.ag.DeadlineExtension;
+      reader.readMessage(value,proto.ag.DeadlineExtension.deserializeBinaryFromReader);
+      msg.addExtensions(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.DeadlineExtensions.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.DeadlineExtensions.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.DeadlineExtensions} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.DeadlineExtensions.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getExtensionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.ag.DeadlineExtension.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated DeadlineExtension extensions = 1;
+ * @return {!Array<!proto.ag.DeadlineExtension>}
+ */
+proto.ag.DeadlineExtensions.prototype.getExtensionsList = function() {
+  return /** @type{!Array<!proto.ag.DeadlineExtension>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.DeadlineExtension, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.DeadlineExtension>} value
+ * @return {!proto.ag.DeadlineExtensions} returns this
+*/
+proto.ag.DeadlineExtensions.prototype.setExtensionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.ag.DeadlineExtension=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.DeadlineExtension}
+ */
+proto.ag.DeadlineExtensions.prototype.addExtensions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.DeadlineExtension, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.DeadlineExtensions} returns this
+ */
+proto.ag.DeadlineExtensions.prototype.clearExtensionsList = function() {
+  return this.setExtensionsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.DeadlineSnapshot.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.DeadlineSnapshot.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.DeadlineSnapshot} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.DeadlineSnapshot.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    userid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    groupid: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    commithash: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    deadline: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    snapshotdate: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    frozen: jspb.Message.getBooleanFieldWithDefault(msg, 8, false),
+    buildjobid: jspb.Message.getFieldWithDefault(msg, 9, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.DeadlineSnapshot}
+ */
+proto.ag.DeadlineSnapshot.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.DeadlineSnapshot;
+  return proto.ag.DeadlineSnapshot.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.DeadlineSnapshot} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.DeadlineSnapshot}
+ */
+proto.ag.DeadlineSnapshot.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setUserid(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setGroupid(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCommithash(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDeadline(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSnapshotdate(value);
+      break;
+    case 8:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setFrozen(value);
+      break;
+    case 9:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setBuildjobid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.DeadlineSnapshot.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.DeadlineSnapshot.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.DeadlineSnapshot} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.DeadlineSnapshot.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getUserid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getGroupid();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
+  f = message.getCommithash();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getDeadline();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getSnapshotdate();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getFrozen();
+  if (f) {
+    writer.writeBool(
+      8,
+      f
+    );
+  }
+  f = message.getBuildjobid();
+  if (f !== 0) {
+    writer.writeUint64(
+      9,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.ag.DeadlineSnapshot.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.DeadlineSnapshot} returns this
+ */
+proto.ag.DeadlineSnapshot.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 assignmentID = 2;
+ * @return {number}
+ */
+proto.ag.DeadlineSnapshot.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.DeadlineSnapshot} returns this
+ */
+proto.ag.DeadlineSnapshot.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 userID = 3;
+ * @return {number}
+ */
+proto.ag.DeadlineSnapshot.prototype.getUserid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.DeadlineSnapshot} returns this
+ */
+proto.ag.DeadlineSnapshot.prototype.setUserid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional uint64 groupID = 4;
+ * @return {number}
+ */
+proto.ag.DeadlineSnapshot.prototype.getGroupid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.DeadlineSnapshot} returns this
+ */
+proto.ag.DeadlineSnapshot.prototype.setGroupid = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional string commitHash = 5;
+ * @return {string}
+ */
+proto.ag.DeadlineSnapshot.prototype.getCommithash = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.DeadlineSnapshot} returns this
+ */
+proto.ag.DeadlineSnapshot.prototype.setCommithash = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional string deadline = 6;
+ * @return {string}
+ */
+proto.ag.DeadlineSnapshot.prototype.getDeadline = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.DeadlineSnapshot} returns this
+ */
+proto.ag.DeadlineSnapshot.prototype.setDeadline = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional string snapshotDate = 7;
+ * @return {string}
+ */
+proto.ag.DeadlineSnapshot.prototype.getSnapshotdate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.DeadlineSnapshot} returns this
+ */
+proto.ag.DeadlineSnapshot.prototype.setSnapshotdate = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * optional bool frozen = 8;
+ * @return {boolean}
+ */
+proto.ag.DeadlineSnapshot.prototype.getFrozen = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 8, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.DeadlineSnapshot} returns this
+ */
+proto.ag.DeadlineSnapshot.prototype.setFrozen = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 8, value);
+};
+
+
+/**
+ * optional uint64 buildJobID = 9;
+ * @return {number}
+ */
+proto.ag.DeadlineSnapshot.prototype.getBuildjobid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.DeadlineSnapshot} returns this
+ */
+proto.ag.DeadlineSnapshot.prototype.setBuildjobid = function(value) {
+  return jspb.Message.setProto3IntField(this, 9, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.Submission.repeatedFields_ = [12,14];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.Submission.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Submission.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.Submission} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Submission.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    userid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    groupid: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    score: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    scoreobjects: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    buildinfo: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    commithash: jspb.Message.getFieldWithDefault(msg, 8, ""),
+    released: jspb.Message.getBooleanFieldWithDefault(msg, 9, false),
+    status: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    approveddate: jspb.Message.getFieldWithDefault(msg, 11, ""),
+    reviewsList: jspb.Message.toObjectList(msg.getReviewsList(),
+    proto.ag.Review.toObject, includeInstance),
+    gradedattemptid: jspb.Message.getFieldWithDefault(msg, 13, 0),
+    testresultsList: jspb.Message.toObjectList(msg.getTestresultsList(),
+    proto.ag.TestResult.toObject, includeInstance),
+    rawscore: jspb.Message.getFieldWithDefault(msg, 15, 0),
+    hiddenscore: jspb.Message.getFieldWithDefault(msg, 16, 0),
+    hiddenscoreobjects: jspb.Message.getFieldWithDefault(msg, 17, ""),
+    hiddenbuildinfo: jspb.Message.getFieldWithDefault(msg, 18, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.Submission}
+ */
+proto.ag.Submission.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.Submission;
+  return proto.ag.Submission.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.Submission} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.Submission}
+ */
+proto.ag.Submission.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setUserid(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setGroupid(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setScore(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setScoreobjects(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBuildinfo(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCommithash(value);
+      break;
+    case 9:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setReleased(value);
+      break;
+    case 10:
+      var value = /** @type {!proto.ag.Submission.Status} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    case 11:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setApproveddate(value);
+      break;
+    case 12:
+      var value = new proto.ag.Review;
+      reader.readMessage(value,proto.ag.Review.deserializeBinaryFromReader);
+      msg.addReviews(value);
+      break;
+    case 13:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setGradedattemptid(value);
+      break;
+    case 14:
+      var value = new proto.ag.TestResult;
+      reader.readMessage(value,proto.ag.TestResult.deserializeBinaryFromReader);
+      msg.addTestresults(value);
+      break;
+    case 15:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRawscore(value);
+      break;
+    case 16:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setHiddenscore(value);
+      break;
+    case 17:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setHiddenscoreobjects(value);
+      break;
+    case 18:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setHiddenbuildinfo(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.Submission.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.Submission.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.Submission} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Submission.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getUserid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getGroupid();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
+  f = message.getScore();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
+  f = message.getScoreobjects();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getBuildinfo();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getCommithash();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
+  f = message.getReleased();
+  if (f) {
+    writer.writeBool(
+      9,
+      f
+    );
+  }
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      10,
+      f
+    );
+  }
+  f = message.getApproveddate();
+  if (f.length > 0) {
+    writer.writeString(
+      11,
+      f
+    );
+  }
+  f = message.getReviewsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      12,
+      f,
+      proto.ag.Review.serializeBinaryToWriter
+    );
+  }
+  f = message.getGradedattemptid();
+  if (f !== 0) {
+    writer.writeUint64(
+      13,
+      f
+    );
+  }
+  f = message.getTestresultsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      14,
+      f,
+      proto.ag.TestResult.serializeBinaryToWriter
+    );
+  }
+  f = message.getRawscore();
+  if (f !== 0) {
+    writer.writeUint32(
+      15,
+      f
+    );
+  }
+  f = message.getHiddenscore();
+  if (f !== 0) {
+    writer.writeUint32(
+      16,
+      f
+    );
+  }
+  f = message.getHiddenscoreobjects();
+  if (f.length > 0) {
+    writer.writeString(
+      17,
+      f
+    );
+  }
+  f = message.getHiddenbuildinfo();
+  if (f.length > 0) {
+    writer.writeString(
+      18,
+      f
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.ag.Submission.Status = {
+  NONE: 0,
+  APPROVED: 1,
+  REJECTED: 2,
+  REVISION: 3
+};
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.ag.Submission.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Submission} returns this
+ */
+proto.ag.Submission.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 assignmentID = 2;
+ * @return {number}
+ */
+proto.ag.Submission.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Submission} returns this
+ */
+proto.ag.Submission.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 userID = 3;
+ * @return {number}
+ */
+proto.ag.Submission.prototype.getUserid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Submission} returns this
+ */
+proto.ag.Submission.prototype.setUserid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional uint64 groupID = 4;
+ * @return {number}
+ */
+proto.ag.Submission.prototype.getGroupid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Submission} returns this
+ */
+proto.ag.Submission.prototype.setGroupid = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional uint32 score = 5;
+ * @return {number}
+ */
+proto.ag.Submission.prototype.getScore = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Submission} returns this
+ */
+proto.ag.Submission.prototype.setScore = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional string scoreObjects = 6;
+ * @return {string}
+ */
+proto.ag.Submission.prototype.getScoreobjects = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Submission} returns this
+ */
+proto.ag.Submission.prototype.setScoreobjects = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional string buildInfo = 7;
+ * @return {string}
+ */
+proto.ag.Submission.prototype.getBuildinfo = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Submission} returns this
+ */
+proto.ag.Submission.prototype.setBuildinfo = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * optional string commitHash = 8;
+ * @return {string}
+ */
+proto.ag.Submission.prototype.getCommithash = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Submission} returns this
+ */
+proto.ag.Submission.prototype.setCommithash = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
+};
+
+
+/**
+ * optional bool released = 9;
+ * @return {boolean}
+ */
+proto.ag.Submission.prototype.getReleased = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 9, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.Submission} returns this
+ */
+proto.ag.Submission.prototype.setReleased = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 9, value);
+};
+
+
+/**
+ * optional Status status = 10;
+ * @return {!proto.ag.Submission.Status}
+ */
+proto.ag.Submission.prototype.getStatus = function() {
+  return /** @type {!proto.ag.Submission.Status} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/**
+ * @param {!proto.ag.Submission.Status} value
+ * @return {!proto.ag.Submission} returns this
+ */
+proto.ag.Submission.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 10, value);
+};
+
+
+/**
+ * optional string approvedDate = 11;
+ * @return {string}
+ */
+proto.ag.Submission.prototype.getApproveddate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Submission} returns this
+ */
+proto.ag.Submission.prototype.setApproveddate = function(value) {
+  return jspb.Message.setProto3StringField(this, 11, value);
+};
+
+
+/**
+ * repeated Review reviews = 12;
+ * @return {!Array<!proto.ag.Review>}
+ */
+proto.ag.Submission.prototype.getReviewsList = function() {
+  return /** @type{!Array<!proto.ag.Review>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.Review, 12));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.Review>} value
+ * @return {!proto.ag.Submission} returns this
+*/
+proto.ag.Submission.prototype.setReviewsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 12, value);
+};
+
+
+/**
+ * @param {!proto.ag.Review=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.Review}
+ */
+proto.ag.Submission.prototype.addReviews = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 12, opt_value, proto.ag.Review, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.Submission} returns this
+ */
+proto.ag.Submission.prototype.clearReviewsList = function() {
+  return this.setReviewsList([]);
+};
+
+
+/**
+ * optional uint64 gradedAttemptID = 13;
+ * @return {number}
+ */
+proto.ag.Submission.prototype.getGradedattemptid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 13, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Submission} returns this
+ */
+proto.ag.Submission.prototype.setGradedattemptid = function(value) {
+  return jspb.Message.setProto3IntField(this, 13, value);
+};
+
+
+/**
+ * repeated TestResult testResults = 14;
+ * @return {!Array<!proto.ag.TestResult>}
+ */
+proto.ag.Submission.prototype.getTestresultsList = function() {
+  return /** @type{!Array<!proto.ag.TestResult>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.TestResult, 14));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.TestResult>} value
+ * @return {!proto.ag.Submission} returns this
+*/
+proto.ag.Submission.prototype.setTestresultsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 14, value);
+};
+
+
+/**
+ * @param {!proto.ag.TestResult=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.TestResult}
+ */
+proto.ag.Submission.prototype.addTestresults = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 14, opt_value, proto.ag.TestResult, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.Submission} returns this
+ */
+proto.ag.Submission.prototype.clearTestresultsList = function() {
+  return this.setTestresultsList([]);
+};
+
+
+/**
+ * optional uint32 rawScore = 15;
+ * @return {number}
+ */
+proto.ag.Submission.prototype.getRawscore = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 15, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Submission} returns this
+ */
+proto.ag.Submission.prototype.setRawscore = function(value) {
+  return jspb.Message.setProto3IntField(this, 15, value);
+};
+
+
+/**
+ * optional uint32 hiddenScore = 16;
+ * @return {number}
+ */
+proto.ag.Submission.prototype.getHiddenscore = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 16, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.Submission} returns this
+ */
+proto.ag.Submission.prototype.setHiddenscore = function(value) {
+  return jspb.Message.setProto3IntField(this, 16, value);
+};
+
+
+/**
+ * optional string hiddenScoreObjects = 17;
+ * @return {string}
+ */
+proto.ag.Submission.prototype.getHiddenscoreobjects = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 17, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Submission} returns this
+ */
+proto.ag.Submission.prototype.setHiddenscoreobjects = function(value) {
+  return jspb.Message.setProto3StringField(this, 17, value);
+};
+
+
+/**
+ * optional string hiddenBuildInfo = 18;
+ * @return {string}
+ */
+proto.ag.Submission.prototype.getHiddenbuildinfo = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 18, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.Submission} returns this
+ */
+proto.ag.Submission.prototype.setHiddenbuildinfo = function(value) {
+  return jspb.Message.setProto3StringField(this, 18, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.Submissions.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.Submissions.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Submissions.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.Submissions} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Submissions.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    submissionsList: jspb.Message.toObjectList(msg.getSubmissionsList(),
+    proto.ag.Submission.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.Submissions}
+ */
+proto.ag.Submissions.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.Submissions;
+  return proto.ag.Submissions.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.Submissions} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.Submissions}
+ */
+proto.ag.Submissions.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.ag.Submission;
+      reader.readMessage(value,proto.ag.Submission.deserializeBinaryFromReader);
+      msg.addSubmissions(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.Submissions.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.Submissions.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.Submissions} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Submissions.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSubmissionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.ag.Submission.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated Submission submissions = 1;
+ * @return {!Array<!proto.ag.Submission>}
+ */
+proto.ag.Submissions.prototype.getSubmissionsList = function() {
+  return /** @type{!Array<!proto.ag.Submission>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.Submission, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.Submission>} value
+ * @return {!proto.ag.Submissions} returns this
+*/
+proto.ag.Submissions.prototype.setSubmissionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.ag.Submission=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.Submission}
+ */
+proto.ag.Submissions.prototype.addSubmissions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.Submission, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.Submissions} returns this
+ */
+proto.ag.Submissions.prototype.clearSubmissionsList = function() {
+  return this.setSubmissionsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.SubmissionAttempt.repeatedFields_ = [8];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.SubmissionAttempt.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.SubmissionAttempt.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.SubmissionAttempt} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.SubmissionAttempt.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    submissionid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    commithash: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    score: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    scoreobjects: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    buildinfo: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    createddate: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    testresultsList: jspb.Message.toObjectList(msg.getTestresultsList(),
+    proto.ag.TestResult.toObject, includeInstance),
+    rawscore: jspb.Message.getFieldWithDefault(msg, 9, 0),
+    hiddenscore: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    hiddenscoreobjects: jspb.Message.getFieldWithDefault(msg, 11, ""),
+    hiddenbuildinfo: jspb.Message.getFieldWithDefault(msg, 12, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.SubmissionAttempt}
+ */
+proto.ag.SubmissionAttempt.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.SubmissionAttempt;
+  return proto.ag.SubmissionAttempt.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.SubmissionAttempt} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.SubmissionAttempt}
+ */
+proto.ag.SubmissionAttempt.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setSubmissionid(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCommithash(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setScore(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setScoreobjects(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBuildinfo(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCreateddate(value);
+      break;
+    case 8:
+      var value = new proto.ag.TestResult;
+      reader.readMessage(value,proto.ag.TestResult.deserializeBinaryFromReader);
+      msg.addTestresults(value);
+      break;
+    case 9:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRawscore(value);
+      break;
+    case 10:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setHiddenscore(value);
+      break;
+    case 11:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setHiddenscoreobjects(value);
+      break;
+    case 12:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setHiddenbuildinfo(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.SubmissionAttempt.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.SubmissionAttempt.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.SubmissionAttempt} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.SubmissionAttempt.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getSubmissionid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getCommithash();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getScore();
+  if (f !== 0) {
+    writer.writeUint32(
+      4,
+      f
+    );
+  }
+  f = message.getScoreobjects();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getBuildinfo();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getCreateddate();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getTestresultsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      8,
+      f,
+      proto.ag.TestResult.serializeBinaryToWriter
+    );
+  }
+  f = message.getRawscore();
+  if (f !== 0) {
+    writer.writeUint32(
+      9,
+      f
+    );
+  }
+  f = message.getHiddenscore();
+  if (f !== 0) {
+    writer.writeUint32(
+      10,
+      f
+    );
+  }
+  f = message.getHiddenscoreobjects();
+  if (f.length > 0) {
+    writer.writeString(
+      11,
+      f
+    );
+  }
+  f = message.getHiddenbuildinfo();
+  if (f.length > 0) {
+    writer.writeString(
+      12,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.ag.SubmissionAttempt.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.SubmissionAttempt} returns this
+ */
+proto.ag.SubmissionAttempt.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 submissionID = 2;
+ * @return {number}
+ */
+proto.ag.SubmissionAttempt.prototype.getSubmissionid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.SubmissionAttempt} returns this
+ */
+proto.ag.SubmissionAttempt.prototype.setSubmissionid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string commitHash = 3;
+ * @return {string}
+ */
+proto.ag.SubmissionAttempt.prototype.getCommithash = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.SubmissionAttempt} returns this
+ */
+proto.ag.SubmissionAttempt.prototype.setCommithash = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional uint32 score = 4;
+ * @return {number}
+ */
+proto.ag.SubmissionAttempt.prototype.getScore = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.SubmissionAttempt} returns this
+ */
+proto.ag.SubmissionAttempt.prototype.setScore = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional string scoreObjects = 5;
+ * @return {string}
+ */
+proto.ag.SubmissionAttempt.prototype.getScoreobjects = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.SubmissionAttempt} returns this
+ */
+proto.ag.SubmissionAttempt.prototype.setScoreobjects = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional string buildInfo = 6;
+ * @return {string}
+ */
+proto.ag.SubmissionAttempt.prototype.getBuildinfo = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.SubmissionAttempt} returns this
+ */
+proto.ag.SubmissionAttempt.prototype.setBuildinfo = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional string createdDate = 7;
+ * @return {string}
+ */
+proto.ag.SubmissionAttempt.prototype.getCreateddate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.SubmissionAttempt} returns this
+ */
+proto.ag.SubmissionAttempt.prototype.setCreateddate = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * repeated TestResult testResults = 8;
+ * @return {!Array<!proto.ag.TestResult>}
+ */
+proto.ag.SubmissionAttempt.prototype.getTestresultsList = function() {
+  return /** @type{!Array<!proto.ag.TestResult>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.TestResult, 8));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.TestResult>} value
+ * @return {!proto.ag.SubmissionAttempt} returns this
+*/
+proto.ag.SubmissionAttempt.prototype.setTestresultsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 8, value);
+};
+
+
+/**
+ * @param {!proto.ag.TestResult=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.TestResult}
+ */
+proto.ag.SubmissionAttempt.prototype.addTestresults = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 8, opt_value, proto.ag.TestResult, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.SubmissionAttempt} returns this
+ */
+proto.ag.SubmissionAttempt.prototype.clearTestresultsList = function() {
+  return this.setTestresultsList([]);
+};
+
+
+/**
+ * optional uint32 rawScore = 9;
+ * @return {number}
+ */
+proto.ag.SubmissionAttempt.prototype.getRawscore = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.SubmissionAttempt} returns this
+ */
+proto.ag.SubmissionAttempt.prototype.setRawscore = function(value) {
+  return jspb.Message.setProto3IntField(this, 9, value);
+};
+
+
+/**
+ * optional uint32 hiddenScore = 10;
+ * @return {number}
+ */
+proto.ag.SubmissionAttempt.prototype.getHiddenscore = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.SubmissionAttempt} returns this
+ */
+proto.ag.SubmissionAttempt.prototype.setHiddenscore = function(value) {
+  return jspb.Message.setProto3IntField(this, 10, value);
+};
+
+
+/**
+ * optional string hiddenScoreObjects = 11;
+ * @return {string}
+ */
+proto.ag.SubmissionAttempt.prototype.getHiddenscoreobjects = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.SubmissionAttempt} returns this
+ */
+proto.ag.SubmissionAttempt.prototype.setHiddenscoreobjects = function(value) {
+  return jspb.Message.setProto3StringField(this, 11, value);
+};
+
+
+/**
+ * optional string hiddenBuildInfo = 12;
+ * @return {string}
+ */
+proto.ag.SubmissionAttempt.prototype.getHiddenbuildinfo = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 12, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.SubmissionAttempt} returns this
+ */
+proto.ag.SubmissionAttempt.prototype.setHiddenbuildinfo = function(value) {
+  return jspb.Message.setProto3StringField(this, 12, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.SubmissionAttempts.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.SubmissionAttempts.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.SubmissionAttempts.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.SubmissionAttempts} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.SubmissionAttempts.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    attemptsList: jspb.Message.toObjectList(msg.getAttemptsList(),
+    proto.ag.SubmissionAttempt.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.SubmissionAttempts}
+ */
+proto.ag.SubmissionAttempts.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.SubmissionAttempts;
+  return proto.ag.SubmissionAttempts.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.SubmissionAttempts} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.SubmissionAttempts}
+ */
+proto.ag.SubmissionAttempts.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.ag.SubmissionAttempt;
+      reader.readMessage(value,proto.ag.SubmissionAttempt.deserializeBinaryFromReader);
+      msg.addAttempts(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.SubmissionAttempts.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.SubmissionAttempts.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.SubmissionAttempts} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.SubmissionAttempts.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAttemptsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.ag.SubmissionAttempt.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated SubmissionAttempt attempts = 1;
+ * @return {!Array<!proto.ag.SubmissionAttempt>}
+ */
+proto.ag.SubmissionAttempts.prototype.getAttemptsList = function() {
+  return /** @type{!Array<!proto.ag.SubmissionAttempt>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.SubmissionAttempt, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.SubmissionAttempt>} value
+ * @return {!proto.ag.SubmissionAttempts} returns this
+*/
+proto.ag.SubmissionAttempts.prototype.setAttemptsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.ag.SubmissionAttempt=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.SubmissionAttempt}
+ */
+proto.ag.SubmissionAttempts.prototype.addAttempts = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.SubmissionAttempt, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.SubmissionAttempts} returns this
+ */
+proto.ag.SubmissionAttempts.prototype.clearAttemptsList = function() {
+  return this.setAttemptsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
  * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
  * For the list of reserved names please see:
  *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
@@ -1123,8 +10370,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.User.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.User.toObject(opt_includeInstance, this);
+proto.ag.TestResult.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.TestResult.toObject(opt_includeInstance, this);
 };
 
 
@@ -1133,23 +10380,23 @@ proto.ag.User.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.User} msg The msg instance to transform.
+ * @param {!proto.ag.TestResult} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.User.toObject = function(includeInstance, msg) {
+proto.ag.TestResult.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    isadmin: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
-    name: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    studentid: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    email: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    avatarurl: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    login: jspb.Message.getFieldWithDefault(msg, 7, ""),
-    remoteidentitiesList: jspb.Message.toObjectList(msg.getRemoteidentitiesList(),
-    proto.ag.RemoteIdentity.toObject, includeInstance),
-    enrollmentsList: jspb.Message.toObjectList(msg.getEnrollmentsList(),
-    proto.ag.Enrollment.toObject, includeInstance)
+    attemptid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    submissionid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    testname: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    score: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    maxscore: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    weight: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    duration: jspb.Message.getFieldWithDefault(msg, 9, 0),
+    failureoutput: jspb.Message.getFieldWithDefault(msg, 10, ""),
+    hidden: jspb.Message.getBooleanFieldWithDefault(msg, 11, false)
   };
 
   if (includeInstance) {
@@ -1163,23 +10410,23 @@ proto.ag.User.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.User}
+ * @return {!proto.ag.TestResult}
  */
-proto.ag.User.deserializeBinary = function(bytes) {
+proto.ag.TestResult.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.User;
-  return proto.ag.User.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.TestResult;
+  return proto.ag.TestResult.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.User} msg The message object to deserialize into.
+ * @param {!proto.ag.TestResult} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.User}
+ * @return {!proto.ag.TestResult}
  */
-proto.ag.User.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.TestResult.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1191,38 +10438,44 @@ proto.ag.User.deserializeBinaryFromReader = function(msg, reader) {
       msg.setId(value);
       break;
     case 2:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIsadmin(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAttemptid(value);
       break;
     case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setSubmissionid(value);
       break;
     case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setStudentid(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
       break;
     case 5:
       var value = /** @type {string} */ (reader.readString());
-      msg.setEmail(value);
+      msg.setTestname(value);
       break;
     case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setAvatarurl(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setScore(value);
       break;
     case 7:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setLogin(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMaxscore(value);
       break;
     case 8:
-      var value = new proto.ag.RemoteIdentity;
-      reader.readMessage(value,proto.ag.RemoteIdentity.deserializeBinaryFromReader);
-      msg.addRemoteidentities(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setWeight(value);
       break;
     case 9:
-      var value = new proto.ag.Enrollment;
-      reader.readMessage(value,proto.ag.Enrollment.deserializeBinaryFromReader);
-      msg.addEnrollments(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setDuration(value);
+      break;
+    case 10:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFailureoutput(value);
+      break;
+    case 11:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setHidden(value);
       break;
     default:
       reader.skipField();
@@ -1237,9 +10490,9 @@ proto.ag.User.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.User.prototype.serializeBinary = function() {
+proto.ag.TestResult.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.User.serializeBinaryToWriter(this, writer);
+  proto.ag.TestResult.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1247,11 +10500,11 @@ proto.ag.User.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.User} message
+ * @param {!proto.ag.TestResult} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.User.serializeBinaryToWriter = function(message, writer) {
+proto.ag.TestResult.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f !== 0) {
@@ -1260,62 +10513,74 @@ proto.ag.User.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getIsadmin();
-  if (f) {
-    writer.writeBool(
+  f = message.getAttemptid();
+  if (f !== 0) {
+    writer.writeUint64(
       2,
       f
     );
   }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getSubmissionid();
+  if (f !== 0) {
+    writer.writeUint64(
       3,
       f
     );
   }
-  f = message.getStudentid();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
       4,
       f
     );
   }
-  f = message.getEmail();
+  f = message.getTestname();
   if (f.length > 0) {
     writer.writeString(
       5,
       f
     );
   }
-  f = message.getAvatarurl();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getScore();
+  if (f !== 0) {
+    writer.writeInt32(
       6,
       f
     );
   }
-  f = message.getLogin();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getMaxscore();
+  if (f !== 0) {
+    writer.writeInt32(
       7,
       f
     );
   }
-  f = message.getRemoteidentitiesList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = message.getWeight();
+  if (f !== 0) {
+    writer.writeInt32(
       8,
-      f,
-      proto.ag.RemoteIdentity.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getEnrollmentsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = message.getDuration();
+  if (f !== 0) {
+    writer.writeInt64(
       9,
-      f,
-      proto.ag.Enrollment.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getFailureoutput();
+  if (f.length > 0) {
+    writer.writeString(
+      10,
+      f
+    );
+  }
+  f = message.getHidden();
+  if (f) {
+    writer.writeBool(
+      11,
+      f
     );
   }
 };
@@ -1325,201 +10590,447 @@ proto.ag.User.serializeBinaryToWriter = function(message, writer) {
  * optional uint64 ID = 1;
  * @return {number}
  */
-proto.ag.User.prototype.getId = function() {
+proto.ag.TestResult.prototype.getId = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.User} returns this
+ * @return {!proto.ag.TestResult} returns this
  */
-proto.ag.User.prototype.setId = function(value) {
+proto.ag.TestResult.prototype.setId = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional bool isAdmin = 2;
- * @return {boolean}
+ * optional uint64 attemptID = 2;
+ * @return {number}
  */
-proto.ag.User.prototype.getIsadmin = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+proto.ag.TestResult.prototype.getAttemptid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.ag.User} returns this
+ * @param {number} value
+ * @return {!proto.ag.TestResult} returns this
  */
-proto.ag.User.prototype.setIsadmin = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 2, value);
+proto.ag.TestResult.prototype.setAttemptid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional string name = 3;
+ * optional uint64 submissionID = 3;
+ * @return {number}
+ */
+proto.ag.TestResult.prototype.getSubmissionid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.TestResult} returns this
+ */
+proto.ag.TestResult.prototype.setSubmissionid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional uint64 assignmentID = 4;
+ * @return {number}
+ */
+proto.ag.TestResult.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.TestResult} returns this
+ */
+proto.ag.TestResult.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional string testName = 5;
  * @return {string}
  */
-proto.ag.User.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.ag.TestResult.prototype.getTestname = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ag.User} returns this
+ * @return {!proto.ag.TestResult} returns this
  */
-proto.ag.User.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.ag.TestResult.prototype.setTestname = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional int32 score = 6;
+ * @return {number}
+ */
+proto.ag.TestResult.prototype.getScore = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.TestResult} returns this
+ */
+proto.ag.TestResult.prototype.setScore = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * optional int32 maxScore = 7;
+ * @return {number}
+ */
+proto.ag.TestResult.prototype.getMaxscore = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.TestResult} returns this
+ */
+proto.ag.TestResult.prototype.setMaxscore = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
+/**
+ * optional int32 weight = 8;
+ * @return {number}
+ */
+proto.ag.TestResult.prototype.getWeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.TestResult} returns this
+ */
+proto.ag.TestResult.prototype.setWeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
+};
+
+
+/**
+ * optional int64 duration = 9;
+ * @return {number}
+ */
+proto.ag.TestResult.prototype.getDuration = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.TestResult} returns this
+ */
+proto.ag.TestResult.prototype.setDuration = function(value) {
+  return jspb.Message.setProto3IntField(this, 9, value);
+};
+
+
+/**
+ * optional string failureOutput = 10;
+ * @return {string}
+ */
+proto.ag.TestResult.prototype.getFailureoutput = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.TestResult} returns this
+ */
+proto.ag.TestResult.prototype.setFailureoutput = function(value) {
+  return jspb.Message.setProto3StringField(this, 10, value);
 };
 
 
 /**
- * optional string studentID = 4;
- * @return {string}
+ * optional bool hidden = 11;
+ * @return {boolean}
+ */
+proto.ag.TestResult.prototype.getHidden = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 11, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.TestResult} returns this
+ */
+proto.ag.TestResult.prototype.setHidden = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 11, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.ag.User.prototype.getStudentid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.ag.TestCaseStatistics.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.TestCaseStatistics.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.User} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.TestCaseStatistics} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.User.prototype.setStudentid = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.ag.TestCaseStatistics.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    testname: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    runs: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    failures: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    averagescore: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    averageduration: jspb.Message.getFieldWithDefault(msg, 5, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional string email = 5;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.TestCaseStatistics}
  */
-proto.ag.User.prototype.getEmail = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.ag.TestCaseStatistics.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.TestCaseStatistics;
+  return proto.ag.TestCaseStatistics.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.User} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.TestCaseStatistics} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.TestCaseStatistics}
  */
-proto.ag.User.prototype.setEmail = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
+proto.ag.TestCaseStatistics.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTestname(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRuns(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setFailures(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setAveragescore(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setAverageduration(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional string avatarURL = 6;
- * @return {string}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.ag.User.prototype.getAvatarurl = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.ag.TestCaseStatistics.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.TestCaseStatistics.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.User} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.TestCaseStatistics} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.User.prototype.setAvatarurl = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+proto.ag.TestCaseStatistics.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTestname();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getRuns();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+  f = message.getFailures();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
+    );
+  }
+  f = message.getAveragescore();
+  if (f !== 0) {
+    writer.writeUint32(
+      4,
+      f
+    );
+  }
+  f = message.getAverageduration();
+  if (f !== 0) {
+    writer.writeInt64(
+      5,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string login = 7;
+ * optional string testName = 1;
  * @return {string}
  */
-proto.ag.User.prototype.getLogin = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+proto.ag.TestCaseStatistics.prototype.getTestname = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ag.User} returns this
+ * @return {!proto.ag.TestCaseStatistics} returns this
  */
-proto.ag.User.prototype.setLogin = function(value) {
-  return jspb.Message.setProto3StringField(this, 7, value);
+proto.ag.TestCaseStatistics.prototype.setTestname = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * repeated RemoteIdentity remoteIdentities = 8;
- * @return {!Array<!proto.ag.RemoteIdentity>}
+ * optional uint32 runs = 2;
+ * @return {number}
  */
-proto.ag.User.prototype.getRemoteidentitiesList = function() {
-  return /** @type{!Array<!proto.ag.RemoteIdentity>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.RemoteIdentity, 8));
+proto.ag.TestCaseStatistics.prototype.getRuns = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {!Array<!proto.ag.RemoteIdentity>} value
- * @return {!proto.ag.User} returns this
-*/
-proto.ag.User.prototype.setRemoteidentitiesList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 8, value);
+ * @param {number} value
+ * @return {!proto.ag.TestCaseStatistics} returns this
+ */
+proto.ag.TestCaseStatistics.prototype.setRuns = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * @param {!proto.ag.RemoteIdentity=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ag.RemoteIdentity}
+ * optional uint32 failures = 3;
+ * @return {number}
  */
-proto.ag.User.prototype.addRemoteidentities = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 8, opt_value, proto.ag.RemoteIdentity, opt_index);
+proto.ag.TestCaseStatistics.prototype.getFailures = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.User} returns this
+ * @param {number} value
+ * @return {!proto.ag.TestCaseStatistics} returns this
  */
-proto.ag.User.prototype.clearRemoteidentitiesList = function() {
-  return this.setRemoteidentitiesList([]);
+proto.ag.TestCaseStatistics.prototype.setFailures = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * repeated Enrollment enrollments = 9;
- * @return {!Array<!proto.ag.Enrollment>}
+ * optional uint32 averageScore = 4;
+ * @return {number}
  */
-proto.ag.User.prototype.getEnrollmentsList = function() {
-  return /** @type{!Array<!proto.ag.Enrollment>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.Enrollment, 9));
+proto.ag.TestCaseStatistics.prototype.getAveragescore = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * @param {!Array<!proto.ag.Enrollment>} value
- * @return {!proto.ag.User} returns this
-*/
-proto.ag.User.prototype.setEnrollmentsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 9, value);
+ * @param {number} value
+ * @return {!proto.ag.TestCaseStatistics} returns this
+ */
+proto.ag.TestCaseStatistics.prototype.setAveragescore = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * @param {!proto.ag.Enrollment=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ag.Enrollment}
+ * optional int64 averageDuration = 5;
+ * @return {number}
  */
-proto.ag.User.prototype.addEnrollments = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 9, opt_value, proto.ag.Enrollment, opt_index);
+proto.ag.TestCaseStatistics.prototype.getAverageduration = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.User} returns this
+ * @param {number} value
+ * @return {!proto.ag.TestCaseStatistics} returns this
  */
-proto.ag.User.prototype.clearEnrollmentsList = function() {
-  return this.setEnrollmentsList([]);
+proto.ag.TestCaseStatistics.prototype.setAverageduration = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
@@ -1529,7 +11040,7 @@ proto.ag.User.prototype.clearEnrollmentsList = function() {
  * @private {!Array<number>}
  * @const
  */
-proto.ag.Users.repeatedFields_ = [1];
+proto.ag.TestStatistics.repeatedFields_ = [1];
 
 
 
@@ -1546,8 +11057,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Users.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Users.toObject(opt_includeInstance, this);
+proto.ag.TestStatistics.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.TestStatistics.toObject(opt_includeInstance, this);
 };
 
 
@@ -1556,14 +11067,14 @@ proto.ag.Users.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Users} msg The msg instance to transform.
+ * @param {!proto.ag.TestStatistics} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Users.toObject = function(includeInstance, msg) {
+proto.ag.TestStatistics.toObject = function(includeInstance, msg) {
   var f, obj = {
-    usersList: jspb.Message.toObjectList(msg.getUsersList(),
-    proto.ag.User.toObject, includeInstance)
+    testsList: jspb.Message.toObjectList(msg.getTestsList(),
+    proto.ag.TestCaseStatistics.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -1577,23 +11088,23 @@ proto.ag.Users.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Users}
+ * @return {!proto.ag.TestStatistics}
  */
-proto.ag.Users.deserializeBinary = function(bytes) {
+proto.ag.TestStatistics.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Users;
-  return proto.ag.Users.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.TestStatistics;
+  return proto.ag.TestStatistics.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Users} msg The message object to deserialize into.
+ * @param {!proto.ag.TestStatistics} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Users}
+ * @return {!proto.ag.TestStatistics}
  */
-proto.ag.Users.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.TestStatistics.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1601,9 +11112,9 @@ proto.ag.Users.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.ag.User;
-      reader.readMessage(value,proto.ag.User.deserializeBinaryFromReader);
-      msg.addUsers(value);
+      var value = new proto.ag.TestCaseStatistics;
+      reader.readMessage(value,proto.ag.TestCaseStatistics.deserializeBinaryFromReader);
+      msg.addTests(value);
       break;
     default:
       reader.skipField();
@@ -1618,9 +11129,9 @@ proto.ag.Users.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Users.prototype.serializeBinary = function() {
+proto.ag.TestStatistics.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Users.serializeBinaryToWriter(this, writer);
+  proto.ag.TestStatistics.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1628,58 +11139,58 @@ proto.ag.Users.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Users} message
+ * @param {!proto.ag.TestStatistics} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Users.serializeBinaryToWriter = function(message, writer) {
+proto.ag.TestStatistics.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUsersList();
+  f = message.getTestsList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
       1,
       f,
-      proto.ag.User.serializeBinaryToWriter
+      proto.ag.TestCaseStatistics.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * repeated User users = 1;
- * @return {!Array<!proto.ag.User>}
+ * repeated TestCaseStatistics tests = 1;
+ * @return {!Array<!proto.ag.TestCaseStatistics>}
  */
-proto.ag.Users.prototype.getUsersList = function() {
-  return /** @type{!Array<!proto.ag.User>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.User, 1));
+proto.ag.TestStatistics.prototype.getTestsList = function() {
+  return /** @type{!Array<!proto.ag.TestCaseStatistics>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.TestCaseStatistics, 1));
 };
 
 
 /**
- * @param {!Array<!proto.ag.User>} value
- * @return {!proto.ag.Users} returns this
+ * @param {!Array<!proto.ag.TestCaseStatistics>} value
+ * @return {!proto.ag.TestStatistics} returns this
 */
-proto.ag.Users.prototype.setUsersList = function(value) {
+proto.ag.TestStatistics.prototype.setTestsList = function(value) {
   return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.ag.User=} opt_value
+ * @param {!proto.ag.TestCaseStatistics=} opt_value
  * @param {number=} opt_index
- * @return {!proto.ag.User}
+ * @return {!proto.ag.TestCaseStatistics}
  */
-proto.ag.Users.prototype.addUsers = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.User, opt_index);
+proto.ag.TestStatistics.prototype.addTests = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.TestCaseStatistics, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.ag.Users} returns this
+ * @return {!proto.ag.TestStatistics} returns this
  */
-proto.ag.Users.prototype.clearUsersList = function() {
-  return this.setUsersList([]);
+proto.ag.TestStatistics.prototype.clearTestsList = function() {
+  return this.setTestsList([]);
 };
 
 
@@ -1699,8 +11210,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.RemoteIdentity.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.RemoteIdentity.toObject(opt_includeInstance, this);
+proto.ag.PushActivity.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.PushActivity.toObject(opt_includeInstance, this);
 };
 
 
@@ -1709,17 +11220,14 @@ proto.ag.RemoteIdentity.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.RemoteIdentity} msg The msg instance to transform.
+ * @param {!proto.ag.PushActivity} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.RemoteIdentity.toObject = function(includeInstance, msg) {
+proto.ag.PushActivity.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    provider: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    remoteid: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    accesstoken: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    userid: jspb.Message.getFieldWithDefault(msg, 5, 0)
+    daystodeadline: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    attempts: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -1733,48 +11241,36 @@ proto.ag.RemoteIdentity.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.RemoteIdentity}
+ * @return {!proto.ag.PushActivity}
  */
-proto.ag.RemoteIdentity.deserializeBinary = function(bytes) {
+proto.ag.PushActivity.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.RemoteIdentity;
-  return proto.ag.RemoteIdentity.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.PushActivity;
+  return proto.ag.PushActivity.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.RemoteIdentity} msg The message object to deserialize into.
+ * @param {!proto.ag.PushActivity} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.RemoteIdentity}
- */
-proto.ag.RemoteIdentity.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setId(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setProvider(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setRemoteid(value);
+ * @return {!proto.ag.PushActivity}
+ */
+proto.ag.PushActivity.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
       break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setAccesstoken(value);
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setDaystodeadline(value);
       break;
-    case 5:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setUserid(value);
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setAttempts(value);
       break;
     default:
       reader.skipField();
@@ -1789,9 +11285,9 @@ proto.ag.RemoteIdentity.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.RemoteIdentity.prototype.serializeBinary = function() {
+proto.ag.PushActivity.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.RemoteIdentity.serializeBinaryToWriter(this, writer);
+  proto.ag.PushActivity.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1799,44 +11295,23 @@ proto.ag.RemoteIdentity.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.RemoteIdentity} message
+ * @param {!proto.ag.PushActivity} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.RemoteIdentity.serializeBinaryToWriter = function(message, writer) {
+proto.ag.PushActivity.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getDaystodeadline();
   if (f !== 0) {
-    writer.writeUint64(
+    writer.writeInt32(
       1,
       f
     );
   }
-  f = message.getProvider();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getRemoteid();
-  if (f !== 0) {
-    writer.writeUint64(
-      3,
-      f
-    );
-  }
-  f = message.getAccesstoken();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getUserid();
+  f = message.getAttempts();
   if (f !== 0) {
-    writer.writeUint64(
-      5,
+    writer.writeUint32(
+      2,
       f
     );
   }
@@ -1844,92 +11319,38 @@ proto.ag.RemoteIdentity.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * optional uint64 ID = 1;
+ * optional int32 daysToDeadline = 1;
  * @return {number}
  */
-proto.ag.RemoteIdentity.prototype.getId = function() {
+proto.ag.PushActivity.prototype.getDaystodeadline = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.RemoteIdentity} returns this
+ * @return {!proto.ag.PushActivity} returns this
  */
-proto.ag.RemoteIdentity.prototype.setId = function(value) {
+proto.ag.PushActivity.prototype.setDaystodeadline = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional string provider = 2;
- * @return {string}
- */
-proto.ag.RemoteIdentity.prototype.getProvider = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.ag.RemoteIdentity} returns this
- */
-proto.ag.RemoteIdentity.prototype.setProvider = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
-/**
- * optional uint64 remoteID = 3;
- * @return {number}
- */
-proto.ag.RemoteIdentity.prototype.getRemoteid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.ag.RemoteIdentity} returns this
- */
-proto.ag.RemoteIdentity.prototype.setRemoteid = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
-};
-
-
-/**
- * optional string accessToken = 4;
- * @return {string}
- */
-proto.ag.RemoteIdentity.prototype.getAccesstoken = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.ag.RemoteIdentity} returns this
- */
-proto.ag.RemoteIdentity.prototype.setAccesstoken = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
-};
-
-
-/**
- * optional uint64 userID = 5;
+ * optional uint32 attempts = 2;
  * @return {number}
  */
-proto.ag.RemoteIdentity.prototype.getUserid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.ag.PushActivity.prototype.getAttempts = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.RemoteIdentity} returns this
+ * @return {!proto.ag.PushActivity} returns this
  */
-proto.ag.RemoteIdentity.prototype.setUserid = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
+proto.ag.PushActivity.prototype.setAttempts = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
@@ -1939,7 +11360,7 @@ proto.ag.RemoteIdentity.prototype.setUserid = function(value) {
  * @private {!Array<number>}
  * @const
  */
-proto.ag.Group.repeatedFields_ = [6,7];
+proto.ag.AssignmentStatistics.repeatedFields_ = [6,9];
 
 
 
@@ -1956,8 +11377,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Group.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Group.toObject(opt_includeInstance, this);
+proto.ag.AssignmentStatistics.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.AssignmentStatistics.toObject(opt_includeInstance, this);
 };
 
 
@@ -1966,21 +11387,22 @@ proto.ag.Group.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Group} msg The msg instance to transform.
+ * @param {!proto.ag.AssignmentStatistics} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Group.toObject = function(includeInstance, msg) {
+proto.ag.AssignmentStatistics.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 1, 0),
     name: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    courseid: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    teamid: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    status: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    usersList: jspb.Message.toObjectList(msg.getUsersList(),
-    proto.ag.User.toObject, includeInstance),
-    enrollmentsList: jspb.Message.toObjectList(msg.getEnrollmentsList(),
-    proto.ag.Enrollment.toObject, includeInstance)
+    submissions: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    approved: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    approvalrate: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    scoredistributionList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f,
+    mediantimetoapproval: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    usedslipdays: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    pushactivityList: jspb.Message.toObjectList(msg.getPushactivityList(),
+    proto.ag.PushActivity.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -1994,23 +11416,23 @@ proto.ag.Group.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Group}
+ * @return {!proto.ag.AssignmentStatistics}
  */
-proto.ag.Group.deserializeBinary = function(bytes) {
+proto.ag.AssignmentStatistics.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Group;
-  return proto.ag.Group.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.AssignmentStatistics;
+  return proto.ag.AssignmentStatistics.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Group} msg The message object to deserialize into.
+ * @param {!proto.ag.AssignmentStatistics} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Group}
+ * @return {!proto.ag.AssignmentStatistics}
  */
-proto.ag.Group.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.AssignmentStatistics.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2019,33 +11441,42 @@ proto.ag.Group.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setId(value);
+      msg.setAssignmentid(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
       msg.setName(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setSubmissions(value);
       break;
     case 4:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setTeamid(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setApproved(value);
       break;
     case 5:
-      var value = /** @type {!proto.ag.Group.GroupStatus} */ (reader.readEnum());
-      msg.setStatus(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setApprovalrate(value);
       break;
     case 6:
-      var value = new proto.ag.User;
-      reader.readMessage(value,proto.ag.User.deserializeBinaryFromReader);
-      msg.addUsers(value);
+      var values = /** @type {!Array<number>} */ (reader.isDelimited() ? reader.readPackedUint32() : [reader.readUint32()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addScoredistribution(values[i]);
+      }
       break;
     case 7:
-      var value = new proto.ag.Enrollment;
-      reader.readMessage(value,proto.ag.Enrollment.deserializeBinaryFromReader);
-      msg.addEnrollments(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setMediantimetoapproval(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setUsedslipdays(value);
+      break;
+    case 9:
+      var value = new proto.ag.PushActivity;
+      reader.readMessage(value,proto.ag.PushActivity.deserializeBinaryFromReader);
+      msg.addPushactivity(value);
       break;
     default:
       reader.skipField();
@@ -2060,9 +11491,9 @@ proto.ag.Group.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Group.prototype.serializeBinary = function() {
+proto.ag.AssignmentStatistics.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Group.serializeBinaryToWriter(this, writer);
+  proto.ag.AssignmentStatistics.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2070,13 +11501,13 @@ proto.ag.Group.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Group} message
+ * @param {!proto.ag.AssignmentStatistics} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Group.serializeBinaryToWriter = function(message, writer) {
+proto.ag.AssignmentStatistics.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getAssignmentid();
   if (f !== 0) {
     writer.writeUint64(
       1,
@@ -2090,68 +11521,73 @@ proto.ag.Group.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getCourseid();
+  f = message.getSubmissions();
   if (f !== 0) {
-    writer.writeUint64(
+    writer.writeUint32(
       3,
       f
     );
   }
-  f = message.getTeamid();
+  f = message.getApproved();
   if (f !== 0) {
-    writer.writeUint64(
+    writer.writeUint32(
       4,
       f
     );
   }
-  f = message.getStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getApprovalrate();
+  if (f !== 0) {
+    writer.writeUint32(
       5,
       f
     );
   }
-  f = message.getUsersList();
+  f = message.getScoredistributionList();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writePackedUint32(
       6,
-      f,
-      proto.ag.User.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getEnrollmentsList();
+  f = message.getMediantimetoapproval();
+  if (f !== 0) {
+    writer.writeInt64(
+      7,
+      f
+    );
+  }
+  f = message.getUsedslipdays();
+  if (f !== 0) {
+    writer.writeUint32(
+      8,
+      f
+    );
+  }
+  f = message.getPushactivityList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
-      7,
+      9,
       f,
-      proto.ag.Enrollment.serializeBinaryToWriter
+      proto.ag.PushActivity.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * @enum {number}
- */
-proto.ag.Group.GroupStatus = {
-  PENDING: 0,
-  APPROVED: 1
-};
-
-/**
- * optional uint64 ID = 1;
+ * optional uint64 assignmentID = 1;
  * @return {number}
  */
-proto.ag.Group.prototype.getId = function() {
+proto.ag.AssignmentStatistics.prototype.getAssignmentid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Group} returns this
+ * @return {!proto.ag.AssignmentStatistics} returns this
  */
-proto.ag.Group.prototype.setId = function(value) {
+proto.ag.AssignmentStatistics.prototype.setAssignmentid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
@@ -2160,147 +11596,182 @@ proto.ag.Group.prototype.setId = function(value) {
  * optional string name = 2;
  * @return {string}
  */
-proto.ag.Group.prototype.getName = function() {
+proto.ag.AssignmentStatistics.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ag.Group} returns this
+ * @return {!proto.ag.AssignmentStatistics} returns this
  */
-proto.ag.Group.prototype.setName = function(value) {
+proto.ag.AssignmentStatistics.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional uint64 courseID = 3;
+ * optional uint32 submissions = 3;
  * @return {number}
  */
-proto.ag.Group.prototype.getCourseid = function() {
+proto.ag.AssignmentStatistics.prototype.getSubmissions = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Group} returns this
+ * @return {!proto.ag.AssignmentStatistics} returns this
  */
-proto.ag.Group.prototype.setCourseid = function(value) {
+proto.ag.AssignmentStatistics.prototype.setSubmissions = function(value) {
   return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * optional uint64 teamID = 4;
+ * optional uint32 approved = 4;
  * @return {number}
  */
-proto.ag.Group.prototype.getTeamid = function() {
+proto.ag.AssignmentStatistics.prototype.getApproved = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Group} returns this
+ * @return {!proto.ag.AssignmentStatistics} returns this
  */
-proto.ag.Group.prototype.setTeamid = function(value) {
+proto.ag.AssignmentStatistics.prototype.setApproved = function(value) {
   return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * optional GroupStatus status = 5;
- * @return {!proto.ag.Group.GroupStatus}
+ * optional uint32 approvalRate = 5;
+ * @return {number}
  */
-proto.ag.Group.prototype.getStatus = function() {
-  return /** @type {!proto.ag.Group.GroupStatus} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.ag.AssignmentStatistics.prototype.getApprovalrate = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * @param {!proto.ag.Group.GroupStatus} value
- * @return {!proto.ag.Group} returns this
+ * @param {number} value
+ * @return {!proto.ag.AssignmentStatistics} returns this
  */
-proto.ag.Group.prototype.setStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 5, value);
+proto.ag.AssignmentStatistics.prototype.setApprovalrate = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
 /**
- * repeated User users = 6;
- * @return {!Array<!proto.ag.User>}
+ * repeated uint32 scoreDistribution = 6;
+ * @return {!Array<number>}
+ */
+proto.ag.AssignmentStatistics.prototype.getScoredistributionList = function() {
+  return /** @type {!Array<number>} */ (jspb.Message.getRepeatedField(this, 6));
+};
+
+
+/**
+ * @param {!Array<number>} value
+ * @return {!proto.ag.AssignmentStatistics} returns this
+ */
+proto.ag.AssignmentStatistics.prototype.setScoredistributionList = function(value) {
+  return jspb.Message.setField(this, 6, value || []);
+};
+
+
+/**
+ * @param {number} value
+ * @param {number=} opt_index
+ * @return {!proto.ag.AssignmentStatistics} returns this
+ */
+proto.ag.AssignmentStatistics.prototype.addScoredistribution = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 6, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.AssignmentStatistics} returns this
+ */
+proto.ag.AssignmentStatistics.prototype.clearScoredistributionList = function() {
+  return this.setScoredistributionList([]);
+};
+
+
+/**
+ * optional int64 medianTimeToApproval = 7;
+ * @return {number}
  */
-proto.ag.Group.prototype.getUsersList = function() {
-  return /** @type{!Array<!proto.ag.User>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.User, 6));
+proto.ag.AssignmentStatistics.prototype.getMediantimetoapproval = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
 };
 
 
 /**
- * @param {!Array<!proto.ag.User>} value
- * @return {!proto.ag.Group} returns this
-*/
-proto.ag.Group.prototype.setUsersList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 6, value);
+ * @param {number} value
+ * @return {!proto.ag.AssignmentStatistics} returns this
+ */
+proto.ag.AssignmentStatistics.prototype.setMediantimetoapproval = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
 };
 
 
 /**
- * @param {!proto.ag.User=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ag.User}
+ * optional uint32 usedSlipDays = 8;
+ * @return {number}
  */
-proto.ag.Group.prototype.addUsers = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 6, opt_value, proto.ag.User, opt_index);
+proto.ag.AssignmentStatistics.prototype.getUsedslipdays = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.Group} returns this
+ * @param {number} value
+ * @return {!proto.ag.AssignmentStatistics} returns this
  */
-proto.ag.Group.prototype.clearUsersList = function() {
-  return this.setUsersList([]);
+proto.ag.AssignmentStatistics.prototype.setUsedslipdays = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
 };
 
 
 /**
- * repeated Enrollment enrollments = 7;
- * @return {!Array<!proto.ag.Enrollment>}
+ * repeated PushActivity pushActivity = 9;
+ * @return {!Array<!proto.ag.PushActivity>}
  */
-proto.ag.Group.prototype.getEnrollmentsList = function() {
-  return /** @type{!Array<!proto.ag.Enrollment>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.Enrollment, 7));
+proto.ag.AssignmentStatistics.prototype.getPushactivityList = function() {
+  return /** @type{!Array<!proto.ag.PushActivity>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.PushActivity, 9));
 };
 
 
 /**
- * @param {!Array<!proto.ag.Enrollment>} value
- * @return {!proto.ag.Group} returns this
+ * @param {!Array<!proto.ag.PushActivity>} value
+ * @return {!proto.ag.AssignmentStatistics} returns this
 */
-proto.ag.Group.prototype.setEnrollmentsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 7, value);
+proto.ag.AssignmentStatistics.prototype.setPushactivityList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 9, value);
 };
 
 
 /**
- * @param {!proto.ag.Enrollment=} opt_value
+ * @param {!proto.ag.PushActivity=} opt_value
  * @param {number=} opt_index
- * @return {!proto.ag.Enrollment}
+ * @return {!proto.ag.PushActivity}
  */
-proto.ag.Group.prototype.addEnrollments = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 7, opt_value, proto.ag.Enrollment, opt_index);
+proto.ag.AssignmentStatistics.prototype.addPushactivity = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 9, opt_value, proto.ag.PushActivity, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.ag.Group} returns this
+ * @return {!proto.ag.AssignmentStatistics} returns this
  */
-proto.ag.Group.prototype.clearEnrollmentsList = function() {
-  return this.setEnrollmentsList([]);
+proto.ag.AssignmentStatistics.prototype.clearPushactivityList = function() {
+  return this.setPushactivityList([]);
 };
 
 
@@ -2310,7 +11781,7 @@ proto.ag.Group.prototype.clearEnrollmentsList = function() {
  * @private {!Array<number>}
  * @const
  */
-proto.ag.Groups.repeatedFields_ = [1];
+proto.ag.CourseStatistics.repeatedFields_ = [3];
 
 
 
@@ -2327,8 +11798,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Groups.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Groups.toObject(opt_includeInstance, this);
+proto.ag.CourseStatistics.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.CourseStatistics.toObject(opt_includeInstance, this);
 };
 
 
@@ -2337,14 +11808,16 @@ proto.ag.Groups.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Groups} msg The msg instance to transform.
+ * @param {!proto.ag.CourseStatistics} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Groups.toObject = function(includeInstance, msg) {
+proto.ag.CourseStatistics.toObject = function(includeInstance, msg) {
   var f, obj = {
-    groupsList: jspb.Message.toObjectList(msg.getGroupsList(),
-    proto.ag.Group.toObject, includeInstance)
+    students: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    activestudents: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    assignmentsList: jspb.Message.toObjectList(msg.getAssignmentsList(),
+    proto.ag.AssignmentStatistics.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -2358,23 +11831,23 @@ proto.ag.Groups.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Groups}
+ * @return {!proto.ag.CourseStatistics}
  */
-proto.ag.Groups.deserializeBinary = function(bytes) {
+proto.ag.CourseStatistics.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Groups;
-  return proto.ag.Groups.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.CourseStatistics;
+  return proto.ag.CourseStatistics.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Groups} msg The message object to deserialize into.
+ * @param {!proto.ag.CourseStatistics} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Groups}
+ * @return {!proto.ag.CourseStatistics}
  */
-proto.ag.Groups.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.CourseStatistics.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2382,9 +11855,17 @@ proto.ag.Groups.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.ag.Group;
-      reader.readMessage(value,proto.ag.Group.deserializeBinaryFromReader);
-      msg.addGroups(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setStudents(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setActivestudents(value);
+      break;
+    case 3:
+      var value = new proto.ag.AssignmentStatistics;
+      reader.readMessage(value,proto.ag.AssignmentStatistics.deserializeBinaryFromReader);
+      msg.addAssignments(value);
       break;
     default:
       reader.skipField();
@@ -2399,9 +11880,9 @@ proto.ag.Groups.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Groups.prototype.serializeBinary = function() {
+proto.ag.CourseStatistics.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Groups.serializeBinaryToWriter(this, writer);
+  proto.ag.CourseStatistics.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2409,68 +11890,111 @@ proto.ag.Groups.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Groups} message
+ * @param {!proto.ag.CourseStatistics} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Groups.serializeBinaryToWriter = function(message, writer) {
+proto.ag.CourseStatistics.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getGroupsList();
+  f = message.getStudents();
+  if (f !== 0) {
+    writer.writeUint32(
+      1,
+      f
+    );
+  }
+  f = message.getActivestudents();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+  f = message.getAssignmentsList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
-      1,
+      3,
       f,
-      proto.ag.Group.serializeBinaryToWriter
+      proto.ag.AssignmentStatistics.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * repeated Group groups = 1;
- * @return {!Array<!proto.ag.Group>}
+ * optional uint32 students = 1;
+ * @return {number}
  */
-proto.ag.Groups.prototype.getGroupsList = function() {
-  return /** @type{!Array<!proto.ag.Group>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.Group, 1));
+proto.ag.CourseStatistics.prototype.getStudents = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {!Array<!proto.ag.Group>} value
- * @return {!proto.ag.Groups} returns this
-*/
-proto.ag.Groups.prototype.setGroupsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {number} value
+ * @return {!proto.ag.CourseStatistics} returns this
+ */
+proto.ag.CourseStatistics.prototype.setStudents = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.ag.Group=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ag.Group}
+ * optional uint32 activeStudents = 2;
+ * @return {number}
  */
-proto.ag.Groups.prototype.addGroups = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.Group, opt_index);
+proto.ag.CourseStatistics.prototype.getActivestudents = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.Groups} returns this
+ * @param {number} value
+ * @return {!proto.ag.CourseStatistics} returns this
  */
-proto.ag.Groups.prototype.clearGroupsList = function() {
-  return this.setGroupsList([]);
+proto.ag.CourseStatistics.prototype.setActivestudents = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
+/**
+ * repeated AssignmentStatistics assignments = 3;
+ * @return {!Array<!proto.ag.AssignmentStatistics>}
+ */
+proto.ag.CourseStatistics.prototype.getAssignmentsList = function() {
+  return /** @type{!Array<!proto.ag.AssignmentStatistics>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.AssignmentStatistics, 3));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {!Array<!proto.ag.AssignmentStatistics>} value
+ * @return {!proto.ag.CourseStatistics} returns this
+*/
+proto.ag.CourseStatistics.prototype.setAssignmentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.ag.AssignmentStatistics=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.AssignmentStatistics}
  */
-proto.ag.Course.repeatedFields_ = [12,13,14];
+proto.ag.CourseStatistics.prototype.addAssignments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.ag.AssignmentStatistics, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.CourseStatistics} returns this
+ */
+proto.ag.CourseStatistics.prototype.clearAssignmentsList = function() {
+  return this.setAssignmentsList([]);
+};
+
+
 
 
 
@@ -2487,8 +12011,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Course.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Course.toObject(opt_includeInstance, this);
+proto.ag.BuildJob.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.BuildJob.toObject(opt_includeInstance, this);
 };
 
 
@@ -2497,29 +12021,25 @@ proto.ag.Course.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Course} msg The msg instance to transform.
+ * @param {!proto.ag.BuildJob} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Course.toObject = function(includeInstance, msg) {
+proto.ag.BuildJob.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    coursecreatorid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    name: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    code: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    year: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    tag: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    provider: jspb.Message.getFieldWithDefault(msg, 7, ""),
-    organizationid: jspb.Message.getFieldWithDefault(msg, 8, 0),
-    organizationpath: jspb.Message.getFieldWithDefault(msg, 9, ""),
-    slipdays: jspb.Message.getFieldWithDefault(msg, 10, 0),
-    enrolled: jspb.Message.getFieldWithDefault(msg, 11, 0),
-    enrollmentsList: jspb.Message.toObjectList(msg.getEnrollmentsList(),
-    proto.ag.Enrollment.toObject, includeInstance),
-    assignmentsList: jspb.Message.toObjectList(msg.getAssignmentsList(),
-    proto.ag.Assignment.toObject, includeInstance),
-    groupsList: jspb.Message.toObjectList(msg.getGroupsList(),
-    proto.ag.Group.toObject, includeInstance)
+    courseid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    repositoryid: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    commitid: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    jobowner: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    status: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    queueddate: jspb.Message.getFieldWithDefault(msg, 8, ""),
+    starteddate: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    finisheddate: jspb.Message.getFieldWithDefault(msg, 10, ""),
+    errormessage: jspb.Message.getFieldWithDefault(msg, 11, ""),
+    deadline: jspb.Message.getBooleanFieldWithDefault(msg, 12, false),
+    delayeduntil: jspb.Message.getFieldWithDefault(msg, 13, "")
   };
 
   if (includeInstance) {
@@ -2533,23 +12053,23 @@ proto.ag.Course.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Course}
+ * @return {!proto.ag.BuildJob}
  */
-proto.ag.Course.deserializeBinary = function(bytes) {
+proto.ag.BuildJob.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Course;
-  return proto.ag.Course.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.BuildJob;
+  return proto.ag.BuildJob.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Course} msg The message object to deserialize into.
+ * @param {!proto.ag.BuildJob} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Course}
+ * @return {!proto.ag.BuildJob}
  */
-proto.ag.Course.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.BuildJob.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2562,58 +12082,51 @@ proto.ag.Course.deserializeBinaryFromReader = function(msg, reader) {
       break;
     case 2:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setCoursecreatorid(value);
+      msg.setCourseid(value);
       break;
     case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
       break;
     case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setCode(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setRepositoryid(value);
       break;
     case 5:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setYear(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCommitid(value);
       break;
     case 6:
       var value = /** @type {string} */ (reader.readString());
-      msg.setTag(value);
+      msg.setJobowner(value);
       break;
     case 7:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setProvider(value);
+      var value = /** @type {!proto.ag.BuildJob.Status} */ (reader.readEnum());
+      msg.setStatus(value);
       break;
     case 8:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setOrganizationid(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setQueueddate(value);
       break;
     case 9:
       var value = /** @type {string} */ (reader.readString());
-      msg.setOrganizationpath(value);
+      msg.setStarteddate(value);
       break;
     case 10:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setSlipdays(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFinisheddate(value);
       break;
     case 11:
-      var value = /** @type {!proto.ag.Enrollment.UserStatus} */ (reader.readEnum());
-      msg.setEnrolled(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setErrormessage(value);
       break;
     case 12:
-      var value = new proto.ag.Enrollment;
-      reader.readMessage(value,proto.ag.Enrollment.deserializeBinaryFromReader);
-      msg.addEnrollments(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDeadline(value);
       break;
     case 13:
-      var value = new proto.ag.Assignment;
-      reader.readMessage(value,proto.ag.Assignment.deserializeBinaryFromReader);
-      msg.addAssignments(value);
-      break;
-    case 14:
-      var value = new proto.ag.Group;
-      reader.readMessage(value,proto.ag.Group.deserializeBinaryFromReader);
-      msg.addGroups(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDelayeduntil(value);
       break;
     default:
       reader.skipField();
@@ -2628,9 +12141,9 @@ proto.ag.Course.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Course.prototype.serializeBinary = function() {
+proto.ag.BuildJob.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Course.serializeBinaryToWriter(this, writer);
+  proto.ag.BuildJob.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2638,11 +12151,11 @@ proto.ag.Course.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Course} message
+ * @param {!proto.ag.BuildJob} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Course.serializeBinaryToWriter = function(message, writer) {
+proto.ag.BuildJob.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f !== 0) {
@@ -2651,412 +12164,335 @@ proto.ag.Course.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getCoursecreatorid();
+  f = message.getCourseid();
   if (f !== 0) {
     writer.writeUint64(
       2,
       f
     );
   }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
       3,
       f
     );
   }
-  f = message.getCode();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getRepositoryid();
+  if (f !== 0) {
+    writer.writeUint64(
       4,
       f
     );
   }
-  f = message.getYear();
-  if (f !== 0) {
-    writer.writeUint32(
+  f = message.getCommitid();
+  if (f.length > 0) {
+    writer.writeString(
       5,
       f
     );
   }
-  f = message.getTag();
+  f = message.getJobowner();
   if (f.length > 0) {
     writer.writeString(
       6,
       f
     );
   }
-  f = message.getProvider();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
       7,
       f
     );
   }
-  f = message.getOrganizationid();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getQueueddate();
+  if (f.length > 0) {
+    writer.writeString(
       8,
       f
     );
   }
-  f = message.getOrganizationpath();
+  f = message.getStarteddate();
   if (f.length > 0) {
     writer.writeString(
       9,
       f
     );
   }
-  f = message.getSlipdays();
-  if (f !== 0) {
-    writer.writeUint32(
+  f = message.getFinisheddate();
+  if (f.length > 0) {
+    writer.writeString(
       10,
       f
     );
   }
-  f = message.getEnrolled();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getErrormessage();
+  if (f.length > 0) {
+    writer.writeString(
       11,
       f
     );
   }
-  f = message.getEnrollmentsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = message.getDeadline();
+  if (f) {
+    writer.writeBool(
       12,
-      f,
-      proto.ag.Enrollment.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getAssignmentsList();
+  f = message.getDelayeduntil();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       13,
-      f,
-      proto.ag.Assignment.serializeBinaryToWriter
-    );
-  }
-  f = message.getGroupsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      14,
-      f,
-      proto.ag.Group.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
+/**
+ * @enum {number}
+ */
+proto.ag.BuildJob.Status = {
+  QUEUED: 0,
+  RUNNING: 1,
+  DONE: 2,
+  FAILED: 3,
+  CANCELED: 4
+};
+
 /**
  * optional uint64 ID = 1;
  * @return {number}
  */
-proto.ag.Course.prototype.getId = function() {
+proto.ag.BuildJob.prototype.getId = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Course} returns this
+ * @return {!proto.ag.BuildJob} returns this
  */
-proto.ag.Course.prototype.setId = function(value) {
+proto.ag.BuildJob.prototype.setId = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 courseCreatorID = 2;
+ * optional uint64 courseID = 2;
  * @return {number}
  */
-proto.ag.Course.prototype.getCoursecreatorid = function() {
+proto.ag.BuildJob.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Course} returns this
+ * @return {!proto.ag.BuildJob} returns this
  */
-proto.ag.Course.prototype.setCoursecreatorid = function(value) {
+proto.ag.BuildJob.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional string name = 3;
- * @return {string}
- */
-proto.ag.Course.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.ag.Course} returns this
- */
-proto.ag.Course.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
-};
-
-
-/**
- * optional string code = 4;
- * @return {string}
+ * optional uint64 assignmentID = 3;
+ * @return {number}
  */
-proto.ag.Course.prototype.getCode = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.ag.BuildJob.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.Course} returns this
+ * @param {number} value
+ * @return {!proto.ag.BuildJob} returns this
  */
-proto.ag.Course.prototype.setCode = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.ag.BuildJob.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * optional uint32 year = 5;
+ * optional uint64 repositoryID = 4;
  * @return {number}
  */
-proto.ag.Course.prototype.getYear = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.ag.BuildJob.prototype.getRepositoryid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Course} returns this
+ * @return {!proto.ag.BuildJob} returns this
  */
-proto.ag.Course.prototype.setYear = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
+proto.ag.BuildJob.prototype.setRepositoryid = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * optional string tag = 6;
+ * optional string commitID = 5;
  * @return {string}
  */
-proto.ag.Course.prototype.getTag = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.ag.BuildJob.prototype.getCommitid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ag.Course} returns this
+ * @return {!proto.ag.BuildJob} returns this
  */
-proto.ag.Course.prototype.setTag = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+proto.ag.BuildJob.prototype.setCommitid = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
 /**
- * optional string provider = 7;
+ * optional string jobOwner = 6;
  * @return {string}
  */
-proto.ag.Course.prototype.getProvider = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+proto.ag.BuildJob.prototype.getJobowner = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ag.Course} returns this
+ * @return {!proto.ag.BuildJob} returns this
  */
-proto.ag.Course.prototype.setProvider = function(value) {
-  return jspb.Message.setProto3StringField(this, 7, value);
+proto.ag.BuildJob.prototype.setJobowner = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
 };
 
 
 /**
- * optional uint64 organizationID = 8;
- * @return {number}
+ * optional Status status = 7;
+ * @return {!proto.ag.BuildJob.Status}
  */
-proto.ag.Course.prototype.getOrganizationid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+proto.ag.BuildJob.prototype.getStatus = function() {
+  return /** @type {!proto.ag.BuildJob.Status} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.Course} returns this
+ * @param {!proto.ag.BuildJob.Status} value
+ * @return {!proto.ag.BuildJob} returns this
  */
-proto.ag.Course.prototype.setOrganizationid = function(value) {
-  return jspb.Message.setProto3IntField(this, 8, value);
+proto.ag.BuildJob.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 7, value);
 };
 
 
 /**
- * optional string organizationPath = 9;
+ * optional string queuedDate = 8;
  * @return {string}
  */
-proto.ag.Course.prototype.getOrganizationpath = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+proto.ag.BuildJob.prototype.getQueueddate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ag.Course} returns this
- */
-proto.ag.Course.prototype.setOrganizationpath = function(value) {
-  return jspb.Message.setProto3StringField(this, 9, value);
-};
-
-
-/**
- * optional uint32 slipDays = 10;
- * @return {number}
- */
-proto.ag.Course.prototype.getSlipdays = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.ag.Course} returns this
- */
-proto.ag.Course.prototype.setSlipdays = function(value) {
-  return jspb.Message.setProto3IntField(this, 10, value);
-};
-
-
-/**
- * optional Enrollment.UserStatus enrolled = 11;
- * @return {!proto.ag.Enrollment.UserStatus}
+ * @return {!proto.ag.BuildJob} returns this
  */
-proto.ag.Course.prototype.getEnrolled = function() {
-  return /** @type {!proto.ag.Enrollment.UserStatus} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+proto.ag.BuildJob.prototype.setQueueddate = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
 };
 
 
 /**
- * @param {!proto.ag.Enrollment.UserStatus} value
- * @return {!proto.ag.Course} returns this
+ * optional string startedDate = 9;
+ * @return {string}
  */
-proto.ag.Course.prototype.setEnrolled = function(value) {
-  return jspb.Message.setProto3EnumField(this, 11, value);
+proto.ag.BuildJob.prototype.getStarteddate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
 };
 
 
 /**
- * repeated Enrollment enrollments = 12;
- * @return {!Array<!proto.ag.Enrollment>}
+ * @param {string} value
+ * @return {!proto.ag.BuildJob} returns this
  */
-proto.ag.Course.prototype.getEnrollmentsList = function() {
-  return /** @type{!Array<!proto.ag.Enrollment>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.Enrollment, 12));
-};
-
-
-/**
- * @param {!Array<!proto.ag.Enrollment>} value
- * @return {!proto.ag.Course} returns this
-*/
-proto.ag.Course.prototype.setEnrollmentsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 12, value);
+proto.ag.BuildJob.prototype.setStarteddate = function(value) {
+  return jspb.Message.setProto3StringField(this, 9, value);
 };
 
 
 /**
- * @param {!proto.ag.Enrollment=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ag.Enrollment}
+ * optional string finishedDate = 10;
+ * @return {string}
  */
-proto.ag.Course.prototype.addEnrollments = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 12, opt_value, proto.ag.Enrollment, opt_index);
+proto.ag.BuildJob.prototype.getFinisheddate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.Course} returns this
+ * @param {string} value
+ * @return {!proto.ag.BuildJob} returns this
  */
-proto.ag.Course.prototype.clearEnrollmentsList = function() {
-  return this.setEnrollmentsList([]);
+proto.ag.BuildJob.prototype.setFinisheddate = function(value) {
+  return jspb.Message.setProto3StringField(this, 10, value);
 };
 
 
 /**
- * repeated Assignment assignments = 13;
- * @return {!Array<!proto.ag.Assignment>}
+ * optional string errorMessage = 11;
+ * @return {string}
  */
-proto.ag.Course.prototype.getAssignmentsList = function() {
-  return /** @type{!Array<!proto.ag.Assignment>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.Assignment, 13));
-};
-
-
-/**
- * @param {!Array<!proto.ag.Assignment>} value
- * @return {!proto.ag.Course} returns this
-*/
-proto.ag.Course.prototype.setAssignmentsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 13, value);
+proto.ag.BuildJob.prototype.getErrormessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
 };
 
 
 /**
- * @param {!proto.ag.Assignment=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ag.Assignment}
+ * @param {string} value
+ * @return {!proto.ag.BuildJob} returns this
  */
-proto.ag.Course.prototype.addAssignments = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 13, opt_value, proto.ag.Assignment, opt_index);
+proto.ag.BuildJob.prototype.setErrormessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 11, value);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.Course} returns this
+ * optional bool deadline = 12;
+ * @return {boolean}
  */
-proto.ag.Course.prototype.clearAssignmentsList = function() {
-  return this.setAssignmentsList([]);
+proto.ag.BuildJob.prototype.getDeadline = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 12, false));
 };
 
 
 /**
- * repeated Group groups = 14;
- * @return {!Array<!proto.ag.Group>}
+ * @param {boolean} value
+ * @return {!proto.ag.BuildJob} returns this
  */
-proto.ag.Course.prototype.getGroupsList = function() {
-  return /** @type{!Array<!proto.ag.Group>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.Group, 14));
-};
-
-
-/**
- * @param {!Array<!proto.ag.Group>} value
- * @return {!proto.ag.Course} returns this
-*/
-proto.ag.Course.prototype.setGroupsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 14, value);
+proto.ag.BuildJob.prototype.setDeadline = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 12, value);
 };
 
 
 /**
- * @param {!proto.ag.Group=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ag.Group}
+ * optional string delayedUntil = 13;
+ * @return {string}
  */
-proto.ag.Course.prototype.addGroups = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 14, opt_value, proto.ag.Group, opt_index);
+proto.ag.BuildJob.prototype.getDelayeduntil = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 13, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.Course} returns this
+ * @param {string} value
+ * @return {!proto.ag.BuildJob} returns this
  */
-proto.ag.Course.prototype.clearGroupsList = function() {
-  return this.setGroupsList([]);
+proto.ag.BuildJob.prototype.setDelayeduntil = function(value) {
+  return jspb.Message.setProto3StringField(this, 13, value);
 };
 
 
@@ -3066,7 +12502,7 @@ proto.ag.Course.prototype.clearGroupsList = function() {
  * @private {!Array<number>}
  * @const
  */
-proto.ag.Courses.repeatedFields_ = [1];
+proto.ag.BuildJobs.repeatedFields_ = [1];
 
 
 
@@ -3083,8 +12519,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Courses.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Courses.toObject(opt_includeInstance, this);
+proto.ag.BuildJobs.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.BuildJobs.toObject(opt_includeInstance, this);
 };
 
 
@@ -3093,14 +12529,14 @@ proto.ag.Courses.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Courses} msg The msg instance to transform.
+ * @param {!proto.ag.BuildJobs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Courses.toObject = function(includeInstance, msg) {
+proto.ag.BuildJobs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    coursesList: jspb.Message.toObjectList(msg.getCoursesList(),
-    proto.ag.Course.toObject, includeInstance)
+    jobsList: jspb.Message.toObjectList(msg.getJobsList(),
+    proto.ag.BuildJob.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -3114,23 +12550,23 @@ proto.ag.Courses.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Courses}
+ * @return {!proto.ag.BuildJobs}
  */
-proto.ag.Courses.deserializeBinary = function(bytes) {
+proto.ag.BuildJobs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Courses;
-  return proto.ag.Courses.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.BuildJobs;
+  return proto.ag.BuildJobs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Courses} msg The message object to deserialize into.
+ * @param {!proto.ag.BuildJobs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Courses}
+ * @return {!proto.ag.BuildJobs}
  */
-proto.ag.Courses.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.BuildJobs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3138,9 +12574,9 @@ proto.ag.Courses.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.ag.Course;
-      reader.readMessage(value,proto.ag.Course.deserializeBinaryFromReader);
-      msg.addCourses(value);
+      var value = new proto.ag.BuildJob;
+      reader.readMessage(value,proto.ag.BuildJob.deserializeBinaryFromReader);
+      msg.addJobs(value);
       break;
     default:
       reader.skipField();
@@ -3155,9 +12591,9 @@ proto.ag.Courses.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Courses.prototype.serializeBinary = function() {
+proto.ag.BuildJobs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Courses.serializeBinaryToWriter(this, writer);
+  proto.ag.BuildJobs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3165,58 +12601,58 @@ proto.ag.Courses.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Courses} message
+ * @param {!proto.ag.BuildJobs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Courses.serializeBinaryToWriter = function(message, writer) {
+proto.ag.BuildJobs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCoursesList();
+  f = message.getJobsList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
       1,
       f,
-      proto.ag.Course.serializeBinaryToWriter
+      proto.ag.BuildJob.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * repeated Course courses = 1;
- * @return {!Array<!proto.ag.Course>}
+ * repeated BuildJob jobs = 1;
+ * @return {!Array<!proto.ag.BuildJob>}
  */
-proto.ag.Courses.prototype.getCoursesList = function() {
-  return /** @type{!Array<!proto.ag.Course>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.Course, 1));
+proto.ag.BuildJobs.prototype.getJobsList = function() {
+  return /** @type{!Array<!proto.ag.BuildJob>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.BuildJob, 1));
 };
 
 
 /**
- * @param {!Array<!proto.ag.Course>} value
- * @return {!proto.ag.Courses} returns this
+ * @param {!Array<!proto.ag.BuildJob>} value
+ * @return {!proto.ag.BuildJobs} returns this
 */
-proto.ag.Courses.prototype.setCoursesList = function(value) {
+proto.ag.BuildJobs.prototype.setJobsList = function(value) {
   return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.ag.Course=} opt_value
+ * @param {!proto.ag.BuildJob=} opt_value
  * @param {number=} opt_index
- * @return {!proto.ag.Course}
+ * @return {!proto.ag.BuildJob}
  */
-proto.ag.Courses.prototype.addCourses = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.Course, opt_index);
+proto.ag.BuildJobs.prototype.addJobs = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.BuildJob, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.ag.Courses} returns this
+ * @return {!proto.ag.BuildJobs} returns this
  */
-proto.ag.Courses.prototype.clearCoursesList = function() {
-  return this.setCoursesList([]);
+proto.ag.BuildJobs.prototype.clearJobsList = function() {
+  return this.setJobsList([]);
 };
 
 
@@ -3236,8 +12672,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Repository.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Repository.toObject(opt_includeInstance, this);
+proto.ag.BuildQuota.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.BuildQuota.toObject(opt_includeInstance, this);
 };
 
 
@@ -3246,19 +12682,19 @@ proto.ag.Repository.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Repository} msg The msg instance to transform.
+ * @param {!proto.ag.BuildQuota} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Repository.toObject = function(includeInstance, msg) {
+proto.ag.BuildQuota.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    organizationid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
     repositoryid: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    userid: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    groupid: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    htmlurl: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    repotype: jspb.Message.getFieldWithDefault(msg, 7, 0)
+    resetdate: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    builds: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    remaining: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    nextbuild: jspb.Message.getFieldWithDefault(msg, 7, "")
   };
 
   if (includeInstance) {
@@ -3272,23 +12708,23 @@ proto.ag.Repository.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Repository}
+ * @return {!proto.ag.BuildQuota}
  */
-proto.ag.Repository.deserializeBinary = function(bytes) {
+proto.ag.BuildQuota.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Repository;
-  return proto.ag.Repository.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.BuildQuota;
+  return proto.ag.BuildQuota.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Repository} msg The message object to deserialize into.
+ * @param {!proto.ag.BuildQuota} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Repository}
+ * @return {!proto.ag.BuildQuota}
  */
-proto.ag.Repository.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.BuildQuota.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3301,27 +12737,27 @@ proto.ag.Repository.deserializeBinaryFromReader = function(msg, reader) {
       break;
     case 2:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setOrganizationid(value);
+      msg.setAssignmentid(value);
       break;
     case 3:
       var value = /** @type {number} */ (reader.readUint64());
       msg.setRepositoryid(value);
       break;
     case 4:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setUserid(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setResetdate(value);
       break;
     case 5:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setGroupid(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setBuilds(value);
       break;
     case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setHtmlurl(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRemaining(value);
       break;
     case 7:
-      var value = /** @type {!proto.ag.Repository.Type} */ (reader.readEnum());
-      msg.setRepotype(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNextbuild(value);
       break;
     default:
       reader.skipField();
@@ -3336,9 +12772,9 @@ proto.ag.Repository.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Repository.prototype.serializeBinary = function() {
+proto.ag.BuildQuota.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Repository.serializeBinaryToWriter(this, writer);
+  proto.ag.BuildQuota.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3346,11 +12782,11 @@ proto.ag.Repository.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Repository} message
+ * @param {!proto.ag.BuildQuota} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Repository.serializeBinaryToWriter = function(message, writer) {
+proto.ag.BuildQuota.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f !== 0) {
@@ -3359,7 +12795,7 @@ proto.ag.Repository.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getOrganizationid();
+  f = message.getAssignmentid();
   if (f !== 0) {
     writer.writeUint64(
       2,
@@ -3373,30 +12809,30 @@ proto.ag.Repository.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getUserid();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getResetdate();
+  if (f.length > 0) {
+    writer.writeString(
       4,
       f
     );
   }
-  f = message.getGroupid();
+  f = message.getBuilds();
   if (f !== 0) {
-    writer.writeUint64(
+    writer.writeUint32(
       5,
       f
     );
   }
-  f = message.getHtmlurl();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getRemaining();
+  if (f !== 0) {
+    writer.writeUint32(
       6,
       f
     );
   }
-  f = message.getRepotype();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getNextbuild();
+  if (f.length > 0) {
+    writer.writeString(
       7,
       f
     );
@@ -3404,50 +12840,38 @@ proto.ag.Repository.serializeBinaryToWriter = function(message, writer) {
 };
 
 
-/**
- * @enum {number}
- */
-proto.ag.Repository.Type = {
-  NONE: 0,
-  COURSEINFO: 1,
-  ASSIGNMENTS: 2,
-  TESTS: 3,
-  USER: 4,
-  GROUP: 5
-};
-
 /**
  * optional uint64 ID = 1;
  * @return {number}
  */
-proto.ag.Repository.prototype.getId = function() {
+proto.ag.BuildQuota.prototype.getId = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Repository} returns this
+ * @return {!proto.ag.BuildQuota} returns this
  */
-proto.ag.Repository.prototype.setId = function(value) {
+proto.ag.BuildQuota.prototype.setId = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 organizationID = 2;
+ * optional uint64 assignmentID = 2;
  * @return {number}
  */
-proto.ag.Repository.prototype.getOrganizationid = function() {
+proto.ag.BuildQuota.prototype.getAssignmentid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Repository} returns this
+ * @return {!proto.ag.BuildQuota} returns this
  */
-proto.ag.Repository.prototype.setOrganizationid = function(value) {
+proto.ag.BuildQuota.prototype.setAssignmentid = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
@@ -3456,89 +12880,89 @@ proto.ag.Repository.prototype.setOrganizationid = function(value) {
  * optional uint64 repositoryID = 3;
  * @return {number}
  */
-proto.ag.Repository.prototype.getRepositoryid = function() {
+proto.ag.BuildQuota.prototype.getRepositoryid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Repository} returns this
+ * @return {!proto.ag.BuildQuota} returns this
  */
-proto.ag.Repository.prototype.setRepositoryid = function(value) {
+proto.ag.BuildQuota.prototype.setRepositoryid = function(value) {
   return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * optional uint64 userID = 4;
- * @return {number}
+ * optional string resetDate = 4;
+ * @return {string}
  */
-proto.ag.Repository.prototype.getUserid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.ag.BuildQuota.prototype.getResetdate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.Repository} returns this
+ * @param {string} value
+ * @return {!proto.ag.BuildQuota} returns this
  */
-proto.ag.Repository.prototype.setUserid = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
+proto.ag.BuildQuota.prototype.setResetdate = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * optional uint64 groupID = 5;
+ * optional uint32 builds = 5;
  * @return {number}
  */
-proto.ag.Repository.prototype.getGroupid = function() {
+proto.ag.BuildQuota.prototype.getBuilds = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Repository} returns this
+ * @return {!proto.ag.BuildQuota} returns this
  */
-proto.ag.Repository.prototype.setGroupid = function(value) {
+proto.ag.BuildQuota.prototype.setBuilds = function(value) {
   return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
 /**
- * optional string HTMLURL = 6;
- * @return {string}
+ * optional uint32 remaining = 6;
+ * @return {number}
  */
-proto.ag.Repository.prototype.getHtmlurl = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.ag.BuildQuota.prototype.getRemaining = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.Repository} returns this
+ * @param {number} value
+ * @return {!proto.ag.BuildQuota} returns this
  */
-proto.ag.Repository.prototype.setHtmlurl = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+proto.ag.BuildQuota.prototype.setRemaining = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
 };
 
 
 /**
- * optional Type repoType = 7;
- * @return {!proto.ag.Repository.Type}
+ * optional string nextBuild = 7;
+ * @return {string}
  */
-proto.ag.Repository.prototype.getRepotype = function() {
-  return /** @type {!proto.ag.Repository.Type} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+proto.ag.BuildQuota.prototype.getNextbuild = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
 };
 
 
 /**
- * @param {!proto.ag.Repository.Type} value
- * @return {!proto.ag.Repository} returns this
+ * @param {string} value
+ * @return {!proto.ag.BuildQuota} returns this
  */
-proto.ag.Repository.prototype.setRepotype = function(value) {
-  return jspb.Message.setProto3EnumField(this, 7, value);
+proto.ag.BuildQuota.prototype.setNextbuild = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
 };
 
 
@@ -3548,7 +12972,7 @@ proto.ag.Repository.prototype.setRepotype = function(value) {
  * @private {!Array<number>}
  * @const
  */
-proto.ag.Enrollment.repeatedFields_ = [14];
+proto.ag.BuildQuotas.repeatedFields_ = [1];
 
 
 
@@ -3565,8 +12989,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Enrollment.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Enrollment.toObject(opt_includeInstance, this);
+proto.ag.BuildQuotas.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.BuildQuotas.toObject(opt_includeInstance, this);
 };
 
 
@@ -3575,27 +12999,182 @@ proto.ag.Enrollment.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Enrollment} msg The msg instance to transform.
+ * @param {!proto.ag.BuildQuotas} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Enrollment.toObject = function(includeInstance, msg) {
+proto.ag.BuildQuotas.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    courseid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    userid: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    groupid: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    hasteacherscopes: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
-    user: (f = msg.getUser()) && proto.ag.User.toObject(includeInstance, f),
-    course: (f = msg.getCourse()) && proto.ag.Course.toObject(includeInstance, f),
-    group: (f = msg.getGroup()) && proto.ag.Group.toObject(includeInstance, f),
-    status: jspb.Message.getFieldWithDefault(msg, 9, 0),
-    state: jspb.Message.getFieldWithDefault(msg, 10, 0),
-    slipdaysremaining: jspb.Message.getFieldWithDefault(msg, 11, 0),
-    lastactivitydate: jspb.Message.getFieldWithDefault(msg, 12, ""),
-    totalapproved: jspb.Message.getFieldWithDefault(msg, 13, 0),
-    usedslipdaysList: jspb.Message.toObjectList(msg.getUsedslipdaysList(),
-    proto.ag.UsedSlipDays.toObject, includeInstance)
+    quotasList: jspb.Message.toObjectList(msg.getQuotasList(),
+    proto.ag.BuildQuota.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.BuildQuotas}
+ */
+proto.ag.BuildQuotas.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.BuildQuotas;
+  return proto.ag.BuildQuotas.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.BuildQuotas} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.BuildQuotas}
+ */
+proto.ag.BuildQuotas.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.ag.BuildQuota;
+      reader.readMessage(value,proto.ag.BuildQuota.deserializeBinaryFromReader);
+      msg.addQuotas(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.BuildQuotas.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.BuildQuotas.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.BuildQuotas} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.BuildQuotas.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getQuotasList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.ag.BuildQuota.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated BuildQuota quotas = 1;
+ * @return {!Array<!proto.ag.BuildQuota>}
+ */
+proto.ag.BuildQuotas.prototype.getQuotasList = function() {
+  return /** @type{!Array<!proto.ag.BuildQuota>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.BuildQuota, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.BuildQuota>} value
+ * @return {!proto.ag.BuildQuotas} returns this
+*/
+proto.ag.BuildQuotas.prototype.setQuotasList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.ag.BuildQuota=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.BuildQuota}
+ */
+proto.ag.BuildQuotas.prototype.addQuotas = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.BuildQuota, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.BuildQuotas} returns this
+ */
+proto.ag.BuildQuotas.prototype.clearQuotasList = function() {
+  return this.setQuotasList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.SimilarityPair.repeatedFields_ = [9];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.SimilarityPair.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.SimilarityPair.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.SimilarityPair} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.SimilarityPair.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    submissiona: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    submissionb: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    ownera: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    ownerb: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    similarity: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    checkeddate: jspb.Message.getFieldWithDefault(msg, 8, ""),
+    matchesList: jspb.Message.toObjectList(msg.getMatchesList(),
+    proto.ag.SimilarityMatch.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -3609,23 +13188,23 @@ proto.ag.Enrollment.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Enrollment}
+ * @return {!proto.ag.SimilarityPair}
  */
-proto.ag.Enrollment.deserializeBinary = function(bytes) {
+proto.ag.SimilarityPair.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Enrollment;
-  return proto.ag.Enrollment.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.SimilarityPair;
+  return proto.ag.SimilarityPair.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Enrollment} msg The message object to deserialize into.
+ * @param {!proto.ag.SimilarityPair} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Enrollment}
+ * @return {!proto.ag.SimilarityPair}
  */
-proto.ag.Enrollment.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.SimilarityPair.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3638,59 +13217,36 @@ proto.ag.Enrollment.deserializeBinaryFromReader = function(msg, reader) {
       break;
     case 2:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
+      msg.setAssignmentid(value);
       break;
     case 3:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setUserid(value);
+      msg.setSubmissiona(value);
       break;
     case 4:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setGroupid(value);
+      msg.setSubmissionb(value);
       break;
     case 5:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setHasteacherscopes(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOwnera(value);
       break;
     case 6:
-      var value = new proto.ag.User;
-      reader.readMessage(value,proto.ag.User.deserializeBinaryFromReader);
-      msg.setUser(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOwnerb(value);
       break;
     case 7:
-      var value = new proto.ag.Course;
-      reader.readMessage(value,proto.ag.Course.deserializeBinaryFromReader);
-      msg.setCourse(value);
-      break;
-    case 8:
-      var value = new proto.ag.Group;
-      reader.readMessage(value,proto.ag.Group.deserializeBinaryFromReader);
-      msg.setGroup(value);
-      break;
-    case 9:
-      var value = /** @type {!proto.ag.Enrollment.UserStatus} */ (reader.readEnum());
-      msg.setStatus(value);
-      break;
-    case 10:
-      var value = /** @type {!proto.ag.Enrollment.DisplayState} */ (reader.readEnum());
-      msg.setState(value);
-      break;
-    case 11:
       var value = /** @type {number} */ (reader.readUint32());
-      msg.setSlipdaysremaining(value);
+      msg.setSimilarity(value);
       break;
-    case 12:
+    case 8:
       var value = /** @type {string} */ (reader.readString());
-      msg.setLastactivitydate(value);
-      break;
-    case 13:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setTotalapproved(value);
+      msg.setCheckeddate(value);
       break;
-    case 14:
-      var value = new proto.ag.UsedSlipDays;
-      reader.readMessage(value,proto.ag.UsedSlipDays.deserializeBinaryFromReader);
-      msg.addUsedslipdays(value);
+    case 9:
+      var value = new proto.ag.SimilarityMatch;
+      reader.readMessage(value,proto.ag.SimilarityMatch.deserializeBinaryFromReader);
+      msg.addMatches(value);
       break;
     default:
       reader.skipField();
@@ -3705,9 +13261,9 @@ proto.ag.Enrollment.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Enrollment.prototype.serializeBinary = function() {
+proto.ag.SimilarityPair.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Enrollment.serializeBinaryToWriter(this, writer);
+  proto.ag.SimilarityPair.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3715,11 +13271,11 @@ proto.ag.Enrollment.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Enrollment} message
+ * @param {!proto.ag.SimilarityPair} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Enrollment.serializeBinaryToWriter = function(message, writer) {
+proto.ag.SimilarityPair.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f !== 0) {
@@ -3728,450 +13284,405 @@ proto.ag.Enrollment.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getCourseid();
+  f = message.getAssignmentid();
   if (f !== 0) {
     writer.writeUint64(
       2,
       f
     );
   }
-  f = message.getUserid();
+  f = message.getSubmissiona();
   if (f !== 0) {
     writer.writeUint64(
       3,
       f
     );
   }
-  f = message.getGroupid();
+  f = message.getSubmissionb();
   if (f !== 0) {
     writer.writeUint64(
       4,
       f
     );
   }
-  f = message.getHasteacherscopes();
-  if (f) {
-    writer.writeBool(
+  f = message.getOwnera();
+  if (f.length > 0) {
+    writer.writeString(
       5,
       f
     );
   }
-  f = message.getUser();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getOwnerb();
+  if (f.length > 0) {
+    writer.writeString(
       6,
-      f,
-      proto.ag.User.serializeBinaryToWriter
-    );
-  }
-  f = message.getCourse();
-  if (f != null) {
-    writer.writeMessage(
-      7,
-      f,
-      proto.ag.Course.serializeBinaryToWriter
-    );
-  }
-  f = message.getGroup();
-  if (f != null) {
-    writer.writeMessage(
-      8,
-      f,
-      proto.ag.Group.serializeBinaryToWriter
-    );
-  }
-  f = message.getStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      9,
-      f
-    );
-  }
-  f = message.getState();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      10,
       f
     );
   }
-  f = message.getSlipdaysremaining();
+  f = message.getSimilarity();
   if (f !== 0) {
     writer.writeUint32(
-      11,
+      7,
       f
     );
   }
-  f = message.getLastactivitydate();
+  f = message.getCheckeddate();
   if (f.length > 0) {
     writer.writeString(
-      12,
-      f
-    );
-  }
-  f = message.getTotalapproved();
-  if (f !== 0) {
-    writer.writeUint64(
-      13,
+      8,
       f
     );
   }
-  f = message.getUsedslipdaysList();
+  f = message.getMatchesList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
-      14,
+      9,
       f,
-      proto.ag.UsedSlipDays.serializeBinaryToWriter
+      proto.ag.SimilarityMatch.serializeBinaryToWriter
     );
   }
 };
 
 
-/**
- * @enum {number}
- */
-proto.ag.Enrollment.UserStatus = {
-  NONE: 0,
-  PENDING: 1,
-  STUDENT: 2,
-  TEACHER: 3
-};
-
-/**
- * @enum {number}
- */
-proto.ag.Enrollment.DisplayState = {
-  UNSET: 0,
-  HIDDEN: 1,
-  VISIBLE: 2,
-  FAVORITE: 3
-};
-
 /**
  * optional uint64 ID = 1;
  * @return {number}
  */
-proto.ag.Enrollment.prototype.getId = function() {
+proto.ag.SimilarityPair.prototype.getId = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Enrollment} returns this
+ * @return {!proto.ag.SimilarityPair} returns this
  */
-proto.ag.Enrollment.prototype.setId = function(value) {
+proto.ag.SimilarityPair.prototype.setId = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 courseID = 2;
+ * optional uint64 assignmentID = 2;
  * @return {number}
  */
-proto.ag.Enrollment.prototype.getCourseid = function() {
+proto.ag.SimilarityPair.prototype.getAssignmentid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Enrollment} returns this
+ * @return {!proto.ag.SimilarityPair} returns this
  */
-proto.ag.Enrollment.prototype.setCourseid = function(value) {
+proto.ag.SimilarityPair.prototype.setAssignmentid = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional uint64 userID = 3;
+ * optional uint64 submissionA = 3;
  * @return {number}
  */
-proto.ag.Enrollment.prototype.getUserid = function() {
+proto.ag.SimilarityPair.prototype.getSubmissiona = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Enrollment} returns this
+ * @return {!proto.ag.SimilarityPair} returns this
  */
-proto.ag.Enrollment.prototype.setUserid = function(value) {
+proto.ag.SimilarityPair.prototype.setSubmissiona = function(value) {
   return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * optional uint64 groupID = 4;
+ * optional uint64 submissionB = 4;
  * @return {number}
  */
-proto.ag.Enrollment.prototype.getGroupid = function() {
+proto.ag.SimilarityPair.prototype.getSubmissionb = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Enrollment} returns this
+ * @return {!proto.ag.SimilarityPair} returns this
  */
-proto.ag.Enrollment.prototype.setGroupid = function(value) {
+proto.ag.SimilarityPair.prototype.setSubmissionb = function(value) {
   return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * optional bool hasTeacherScopes = 5;
- * @return {boolean}
- */
-proto.ag.Enrollment.prototype.getHasteacherscopes = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.ag.Enrollment} returns this
- */
-proto.ag.Enrollment.prototype.setHasteacherscopes = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 5, value);
-};
-
-
-/**
- * optional User user = 6;
- * @return {?proto.ag.User}
+ * optional string ownerA = 5;
+ * @return {string}
  */
-proto.ag.Enrollment.prototype.getUser = function() {
-  return /** @type{?proto.ag.User} */ (
-    jspb.Message.getWrapperField(this, proto.ag.User, 6));
-};
-
-
-/**
- * @param {?proto.ag.User|undefined} value
- * @return {!proto.ag.Enrollment} returns this
-*/
-proto.ag.Enrollment.prototype.setUser = function(value) {
-  return jspb.Message.setWrapperField(this, 6, value);
+proto.ag.SimilarityPair.prototype.getOwnera = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.ag.Enrollment} returns this
+ * @param {string} value
+ * @return {!proto.ag.SimilarityPair} returns this
  */
-proto.ag.Enrollment.prototype.clearUser = function() {
-  return this.setUser(undefined);
+proto.ag.SimilarityPair.prototype.setOwnera = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional string ownerB = 6;
+ * @return {string}
  */
-proto.ag.Enrollment.prototype.hasUser = function() {
-  return jspb.Message.getField(this, 6) != null;
+proto.ag.SimilarityPair.prototype.getOwnerb = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
 };
 
 
 /**
- * optional Course course = 7;
- * @return {?proto.ag.Course}
+ * @param {string} value
+ * @return {!proto.ag.SimilarityPair} returns this
  */
-proto.ag.Enrollment.prototype.getCourse = function() {
-  return /** @type{?proto.ag.Course} */ (
-    jspb.Message.getWrapperField(this, proto.ag.Course, 7));
-};
-
-
-/**
- * @param {?proto.ag.Course|undefined} value
- * @return {!proto.ag.Enrollment} returns this
-*/
-proto.ag.Enrollment.prototype.setCourse = function(value) {
-  return jspb.Message.setWrapperField(this, 7, value);
+proto.ag.SimilarityPair.prototype.setOwnerb = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.ag.Enrollment} returns this
+ * optional uint32 similarity = 7;
+ * @return {number}
  */
-proto.ag.Enrollment.prototype.clearCourse = function() {
-  return this.setCourse(undefined);
+proto.ag.SimilarityPair.prototype.getSimilarity = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.ag.SimilarityPair} returns this
  */
-proto.ag.Enrollment.prototype.hasCourse = function() {
-  return jspb.Message.getField(this, 7) != null;
+proto.ag.SimilarityPair.prototype.setSimilarity = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
 };
 
 
 /**
- * optional Group group = 8;
- * @return {?proto.ag.Group}
+ * optional string checkedDate = 8;
+ * @return {string}
  */
-proto.ag.Enrollment.prototype.getGroup = function() {
-  return /** @type{?proto.ag.Group} */ (
-    jspb.Message.getWrapperField(this, proto.ag.Group, 8));
-};
-
-
-/**
- * @param {?proto.ag.Group|undefined} value
- * @return {!proto.ag.Enrollment} returns this
-*/
-proto.ag.Enrollment.prototype.setGroup = function(value) {
-  return jspb.Message.setWrapperField(this, 8, value);
+proto.ag.SimilarityPair.prototype.getCheckeddate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.ag.Enrollment} returns this
+ * @param {string} value
+ * @return {!proto.ag.SimilarityPair} returns this
  */
-proto.ag.Enrollment.prototype.clearGroup = function() {
-  return this.setGroup(undefined);
+proto.ag.SimilarityPair.prototype.setCheckeddate = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * repeated SimilarityMatch matches = 9;
+ * @return {!Array<!proto.ag.SimilarityMatch>}
  */
-proto.ag.Enrollment.prototype.hasGroup = function() {
-  return jspb.Message.getField(this, 8) != null;
+proto.ag.SimilarityPair.prototype.getMatchesList = function() {
+  return /** @type{!Array<!proto.ag.SimilarityMatch>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.SimilarityMatch, 9));
 };
 
 
 /**
- * optional UserStatus status = 9;
- * @return {!proto.ag.Enrollment.UserStatus}
- */
-proto.ag.Enrollment.prototype.getStatus = function() {
-  return /** @type {!proto.ag.Enrollment.UserStatus} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+ * @param {!Array<!proto.ag.SimilarityMatch>} value
+ * @return {!proto.ag.SimilarityPair} returns this
+*/
+proto.ag.SimilarityPair.prototype.setMatchesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 9, value);
 };
 
 
 /**
- * @param {!proto.ag.Enrollment.UserStatus} value
- * @return {!proto.ag.Enrollment} returns this
+ * @param {!proto.ag.SimilarityMatch=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.SimilarityMatch}
  */
-proto.ag.Enrollment.prototype.setStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 9, value);
+proto.ag.SimilarityPair.prototype.addMatches = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 9, opt_value, proto.ag.SimilarityMatch, opt_index);
 };
 
 
 /**
- * optional DisplayState state = 10;
- * @return {!proto.ag.Enrollment.DisplayState}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.SimilarityPair} returns this
  */
-proto.ag.Enrollment.prototype.getState = function() {
-  return /** @type {!proto.ag.Enrollment.DisplayState} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+proto.ag.SimilarityPair.prototype.clearMatchesList = function() {
+  return this.setMatchesList([]);
 };
 
 
+
 /**
- * @param {!proto.ag.Enrollment.DisplayState} value
- * @return {!proto.ag.Enrollment} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.ag.Enrollment.prototype.setState = function(value) {
-  return jspb.Message.setProto3EnumField(this, 10, value);
-};
+proto.ag.SimilarityPairs.repeatedFields_ = [1];
+
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional uint32 slipDaysRemaining = 11;
- * @return {number}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.ag.Enrollment.prototype.getSlipdaysremaining = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+proto.ag.SimilarityPairs.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.SimilarityPairs.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.Enrollment} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.SimilarityPairs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Enrollment.prototype.setSlipdaysremaining = function(value) {
-  return jspb.Message.setProto3IntField(this, 11, value);
+proto.ag.SimilarityPairs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    pairsList: jspb.Message.toObjectList(msg.getPairsList(),
+    proto.ag.SimilarityPair.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional string lastActivityDate = 12;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.SimilarityPairs}
  */
-proto.ag.Enrollment.prototype.getLastactivitydate = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 12, ""));
+proto.ag.SimilarityPairs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.SimilarityPairs;
+  return proto.ag.SimilarityPairs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.Enrollment} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.SimilarityPairs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.SimilarityPairs}
  */
-proto.ag.Enrollment.prototype.setLastactivitydate = function(value) {
-  return jspb.Message.setProto3StringField(this, 12, value);
+proto.ag.SimilarityPairs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.ag.SimilarityPair;
+      reader.readMessage(value,proto.ag.SimilarityPair.deserializeBinaryFromReader);
+      msg.addPairs(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional uint64 totalApproved = 13;
- * @return {number}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.ag.Enrollment.prototype.getTotalapproved = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 13, 0));
+proto.ag.SimilarityPairs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.SimilarityPairs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.Enrollment} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.SimilarityPairs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Enrollment.prototype.setTotalapproved = function(value) {
-  return jspb.Message.setProto3IntField(this, 13, value);
+proto.ag.SimilarityPairs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPairsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.ag.SimilarityPair.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * repeated UsedSlipDays usedSlipDays = 14;
- * @return {!Array<!proto.ag.UsedSlipDays>}
+ * repeated SimilarityPair pairs = 1;
+ * @return {!Array<!proto.ag.SimilarityPair>}
  */
-proto.ag.Enrollment.prototype.getUsedslipdaysList = function() {
-  return /** @type{!Array<!proto.ag.UsedSlipDays>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.UsedSlipDays, 14));
+proto.ag.SimilarityPairs.prototype.getPairsList = function() {
+  return /** @type{!Array<!proto.ag.SimilarityPair>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.SimilarityPair, 1));
 };
 
 
 /**
- * @param {!Array<!proto.ag.UsedSlipDays>} value
- * @return {!proto.ag.Enrollment} returns this
+ * @param {!Array<!proto.ag.SimilarityPair>} value
+ * @return {!proto.ag.SimilarityPairs} returns this
 */
-proto.ag.Enrollment.prototype.setUsedslipdaysList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 14, value);
+proto.ag.SimilarityPairs.prototype.setPairsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.ag.UsedSlipDays=} opt_value
+ * @param {!proto.ag.SimilarityPair=} opt_value
  * @param {number=} opt_index
- * @return {!proto.ag.UsedSlipDays}
+ * @return {!proto.ag.SimilarityPair}
  */
-proto.ag.Enrollment.prototype.addUsedslipdays = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 14, opt_value, proto.ag.UsedSlipDays, opt_index);
+proto.ag.SimilarityPairs.prototype.addPairs = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.SimilarityPair, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.ag.Enrollment} returns this
+ * @return {!proto.ag.SimilarityPairs} returns this
  */
-proto.ag.Enrollment.prototype.clearUsedslipdaysList = function() {
-  return this.setUsedslipdaysList([]);
+proto.ag.SimilarityPairs.prototype.clearPairsList = function() {
+  return this.setPairsList([]);
 };
 
 
@@ -4191,8 +13702,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.UsedSlipDays.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.UsedSlipDays.toObject(opt_includeInstance, this);
+proto.ag.SimilarityMatch.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.SimilarityMatch.toObject(opt_includeInstance, this);
 };
 
 
@@ -4201,16 +13712,20 @@ proto.ag.UsedSlipDays.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.UsedSlipDays} msg The msg instance to transform.
+ * @param {!proto.ag.SimilarityMatch} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.UsedSlipDays.toObject = function(includeInstance, msg) {
+proto.ag.SimilarityMatch.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    enrollmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    assignmentid: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    usedslipdays: jspb.Message.getFieldWithDefault(msg, 4, 0)
+    pairid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    filea: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    startlinea: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    endlinea: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    fileb: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    startlineb: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    endlineb: jspb.Message.getFieldWithDefault(msg, 8, 0)
   };
 
   if (includeInstance) {
@@ -4224,23 +13739,23 @@ proto.ag.UsedSlipDays.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.UsedSlipDays}
+ * @return {!proto.ag.SimilarityMatch}
  */
-proto.ag.UsedSlipDays.deserializeBinary = function(bytes) {
+proto.ag.SimilarityMatch.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.UsedSlipDays;
-  return proto.ag.UsedSlipDays.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.SimilarityMatch;
+  return proto.ag.SimilarityMatch.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.UsedSlipDays} msg The message object to deserialize into.
+ * @param {!proto.ag.SimilarityMatch} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.UsedSlipDays}
+ * @return {!proto.ag.SimilarityMatch}
  */
-proto.ag.UsedSlipDays.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.SimilarityMatch.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4253,15 +13768,31 @@ proto.ag.UsedSlipDays.deserializeBinaryFromReader = function(msg, reader) {
       break;
     case 2:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setEnrollmentid(value);
+      msg.setPairid(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setAssignmentid(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFilea(value);
       break;
     case 4:
       var value = /** @type {number} */ (reader.readUint32());
-      msg.setUsedslipdays(value);
+      msg.setStartlinea(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setEndlinea(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFileb(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setStartlineb(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setEndlineb(value);
       break;
     default:
       reader.skipField();
@@ -4276,9 +13807,9 @@ proto.ag.UsedSlipDays.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.UsedSlipDays.prototype.serializeBinary = function() {
+proto.ag.SimilarityMatch.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.UsedSlipDays.serializeBinaryToWriter(this, writer);
+  proto.ag.SimilarityMatch.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4286,11 +13817,11 @@ proto.ag.UsedSlipDays.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.UsedSlipDays} message
+ * @param {!proto.ag.SimilarityMatch} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.UsedSlipDays.serializeBinaryToWriter = function(message, writer) {
+proto.ag.SimilarityMatch.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f !== 0) {
@@ -4299,99 +13830,199 @@ proto.ag.UsedSlipDays.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getEnrollmentid();
+  f = message.getPairid();
   if (f !== 0) {
     writer.writeUint64(
       2,
       f
     );
   }
-  f = message.getAssignmentid();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getFilea();
+  if (f.length > 0) {
+    writer.writeString(
       3,
       f
     );
   }
-  f = message.getUsedslipdays();
+  f = message.getStartlinea();
   if (f !== 0) {
     writer.writeUint32(
       4,
       f
     );
   }
+  f = message.getEndlinea();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
+  f = message.getFileb();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getStartlineb();
+  if (f !== 0) {
+    writer.writeUint32(
+      7,
+      f
+    );
+  }
+  f = message.getEndlineb();
+  if (f !== 0) {
+    writer.writeUint32(
+      8,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.ag.SimilarityMatch.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.SimilarityMatch} returns this
+ */
+proto.ag.SimilarityMatch.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 pairID = 2;
+ * @return {number}
+ */
+proto.ag.SimilarityMatch.prototype.getPairid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.SimilarityMatch} returns this
+ */
+proto.ag.SimilarityMatch.prototype.setPairid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string fileA = 3;
+ * @return {string}
+ */
+proto.ag.SimilarityMatch.prototype.getFilea = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.SimilarityMatch} returns this
+ */
+proto.ag.SimilarityMatch.prototype.setFilea = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional uint32 startLineA = 4;
+ * @return {number}
+ */
+proto.ag.SimilarityMatch.prototype.getStartlinea = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.SimilarityMatch} returns this
+ */
+proto.ag.SimilarityMatch.prototype.setStartlinea = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * optional uint64 ID = 1;
+ * optional uint32 endLineA = 5;
  * @return {number}
  */
-proto.ag.UsedSlipDays.prototype.getId = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.ag.SimilarityMatch.prototype.getEndlinea = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.UsedSlipDays} returns this
+ * @return {!proto.ag.SimilarityMatch} returns this
  */
-proto.ag.UsedSlipDays.prototype.setId = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.ag.SimilarityMatch.prototype.setEndlinea = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
 /**
- * optional uint64 enrollmentID = 2;
- * @return {number}
+ * optional string fileB = 6;
+ * @return {string}
  */
-proto.ag.UsedSlipDays.prototype.getEnrollmentid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.ag.SimilarityMatch.prototype.getFileb = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.UsedSlipDays} returns this
+ * @param {string} value
+ * @return {!proto.ag.SimilarityMatch} returns this
  */
-proto.ag.UsedSlipDays.prototype.setEnrollmentid = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.ag.SimilarityMatch.prototype.setFileb = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
 };
 
 
 /**
- * optional uint64 assignmentID = 3;
+ * optional uint32 startLineB = 7;
  * @return {number}
  */
-proto.ag.UsedSlipDays.prototype.getAssignmentid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.ag.SimilarityMatch.prototype.getStartlineb = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.UsedSlipDays} returns this
+ * @return {!proto.ag.SimilarityMatch} returns this
  */
-proto.ag.UsedSlipDays.prototype.setAssignmentid = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.ag.SimilarityMatch.prototype.setStartlineb = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
 };
 
 
 /**
- * optional uint32 usedSlipDays = 4;
+ * optional uint32 endLineB = 8;
  * @return {number}
  */
-proto.ag.UsedSlipDays.prototype.getUsedslipdays = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.ag.SimilarityMatch.prototype.getEndlineb = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.UsedSlipDays} returns this
+ * @return {!proto.ag.SimilarityMatch} returns this
  */
-proto.ag.UsedSlipDays.prototype.setUsedslipdays = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
+proto.ag.SimilarityMatch.prototype.setEndlineb = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
 };
 
 
@@ -4401,7 +14032,7 @@ proto.ag.UsedSlipDays.prototype.setUsedslipdays = function(value) {
  * @private {!Array<number>}
  * @const
  */
-proto.ag.Enrollments.repeatedFields_ = [1];
+proto.ag.GradingBenchmark.repeatedFields_ = [5];
 
 
 
@@ -4418,8 +14049,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Enrollments.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Enrollments.toObject(opt_includeInstance, this);
+proto.ag.GradingBenchmark.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.GradingBenchmark.toObject(opt_includeInstance, this);
 };
 
 
@@ -4428,14 +14059,18 @@ proto.ag.Enrollments.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Enrollments} msg The msg instance to transform.
+ * @param {!proto.ag.GradingBenchmark} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Enrollments.toObject = function(includeInstance, msg) {
+proto.ag.GradingBenchmark.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enrollmentsList: jspb.Message.toObjectList(msg.getEnrollmentsList(),
-    proto.ag.Enrollment.toObject, includeInstance)
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    heading: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    comment: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    criteriaList: jspb.Message.toObjectList(msg.getCriteriaList(),
+    proto.ag.GradingCriterion.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -4449,23 +14084,23 @@ proto.ag.Enrollments.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Enrollments}
+ * @return {!proto.ag.GradingBenchmark}
  */
-proto.ag.Enrollments.deserializeBinary = function(bytes) {
+proto.ag.GradingBenchmark.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Enrollments;
-  return proto.ag.Enrollments.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.GradingBenchmark;
+  return proto.ag.GradingBenchmark.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Enrollments} msg The message object to deserialize into.
+ * @param {!proto.ag.GradingBenchmark} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Enrollments}
+ * @return {!proto.ag.GradingBenchmark}
  */
-proto.ag.Enrollments.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.GradingBenchmark.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4473,9 +14108,25 @@ proto.ag.Enrollments.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.ag.Enrollment;
-      reader.readMessage(value,proto.ag.Enrollment.deserializeBinaryFromReader);
-      msg.addEnrollments(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setHeading(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setComment(value);
+      break;
+    case 5:
+      var value = new proto.ag.GradingCriterion;
+      reader.readMessage(value,proto.ag.GradingCriterion.deserializeBinaryFromReader);
+      msg.addCriteria(value);
       break;
     default:
       reader.skipField();
@@ -4490,9 +14141,9 @@ proto.ag.Enrollments.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Enrollments.prototype.serializeBinary = function() {
+proto.ag.GradingBenchmark.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Enrollments.serializeBinaryToWriter(this, writer);
+  proto.ag.GradingBenchmark.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4500,260 +14151,158 @@ proto.ag.Enrollments.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Enrollments} message
+ * @param {!proto.ag.GradingBenchmark} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Enrollments.serializeBinaryToWriter = function(message, writer) {
+proto.ag.GradingBenchmark.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEnrollmentsList();
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getHeading();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getComment();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getCriteriaList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
-      1,
+      5,
       f,
-      proto.ag.Enrollment.serializeBinaryToWriter
+      proto.ag.GradingCriterion.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * repeated Enrollment enrollments = 1;
- * @return {!Array<!proto.ag.Enrollment>}
- */
-proto.ag.Enrollments.prototype.getEnrollmentsList = function() {
-  return /** @type{!Array<!proto.ag.Enrollment>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.Enrollment, 1));
-};
-
-
-/**
- * @param {!Array<!proto.ag.Enrollment>} value
- * @return {!proto.ag.Enrollments} returns this
-*/
-proto.ag.Enrollments.prototype.setEnrollmentsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
-};
-
-
-/**
- * @param {!proto.ag.Enrollment=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ag.Enrollment}
- */
-proto.ag.Enrollments.prototype.addEnrollments = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.Enrollment, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.Enrollments} returns this
- */
-proto.ag.Enrollments.prototype.clearEnrollmentsList = function() {
-  return this.setEnrollmentsList([]);
-};
-
-
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
-/**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
- */
-proto.ag.SubmissionLink.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.SubmissionLink.toObject(opt_includeInstance, this);
-};
-
-
-/**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.ag.SubmissionLink} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.ag.SubmissionLink.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    assignment: (f = msg.getAssignment()) && proto.ag.Assignment.toObject(includeInstance, f),
-    submission: (f = msg.getSubmission()) && proto.ag.Submission.toObject(includeInstance, f)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
-};
-}
-
-
-/**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.SubmissionLink}
+ * optional uint64 ID = 1;
+ * @return {number}
  */
-proto.ag.SubmissionLink.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.SubmissionLink;
-  return proto.ag.SubmissionLink.deserializeBinaryFromReader(msg, reader);
+proto.ag.GradingBenchmark.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
-
-/**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.ag.SubmissionLink} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.SubmissionLink}
- */
-proto.ag.SubmissionLink.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = new proto.ag.Assignment;
-      reader.readMessage(value,proto.ag.Assignment.deserializeBinaryFromReader);
-      msg.setAssignment(value);
-      break;
-    case 2:
-      var value = new proto.ag.Submission;
-      reader.readMessage(value,proto.ag.Submission.deserializeBinaryFromReader);
-      msg.setSubmission(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.GradingBenchmark} returns this
+ */
+proto.ag.GradingBenchmark.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional uint64 assignmentID = 2;
+ * @return {number}
  */
-proto.ag.SubmissionLink.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.ag.SubmissionLink.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.ag.GradingBenchmark.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.ag.SubmissionLink} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {number} value
+ * @return {!proto.ag.GradingBenchmark} returns this
  */
-proto.ag.SubmissionLink.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getAssignment();
-  if (f != null) {
-    writer.writeMessage(
-      1,
-      f,
-      proto.ag.Assignment.serializeBinaryToWriter
-    );
-  }
-  f = message.getSubmission();
-  if (f != null) {
-    writer.writeMessage(
-      2,
-      f,
-      proto.ag.Submission.serializeBinaryToWriter
-    );
-  }
+proto.ag.GradingBenchmark.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional Assignment assignment = 1;
- * @return {?proto.ag.Assignment}
+ * optional string heading = 3;
+ * @return {string}
  */
-proto.ag.SubmissionLink.prototype.getAssignment = function() {
-  return /** @type{?proto.ag.Assignment} */ (
-    jspb.Message.getWrapperField(this, proto.ag.Assignment, 1));
+proto.ag.GradingBenchmark.prototype.getHeading = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * @param {?proto.ag.Assignment|undefined} value
- * @return {!proto.ag.SubmissionLink} returns this
-*/
-proto.ag.SubmissionLink.prototype.setAssignment = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+ * @param {string} value
+ * @return {!proto.ag.GradingBenchmark} returns this
+ */
+proto.ag.GradingBenchmark.prototype.setHeading = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.ag.SubmissionLink} returns this
+ * optional string comment = 4;
+ * @return {string}
  */
-proto.ag.SubmissionLink.prototype.clearAssignment = function() {
-  return this.setAssignment(undefined);
+proto.ag.GradingBenchmark.prototype.getComment = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.ag.GradingBenchmark} returns this
  */
-proto.ag.SubmissionLink.prototype.hasAssignment = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.ag.GradingBenchmark.prototype.setComment = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * optional Submission submission = 2;
- * @return {?proto.ag.Submission}
+ * repeated GradingCriterion criteria = 5;
+ * @return {!Array<!proto.ag.GradingCriterion>}
  */
-proto.ag.SubmissionLink.prototype.getSubmission = function() {
-  return /** @type{?proto.ag.Submission} */ (
-    jspb.Message.getWrapperField(this, proto.ag.Submission, 2));
+proto.ag.GradingBenchmark.prototype.getCriteriaList = function() {
+  return /** @type{!Array<!proto.ag.GradingCriterion>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.GradingCriterion, 5));
 };
 
 
 /**
- * @param {?proto.ag.Submission|undefined} value
- * @return {!proto.ag.SubmissionLink} returns this
+ * @param {!Array<!proto.ag.GradingCriterion>} value
+ * @return {!proto.ag.GradingBenchmark} returns this
 */
-proto.ag.SubmissionLink.prototype.setSubmission = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+proto.ag.GradingBenchmark.prototype.setCriteriaList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 5, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.ag.SubmissionLink} returns this
+ * @param {!proto.ag.GradingCriterion=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ag.GradingCriterion}
  */
-proto.ag.SubmissionLink.prototype.clearSubmission = function() {
-  return this.setSubmission(undefined);
+proto.ag.GradingBenchmark.prototype.addCriteria = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 5, opt_value, proto.ag.GradingCriterion, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.GradingBenchmark} returns this
  */
-proto.ag.SubmissionLink.prototype.hasSubmission = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.ag.GradingBenchmark.prototype.clearCriteriaList = function() {
+  return this.setCriteriaList([]);
 };
 
 
@@ -4763,7 +14312,7 @@ proto.ag.SubmissionLink.prototype.hasSubmission = function() {
  * @private {!Array<number>}
  * @const
  */
-proto.ag.EnrollmentLink.repeatedFields_ = [3];
+proto.ag.Benchmarks.repeatedFields_ = [1];
 
 
 
@@ -4780,8 +14329,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.EnrollmentLink.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.EnrollmentLink.toObject(opt_includeInstance, this);
+proto.ag.Benchmarks.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Benchmarks.toObject(opt_includeInstance, this);
 };
 
 
@@ -4790,15 +14339,14 @@ proto.ag.EnrollmentLink.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.EnrollmentLink} msg The msg instance to transform.
+ * @param {!proto.ag.Benchmarks} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.EnrollmentLink.toObject = function(includeInstance, msg) {
+proto.ag.Benchmarks.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enrollment: (f = msg.getEnrollment()) && proto.ag.Enrollment.toObject(includeInstance, f),
-    submissionsList: jspb.Message.toObjectList(msg.getSubmissionsList(),
-    proto.ag.SubmissionLink.toObject, includeInstance)
+    benchmarksList: jspb.Message.toObjectList(msg.getBenchmarksList(),
+    proto.ag.GradingBenchmark.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -4812,38 +14360,33 @@ proto.ag.EnrollmentLink.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.EnrollmentLink}
+ * @return {!proto.ag.Benchmarks}
  */
-proto.ag.EnrollmentLink.deserializeBinary = function(bytes) {
+proto.ag.Benchmarks.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.EnrollmentLink;
-  return proto.ag.EnrollmentLink.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.Benchmarks;
+  return proto.ag.Benchmarks.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.EnrollmentLink} msg The message object to deserialize into.
+ * @param {!proto.ag.Benchmarks} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.EnrollmentLink}
+ * @return {!proto.ag.Benchmarks}
  */
-proto.ag.EnrollmentLink.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.Benchmarks.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 2:
-      var value = new proto.ag.Enrollment;
-      reader.readMessage(value,proto.ag.Enrollment.deserializeBinaryFromReader);
-      msg.setEnrollment(value);
-      break;
-    case 3:
-      var value = new proto.ag.SubmissionLink;
-      reader.readMessage(value,proto.ag.SubmissionLink.deserializeBinaryFromReader);
-      msg.addSubmissions(value);
+    case 1:
+      var value = new proto.ag.GradingBenchmark;
+      reader.readMessage(value,proto.ag.GradingBenchmark.deserializeBinaryFromReader);
+      msg.addBenchmarks(value);
       break;
     default:
       reader.skipField();
@@ -4858,9 +14401,9 @@ proto.ag.EnrollmentLink.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.EnrollmentLink.prototype.serializeBinary = function() {
+proto.ag.Benchmarks.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.EnrollmentLink.serializeBinaryToWriter(this, writer);
+  proto.ag.Benchmarks.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4868,113 +14411,61 @@ proto.ag.EnrollmentLink.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.EnrollmentLink} message
+ * @param {!proto.ag.Benchmarks} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.EnrollmentLink.serializeBinaryToWriter = function(message, writer) {
+proto.ag.Benchmarks.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEnrollment();
-  if (f != null) {
-    writer.writeMessage(
-      2,
-      f,
-      proto.ag.Enrollment.serializeBinaryToWriter
-    );
-  }
-  f = message.getSubmissionsList();
+  f = message.getBenchmarksList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
-      3,
+      1,
       f,
-      proto.ag.SubmissionLink.serializeBinaryToWriter
+      proto.ag.GradingBenchmark.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional Enrollment enrollment = 2;
- * @return {?proto.ag.Enrollment}
- */
-proto.ag.EnrollmentLink.prototype.getEnrollment = function() {
-  return /** @type{?proto.ag.Enrollment} */ (
-    jspb.Message.getWrapperField(this, proto.ag.Enrollment, 2));
-};
-
-
-/**
- * @param {?proto.ag.Enrollment|undefined} value
- * @return {!proto.ag.EnrollmentLink} returns this
-*/
-proto.ag.EnrollmentLink.prototype.setEnrollment = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.ag.EnrollmentLink} returns this
- */
-proto.ag.EnrollmentLink.prototype.clearEnrollment = function() {
-  return this.setEnrollment(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.ag.EnrollmentLink.prototype.hasEnrollment = function() {
-  return jspb.Message.getField(this, 2) != null;
-};
-
-
-/**
- * repeated SubmissionLink submissions = 3;
- * @return {!Array<!proto.ag.SubmissionLink>}
+ * repeated GradingBenchmark benchmarks = 1;
+ * @return {!Array<!proto.ag.GradingBenchmark>}
  */
-proto.ag.EnrollmentLink.prototype.getSubmissionsList = function() {
-  return /** @type{!Array<!proto.ag.SubmissionLink>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.SubmissionLink, 3));
+proto.ag.Benchmarks.prototype.getBenchmarksList = function() {
+  return /** @type{!Array<!proto.ag.GradingBenchmark>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.GradingBenchmark, 1));
 };
 
 
 /**
- * @param {!Array<!proto.ag.SubmissionLink>} value
- * @return {!proto.ag.EnrollmentLink} returns this
+ * @param {!Array<!proto.ag.GradingBenchmark>} value
+ * @return {!proto.ag.Benchmarks} returns this
 */
-proto.ag.EnrollmentLink.prototype.setSubmissionsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+proto.ag.Benchmarks.prototype.setBenchmarksList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.ag.SubmissionLink=} opt_value
+ * @param {!proto.ag.GradingBenchmark=} opt_value
  * @param {number=} opt_index
- * @return {!proto.ag.SubmissionLink}
+ * @return {!proto.ag.GradingBenchmark}
  */
-proto.ag.EnrollmentLink.prototype.addSubmissions = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.ag.SubmissionLink, opt_index);
+proto.ag.Benchmarks.prototype.addBenchmarks = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.GradingBenchmark, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.ag.EnrollmentLink} returns this
- */
-proto.ag.EnrollmentLink.prototype.clearSubmissionsList = function() {
-  return this.setSubmissionsList([]);
-};
-
-
-
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @return {!proto.ag.Benchmarks} returns this
  */
-proto.ag.CourseSubmissions.repeatedFields_ = [2];
+proto.ag.Benchmarks.prototype.clearBenchmarksList = function() {
+  return this.setBenchmarksList([]);
+};
+
+
 
 
 
@@ -4991,8 +14482,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.CourseSubmissions.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.CourseSubmissions.toObject(opt_includeInstance, this);
+proto.ag.GradingCriterion.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.GradingCriterion.toObject(opt_includeInstance, this);
 };
 
 
@@ -5001,15 +14492,18 @@ proto.ag.CourseSubmissions.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.CourseSubmissions} msg The msg instance to transform.
+ * @param {!proto.ag.GradingCriterion} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.CourseSubmissions.toObject = function(includeInstance, msg) {
+proto.ag.GradingCriterion.toObject = function(includeInstance, msg) {
   var f, obj = {
-    course: (f = msg.getCourse()) && proto.ag.Course.toObject(includeInstance, f),
-    linksList: jspb.Message.toObjectList(msg.getLinksList(),
-    proto.ag.EnrollmentLink.toObject, includeInstance)
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    points: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    benchmarkid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    description: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    grade: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    comment: jspb.Message.getFieldWithDefault(msg, 6, "")
   };
 
   if (includeInstance) {
@@ -5023,23 +14517,23 @@ proto.ag.CourseSubmissions.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.CourseSubmissions}
+ * @return {!proto.ag.GradingCriterion}
  */
-proto.ag.CourseSubmissions.deserializeBinary = function(bytes) {
+proto.ag.GradingCriterion.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.CourseSubmissions;
-  return proto.ag.CourseSubmissions.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.GradingCriterion;
+  return proto.ag.GradingCriterion.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.CourseSubmissions} msg The message object to deserialize into.
+ * @param {!proto.ag.GradingCriterion} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.CourseSubmissions}
+ * @return {!proto.ag.GradingCriterion}
  */
-proto.ag.CourseSubmissions.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.GradingCriterion.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5047,14 +14541,28 @@ proto.ag.CourseSubmissions.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.ag.Course;
-      reader.readMessage(value,proto.ag.Course.deserializeBinaryFromReader);
-      msg.setCourse(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
       break;
     case 2:
-      var value = new proto.ag.EnrollmentLink;
-      reader.readMessage(value,proto.ag.EnrollmentLink.deserializeBinaryFromReader);
-      msg.addLinks(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setPoints(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setBenchmarkid(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDescription(value);
+      break;
+    case 5:
+      var value = /** @type {!proto.ag.GradingCriterion.Grade} */ (reader.readEnum());
+      msg.setGrade(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setComment(value);
       break;
     default:
       reader.skipField();
@@ -5069,9 +14577,9 @@ proto.ag.CourseSubmissions.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.CourseSubmissions.prototype.serializeBinary = function() {
+proto.ag.GradingCriterion.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.CourseSubmissions.serializeBinaryToWriter(this, writer);
+  proto.ag.GradingCriterion.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5079,103 +14587,171 @@ proto.ag.CourseSubmissions.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.CourseSubmissions} message
+ * @param {!proto.ag.GradingCriterion} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.CourseSubmissions.serializeBinaryToWriter = function(message, writer) {
+proto.ag.GradingCriterion.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCourse();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
       1,
-      f,
-      proto.ag.Course.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getLinksList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = message.getPoints();
+  if (f !== 0) {
+    writer.writeUint64(
       2,
-      f,
-      proto.ag.EnrollmentLink.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getBenchmarkid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getDescription();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getGrade();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      5,
+      f
+    );
+  }
+  f = message.getComment();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
     );
   }
 };
 
 
 /**
- * optional Course course = 1;
- * @return {?proto.ag.Course}
+ * @enum {number}
  */
-proto.ag.CourseSubmissions.prototype.getCourse = function() {
-  return /** @type{?proto.ag.Course} */ (
-    jspb.Message.getWrapperField(this, proto.ag.Course, 1));
+proto.ag.GradingCriterion.Grade = {
+  NONE: 0,
+  FAILED: 1,
+  PASSED: 2
+};
+
+/**
+ * optional uint64 ID = 1;
+ * @return {number}
+ */
+proto.ag.GradingCriterion.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {?proto.ag.Course|undefined} value
- * @return {!proto.ag.CourseSubmissions} returns this
-*/
-proto.ag.CourseSubmissions.prototype.setCourse = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+ * @param {number} value
+ * @return {!proto.ag.GradingCriterion} returns this
+ */
+proto.ag.GradingCriterion.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.ag.CourseSubmissions} returns this
+ * optional uint64 points = 2;
+ * @return {number}
  */
-proto.ag.CourseSubmissions.prototype.clearCourse = function() {
-  return this.setCourse(undefined);
+proto.ag.GradingCriterion.prototype.getPoints = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.ag.GradingCriterion} returns this
  */
-proto.ag.CourseSubmissions.prototype.hasCourse = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.ag.GradingCriterion.prototype.setPoints = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * repeated EnrollmentLink links = 2;
- * @return {!Array<!proto.ag.EnrollmentLink>}
+ * optional uint64 benchmarkID = 3;
+ * @return {number}
  */
-proto.ag.CourseSubmissions.prototype.getLinksList = function() {
-  return /** @type{!Array<!proto.ag.EnrollmentLink>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.EnrollmentLink, 2));
+proto.ag.GradingCriterion.prototype.getBenchmarkid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * @param {!Array<!proto.ag.EnrollmentLink>} value
- * @return {!proto.ag.CourseSubmissions} returns this
-*/
-proto.ag.CourseSubmissions.prototype.setLinksList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+ * @param {number} value
+ * @return {!proto.ag.GradingCriterion} returns this
+ */
+proto.ag.GradingCriterion.prototype.setBenchmarkid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * @param {!proto.ag.EnrollmentLink=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ag.EnrollmentLink}
+ * optional string description = 4;
+ * @return {string}
  */
-proto.ag.CourseSubmissions.prototype.addLinks = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.ag.EnrollmentLink, opt_index);
+proto.ag.GradingCriterion.prototype.getDescription = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.CourseSubmissions} returns this
+ * @param {string} value
+ * @return {!proto.ag.GradingCriterion} returns this
  */
-proto.ag.CourseSubmissions.prototype.clearLinksList = function() {
-  return this.setLinksList([]);
+proto.ag.GradingCriterion.prototype.setDescription = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional Grade grade = 5;
+ * @return {!proto.ag.GradingCriterion.Grade}
+ */
+proto.ag.GradingCriterion.prototype.getGrade = function() {
+  return /** @type {!proto.ag.GradingCriterion.Grade} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {!proto.ag.GradingCriterion.Grade} value
+ * @return {!proto.ag.GradingCriterion} returns this
+ */
+proto.ag.GradingCriterion.prototype.setGrade = function(value) {
+  return jspb.Message.setProto3EnumField(this, 5, value);
+};
+
+
+/**
+ * optional string comment = 6;
+ * @return {string}
+ */
+proto.ag.GradingCriterion.prototype.getComment = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.GradingCriterion} returns this
+ */
+proto.ag.GradingCriterion.prototype.setComment = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
 };
 
 
@@ -5185,7 +14761,7 @@ proto.ag.CourseSubmissions.prototype.clearLinksList = function() {
  * @private {!Array<number>}
  * @const
  */
-proto.ag.Assignment.repeatedFields_ = [12,13];
+proto.ag.Review.repeatedFields_ = [8];
 
 
 
@@ -5202,8 +14778,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Assignment.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Assignment.toObject(opt_includeInstance, this);
+proto.ag.Review.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Review.toObject(opt_includeInstance, this);
 };
 
 
@@ -5212,28 +14788,22 @@ proto.ag.Assignment.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Assignment} msg The msg instance to transform.
+ * @param {!proto.ag.Review} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.ag.Assignment.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    courseid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    name: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    scriptfile: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    deadline: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    autoapprove: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
-    order: jspb.Message.getFieldWithDefault(msg, 7, 0),
-    isgrouplab: jspb.Message.getBooleanFieldWithDefault(msg, 8, false),
-    scorelimit: jspb.Message.getFieldWithDefault(msg, 9, 0),
-    reviewers: jspb.Message.getFieldWithDefault(msg, 10, 0),
-    skiptests: jspb.Message.getBooleanFieldWithDefault(msg, 11, false),
-    submissionsList: jspb.Message.toObjectList(msg.getSubmissionsList(),
-    proto.ag.Submission.toObject, includeInstance),
-    gradingbenchmarksList: jspb.Message.toObjectList(msg.getGradingbenchmarksList(),
+ */
+proto.ag.Review.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    submissionid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    reviewerid: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    review: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    feedback: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    ready: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
+    score: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    benchmarksList: jspb.Message.toObjectList(msg.getBenchmarksList(),
     proto.ag.GradingBenchmark.toObject, includeInstance),
-    containertimeout: jspb.Message.getFieldWithDefault(msg, 14, 0)
+    edited: jspb.Message.getFieldWithDefault(msg, 9, "")
   };
 
   if (includeInstance) {
@@ -5247,23 +14817,23 @@ proto.ag.Assignment.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Assignment}
+ * @return {!proto.ag.Review}
  */
-proto.ag.Assignment.deserializeBinary = function(bytes) {
+proto.ag.Review.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Assignment;
-  return proto.ag.Assignment.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.Review;
+  return proto.ag.Review.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Assignment} msg The message object to deserialize into.
+ * @param {!proto.ag.Review} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Assignment}
+ * @return {!proto.ag.Review}
  */
-proto.ag.Assignment.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.Review.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5276,57 +14846,36 @@ proto.ag.Assignment.deserializeBinaryFromReader = function(msg, reader) {
       break;
     case 2:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
+      msg.setSubmissionid(value);
       break;
     case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setReviewerid(value);
       break;
     case 4:
       var value = /** @type {string} */ (reader.readString());
-      msg.setScriptfile(value);
+      msg.setReview(value);
       break;
     case 5:
       var value = /** @type {string} */ (reader.readString());
-      msg.setDeadline(value);
+      msg.setFeedback(value);
       break;
     case 6:
       var value = /** @type {boolean} */ (reader.readBool());
-      msg.setAutoapprove(value);
+      msg.setReady(value);
       break;
     case 7:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setOrder(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setScore(value);
       break;
     case 8:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIsgrouplab(value);
-      break;
-    case 9:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setScorelimit(value);
-      break;
-    case 10:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setReviewers(value);
-      break;
-    case 11:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setSkiptests(value);
-      break;
-    case 12:
-      var value = new proto.ag.Submission;
-      reader.readMessage(value,proto.ag.Submission.deserializeBinaryFromReader);
-      msg.addSubmissions(value);
-      break;
-    case 13:
       var value = new proto.ag.GradingBenchmark;
       reader.readMessage(value,proto.ag.GradingBenchmark.deserializeBinaryFromReader);
-      msg.addGradingbenchmarks(value);
+      msg.addBenchmarks(value);
       break;
-    case 14:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setContainertimeout(value);
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEdited(value);
       break;
     default:
       reader.skipField();
@@ -5341,9 +14890,9 @@ proto.ag.Assignment.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Assignment.prototype.serializeBinary = function() {
+proto.ag.Review.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Assignment.serializeBinaryToWriter(this, writer);
+  proto.ag.Review.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5351,11 +14900,11 @@ proto.ag.Assignment.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Assignment} message
+ * @param {!proto.ag.Review} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Assignment.serializeBinaryToWriter = function(message, writer) {
+proto.ag.Review.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f !== 0) {
@@ -5364,96 +14913,60 @@ proto.ag.Assignment.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getCourseid();
+  f = message.getSubmissionid();
   if (f !== 0) {
     writer.writeUint64(
       2,
       f
     );
   }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getReviewerid();
+  if (f !== 0) {
+    writer.writeUint64(
       3,
       f
     );
   }
-  f = message.getScriptfile();
+  f = message.getReview();
   if (f.length > 0) {
     writer.writeString(
       4,
       f
     );
   }
-  f = message.getDeadline();
+  f = message.getFeedback();
   if (f.length > 0) {
     writer.writeString(
       5,
       f
     );
   }
-  f = message.getAutoapprove();
+  f = message.getReady();
   if (f) {
     writer.writeBool(
       6,
       f
     );
   }
-  f = message.getOrder();
+  f = message.getScore();
   if (f !== 0) {
-    writer.writeUint32(
+    writer.writeUint64(
       7,
       f
     );
   }
-  f = message.getIsgrouplab();
-  if (f) {
-    writer.writeBool(
-      8,
-      f
-    );
-  }
-  f = message.getScorelimit();
-  if (f !== 0) {
-    writer.writeUint32(
-      9,
-      f
-    );
-  }
-  f = message.getReviewers();
-  if (f !== 0) {
-    writer.writeUint32(
-      10,
-      f
-    );
-  }
-  f = message.getSkiptests();
-  if (f) {
-    writer.writeBool(
-      11,
-      f
-    );
-  }
-  f = message.getSubmissionsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      12,
-      f,
-      proto.ag.Submission.serializeBinaryToWriter
-    );
-  }
-  f = message.getGradingbenchmarksList();
+  f = message.getBenchmarksList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
-      13,
+      8,
       f,
       proto.ag.GradingBenchmark.serializeBinaryToWriter
     );
   }
-  f = message.getContainertimeout();
-  if (f !== 0) {
-    writer.writeUint32(
-      14,
+  f = message.getEdited();
+  if (f.length > 0) {
+    writer.writeString(
+      9,
       f
     );
   }
@@ -5464,254 +14977,144 @@ proto.ag.Assignment.serializeBinaryToWriter = function(message, writer) {
  * optional uint64 ID = 1;
  * @return {number}
  */
-proto.ag.Assignment.prototype.getId = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.ag.Assignment} returns this
- */
-proto.ag.Assignment.prototype.setId = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
-};
-
-
-/**
- * optional uint64 courseID = 2;
- * @return {number}
- */
-proto.ag.Assignment.prototype.getCourseid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.ag.Assignment} returns this
- */
-proto.ag.Assignment.prototype.setCourseid = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
-};
-
-
-/**
- * optional string name = 3;
- * @return {string}
- */
-proto.ag.Assignment.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.ag.Assignment} returns this
- */
-proto.ag.Assignment.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
-};
-
-
-/**
- * optional string scriptFile = 4;
- * @return {string}
- */
-proto.ag.Assignment.prototype.getScriptfile = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.ag.Assignment} returns this
- */
-proto.ag.Assignment.prototype.setScriptfile = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
-};
-
-
-/**
- * optional string deadline = 5;
- * @return {string}
- */
-proto.ag.Assignment.prototype.getDeadline = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.ag.Assignment} returns this
- */
-proto.ag.Assignment.prototype.setDeadline = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
-};
-
-
-/**
- * optional bool autoApprove = 6;
- * @return {boolean}
- */
-proto.ag.Assignment.prototype.getAutoapprove = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.ag.Assignment} returns this
- */
-proto.ag.Assignment.prototype.setAutoapprove = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 6, value);
-};
-
-
-/**
- * optional uint32 order = 7;
- * @return {number}
- */
-proto.ag.Assignment.prototype.getOrder = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+proto.ag.Review.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Assignment} returns this
+ * @return {!proto.ag.Review} returns this
  */
-proto.ag.Assignment.prototype.setOrder = function(value) {
-  return jspb.Message.setProto3IntField(this, 7, value);
+proto.ag.Review.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional bool isGroupLab = 8;
- * @return {boolean}
+ * optional uint64 submissionID = 2;
+ * @return {number}
  */
-proto.ag.Assignment.prototype.getIsgrouplab = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 8, false));
+proto.ag.Review.prototype.getSubmissionid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.ag.Assignment} returns this
+ * @param {number} value
+ * @return {!proto.ag.Review} returns this
  */
-proto.ag.Assignment.prototype.setIsgrouplab = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 8, value);
+proto.ag.Review.prototype.setSubmissionid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional uint32 scoreLimit = 9;
+ * optional uint64 reviewerID = 3;
  * @return {number}
  */
-proto.ag.Assignment.prototype.getScorelimit = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+proto.ag.Review.prototype.getReviewerid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Assignment} returns this
+ * @return {!proto.ag.Review} returns this
  */
-proto.ag.Assignment.prototype.setScorelimit = function(value) {
-  return jspb.Message.setProto3IntField(this, 9, value);
+proto.ag.Review.prototype.setReviewerid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * optional uint32 reviewers = 10;
- * @return {number}
+ * optional string review = 4;
+ * @return {string}
  */
-proto.ag.Assignment.prototype.getReviewers = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+proto.ag.Review.prototype.getReview = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.Assignment} returns this
+ * @param {string} value
+ * @return {!proto.ag.Review} returns this
  */
-proto.ag.Assignment.prototype.setReviewers = function(value) {
-  return jspb.Message.setProto3IntField(this, 10, value);
+proto.ag.Review.prototype.setReview = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * optional bool skipTests = 11;
- * @return {boolean}
+ * optional string feedback = 5;
+ * @return {string}
  */
-proto.ag.Assignment.prototype.getSkiptests = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 11, false));
+proto.ag.Review.prototype.getFeedback = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.ag.Assignment} returns this
+ * @param {string} value
+ * @return {!proto.ag.Review} returns this
  */
-proto.ag.Assignment.prototype.setSkiptests = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 11, value);
+proto.ag.Review.prototype.setFeedback = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
 /**
- * repeated Submission submissions = 12;
- * @return {!Array<!proto.ag.Submission>}
+ * optional bool ready = 6;
+ * @return {boolean}
  */
-proto.ag.Assignment.prototype.getSubmissionsList = function() {
-  return /** @type{!Array<!proto.ag.Submission>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.Submission, 12));
+proto.ag.Review.prototype.getReady = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
 };
 
 
 /**
- * @param {!Array<!proto.ag.Submission>} value
- * @return {!proto.ag.Assignment} returns this
-*/
-proto.ag.Assignment.prototype.setSubmissionsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 12, value);
+ * @param {boolean} value
+ * @return {!proto.ag.Review} returns this
+ */
+proto.ag.Review.prototype.setReady = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 6, value);
 };
 
 
 /**
- * @param {!proto.ag.Submission=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ag.Submission}
+ * optional uint64 score = 7;
+ * @return {number}
  */
-proto.ag.Assignment.prototype.addSubmissions = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 12, opt_value, proto.ag.Submission, opt_index);
+proto.ag.Review.prototype.getScore = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.Assignment} returns this
+ * @param {number} value
+ * @return {!proto.ag.Review} returns this
  */
-proto.ag.Assignment.prototype.clearSubmissionsList = function() {
-  return this.setSubmissionsList([]);
+proto.ag.Review.prototype.setScore = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
 };
 
 
 /**
- * repeated GradingBenchmark gradingBenchmarks = 13;
+ * repeated GradingBenchmark benchmarks = 8;
  * @return {!Array<!proto.ag.GradingBenchmark>}
  */
-proto.ag.Assignment.prototype.getGradingbenchmarksList = function() {
+proto.ag.Review.prototype.getBenchmarksList = function() {
   return /** @type{!Array<!proto.ag.GradingBenchmark>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.GradingBenchmark, 13));
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.GradingBenchmark, 8));
 };
 
 
 /**
  * @param {!Array<!proto.ag.GradingBenchmark>} value
- * @return {!proto.ag.Assignment} returns this
+ * @return {!proto.ag.Review} returns this
 */
-proto.ag.Assignment.prototype.setGradingbenchmarksList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 13, value);
+proto.ag.Review.prototype.setBenchmarksList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 8, value);
 };
 
 
@@ -5720,35 +15123,35 @@ proto.ag.Assignment.prototype.setGradingbenchmarksList = function(value) {
  * @param {number=} opt_index
  * @return {!proto.ag.GradingBenchmark}
  */
-proto.ag.Assignment.prototype.addGradingbenchmarks = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 13, opt_value, proto.ag.GradingBenchmark, opt_index);
+proto.ag.Review.prototype.addBenchmarks = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 8, opt_value, proto.ag.GradingBenchmark, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.ag.Assignment} returns this
+ * @return {!proto.ag.Review} returns this
  */
-proto.ag.Assignment.prototype.clearGradingbenchmarksList = function() {
-  return this.setGradingbenchmarksList([]);
+proto.ag.Review.prototype.clearBenchmarksList = function() {
+  return this.setBenchmarksList([]);
 };
 
 
 /**
- * optional uint32 containerTimeout = 14;
- * @return {number}
+ * optional string edited = 9;
+ * @return {string}
  */
-proto.ag.Assignment.prototype.getContainertimeout = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 14, 0));
+proto.ag.Review.prototype.getEdited = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.Assignment} returns this
+ * @param {string} value
+ * @return {!proto.ag.Review} returns this
  */
-proto.ag.Assignment.prototype.setContainertimeout = function(value) {
-  return jspb.Message.setProto3IntField(this, 14, value);
+proto.ag.Review.prototype.setEdited = function(value) {
+  return jspb.Message.setProto3StringField(this, 9, value);
 };
 
 
@@ -5758,7 +15161,7 @@ proto.ag.Assignment.prototype.setContainertimeout = function(value) {
  * @private {!Array<number>}
  * @const
  */
-proto.ag.Assignments.repeatedFields_ = [1];
+proto.ag.Reviewers.repeatedFields_ = [1];
 
 
 
@@ -5775,8 +15178,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Assignments.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Assignments.toObject(opt_includeInstance, this);
+proto.ag.Reviewers.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Reviewers.toObject(opt_includeInstance, this);
 };
 
 
@@ -5785,14 +15188,14 @@ proto.ag.Assignments.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Assignments} msg The msg instance to transform.
+ * @param {!proto.ag.Reviewers} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Assignments.toObject = function(includeInstance, msg) {
+proto.ag.Reviewers.toObject = function(includeInstance, msg) {
   var f, obj = {
-    assignmentsList: jspb.Message.toObjectList(msg.getAssignmentsList(),
-    proto.ag.Assignment.toObject, includeInstance)
+    reviewersList: jspb.Message.toObjectList(msg.getReviewersList(),
+    proto.ag.User.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -5806,23 +15209,23 @@ proto.ag.Assignments.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Assignments}
+ * @return {!proto.ag.Reviewers}
  */
-proto.ag.Assignments.deserializeBinary = function(bytes) {
+proto.ag.Reviewers.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Assignments;
-  return proto.ag.Assignments.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.Reviewers;
+  return proto.ag.Reviewers.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Assignments} msg The message object to deserialize into.
+ * @param {!proto.ag.Reviewers} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Assignments}
+ * @return {!proto.ag.Reviewers}
  */
-proto.ag.Assignments.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.Reviewers.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5830,9 +15233,9 @@ proto.ag.Assignments.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.ag.Assignment;
-      reader.readMessage(value,proto.ag.Assignment.deserializeBinaryFromReader);
-      msg.addAssignments(value);
+      var value = new proto.ag.User;
+      reader.readMessage(value,proto.ag.User.deserializeBinaryFromReader);
+      msg.addReviewers(value);
       break;
     default:
       reader.skipField();
@@ -5847,9 +15250,9 @@ proto.ag.Assignments.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Assignments.prototype.serializeBinary = function() {
+proto.ag.Reviewers.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Assignments.serializeBinaryToWriter(this, writer);
+  proto.ag.Reviewers.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5857,69 +15260,62 @@ proto.ag.Assignments.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Assignments} message
+ * @param {!proto.ag.Reviewers} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Assignments.serializeBinaryToWriter = function(message, writer) {
+proto.ag.Reviewers.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getAssignmentsList();
+  f = message.getReviewersList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
       1,
       f,
-      proto.ag.Assignment.serializeBinaryToWriter
+      proto.ag.User.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * repeated Assignment assignments = 1;
- * @return {!Array<!proto.ag.Assignment>}
+ * repeated User reviewers = 1;
+ * @return {!Array<!proto.ag.User>}
  */
-proto.ag.Assignments.prototype.getAssignmentsList = function() {
-  return /** @type{!Array<!proto.ag.Assignment>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.Assignment, 1));
+proto.ag.Reviewers.prototype.getReviewersList = function() {
+  return /** @type{!Array<!proto.ag.User>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.User, 1));
 };
 
 
 /**
- * @param {!Array<!proto.ag.Assignment>} value
- * @return {!proto.ag.Assignments} returns this
+ * @param {!Array<!proto.ag.User>} value
+ * @return {!proto.ag.Reviewers} returns this
 */
-proto.ag.Assignments.prototype.setAssignmentsList = function(value) {
+proto.ag.Reviewers.prototype.setReviewersList = function(value) {
   return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.ag.Assignment=} opt_value
+ * @param {!proto.ag.User=} opt_value
  * @param {number=} opt_index
- * @return {!proto.ag.Assignment}
+ * @return {!proto.ag.User}
  */
-proto.ag.Assignments.prototype.addAssignments = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.Assignment, opt_index);
+proto.ag.Reviewers.prototype.addReviewers = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.User, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.ag.Assignments} returns this
+ * @return {!proto.ag.Reviewers} returns this
  */
-proto.ag.Assignments.prototype.clearAssignmentsList = function() {
-  return this.setAssignmentsList([]);
+proto.ag.Reviewers.prototype.clearReviewersList = function() {
+  return this.setReviewersList([]);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.ag.Submission.repeatedFields_ = [12];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -5935,8 +15331,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Submission.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Submission.toObject(opt_includeInstance, this);
+proto.ag.ReviewRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.ReviewRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -5945,25 +15341,14 @@ proto.ag.Submission.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Submission} msg The msg instance to transform.
+ * @param {!proto.ag.ReviewRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Submission.toObject = function(includeInstance, msg) {
+proto.ag.ReviewRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    userid: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    groupid: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    score: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    scoreobjects: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    buildinfo: jspb.Message.getFieldWithDefault(msg, 7, ""),
-    commithash: jspb.Message.getFieldWithDefault(msg, 8, ""),
-    released: jspb.Message.getBooleanFieldWithDefault(msg, 9, false),
-    status: jspb.Message.getFieldWithDefault(msg, 10, 0),
-    approveddate: jspb.Message.getFieldWithDefault(msg, 11, ""),
-    reviewsList: jspb.Message.toObjectList(msg.getReviewsList(),
-    proto.ag.Review.toObject, includeInstance)
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    review: (f = msg.getReview()) && proto.ag.Review.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -5977,23 +15362,23 @@ proto.ag.Submission.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Submission}
+ * @return {!proto.ag.ReviewRequest}
  */
-proto.ag.Submission.deserializeBinary = function(bytes) {
+proto.ag.ReviewRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Submission;
-  return proto.ag.Submission.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.ReviewRequest;
+  return proto.ag.ReviewRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Submission} msg The message object to deserialize into.
+ * @param {!proto.ag.ReviewRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Submission}
+ * @return {!proto.ag.ReviewRequest}
  */
-proto.ag.Submission.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.ReviewRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6002,52 +15387,12 @@ proto.ag.Submission.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setId(value);
+      msg.setCourseid(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setAssignmentid(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setUserid(value);
-      break;
-    case 4:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setGroupid(value);
-      break;
-    case 5:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setScore(value);
-      break;
-    case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setScoreobjects(value);
-      break;
-    case 7:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setBuildinfo(value);
-      break;
-    case 8:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setCommithash(value);
-      break;
-    case 9:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setReleased(value);
-      break;
-    case 10:
-      var value = /** @type {!proto.ag.Submission.Status} */ (reader.readEnum());
-      msg.setStatus(value);
-      break;
-    case 11:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setApproveddate(value);
-      break;
-    case 12:
       var value = new proto.ag.Review;
       reader.readMessage(value,proto.ag.Review.deserializeBinaryFromReader);
-      msg.addReviews(value);
+      msg.setReview(value);
       break;
     default:
       reader.skipField();
@@ -6062,9 +15407,9 @@ proto.ag.Submission.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Submission.prototype.serializeBinary = function() {
+proto.ag.ReviewRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Submission.serializeBinaryToWriter(this, writer);
+  proto.ag.ReviewRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6072,93 +15417,23 @@ proto.ag.Submission.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Submission} message
+ * @param {!proto.ag.ReviewRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Submission.serializeBinaryToWriter = function(message, writer) {
+proto.ag.ReviewRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getCourseid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getAssignmentid();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getReview();
+  if (f != null) {
+    writer.writeMessage(
       2,
-      f
-    );
-  }
-  f = message.getUserid();
-  if (f !== 0) {
-    writer.writeUint64(
-      3,
-      f
-    );
-  }
-  f = message.getGroupid();
-  if (f !== 0) {
-    writer.writeUint64(
-      4,
-      f
-    );
-  }
-  f = message.getScore();
-  if (f !== 0) {
-    writer.writeUint32(
-      5,
-      f
-    );
-  }
-  f = message.getScoreobjects();
-  if (f.length > 0) {
-    writer.writeString(
-      6,
-      f
-    );
-  }
-  f = message.getBuildinfo();
-  if (f.length > 0) {
-    writer.writeString(
-      7,
-      f
-    );
-  }
-  f = message.getCommithash();
-  if (f.length > 0) {
-    writer.writeString(
-      8,
-      f
-    );
-  }
-  f = message.getReleased();
-  if (f) {
-    writer.writeBool(
-      9,
-      f
-    );
-  }
-  f = message.getStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      10,
-      f
-    );
-  }
-  f = message.getApproveddate();
-  if (f.length > 0) {
-    writer.writeString(
-      11,
-      f
-    );
-  }
-  f = message.getReviewsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      12,
       f,
       proto.ag.Review.serializeBinaryToWriter
     );
@@ -6167,259 +15442,242 @@ proto.ag.Submission.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * @enum {number}
- */
-proto.ag.Submission.Status = {
-  NONE: 0,
-  APPROVED: 1,
-  REJECTED: 2,
-  REVISION: 3
-};
-
-/**
- * optional uint64 ID = 1;
+ * optional uint64 courseID = 1;
  * @return {number}
  */
-proto.ag.Submission.prototype.getId = function() {
+proto.ag.ReviewRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Submission} returns this
+ * @return {!proto.ag.ReviewRequest} returns this
  */
-proto.ag.Submission.prototype.setId = function(value) {
+proto.ag.ReviewRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 assignmentID = 2;
- * @return {number}
- */
-proto.ag.Submission.prototype.getAssignmentid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.ag.Submission} returns this
- */
-proto.ag.Submission.prototype.setAssignmentid = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
-};
-
-
-/**
- * optional uint64 userID = 3;
- * @return {number}
- */
-proto.ag.Submission.prototype.getUserid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.ag.Submission} returns this
- */
-proto.ag.Submission.prototype.setUserid = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
-};
-
-
-/**
- * optional uint64 groupID = 4;
- * @return {number}
- */
-proto.ag.Submission.prototype.getGroupid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.ag.Submission} returns this
- */
-proto.ag.Submission.prototype.setGroupid = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
-};
-
-
-/**
- * optional uint32 score = 5;
- * @return {number}
+ * optional Review review = 2;
+ * @return {?proto.ag.Review}
  */
-proto.ag.Submission.prototype.getScore = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.ag.ReviewRequest.prototype.getReview = function() {
+  return /** @type{?proto.ag.Review} */ (
+    jspb.Message.getWrapperField(this, proto.ag.Review, 2));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.Submission} returns this
- */
-proto.ag.Submission.prototype.setScore = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
+ * @param {?proto.ag.Review|undefined} value
+ * @return {!proto.ag.ReviewRequest} returns this
+*/
+proto.ag.ReviewRequest.prototype.setReview = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
 };
 
 
 /**
- * optional string scoreObjects = 6;
- * @return {string}
+ * Clears the message field making it undefined.
+ * @return {!proto.ag.ReviewRequest} returns this
  */
-proto.ag.Submission.prototype.getScoreobjects = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.ag.ReviewRequest.prototype.clearReview = function() {
+  return this.setReview(undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.Submission} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.ag.Submission.prototype.setScoreobjects = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+proto.ag.ReviewRequest.prototype.hasReview = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
-/**
- * optional string buildInfo = 7;
- * @return {string}
- */
-proto.ag.Submission.prototype.getBuildinfo = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
-};
-
 
-/**
- * @param {string} value
- * @return {!proto.ag.Submission} returns this
- */
-proto.ag.Submission.prototype.setBuildinfo = function(value) {
-  return jspb.Message.setProto3StringField(this, 7, value);
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional string commitHash = 8;
- * @return {string}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.ag.Submission.prototype.getCommithash = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+proto.ag.DeadlineExtensionRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.DeadlineExtensionRequest.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.Submission} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.DeadlineExtensionRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Submission.prototype.setCommithash = function(value) {
-  return jspb.Message.setProto3StringField(this, 8, value);
+proto.ag.DeadlineExtensionRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    extension: (f = msg.getExtension()) && proto.ag.DeadlineExtension.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional bool released = 9;
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.DeadlineExtensionRequest}
  */
-proto.ag.Submission.prototype.getReleased = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 9, false));
+proto.ag.DeadlineExtensionRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.DeadlineExtensionRequest;
+  return proto.ag.DeadlineExtensionRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.ag.Submission} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.DeadlineExtensionRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.DeadlineExtensionRequest}
  */
-proto.ag.Submission.prototype.setReleased = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 9, value);
+proto.ag.DeadlineExtensionRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 2:
+      var value = new proto.ag.DeadlineExtension;
+      reader.readMessage(value,proto.ag.DeadlineExtension.deserializeBinaryFromReader);
+      msg.setExtension(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional Status status = 10;
- * @return {!proto.ag.Submission.Status}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.ag.Submission.prototype.getStatus = function() {
-  return /** @type {!proto.ag.Submission.Status} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+proto.ag.DeadlineExtensionRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.DeadlineExtensionRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {!proto.ag.Submission.Status} value
- * @return {!proto.ag.Submission} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.DeadlineExtensionRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Submission.prototype.setStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 10, value);
+proto.ag.DeadlineExtensionRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getExtension();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.ag.DeadlineExtension.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional string approvedDate = 11;
- * @return {string}
+ * optional uint64 courseID = 1;
+ * @return {number}
  */
-proto.ag.Submission.prototype.getApproveddate = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
+proto.ag.DeadlineExtensionRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.Submission} returns this
+ * @param {number} value
+ * @return {!proto.ag.DeadlineExtensionRequest} returns this
  */
-proto.ag.Submission.prototype.setApproveddate = function(value) {
-  return jspb.Message.setProto3StringField(this, 11, value);
+proto.ag.DeadlineExtensionRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * repeated Review reviews = 12;
- * @return {!Array<!proto.ag.Review>}
+ * optional DeadlineExtension extension = 2;
+ * @return {?proto.ag.DeadlineExtension}
  */
-proto.ag.Submission.prototype.getReviewsList = function() {
-  return /** @type{!Array<!proto.ag.Review>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.Review, 12));
+proto.ag.DeadlineExtensionRequest.prototype.getExtension = function() {
+  return /** @type{?proto.ag.DeadlineExtension} */ (
+    jspb.Message.getWrapperField(this, proto.ag.DeadlineExtension, 2));
 };
 
 
 /**
- * @param {!Array<!proto.ag.Review>} value
- * @return {!proto.ag.Submission} returns this
+ * @param {?proto.ag.DeadlineExtension|undefined} value
+ * @return {!proto.ag.DeadlineExtensionRequest} returns this
 */
-proto.ag.Submission.prototype.setReviewsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 12, value);
+proto.ag.DeadlineExtensionRequest.prototype.setExtension = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
 };
 
 
 /**
- * @param {!proto.ag.Review=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ag.Review}
+ * Clears the message field making it undefined.
+ * @return {!proto.ag.DeadlineExtensionRequest} returns this
  */
-proto.ag.Submission.prototype.addReviews = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 12, opt_value, proto.ag.Review, opt_index);
+proto.ag.DeadlineExtensionRequest.prototype.clearExtension = function() {
+  return this.setExtension(undefined);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.Submission} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.ag.Submission.prototype.clearReviewsList = function() {
-  return this.setReviewsList([]);
+proto.ag.DeadlineExtensionRequest.prototype.hasExtension = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.ag.Submissions.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -6435,8 +15693,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Submissions.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Submissions.toObject(opt_includeInstance, this);
+proto.ag.SlipDaysRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.SlipDaysRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -6445,14 +15703,16 @@ proto.ag.Submissions.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Submissions} msg The msg instance to transform.
+ * @param {!proto.ag.SlipDaysRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Submissions.toObject = function(includeInstance, msg) {
+proto.ag.SlipDaysRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    submissionsList: jspb.Message.toObjectList(msg.getSubmissionsList(),
-    proto.ag.Submission.toObject, includeInstance)
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    changeslipdays: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    slipdays: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    apply: jspb.Message.getBooleanFieldWithDefault(msg, 4, false)
   };
 
   if (includeInstance) {
@@ -6466,23 +15726,23 @@ proto.ag.Submissions.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Submissions}
+ * @return {!proto.ag.SlipDaysRequest}
  */
-proto.ag.Submissions.deserializeBinary = function(bytes) {
+proto.ag.SlipDaysRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Submissions;
-  return proto.ag.Submissions.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.SlipDaysRequest;
+  return proto.ag.SlipDaysRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Submissions} msg The message object to deserialize into.
+ * @param {!proto.ag.SlipDaysRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Submissions}
+ * @return {!proto.ag.SlipDaysRequest}
  */
-proto.ag.Submissions.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.SlipDaysRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6490,9 +15750,20 @@ proto.ag.Submissions.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.ag.Submission;
-      reader.readMessage(value,proto.ag.Submission.deserializeBinaryFromReader);
-      msg.addSubmissions(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setChangeslipdays(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setSlipdays(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setApply(value);
       break;
     default:
       reader.skipField();
@@ -6507,9 +15778,9 @@ proto.ag.Submissions.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Submissions.prototype.serializeBinary = function() {
+proto.ag.SlipDaysRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Submissions.serializeBinaryToWriter(this, writer);
+  proto.ag.SlipDaysRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6517,68 +15788,115 @@ proto.ag.Submissions.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Submissions} message
+ * @param {!proto.ag.SlipDaysRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Submissions.serializeBinaryToWriter = function(message, writer) {
+proto.ag.SlipDaysRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSubmissionsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
       1,
-      f,
-      proto.ag.Submission.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getChangeslipdays();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getSlipdays();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
+    );
+  }
+  f = message.getApply();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
     );
   }
 };
 
 
 /**
- * repeated Submission submissions = 1;
- * @return {!Array<!proto.ag.Submission>}
+ * optional uint64 courseID = 1;
+ * @return {number}
  */
-proto.ag.Submissions.prototype.getSubmissionsList = function() {
-  return /** @type{!Array<!proto.ag.Submission>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.Submission, 1));
+proto.ag.SlipDaysRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {!Array<!proto.ag.Submission>} value
- * @return {!proto.ag.Submissions} returns this
-*/
-proto.ag.Submissions.prototype.setSubmissionsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {number} value
+ * @return {!proto.ag.SlipDaysRequest} returns this
+ */
+proto.ag.SlipDaysRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.ag.Submission=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ag.Submission}
+ * optional bool changeSlipDays = 2;
+ * @return {boolean}
  */
-proto.ag.Submissions.prototype.addSubmissions = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.Submission, opt_index);
+proto.ag.SlipDaysRequest.prototype.getChangeslipdays = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.Submissions} returns this
+ * @param {boolean} value
+ * @return {!proto.ag.SlipDaysRequest} returns this
  */
-proto.ag.Submissions.prototype.clearSubmissionsList = function() {
-  return this.setSubmissionsList([]);
+proto.ag.SlipDaysRequest.prototype.setChangeslipdays = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
 };
 
 
+/**
+ * optional uint32 slipDays = 3;
+ * @return {number}
+ */
+proto.ag.SlipDaysRequest.prototype.getSlipdays = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {number} value
+ * @return {!proto.ag.SlipDaysRequest} returns this
  */
-proto.ag.GradingBenchmark.repeatedFields_ = [5];
+proto.ag.SlipDaysRequest.prototype.setSlipdays = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional bool apply = 4;
+ * @return {boolean}
+ */
+proto.ag.SlipDaysRequest.prototype.getApply = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.SlipDaysRequest} returns this
+ */
+proto.ag.SlipDaysRequest.prototype.setApply = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
 
 
 
@@ -6595,8 +15913,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.GradingBenchmark.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.GradingBenchmark.toObject(opt_includeInstance, this);
+proto.ag.CourseRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.CourseRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -6605,18 +15923,13 @@ proto.ag.GradingBenchmark.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.GradingBenchmark} msg The msg instance to transform.
+ * @param {!proto.ag.CourseRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.GradingBenchmark.toObject = function(includeInstance, msg) {
+proto.ag.CourseRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    heading: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    comment: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    criteriaList: jspb.Message.toObjectList(msg.getCriteriaList(),
-    proto.ag.GradingCriterion.toObject, includeInstance)
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0)
   };
 
   if (includeInstance) {
@@ -6630,23 +15943,23 @@ proto.ag.GradingBenchmark.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.GradingBenchmark}
+ * @return {!proto.ag.CourseRequest}
  */
-proto.ag.GradingBenchmark.deserializeBinary = function(bytes) {
+proto.ag.CourseRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.GradingBenchmark;
-  return proto.ag.GradingBenchmark.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.CourseRequest;
+  return proto.ag.CourseRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.GradingBenchmark} msg The message object to deserialize into.
+ * @param {!proto.ag.CourseRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.GradingBenchmark}
+ * @return {!proto.ag.CourseRequest}
  */
-proto.ag.GradingBenchmark.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.CourseRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6655,24 +15968,7 @@ proto.ag.GradingBenchmark.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setId(value);
-      break;
-    case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setAssignmentid(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setHeading(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setComment(value);
-      break;
-    case 5:
-      var value = new proto.ag.GradingCriterion;
-      reader.readMessage(value,proto.ag.GradingCriterion.deserializeBinaryFromReader);
-      msg.addCriteria(value);
+      msg.setCourseid(value);
       break;
     default:
       reader.skipField();
@@ -6687,9 +15983,9 @@ proto.ag.GradingBenchmark.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.GradingBenchmark.prototype.serializeBinary = function() {
+proto.ag.CourseRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.GradingBenchmark.serializeBinaryToWriter(this, writer);
+  proto.ag.CourseRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6697,169 +15993,171 @@ proto.ag.GradingBenchmark.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.GradingBenchmark} message
+ * @param {!proto.ag.CourseRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.GradingBenchmark.serializeBinaryToWriter = function(message, writer) {
+proto.ag.CourseRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getCourseid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getAssignmentid();
-  if (f !== 0) {
-    writer.writeUint64(
-      2,
-      f
-    );
-  }
-  f = message.getHeading();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
-  f = message.getComment();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getCriteriaList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      5,
-      f,
-      proto.ag.GradingCriterion.serializeBinaryToWriter
-    );
-  }
 };
 
 
 /**
- * optional uint64 ID = 1;
+ * optional uint64 courseID = 1;
  * @return {number}
  */
-proto.ag.GradingBenchmark.prototype.getId = function() {
+proto.ag.CourseRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.GradingBenchmark} returns this
+ * @return {!proto.ag.CourseRequest} returns this
  */
-proto.ag.GradingBenchmark.prototype.setId = function(value) {
+proto.ag.CourseRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
-/**
- * optional uint64 assignmentID = 2;
- * @return {number}
- */
-proto.ag.GradingBenchmark.prototype.getAssignmentid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
 
-/**
- * @param {number} value
- * @return {!proto.ag.GradingBenchmark} returns this
- */
-proto.ag.GradingBenchmark.prototype.setAssignmentid = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional string heading = 3;
- * @return {string}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.ag.GradingBenchmark.prototype.getHeading = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.ag.UserRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.UserRequest.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.GradingBenchmark} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.UserRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.GradingBenchmark.prototype.setHeading = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.ag.UserRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    userid: jspb.Message.getFieldWithDefault(msg, 1, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional string comment = 4;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.UserRequest}
  */
-proto.ag.GradingBenchmark.prototype.getComment = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.ag.UserRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.UserRequest;
+  return proto.ag.UserRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.GradingBenchmark} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.UserRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.UserRequest}
  */
-proto.ag.GradingBenchmark.prototype.setComment = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.ag.UserRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setUserid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * repeated GradingCriterion criteria = 5;
- * @return {!Array<!proto.ag.GradingCriterion>}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.ag.GradingBenchmark.prototype.getCriteriaList = function() {
-  return /** @type{!Array<!proto.ag.GradingCriterion>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.GradingCriterion, 5));
+proto.ag.UserRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.UserRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {!Array<!proto.ag.GradingCriterion>} value
- * @return {!proto.ag.GradingBenchmark} returns this
-*/
-proto.ag.GradingBenchmark.prototype.setCriteriaList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 5, value);
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.UserRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.UserRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUserid();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * @param {!proto.ag.GradingCriterion=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ag.GradingCriterion}
+ * optional uint64 userID = 1;
+ * @return {number}
  */
-proto.ag.GradingBenchmark.prototype.addCriteria = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 5, opt_value, proto.ag.GradingCriterion, opt_index);
+proto.ag.UserRequest.prototype.getUserid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.GradingBenchmark} returns this
+ * @param {number} value
+ * @return {!proto.ag.UserRequest} returns this
  */
-proto.ag.GradingBenchmark.prototype.clearCriteriaList = function() {
-  return this.setCriteriaList([]);
+proto.ag.UserRequest.prototype.setUserid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.ag.Benchmarks.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -6875,8 +16173,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Benchmarks.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Benchmarks.toObject(opt_includeInstance, this);
+proto.ag.GetGroupRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.GetGroupRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -6885,14 +16183,13 @@ proto.ag.Benchmarks.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Benchmarks} msg The msg instance to transform.
+ * @param {!proto.ag.GetGroupRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Benchmarks.toObject = function(includeInstance, msg) {
+proto.ag.GetGroupRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    benchmarksList: jspb.Message.toObjectList(msg.getBenchmarksList(),
-    proto.ag.GradingBenchmark.toObject, includeInstance)
+    groupid: jspb.Message.getFieldWithDefault(msg, 1, 0)
   };
 
   if (includeInstance) {
@@ -6906,23 +16203,23 @@ proto.ag.Benchmarks.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Benchmarks}
+ * @return {!proto.ag.GetGroupRequest}
  */
-proto.ag.Benchmarks.deserializeBinary = function(bytes) {
+proto.ag.GetGroupRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Benchmarks;
-  return proto.ag.Benchmarks.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.GetGroupRequest;
+  return proto.ag.GetGroupRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Benchmarks} msg The message object to deserialize into.
+ * @param {!proto.ag.GetGroupRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Benchmarks}
+ * @return {!proto.ag.GetGroupRequest}
  */
-proto.ag.Benchmarks.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.GetGroupRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6930,9 +16227,8 @@ proto.ag.Benchmarks.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.ag.GradingBenchmark;
-      reader.readMessage(value,proto.ag.GradingBenchmark.deserializeBinaryFromReader);
-      msg.addBenchmarks(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setGroupid(value);
       break;
     default:
       reader.skipField();
@@ -6947,9 +16243,9 @@ proto.ag.Benchmarks.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Benchmarks.prototype.serializeBinary = function() {
+proto.ag.GetGroupRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Benchmarks.serializeBinaryToWriter(this, writer);
+  proto.ag.GetGroupRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6957,58 +16253,37 @@ proto.ag.Benchmarks.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Benchmarks} message
+ * @param {!proto.ag.GetGroupRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Benchmarks.serializeBinaryToWriter = function(message, writer) {
+proto.ag.GetGroupRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getBenchmarksList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = message.getGroupid();
+  if (f !== 0) {
+    writer.writeUint64(
       1,
-      f,
-      proto.ag.GradingBenchmark.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * repeated GradingBenchmark benchmarks = 1;
- * @return {!Array<!proto.ag.GradingBenchmark>}
- */
-proto.ag.Benchmarks.prototype.getBenchmarksList = function() {
-  return /** @type{!Array<!proto.ag.GradingBenchmark>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.GradingBenchmark, 1));
-};
-
-
-/**
- * @param {!Array<!proto.ag.GradingBenchmark>} value
- * @return {!proto.ag.Benchmarks} returns this
-*/
-proto.ag.Benchmarks.prototype.setBenchmarksList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
-};
-
-
-/**
- * @param {!proto.ag.GradingBenchmark=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ag.GradingBenchmark}
+ * optional uint64 groupID = 1;
+ * @return {number}
  */
-proto.ag.Benchmarks.prototype.addBenchmarks = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.GradingBenchmark, opt_index);
+proto.ag.GetGroupRequest.prototype.getGroupid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.Benchmarks} returns this
+ * @param {number} value
+ * @return {!proto.ag.GetGroupRequest} returns this
  */
-proto.ag.Benchmarks.prototype.clearBenchmarksList = function() {
-  return this.setBenchmarksList([]);
+proto.ag.GetGroupRequest.prototype.setGroupid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
@@ -7028,8 +16303,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.GradingCriterion.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.GradingCriterion.toObject(opt_includeInstance, this);
+proto.ag.GroupRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.GroupRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -7038,18 +16313,15 @@ proto.ag.GradingCriterion.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.GradingCriterion} msg The msg instance to transform.
+ * @param {!proto.ag.GroupRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.GradingCriterion.toObject = function(includeInstance, msg) {
+proto.ag.GroupRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    points: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    benchmarkid: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    description: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    grade: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    comment: jspb.Message.getFieldWithDefault(msg, 6, "")
+    userid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    groupid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    courseid: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -7063,23 +16335,23 @@ proto.ag.GradingCriterion.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.GradingCriterion}
+ * @return {!proto.ag.GroupRequest}
  */
-proto.ag.GradingCriterion.deserializeBinary = function(bytes) {
+proto.ag.GroupRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.GradingCriterion;
-  return proto.ag.GradingCriterion.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.GroupRequest;
+  return proto.ag.GroupRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.GradingCriterion} msg The message object to deserialize into.
+ * @param {!proto.ag.GroupRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.GradingCriterion}
+ * @return {!proto.ag.GroupRequest}
  */
-proto.ag.GradingCriterion.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.GroupRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7088,27 +16360,15 @@ proto.ag.GradingCriterion.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setId(value);
+      msg.setUserid(value);
       break;
     case 2:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setPoints(value);
+      msg.setGroupid(value);
       break;
     case 3:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setBenchmarkid(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDescription(value);
-      break;
-    case 5:
-      var value = /** @type {!proto.ag.GradingCriterion.Grade} */ (reader.readEnum());
-      msg.setGrade(value);
-      break;
-    case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setComment(value);
+      msg.setCourseid(value);
       break;
     default:
       reader.skipField();
@@ -7123,9 +16383,9 @@ proto.ag.GradingCriterion.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.GradingCriterion.prototype.serializeBinary = function() {
+proto.ag.GroupRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.GradingCriterion.serializeBinaryToWriter(this, writer);
+  proto.ag.GroupRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7133,182 +16393,351 @@ proto.ag.GradingCriterion.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.GradingCriterion} message
+ * @param {!proto.ag.GroupRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.GradingCriterion.serializeBinaryToWriter = function(message, writer) {
+proto.ag.GroupRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getUserid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getPoints();
+  f = message.getGroupid();
   if (f !== 0) {
     writer.writeUint64(
       2,
       f
     );
   }
-  f = message.getBenchmarkid();
+  f = message.getCourseid();
   if (f !== 0) {
     writer.writeUint64(
       3,
       f
     );
   }
-  f = message.getDescription();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getGrade();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      5,
-      f
-    );
-  }
-  f = message.getComment();
-  if (f.length > 0) {
-    writer.writeString(
-      6,
-      f
-    );
+};
+
+
+/**
+ * optional uint64 userID = 1;
+ * @return {number}
+ */
+proto.ag.GroupRequest.prototype.getUserid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.GroupRequest} returns this
+ */
+proto.ag.GroupRequest.prototype.setUserid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 groupID = 2;
+ * @return {number}
+ */
+proto.ag.GroupRequest.prototype.getGroupid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.GroupRequest} returns this
+ */
+proto.ag.GroupRequest.prototype.setGroupid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 courseID = 3;
+ * @return {number}
+ */
+proto.ag.GroupRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.GroupRequest} returns this
+ */
+proto.ag.GroupRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.Provider.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Provider.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.Provider} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.Provider.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    provider: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
   }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.Provider}
+ */
+proto.ag.Provider.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.Provider;
+  return proto.ag.Provider.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @enum {number}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.Provider} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.Provider}
  */
-proto.ag.GradingCriterion.Grade = {
-  NONE: 0,
-  FAILED: 1,
-  PASSED: 2
+proto.ag.Provider.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setProvider(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
+
 /**
- * optional uint64 ID = 1;
- * @return {number}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.ag.GradingCriterion.prototype.getId = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.ag.Provider.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.Provider.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.GradingCriterion} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.Provider} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.GradingCriterion.prototype.setId = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.ag.Provider.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getProvider();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * optional uint64 points = 2;
- * @return {number}
+ * optional string provider = 1;
+ * @return {string}
  */
-proto.ag.GradingCriterion.prototype.getPoints = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.ag.Provider.prototype.getProvider = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.GradingCriterion} returns this
+ * @param {string} value
+ * @return {!proto.ag.Provider} returns this
  */
-proto.ag.GradingCriterion.prototype.setPoints = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.ag.Provider.prototype.setProvider = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional uint64 benchmarkID = 3;
- * @return {number}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.ag.GradingCriterion.prototype.getBenchmarkid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.ag.OrgRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.OrgRequest.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.GradingCriterion} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.OrgRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.GradingCriterion.prototype.setBenchmarkid = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.ag.OrgRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    orgname: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional string description = 4;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.OrgRequest}
  */
-proto.ag.GradingCriterion.prototype.getDescription = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.ag.OrgRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.OrgRequest;
+  return proto.ag.OrgRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.GradingCriterion} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.OrgRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.OrgRequest}
  */
-proto.ag.GradingCriterion.prototype.setDescription = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.ag.OrgRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOrgname(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional Grade grade = 5;
- * @return {!proto.ag.GradingCriterion.Grade}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.ag.GradingCriterion.prototype.getGrade = function() {
-  return /** @type {!proto.ag.GradingCriterion.Grade} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.ag.OrgRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.OrgRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {!proto.ag.GradingCriterion.Grade} value
- * @return {!proto.ag.GradingCriterion} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.OrgRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.GradingCriterion.prototype.setGrade = function(value) {
-  return jspb.Message.setProto3EnumField(this, 5, value);
+proto.ag.OrgRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getOrgname();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string comment = 6;
+ * optional string orgName = 1;
  * @return {string}
  */
-proto.ag.GradingCriterion.prototype.getComment = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.ag.OrgRequest.prototype.getOrgname = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ag.GradingCriterion} returns this
+ * @return {!proto.ag.OrgRequest} returns this
  */
-proto.ag.GradingCriterion.prototype.setComment = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+proto.ag.OrgRequest.prototype.setOrgname = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.ag.Review.repeatedFields_ = [8];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -7324,8 +16753,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Review.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Review.toObject(opt_includeInstance, this);
+proto.ag.Organization.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Organization.toObject(opt_includeInstance, this);
 };
 
 
@@ -7334,22 +16763,16 @@ proto.ag.Review.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Review} msg The msg instance to transform.
+ * @param {!proto.ag.Organization} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Review.toObject = function(includeInstance, msg) {
+proto.ag.Organization.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    submissionid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    reviewerid: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    review: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    feedback: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    ready: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
-    score: jspb.Message.getFieldWithDefault(msg, 7, 0),
-    benchmarksList: jspb.Message.toObjectList(msg.getBenchmarksList(),
-    proto.ag.GradingBenchmark.toObject, includeInstance),
-    edited: jspb.Message.getFieldWithDefault(msg, 9, "")
+    path: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    avatar: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    paymentplan: jspb.Message.getFieldWithDefault(msg, 4, "")
   };
 
   if (includeInstance) {
@@ -7363,23 +16786,23 @@ proto.ag.Review.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Review}
+ * @return {!proto.ag.Organization}
  */
-proto.ag.Review.deserializeBinary = function(bytes) {
+proto.ag.Organization.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Review;
-  return proto.ag.Review.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.Organization;
+  return proto.ag.Organization.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Review} msg The message object to deserialize into.
+ * @param {!proto.ag.Organization} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Review}
+ * @return {!proto.ag.Organization}
  */
-proto.ag.Review.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.Organization.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7391,37 +16814,16 @@ proto.ag.Review.deserializeBinaryFromReader = function(msg, reader) {
       msg.setId(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setSubmissionid(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setReviewerid(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setReview(value);
-      break;
-    case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setFeedback(value);
-      break;
-    case 6:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setReady(value);
-      break;
-    case 7:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setScore(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPath(value);
       break;
-    case 8:
-      var value = new proto.ag.GradingBenchmark;
-      reader.readMessage(value,proto.ag.GradingBenchmark.deserializeBinaryFromReader);
-      msg.addBenchmarks(value);
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAvatar(value);
       break;
-    case 9:
+    case 4:
       var value = /** @type {string} */ (reader.readString());
-      msg.setEdited(value);
+      msg.setPaymentplan(value);
       break;
     default:
       reader.skipField();
@@ -7436,9 +16838,9 @@ proto.ag.Review.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Review.prototype.serializeBinary = function() {
+proto.ag.Organization.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Review.serializeBinaryToWriter(this, writer);
+  proto.ag.Organization.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7446,11 +16848,11 @@ proto.ag.Review.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Review} message
+ * @param {!proto.ag.Organization} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Review.serializeBinaryToWriter = function(message, writer) {
+proto.ag.Organization.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f !== 0) {
@@ -7459,60 +16861,24 @@ proto.ag.Review.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getSubmissionid();
-  if (f !== 0) {
-    writer.writeUint64(
-      2,
-      f
-    );
-  }
-  f = message.getReviewerid();
-  if (f !== 0) {
-    writer.writeUint64(
-      3,
-      f
-    );
-  }
-  f = message.getReview();
+  f = message.getPath();
   if (f.length > 0) {
     writer.writeString(
-      4,
+      2,
       f
     );
   }
-  f = message.getFeedback();
+  f = message.getAvatar();
   if (f.length > 0) {
     writer.writeString(
-      5,
-      f
-    );
-  }
-  f = message.getReady();
-  if (f) {
-    writer.writeBool(
-      6,
-      f
-    );
-  }
-  f = message.getScore();
-  if (f !== 0) {
-    writer.writeUint64(
-      7,
+      3,
       f
     );
   }
-  f = message.getBenchmarksList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      8,
-      f,
-      proto.ag.GradingBenchmark.serializeBinaryToWriter
-    );
-  }
-  f = message.getEdited();
+  f = message.getPaymentplan();
   if (f.length > 0) {
     writer.writeString(
-      9,
+      4,
       f
     );
   }
@@ -7523,181 +16889,71 @@ proto.ag.Review.serializeBinaryToWriter = function(message, writer) {
  * optional uint64 ID = 1;
  * @return {number}
  */
-proto.ag.Review.prototype.getId = function() {
+proto.ag.Organization.prototype.getId = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Review} returns this
+ * @return {!proto.ag.Organization} returns this
  */
-proto.ag.Review.prototype.setId = function(value) {
+proto.ag.Organization.prototype.setId = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 submissionID = 2;
- * @return {number}
- */
-proto.ag.Review.prototype.getSubmissionid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.ag.Review} returns this
- */
-proto.ag.Review.prototype.setSubmissionid = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
-};
-
-
-/**
- * optional uint64 reviewerID = 3;
- * @return {number}
- */
-proto.ag.Review.prototype.getReviewerid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.ag.Review} returns this
- */
-proto.ag.Review.prototype.setReviewerid = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
-};
-
-
-/**
- * optional string review = 4;
+ * optional string path = 2;
  * @return {string}
  */
-proto.ag.Review.prototype.getReview = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.ag.Organization.prototype.getPath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ag.Review} returns this
+ * @return {!proto.ag.Organization} returns this
  */
-proto.ag.Review.prototype.setReview = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.ag.Organization.prototype.setPath = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional string feedback = 5;
+ * optional string avatar = 3;
  * @return {string}
  */
-proto.ag.Review.prototype.getFeedback = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.ag.Organization.prototype.getAvatar = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ag.Review} returns this
- */
-proto.ag.Review.prototype.setFeedback = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
-};
-
-
-/**
- * optional bool ready = 6;
- * @return {boolean}
- */
-proto.ag.Review.prototype.getReady = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.ag.Review} returns this
- */
-proto.ag.Review.prototype.setReady = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 6, value);
-};
-
-
-/**
- * optional uint64 score = 7;
- * @return {number}
- */
-proto.ag.Review.prototype.getScore = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.ag.Review} returns this
- */
-proto.ag.Review.prototype.setScore = function(value) {
-  return jspb.Message.setProto3IntField(this, 7, value);
-};
-
-
-/**
- * repeated GradingBenchmark benchmarks = 8;
- * @return {!Array<!proto.ag.GradingBenchmark>}
- */
-proto.ag.Review.prototype.getBenchmarksList = function() {
-  return /** @type{!Array<!proto.ag.GradingBenchmark>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.GradingBenchmark, 8));
-};
-
-
-/**
- * @param {!Array<!proto.ag.GradingBenchmark>} value
- * @return {!proto.ag.Review} returns this
-*/
-proto.ag.Review.prototype.setBenchmarksList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 8, value);
-};
-
-
-/**
- * @param {!proto.ag.GradingBenchmark=} opt_value
- * @param {number=} opt_index
- * @return {!proto.ag.GradingBenchmark}
- */
-proto.ag.Review.prototype.addBenchmarks = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 8, opt_value, proto.ag.GradingBenchmark, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.Review} returns this
+ * @return {!proto.ag.Organization} returns this
  */
-proto.ag.Review.prototype.clearBenchmarksList = function() {
-  return this.setBenchmarksList([]);
+proto.ag.Organization.prototype.setAvatar = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * optional string edited = 9;
+ * optional string paymentPlan = 4;
  * @return {string}
  */
-proto.ag.Review.prototype.getEdited = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+proto.ag.Organization.prototype.getPaymentplan = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ag.Review} returns this
+ * @return {!proto.ag.Organization} returns this
  */
-proto.ag.Review.prototype.setEdited = function(value) {
-  return jspb.Message.setProto3StringField(this, 9, value);
+proto.ag.Organization.prototype.setPaymentplan = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
@@ -7707,7 +16963,7 @@ proto.ag.Review.prototype.setEdited = function(value) {
  * @private {!Array<number>}
  * @const
  */
-proto.ag.Reviewers.repeatedFields_ = [1];
+proto.ag.Organizations.repeatedFields_ = [1];
 
 
 
@@ -7724,8 +16980,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Reviewers.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Reviewers.toObject(opt_includeInstance, this);
+proto.ag.Organizations.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Organizations.toObject(opt_includeInstance, this);
 };
 
 
@@ -7734,14 +16990,14 @@ proto.ag.Reviewers.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Reviewers} msg The msg instance to transform.
+ * @param {!proto.ag.Organizations} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Reviewers.toObject = function(includeInstance, msg) {
+proto.ag.Organizations.toObject = function(includeInstance, msg) {
   var f, obj = {
-    reviewersList: jspb.Message.toObjectList(msg.getReviewersList(),
-    proto.ag.User.toObject, includeInstance)
+    organizationsList: jspb.Message.toObjectList(msg.getOrganizationsList(),
+    proto.ag.Organization.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -7755,23 +17011,23 @@ proto.ag.Reviewers.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Reviewers}
+ * @return {!proto.ag.Organizations}
  */
-proto.ag.Reviewers.deserializeBinary = function(bytes) {
+proto.ag.Organizations.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Reviewers;
-  return proto.ag.Reviewers.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.Organizations;
+  return proto.ag.Organizations.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Reviewers} msg The message object to deserialize into.
+ * @param {!proto.ag.Organizations} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Reviewers}
+ * @return {!proto.ag.Organizations}
  */
-proto.ag.Reviewers.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.Organizations.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7779,9 +17035,9 @@ proto.ag.Reviewers.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.ag.User;
-      reader.readMessage(value,proto.ag.User.deserializeBinaryFromReader);
-      msg.addReviewers(value);
+      var value = new proto.ag.Organization;
+      reader.readMessage(value,proto.ag.Organization.deserializeBinaryFromReader);
+      msg.addOrganizations(value);
       break;
     default:
       reader.skipField();
@@ -7796,9 +17052,9 @@ proto.ag.Reviewers.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Reviewers.prototype.serializeBinary = function() {
+proto.ag.Organizations.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Reviewers.serializeBinaryToWriter(this, writer);
+  proto.ag.Organizations.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7806,62 +17062,69 @@ proto.ag.Reviewers.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Reviewers} message
+ * @param {!proto.ag.Organizations} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Reviewers.serializeBinaryToWriter = function(message, writer) {
+proto.ag.Organizations.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getReviewersList();
+  f = message.getOrganizationsList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
       1,
       f,
-      proto.ag.User.serializeBinaryToWriter
+      proto.ag.Organization.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * repeated User reviewers = 1;
- * @return {!Array<!proto.ag.User>}
+ * repeated Organization organizations = 1;
+ * @return {!Array<!proto.ag.Organization>}
  */
-proto.ag.Reviewers.prototype.getReviewersList = function() {
-  return /** @type{!Array<!proto.ag.User>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.User, 1));
+proto.ag.Organizations.prototype.getOrganizationsList = function() {
+  return /** @type{!Array<!proto.ag.Organization>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ag.Organization, 1));
 };
 
 
 /**
- * @param {!Array<!proto.ag.User>} value
- * @return {!proto.ag.Reviewers} returns this
+ * @param {!Array<!proto.ag.Organization>} value
+ * @return {!proto.ag.Organizations} returns this
 */
-proto.ag.Reviewers.prototype.setReviewersList = function(value) {
+proto.ag.Organizations.prototype.setOrganizationsList = function(value) {
   return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.ag.User=} opt_value
+ * @param {!proto.ag.Organization=} opt_value
  * @param {number=} opt_index
- * @return {!proto.ag.User}
+ * @return {!proto.ag.Organization}
  */
-proto.ag.Reviewers.prototype.addReviewers = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.User, opt_index);
+proto.ag.Organizations.prototype.addOrganizations = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.Organization, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.ag.Reviewers} returns this
+ * @return {!proto.ag.Organizations} returns this
  */
-proto.ag.Reviewers.prototype.clearReviewersList = function() {
-  return this.setReviewersList([]);
+proto.ag.Organizations.prototype.clearOrganizationsList = function() {
+  return this.setOrganizationsList([]);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.EnrollmentRequest.repeatedFields_ = [4];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -7877,8 +17140,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.ReviewRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.ReviewRequest.toObject(opt_includeInstance, this);
+proto.ag.EnrollmentRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.EnrollmentRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -7887,14 +17150,16 @@ proto.ag.ReviewRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.ReviewRequest} msg The msg instance to transform.
+ * @param {!proto.ag.EnrollmentRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.ReviewRequest.toObject = function(includeInstance, msg) {
+proto.ag.EnrollmentRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    review: (f = msg.getReview()) && proto.ag.Review.toObject(includeInstance, f)
+    ignoregroupmembers: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    withactivity: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    statusesList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -7908,23 +17173,23 @@ proto.ag.ReviewRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.ReviewRequest}
+ * @return {!proto.ag.EnrollmentRequest}
  */
-proto.ag.ReviewRequest.deserializeBinary = function(bytes) {
+proto.ag.EnrollmentRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.ReviewRequest;
-  return proto.ag.ReviewRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.EnrollmentRequest;
+  return proto.ag.EnrollmentRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.ReviewRequest} msg The message object to deserialize into.
+ * @param {!proto.ag.EnrollmentRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.ReviewRequest}
+ * @return {!proto.ag.EnrollmentRequest}
  */
-proto.ag.ReviewRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.EnrollmentRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7936,9 +17201,18 @@ proto.ag.ReviewRequest.deserializeBinaryFromReader = function(msg, reader) {
       msg.setCourseid(value);
       break;
     case 2:
-      var value = new proto.ag.Review;
-      reader.readMessage(value,proto.ag.Review.deserializeBinaryFromReader);
-      msg.setReview(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIgnoregroupmembers(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setWithactivity(value);
+      break;
+    case 4:
+      var values = /** @type {!Array<!proto.ag.Enrollment.UserStatus>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addStatuses(values[i]);
+      }
       break;
     default:
       reader.skipField();
@@ -7953,9 +17227,9 @@ proto.ag.ReviewRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.ReviewRequest.prototype.serializeBinary = function() {
+proto.ag.EnrollmentRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.ReviewRequest.serializeBinaryToWriter(this, writer);
+  proto.ag.EnrollmentRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7963,11 +17237,11 @@ proto.ag.ReviewRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.ReviewRequest} message
+ * @param {!proto.ag.EnrollmentRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.ReviewRequest.serializeBinaryToWriter = function(message, writer) {
+proto.ag.EnrollmentRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getCourseid();
   if (f !== 0) {
@@ -7976,12 +17250,25 @@ proto.ag.ReviewRequest.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getReview();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getIgnoregroupmembers();
+  if (f) {
+    writer.writeBool(
       2,
-      f,
-      proto.ag.Review.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getWithactivity();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getStatusesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      4,
+      f
     );
   }
 };
@@ -7991,188 +17278,101 @@ proto.ag.ReviewRequest.serializeBinaryToWriter = function(message, writer) {
  * optional uint64 courseID = 1;
  * @return {number}
  */
-proto.ag.ReviewRequest.prototype.getCourseid = function() {
+proto.ag.EnrollmentRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.ReviewRequest} returns this
+ * @return {!proto.ag.EnrollmentRequest} returns this
  */
-proto.ag.ReviewRequest.prototype.setCourseid = function(value) {
+proto.ag.EnrollmentRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional Review review = 2;
- * @return {?proto.ag.Review}
- */
-proto.ag.ReviewRequest.prototype.getReview = function() {
-  return /** @type{?proto.ag.Review} */ (
-    jspb.Message.getWrapperField(this, proto.ag.Review, 2));
-};
-
-
-/**
- * @param {?proto.ag.Review|undefined} value
- * @return {!proto.ag.ReviewRequest} returns this
-*/
-proto.ag.ReviewRequest.prototype.setReview = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.ag.ReviewRequest} returns this
- */
-proto.ag.ReviewRequest.prototype.clearReview = function() {
-  return this.setReview(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
+ * optional bool ignoreGroupMembers = 2;
  * @return {boolean}
  */
-proto.ag.ReviewRequest.prototype.hasReview = function() {
-  return jspb.Message.getField(this, 2) != null;
-};
-
-
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
-/**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
- */
-proto.ag.CourseRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.CourseRequest.toObject(opt_includeInstance, this);
+proto.ag.EnrollmentRequest.prototype.getIgnoregroupmembers = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.ag.CourseRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {boolean} value
+ * @return {!proto.ag.EnrollmentRequest} returns this
  */
-proto.ag.CourseRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.ag.EnrollmentRequest.prototype.setIgnoregroupmembers = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.CourseRequest}
+ * optional bool withActivity = 3;
+ * @return {boolean}
  */
-proto.ag.CourseRequest.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.CourseRequest;
-  return proto.ag.CourseRequest.deserializeBinaryFromReader(msg, reader);
+proto.ag.EnrollmentRequest.prototype.getWithactivity = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.ag.CourseRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.CourseRequest}
+ * @param {boolean} value
+ * @return {!proto.ag.EnrollmentRequest} returns this
  */
-proto.ag.CourseRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.ag.EnrollmentRequest.prototype.setWithactivity = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * repeated Enrollment.UserStatus statuses = 4;
+ * @return {!Array<!proto.ag.Enrollment.UserStatus>}
  */
-proto.ag.CourseRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.ag.CourseRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.ag.EnrollmentRequest.prototype.getStatusesList = function() {
+  return /** @type {!Array<!proto.ag.Enrollment.UserStatus>} */ (jspb.Message.getRepeatedField(this, 4));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.ag.CourseRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {!Array<!proto.ag.Enrollment.UserStatus>} value
+ * @return {!proto.ag.EnrollmentRequest} returns this
  */
-proto.ag.CourseRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getCourseid();
-  if (f !== 0) {
-    writer.writeUint64(
-      1,
-      f
-    );
-  }
+proto.ag.EnrollmentRequest.prototype.setStatusesList = function(value) {
+  return jspb.Message.setField(this, 4, value || []);
 };
 
 
 /**
- * optional uint64 courseID = 1;
- * @return {number}
+ * @param {!proto.ag.Enrollment.UserStatus} value
+ * @param {number=} opt_index
+ * @return {!proto.ag.EnrollmentRequest} returns this
  */
-proto.ag.CourseRequest.prototype.getCourseid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.ag.EnrollmentRequest.prototype.addStatuses = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.CourseRequest} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.EnrollmentRequest} returns this
  */
-proto.ag.CourseRequest.prototype.setCourseid = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.ag.EnrollmentRequest.prototype.clearStatusesList = function() {
+  return this.setStatusesList([]);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.EnrollmentStatusRequest.repeatedFields_ = [2];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -8188,8 +17388,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.UserRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.UserRequest.toObject(opt_includeInstance, this);
+proto.ag.EnrollmentStatusRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.EnrollmentStatusRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -8198,13 +17398,14 @@ proto.ag.UserRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.UserRequest} msg The msg instance to transform.
+ * @param {!proto.ag.EnrollmentStatusRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.UserRequest.toObject = function(includeInstance, msg) {
+proto.ag.EnrollmentStatusRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    userid: jspb.Message.getFieldWithDefault(msg, 1, 0)
+    userid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    statusesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -8218,23 +17419,23 @@ proto.ag.UserRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.UserRequest}
+ * @return {!proto.ag.EnrollmentStatusRequest}
  */
-proto.ag.UserRequest.deserializeBinary = function(bytes) {
+proto.ag.EnrollmentStatusRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.UserRequest;
-  return proto.ag.UserRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.EnrollmentStatusRequest;
+  return proto.ag.EnrollmentStatusRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.UserRequest} msg The message object to deserialize into.
+ * @param {!proto.ag.EnrollmentStatusRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.UserRequest}
+ * @return {!proto.ag.EnrollmentStatusRequest}
  */
-proto.ag.UserRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.EnrollmentStatusRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8245,6 +17446,12 @@ proto.ag.UserRequest.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {number} */ (reader.readUint64());
       msg.setUserid(value);
       break;
+    case 2:
+      var values = /** @type {!Array<!proto.ag.Enrollment.UserStatus>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addStatuses(values[i]);
+      }
+      break;
     default:
       reader.skipField();
       break;
@@ -8258,9 +17465,9 @@ proto.ag.UserRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.UserRequest.prototype.serializeBinary = function() {
+proto.ag.EnrollmentStatusRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.UserRequest.serializeBinaryToWriter(this, writer);
+  proto.ag.EnrollmentStatusRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8268,11 +17475,11 @@ proto.ag.UserRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.UserRequest} message
+ * @param {!proto.ag.EnrollmentStatusRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.UserRequest.serializeBinaryToWriter = function(message, writer) {
+proto.ag.EnrollmentStatusRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getUserid();
   if (f !== 0) {
@@ -8281,6 +17488,13 @@ proto.ag.UserRequest.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getStatusesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      2,
+      f
+    );
+  }
 };
 
 
@@ -8288,20 +17502,57 @@ proto.ag.UserRequest.serializeBinaryToWriter = function(message, writer) {
  * optional uint64 userID = 1;
  * @return {number}
  */
-proto.ag.UserRequest.prototype.getUserid = function() {
+proto.ag.EnrollmentStatusRequest.prototype.getUserid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.UserRequest} returns this
+ * @return {!proto.ag.EnrollmentStatusRequest} returns this
  */
-proto.ag.UserRequest.prototype.setUserid = function(value) {
+proto.ag.EnrollmentStatusRequest.prototype.setUserid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
+/**
+ * repeated Enrollment.UserStatus statuses = 2;
+ * @return {!Array<!proto.ag.Enrollment.UserStatus>}
+ */
+proto.ag.EnrollmentStatusRequest.prototype.getStatusesList = function() {
+  return /** @type {!Array<!proto.ag.Enrollment.UserStatus>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.ag.Enrollment.UserStatus>} value
+ * @return {!proto.ag.EnrollmentStatusRequest} returns this
+ */
+proto.ag.EnrollmentStatusRequest.prototype.setStatusesList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {!proto.ag.Enrollment.UserStatus} value
+ * @param {number=} opt_index
+ * @return {!proto.ag.EnrollmentStatusRequest} returns this
+ */
+proto.ag.EnrollmentStatusRequest.prototype.addStatuses = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.EnrollmentStatusRequest} returns this
+ */
+proto.ag.EnrollmentStatusRequest.prototype.clearStatusesList = function() {
+  return this.setStatusesList([]);
+};
+
+
 
 
 
@@ -8318,8 +17569,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.GetGroupRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.GetGroupRequest.toObject(opt_includeInstance, this);
+proto.ag.SubmissionRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.SubmissionRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -8328,13 +17579,15 @@ proto.ag.GetGroupRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.GetGroupRequest} msg The msg instance to transform.
+ * @param {!proto.ag.SubmissionRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.GetGroupRequest.toObject = function(includeInstance, msg) {
+proto.ag.SubmissionRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    groupid: jspb.Message.getFieldWithDefault(msg, 1, 0)
+    userid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    groupid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    courseid: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -8348,23 +17601,23 @@ proto.ag.GetGroupRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.GetGroupRequest}
+ * @return {!proto.ag.SubmissionRequest}
  */
-proto.ag.GetGroupRequest.deserializeBinary = function(bytes) {
+proto.ag.SubmissionRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.GetGroupRequest;
-  return proto.ag.GetGroupRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.SubmissionRequest;
+  return proto.ag.SubmissionRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.GetGroupRequest} msg The message object to deserialize into.
+ * @param {!proto.ag.SubmissionRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.GetGroupRequest}
+ * @return {!proto.ag.SubmissionRequest}
  */
-proto.ag.GetGroupRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.SubmissionRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8372,9 +17625,17 @@ proto.ag.GetGroupRequest.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setUserid(value);
+      break;
+    case 2:
       var value = /** @type {number} */ (reader.readUint64());
       msg.setGroupid(value);
       break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -8388,9 +17649,9 @@ proto.ag.GetGroupRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.GetGroupRequest.prototype.serializeBinary = function() {
+proto.ag.SubmissionRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.GetGroupRequest.serializeBinaryToWriter(this, writer);
+  proto.ag.SubmissionRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8398,40 +17659,90 @@ proto.ag.GetGroupRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.GetGroupRequest} message
+ * @param {!proto.ag.SubmissionRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.GetGroupRequest.serializeBinaryToWriter = function(message, writer) {
+proto.ag.SubmissionRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getGroupid();
+  f = message.getUserid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
+  f = message.getGroupid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * optional uint64 groupID = 1;
+ * optional uint64 userID = 1;
  * @return {number}
  */
-proto.ag.GetGroupRequest.prototype.getGroupid = function() {
+proto.ag.SubmissionRequest.prototype.getUserid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.GetGroupRequest} returns this
+ * @return {!proto.ag.SubmissionRequest} returns this
  */
-proto.ag.GetGroupRequest.prototype.setGroupid = function(value) {
+proto.ag.SubmissionRequest.prototype.setUserid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
+/**
+ * optional uint64 groupID = 2;
+ * @return {number}
+ */
+proto.ag.SubmissionRequest.prototype.getGroupid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.SubmissionRequest} returns this
+ */
+proto.ag.SubmissionRequest.prototype.setGroupid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 courseID = 3;
+ * @return {number}
+ */
+proto.ag.SubmissionRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.SubmissionRequest} returns this
+ */
+proto.ag.SubmissionRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
 
 
 
@@ -8448,8 +17759,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.GroupRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.GroupRequest.toObject(opt_includeInstance, this);
+proto.ag.UpdateSubmissionRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.UpdateSubmissionRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -8458,15 +17769,17 @@ proto.ag.GroupRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.GroupRequest} msg The msg instance to transform.
+ * @param {!proto.ag.UpdateSubmissionRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.GroupRequest.toObject = function(includeInstance, msg) {
+proto.ag.UpdateSubmissionRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    userid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    groupid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    courseid: jspb.Message.getFieldWithDefault(msg, 3, 0)
+    submissionid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    courseid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    score: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    released: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    status: jspb.Message.getFieldWithDefault(msg, 5, 0)
   };
 
   if (includeInstance) {
@@ -8480,23 +17793,23 @@ proto.ag.GroupRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.GroupRequest}
+ * @return {!proto.ag.UpdateSubmissionRequest}
  */
-proto.ag.GroupRequest.deserializeBinary = function(bytes) {
+proto.ag.UpdateSubmissionRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.GroupRequest;
-  return proto.ag.GroupRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.UpdateSubmissionRequest;
+  return proto.ag.UpdateSubmissionRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.GroupRequest} msg The message object to deserialize into.
+ * @param {!proto.ag.UpdateSubmissionRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.GroupRequest}
+ * @return {!proto.ag.UpdateSubmissionRequest}
  */
-proto.ag.GroupRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.UpdateSubmissionRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8505,15 +17818,23 @@ proto.ag.GroupRequest.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setUserid(value);
+      msg.setSubmissionid(value);
       break;
     case 2:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setGroupid(value);
+      msg.setCourseid(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setScore(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setReleased(value);
+      break;
+    case 5:
+      var value = /** @type {!proto.ag.Submission.Status} */ (reader.readEnum());
+      msg.setStatus(value);
       break;
     default:
       reader.skipField();
@@ -8528,9 +17849,9 @@ proto.ag.GroupRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.GroupRequest.prototype.serializeBinary = function() {
+proto.ag.UpdateSubmissionRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.GroupRequest.serializeBinaryToWriter(this, writer);
+  proto.ag.UpdateSubmissionRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8538,90 +17859,140 @@ proto.ag.GroupRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.GroupRequest} message
+ * @param {!proto.ag.UpdateSubmissionRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.GroupRequest.serializeBinaryToWriter = function(message, writer) {
+proto.ag.UpdateSubmissionRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUserid();
+  f = message.getSubmissionid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getGroupid();
+  f = message.getCourseid();
   if (f !== 0) {
     writer.writeUint64(
       2,
       f
     );
   }
-  f = message.getCourseid();
+  f = message.getScore();
   if (f !== 0) {
-    writer.writeUint64(
+    writer.writeUint32(
       3,
       f
     );
   }
+  f = message.getReleased();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      5,
+      f
+    );
+  }
 };
 
 
 /**
- * optional uint64 userID = 1;
+ * optional uint64 submissionID = 1;
  * @return {number}
  */
-proto.ag.GroupRequest.prototype.getUserid = function() {
+proto.ag.UpdateSubmissionRequest.prototype.getSubmissionid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.GroupRequest} returns this
+ * @return {!proto.ag.UpdateSubmissionRequest} returns this
  */
-proto.ag.GroupRequest.prototype.setUserid = function(value) {
+proto.ag.UpdateSubmissionRequest.prototype.setSubmissionid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 groupID = 2;
+ * optional uint64 courseID = 2;
  * @return {number}
  */
-proto.ag.GroupRequest.prototype.getGroupid = function() {
+proto.ag.UpdateSubmissionRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.GroupRequest} returns this
+ * @return {!proto.ag.UpdateSubmissionRequest} returns this
  */
-proto.ag.GroupRequest.prototype.setGroupid = function(value) {
+proto.ag.UpdateSubmissionRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional uint64 courseID = 3;
+ * optional uint32 score = 3;
  * @return {number}
  */
-proto.ag.GroupRequest.prototype.getCourseid = function() {
+proto.ag.UpdateSubmissionRequest.prototype.getScore = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.GroupRequest} returns this
+ * @return {!proto.ag.UpdateSubmissionRequest} returns this
  */
-proto.ag.GroupRequest.prototype.setCourseid = function(value) {
+proto.ag.UpdateSubmissionRequest.prototype.setScore = function(value) {
   return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
+/**
+ * optional bool released = 4;
+ * @return {boolean}
+ */
+proto.ag.UpdateSubmissionRequest.prototype.getReleased = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.UpdateSubmissionRequest} returns this
+ */
+proto.ag.UpdateSubmissionRequest.prototype.setReleased = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
+/**
+ * optional Submission.Status status = 5;
+ * @return {!proto.ag.Submission.Status}
+ */
+proto.ag.UpdateSubmissionRequest.prototype.getStatus = function() {
+  return /** @type {!proto.ag.Submission.Status} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {!proto.ag.Submission.Status} value
+ * @return {!proto.ag.UpdateSubmissionRequest} returns this
+ */
+proto.ag.UpdateSubmissionRequest.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 5, value);
+};
+
+
 
 
 
@@ -8638,8 +18009,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Provider.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Provider.toObject(opt_includeInstance, this);
+proto.ag.UpdateSubmissionsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.UpdateSubmissionsRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -8648,13 +18019,17 @@ proto.ag.Provider.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Provider} msg The msg instance to transform.
+ * @param {!proto.ag.UpdateSubmissionsRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Provider.toObject = function(includeInstance, msg) {
+proto.ag.UpdateSubmissionsRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    provider: jspb.Message.getFieldWithDefault(msg, 1, "")
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    scorelimit: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    release: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    approve: jspb.Message.getBooleanFieldWithDefault(msg, 5, false)
   };
 
   if (includeInstance) {
@@ -8668,23 +18043,23 @@ proto.ag.Provider.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Provider}
+ * @return {!proto.ag.UpdateSubmissionsRequest}
  */
-proto.ag.Provider.deserializeBinary = function(bytes) {
+proto.ag.UpdateSubmissionsRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Provider;
-  return proto.ag.Provider.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.UpdateSubmissionsRequest;
+  return proto.ag.UpdateSubmissionsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Provider} msg The message object to deserialize into.
+ * @param {!proto.ag.UpdateSubmissionsRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Provider}
+ * @return {!proto.ag.UpdateSubmissionsRequest}
  */
-proto.ag.Provider.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.UpdateSubmissionsRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8692,8 +18067,24 @@ proto.ag.Provider.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setProvider(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setScorelimit(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setRelease(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setApprove(value);
       break;
     default:
       reader.skipField();
@@ -8705,50 +18096,150 @@ proto.ag.Provider.deserializeBinaryFromReader = function(msg, reader) {
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.UpdateSubmissionsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.UpdateSubmissionsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.UpdateSubmissionsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.UpdateSubmissionsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getScorelimit();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
+    );
+  }
+  f = message.getRelease();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+  f = message.getApprove();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 courseID = 1;
+ * @return {number}
+ */
+proto.ag.UpdateSubmissionsRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.UpdateSubmissionsRequest} returns this
+ */
+proto.ag.UpdateSubmissionsRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 assignmentID = 2;
+ * @return {number}
+ */
+proto.ag.UpdateSubmissionsRequest.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.UpdateSubmissionsRequest} returns this
+ */
+proto.ag.UpdateSubmissionsRequest.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint32 scoreLimit = 3;
+ * @return {number}
+ */
+proto.ag.UpdateSubmissionsRequest.prototype.getScorelimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.UpdateSubmissionsRequest} returns this
  */
-proto.ag.Provider.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.ag.Provider.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.ag.UpdateSubmissionsRequest.prototype.setScorelimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Provider} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional bool release = 4;
+ * @return {boolean}
  */
-proto.ag.Provider.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getProvider();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
+proto.ag.UpdateSubmissionsRequest.prototype.getRelease = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
 };
 
 
 /**
- * optional string provider = 1;
- * @return {string}
+ * @param {boolean} value
+ * @return {!proto.ag.UpdateSubmissionsRequest} returns this
  */
-proto.ag.Provider.prototype.getProvider = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.ag.UpdateSubmissionsRequest.prototype.setRelease = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.Provider} returns this
+ * optional bool approve = 5;
+ * @return {boolean}
  */
-proto.ag.Provider.prototype.setProvider = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.ag.UpdateSubmissionsRequest.prototype.getApprove = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.UpdateSubmissionsRequest} returns this
+ */
+proto.ag.UpdateSubmissionsRequest.prototype.setApprove = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
 };
 
 
@@ -8768,8 +18259,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.OrgRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.OrgRequest.toObject(opt_includeInstance, this);
+proto.ag.SubmissionAttemptRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.SubmissionAttemptRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -8778,13 +18269,15 @@ proto.ag.OrgRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.OrgRequest} msg The msg instance to transform.
+ * @param {!proto.ag.SubmissionAttemptRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.OrgRequest.toObject = function(includeInstance, msg) {
+proto.ag.SubmissionAttemptRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    orgname: jspb.Message.getFieldWithDefault(msg, 1, "")
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    submissionid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    attemptid: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -8798,23 +18291,23 @@ proto.ag.OrgRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.OrgRequest}
+ * @return {!proto.ag.SubmissionAttemptRequest}
  */
-proto.ag.OrgRequest.deserializeBinary = function(bytes) {
+proto.ag.SubmissionAttemptRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.OrgRequest;
-  return proto.ag.OrgRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.SubmissionAttemptRequest;
+  return proto.ag.SubmissionAttemptRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.OrgRequest} msg The message object to deserialize into.
+ * @param {!proto.ag.SubmissionAttemptRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.OrgRequest}
+ * @return {!proto.ag.SubmissionAttemptRequest}
  */
-proto.ag.OrgRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.SubmissionAttemptRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8822,8 +18315,16 @@ proto.ag.OrgRequest.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setOrgname(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setSubmissionid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAttemptid(value);
       break;
     default:
       reader.skipField();
@@ -8838,9 +18339,9 @@ proto.ag.OrgRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.OrgRequest.prototype.serializeBinary = function() {
+proto.ag.SubmissionAttemptRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.OrgRequest.serializeBinaryToWriter(this, writer);
+  proto.ag.SubmissionAttemptRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8848,37 +18349,87 @@ proto.ag.OrgRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.OrgRequest} message
+ * @param {!proto.ag.SubmissionAttemptRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.OrgRequest.serializeBinaryToWriter = function(message, writer) {
+proto.ag.SubmissionAttemptRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getOrgname();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
       1,
       f
     );
   }
+  f = message.getSubmissionid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getAttemptid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string orgName = 1;
- * @return {string}
+ * optional uint64 courseID = 1;
+ * @return {number}
  */
-proto.ag.OrgRequest.prototype.getOrgname = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.ag.SubmissionAttemptRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.OrgRequest} returns this
+ * @param {number} value
+ * @return {!proto.ag.SubmissionAttemptRequest} returns this
  */
-proto.ag.OrgRequest.prototype.setOrgname = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.ag.SubmissionAttemptRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 submissionID = 2;
+ * @return {number}
+ */
+proto.ag.SubmissionAttemptRequest.prototype.getSubmissionid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.SubmissionAttemptRequest} returns this
+ */
+proto.ag.SubmissionAttemptRequest.prototype.setSubmissionid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 attemptID = 3;
+ * @return {number}
+ */
+proto.ag.SubmissionAttemptRequest.prototype.getAttemptid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.SubmissionAttemptRequest} returns this
+ */
+proto.ag.SubmissionAttemptRequest.prototype.setAttemptid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
@@ -8898,8 +18449,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Organization.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Organization.toObject(opt_includeInstance, this);
+proto.ag.SubmissionReviewersRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.SubmissionReviewersRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -8908,16 +18459,14 @@ proto.ag.Organization.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Organization} msg The msg instance to transform.
+ * @param {!proto.ag.SubmissionReviewersRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Organization.toObject = function(includeInstance, msg) {
+proto.ag.SubmissionReviewersRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    path: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    avatar: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    paymentplan: jspb.Message.getFieldWithDefault(msg, 4, "")
+    submissionid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    courseid: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -8931,23 +18480,23 @@ proto.ag.Organization.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Organization}
+ * @return {!proto.ag.SubmissionReviewersRequest}
  */
-proto.ag.Organization.deserializeBinary = function(bytes) {
+proto.ag.SubmissionReviewersRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Organization;
-  return proto.ag.Organization.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.SubmissionReviewersRequest;
+  return proto.ag.SubmissionReviewersRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Organization} msg The message object to deserialize into.
+ * @param {!proto.ag.SubmissionReviewersRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Organization}
+ * @return {!proto.ag.SubmissionReviewersRequest}
  */
-proto.ag.Organization.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.SubmissionReviewersRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8956,19 +18505,11 @@ proto.ag.Organization.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setId(value);
+      msg.setSubmissionid(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPath(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setAvatar(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPaymentplan(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
       break;
     default:
       reader.skipField();
@@ -8983,9 +18524,9 @@ proto.ag.Organization.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Organization.prototype.serializeBinary = function() {
+proto.ag.SubmissionReviewersRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Organization.serializeBinaryToWriter(this, writer);
+  proto.ag.SubmissionReviewersRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8993,112 +18534,62 @@ proto.ag.Organization.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Organization} message
+ * @param {!proto.ag.SubmissionReviewersRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Organization.serializeBinaryToWriter = function(message, writer) {
+proto.ag.SubmissionReviewersRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getSubmissionid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getPath();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
       2,
       f
     );
   }
-  f = message.getAvatar();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
-  f = message.getPaymentplan();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
 };
 
 
 /**
- * optional uint64 ID = 1;
+ * optional uint64 submissionID = 1;
  * @return {number}
  */
-proto.ag.Organization.prototype.getId = function() {
+proto.ag.SubmissionReviewersRequest.prototype.getSubmissionid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.Organization} returns this
- */
-proto.ag.Organization.prototype.setId = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
-};
-
-
-/**
- * optional string path = 2;
- * @return {string}
- */
-proto.ag.Organization.prototype.getPath = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.ag.Organization} returns this
- */
-proto.ag.Organization.prototype.setPath = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
-/**
- * optional string avatar = 3;
- * @return {string}
- */
-proto.ag.Organization.prototype.getAvatar = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.ag.Organization} returns this
+ * @param {number} value
+ * @return {!proto.ag.SubmissionReviewersRequest} returns this
  */
-proto.ag.Organization.prototype.setAvatar = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.ag.SubmissionReviewersRequest.prototype.setSubmissionid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional string paymentPlan = 4;
- * @return {string}
+ * optional uint64 courseID = 2;
+ * @return {number}
  */
-proto.ag.Organization.prototype.getPaymentplan = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.ag.SubmissionReviewersRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.Organization} returns this
+ * @param {number} value
+ * @return {!proto.ag.SubmissionReviewersRequest} returns this
  */
-proto.ag.Organization.prototype.setPaymentplan = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.ag.SubmissionReviewersRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
@@ -9108,7 +18599,7 @@ proto.ag.Organization.prototype.setPaymentplan = function(value) {
  * @private {!Array<number>}
  * @const
  */
-proto.ag.Organizations.repeatedFields_ = [1];
+proto.ag.Providers.repeatedFields_ = [1];
 
 
 
@@ -9125,8 +18616,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Organizations.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Organizations.toObject(opt_includeInstance, this);
+proto.ag.Providers.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Providers.toObject(opt_includeInstance, this);
 };
 
 
@@ -9135,14 +18626,13 @@ proto.ag.Organizations.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Organizations} msg The msg instance to transform.
+ * @param {!proto.ag.Providers} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Organizations.toObject = function(includeInstance, msg) {
+proto.ag.Providers.toObject = function(includeInstance, msg) {
   var f, obj = {
-    organizationsList: jspb.Message.toObjectList(msg.getOrganizationsList(),
-    proto.ag.Organization.toObject, includeInstance)
+    providersList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -9156,23 +18646,23 @@ proto.ag.Organizations.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Organizations}
+ * @return {!proto.ag.Providers}
  */
-proto.ag.Organizations.deserializeBinary = function(bytes) {
+proto.ag.Providers.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Organizations;
-  return proto.ag.Organizations.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.Providers;
+  return proto.ag.Providers.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Organizations} msg The message object to deserialize into.
+ * @param {!proto.ag.Providers} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Organizations}
+ * @return {!proto.ag.Providers}
  */
-proto.ag.Organizations.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.Providers.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9180,9 +18670,8 @@ proto.ag.Organizations.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.ag.Organization;
-      reader.readMessage(value,proto.ag.Organization.deserializeBinaryFromReader);
-      msg.addOrganizations(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.addProviders(value);
       break;
     default:
       reader.skipField();
@@ -9197,9 +18686,9 @@ proto.ag.Organizations.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Organizations.prototype.serializeBinary = function() {
+proto.ag.Providers.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Organizations.serializeBinaryToWriter(this, writer);
+  proto.ag.Providers.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9207,58 +18696,56 @@ proto.ag.Organizations.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Organizations} message
+ * @param {!proto.ag.Providers} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Organizations.serializeBinaryToWriter = function(message, writer) {
+proto.ag.Providers.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getOrganizationsList();
+  f = message.getProvidersList();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeRepeatedString(
       1,
-      f,
-      proto.ag.Organization.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * repeated Organization organizations = 1;
- * @return {!Array<!proto.ag.Organization>}
+ * repeated string providers = 1;
+ * @return {!Array<string>}
  */
-proto.ag.Organizations.prototype.getOrganizationsList = function() {
-  return /** @type{!Array<!proto.ag.Organization>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.ag.Organization, 1));
+proto.ag.Providers.prototype.getProvidersList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * @param {!Array<!proto.ag.Organization>} value
- * @return {!proto.ag.Organizations} returns this
-*/
-proto.ag.Organizations.prototype.setOrganizationsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {!Array<string>} value
+ * @return {!proto.ag.Providers} returns this
+ */
+proto.ag.Providers.prototype.setProvidersList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
- * @param {!proto.ag.Organization=} opt_value
+ * @param {string} value
  * @param {number=} opt_index
- * @return {!proto.ag.Organization}
+ * @return {!proto.ag.Providers} returns this
  */
-proto.ag.Organizations.prototype.addOrganizations = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ag.Organization, opt_index);
+proto.ag.Providers.prototype.addProviders = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.ag.Organizations} returns this
+ * @return {!proto.ag.Providers} returns this
  */
-proto.ag.Organizations.prototype.clearOrganizationsList = function() {
-  return this.setOrganizationsList([]);
+proto.ag.Providers.prototype.clearProvidersList = function() {
+  return this.setProvidersList([]);
 };
 
 
@@ -9268,7 +18755,7 @@ proto.ag.Organizations.prototype.clearOrganizationsList = function() {
  * @private {!Array<number>}
  * @const
  */
-proto.ag.EnrollmentRequest.repeatedFields_ = [4];
+proto.ag.URLRequest.repeatedFields_ = [2];
 
 
 
@@ -9285,8 +18772,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.EnrollmentRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.EnrollmentRequest.toObject(opt_includeInstance, this);
+proto.ag.URLRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.URLRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -9295,16 +18782,14 @@ proto.ag.EnrollmentRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.EnrollmentRequest} msg The msg instance to transform.
+ * @param {!proto.ag.URLRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.EnrollmentRequest.toObject = function(includeInstance, msg) {
+proto.ag.URLRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    ignoregroupmembers: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
-    withactivity: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
-    statusesList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f
+    repotypesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -9318,23 +18803,23 @@ proto.ag.EnrollmentRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.EnrollmentRequest}
+ * @return {!proto.ag.URLRequest}
  */
-proto.ag.EnrollmentRequest.deserializeBinary = function(bytes) {
+proto.ag.URLRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.EnrollmentRequest;
-  return proto.ag.EnrollmentRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.URLRequest;
+  return proto.ag.URLRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.EnrollmentRequest} msg The message object to deserialize into.
+ * @param {!proto.ag.URLRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.EnrollmentRequest}
+ * @return {!proto.ag.URLRequest}
  */
-proto.ag.EnrollmentRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.URLRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9346,17 +18831,9 @@ proto.ag.EnrollmentRequest.deserializeBinaryFromReader = function(msg, reader) {
       msg.setCourseid(value);
       break;
     case 2:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIgnoregroupmembers(value);
-      break;
-    case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setWithactivity(value);
-      break;
-    case 4:
-      var values = /** @type {!Array<!proto.ag.Enrollment.UserStatus>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
+      var values = /** @type {!Array<!proto.ag.Repository.Type>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
       for (var i = 0; i < values.length; i++) {
-        msg.addStatuses(values[i]);
+        msg.addRepotypes(values[i]);
       }
       break;
     default:
@@ -9372,9 +18849,9 @@ proto.ag.EnrollmentRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.EnrollmentRequest.prototype.serializeBinary = function() {
+proto.ag.URLRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.EnrollmentRequest.serializeBinaryToWriter(this, writer);
+  proto.ag.URLRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9382,11 +18859,11 @@ proto.ag.EnrollmentRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.EnrollmentRequest} message
+ * @param {!proto.ag.URLRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.EnrollmentRequest.serializeBinaryToWriter = function(message, writer) {
+proto.ag.URLRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getCourseid();
   if (f !== 0) {
@@ -9395,24 +18872,10 @@ proto.ag.EnrollmentRequest.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getIgnoregroupmembers();
-  if (f) {
-    writer.writeBool(
-      2,
-      f
-    );
-  }
-  f = message.getWithactivity();
-  if (f) {
-    writer.writeBool(
-      3,
-      f
-    );
-  }
-  f = message.getStatusesList();
+  f = message.getRepotypesList();
   if (f.length > 0) {
     writer.writePackedEnum(
-      4,
+      2,
       f
     );
   }
@@ -9423,101 +18886,58 @@ proto.ag.EnrollmentRequest.serializeBinaryToWriter = function(message, writer) {
  * optional uint64 courseID = 1;
  * @return {number}
  */
-proto.ag.EnrollmentRequest.prototype.getCourseid = function() {
+proto.ag.URLRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.EnrollmentRequest} returns this
+ * @return {!proto.ag.URLRequest} returns this
  */
-proto.ag.EnrollmentRequest.prototype.setCourseid = function(value) {
+proto.ag.URLRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional bool ignoreGroupMembers = 2;
- * @return {boolean}
- */
-proto.ag.EnrollmentRequest.prototype.getIgnoregroupmembers = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.ag.EnrollmentRequest} returns this
- */
-proto.ag.EnrollmentRequest.prototype.setIgnoregroupmembers = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 2, value);
-};
-
-
-/**
- * optional bool withActivity = 3;
- * @return {boolean}
- */
-proto.ag.EnrollmentRequest.prototype.getWithactivity = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.ag.EnrollmentRequest} returns this
- */
-proto.ag.EnrollmentRequest.prototype.setWithactivity = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 3, value);
-};
-
-
-/**
- * repeated Enrollment.UserStatus statuses = 4;
- * @return {!Array<!proto.ag.Enrollment.UserStatus>}
+ * repeated Repository.Type repoTypes = 2;
+ * @return {!Array<!proto.ag.Repository.Type>}
  */
-proto.ag.EnrollmentRequest.prototype.getStatusesList = function() {
-  return /** @type {!Array<!proto.ag.Enrollment.UserStatus>} */ (jspb.Message.getRepeatedField(this, 4));
+proto.ag.URLRequest.prototype.getRepotypesList = function() {
+  return /** @type {!Array<!proto.ag.Repository.Type>} */ (jspb.Message.getRepeatedField(this, 2));
 };
 
 
 /**
- * @param {!Array<!proto.ag.Enrollment.UserStatus>} value
- * @return {!proto.ag.EnrollmentRequest} returns this
+ * @param {!Array<!proto.ag.Repository.Type>} value
+ * @return {!proto.ag.URLRequest} returns this
  */
-proto.ag.EnrollmentRequest.prototype.setStatusesList = function(value) {
-  return jspb.Message.setField(this, 4, value || []);
+proto.ag.URLRequest.prototype.setRepotypesList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
 };
 
 
 /**
- * @param {!proto.ag.Enrollment.UserStatus} value
+ * @param {!proto.ag.Repository.Type} value
  * @param {number=} opt_index
- * @return {!proto.ag.EnrollmentRequest} returns this
+ * @return {!proto.ag.URLRequest} returns this
  */
-proto.ag.EnrollmentRequest.prototype.addStatuses = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+proto.ag.URLRequest.prototype.addRepotypes = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.ag.EnrollmentRequest} returns this
+ * @return {!proto.ag.URLRequest} returns this
  */
-proto.ag.EnrollmentRequest.prototype.clearStatusesList = function() {
-  return this.setStatusesList([]);
+proto.ag.URLRequest.prototype.clearRepotypesList = function() {
+  return this.setRepotypesList([]);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.ag.EnrollmentStatusRequest.repeatedFields_ = [2];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -9533,8 +18953,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.EnrollmentStatusRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.EnrollmentStatusRequest.toObject(opt_includeInstance, this);
+proto.ag.RepositoryRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.RepositoryRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -9543,14 +18963,15 @@ proto.ag.EnrollmentStatusRequest.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.EnrollmentStatusRequest} msg The msg instance to transform.
+ * @param {!proto.ag.RepositoryRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.EnrollmentStatusRequest.toObject = function(includeInstance, msg) {
+proto.ag.RepositoryRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     userid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    statusesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
+    groupid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    courseid: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -9564,23 +18985,23 @@ proto.ag.EnrollmentStatusRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.EnrollmentStatusRequest}
+ * @return {!proto.ag.RepositoryRequest}
  */
-proto.ag.EnrollmentStatusRequest.deserializeBinary = function(bytes) {
+proto.ag.RepositoryRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.EnrollmentStatusRequest;
-  return proto.ag.EnrollmentStatusRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.RepositoryRequest;
+  return proto.ag.RepositoryRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.EnrollmentStatusRequest} msg The message object to deserialize into.
+ * @param {!proto.ag.RepositoryRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.EnrollmentStatusRequest}
+ * @return {!proto.ag.RepositoryRequest}
  */
-proto.ag.EnrollmentStatusRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.RepositoryRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9592,10 +19013,12 @@ proto.ag.EnrollmentStatusRequest.deserializeBinaryFromReader = function(msg, rea
       msg.setUserid(value);
       break;
     case 2:
-      var values = /** @type {!Array<!proto.ag.Enrollment.UserStatus>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
-      for (var i = 0; i < values.length; i++) {
-        msg.addStatuses(values[i]);
-      }
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setGroupid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
       break;
     default:
       reader.skipField();
@@ -9610,9 +19033,9 @@ proto.ag.EnrollmentStatusRequest.deserializeBinaryFromReader = function(msg, rea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.EnrollmentStatusRequest.prototype.serializeBinary = function() {
+proto.ag.RepositoryRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.EnrollmentStatusRequest.serializeBinaryToWriter(this, writer);
+  proto.ag.RepositoryRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9620,11 +19043,11 @@ proto.ag.EnrollmentStatusRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.EnrollmentStatusRequest} message
+ * @param {!proto.ag.RepositoryRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.EnrollmentStatusRequest.serializeBinaryToWriter = function(message, writer) {
+proto.ag.RepositoryRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getUserid();
   if (f !== 0) {
@@ -9633,13 +19056,20 @@ proto.ag.EnrollmentStatusRequest.serializeBinaryToWriter = function(message, wri
       f
     );
   }
-  f = message.getStatusesList();
-  if (f.length > 0) {
-    writer.writePackedEnum(
+  f = message.getGroupid();
+  if (f !== 0) {
+    writer.writeUint64(
       2,
       f
     );
   }
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
 };
 
 
@@ -9647,54 +19077,53 @@ proto.ag.EnrollmentStatusRequest.serializeBinaryToWriter = function(message, wri
  * optional uint64 userID = 1;
  * @return {number}
  */
-proto.ag.EnrollmentStatusRequest.prototype.getUserid = function() {
+proto.ag.RepositoryRequest.prototype.getUserid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.EnrollmentStatusRequest} returns this
+ * @return {!proto.ag.RepositoryRequest} returns this
  */
-proto.ag.EnrollmentStatusRequest.prototype.setUserid = function(value) {
+proto.ag.RepositoryRequest.prototype.setUserid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * repeated Enrollment.UserStatus statuses = 2;
- * @return {!Array<!proto.ag.Enrollment.UserStatus>}
+ * optional uint64 groupID = 2;
+ * @return {number}
  */
-proto.ag.EnrollmentStatusRequest.prototype.getStatusesList = function() {
-  return /** @type {!Array<!proto.ag.Enrollment.UserStatus>} */ (jspb.Message.getRepeatedField(this, 2));
+proto.ag.RepositoryRequest.prototype.getGroupid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {!Array<!proto.ag.Enrollment.UserStatus>} value
- * @return {!proto.ag.EnrollmentStatusRequest} returns this
+ * @param {number} value
+ * @return {!proto.ag.RepositoryRequest} returns this
  */
-proto.ag.EnrollmentStatusRequest.prototype.setStatusesList = function(value) {
-  return jspb.Message.setField(this, 2, value || []);
+proto.ag.RepositoryRequest.prototype.setGroupid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * @param {!proto.ag.Enrollment.UserStatus} value
- * @param {number=} opt_index
- * @return {!proto.ag.EnrollmentStatusRequest} returns this
+ * optional uint64 courseID = 3;
+ * @return {number}
  */
-proto.ag.EnrollmentStatusRequest.prototype.addStatuses = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+proto.ag.RepositoryRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.EnrollmentStatusRequest} returns this
+ * @param {number} value
+ * @return {!proto.ag.RepositoryRequest} returns this
  */
-proto.ag.EnrollmentStatusRequest.prototype.clearStatusesList = function() {
-  return this.setStatusesList([]);
+proto.ag.RepositoryRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
@@ -9714,8 +19143,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.SubmissionRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.SubmissionRequest.toObject(opt_includeInstance, this);
+proto.ag.Repositories.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Repositories.toObject(opt_includeInstance, this);
 };
 
 
@@ -9724,15 +19153,13 @@ proto.ag.SubmissionRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.SubmissionRequest} msg The msg instance to transform.
+ * @param {!proto.ag.Repositories} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.SubmissionRequest.toObject = function(includeInstance, msg) {
+proto.ag.Repositories.toObject = function(includeInstance, msg) {
   var f, obj = {
-    userid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    groupid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    courseid: jspb.Message.getFieldWithDefault(msg, 3, 0)
+    urlsMap: (f = msg.getUrlsMap()) ? f.toObject(includeInstance, undefined) : []
   };
 
   if (includeInstance) {
@@ -9746,23 +19173,23 @@ proto.ag.SubmissionRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.SubmissionRequest}
+ * @return {!proto.ag.Repositories}
  */
-proto.ag.SubmissionRequest.deserializeBinary = function(bytes) {
+proto.ag.Repositories.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.SubmissionRequest;
-  return proto.ag.SubmissionRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.Repositories;
+  return proto.ag.Repositories.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.SubmissionRequest} msg The message object to deserialize into.
+ * @param {!proto.ag.Repositories} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.SubmissionRequest}
+ * @return {!proto.ag.Repositories}
  */
-proto.ag.SubmissionRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.Repositories.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9770,16 +19197,10 @@ proto.ag.SubmissionRequest.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setUserid(value);
-      break;
-    case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setGroupid(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
+      var value = msg.getUrlsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
       break;
     default:
       reader.skipField();
@@ -9794,9 +19215,9 @@ proto.ag.SubmissionRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.SubmissionRequest.prototype.serializeBinary = function() {
+proto.ag.Repositories.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.SubmissionRequest.serializeBinaryToWriter(this, writer);
+  proto.ag.Repositories.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9804,87 +19225,168 @@ proto.ag.SubmissionRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.SubmissionRequest} message
+ * @param {!proto.ag.Repositories} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.SubmissionRequest.serializeBinaryToWriter = function(message, writer) {
+proto.ag.Repositories.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUserid();
-  if (f !== 0) {
-    writer.writeUint64(
-      1,
-      f
-    );
-  }
-  f = message.getGroupid();
-  if (f !== 0) {
-    writer.writeUint64(
-      2,
-      f
-    );
+  f = message.getUrlsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
   }
-  f = message.getCourseid();
-  if (f !== 0) {
-    writer.writeUint64(
-      3,
-      f
-    );
+};
+
+
+/**
+ * map<string, string> URLs = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.ag.Repositories.prototype.getUrlsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.ag.Repositories} returns this
+ */
+proto.ag.Repositories.prototype.clearUrlsMap = function() {
+  this.getUrlsMap().clear();
+  return this;};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ag.AuthorizationResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.AuthorizationResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ag.AuthorizationResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.AuthorizationResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    isauthorized: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
   }
+  return obj;
 };
+}
 
 
 /**
- * optional uint64 userID = 1;
- * @return {number}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ag.AuthorizationResponse}
  */
-proto.ag.SubmissionRequest.prototype.getUserid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.ag.AuthorizationResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ag.AuthorizationResponse;
+  return proto.ag.AuthorizationResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.SubmissionRequest} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ag.AuthorizationResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ag.AuthorizationResponse}
  */
-proto.ag.SubmissionRequest.prototype.setUserid = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.ag.AuthorizationResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsauthorized(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional uint64 groupID = 2;
- * @return {number}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.ag.SubmissionRequest.prototype.getGroupid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.ag.AuthorizationResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.AuthorizationResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.SubmissionRequest} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.AuthorizationResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.SubmissionRequest.prototype.setGroupid = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.ag.AuthorizationResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getIsauthorized();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * optional uint64 courseID = 3;
- * @return {number}
+ * optional bool IsAuthorized = 1;
+ * @return {boolean}
  */
-proto.ag.SubmissionRequest.prototype.getCourseid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.ag.AuthorizationResponse.prototype.getIsauthorized = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.SubmissionRequest} returns this
+ * @param {boolean} value
+ * @return {!proto.ag.AuthorizationResponse} returns this
  */
-proto.ag.SubmissionRequest.prototype.setCourseid = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.ag.AuthorizationResponse.prototype.setIsauthorized = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
 };
 
 
@@ -9904,8 +19406,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.UpdateSubmissionRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.UpdateSubmissionRequest.toObject(opt_includeInstance, this);
+proto.ag.Status.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.Status.toObject(opt_includeInstance, this);
 };
 
 
@@ -9914,17 +19416,14 @@ proto.ag.UpdateSubmissionRequest.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.UpdateSubmissionRequest} msg The msg instance to transform.
+ * @param {!proto.ag.Status} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.UpdateSubmissionRequest.toObject = function(includeInstance, msg) {
+proto.ag.Status.toObject = function(includeInstance, msg) {
   var f, obj = {
-    submissionid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    courseid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    score: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    released: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
-    status: jspb.Message.getFieldWithDefault(msg, 5, 0)
+    code: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    error: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -9938,23 +19437,23 @@ proto.ag.UpdateSubmissionRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.UpdateSubmissionRequest}
+ * @return {!proto.ag.Status}
  */
-proto.ag.UpdateSubmissionRequest.deserializeBinary = function(bytes) {
+proto.ag.Status.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.UpdateSubmissionRequest;
-  return proto.ag.UpdateSubmissionRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.Status;
+  return proto.ag.Status.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.UpdateSubmissionRequest} msg The message object to deserialize into.
+ * @param {!proto.ag.Status} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.UpdateSubmissionRequest}
+ * @return {!proto.ag.Status}
  */
-proto.ag.UpdateSubmissionRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.Status.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9963,23 +19462,11 @@ proto.ag.UpdateSubmissionRequest.deserializeBinaryFromReader = function(msg, rea
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setSubmissionid(value);
+      msg.setCode(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setScore(value);
-      break;
-    case 4:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setReleased(value);
-      break;
-    case 5:
-      var value = /** @type {!proto.ag.Submission.Status} */ (reader.readEnum());
-      msg.setStatus(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setError(value);
       break;
     default:
       reader.skipField();
@@ -9994,9 +19481,9 @@ proto.ag.UpdateSubmissionRequest.deserializeBinaryFromReader = function(msg, rea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.UpdateSubmissionRequest.prototype.serializeBinary = function() {
+proto.ag.Status.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.UpdateSubmissionRequest.serializeBinaryToWriter(this, writer);
+  proto.ag.Status.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10004,137 +19491,62 @@ proto.ag.UpdateSubmissionRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.UpdateSubmissionRequest} message
+ * @param {!proto.ag.Status} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.UpdateSubmissionRequest.serializeBinaryToWriter = function(message, writer) {
+proto.ag.Status.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSubmissionid();
+  f = message.getCode();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getCourseid();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getError();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
   }
-  f = message.getScore();
-  if (f !== 0) {
-    writer.writeUint32(
-      3,
-      f
-    );
-  }
-  f = message.getReleased();
-  if (f) {
-    writer.writeBool(
-      4,
-      f
-    );
-  }
-  f = message.getStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      5,
-      f
-    );
-  }
 };
 
 
 /**
- * optional uint64 submissionID = 1;
+ * optional uint64 Code = 1;
  * @return {number}
  */
-proto.ag.UpdateSubmissionRequest.prototype.getSubmissionid = function() {
+proto.ag.Status.prototype.getCode = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.UpdateSubmissionRequest} returns this
+ * @return {!proto.ag.Status} returns this
  */
-proto.ag.UpdateSubmissionRequest.prototype.setSubmissionid = function(value) {
+proto.ag.Status.prototype.setCode = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 courseID = 2;
- * @return {number}
- */
-proto.ag.UpdateSubmissionRequest.prototype.getCourseid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.ag.UpdateSubmissionRequest} returns this
- */
-proto.ag.UpdateSubmissionRequest.prototype.setCourseid = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
-};
-
-
-/**
- * optional uint32 score = 3;
- * @return {number}
- */
-proto.ag.UpdateSubmissionRequest.prototype.getScore = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.ag.UpdateSubmissionRequest} returns this
- */
-proto.ag.UpdateSubmissionRequest.prototype.setScore = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
-};
-
-
-/**
- * optional bool released = 4;
- * @return {boolean}
- */
-proto.ag.UpdateSubmissionRequest.prototype.getReleased = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.ag.UpdateSubmissionRequest} returns this
- */
-proto.ag.UpdateSubmissionRequest.prototype.setReleased = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 4, value);
-};
-
-
-/**
- * optional Submission.Status status = 5;
- * @return {!proto.ag.Submission.Status}
+ * optional string Error = 2;
+ * @return {string}
  */
-proto.ag.UpdateSubmissionRequest.prototype.getStatus = function() {
-  return /** @type {!proto.ag.Submission.Status} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.ag.Status.prototype.getError = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {!proto.ag.Submission.Status} value
- * @return {!proto.ag.UpdateSubmissionRequest} returns this
+ * @param {string} value
+ * @return {!proto.ag.Status} returns this
  */
-proto.ag.UpdateSubmissionRequest.prototype.setStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 5, value);
+proto.ag.Status.prototype.setError = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -10154,8 +19566,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.UpdateSubmissionsRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.UpdateSubmissionsRequest.toObject(opt_includeInstance, this);
+proto.ag.SubmissionsForCourseRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.SubmissionsForCourseRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -10164,17 +19576,15 @@ proto.ag.UpdateSubmissionsRequest.prototype.toObject = function(opt_includeInsta
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.UpdateSubmissionsRequest} msg The msg instance to transform.
+ * @param {!proto.ag.SubmissionsForCourseRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.UpdateSubmissionsRequest.toObject = function(includeInstance, msg) {
+proto.ag.SubmissionsForCourseRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    scorelimit: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    release: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
-    approve: jspb.Message.getBooleanFieldWithDefault(msg, 5, false)
+    type: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    skipbuildinfo: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
   };
 
   if (includeInstance) {
@@ -10188,23 +19598,23 @@ proto.ag.UpdateSubmissionsRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.UpdateSubmissionsRequest}
+ * @return {!proto.ag.SubmissionsForCourseRequest}
  */
-proto.ag.UpdateSubmissionsRequest.deserializeBinary = function(bytes) {
+proto.ag.SubmissionsForCourseRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.UpdateSubmissionsRequest;
-  return proto.ag.UpdateSubmissionsRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.SubmissionsForCourseRequest;
+  return proto.ag.SubmissionsForCourseRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.UpdateSubmissionsRequest} msg The message object to deserialize into.
+ * @param {!proto.ag.SubmissionsForCourseRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.UpdateSubmissionsRequest}
+ * @return {!proto.ag.SubmissionsForCourseRequest}
  */
-proto.ag.UpdateSubmissionsRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.SubmissionsForCourseRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10216,20 +19626,12 @@ proto.ag.UpdateSubmissionsRequest.deserializeBinaryFromReader = function(msg, re
       msg.setCourseid(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setAssignmentid(value);
+      var value = /** @type {!proto.ag.SubmissionsForCourseRequest.Type} */ (reader.readEnum());
+      msg.setType(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setScorelimit(value);
-      break;
-    case 4:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setRelease(value);
-      break;
-    case 5:
       var value = /** @type {boolean} */ (reader.readBool());
-      msg.setApprove(value);
+      msg.setSkipbuildinfo(value);
       break;
     default:
       reader.skipField();
@@ -10244,9 +19646,9 @@ proto.ag.UpdateSubmissionsRequest.deserializeBinaryFromReader = function(msg, re
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.UpdateSubmissionsRequest.prototype.serializeBinary = function() {
+proto.ag.SubmissionsForCourseRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.UpdateSubmissionsRequest.serializeBinaryToWriter(this, writer);
+  proto.ag.SubmissionsForCourseRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10254,11 +19656,11 @@ proto.ag.UpdateSubmissionsRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.UpdateSubmissionsRequest} message
+ * @param {!proto.ag.SubmissionsForCourseRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.UpdateSubmissionsRequest.serializeBinaryToWriter = function(message, writer) {
+proto.ag.SubmissionsForCourseRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getCourseid();
   if (f !== 0) {
@@ -10267,31 +19669,17 @@ proto.ag.UpdateSubmissionsRequest.serializeBinaryToWriter = function(message, wr
       f
     );
   }
-  f = message.getAssignmentid();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getType();
+  if (f !== 0.0) {
+    writer.writeEnum(
       2,
       f
     );
   }
-  f = message.getScorelimit();
-  if (f !== 0) {
-    writer.writeUint32(
-      3,
-      f
-    );
-  }
-  f = message.getRelease();
-  if (f) {
-    writer.writeBool(
-      4,
-      f
-    );
-  }
-  f = message.getApprove();
+  f = message.getSkipbuildinfo();
   if (f) {
     writer.writeBool(
-      5,
+      3,
       f
     );
   }
@@ -10299,92 +19687,65 @@ proto.ag.UpdateSubmissionsRequest.serializeBinaryToWriter = function(message, wr
 
 
 /**
- * optional uint64 courseID = 1;
- * @return {number}
- */
-proto.ag.UpdateSubmissionsRequest.prototype.getCourseid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.ag.UpdateSubmissionsRequest} returns this
- */
-proto.ag.UpdateSubmissionsRequest.prototype.setCourseid = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
-};
-
-
-/**
- * optional uint64 assignmentID = 2;
- * @return {number}
- */
-proto.ag.UpdateSubmissionsRequest.prototype.getAssignmentid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.ag.UpdateSubmissionsRequest} returns this
+ * @enum {number}
  */
-proto.ag.UpdateSubmissionsRequest.prototype.setAssignmentid = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.ag.SubmissionsForCourseRequest.Type = {
+  ALL: 0,
+  INDIVIDUAL: 1,
+  GROUP: 2
 };
 
-
 /**
- * optional uint32 scoreLimit = 3;
+ * optional uint64 courseID = 1;
  * @return {number}
  */
-proto.ag.UpdateSubmissionsRequest.prototype.getScorelimit = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.ag.SubmissionsForCourseRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.UpdateSubmissionsRequest} returns this
+ * @return {!proto.ag.SubmissionsForCourseRequest} returns this
  */
-proto.ag.UpdateSubmissionsRequest.prototype.setScorelimit = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.ag.SubmissionsForCourseRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional bool release = 4;
- * @return {boolean}
+ * optional Type type = 2;
+ * @return {!proto.ag.SubmissionsForCourseRequest.Type}
  */
-proto.ag.UpdateSubmissionsRequest.prototype.getRelease = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+proto.ag.SubmissionsForCourseRequest.prototype.getType = function() {
+  return /** @type {!proto.ag.SubmissionsForCourseRequest.Type} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.ag.UpdateSubmissionsRequest} returns this
+ * @param {!proto.ag.SubmissionsForCourseRequest.Type} value
+ * @return {!proto.ag.SubmissionsForCourseRequest} returns this
  */
-proto.ag.UpdateSubmissionsRequest.prototype.setRelease = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 4, value);
+proto.ag.SubmissionsForCourseRequest.prototype.setType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
 };
 
 
 /**
- * optional bool approve = 5;
+ * optional bool skipBuildInfo = 3;
  * @return {boolean}
  */
-proto.ag.UpdateSubmissionsRequest.prototype.getApprove = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+proto.ag.SubmissionsForCourseRequest.prototype.getSkipbuildinfo = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
 };
 
 
 /**
  * @param {boolean} value
- * @return {!proto.ag.UpdateSubmissionsRequest} returns this
+ * @return {!proto.ag.SubmissionsForCourseRequest} returns this
  */
-proto.ag.UpdateSubmissionsRequest.prototype.setApprove = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 5, value);
+proto.ag.SubmissionsForCourseRequest.prototype.setSkipbuildinfo = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
 };
 
 
@@ -10404,8 +19765,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.SubmissionReviewersRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.SubmissionReviewersRequest.toObject(opt_includeInstance, this);
+proto.ag.RebuildRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.RebuildRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -10414,14 +19775,14 @@ proto.ag.SubmissionReviewersRequest.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.SubmissionReviewersRequest} msg The msg instance to transform.
+ * @param {!proto.ag.RebuildRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.SubmissionReviewersRequest.toObject = function(includeInstance, msg) {
+proto.ag.RebuildRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     submissionid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    courseid: jspb.Message.getFieldWithDefault(msg, 2, 0)
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -10435,23 +19796,23 @@ proto.ag.SubmissionReviewersRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.SubmissionReviewersRequest}
+ * @return {!proto.ag.RebuildRequest}
  */
-proto.ag.SubmissionReviewersRequest.deserializeBinary = function(bytes) {
+proto.ag.RebuildRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.SubmissionReviewersRequest;
-  return proto.ag.SubmissionReviewersRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.RebuildRequest;
+  return proto.ag.RebuildRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.SubmissionReviewersRequest} msg The message object to deserialize into.
+ * @param {!proto.ag.RebuildRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.SubmissionReviewersRequest}
+ * @return {!proto.ag.RebuildRequest}
  */
-proto.ag.SubmissionReviewersRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.RebuildRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10464,7 +19825,7 @@ proto.ag.SubmissionReviewersRequest.deserializeBinaryFromReader = function(msg,
       break;
     case 2:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
+      msg.setAssignmentid(value);
       break;
     default:
       reader.skipField();
@@ -10479,9 +19840,9 @@ proto.ag.SubmissionReviewersRequest.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.SubmissionReviewersRequest.prototype.serializeBinary = function() {
+proto.ag.RebuildRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.SubmissionReviewersRequest.serializeBinaryToWriter(this, writer);
+  proto.ag.RebuildRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10489,11 +19850,11 @@ proto.ag.SubmissionReviewersRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.SubmissionReviewersRequest} message
+ * @param {!proto.ag.RebuildRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.SubmissionReviewersRequest.serializeBinaryToWriter = function(message, writer) {
+proto.ag.RebuildRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getSubmissionid();
   if (f !== 0) {
@@ -10502,7 +19863,7 @@ proto.ag.SubmissionReviewersRequest.serializeBinaryToWriter = function(message,
       f
     );
   }
-  f = message.getCourseid();
+  f = message.getAssignmentid();
   if (f !== 0) {
     writer.writeUint64(
       2,
@@ -10516,46 +19877,39 @@ proto.ag.SubmissionReviewersRequest.serializeBinaryToWriter = function(message,
  * optional uint64 submissionID = 1;
  * @return {number}
  */
-proto.ag.SubmissionReviewersRequest.prototype.getSubmissionid = function() {
+proto.ag.RebuildRequest.prototype.getSubmissionid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.SubmissionReviewersRequest} returns this
+ * @return {!proto.ag.RebuildRequest} returns this
  */
-proto.ag.SubmissionReviewersRequest.prototype.setSubmissionid = function(value) {
+proto.ag.RebuildRequest.prototype.setSubmissionid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 courseID = 2;
+ * optional uint64 assignmentID = 2;
  * @return {number}
  */
-proto.ag.SubmissionReviewersRequest.prototype.getCourseid = function() {
+proto.ag.RebuildRequest.prototype.getAssignmentid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.SubmissionReviewersRequest} returns this
+ * @return {!proto.ag.RebuildRequest} returns this
  */
-proto.ag.SubmissionReviewersRequest.prototype.setCourseid = function(value) {
+proto.ag.RebuildRequest.prototype.setAssignmentid = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.ag.Providers.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -10571,8 +19925,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Providers.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Providers.toObject(opt_includeInstance, this);
+proto.ag.CourseUserRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.CourseUserRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -10581,13 +19935,15 @@ proto.ag.Providers.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Providers} msg The msg instance to transform.
+ * @param {!proto.ag.CourseUserRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Providers.toObject = function(includeInstance, msg) {
+proto.ag.CourseUserRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    providersList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+    coursecode: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    courseyear: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    userlogin: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -10601,23 +19957,23 @@ proto.ag.Providers.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Providers}
+ * @return {!proto.ag.CourseUserRequest}
  */
-proto.ag.Providers.deserializeBinary = function(bytes) {
+proto.ag.CourseUserRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Providers;
-  return proto.ag.Providers.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.CourseUserRequest;
+  return proto.ag.CourseUserRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Providers} msg The message object to deserialize into.
+ * @param {!proto.ag.CourseUserRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Providers}
+ * @return {!proto.ag.CourseUserRequest}
  */
-proto.ag.Providers.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.CourseUserRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10626,7 +19982,15 @@ proto.ag.Providers.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.addProviders(value);
+      msg.setCoursecode(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setCourseyear(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUserlogin(value);
       break;
     default:
       reader.skipField();
@@ -10641,9 +20005,9 @@ proto.ag.Providers.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Providers.prototype.serializeBinary = function() {
+proto.ag.CourseUserRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Providers.serializeBinaryToWriter(this, writer);
+  proto.ag.CourseUserRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10651,67 +20015,91 @@ proto.ag.Providers.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Providers} message
+ * @param {!proto.ag.CourseUserRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Providers.serializeBinaryToWriter = function(message, writer) {
+proto.ag.CourseUserRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getProvidersList();
+  f = message.getCoursecode();
   if (f.length > 0) {
-    writer.writeRepeatedString(
+    writer.writeString(
       1,
       f
     );
   }
+  f = message.getCourseyear();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+  f = message.getUserlogin();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * repeated string providers = 1;
- * @return {!Array<string>}
+ * optional string courseCode = 1;
+ * @return {string}
  */
-proto.ag.Providers.prototype.getProvidersList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+proto.ag.CourseUserRequest.prototype.getCoursecode = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.ag.Providers} returns this
+ * @param {string} value
+ * @return {!proto.ag.CourseUserRequest} returns this
  */
-proto.ag.Providers.prototype.setProvidersList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+proto.ag.CourseUserRequest.prototype.setCoursecode = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional uint32 courseYear = 2;
+ * @return {number}
+ */
+proto.ag.CourseUserRequest.prototype.getCourseyear = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.CourseUserRequest} returns this
+ */
+proto.ag.CourseUserRequest.prototype.setCourseyear = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.ag.Providers} returns this
+ * optional string userLogin = 3;
+ * @return {string}
  */
-proto.ag.Providers.prototype.addProviders = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+proto.ag.CourseUserRequest.prototype.getUserlogin = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.Providers} returns this
+ * @param {string} value
+ * @return {!proto.ag.CourseUserRequest} returns this
  */
-proto.ag.Providers.prototype.clearProvidersList = function() {
-  return this.setProvidersList([]);
+proto.ag.CourseUserRequest.prototype.setUserlogin = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.ag.URLRequest.repeatedFields_ = [2];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -10727,8 +20115,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.URLRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.URLRequest.toObject(opt_includeInstance, this);
+proto.ag.LoadCriteriaRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.LoadCriteriaRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -10737,14 +20125,14 @@ proto.ag.URLRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.URLRequest} msg The msg instance to transform.
+ * @param {!proto.ag.LoadCriteriaRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.URLRequest.toObject = function(includeInstance, msg) {
+proto.ag.LoadCriteriaRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    repotypesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -10758,23 +20146,23 @@ proto.ag.URLRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.URLRequest}
+ * @return {!proto.ag.LoadCriteriaRequest}
  */
-proto.ag.URLRequest.deserializeBinary = function(bytes) {
+proto.ag.LoadCriteriaRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.URLRequest;
-  return proto.ag.URLRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.LoadCriteriaRequest;
+  return proto.ag.LoadCriteriaRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.URLRequest} msg The message object to deserialize into.
+ * @param {!proto.ag.LoadCriteriaRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.URLRequest}
+ * @return {!proto.ag.LoadCriteriaRequest}
  */
-proto.ag.URLRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.LoadCriteriaRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10786,10 +20174,8 @@ proto.ag.URLRequest.deserializeBinaryFromReader = function(msg, reader) {
       msg.setCourseid(value);
       break;
     case 2:
-      var values = /** @type {!Array<!proto.ag.Repository.Type>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
-      for (var i = 0; i < values.length; i++) {
-        msg.addRepotypes(values[i]);
-      }
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
       break;
     default:
       reader.skipField();
@@ -10804,9 +20190,9 @@ proto.ag.URLRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.URLRequest.prototype.serializeBinary = function() {
+proto.ag.LoadCriteriaRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.URLRequest.serializeBinaryToWriter(this, writer);
+  proto.ag.LoadCriteriaRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10814,11 +20200,11 @@ proto.ag.URLRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.URLRequest} message
+ * @param {!proto.ag.LoadCriteriaRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.URLRequest.serializeBinaryToWriter = function(message, writer) {
+proto.ag.LoadCriteriaRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getCourseid();
   if (f !== 0) {
@@ -10827,9 +20213,9 @@ proto.ag.URLRequest.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getRepotypesList();
-  if (f.length > 0) {
-    writer.writePackedEnum(
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
       2,
       f
     );
@@ -10841,54 +20227,35 @@ proto.ag.URLRequest.serializeBinaryToWriter = function(message, writer) {
  * optional uint64 courseID = 1;
  * @return {number}
  */
-proto.ag.URLRequest.prototype.getCourseid = function() {
+proto.ag.LoadCriteriaRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.URLRequest} returns this
+ * @return {!proto.ag.LoadCriteriaRequest} returns this
  */
-proto.ag.URLRequest.prototype.setCourseid = function(value) {
+proto.ag.LoadCriteriaRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * repeated Repository.Type repoTypes = 2;
- * @return {!Array<!proto.ag.Repository.Type>}
- */
-proto.ag.URLRequest.prototype.getRepotypesList = function() {
-  return /** @type {!Array<!proto.ag.Repository.Type>} */ (jspb.Message.getRepeatedField(this, 2));
-};
-
-
-/**
- * @param {!Array<!proto.ag.Repository.Type>} value
- * @return {!proto.ag.URLRequest} returns this
- */
-proto.ag.URLRequest.prototype.setRepotypesList = function(value) {
-  return jspb.Message.setField(this, 2, value || []);
-};
-
-
-/**
- * @param {!proto.ag.Repository.Type} value
- * @param {number=} opt_index
- * @return {!proto.ag.URLRequest} returns this
+ * optional uint64 assignmentID = 2;
+ * @return {number}
  */
-proto.ag.URLRequest.prototype.addRepotypes = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+proto.ag.LoadCriteriaRequest.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.ag.URLRequest} returns this
+ * @param {number} value
+ * @return {!proto.ag.LoadCriteriaRequest} returns this
  */
-proto.ag.URLRequest.prototype.clearRepotypesList = function() {
-  return this.setRepotypesList([]);
+proto.ag.LoadCriteriaRequest.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
@@ -10908,8 +20275,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.RepositoryRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.RepositoryRequest.toObject(opt_includeInstance, this);
+proto.ag.BuildJobRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.BuildJobRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -10918,15 +20285,14 @@ proto.ag.RepositoryRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.RepositoryRequest} msg The msg instance to transform.
+ * @param {!proto.ag.BuildJobRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.RepositoryRequest.toObject = function(includeInstance, msg) {
+proto.ag.BuildJobRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    userid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    groupid: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    courseid: jspb.Message.getFieldWithDefault(msg, 3, 0)
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    jobid: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -10940,23 +20306,23 @@ proto.ag.RepositoryRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.RepositoryRequest}
+ * @return {!proto.ag.BuildJobRequest}
  */
-proto.ag.RepositoryRequest.deserializeBinary = function(bytes) {
+proto.ag.BuildJobRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.RepositoryRequest;
-  return proto.ag.RepositoryRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.BuildJobRequest;
+  return proto.ag.BuildJobRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.RepositoryRequest} msg The message object to deserialize into.
+ * @param {!proto.ag.BuildJobRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.RepositoryRequest}
+ * @return {!proto.ag.BuildJobRequest}
  */
-proto.ag.RepositoryRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.BuildJobRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10965,15 +20331,11 @@ proto.ag.RepositoryRequest.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setUserid(value);
+      msg.setCourseid(value);
       break;
     case 2:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setGroupid(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
+      msg.setJobid(value);
       break;
     default:
       reader.skipField();
@@ -10988,9 +20350,9 @@ proto.ag.RepositoryRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.RepositoryRequest.prototype.serializeBinary = function() {
+proto.ag.BuildJobRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.RepositoryRequest.serializeBinaryToWriter(this, writer);
+  proto.ag.BuildJobRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10998,90 +20360,65 @@ proto.ag.RepositoryRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.RepositoryRequest} message
+ * @param {!proto.ag.BuildJobRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.RepositoryRequest.serializeBinaryToWriter = function(message, writer) {
+proto.ag.BuildJobRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUserid();
+  f = message.getCourseid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getGroupid();
+  f = message.getJobid();
   if (f !== 0) {
     writer.writeUint64(
       2,
       f
     );
   }
-  f = message.getCourseid();
-  if (f !== 0) {
-    writer.writeUint64(
-      3,
-      f
-    );
-  }
 };
 
 
 /**
- * optional uint64 userID = 1;
+ * optional uint64 courseID = 1;
  * @return {number}
  */
-proto.ag.RepositoryRequest.prototype.getUserid = function() {
+proto.ag.BuildJobRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.RepositoryRequest} returns this
+ * @return {!proto.ag.BuildJobRequest} returns this
  */
-proto.ag.RepositoryRequest.prototype.setUserid = function(value) {
+proto.ag.BuildJobRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint64 groupID = 2;
+ * optional uint64 jobID = 2;
  * @return {number}
  */
-proto.ag.RepositoryRequest.prototype.getGroupid = function() {
+proto.ag.BuildJobRequest.prototype.getJobid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.RepositoryRequest} returns this
+ * @return {!proto.ag.BuildJobRequest} returns this
  */
-proto.ag.RepositoryRequest.prototype.setGroupid = function(value) {
+proto.ag.BuildJobRequest.prototype.setJobid = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
-/**
- * optional uint64 courseID = 3;
- * @return {number}
- */
-proto.ag.RepositoryRequest.prototype.getCourseid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.ag.RepositoryRequest} returns this
- */
-proto.ag.RepositoryRequest.prototype.setCourseid = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
-};
-
-
 
 
 
@@ -11098,8 +20435,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Repositories.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Repositories.toObject(opt_includeInstance, this);
+proto.ag.BuildQuotaRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.BuildQuotaRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -11108,13 +20445,15 @@ proto.ag.Repositories.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Repositories} msg The msg instance to transform.
+ * @param {!proto.ag.BuildQuotaRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Repositories.toObject = function(includeInstance, msg) {
+proto.ag.BuildQuotaRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    urlsMap: (f = msg.getUrlsMap()) ? f.toObject(includeInstance, undefined) : []
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    repositoryid: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -11128,23 +20467,23 @@ proto.ag.Repositories.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Repositories}
+ * @return {!proto.ag.BuildQuotaRequest}
  */
-proto.ag.Repositories.deserializeBinary = function(bytes) {
+proto.ag.BuildQuotaRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Repositories;
-  return proto.ag.Repositories.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.BuildQuotaRequest;
+  return proto.ag.BuildQuotaRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Repositories} msg The message object to deserialize into.
+ * @param {!proto.ag.BuildQuotaRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Repositories}
+ * @return {!proto.ag.BuildQuotaRequest}
  */
-proto.ag.Repositories.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.BuildQuotaRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -11152,10 +20491,16 @@ proto.ag.Repositories.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = msg.getUrlsMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
-         });
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setRepositoryid(value);
       break;
     default:
       reader.skipField();
@@ -11167,52 +20512,101 @@ proto.ag.Repositories.deserializeBinaryFromReader = function(msg, reader) {
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ag.BuildQuotaRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ag.BuildQuotaRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ag.BuildQuotaRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ag.BuildQuotaRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getRepositoryid();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 courseID = 1;
+ * @return {number}
+ */
+proto.ag.BuildQuotaRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.BuildQuotaRequest} returns this
+ */
+proto.ag.BuildQuotaRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 assignmentID = 2;
+ * @return {number}
  */
-proto.ag.Repositories.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.ag.Repositories.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.ag.BuildQuotaRequest.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Repositories} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {number} value
+ * @return {!proto.ag.BuildQuotaRequest} returns this
  */
-proto.ag.Repositories.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getUrlsMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
-  }
+proto.ag.BuildQuotaRequest.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * map<string, string> URLs = 1;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,string>}
+ * optional uint64 repositoryID = 3;
+ * @return {number}
  */
-proto.ag.Repositories.prototype.getUrlsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,string>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      null));
+proto.ag.BuildQuotaRequest.prototype.getRepositoryid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.ag.Repositories} returns this
+ * @param {number} value
+ * @return {!proto.ag.BuildQuotaRequest} returns this
  */
-proto.ag.Repositories.prototype.clearUrlsMap = function() {
-  this.getUrlsMap().clear();
-  return this;};
+proto.ag.BuildQuotaRequest.prototype.setRepositoryid = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
 
 
 
@@ -11231,8 +20625,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.AuthorizationResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.AuthorizationResponse.toObject(opt_includeInstance, this);
+proto.ag.BuildLogRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.BuildLogRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -11241,13 +20635,14 @@ proto.ag.AuthorizationResponse.prototype.toObject = function(opt_includeInstance
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.AuthorizationResponse} msg The msg instance to transform.
+ * @param {!proto.ag.BuildLogRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.AuthorizationResponse.toObject = function(includeInstance, msg) {
+proto.ag.BuildLogRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    isauthorized: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    commitid: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -11261,23 +20656,23 @@ proto.ag.AuthorizationResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.AuthorizationResponse}
+ * @return {!proto.ag.BuildLogRequest}
  */
-proto.ag.AuthorizationResponse.deserializeBinary = function(bytes) {
+proto.ag.BuildLogRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.AuthorizationResponse;
-  return proto.ag.AuthorizationResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.BuildLogRequest;
+  return proto.ag.BuildLogRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.AuthorizationResponse} msg The message object to deserialize into.
+ * @param {!proto.ag.BuildLogRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.AuthorizationResponse}
+ * @return {!proto.ag.BuildLogRequest}
  */
-proto.ag.AuthorizationResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.BuildLogRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -11285,8 +20680,12 @@ proto.ag.AuthorizationResponse.deserializeBinaryFromReader = function(msg, reade
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIsauthorized(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCommitid(value);
       break;
     default:
       reader.skipField();
@@ -11301,9 +20700,9 @@ proto.ag.AuthorizationResponse.deserializeBinaryFromReader = function(msg, reade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.AuthorizationResponse.prototype.serializeBinary = function() {
+proto.ag.BuildLogRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.AuthorizationResponse.serializeBinaryToWriter(this, writer);
+  proto.ag.BuildLogRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -11311,37 +20710,62 @@ proto.ag.AuthorizationResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.AuthorizationResponse} message
+ * @param {!proto.ag.BuildLogRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.AuthorizationResponse.serializeBinaryToWriter = function(message, writer) {
+proto.ag.BuildLogRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getIsauthorized();
-  if (f) {
-    writer.writeBool(
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
       1,
       f
     );
   }
+  f = message.getCommitid();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional bool IsAuthorized = 1;
- * @return {boolean}
+ * optional uint64 courseID = 1;
+ * @return {number}
  */
-proto.ag.AuthorizationResponse.prototype.getIsauthorized = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+proto.ag.BuildLogRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.ag.AuthorizationResponse} returns this
+ * @param {number} value
+ * @return {!proto.ag.BuildLogRequest} returns this
  */
-proto.ag.AuthorizationResponse.prototype.setIsauthorized = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
+proto.ag.BuildLogRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional string commitID = 2;
+ * @return {string}
+ */
+proto.ag.BuildLogRequest.prototype.getCommitid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ag.BuildLogRequest} returns this
+ */
+proto.ag.BuildLogRequest.prototype.setCommitid = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -11361,8 +20785,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.Status.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.Status.toObject(opt_includeInstance, this);
+proto.ag.BuildLogLine.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.BuildLogLine.toObject(opt_includeInstance, this);
 };
 
 
@@ -11371,14 +20795,15 @@ proto.ag.Status.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.Status} msg The msg instance to transform.
+ * @param {!proto.ag.BuildLogLine} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Status.toObject = function(includeInstance, msg) {
+proto.ag.BuildLogLine.toObject = function(includeInstance, msg) {
   var f, obj = {
-    code: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    error: jspb.Message.getFieldWithDefault(msg, 2, "")
+    jobid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    line: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -11392,23 +20817,23 @@ proto.ag.Status.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.Status}
+ * @return {!proto.ag.BuildLogLine}
  */
-proto.ag.Status.deserializeBinary = function(bytes) {
+proto.ag.BuildLogLine.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.Status;
-  return proto.ag.Status.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.BuildLogLine;
+  return proto.ag.BuildLogLine.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.Status} msg The message object to deserialize into.
+ * @param {!proto.ag.BuildLogLine} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.Status}
+ * @return {!proto.ag.BuildLogLine}
  */
-proto.ag.Status.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.BuildLogLine.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -11417,11 +20842,15 @@ proto.ag.Status.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setCode(value);
+      msg.setJobid(value);
       break;
     case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
+      break;
+    case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.setError(value);
+      msg.setLine(value);
       break;
     default:
       reader.skipField();
@@ -11436,9 +20865,9 @@ proto.ag.Status.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.Status.prototype.serializeBinary = function() {
+proto.ag.BuildLogLine.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.Status.serializeBinaryToWriter(this, writer);
+  proto.ag.BuildLogLine.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -11446,23 +20875,30 @@ proto.ag.Status.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.Status} message
+ * @param {!proto.ag.BuildLogLine} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.Status.serializeBinaryToWriter = function(message, writer) {
+proto.ag.BuildLogLine.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCode();
+  f = message.getJobid();
   if (f !== 0) {
     writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getError();
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getLine();
   if (f.length > 0) {
     writer.writeString(
-      2,
+      3,
       f
     );
   }
@@ -11470,38 +20906,56 @@ proto.ag.Status.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * optional uint64 Code = 1;
+ * optional uint64 jobID = 1;
  * @return {number}
  */
-proto.ag.Status.prototype.getCode = function() {
+proto.ag.BuildLogLine.prototype.getJobid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.Status} returns this
+ * @return {!proto.ag.BuildLogLine} returns this
  */
-proto.ag.Status.prototype.setCode = function(value) {
+proto.ag.BuildLogLine.prototype.setJobid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional string Error = 2;
+ * optional uint64 assignmentID = 2;
+ * @return {number}
+ */
+proto.ag.BuildLogLine.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ag.BuildLogLine} returns this
+ */
+proto.ag.BuildLogLine.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string line = 3;
  * @return {string}
  */
-proto.ag.Status.prototype.getError = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.ag.BuildLogLine.prototype.getLine = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ag.Status} returns this
+ * @return {!proto.ag.BuildLogLine} returns this
  */
-proto.ag.Status.prototype.setError = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.ag.BuildLogLine.prototype.setLine = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
@@ -11521,8 +20975,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.SubmissionsForCourseRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.SubmissionsForCourseRequest.toObject(opt_includeInstance, this);
+proto.ag.TestStatisticsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.TestStatisticsRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -11531,15 +20985,15 @@ proto.ag.SubmissionsForCourseRequest.prototype.toObject = function(opt_includeIn
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.SubmissionsForCourseRequest} msg The msg instance to transform.
+ * @param {!proto.ag.TestStatisticsRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.SubmissionsForCourseRequest.toObject = function(includeInstance, msg) {
+proto.ag.TestStatisticsRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    type: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    skipbuildinfo: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
+    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    allattempts: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
   };
 
   if (includeInstance) {
@@ -11553,23 +21007,23 @@ proto.ag.SubmissionsForCourseRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.SubmissionsForCourseRequest}
+ * @return {!proto.ag.TestStatisticsRequest}
  */
-proto.ag.SubmissionsForCourseRequest.deserializeBinary = function(bytes) {
+proto.ag.TestStatisticsRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.SubmissionsForCourseRequest;
-  return proto.ag.SubmissionsForCourseRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.TestStatisticsRequest;
+  return proto.ag.TestStatisticsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.SubmissionsForCourseRequest} msg The message object to deserialize into.
+ * @param {!proto.ag.TestStatisticsRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.SubmissionsForCourseRequest}
+ * @return {!proto.ag.TestStatisticsRequest}
  */
-proto.ag.SubmissionsForCourseRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.TestStatisticsRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -11581,12 +21035,12 @@ proto.ag.SubmissionsForCourseRequest.deserializeBinaryFromReader = function(msg,
       msg.setCourseid(value);
       break;
     case 2:
-      var value = /** @type {!proto.ag.SubmissionsForCourseRequest.Type} */ (reader.readEnum());
-      msg.setType(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAssignmentid(value);
       break;
     case 3:
       var value = /** @type {boolean} */ (reader.readBool());
-      msg.setSkipbuildinfo(value);
+      msg.setAllattempts(value);
       break;
     default:
       reader.skipField();
@@ -11601,9 +21055,9 @@ proto.ag.SubmissionsForCourseRequest.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.SubmissionsForCourseRequest.prototype.serializeBinary = function() {
+proto.ag.TestStatisticsRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.SubmissionsForCourseRequest.serializeBinaryToWriter(this, writer);
+  proto.ag.TestStatisticsRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -11611,11 +21065,11 @@ proto.ag.SubmissionsForCourseRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.SubmissionsForCourseRequest} message
+ * @param {!proto.ag.TestStatisticsRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.SubmissionsForCourseRequest.serializeBinaryToWriter = function(message, writer) {
+proto.ag.TestStatisticsRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getCourseid();
   if (f !== 0) {
@@ -11624,14 +21078,14 @@ proto.ag.SubmissionsForCourseRequest.serializeBinaryToWriter = function(message,
       f
     );
   }
-  f = message.getType();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getAssignmentid();
+  if (f !== 0) {
+    writer.writeUint64(
       2,
       f
     );
   }
-  f = message.getSkipbuildinfo();
+  f = message.getAllattempts();
   if (f) {
     writer.writeBool(
       3,
@@ -11641,65 +21095,56 @@ proto.ag.SubmissionsForCourseRequest.serializeBinaryToWriter = function(message,
 };
 
 
-/**
- * @enum {number}
- */
-proto.ag.SubmissionsForCourseRequest.Type = {
-  ALL: 0,
-  INDIVIDUAL: 1,
-  GROUP: 2
-};
-
 /**
  * optional uint64 courseID = 1;
  * @return {number}
  */
-proto.ag.SubmissionsForCourseRequest.prototype.getCourseid = function() {
+proto.ag.TestStatisticsRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.SubmissionsForCourseRequest} returns this
+ * @return {!proto.ag.TestStatisticsRequest} returns this
  */
-proto.ag.SubmissionsForCourseRequest.prototype.setCourseid = function(value) {
+proto.ag.TestStatisticsRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional Type type = 2;
- * @return {!proto.ag.SubmissionsForCourseRequest.Type}
+ * optional uint64 assignmentID = 2;
+ * @return {number}
  */
-proto.ag.SubmissionsForCourseRequest.prototype.getType = function() {
-  return /** @type {!proto.ag.SubmissionsForCourseRequest.Type} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.ag.TestStatisticsRequest.prototype.getAssignmentid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {!proto.ag.SubmissionsForCourseRequest.Type} value
- * @return {!proto.ag.SubmissionsForCourseRequest} returns this
+ * @param {number} value
+ * @return {!proto.ag.TestStatisticsRequest} returns this
  */
-proto.ag.SubmissionsForCourseRequest.prototype.setType = function(value) {
-  return jspb.Message.setProto3EnumField(this, 2, value);
+proto.ag.TestStatisticsRequest.prototype.setAssignmentid = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional bool skipBuildInfo = 3;
+ * optional bool allAttempts = 3;
  * @return {boolean}
  */
-proto.ag.SubmissionsForCourseRequest.prototype.getSkipbuildinfo = function() {
+proto.ag.TestStatisticsRequest.prototype.getAllattempts = function() {
   return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
 };
 
 
 /**
  * @param {boolean} value
- * @return {!proto.ag.SubmissionsForCourseRequest} returns this
+ * @return {!proto.ag.TestStatisticsRequest} returns this
  */
-proto.ag.SubmissionsForCourseRequest.prototype.setSkipbuildinfo = function(value) {
+proto.ag.TestStatisticsRequest.prototype.setAllattempts = function(value) {
   return jspb.Message.setProto3BooleanField(this, 3, value);
 };
 
@@ -11720,8 +21165,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.RebuildRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.RebuildRequest.toObject(opt_includeInstance, this);
+proto.ag.SimilarityRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.SimilarityRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -11730,13 +21175,13 @@ proto.ag.RebuildRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.RebuildRequest} msg The msg instance to transform.
+ * @param {!proto.ag.SimilarityRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.RebuildRequest.toObject = function(includeInstance, msg) {
+proto.ag.SimilarityRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    submissionid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
     assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
@@ -11751,23 +21196,23 @@ proto.ag.RebuildRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.RebuildRequest}
+ * @return {!proto.ag.SimilarityRequest}
  */
-proto.ag.RebuildRequest.deserializeBinary = function(bytes) {
+proto.ag.SimilarityRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.RebuildRequest;
-  return proto.ag.RebuildRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.SimilarityRequest;
+  return proto.ag.SimilarityRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.RebuildRequest} msg The message object to deserialize into.
+ * @param {!proto.ag.SimilarityRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.RebuildRequest}
+ * @return {!proto.ag.SimilarityRequest}
  */
-proto.ag.RebuildRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.SimilarityRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -11776,7 +21221,7 @@ proto.ag.RebuildRequest.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setSubmissionid(value);
+      msg.setCourseid(value);
       break;
     case 2:
       var value = /** @type {number} */ (reader.readUint64());
@@ -11795,9 +21240,9 @@ proto.ag.RebuildRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.RebuildRequest.prototype.serializeBinary = function() {
+proto.ag.SimilarityRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.RebuildRequest.serializeBinaryToWriter(this, writer);
+  proto.ag.SimilarityRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -11805,13 +21250,13 @@ proto.ag.RebuildRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.RebuildRequest} message
+ * @param {!proto.ag.SimilarityRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.RebuildRequest.serializeBinaryToWriter = function(message, writer) {
+proto.ag.SimilarityRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSubmissionid();
+  f = message.getCourseid();
   if (f !== 0) {
     writer.writeUint64(
       1,
@@ -11829,19 +21274,19 @@ proto.ag.RebuildRequest.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * optional uint64 submissionID = 1;
+ * optional uint64 courseID = 1;
  * @return {number}
  */
-proto.ag.RebuildRequest.prototype.getSubmissionid = function() {
+proto.ag.SimilarityRequest.prototype.getCourseid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.RebuildRequest} returns this
+ * @return {!proto.ag.SimilarityRequest} returns this
  */
-proto.ag.RebuildRequest.prototype.setSubmissionid = function(value) {
+proto.ag.SimilarityRequest.prototype.setCourseid = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
@@ -11850,21 +21295,28 @@ proto.ag.RebuildRequest.prototype.setSubmissionid = function(value) {
  * optional uint64 assignmentID = 2;
  * @return {number}
  */
-proto.ag.RebuildRequest.prototype.getAssignmentid = function() {
+proto.ag.SimilarityRequest.prototype.getAssignmentid = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.RebuildRequest} returns this
+ * @return {!proto.ag.SimilarityRequest} returns this
  */
-proto.ag.RebuildRequest.prototype.setAssignmentid = function(value) {
+proto.ag.SimilarityRequest.prototype.setAssignmentid = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ag.GradebookRequest.repeatedFields_ = [5,6];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -11880,8 +21332,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.CourseUserRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.CourseUserRequest.toObject(opt_includeInstance, this);
+proto.ag.GradebookRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.GradebookRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -11890,15 +21342,18 @@ proto.ag.CourseUserRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.CourseUserRequest} msg The msg instance to transform.
+ * @param {!proto.ag.GradebookRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.CourseUserRequest.toObject = function(includeInstance, msg) {
+proto.ag.GradebookRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    coursecode: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    courseyear: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    userlogin: jspb.Message.getFieldWithDefault(msg, 3, "")
+    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    format: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    groups: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    minapproved: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    requiredassignmentsList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f,
+    ignoredloginsList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -11912,23 +21367,23 @@ proto.ag.CourseUserRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.CourseUserRequest}
+ * @return {!proto.ag.GradebookRequest}
  */
-proto.ag.CourseUserRequest.deserializeBinary = function(bytes) {
+proto.ag.GradebookRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.CourseUserRequest;
-  return proto.ag.CourseUserRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.GradebookRequest;
+  return proto.ag.GradebookRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.CourseUserRequest} msg The message object to deserialize into.
+ * @param {!proto.ag.GradebookRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.CourseUserRequest}
+ * @return {!proto.ag.GradebookRequest}
  */
-proto.ag.CourseUserRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.GradebookRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -11936,16 +21391,28 @@ proto.ag.CourseUserRequest.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setCoursecode(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCourseid(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setCourseyear(value);
+      var value = /** @type {!proto.ag.GradebookRequest.Format} */ (reader.readEnum());
+      msg.setFormat(value);
       break;
     case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setGroups(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setMinapproved(value);
+      break;
+    case 5:
       var value = /** @type {string} */ (reader.readString());
-      msg.setUserlogin(value);
+      msg.addRequiredassignments(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addIgnoredlogins(value);
       break;
     default:
       reader.skipField();
@@ -11960,9 +21427,9 @@ proto.ag.CourseUserRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.CourseUserRequest.prototype.serializeBinary = function() {
+proto.ag.GradebookRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.CourseUserRequest.serializeBinaryToWriter(this, writer);
+  proto.ag.GradebookRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -11970,30 +21437,51 @@ proto.ag.CourseUserRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.CourseUserRequest} message
+ * @param {!proto.ag.GradebookRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.CourseUserRequest.serializeBinaryToWriter = function(message, writer) {
+proto.ag.GradebookRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCoursecode();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getCourseid();
+  if (f !== 0) {
+    writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getCourseyear();
+  f = message.getFormat();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+  f = message.getGroups();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getMinapproved();
   if (f !== 0) {
     writer.writeUint32(
-      2,
+      4,
       f
     );
   }
-  f = message.getUserlogin();
+  f = message.getRequiredassignmentsList();
   if (f.length > 0) {
-    writer.writeString(
-      3,
+    writer.writeRepeatedString(
+      5,
+      f
+    );
+  }
+  f = message.getIgnoredloginsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      6,
       f
     );
   }
@@ -12001,56 +21489,158 @@ proto.ag.CourseUserRequest.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * optional string courseCode = 1;
- * @return {string}
+ * @enum {number}
  */
-proto.ag.CourseUserRequest.prototype.getCoursecode = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.ag.GradebookRequest.Format = {
+  CSV: 0,
+  XLSX: 1,
+  CANVAS: 2,
+  MOODLE: 3
+};
+
+/**
+ * optional uint64 courseID = 1;
+ * @return {number}
+ */
+proto.ag.GradebookRequest.prototype.getCourseid = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.ag.CourseUserRequest} returns this
+ * @param {number} value
+ * @return {!proto.ag.GradebookRequest} returns this
  */
-proto.ag.CourseUserRequest.prototype.setCoursecode = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.ag.GradebookRequest.prototype.setCourseid = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint32 courseYear = 2;
+ * optional Format format = 2;
+ * @return {!proto.ag.GradebookRequest.Format}
+ */
+proto.ag.GradebookRequest.prototype.getFormat = function() {
+  return /** @type {!proto.ag.GradebookRequest.Format} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {!proto.ag.GradebookRequest.Format} value
+ * @return {!proto.ag.GradebookRequest} returns this
+ */
+proto.ag.GradebookRequest.prototype.setFormat = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
+
+
+/**
+ * optional bool groups = 3;
+ * @return {boolean}
+ */
+proto.ag.GradebookRequest.prototype.getGroups = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ag.GradebookRequest} returns this
+ */
+proto.ag.GradebookRequest.prototype.setGroups = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+/**
+ * optional uint32 minApproved = 4;
  * @return {number}
  */
-proto.ag.CourseUserRequest.prototype.getCourseyear = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.ag.GradebookRequest.prototype.getMinapproved = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.ag.CourseUserRequest} returns this
+ * @return {!proto.ag.GradebookRequest} returns this
  */
-proto.ag.CourseUserRequest.prototype.setCourseyear = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.ag.GradebookRequest.prototype.setMinapproved = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * optional string userLogin = 3;
- * @return {string}
+ * repeated string requiredAssignments = 5;
+ * @return {!Array<string>}
  */
-proto.ag.CourseUserRequest.prototype.getUserlogin = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.ag.GradebookRequest.prototype.getRequiredassignmentsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 5));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.ag.GradebookRequest} returns this
+ */
+proto.ag.GradebookRequest.prototype.setRequiredassignmentsList = function(value) {
+  return jspb.Message.setField(this, 5, value || []);
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.ag.CourseUserRequest} returns this
+ * @param {number=} opt_index
+ * @return {!proto.ag.GradebookRequest} returns this
  */
-proto.ag.CourseUserRequest.prototype.setUserlogin = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.ag.GradebookRequest.prototype.addRequiredassignments = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.GradebookRequest} returns this
+ */
+proto.ag.GradebookRequest.prototype.clearRequiredassignmentsList = function() {
+  return this.setRequiredassignmentsList([]);
+};
+
+
+/**
+ * repeated string ignoredLogins = 6;
+ * @return {!Array<string>}
+ */
+proto.ag.GradebookRequest.prototype.getIgnoredloginsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 6));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.ag.GradebookRequest} returns this
+ */
+proto.ag.GradebookRequest.prototype.setIgnoredloginsList = function(value) {
+  return jspb.Message.setField(this, 6, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.ag.GradebookRequest} returns this
+ */
+proto.ag.GradebookRequest.prototype.addIgnoredlogins = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 6, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ag.GradebookRequest} returns this
+ */
+proto.ag.GradebookRequest.prototype.clearIgnoredloginsList = function() {
+  return this.setIgnoredloginsList([]);
 };
 
 
@@ -12070,8 +21660,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ag.LoadCriteriaRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.ag.LoadCriteriaRequest.toObject(opt_includeInstance, this);
+proto.ag.GradebookFile.prototype.toObject = function(opt_includeInstance) {
+  return proto.ag.GradebookFile.toObject(opt_includeInstance, this);
 };
 
 
@@ -12080,14 +21670,15 @@ proto.ag.LoadCriteriaRequest.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ag.LoadCriteriaRequest} msg The msg instance to transform.
+ * @param {!proto.ag.GradebookFile} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.LoadCriteriaRequest.toObject = function(includeInstance, msg) {
+proto.ag.GradebookFile.toObject = function(includeInstance, msg) {
   var f, obj = {
-    courseid: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    assignmentid: jspb.Message.getFieldWithDefault(msg, 2, 0)
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    contenttype: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    content: msg.getContent_asB64()
   };
 
   if (includeInstance) {
@@ -12101,23 +21692,23 @@ proto.ag.LoadCriteriaRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ag.LoadCriteriaRequest}
+ * @return {!proto.ag.GradebookFile}
  */
-proto.ag.LoadCriteriaRequest.deserializeBinary = function(bytes) {
+proto.ag.GradebookFile.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ag.LoadCriteriaRequest;
-  return proto.ag.LoadCriteriaRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ag.GradebookFile;
+  return proto.ag.GradebookFile.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ag.LoadCriteriaRequest} msg The message object to deserialize into.
+ * @param {!proto.ag.GradebookFile} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ag.LoadCriteriaRequest}
+ * @return {!proto.ag.GradebookFile}
  */
-proto.ag.LoadCriteriaRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.ag.GradebookFile.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -12125,12 +21716,16 @@ proto.ag.LoadCriteriaRequest.deserializeBinaryFromReader = function(msg, reader)
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setCourseid(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setAssignmentid(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setContenttype(value);
+      break;
+    case 3:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setContent(value);
       break;
     default:
       reader.skipField();
@@ -12145,9 +21740,9 @@ proto.ag.LoadCriteriaRequest.deserializeBinaryFromReader = function(msg, reader)
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ag.LoadCriteriaRequest.prototype.serializeBinary = function() {
+proto.ag.GradebookFile.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ag.LoadCriteriaRequest.serializeBinaryToWriter(this, writer);
+  proto.ag.GradebookFile.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -12155,62 +21750,111 @@ proto.ag.LoadCriteriaRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ag.LoadCriteriaRequest} message
+ * @param {!proto.ag.GradebookFile} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ag.LoadCriteriaRequest.serializeBinaryToWriter = function(message, writer) {
+proto.ag.GradebookFile.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCourseid();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getAssignmentid();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getContenttype();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
   }
+  f = message.getContent_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * optional uint64 courseID = 1;
- * @return {number}
+ * optional string name = 1;
+ * @return {string}
  */
-proto.ag.LoadCriteriaRequest.prototype.getCourseid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.ag.GradebookFile.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.LoadCriteriaRequest} returns this
+ * @param {string} value
+ * @return {!proto.ag.GradebookFile} returns this
  */
-proto.ag.LoadCriteriaRequest.prototype.setCourseid = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.ag.GradebookFile.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional uint64 assignmentID = 2;
- * @return {number}
+ * optional string contentType = 2;
+ * @return {string}
  */
-proto.ag.LoadCriteriaRequest.prototype.getAssignmentid = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.ag.GradebookFile.prototype.getContenttype = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.ag.LoadCriteriaRequest} returns this
+ * @param {string} value
+ * @return {!proto.ag.GradebookFile} returns this
  */
-proto.ag.LoadCriteriaRequest.prototype.setAssignmentid = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.ag.GradebookFile.prototype.setContenttype = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional bytes content = 3;
+ * @return {!(string|Uint8Array)}
+ */
+proto.ag.GradebookFile.prototype.getContent = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * optional bytes content = 3;
+ * This is a type-conversion wrapper around `getContent()`
+ * @return {string}
+ */
+proto.ag.GradebookFile.prototype.getContent_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getContent()));
+};
+
+
+/**
+ * optional bytes content = 3;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getContent()`
+ * @return {!Uint8Array}
+ */
+proto.ag.GradebookFile.prototype.getContent_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getContent()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.ag.GradebookFile} returns this
+ */
+proto.ag.GradebookFile.prototype.setContent = function(value) {
+  return jspb.Message.setProto3BytesField(this, 3, value);
 };
 
 
@@ -0,0 +1,386 @@
+// Package scheduler performs the actions that are due at the deadlines and release dates
+// of assignments. At the deadline of each student or group, taking deadline extensions and
+// the course's grace period into account, the commit of their repository is recorded as a
+// deadline snapshot, and repositories without any build can be given a final build. The
+// commit of the snapshot is built again if the assignment has hidden tests that only run
+// at the deadline. At an assignment's release date, the reviews of its submissions are released.
+//
+// The schedule is computed from the database rather than kept in memory, so that actions
+// that became due while the server was stopped are performed when it is restarted, and
+// changed deadlines, extensions and release dates are picked up without rescheduling.
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/database"
+	"go.uber.org/zap"
+)
+
+// defaultInterval is the longest time between checks for changed deadlines and release dates.
+const defaultInterval = time.Minute
+
+// Clock tells the current time and signals when a duration has elapsed.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+// systemClock is the clock of the operating system.
+type systemClock struct{}
+
+func (systemClock) Now() time.Time                         { return time.Now() }
+func (systemClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// Builder queues build jobs; it is implemented by ci.Queue.
+type Builder interface {
+	Enqueue(rData *ci.RunData) (*pb.BuildJob, error)
+}
+
+// Options configures the scheduler.
+type Options struct {
+	// Clock tells the time at which actions are due. Defaults to the system clock.
+	Clock Clock
+	// Interval is the longest time between checks for changed deadlines and
+	// release dates. Defaults to one minute.
+	Interval time.Duration
+}
+
+// Scheduler performs the actions that are due at the deadlines and release dates of assignments.
+type Scheduler struct {
+	logger  *zap.SugaredLogger
+	db      database.Database
+	builder Builder
+	opts    Options
+}
+
+// New returns a new scheduler that queues final builds with the given builder.
+// The scheduler does not perform any actions until Start is called.
+func New(logger *zap.SugaredLogger, db database.Database, builder Builder, opts Options) *Scheduler {
+	if opts.Clock == nil {
+		opts.Clock = systemClock{}
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = defaultInterval
+	}
+	return &Scheduler{
+		logger:  logger,
+		db:      db,
+		builder: builder,
+		opts:    opts,
+	}
+}
+
+// Start performs the actions that are due, and then performs actions as they
+// become due until ctx is canceled.
+func (s *Scheduler) Start(ctx context.Context) {
+	go s.run(ctx)
+}
+
+// run performs actions as they become due until ctx is canceled.
+func (s *Scheduler) run(ctx context.Context) {
+	for {
+		next, err := s.RunDue()
+		if err != nil {
+			s.logger.Errorf("Failed to run scheduled actions: %v", err)
+		}
+		wait := s.opts.Interval
+		if !next.IsZero() {
+			if untilNext := next.Sub(s.opts.Clock.Now()); untilNext < wait {
+				wait = untilNext
+			}
+		}
+		select {
+		case <-s.opts.Clock.After(wait):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RunDue performs the actions that are due at the current time of the scheduler's clock,
+// and returns the time at which the next action is due, or the zero time if no action is
+// scheduled. The actions of a course are skipped if they fail, and retried when RunDue
+// is called again.
+func (s *Scheduler) RunDue() (time.Time, error) {
+	now := s.opts.Clock.Now()
+	courses, err := s.db.GetCourses()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get courses: %w", err)
+	}
+	var next time.Time
+	for _, course := range courses {
+		courseNext, err := s.runCourse(now, course)
+		if err != nil {
+			s.logger.Errorf("Failed to run scheduled actions for course %d: %v", course.GetID(), err)
+			continue
+		}
+		next = earliest(next, courseNext)
+	}
+	return next, nil
+}
+
+// owner is a student or group whose repository is snapshotted at the deadlines of assignments.
+type owner struct {
+	enrollment *pb.Enrollment // the user or group the deadline extensions apply to
+	repo       *pb.Repository
+	name       string
+}
+
+// dueSnapshot is a snapshot of an owner's repository that is due.
+type dueSnapshot struct {
+	assignment *pb.Assignment
+	owner      *owner
+	deadline   string
+	existing   *pb.DeadlineSnapshot
+}
+
+// runCourse performs the due actions of the given course's assignments,
+// and returns the time at which the course's next action is due.
+func (s *Scheduler) runCourse(now time.Time, course *pb.Course) (time.Time, error) {
+	assignments, err := s.db.GetCourseAssignmentsWithSubmissionsNoBuildInfo(course.GetID(), pb.SubmissionsForCourseRequest_ALL)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if len(assignments) == 0 {
+		return time.Time{}, nil
+	}
+	extensions, err := s.db.GetDeadlineExtensions(course.GetID())
+	if err != nil {
+		return time.Time{}, err
+	}
+	students, groups, err := s.owners(course)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var next time.Time
+	var due []*dueSnapshot
+	for _, assignment := range assignments {
+		releaseNext, err := s.release(now, assignment)
+		if err != nil {
+			return time.Time{}, err
+		}
+		next = earliest(next, releaseNext)
+
+		owners := students
+		if assignment.GetIsGroupLab() {
+			owners = groups
+		}
+		assignmentDue, snapshotNext, err := s.dueSnapshots(now, course, assignment, owners, extensions)
+		if err != nil {
+			return time.Time{}, err
+		}
+		due = append(due, assignmentDue...)
+		next = earliest(next, snapshotNext)
+	}
+	if len(due) == 0 {
+		return next, nil
+	}
+
+	// the build jobs and attempts are only needed to take the snapshots that are due
+	jobs, err := s.db.GetBuildJobs(course.GetID(), pb.BuildJob_QUEUED, pb.BuildJob_RUNNING, pb.BuildJob_DONE, pb.BuildJob_FAILED)
+	if err != nil {
+		return time.Time{}, err
+	}
+	attempts, err := s.db.GetCourseSubmissionAttempts(course.GetID())
+	if err != nil {
+		return time.Time{}, err
+	}
+	attemptsBySubmission := make(map[uint64][]*pb.SubmissionAttempt)
+	for _, attempt := range attempts {
+		attemptsBySubmission[attempt.GetSubmissionID()] = append(attemptsBySubmission[attempt.GetSubmissionID()], attempt)
+	}
+	for _, snapshot := range due {
+		if err := s.snapshot(now, course, snapshot, jobs, attemptsBySubmission); err != nil {
+			s.logger.Errorf("Failed to take deadline snapshot of assignment %d for %s: %v", snapshot.assignment.GetID(), snapshot.owner.name, err)
+		}
+	}
+	return next, nil
+}
+
+// owners returns the students and the approved groups of the given course that have repositories.
+func (s *Scheduler) owners(course *pb.Course) (students, groups []*owner, err error) {
+	repos, err := s.db.GetRepositories(&pb.Repository{OrganizationID: course.GetOrganizationID()})
+	if err != nil {
+		return nil, nil, err
+	}
+	userRepos := make(map[uint64]*pb.Repository)
+	groupRepos := make(map[uint64]*pb.Repository)
+	for _, repo := range repos {
+		switch {
+		case repo.IsUserRepo():
+			userRepos[repo.GetUserID()] = repo
+		case repo.IsGroupRepo():
+			groupRepos[repo.GetGroupID()] = repo
+		}
+	}
+
+	enrollments, err := s.db.GetEnrollmentsByCourse(course.GetID(), pb.Enrollment_STUDENT)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, enrollment := range enrollments {
+		if repo, ok := userRepos[enrollment.GetUserID()]; ok {
+			students = append(students, &owner{
+				enrollment: &pb.Enrollment{CourseID: course.GetID(), UserID: enrollment.GetUserID(), Course: course},
+				repo:       repo,
+				name:       enrollment.GetUser().GetLogin(),
+			})
+		}
+	}
+	approved, err := s.db.GetGroupsByCourse(course.GetID(), pb.Group_APPROVED)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, group := range approved {
+		if repo, ok := groupRepos[group.GetID()]; ok {
+			groups = append(groups, &owner{
+				enrollment: &pb.Enrollment{CourseID: course.GetID(), GroupID: group.GetID(), Course: course},
+				repo:       repo,
+				name:       group.GetName(),
+			})
+		}
+	}
+	return students, groups, nil
+}
+
+// dueSnapshots returns the snapshots of the given owners' repositories for the given assignment
+// that are due, and the time at which the next snapshot of the assignment is due.
+// The existing snapshot of an owner whose deadline has been moved later is removed,
+// since the snapshot is taken again at the new deadline.
+func (s *Scheduler) dueSnapshots(now time.Time, course *pb.Course, assignment *pb.Assignment, owners []*owner, extensions []*pb.DeadlineExtension) ([]*dueSnapshot, time.Time, error) {
+	if _, err := assignment.SinceDeadline(now); err != nil {
+		// assignments without a valid deadline have no snapshots
+		return nil, time.Time{}, nil
+	}
+	snapshots, err := s.db.GetDeadlineSnapshots(assignment.GetID())
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	existing := make(map[[2]uint64]*pb.DeadlineSnapshot)
+	for _, snapshot := range snapshots {
+		existing[[2]uint64{snapshot.GetUserID(), snapshot.GetGroupID()}] = snapshot
+	}
+
+	var next time.Time
+	var due []*dueSnapshot
+	for _, owner := range owners {
+		sinceDeadline, err := owner.enrollment.SinceDeadline(now, assignment, extensions)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		deadline := now.Add(-sinceDeadline).Add(course.GracePeriodDuration())
+		snapshot := existing[[2]uint64{owner.enrollment.GetUserID(), owner.enrollment.GetGroupID()}]
+		if snapshot.GetDeadline() == deadline.Format(pb.TimeLayout) {
+			continue
+		}
+		if deadline.After(now) {
+			if snapshot != nil {
+				s.logger.Debugf("Removing deadline snapshot of assignment %d for %s, since the deadline has been moved", assignment.GetID(), owner.name)
+				if err := s.db.DeleteDeadlineSnapshot(snapshot.GetID()); err != nil {
+					return nil, time.Time{}, err
+				}
+			}
+			next = earliest(next, deadline)
+			continue
+		}
+		due = append(due, &dueSnapshot{
+			assignment: assignment,
+			owner:      owner,
+			deadline:   deadline.Format(pb.TimeLayout),
+			existing:   snapshot,
+		})
+	}
+	return due, next, nil
+}
+
+// snapshot records the commit of the owner's repository at the deadline of the due snapshot,
+// which is the last commit pushed before the deadline, according to the build jobs queued
+// for the repository and the attempts recorded for the owner's submission. If the assignment
+// has final builds, a repository that never triggered a build is built, and if the assignment
+// has hidden tests that only run at the deadline, the commit of the snapshot is built.
+func (s *Scheduler) snapshot(now time.Time, course *pb.Course, due *dueSnapshot, jobs []*pb.BuildJob, attempts map[uint64][]*pb.SubmissionAttempt) error {
+	assignment, owner := due.assignment, due.owner
+	var commitHash, pushedDate string
+	built := false
+	// dates in pb.TimeLayout compare in chronological order
+	push := func(commit, date string) {
+		built = true
+		if date <= due.deadline && date >= pushedDate {
+			commitHash, pushedDate = commit, date
+		}
+	}
+	for _, job := range jobs {
+		if job.GetAssignmentID() == assignment.GetID() && job.GetRepositoryID() == owner.repo.GetID() {
+			push(job.GetCommitID(), job.GetQueuedDate())
+		}
+	}
+	for _, submission := range assignment.GetSubmissions() {
+		if submission.GetUserID() != owner.enrollment.GetUserID() || submission.GetGroupID() != owner.enrollment.GetGroupID() {
+			continue
+		}
+		for _, attempt := range attempts[submission.GetID()] {
+			push(attempt.GetCommitHash(), attempt.GetCreatedDate())
+		}
+	}
+
+	snapshot := &pb.DeadlineSnapshot{
+		AssignmentID: assignment.GetID(),
+		UserID:       owner.enrollment.GetUserID(),
+		GroupID:      owner.enrollment.GetGroupID(),
+		CommitHash:   commitHash,
+		Deadline:     due.deadline,
+		SnapshotDate: now.Format(pb.TimeLayout),
+		Frozen:       assignment.GetFreezeAtDeadline(),
+		BuildJobID:   due.existing.GetBuildJobID(),
+	}
+	finalBuild := !built && assignment.GetFinalBuild()
+	hiddenTests := commitHash != "" && assignment.GetHiddenAtDeadline() && assignment.GetHiddenTests() != ""
+	if (finalBuild || hiddenTests) && !assignment.GetSkipTests() && s.builder != nil {
+		job, err := s.builder.Enqueue(&ci.RunData{
+			Course:     course,
+			Assignment: assignment,
+			Repo:       owner.repo,
+			CommitID:   commitHash,
+			JobOwner:   owner.name,
+			Deadline:   true,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to queue deadline build: %w", err)
+		}
+		snapshot.BuildJobID = job.GetID()
+	}
+	s.logger.Debugf("Taking deadline snapshot of assignment %d for %s at commit %q", assignment.GetID(), owner.name, commitHash)
+	return s.db.CreateDeadlineSnapshot(snapshot)
+}
+
+// release releases the reviews of the given assignment's submissions if its release date has passed,
+// and returns the release date if it has not yet passed.
+func (s *Scheduler) release(now time.Time, assignment *pb.Assignment) (time.Time, error) {
+	if assignment.GetReleaseDate() == "" || assignment.GetReleasedDate() != "" {
+		return time.Time{}, nil
+	}
+	releaseDate, err := time.ParseInLocation(pb.TimeLayout, assignment.GetReleaseDate(), now.Location())
+	if err != nil {
+		// assignments without a valid release date are not released
+		return time.Time{}, nil
+	}
+	if releaseDate.After(now) {
+		return releaseDate, nil
+	}
+	s.logger.Debugf("Releasing reviews of assignment %d", assignment.GetID())
+	return time.Time{}, s.db.ReleaseReviews(assignment.GetID(), now.Format(pb.TimeLayout))
+}
+
+// earliest returns the earliest of the given times, ignoring zero times.
+func earliest(a, b time.Time) time.Time {
+	if a.IsZero() || (!b.IsZero() && b.Before(a)) {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,425 @@
+package scheduler_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/scheduler"
+	"go.uber.org/zap"
+)
+
+// fakeClock is a clock whose time only changes when it is advanced.
+type fakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters map[chan time.Time]time.Time
+}
+
+func newFakeClock(now time.Time) *fakeClock {
+	return &fakeClock{now: now, waiters: make(map[chan time.Time]time.Time)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ch := make(chan time.Time, 1)
+	if d <= 0 {
+		ch <- c.now
+		return ch
+	}
+	c.waiters[ch] = c.now.Add(d)
+	return ch
+}
+
+// Advance moves the clock forward by d, and signals the waiters whose durations have elapsed.
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	for ch, at := range c.waiters {
+		if !at.After(c.now) {
+			ch <- c.now
+			delete(c.waiters, ch)
+		}
+	}
+}
+
+// fakeBuilder records the run data of the build jobs queued by the scheduler.
+type fakeBuilder struct {
+	mu   sync.Mutex
+	db   database.Database
+	jobs []*ci.RunData
+}
+
+func (b *fakeBuilder) Enqueue(rData *ci.RunData) (*pb.BuildJob, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.jobs = append(b.jobs, rData)
+	job := &pb.BuildJob{
+		CourseID:     rData.Course.GetID(),
+		AssignmentID: rData.Assignment.GetID(),
+		RepositoryID: rData.Repo.GetID(),
+		JobOwner:     rData.JobOwner,
+		QueuedDate:   time.Now().Format(pb.TimeLayout),
+	}
+	return job, b.db.CreateBuildJob(job)
+}
+
+func (b *fakeBuilder) queued() []*ci.RunData {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]*ci.RunData{}, b.jobs...)
+}
+
+// testCourse holds a course with students that have repositories.
+type testCourse struct {
+	db       database.Database
+	course   *pb.Course
+	students map[string]*pb.User
+	repos    map[string]*pb.Repository
+}
+
+func setup(t *testing.T, logins ...string) (*testCourse, func()) {
+	t.Helper()
+	f, err := ioutil.TempFile(os.TempDir(), "testdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	db, err := database.NewGormDB(f.Name(), zap.NewNop())
+	if err != nil {
+		os.Remove(f.Name())
+		t.Fatal(err)
+	}
+
+	tc := &testCourse{db: db, students: make(map[string]*pb.User), repos: make(map[string]*pb.Repository)}
+	teacher := &pb.User{Login: "teacher"}
+	if err := db.CreateUserFromRemoteIdentity(teacher, &pb.RemoteIdentity{Provider: "fake", RemoteID: 1}); err != nil {
+		t.Fatal(err)
+	}
+	tc.course = &pb.Course{OrganizationID: 1, GracePeriod: "0s"}
+	if err := db.CreateCourse(teacher.ID, tc.course); err != nil {
+		t.Fatal(err)
+	}
+	for i, login := range logins {
+		student := &pb.User{Login: login}
+		if err := db.CreateUserFromRemoteIdentity(student, &pb.RemoteIdentity{Provider: "fake", RemoteID: uint64(i + 2)}); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: tc.course.ID}); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: tc.course.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+			t.Fatal(err)
+		}
+		repo := &pb.Repository{OrganizationID: 1, RepositoryID: uint64(i + 1), UserID: student.ID, RepoType: pb.Repository_USER}
+		if err := db.CreateRepository(repo); err != nil {
+			t.Fatal(err)
+		}
+		tc.students[login] = student
+		tc.repos[login] = repo
+	}
+	return tc, func() { os.Remove(f.Name()) }
+}
+
+// push records a build of the given commit to the student's repository.
+func (tc *testCourse) push(t *testing.T, assignment *pb.Assignment, login, commit string, score uint32) *pb.Submission {
+	t.Helper()
+	submission := &pb.Submission{AssignmentID: assignment.ID, UserID: tc.students[login].ID, CommitHash: commit, Score: score}
+	if err := tc.db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+	return submission
+}
+
+func (tc *testCourse) snapshots(t *testing.T, assignment *pb.Assignment) map[uint64]*pb.DeadlineSnapshot {
+	t.Helper()
+	snapshots, err := tc.db.GetDeadlineSnapshots(assignment.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	byUser := make(map[uint64]*pb.DeadlineSnapshot)
+	for _, snapshot := range snapshots {
+		byUser[snapshot.GetUserID()] = snapshot
+	}
+	return byUser
+}
+
+func TestRunDue(t *testing.T) {
+	tc, cleanup := setup(t, "alice", "bob", "carol", "dave")
+	defer cleanup()
+	db := tc.db
+	alice, bob, carol, dave := tc.students["alice"], tc.students["bob"], tc.students["carol"], tc.students["dave"]
+
+	// the builds are recorded with the system time, so the deadline is an hour from now
+	now := time.Now().Truncate(time.Second)
+	deadline := now.Add(time.Hour)
+	lab := &pb.Assignment{
+		CourseID:         tc.course.ID,
+		Name:             "lab1",
+		Order:            1,
+		Deadline:         deadline.Format(pb.TimeLayout),
+		FreezeAtDeadline: true,
+		FinalBuild:       true,
+		ReleaseDate:      deadline.Add(3 * time.Hour).Format(pb.TimeLayout),
+	}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+	graded := tc.push(t, lab, "alice", "a1", 50)
+	// bob's push is queued but not yet built at the deadline
+	if err := db.CreateBuildJob(&pb.BuildJob{
+		CourseID:     tc.course.ID,
+		AssignmentID: lab.ID,
+		RepositoryID: tc.repos["bob"].ID,
+		CommitID:     "b1",
+		Status:       pb.BuildJob_QUEUED,
+		QueuedDate:   now.Format(pb.TimeLayout),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	// carol's deadline is extended by two hours
+	extended := deadline.Add(2 * time.Hour)
+	if err := db.CreateDeadlineExtension(&pb.DeadlineExtension{AssignmentID: lab.ID, UserID: carol.ID, Deadline: extended.Format(pb.TimeLayout)}); err != nil {
+		t.Fatal(err)
+	}
+
+	clock := newFakeClock(now)
+	builder := &fakeBuilder{db: db}
+	s := scheduler.New(zap.NewNop().Sugar(), db, builder, scheduler.Options{Clock: clock})
+
+	next, err := s.RunDue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !next.Equal(deadline) {
+		t.Errorf("RunDue() before the deadline = %v, want next action at the deadline %v", next, deadline)
+	}
+	if snapshots := tc.snapshots(t, lab); len(snapshots) != 0 {
+		t.Errorf("got %d snapshots before the deadline, want none", len(snapshots))
+	}
+
+	clock.Advance(time.Hour)
+	next, err = s.RunDue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !next.Equal(extended) {
+		t.Errorf("RunDue() at the deadline = %v, want next action at the extended deadline %v", next, extended)
+	}
+	snapshots := tc.snapshots(t, lab)
+	for user, wantCommit := range map[*pb.User]string{alice: "a1", bob: "b1", dave: ""} {
+		snapshot, ok := snapshots[user.ID]
+		if !ok {
+			t.Errorf("missing snapshot for %s", user.Login)
+			continue
+		}
+		if snapshot.GetCommitHash() != wantCommit || !snapshot.GetFrozen() || snapshot.GetDeadline() != lab.Deadline {
+			t.Errorf("snapshot for %s = %v, want frozen snapshot of commit %q at %s", user.Login, snapshot, wantCommit, lab.Deadline)
+		}
+	}
+	if _, ok := snapshots[carol.ID]; ok {
+		t.Errorf("got snapshot for %s before the extended deadline", carol.Login)
+	}
+	// only dave's repository never triggered a build
+	if jobs := builder.queued(); len(jobs) != 1 || jobs[0].Repo.GetUserID() != dave.ID || jobs[0].JobOwner != dave.Login {
+		t.Errorf("queued final builds %v, want one for %s", jobs, dave.Login)
+	} else if snapshots[dave.ID].GetBuildJobID() == 0 {
+		t.Errorf("snapshot for %s does not record the final build", dave.Login)
+	}
+
+	// builds after the deadline are recorded, but alice's graded attempt stays at the snapshot
+	late := tc.push(t, lab, "alice", "a2", 100)
+	if late.GetGradedAttemptID() != graded.GetGradedAttemptID() {
+		t.Errorf("graded attempt after the deadline = %d, want %d", late.GetGradedAttemptID(), graded.GetGradedAttemptID())
+	}
+	got, err := db.GetSubmission(&pb.Submission{ID: graded.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.GetScore() != 50 || got.GetCommitHash() != "a1" {
+		t.Errorf("graded submission = (score %d, commit %q), want (score 50, commit %q)", got.GetScore(), got.GetCommitHash(), "a1")
+	}
+	attempts, err := db.GetSubmissionAttempts(graded.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(attempts) != 2 {
+		t.Errorf("got %d attempts, want 2", len(attempts))
+	}
+	// the build of bob's commit at the deadline is graded when it completes
+	if submission := tc.push(t, lab, "bob", "b1", 70); submission.GetGradedAttemptID() == 0 {
+		t.Errorf("build of the snapshot commit of %s is not graded", bob.Login)
+	}
+
+	clock.Advance(2 * time.Hour)
+	next, err = s.RunDue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := deadline.Add(3 * time.Hour); !next.Equal(want) {
+		t.Errorf("RunDue() at the extended deadline = %v, want next action at the release date %v", next, want)
+	}
+	if snapshot, ok := tc.snapshots(t, lab)[carol.ID]; !ok || snapshot.GetDeadline() != extended.Format(pb.TimeLayout) {
+		t.Errorf("snapshot for %s = %v, want snapshot at %s", carol.Login, snapshot, extended.Format(pb.TimeLayout))
+	}
+	// carol never pushed either, so her repository is built at her extended deadline
+	if jobs := builder.queued(); len(jobs) != 2 || jobs[1].JobOwner != carol.Login {
+		t.Errorf("queued final builds %v, want a second one for %s", jobs, carol.Login)
+	}
+
+	clock.Advance(time.Hour)
+	next, err = s.RunDue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !next.IsZero() {
+		t.Errorf("RunDue() after the release date = %v, want no next action", next)
+	}
+	got, err = db.GetSubmission(&pb.Submission{ID: graded.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.GetReleased() {
+		t.Errorf("submission of %s is not released after the release date", alice.Login)
+	}
+	assignment, err := db.GetAssignment(&pb.Assignment{ID: lab.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assignment.GetReleasedDate() != clock.Now().Format(pb.TimeLayout) {
+		t.Errorf("ReleasedDate = %q, want %q", assignment.GetReleasedDate(), clock.Now().Format(pb.TimeLayout))
+	}
+
+	// a restarted scheduler does not repeat the actions
+	restarted := scheduler.New(zap.NewNop().Sugar(), db, builder, scheduler.Options{Clock: clock})
+	if _, err := restarted.RunDue(); err != nil {
+		t.Fatal(err)
+	}
+	if jobs := builder.queued(); len(jobs) != 2 {
+		t.Errorf("queued %d final builds after restart, want 2", len(jobs))
+	}
+	if snapshots := tc.snapshots(t, lab); len(snapshots) != 4 {
+		t.Errorf("got %d snapshots after restart, want 4", len(snapshots))
+	}
+}
+
+func TestRunDueMovedDeadline(t *testing.T) {
+	tc, cleanup := setup(t, "alice")
+	defer cleanup()
+	db := tc.db
+	alice := tc.students["alice"]
+
+	now := time.Now().Truncate(time.Second)
+	lab := &pb.Assignment{CourseID: tc.course.ID, Name: "lab1", Order: 1, Deadline: now.Add(-time.Hour).Format(pb.TimeLayout), FreezeAtDeadline: true}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+	s := scheduler.New(zap.NewNop().Sugar(), db, nil, scheduler.Options{Clock: newFakeClock(now)})
+	if _, err := s.RunDue(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := tc.snapshots(t, lab)[alice.ID]; !ok {
+		t.Fatalf("missing snapshot for %s after the deadline", alice.Login)
+	}
+
+	// the snapshot is removed when the deadline is moved later, so that later builds are graded
+	newDeadline := now.Add(time.Hour)
+	lab.Deadline = newDeadline.Format(pb.TimeLayout)
+	if err := db.UpdateAssignments([]*pb.Assignment{lab}); err != nil {
+		t.Fatal(err)
+	}
+	next, err := s.RunDue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !next.Equal(newDeadline) {
+		t.Errorf("RunDue() = %v, want next action at the new deadline %v", next, newDeadline)
+	}
+	if snapshots := tc.snapshots(t, lab); len(snapshots) != 0 {
+		t.Errorf("got snapshots %v after moving the deadline, want none", snapshots)
+	}
+	if submission := tc.push(t, lab, "alice", "a1", 80); submission.GetGradedAttemptID() == 0 {
+		t.Errorf("build before the new deadline is not graded")
+	}
+}
+
+func TestRunDueDeadlineBuild(t *testing.T) {
+	tc, cleanup := setup(t, "alice", "bob")
+	defer cleanup()
+	db := tc.db
+	alice := tc.students["alice"]
+
+	now := time.Now().Truncate(time.Second)
+	lab := &pb.Assignment{
+		CourseID:         tc.course.ID,
+		Name:             "lab1",
+		Order:            1,
+		Deadline:         now.Add(time.Minute).Format(pb.TimeLayout),
+		HiddenTests:      "hidden_test.go",
+		HiddenAtDeadline: true,
+	}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+	tc.push(t, lab, "alice", "a1", 80)
+
+	builder := &fakeBuilder{db: db}
+	s := scheduler.New(zap.NewNop().Sugar(), db, builder, scheduler.Options{Clock: newFakeClock(now.Add(time.Hour))})
+	if _, err := s.RunDue(); err != nil {
+		t.Fatal(err)
+	}
+	// bob never pushed, and the assignment has no final builds
+	jobs := builder.queued()
+	if len(jobs) != 1 || jobs[0].JobOwner != alice.Login || jobs[0].CommitID != "a1" || !jobs[0].Deadline {
+		t.Errorf("queued builds %v, want deadline build of commit a1 for %s", jobs, alice.Login)
+	}
+}
+
+func TestStart(t *testing.T) {
+	tc, cleanup := setup(t, "alice")
+	defer cleanup()
+	db := tc.db
+	alice := tc.students["alice"]
+
+	now := time.Now().Truncate(time.Second)
+	lab := &pb.Assignment{CourseID: tc.course.ID, Name: "lab1", Order: 1, Deadline: now.Add(time.Hour).Format(pb.TimeLayout)}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+	tc.push(t, lab, "alice", "a1", 80)
+
+	clock := newFakeClock(now)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	scheduler.New(zap.NewNop().Sugar(), db, nil, scheduler.Options{Clock: clock, Interval: 24 * time.Hour}).Start(ctx)
+
+	clock.Advance(time.Hour)
+	timeout := time.After(5 * time.Second)
+	for {
+		if snapshot, ok := tc.snapshots(t, lab)[alice.ID]; ok {
+			if snapshot.GetCommitHash() != "a1" {
+				t.Errorf("snapshot for %s at commit %q, want %q", alice.Login, snapshot.GetCommitHash(), "a1")
+			}
+			return
+		}
+		select {
+		case <-timeout:
+			t.Fatalf("no snapshot for %s after the deadline", alice.Login)
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
@@ -3,17 +3,49 @@ package scm
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
 
 	pb "github.com/autograde/quickfeed/ag"
+	"github.com/gosimple/slug"
+	"gopkg.in/yaml.v2"
 )
 
-// FakeSCM implements the SCM interface.
+// FakeSCM implements the SCM interface using in-memory data.
+// It models organizations, repositories, teams, memberships, hooks and
+// file contents, and returns the same kind of errors as GithubSCM.
 type FakeSCM struct {
+	mu sync.Mutex
+
 	Repositories  map[uint64]*Repository
 	Organizations map[uint64]*pb.Organization
-	Hooks         map[uint64]int
-	Teams         map[uint64]*Team
+	Hooks         map[uint64][]*Hook           // repository ID -> hooks
+	OrgHooks      map[uint64][]*Hook           // organization ID -> hooks
+	Teams         map[uint64]*Team             // team ID -> team
+	OrgMembers    map[uint64]map[string]string // organization ID -> login -> role
+	TeamMembers   map[uint64]map[string]string // team ID -> login -> role
+	TeamRepos     map[uint64]map[uint64]string // team ID -> repository ID -> permission
+	Collaborators map[uint64]map[string]string // repository ID -> login -> permission
+	Files         map[uint64]map[string]string // repository ID -> file path -> content
+	Permissions   map[uint64]string            // organization ID -> default repository permission
+	Users         map[uint64]string            // remote ID -> login
+	// Login is the login name of the authenticated user.
+	Login string
+	// Scopes are the scopes of the authenticated user's token.
+	Scopes []string
+
+	nextOrgID  uint64
+	nextRepoID uint64
+	nextTeamID uint64
+	nextHookID uint64
+	cloneDir   string
 }
 
 // NewFakeSCMClient returns a new Fake client implementing the SCM interface.
@@ -21,205 +53,834 @@ func NewFakeSCMClient() *FakeSCM {
 	return &FakeSCM{
 		Repositories:  make(map[uint64]*Repository),
 		Organizations: make(map[uint64]*pb.Organization),
-		Hooks:         make(map[uint64]int),
+		Hooks:         make(map[uint64][]*Hook),
+		OrgHooks:      make(map[uint64][]*Hook),
 		Teams:         make(map[uint64]*Team),
+		OrgMembers:    make(map[uint64]map[string]string),
+		TeamMembers:   make(map[uint64]map[string]string),
+		TeamRepos:     make(map[uint64]map[uint64]string),
+		Collaborators: make(map[uint64]map[string]string),
+		Files:         make(map[uint64]map[string]string),
+		Permissions:   make(map[uint64]string),
+		Users:         make(map[uint64]string),
+		Scopes:        []string{"admin:org", "delete_repo", "repo", "user", "admin:org_hook"},
 	}
 }
 
 // CreateOrganization implements the SCM interface.
 func (s *FakeSCM) CreateOrganization(ctx context.Context, opt *OrganizationOptions) (*pb.Organization, error) {
-	id := len(s.Organizations) + 1
+	if opt.Path == "" {
+		return nil, ErrMissingFields{
+			Method:  "CreateOrganization",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.orgByPath(opt.Path) != nil {
+		return nil, ErrFailedSCM{
+			Method:   "CreateOrganization",
+			Message:  fmt.Sprintf("organization %s already exists", opt.Path),
+			GitError: errors.New("organization already exists"),
+		}
+	}
+	s.nextOrgID++
+	id := s.nextOrgID
 	org := &pb.Organization{
-		ID:     uint64(id),
+		ID:     id,
 		Path:   opt.Path,
-		Avatar: "https://avatars3.githubusercontent.com/u/1000" + strconv.Itoa(id) + "?v=3",
+		Avatar: "https://avatars3.githubusercontent.com/u/1000" + strconv.Itoa(int(id)) + "?v=3",
 	}
 	s.Organizations[org.ID] = org
-	return org, nil
+	s.OrgMembers[org.ID] = make(map[string]string)
+	if s.Login != "" {
+		s.OrgMembers[org.ID][s.Login] = OrgOwner
+	}
+	return toOrganization(org), nil
 }
 
 // UpdateOrganization implements the SCM interface.
 func (s *FakeSCM) UpdateOrganization(ctx context.Context, opt *OrganizationOptions) error {
-	// TODO no implementation provided yet
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "UpdateOrganization",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	org := s.orgByPath(opt.Path)
+	if org == nil {
+		return errNotFound("UpdateOrganization", "organization", opt.Path)
+	}
+	s.Permissions[org.ID] = opt.DefaultPermission
 	return nil
 }
 
 // GetOrganization implements the SCM interface.
 func (s *FakeSCM) GetOrganization(ctx context.Context, opt *GetOrgOptions) (*pb.Organization, error) {
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "GetOrganization",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// priority is getting the organization by ID
 	org, ok := s.Organizations[opt.ID]
+	if opt.ID == 0 {
+		org = s.orgByPath(slug.Make(opt.Name))
+		ok = org != nil
+	}
 	if !ok {
-		return nil, errors.New("organization not found")
+		return nil, ErrFailedSCM{
+			Method:   "GetOrganization",
+			Message:  fmt.Sprintf("could not find organization %d %s", opt.ID, opt.Name),
+			GitError: errors.New("organization not found"),
+		}
+	}
+
+	// if user name is provided, return the found organization only if the user is one of its owners
+	if opt.Username != "" {
+		role, ok := s.OrgMembers[org.ID][opt.Username]
+		if !ok {
+			return nil, ErrNotMember
+		}
+		if role != OrgOwner {
+			return nil, ErrNotOwner
+		}
 	}
-	return org, nil
+	return toOrganization(org), nil
 }
 
 // CreateRepository implements the SCM interface.
 func (s *FakeSCM) CreateRepository(ctx context.Context, opt *CreateRepositoryOptions) (*Repository, error) {
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "CreateRepository",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	org := s.org(opt.Organization)
+	if org == nil {
+		return nil, errNotFound("CreateRepository", "organization", opt.Organization.GetPath())
+	}
+	// reuse the repository if it already exists
+	if repo := s.repoByPath(org.Path, opt.Path); repo != nil {
+		return toFakeRepository(repo), nil
+	}
+
+	s.nextRepoID++
 	repo := &Repository{
-		ID:      uint64(len(s.Repositories) + 1),
+		ID:      s.nextRepoID,
 		Path:    opt.Path,
-		WebURL:  "https://example.com/" + opt.Organization.Path + "/" + opt.Path,
-		SSHURL:  "git@example.com:" + opt.Organization.Path + "/" + opt.Path,
-		HTTPURL: "https://example.com/" + opt.Organization.Path + "/" + opt.Path + ".git",
-		OrgID:   opt.Organization.ID,
+		Owner:   org.Path,
+		WebURL:  "https://example.com/" + org.Path + "/" + opt.Path,
+		SSHURL:  "git@example.com:" + org.Path + "/" + opt.Path,
+		HTTPURL: "https://example.com/" + org.Path + "/" + opt.Path + ".git",
+		OrgID:   org.ID,
 	}
 	s.Repositories[repo.ID] = repo
-	return repo, nil
+	return toFakeRepository(repo), nil
 }
 
 // GetRepository implements the SCM interface.
-func (s *FakeSCM) GetRepository(cts context.Context, opt *RepositoryOptions) (*Repository, error) {
-	// TODO no implementation provided yet
-	return nil, nil
+func (s *FakeSCM) GetRepository(ctx context.Context, opt *RepositoryOptions) (*Repository, error) {
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "GetRepository",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	repo := s.repo(opt)
+	if repo == nil {
+		return nil, fmt.Errorf("GetRepository failed to fetch repository %d, and path %s: repository not found", opt.ID, opt.Path)
+	}
+	return toFakeRepository(repo), nil
 }
 
 // GetRepositories implements the SCM interface.
 func (s *FakeSCM) GetRepositories(ctx context.Context, org *pb.Organization) ([]*Repository, error) {
+	if !org.IsValid() {
+		return nil, ErrMissingFields{
+			Method:  "GetRepositories",
+			Message: fmt.Sprintf("%+v", org),
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.org(org)
+	if found == nil {
+		return nil, errNotFound("GetRepositories", "organization", org.GetPath())
+	}
 	var repos []*Repository
 	for _, repo := range s.Repositories {
-		if repo.OrgID == org.ID {
-			repos = append(repos, repo)
+		if repo.OrgID == found.ID {
+			repos = append(repos, toFakeRepository(repo))
 		}
 	}
+	sort.Slice(repos, func(i, j int) bool { return repos[i].ID < repos[j].ID })
 	return repos, nil
 }
 
 // DeleteRepository implements the SCM interface.
 func (s *FakeSCM) DeleteRepository(ctx context.Context, opt *RepositoryOptions) error {
-	if _, ok := s.Repositories[opt.ID]; !ok {
-		return errors.New("repository not found")
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	repo := s.repo(opt)
+	if repo == nil {
+		return errNotFound("DeleteRepository", "repository", fmt.Sprintf("%d %s", opt.ID, opt.Path))
+	}
+	delete(s.Repositories, repo.ID)
+	delete(s.Hooks, repo.ID)
+	delete(s.Collaborators, repo.ID)
+	delete(s.Files, repo.ID)
+	for _, repos := range s.TeamRepos {
+		delete(repos, repo.ID)
 	}
-	delete(s.Repositories, opt.ID)
 	return nil
 }
 
 // UpdateRepoAccess implements the SCM interface.
 func (s *FakeSCM) UpdateRepoAccess(ctx context.Context, repo *Repository, user, permission string) error {
-	// TODO no implementation provided yet
+	if repo == nil || !repo.valid() {
+		return ErrMissingFields{
+			Method:  "UpdateRepoAccess",
+			Message: fmt.Sprintf("%+v", repo),
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.repoByPath(repo.Owner, repo.Path)
+	if found == nil {
+		return errNotFound("UpdateRepoAccess", "repository", repo.Owner+"/"+repo.Path)
+	}
+	if user == "" {
+		return errNotFound("UpdateRepoAccess", "user", user)
+	}
+	if s.Collaborators[found.ID] == nil {
+		s.Collaborators[found.ID] = make(map[string]string)
+	}
+	s.Collaborators[found.ID][user] = permission
 	return nil
 }
 
 // RepositoryIsEmpty implements the SCM interface
 func (s *FakeSCM) RepositoryIsEmpty(ctx context.Context, opt *RepositoryOptions) bool {
-	// TODO no implementation provided yet
-	return false
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	repo := s.repo(opt)
+	if repo == nil {
+		return false
+	}
+	return len(s.Files[repo.ID]) == 0
 }
 
 // ListHooks implements the SCM interface.
 func (s *FakeSCM) ListHooks(ctx context.Context, repo *Repository, org string) ([]*Hook, error) {
-	// TODO no implementation provided yet
-	return nil, nil
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var hooks []*Hook
+	// we prioritize organization hooks because repository hooks are no longer used.
+	switch {
+	case org != "":
+		found := s.orgByPath(slug.Make(org))
+		if found == nil {
+			return nil, fmt.Errorf("ListHooks: failed to get hooks for organization %q: organization not found", org)
+		}
+		hooks = s.OrgHooks[found.ID]
+
+	case repo != nil && repo.valid():
+		found := s.repoByPath(repo.Owner, repo.Path)
+		if found == nil {
+			return nil, fmt.Errorf("ListHooks: failed to get hooks for repository %q: repository not found", repo.Path)
+		}
+		hooks = s.Hooks[found.ID]
+
+	default:
+		return nil, fmt.Errorf("ListHooks: called with missing or incompatible arguments: %q %q", repo, org)
+	}
+	return append([]*Hook(nil), hooks...), nil
 }
 
 // CreateHook implements the SCM interface.
 func (s *FakeSCM) CreateHook(ctx context.Context, opt *CreateHookOptions) error {
-	if opt.Repository != nil {
-		if _, ok := s.Repositories[opt.Repository.ID]; !ok {
-			return errors.New("repository not found")
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "CreateHook",
+			Message: fmt.Sprintf("%+v", opt),
 		}
-		s.Hooks[opt.Repository.ID]++
 	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextHookID++
+	hook := &Hook{ID: s.nextHookID, Name: "web", URL: opt.URL, Events: []string{"push"}}
+	// prioritize creating an organization hook
+	if opt.Organization != "" {
+		org := s.orgByPath(opt.Organization)
+		if org == nil {
+			return fmt.Errorf("CreateOrgHook: failed to create hook for org %s: organization not found", opt.Organization)
+		}
+		s.OrgHooks[org.ID] = append(s.OrgHooks[org.ID], hook)
+		return nil
+	}
+	repo := s.repoByPath(opt.Repository.Owner, opt.Repository.Path)
+	if repo == nil {
+		return errNotFound("CreateHook", "repository", opt.Repository.Owner+"/"+opt.Repository.Path)
+	}
+	s.Hooks[repo.ID] = append(s.Hooks[repo.ID], hook)
 	return nil
 }
 
 // CreateTeam implements the SCM interface.
 func (s *FakeSCM) CreateTeam(ctx context.Context, opt *NewTeamOptions) (*Team, error) {
-	newTeam := &Team{
-		ID:           uint64(len(s.Teams) + 1),
-		Name:         opt.TeamName,
-		Organization: opt.Organization,
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "CreateTeam",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	org := s.orgByPath(slug.Make(opt.Organization))
+	if org == nil {
+		return nil, errNotFound("CreateTeam", "organization", opt.Organization)
+	}
+	// reuse the team if it already exists
+	team := s.teamBySlug(org.Path, opt.TeamName)
+	if team == nil {
+		s.nextTeamID++
+		team = &Team{
+			ID:           s.nextTeamID,
+			Name:         opt.TeamName,
+			Organization: org.Path,
+		}
+		s.Teams[team.ID] = team
+		s.TeamMembers[team.ID] = make(map[string]string)
+	}
+	for _, user := range opt.Users {
+		if err := s.addTeamMember(team, user, TeamMember); err != nil {
+			return nil, ErrFailedSCM{
+				Method:   "CreateTeam",
+				Message:  fmt.Sprintf("failed to add user '%s' to team '%s'", user, team.Name),
+				GitError: err,
+			}
+		}
 	}
-	s.Teams[newTeam.ID] = newTeam
-	return newTeam, nil
+	return toFakeTeam(team), nil
 }
 
 // DeleteTeam implements the SCM interface.
 func (s *FakeSCM) DeleteTeam(ctx context.Context, opt *TeamOptions) error {
-	if _, ok := s.Teams[opt.TeamID]; !ok {
-		return errors.New("repository not found")
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "DeleteTeam",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	team := s.team(opt)
+	if team == nil {
+		return errNotFound("DeleteTeam", "team", fmt.Sprintf("%d %s", opt.TeamID, opt.TeamName))
 	}
-	delete(s.Repositories, opt.TeamID)
+	delete(s.Teams, team.ID)
+	delete(s.TeamMembers, team.ID)
+	delete(s.TeamRepos, team.ID)
 	return nil
 }
 
 // GetTeam implements the SCM interface
 func (s *FakeSCM) GetTeam(ctx context.Context, opt *TeamOptions) (*Team, error) {
-	team, ok := s.Teams[opt.TeamID]
-	if !ok {
-		return nil, errors.New("team not found")
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "GetTeam",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	team := s.team(opt)
+	if team == nil {
+		return nil, fmt.Errorf("GetTeam: failed to get team %d %s: team not found", opt.TeamID, opt.TeamName)
 	}
-	return team, nil
+	return toFakeTeam(team), nil
 }
 
 // GetTeams implements the SCM interface
 func (s *FakeSCM) GetTeams(ctx context.Context, org *pb.Organization) ([]*Team, error) {
+	if !org.IsValid() {
+		return nil, ErrMissingFields{
+			Method:  "GetTeams",
+			Message: fmt.Sprintf("%+v", org),
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.org(org)
+	if found == nil {
+		return nil, fmt.Errorf("GetTeams: failed to list teams: organization %s not found", org.GetPath())
+	}
 	var teams []*Team
 	for _, team := range s.Teams {
-		if team.Organization == org.Path {
-			teams = append(teams, team)
+		if strings.EqualFold(team.Organization, found.Path) {
+			teams = append(teams, toFakeTeam(team))
 		}
 	}
+	sort.Slice(teams, func(i, j int) bool { return teams[i].ID < teams[j].ID })
 	return teams, nil
 }
 
 // AddTeamMember implements the scm interface
 func (s *FakeSCM) AddTeamMember(ctx context.Context, opt *TeamMembershipOptions) error {
-	// TODO no implementation provided yet
-	return nil
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "AddTeamMember",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	team := s.team(&TeamOptions{Organization: opt.Organization, OrganizationID: opt.OrganizationID, TeamName: opt.TeamName, TeamID: opt.TeamID})
+	if team == nil {
+		return errNotFound("AddTeamMember", "team", fmt.Sprintf("%d %s", opt.TeamID, opt.TeamName))
+	}
+	role := opt.Role
+	if role == "" {
+		role = TeamMember
+	}
+	return s.addTeamMember(team, opt.Username, role)
 }
 
 // RemoveTeamMember implements the scm interface
 func (s *FakeSCM) RemoveTeamMember(ctx context.Context, opt *TeamMembershipOptions) error {
-	// TODO no implementation provided yet
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "RemoveTeamMember",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	team := s.team(&TeamOptions{Organization: opt.Organization, OrganizationID: opt.OrganizationID, TeamName: opt.TeamName, TeamID: opt.TeamID})
+	if team == nil {
+		return errNotFound("RemoveTeamMember", "team", fmt.Sprintf("%d %s", opt.TeamID, opt.TeamName))
+	}
+	delete(s.TeamMembers[team.ID], opt.Username)
 	return nil
 }
 
 // UpdateTeamMembers implements the SCM interface.
 func (s *FakeSCM) UpdateTeamMembers(ctx context.Context, opt *UpdateTeamOptions) error {
-	// TODO no implementation provided yet
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "UpdateTeamMembers",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	team := s.team(&TeamOptions{OrganizationID: opt.OrganizationID, TeamID: opt.TeamID})
+	if team == nil {
+		return errNotFound("UpdateTeamMember", "team", strconv.Itoa(int(opt.TeamID)))
+	}
+	// add missing members
+	newUsers := make(map[string]bool)
+	for _, member := range opt.Users {
+		newUsers[member] = true
+		if _, ok := s.TeamMembers[team.ID][member]; ok {
+			continue
+		}
+		if err := s.addTeamMember(team, member, TeamMember); err != nil {
+			return err
+		}
+	}
+	// remove members that are not in the new group
+	for member := range s.TeamMembers[team.ID] {
+		if !newUsers[member] {
+			delete(s.TeamMembers[team.ID], member)
+		}
+	}
 	return nil
 }
 
 // CreateCloneURL implements the SCM interface.
+// The repository's files are written to a local git repository,
+// whose path is returned, so that the repository can be cloned offline.
+// An empty string is returned if the repository does not exist.
 func (s *FakeSCM) CreateCloneURL(opt *CreateClonePathOptions) string {
-	return ""
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	repo := s.repoByPath(opt.Organization, opt.Repository)
+	if repo == nil {
+		return ""
+	}
+	dir, err := s.writeClone(repo)
+	if err != nil {
+		return ""
+	}
+	return dir
 }
 
 // AddTeamRepo implements the SCM interface.
 func (s *FakeSCM) AddTeamRepo(ctx context.Context, opt *AddTeamRepoOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "AddTeamRepo",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	team := s.team(&TeamOptions{OrganizationID: opt.OrganizationID, TeamID: opt.TeamID})
+	if team == nil {
+		return errNotFound("AddTeamRepo", "team", strconv.Itoa(int(opt.TeamID)))
+	}
+	repo := s.repoByPath(opt.Owner, opt.Repo)
+	if repo == nil {
+		return errNotFound("AddTeamRepo", "repository", opt.Owner+"/"+opt.Repo)
+	}
+	if s.TeamRepos[team.ID] == nil {
+		s.TeamRepos[team.ID] = make(map[uint64]string)
+	}
+	s.TeamRepos[team.ID][repo.ID] = opt.Permission
 	return nil
 }
 
 // GetUserName implements the SCM interface.
 func (s *FakeSCM) GetUserName(ctx context.Context) (string, error) {
-	return "", nil
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.Login == "" {
+		return "", errors.New("GetUserName: failed to get user: no authenticated user")
+	}
+	return s.Login, nil
 }
 
 // GetUserNameByID implements the SCM interface.
 func (s *FakeSCM) GetUserNameByID(ctx context.Context, remoteID uint64) (string, error) {
-	return "", nil
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	login, ok := s.Users[remoteID]
+	if !ok {
+		return "", fmt.Errorf("GetUserNameByID: failed to get user '%d': user not found", remoteID)
+	}
+	return login, nil
 }
 
 // UpdateOrgMembership implements the SCM interface
 func (s *FakeSCM) UpdateOrgMembership(ctx context.Context, opt *OrgMembershipOptions) error {
-	// TODO no implementation provided yet
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "UpdateOrgMembership",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	org := s.orgByPath(opt.Organization)
+	if org == nil || (opt.Role != OrgOwner && opt.Role != OrgMember) {
+		return ErrFailedSCM{
+			GitError: fmt.Errorf("failed to update membership for user %s in organization %s", opt.Username, opt.Organization),
+			Method:   "UpdateOrgMembership",
+			Message:  fmt.Sprintf("failed to update membership for user %s", opt.Username),
+		}
+	}
+	s.OrgMembers[org.ID][opt.Username] = opt.Role
 	return nil
 }
 
 // RemoveMember implements the SCM interface
 func (s *FakeSCM) RemoveMember(ctx context.Context, opt *OrgMembershipOptions) error {
-	// TODO no implementation provided yet
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "RemoveMember",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	org := s.orgByPath(opt.Organization)
+	if org == nil {
+		return ErrFailedSCM{
+			Method:   "RemoveMember",
+			GitError: fmt.Errorf("failed to remove user %s from organization %s: organization not found", opt.Username, opt.Organization),
+			Message:  fmt.Sprintf("failed to remove user %s from the organization", opt.Username),
+		}
+	}
+	// remove user from the organization and all teams
+	delete(s.OrgMembers[org.ID], opt.Username)
+	for _, team := range s.Teams {
+		if strings.EqualFold(team.Organization, org.Path) {
+			delete(s.TeamMembers[team.ID], opt.Username)
+		}
+	}
 	return nil
 }
 
 // GetUserScopes implements the SCM interface
 func (s *FakeSCM) GetUserScopes(ctx context.Context) *Authorization {
-	// TODO no implementation provided yet
-	return nil
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return &Authorization{Scopes: append(make([]string, 0), s.Scopes...)}
 }
 
 // GetFileContent implements the SCM interface
-func (s *FakeSCM) GetFileContent(context.Context, *FileOptions) (string, error) {
-	// TODO no implementation provided yet
-	return "", nil
+func (s *FakeSCM) GetFileContent(ctx context.Context, opt *FileOptions) (string, error) {
+	if !opt.valid() {
+		return "", ErrMissingFields{
+			Method:  "GetFileContent",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var content string
+	repo := s.repoByPath(opt.Owner, opt.Repository)
+	if repo != nil {
+		content = s.Files[repo.ID][opt.Path]
+	}
+	if content == "" {
+		return "", ErrFailedSCM{
+			Method:   "GetFileContent",
+			GitError: fmt.Errorf("file %s in repo %s of organization %s not found or has no content", opt.Path, opt.Repository, opt.Owner),
+			Message:  fmt.Sprintf("failed to get contents of the file at %s", opt.Path),
+		}
+	}
+	return content, nil
+}
+
+// CommitFiles adds the given files, mapping file paths to contents,
+// to the given repository, replacing any existing files with the same path.
+func (s *FakeSCM) CommitFiles(ctx context.Context, opt *RepositoryOptions, files map[string]string) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "CommitFiles",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	repo := s.repo(opt)
+	if repo == nil {
+		return errNotFound("CommitFiles", "repository", fmt.Sprintf("%d %s", opt.ID, opt.Path))
+	}
+	if s.Files[repo.ID] == nil {
+		s.Files[repo.ID] = make(map[string]string)
+	}
+	for path, content := range files {
+		s.Files[repo.ID][path] = content
+	}
+	return nil
+}
+
+// assignmentFile holds the fields of an 'assignment.yml' file.
+type assignmentFile struct {
+	AssignmentID     uint32 `yaml:"assignmentid"`
+	ScriptFile       string `yaml:"scriptfile,omitempty"`
+	Deadline         string `yaml:"deadline,omitempty"`
+	AutoApprove      bool   `yaml:"autoapprove,omitempty"`
+	ScoreLimit       uint32 `yaml:"scorelimit,omitempty"`
+	IsGroupLab       bool   `yaml:"isgrouplab,omitempty"`
+	Reviewers        uint32 `yaml:"reviewers,omitempty"`
+	ContainerTimeout uint32 `yaml:"containertimeout,omitempty"`
+	SkipTests        bool   `yaml:"skiptests,omitempty"`
+}
+
+// SeedTestsRepo creates the tests repository for the given organization,
+// unless it already exists, and commits an 'assignment.yml' file for each of
+// the given assignments to a directory named after the assignment.
+// The assignment's Order is used as its assignment ID.
+func (s *FakeSCM) SeedTestsRepo(ctx context.Context, org *pb.Organization, assignments ...*pb.Assignment) (*Repository, error) {
+	repo, err := s.CreateRepository(ctx, &CreateRepositoryOptions{
+		Organization: org,
+		Path:         pb.TestsRepo,
+		Private:      true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string]string)
+	for _, assignment := range assignments {
+		content, err := yaml.Marshal(&assignmentFile{
+			AssignmentID:     assignment.GetOrder(),
+			ScriptFile:       assignment.GetScriptFile(),
+			Deadline:         assignment.GetDeadline(),
+			AutoApprove:      assignment.GetAutoApprove(),
+			ScoreLimit:       assignment.GetScoreLimit(),
+			IsGroupLab:       assignment.GetIsGroupLab(),
+			Reviewers:        assignment.GetReviewers(),
+			ContainerTimeout: assignment.GetContainerTimeout(),
+			SkipTests:        assignment.GetSkipTests(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal assignment %s: %w", assignment.GetName(), err)
+		}
+		files[assignment.GetName()+"/assignment.yml"] = string(content)
+	}
+	if err := s.CommitFiles(ctx, &RepositoryOptions{ID: repo.ID}, files); err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
+
+// Cleanup removes the local git repositories created by CreateCloneURL.
+func (s *FakeSCM) Cleanup() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cloneDir == "" {
+		return nil
+	}
+	err := os.RemoveAll(s.cloneDir)
+	s.cloneDir = ""
+	return err
+}
+
+// writeClone writes the files of the given repository to a local git repository
+// and returns its path. The caller must hold s.mu.
+func (s *FakeSCM) writeClone(repo *Repository) (string, error) {
+	if s.cloneDir == "" {
+		dir, err := ioutil.TempDir("", "fakescm")
+		if err != nil {
+			return "", err
+		}
+		s.cloneDir = dir
+	}
+	dir := filepath.Join(s.cloneDir, repo.Owner, repo.Path)
+	if err := os.RemoveAll(dir); err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", err
+	}
+	for path, content := range s.Files[repo.ID] {
+		file := filepath.Join(dir, filepath.FromSlash(path))
+		if err := os.MkdirAll(filepath.Dir(file), 0o700); err != nil {
+			return "", err
+		}
+		if err := ioutil.WriteFile(file, []byte(content), 0o600); err != nil {
+			return "", err
+		}
+	}
+	commands := [][]string{{"init", "--quiet"}}
+	if len(s.Files[repo.ID]) > 0 {
+		commands = append(commands,
+			[]string{"add", "--all"},
+			[]string{"-c", "user.name=fake", "-c", "user.email=fake@example.com", "commit", "--quiet", "--message", "fake commit"},
+		)
+	}
+	for _, args := range commands {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return "", fmt.Errorf("git %v failed: %w: %s", args, err, out)
+		}
+	}
+	return dir, nil
+}
+
+// addTeamMember adds the user to the team, and to the team's organization,
+// unless the user is already a member. The caller must hold s.mu.
+func (s *FakeSCM) addTeamMember(team *Team, user, role string) error {
+	if user == "" {
+		return errors.New("user not found")
+	}
+	s.TeamMembers[team.ID][user] = role
+	if org := s.orgByPath(team.Organization); org != nil {
+		if _, ok := s.OrgMembers[org.ID][user]; !ok {
+			s.OrgMembers[org.ID][user] = OrgMember
+		}
+	}
+	return nil
+}
+
+// org returns the organization with the ID or path of the given organization.
+// The caller must hold s.mu.
+func (s *FakeSCM) org(org *pb.Organization) *pb.Organization {
+	if found, ok := s.Organizations[org.GetID()]; ok {
+		return found
+	}
+	if org.GetPath() == "" {
+		return nil
+	}
+	return s.orgByPath(org.GetPath())
+}
+
+// orgByPath returns the organization with the given path.
+// Like GitHub logins, organization paths are case insensitive.
+func (s *FakeSCM) orgByPath(path string) *pb.Organization {
+	for _, org := range s.Organizations {
+		if strings.EqualFold(org.Path, path) {
+			return org
+		}
+	}
+	return nil
+}
+
+// repo returns the repository with the ID, or the owner and path, of the given options.
+// The caller must hold s.mu.
+func (s *FakeSCM) repo(opt *RepositoryOptions) *Repository {
+	if opt.ID > 0 {
+		return s.Repositories[opt.ID]
+	}
+	return s.repoByPath(opt.Owner, opt.Path)
+}
+
+func (s *FakeSCM) repoByPath(owner, path string) *Repository {
+	for _, repo := range s.Repositories {
+		if strings.EqualFold(repo.Owner, owner) && strings.EqualFold(repo.Path, path) {
+			return repo
+		}
+	}
+	return nil
+}
+
+// team returns the team with the ID, or the organization and name, of the given options.
+// The caller must hold s.mu.
+func (s *FakeSCM) team(opt *TeamOptions) *Team {
+	if opt.TeamID > 0 {
+		return s.Teams[opt.TeamID]
+	}
+	return s.teamBySlug(slug.Make(opt.Organization), opt.TeamName)
+}
+
+func (s *FakeSCM) teamBySlug(org, name string) *Team {
+	for _, team := range s.Teams {
+		if strings.EqualFold(team.Organization, org) && slug.Make(team.Name) == slug.Make(name) {
+			return team
+		}
+	}
+	return nil
+}
+
+func errNotFound(method, kind, name string) error {
+	return ErrFailedSCM{
+		Method:   method,
+		Message:  fmt.Sprintf("%s %s not found", kind, name),
+		GitError: fmt.Errorf("%s not found", kind),
+	}
+}
+
+func toOrganization(org *pb.Organization) *pb.Organization {
+	return &pb.Organization{
+		ID:          org.GetID(),
+		Path:        org.GetPath(),
+		Avatar:      org.GetAvatar(),
+		PaymentPlan: org.GetPaymentPlan(),
+	}
+}
+
+func toFakeRepository(repo *Repository) *Repository {
+	r := *repo
+	return &r
+}
+
+func toFakeTeam(team *Team) *Team {
+	t := *team
+	return &t
 }
@@ -0,0 +1,176 @@
+package scm_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/scm"
+)
+
+func TestFakeOrganization(t *testing.T) {
+	s := scm.NewFakeSCMClient()
+	s.Login = "teacher"
+	ctx := context.Background()
+
+	if _, err := s.CreateOrganization(ctx, &scm.OrganizationOptions{}); !errors.As(err, &scm.ErrMissingFields{}) {
+		t.Errorf("CreateOrganization() with missing path = %v, want ErrMissingFields", err)
+	}
+	org, err := s.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "dat520", Name: "DAT520"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "dat520"}); !errors.As(err, &scm.ErrFailedSCM{}) {
+		t.Errorf("CreateOrganization() with existing path = %v, want ErrFailedSCM", err)
+	}
+
+	got, err := s.GetOrganization(ctx, &scm.GetOrgOptions{Name: "DAT520", Username: "teacher"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.GetID() != org.GetID() {
+		t.Errorf("GetOrganization() = %d, want %d", got.GetID(), org.GetID())
+	}
+	if _, err := s.GetOrganization(ctx, &scm.GetOrgOptions{ID: 42}); !errors.As(err, &scm.ErrFailedSCM{}) {
+		t.Errorf("GetOrganization() with unknown ID = %v, want ErrFailedSCM", err)
+	}
+	if _, err := s.GetOrganization(ctx, &scm.GetOrgOptions{ID: org.GetID(), Username: "student"}); err != scm.ErrNotMember {
+		t.Errorf("GetOrganization() for non-member = %v, want %v", err, scm.ErrNotMember)
+	}
+	if err := s.UpdateOrgMembership(ctx, &scm.OrgMembershipOptions{Organization: "dat520", Username: "student", Role: scm.OrgMember}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.GetOrganization(ctx, &scm.GetOrgOptions{ID: org.GetID(), Username: "student"}); err != scm.ErrNotOwner {
+		t.Errorf("GetOrganization() for member = %v, want %v", err, scm.ErrNotOwner)
+	}
+}
+
+func TestFakeTeams(t *testing.T) {
+	s := scm.NewFakeSCMClient()
+	ctx := context.Background()
+
+	org, err := s.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "dat520"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.CreateTeam(ctx, &scm.NewTeamOptions{Organization: "unknown", TeamName: "team"}); !errors.As(err, &scm.ErrFailedSCM{}) {
+		t.Errorf("CreateTeam() in unknown organization = %v, want ErrFailedSCM", err)
+	}
+	team, err := s.CreateTeam(ctx, &scm.NewTeamOptions{Organization: "dat520", TeamName: "group1", Users: []string{"alice", "bob"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.UpdateTeamMembers(ctx, &scm.UpdateTeamOptions{OrganizationID: org.GetID(), TeamID: team.ID, Users: []string{"bob", "carol"}}); err != nil {
+		t.Fatal(err)
+	}
+	wantMembers := map[string]string{"bob": scm.TeamMember, "carol": scm.TeamMember}
+	if got := s.TeamMembers[team.ID]; len(got) != len(wantMembers) || got["bob"] != wantMembers["bob"] || got["carol"] != wantMembers["carol"] {
+		t.Errorf("team members = %v, want %v", got, wantMembers)
+	}
+	if _, ok := s.OrgMembers[org.GetID()]["carol"]; !ok {
+		t.Error("team member carol is not an organization member")
+	}
+
+	got, err := s.GetTeam(ctx, &scm.TeamOptions{Organization: "dat520", TeamName: "group1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ID != team.ID {
+		t.Errorf("GetTeam() = %d, want %d", got.ID, team.ID)
+	}
+	if err := s.DeleteTeam(ctx, &scm.TeamOptions{OrganizationID: org.GetID(), TeamID: team.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.GetTeam(ctx, &scm.TeamOptions{OrganizationID: org.GetID(), TeamID: team.ID}); err == nil {
+		t.Error("GetTeam() for deleted team succeeded, want error")
+	}
+}
+
+func TestFakeRepositories(t *testing.T) {
+	s := scm.NewFakeSCMClient()
+	ctx := context.Background()
+
+	org, err := s.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "dat520"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, err := s.CreateRepository(ctx, &scm.CreateRepositoryOptions{Organization: org, Path: "alice-labs", Private: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again, err := s.CreateRepository(ctx, &scm.CreateRepositoryOptions{Organization: org, Path: "alice-labs"}); err != nil || again.ID != repo.ID {
+		t.Errorf("CreateRepository() for existing repository = %v, %v, want repository %d", again, err, repo.ID)
+	}
+	if err := s.CreateHook(ctx, &scm.CreateHookOptions{URL: "https://example.com/hook", Secret: "secret", Repository: repo}); err != nil {
+		t.Fatal(err)
+	}
+	hooks, err := s.ListHooks(ctx, repo, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hooks) != 1 {
+		t.Errorf("ListHooks() = %d hooks, want 1", len(hooks))
+	}
+
+	if !s.RepositoryIsEmpty(ctx, &scm.RepositoryOptions{ID: repo.ID}) {
+		t.Error("RepositoryIsEmpty() = false, want true")
+	}
+	if err := s.CommitFiles(ctx, &scm.RepositoryOptions{ID: repo.ID}, map[string]string{"README.md": "# labs"}); err != nil {
+		t.Fatal(err)
+	}
+	content, err := s.GetFileContent(ctx, &scm.FileOptions{Owner: "dat520", Repository: "alice-labs", Path: "README.md"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if content != "# labs" {
+		t.Errorf("GetFileContent() = %q, want %q", content, "# labs")
+	}
+	if s.RepositoryIsEmpty(ctx, &scm.RepositoryOptions{ID: repo.ID}) {
+		t.Error("RepositoryIsEmpty() = true, want false")
+	}
+
+	if err := s.DeleteRepository(ctx, &scm.RepositoryOptions{ID: repo.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.GetRepository(ctx, &scm.RepositoryOptions{ID: repo.ID}); err == nil {
+		t.Error("GetRepository() for deleted repository succeeded, want error")
+	}
+	if err := s.DeleteRepository(ctx, &scm.RepositoryOptions{ID: repo.ID}); !errors.As(err, &scm.ErrFailedSCM{}) {
+		t.Errorf("DeleteRepository() for deleted repository = %v, want ErrFailedSCM", err)
+	}
+}
+
+func TestFakeSeedTestsRepo(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("This test requires git")
+	}
+	s := scm.NewFakeSCMClient()
+	defer s.Cleanup()
+	ctx := context.Background()
+
+	org, err := s.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "dat520"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.SeedTestsRepo(ctx, org, &pb.Assignment{Name: "lab1", Order: 1, ScriptFile: "go.sh"}); err != nil {
+		t.Fatal(err)
+	}
+	cloneURL := s.CreateCloneURL(&scm.CreateClonePathOptions{Organization: "dat520", Repository: pb.TestsRepo})
+	if cloneURL == "" {
+		t.Fatal("CreateCloneURL() = \"\", want path to local repository")
+	}
+	content, err := ioutil.ReadFile(filepath.Join(cloneURL, "lab1", "assignment.yml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(content) == 0 {
+		t.Error("assignment.yml is empty")
+	}
+	if got := s.CreateCloneURL(&scm.CreateClonePathOptions{Organization: "dat520", Repository: "unknown"}); got != "" {
+		t.Errorf("CreateCloneURL() for unknown repository = %q, want \"\"", got)
+	}
+}
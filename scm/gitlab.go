@@ -2,23 +2,54 @@ package scm
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
+	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
+	"github.com/gosimple/slug"
 	gitlab "github.com/xanzy/go-gitlab"
+	"go.uber.org/zap"
 )
 
+// perPage is the number of items requested per page when listing GitLab resources;
+// GitLab returns 20 items per page by default, and at most 100.
+const perPage = 100
+
+// tokenInfoClient is the HTTP client used to get the scopes of access tokens.
+var tokenInfoClient = &http.Client{Timeout: 30 * time.Second}
+
 // GitlabSCM implements the SCM interface.
+//
+// GitLab has no notion of organizations and teams. Instead, a course
+// organization is mapped to a top-level GitLab group, and teams are
+// mapped to subgroups of the course group and their members.
 type GitlabSCM struct {
+	logger *zap.SugaredLogger
 	client *gitlab.Client
+	token  string
 }
 
 // NewGitlabSCMClient returns a new GitLab client implementing the SCM interface.
-func NewGitlabSCMClient(token string) *GitlabSCM {
-	cli, _ := gitlab.NewOAuthClient(token, gitlab.WithoutRetries())
+// If baseURL is empty, the client will use gitlab.com.
+func NewGitlabSCMClient(logger *zap.SugaredLogger, baseURL, token string) (*GitlabSCM, error) {
+	opts := []gitlab.ClientOptionFunc{gitlab.WithoutRetries()}
+	if baseURL != "" {
+		opts = append(opts, gitlab.WithBaseURL(baseURL))
+	}
+	cli, err := gitlab.NewOAuthClient(token, opts...)
+	if err != nil {
+		return nil, err
+	}
 	return &GitlabSCM{
+		logger: logger,
 		client: cli,
-	}
+		token:  token,
+	}, nil
 }
 
 // CreateOrganization implements the SCM interface.
@@ -40,19 +71,70 @@ func (s *GitlabSCM) CreateOrganization(ctx context.Context, opt *OrganizationOpt
 }
 
 // UpdateOrganization implements the SCM interface.
+// GitLab groups have no default repository permission; members' access is
+// instead granted per project. Only project creation rights are updated.
 func (s *GitlabSCM) UpdateOrganization(ctx context.Context, opt *OrganizationOptions) error {
-	// TODO no implementation provided yet
-	return ErrNotSupported{
-		SCM:    "gitlab",
-		Method: "UpdateOrganization",
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "UpdateOrganization",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+
+	creationLevel := gitlab.MaintainerProjectCreation
+	if opt.RepoPermissions {
+		creationLevel = gitlab.DeveloperProjectCreation
+	}
+	_, _, err := s.client.Groups.UpdateGroup(opt.Path, &gitlab.UpdateGroupOptions{
+		ProjectCreationLevel: &creationLevel,
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return ErrFailedSCM{
+			Method:   "UpdateOrganization",
+			Message:  fmt.Sprintf("failed to update GitLab group %s", opt.Path),
+			GitError: err,
+		}
 	}
+	return nil
 }
 
 // GetOrganization implements the SCM interface.
 func (s *GitlabSCM) GetOrganization(ctx context.Context, opt *GetOrgOptions) (*pb.Organization, error) {
-	group, _, err := s.client.Groups.GetGroup(strconv.FormatUint(opt.ID, 10), gitlab.WithContext(ctx))
-	if err != nil {
-		return nil, err
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "GetOrganization",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	// priority is getting the group by ID
+	var gid interface{} = slug.Make(opt.Name)
+	if opt.ID > 0 {
+		gid = int(opt.ID)
+	}
+	group, _, err := s.client.Groups.GetGroup(gid, gitlab.WithContext(ctx))
+	if err != nil || group == nil {
+		return nil, ErrFailedSCM{
+			Method:   "GetOrganization",
+			Message:  fmt.Sprintf("could not find GitLab group %v", gid),
+			GitError: err,
+		}
+	}
+
+	// if user name is provided, return the found group only if the user is one of its owners
+	if opt.Username != "" {
+		userID, err := s.getUserID(ctx, opt.Username)
+		if err != nil {
+			s.logger.Debugf("GetOrganization: failed to find user %s: %v", opt.Username, err)
+			return nil, ErrNotMember
+		}
+		member, _, err := s.client.GroupMembers.GetGroupMember(group.ID, userID, gitlab.WithContext(ctx))
+		if err != nil {
+			s.logger.Debug("User ", opt.Username, " is not a member of ", group.Path)
+			return nil, ErrNotMember
+		}
+		if member.AccessLevel < gitlab.OwnerPermissions {
+			return nil, ErrNotOwner
+		}
 	}
 
 	return &pb.Organization{
@@ -64,39 +146,67 @@ func (s *GitlabSCM) GetOrganization(ctx context.Context, opt *GetOrgOptions) (*p
 
 // CreateRepository implements the SCM interface.
 func (s *GitlabSCM) CreateRepository(ctx context.Context, opt *CreateRepositoryOptions) (*Repository, error) {
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "CreateRepository",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+
+	// first make sure that repo does not already exist for this user or group
+	path := slug.Make(opt.Path)
+	if opt.Organization.Path != "" {
+		repo, _, err := s.client.Projects.GetProject(opt.Organization.Path+"/"+path, nil, gitlab.WithContext(ctx))
+		if err == nil {
+			return toGitlabRepository(repo), nil
+		}
+		// in most cases the repo will not exist and "not found" error will be returned
+		s.logger.Debugf("CreateRepository got expected error when checking for %s repository: %s", opt.Path, err)
+	}
+
 	directoryID := int(opt.Organization.ID)
 	repo, _, err := s.client.Projects.CreateProject(
 		&gitlab.CreateProjectOptions{
-			Path:        &opt.Path,
+			Name:        &opt.Path,
+			Path:        &path,
 			NamespaceID: &directoryID,
+			Visibility:  getVisibilityLevel(opt.Private),
 		},
 		gitlab.WithContext(ctx),
 	)
 	if err != nil {
-		return nil, err
+		return nil, ErrFailedSCM{
+			Method:   "CreateRepository",
+			Message:  fmt.Sprintf("failed to create repository %s, make sure it does not already exist", opt.Path),
+			GitError: err,
+		}
 	}
-
-	return &Repository{
-		ID:      uint64(repo.ID),
-		Path:    repo.Path,
-		WebURL:  repo.WebURL,
-		SSHURL:  repo.SSHURLToRepo,
-		HTTPURL: repo.HTTPURLToRepo,
-		OrgID:   opt.Organization.ID,
-	}, nil
+	return toGitlabRepository(repo), nil
 }
 
 // GetRepository implements the SCM interface.
-func (s *GitlabSCM) GetRepository(cts context.Context, opt *RepositoryOptions) (*Repository, error) {
-	// TODO no implementation provided yet
-	return nil, ErrNotSupported{
-		SCM:    "gitlab",
-		Method: "GetRepository",
+func (s *GitlabSCM) GetRepository(ctx context.Context, opt *RepositoryOptions) (*Repository, error) {
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "GetRepository",
+			Message: fmt.Sprintf("%+v", opt),
+		}
 	}
+	repo, _, err := s.client.Projects.GetProject(projectID(opt), nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("GetRepository failed to fetch repository %d, and path %s: %w", opt.ID, opt.Path, err)
+	}
+	return toGitlabRepository(repo), nil
 }
 
 // GetRepositories implements the SCM interface.
 func (s *GitlabSCM) GetRepositories(ctx context.Context, directory *pb.Organization) ([]*Repository, error) {
+	if !directory.IsValid() {
+		return nil, ErrMissingFields{
+			Method:  "GetRepositories",
+			Message: fmt.Sprintf("%+v", directory),
+		}
+	}
 	var gid interface{}
 	if directory.Path != "" {
 		gid = directory.Path
@@ -104,150 +214,427 @@ func (s *GitlabSCM) GetRepositories(ctx context.Context, directory *pb.Organizat
 		gid = strconv.FormatUint(directory.ID, 10)
 	}
 
-	repos, _, err := s.client.Groups.ListGroupProjects(gid, nil, gitlab.WithContext(ctx))
+	repos, err := s.listGroupProjects(ctx, gid)
 	if err != nil {
-		return nil, err
+		return nil, ErrFailedSCM{
+			GitError: err,
+			Method:   "GetRepositories",
+			Message:  fmt.Sprintf("failed to access repositories for group %v", gid),
+		}
 	}
 
 	var repositories []*Repository
 	for _, repo := range repos {
-		repositories = append(repositories, &Repository{
-			ID:      uint64(repo.ID),
-			Path:    repo.Path,
-			WebURL:  repo.WebURL,
-			SSHURL:  repo.SSHURLToRepo,
-			HTTPURL: repo.HTTPURLToRepo,
-			OrgID:   directory.ID,
-		})
+		repository := toGitlabRepository(repo)
+		if repository.OrgID == 0 {
+			repository.OrgID = directory.ID
+		}
+		repositories = append(repositories, repository)
 	}
-
 	return repositories, nil
 }
 
 // DeleteRepository implements the SCM interface.
-func (s *GitlabSCM) DeleteRepository(ctx context.Context, opt *RepositoryOptions) (err error) {
-	_, err = s.client.Projects.DeleteProject(strconv.FormatUint(opt.ID, 10), gitlab.WithContext(ctx))
-	return
+func (s *GitlabSCM) DeleteRepository(ctx context.Context, opt *RepositoryOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "DeleteRepository",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	if _, err := s.client.Projects.DeleteProject(projectID(opt), gitlab.WithContext(ctx)); err != nil {
+		return ErrFailedSCM{
+			GitError: err,
+			Method:   "DeleteRepository",
+			Message:  fmt.Sprintf("failed to delete repository %d %s", opt.ID, opt.Path),
+		}
+	}
+	return nil
 }
 
 // UpdateRepoAccess implements the SCM interface.
 func (s *GitlabSCM) UpdateRepoAccess(ctx context.Context, repo *Repository, user, permission string) error {
-	// TODO no implementation provided yet
-	return ErrNotSupported{
-		SCM:    "gitlab",
-		Method: "UpdateRepoAccess",
+	if repo == nil || (repo.ID == 0 && !repo.valid()) {
+		return ErrMissingFields{
+			Method:  "UpdateRepoAccess",
+			Message: fmt.Sprintf("%+v", repo),
+		}
+	}
+	userID, err := s.getUserID(ctx, user)
+	if err != nil {
+		return ErrFailedSCM{
+			GitError: err,
+			Method:   "UpdateRepoAccess",
+			Message:  fmt.Sprintf("failed to find user %s", user),
+		}
+	}
+	pid := projectID(&RepositoryOptions{ID: repo.ID, Path: repo.Path, Owner: repo.Owner})
+	level := accessLevel(permission)
+	_, resp, err := s.client.ProjectMembers.AddProjectMember(pid, &gitlab.AddProjectMemberOptions{
+		UserID:      &userID,
+		AccessLevel: &level,
+	}, gitlab.WithContext(ctx))
+	if isConflict(resp) {
+		// user is already a project member; update the access level instead
+		_, _, err = s.client.ProjectMembers.EditProjectMember(pid, userID, &gitlab.EditProjectMemberOptions{
+			AccessLevel: &level,
+		}, gitlab.WithContext(ctx))
+	}
+	if err != nil {
+		return ErrFailedSCM{
+			GitError: err,
+			Method:   "UpdateRepoAccess",
+			Message:  fmt.Sprintf("failed to grant %s permission to user %s for repository %s", permission, user, repo.Path),
+		}
 	}
+	return nil
 }
 
 // RepositoryIsEmpty implements the SCM interface
 func (s *GitlabSCM) RepositoryIsEmpty(ctx context.Context, opt *RepositoryOptions) bool {
-	// TODO no implementation provided yet
-	return false
+	if !opt.valid() {
+		return false
+	}
+	commits, _, err := s.client.Commits.ListCommits(projectID(opt), &gitlab.ListCommitsOptions{
+		ListOptions: gitlab.ListOptions{PerPage: 1},
+	}, gitlab.WithContext(ctx))
+	return err == nil && len(commits) == 0
 }
 
 // ListHooks implements the SCM interface.
-func (s *GitlabSCM) ListHooks(ctx context.Context, repo *Repository, org string) ([]*Hook, error) {
-	// TODO no implementation provided yet
-	return nil, ErrNotSupported{
-		SCM:    "gitlab",
-		Method: "ListHooks",
+func (s *GitlabSCM) ListHooks(ctx context.Context, repo *Repository, org string) (hooks []*Hook, err error) {
+	// we prioritize group hooks because repository hooks are no longer used.
+	switch {
+	case org != "":
+		groupHooks, err := s.listGroupHooks(ctx, slug.Make(org))
+		if err != nil {
+			return nil, fmt.Errorf("ListHooks: failed to get hooks for group %q: %w", org, err)
+		}
+		for _, hook := range groupHooks {
+			hooks = append(hooks, &Hook{
+				ID:     uint64(hook.ID),
+				URL:    hook.URL,
+				Events: hookEvents(hook.PushEvents),
+			})
+		}
+
+	case repo != nil && (repo.ID > 0 || repo.valid()):
+		pid := projectID(&RepositoryOptions{ID: repo.ID, Path: repo.Path, Owner: repo.Owner})
+		projectHooks, _, err := s.client.Projects.ListProjectHooks(pid, nil, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("ListHooks: failed to get hooks for repository %q: %w", repo.Path, err)
+		}
+		for _, hook := range projectHooks {
+			hooks = append(hooks, &Hook{
+				ID:     uint64(hook.ID),
+				URL:    hook.URL,
+				Events: hookEvents(hook.PushEvents),
+			})
+		}
+
+	default:
+		return nil, fmt.Errorf("ListHooks: called with missing or incompatible arguments: %q %q", repo, org)
 	}
+	return hooks, nil
 }
 
 // CreateHook implements the SCM interface.
 func (s *GitlabSCM) CreateHook(ctx context.Context, opt *CreateHookOptions) (err error) {
-	_, _, err = s.client.Projects.AddProjectHook(strconv.FormatUint(opt.Repository.ID, 10), &gitlab.AddProjectHookOptions{
-		URL:   &opt.URL,
-		Token: &opt.Secret,
-	}, gitlab.WithContext(ctx))
-	return
+	if opt.URL == "" || (opt.Organization == "" && opt.Repository == nil) {
+		return ErrMissingFields{
+			Method:  "CreateHook",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	pushEvents := true
+	// prioritize creating a group hook
+	if opt.Organization != "" {
+		_, _, err = s.client.Groups.AddGroupHook(slug.Make(opt.Organization), &gitlab.AddGroupHookOptions{
+			URL:        &opt.URL,
+			Token:      &opt.Secret,
+			PushEvents: &pushEvents,
+		}, gitlab.WithContext(ctx))
+	} else {
+		pid := projectID(&RepositoryOptions{ID: opt.Repository.ID, Path: opt.Repository.Path, Owner: opt.Repository.Owner})
+		_, _, err = s.client.Projects.AddProjectHook(pid, &gitlab.AddProjectHookOptions{
+			URL:        &opt.URL,
+			Token:      &opt.Secret,
+			PushEvents: &pushEvents,
+		}, gitlab.WithContext(ctx))
+	}
+	if err != nil {
+		return ErrFailedSCM{
+			GitError: err,
+			Method:   "CreateHook",
+			Message:  fmt.Sprintf("failed to create GitLab hook with query: %+v", opt),
+		}
+	}
+	return nil
 }
 
 // CreateTeam implements the SCM interface.
+// The team is created as a subgroup of the course group.
 func (s *GitlabSCM) CreateTeam(ctx context.Context, opt *NewTeamOptions) (*Team, error) {
-	// TODO no implementation provided yet
-	return nil, ErrNotSupported{
-		SCM:    "gitlab",
-		Method: "CreateTeam",
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "CreateTeam",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+
+	orgPath := slug.Make(opt.Organization)
+	teamPath := slug.Make(opt.TeamName)
+	// first check whether the team with this name already exists in this group
+	team, _, err := s.client.Groups.GetGroup(orgPath+"/"+teamPath, gitlab.WithContext(ctx))
+	if err != nil {
+		s.logger.Debugf("Team %s not found as expected: %s", opt.TeamName, err)
+		parent, _, err := s.client.Groups.GetGroup(orgPath, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, ErrFailedSCM{
+				Method:   "CreateTeam",
+				Message:  fmt.Sprintf("failed to find GitLab group %s", opt.Organization),
+				GitError: err,
+			}
+		}
+		team, _, err = s.client.Groups.CreateGroup(&gitlab.CreateGroupOptions{
+			Name:       &opt.TeamName,
+			Path:       &teamPath,
+			ParentID:   &parent.ID,
+			Visibility: getVisibilityLevel(true),
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, ErrFailedSCM{
+				Method:   "CreateTeam",
+				Message:  fmt.Sprintf("failed to create GitLab team %s, make sure it does not already exist", opt.TeamName),
+				GitError: fmt.Errorf("failed to create GitLab team %s: %w", opt.TeamName, err),
+			}
+		}
+	}
+	for _, user := range opt.Users {
+		if err := s.addGroupMember(ctx, team.ID, user, gitlab.DeveloperPermissions); err != nil {
+			return nil, ErrFailedSCM{
+				Method:   "CreateTeam",
+				Message:  fmt.Sprintf("failed to add user '%s' to GitLab team '%s'", user, team.Name),
+				GitError: fmt.Errorf("failed to add '%s' to GitLab team '%s': %w", user, team.Name, err),
+			}
+		}
 	}
+	return &Team{
+		ID:           uint64(team.ID),
+		Name:         team.Name,
+		Organization: orgPath,
+	}, nil
 }
 
 // DeleteTeam implements the SCM interface.
 func (s *GitlabSCM) DeleteTeam(ctx context.Context, opt *TeamOptions) error {
-	// TODO no implementation provided yet
-	return ErrNotSupported{
-		SCM:    "gitlab",
-		Method: "DeleteTeam",
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "DeleteTeam",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	if _, err := s.client.Groups.DeleteGroup(teamID(opt), gitlab.WithContext(ctx)); err != nil {
+		return ErrFailedSCM{
+			Method:   "DeleteTeam",
+			Message:  fmt.Sprintf("failed to delete GitLab team '%s'", opt.TeamName),
+			GitError: fmt.Errorf("failed to delete GitLab team '%s': %w", opt.TeamName, err),
+		}
 	}
+	return nil
 }
 
 // GetTeam implements the SCM interface
 func (s *GitlabSCM) GetTeam(ctx context.Context, opt *TeamOptions) (*Team, error) {
-	// TODO no implementation provided yet
-	return nil, ErrNotSupported{
-		SCM:    "gitlab",
-		Method: "GetTeam",
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "GetTeam",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	team, _, err := s.client.Groups.GetGroup(teamID(opt), gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("GetTeam: failed to get GitLab team %v: %w", teamID(opt), err)
 	}
+	return toTeam(team), nil
 }
 
 // GetTeams implements the SCM interface
 func (s *GitlabSCM) GetTeams(ctx context.Context, org *pb.Organization) ([]*Team, error) {
-	// TODO no implementation provided yet
-	return nil, ErrNotSupported{
-		SCM:    "gitlab",
-		Method: "GetTeam",
+	if !org.IsValid() {
+		return nil, ErrMissingFields{
+			Method:  "GetTeams",
+			Message: fmt.Sprintf("%+v", org),
+		}
 	}
+	var gid interface{} = int(org.ID)
+	if org.Path != "" {
+		gid = org.Path
+	}
+	groups, err := s.listSubgroups(ctx, gid)
+	if err != nil {
+		return nil, fmt.Errorf("GetTeams: failed to list GitLab subgroups: %w", err)
+	}
+	var teams []*Team
+	for _, group := range groups {
+		teams = append(teams, toTeam(group))
+	}
+	return teams, nil
 }
 
 // AddTeamMember implements the scm interface
 func (s *GitlabSCM) AddTeamMember(ctx context.Context, opt *TeamMembershipOptions) error {
-	// TODO no implementation provided yet
-	return ErrNotSupported{
-		SCM:    "gitlab",
-		Method: "AddTeamMember",
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "AddTeamMember",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	level := gitlab.DeveloperPermissions
+	if opt.Role == TeamMaintainer {
+		level = gitlab.MaintainerPermissions
+	}
+	gid := teamID(&TeamOptions{Organization: opt.Organization, TeamName: opt.TeamName, TeamID: opt.TeamID, OrganizationID: opt.OrganizationID})
+	if err := s.addGroupMember(ctx, gid, opt.Username, level); err != nil {
+		return ErrFailedSCM{
+			GitError: err,
+			Method:   "AddTeamMember",
+			Message:  fmt.Sprintf("failed to add user (%s) to team (ID %d, team name: %s) with role %s", opt.Username, opt.TeamID, opt.TeamName, opt.Role),
+		}
 	}
+	return nil
 }
 
 // RemoveTeamMember implements the scm interface
 func (s *GitlabSCM) RemoveTeamMember(ctx context.Context, opt *TeamMembershipOptions) error {
-	// TODO no implementation provided yet
-	return ErrNotSupported{
-		SCM:    "gitlab",
-		Method: "RemoveTeamMember",
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "RemoveTeamMember",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	userID, err := s.getUserID(ctx, opt.Username)
+	if err == nil {
+		gid := teamID(&TeamOptions{Organization: opt.Organization, TeamName: opt.TeamName, TeamID: opt.TeamID, OrganizationID: opt.OrganizationID})
+		_, err = s.client.GroupMembers.RemoveGroupMember(gid, userID, gitlab.WithContext(ctx))
 	}
+	if err != nil {
+		return ErrFailedSCM{
+			GitError: err,
+			Method:   "RemoveTeamMember",
+			Message:  fmt.Sprintf("failed to remove user %s from team ID %d", opt.Username, opt.TeamID),
+		}
+	}
+	return nil
 }
 
 // UpdateTeamMembers implements the SCM interface
-func (s *GitlabSCM) UpdateTeamMembers(context.Context, *UpdateTeamOptions) error {
-	// TODO no implementation provided yet
-	return ErrNotSupported{
-		SCM:    "gitlab",
-		Method: "UpdateTeamMembers",
+func (s *GitlabSCM) UpdateTeamMembers(ctx context.Context, opt *UpdateTeamOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "UpdateTeamMembers",
+			Message: fmt.Sprintf("%+v", opt),
+		}
 	}
+	gid := int(opt.TeamID)
+
+	// find current team members
+	oldUsers, err := s.listGroupMembers(ctx, gid)
+	if err != nil {
+		return ErrFailedSCM{
+			GitError: err,
+			Method:   "UpdateTeamMember",
+			Message:  fmt.Sprintf("failed to get members for team ID %d", opt.TeamID),
+		}
+	}
+	members := make(map[string]bool)
+	for _, member := range oldUsers {
+		members[member.Username] = true
+	}
+
+	// add missing members
+	newUsers := make(map[string]bool)
+	for _, member := range opt.Users {
+		newUsers[member] = true
+		if members[member] {
+			continue
+		}
+		if err := s.addGroupMember(ctx, gid, member, gitlab.DeveloperPermissions); err != nil {
+			return ErrFailedSCM{
+				GitError: err,
+				Method:   "UpdateTeamMember",
+				Message:  fmt.Sprintf("failed to add user %s to team ID %d", member, opt.TeamID),
+			}
+		}
+	}
+
+	// remove members that are no longer in the team
+	for _, teamMember := range oldUsers {
+		if newUsers[teamMember.Username] {
+			continue
+		}
+		if _, err := s.client.GroupMembers.RemoveGroupMember(gid, teamMember.ID, gitlab.WithContext(ctx)); err != nil {
+			return ErrFailedSCM{
+				GitError: err,
+				Method:   "UpdateTeamMember",
+				Message:  fmt.Sprintf("failed to remove user %s from team ID %d", teamMember.Username, opt.TeamID),
+			}
+		}
+	}
+	return nil
 }
 
 // AddTeamRepo implements the SCM interface.
+// The repository is shared with the team's subgroup.
 func (s *GitlabSCM) AddTeamRepo(ctx context.Context, opt *AddTeamRepoOptions) error {
-	// TODO no implementation provided yet
-	return ErrNotSupported{
-		SCM:    "gitlab",
-		Method: "AddTeamRepo",
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "AddTeamRepo",
+			Message: fmt.Sprintf("%+v", opt),
+		}
 	}
+	groupID := int(opt.TeamID)
+	level := accessLevel(opt.Permission)
+	_, err := s.client.Projects.ShareProjectWithGroup(opt.Owner+"/"+opt.Repo, &gitlab.ShareWithGroupOptions{
+		GroupID:     &groupID,
+		GroupAccess: &level,
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return ErrFailedSCM{
+			GitError: fmt.Errorf("failed to share GitLab repository '%s' with team %d: %w", opt.Repo, opt.TeamID, err),
+			Method:   "AddTeamRepo",
+			Message:  fmt.Sprintf("failed to make GitLab repository '%s' a team repository", opt.Repo),
+		}
+	}
+	return nil
 }
 
 // GetUserName implements the SCM interface.
 func (s *GitlabSCM) GetUserName(ctx context.Context) (string, error) {
-	return "", nil
+	user, _, err := s.client.Users.CurrentUser(gitlab.WithContext(ctx))
+	if err != nil {
+		return "", fmt.Errorf("GetUserName: failed to get GitLab user: %w", err)
+	}
+	return user.Username, nil
 }
 
 // GetUserNameByID implements the SCM interface.
 func (s *GitlabSCM) GetUserNameByID(ctx context.Context, remoteID uint64) (string, error) {
-	return "", nil
+	user, _, err := s.client.Users.GetUser(int(remoteID), gitlab.WithContext(ctx))
+	if err != nil {
+		return "", fmt.Errorf("GetUserNameByID: failed to get GitLab user '%d': %w", remoteID, err)
+	}
+	return user.Username, nil
 }
 
 // CreateCloneURL implements the SCM interface.
 func (s *GitlabSCM) CreateCloneURL(opt *CreateClonePathOptions) string {
-	return ""
+	token := s.token
+	if len(opt.UserToken) > 0 {
+		token = opt.UserToken
+	}
+	base := s.client.BaseURL()
+	return base.Scheme + "://oauth2:" + token + "@" + base.Host + "/" + opt.Organization + "/" + opt.Repository + ".git"
 }
 
 func getVisibilityLevel(private bool) *gitlab.VisibilityValue {
@@ -257,35 +644,312 @@ func getVisibilityLevel(private bool) *gitlab.VisibilityValue {
 	return gitlab.Visibility(gitlab.PublicVisibility)
 }
 
-// UpdateOrgMembership implements the SCM interface
+// UpdateOrgMembership implements the SCM interface.
+// Organization owners become owners of the course group. Other members become
+// guests, which cannot read the code of private projects in the group.
 func (s *GitlabSCM) UpdateOrgMembership(ctx context.Context, opt *OrgMembershipOptions) error {
-	// TODO no implementation provided yet
-	return ErrNotSupported{
-		SCM:    "gitlab",
-		Method: "UpdateOrgMembership",
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "UpdateOrgMembership",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	level := gitlab.GuestPermissions
+	if opt.Role == OrgOwner {
+		level = gitlab.OwnerPermissions
 	}
+	if err := s.addGroupMember(ctx, slug.Make(opt.Organization), opt.Username, level); err != nil {
+		return ErrFailedSCM{
+			GitError: fmt.Errorf("failed to update membership for user %s in group %s: %w", opt.Username, opt.Organization, err),
+			Method:   "UpdateOrgMembership",
+			Message:  fmt.Sprintf("failed to update membership for user %s", opt.Username),
+		}
+	}
+	return nil
 }
 
 // RemoveMember implements the SCM interface
 func (s *GitlabSCM) RemoveMember(ctx context.Context, opt *OrgMembershipOptions) error {
-	// TODO no implementation provided yet
-	return ErrNotSupported{
-		SCM:    "gitlab",
-		Method: "RemoveMember",
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "RemoveMember",
+			Message: fmt.Sprintf("%+v", opt),
+		}
 	}
+	orgPath := slug.Make(opt.Organization)
+	userID, err := s.getUserID(ctx, opt.Username)
+	if err != nil {
+		return ErrFailedSCM{
+			Method:   "RemoveMember",
+			GitError: fmt.Errorf("failed to find user %s: %w", opt.Username, err),
+			Message:  fmt.Sprintf("failed to remove user %s from the organization", opt.Username),
+		}
+	}
+
+	// remove user from all teams; subgroup memberships are not removed with the group membership
+	subgroups, err := s.listSubgroups(ctx, orgPath)
+	if err != nil {
+		return ErrFailedSCM{
+			Method:   "RemoveMember",
+			GitError: fmt.Errorf("failed to list subgroups of group %s: %w", opt.Organization, err),
+			Message:  fmt.Sprintf("failed to remove user %s from the organization", opt.Username),
+		}
+	}
+	for _, group := range subgroups {
+		resp, err := s.client.GroupMembers.RemoveGroupMember(group.ID, userID, gitlab.WithContext(ctx))
+		if err != nil && !isNotFound(resp) {
+			return ErrFailedSCM{
+				Method:   "RemoveMember",
+				GitError: fmt.Errorf("failed to remove user %s from team %s: %w", opt.Username, group.Path, err),
+				Message:  fmt.Sprintf("failed to remove user %s from the organization", opt.Username),
+			}
+		}
+	}
+	if _, err := s.client.GroupMembers.RemoveGroupMember(orgPath, userID, gitlab.WithContext(ctx)); err != nil {
+		return ErrFailedSCM{
+			Method:   "RemoveMember",
+			GitError: fmt.Errorf("failed to remove user %s from group %s: %w", opt.Username, opt.Organization, err),
+			Message:  fmt.Sprintf("failed to remove user %s from the organization", opt.Username),
+		}
+	}
+	return nil
 }
 
 // GetUserScopes implements the SCM interface
 func (s *GitlabSCM) GetUserScopes(ctx context.Context) *Authorization {
-	// TODO no implementation provided yet
-	return nil
+	// the token info endpoint is not part of the versioned API
+	base := s.client.BaseURL()
+	tokenInfoURL := base.Scheme + "://" + base.Host + "/oauth/token/info"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tokenInfoURL, nil)
+	if err != nil {
+		s.logger.Errorf("GetUserScopes: failed to create request: %v", err)
+		return &Authorization{Scopes: make([]string, 0)}
+	}
+	req.Header.Set("Authorization", "Bearer "+s.token)
+	resp, err := tokenInfoClient.Do(req)
+	if err != nil {
+		s.logger.Errorf("GetUserScopes: got no scopes: %v", err)
+		return &Authorization{Scopes: make([]string, 0)}
+	}
+	defer resp.Body.Close()
+
+	var tokenInfo struct {
+		Scopes []string `json:"scope"`
+	}
+	if resp.StatusCode != http.StatusOK {
+		s.logger.Errorf("GetUserScopes: got no scopes: %s", resp.Status)
+		return &Authorization{Scopes: make([]string, 0)}
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tokenInfo); err != nil {
+		s.logger.Errorf("GetUserScopes: failed to decode token info: %v", err)
+		return &Authorization{Scopes: make([]string, 0)}
+	}
+	return &Authorization{Scopes: tokenInfo.Scopes}
 }
 
 // GetFileContent implements the SCM interface
-func (s *GitlabSCM) GetFileContent(context.Context, *FileOptions) (string, error) {
-	// TODO no implementation provided yet
-	return "", ErrNotSupported{
-		SCM:    "gitlab",
-		Method: "GetFileContent",
+func (s *GitlabSCM) GetFileContent(ctx context.Context, opt *FileOptions) (string, error) {
+	if !opt.valid() {
+		return "", ErrMissingFields{
+			Method:  "GetFileContent",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	ref := "HEAD"
+	content, _, err := s.client.RepositoryFiles.GetRawFile(opt.Owner+"/"+opt.Repository, opt.Path, &gitlab.GetRawFileOptions{
+		Ref: &ref,
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return "", ErrFailedSCM{
+			Method:   "GetFileContent",
+			GitError: fmt.Errorf("failed to get contents of a file %s in repo %s of group %s: %w", opt.Path, opt.Repository, opt.Owner, err),
+			Message:  fmt.Sprintf("failed to get contents of the file at %s", opt.Path),
+		}
+	}
+	if len(content) == 0 {
+		return "", ErrFailedSCM{
+			Method:   "GetFileContent",
+			GitError: fmt.Errorf("file %s in repo %s of group %s has no content", opt.Path, opt.Repository, opt.Owner),
+			Message:  fmt.Sprintf("%s has no content", opt.Path),
+		}
+	}
+	return string(content), nil
+}
+
+// getUserID returns the GitLab user ID for the given username.
+func (s *GitlabSCM) getUserID(ctx context.Context, username string) (int, error) {
+	users, _, err := s.client.Users.ListUsers(&gitlab.ListUsersOptions{Username: &username}, gitlab.WithContext(ctx))
+	if err != nil {
+		return 0, err
+	}
+	if len(users) == 0 {
+		return 0, fmt.Errorf("user %s not found", username)
+	}
+	return users[0].ID, nil
+}
+
+// addGroupMember adds the user to the given group with the given access level.
+// If the user is already a member of the group, the access level is updated.
+func (s *GitlabSCM) addGroupMember(ctx context.Context, gid interface{}, username string, level gitlab.AccessLevelValue) error {
+	userID, err := s.getUserID(ctx, username)
+	if err != nil {
+		return err
+	}
+	_, resp, err := s.client.GroupMembers.AddGroupMember(gid, &gitlab.AddGroupMemberOptions{
+		UserID:      &userID,
+		AccessLevel: &level,
+	}, gitlab.WithContext(ctx))
+	if isConflict(resp) {
+		_, _, err = s.client.GroupMembers.EditGroupMember(gid, userID, &gitlab.EditGroupMemberOptions{
+			AccessLevel: &level,
+		}, gitlab.WithContext(ctx))
+	}
+	return err
+}
+
+// projectID returns the GitLab project ID for the given repository options;
+// either its numeric ID or its namespaced path.
+func projectID(opt *RepositoryOptions) interface{} {
+	if opt.ID > 0 {
+		return int(opt.ID)
+	}
+	return opt.Owner + "/" + opt.Path
+}
+
+// teamID returns the GitLab group ID for the given team options;
+// either its numeric ID or its namespaced path.
+func teamID(opt *TeamOptions) interface{} {
+	if opt.TeamID > 0 {
+		return int(opt.TeamID)
+	}
+	return slug.Make(opt.Organization) + "/" + slug.Make(opt.TeamName)
+}
+
+// accessLevel maps repository permissions to GitLab access levels.
+func accessLevel(permission string) gitlab.AccessLevelValue {
+	switch permission {
+	case RepoPull, OrgPull:
+		return gitlab.ReporterPermissions
+	case RepoPush, OrgPush:
+		return gitlab.DeveloperPermissions
+	case RepoFull:
+		return gitlab.MaintainerPermissions
+	}
+	return gitlab.GuestPermissions
+}
+
+func hookEvents(push bool) []string {
+	if push {
+		return []string{"push"}
+	}
+	return nil
+}
+
+func isConflict(resp *gitlab.Response) bool {
+	return resp != nil && resp.StatusCode == http.StatusConflict
+}
+
+func isNotFound(resp *gitlab.Response) bool {
+	return resp != nil && resp.StatusCode == http.StatusNotFound
+}
+
+func toGitlabRepository(repo *gitlab.Project) *Repository {
+	repository := &Repository{
+		ID:      uint64(repo.ID),
+		Path:    repo.Path,
+		WebURL:  repo.WebURL,
+		SSHURL:  repo.SSHURLToRepo,
+		HTTPURL: repo.HTTPURLToRepo,
+	}
+	if repo.Namespace != nil {
+		repository.Owner = repo.Namespace.FullPath
+		repository.OrgID = uint64(repo.Namespace.ID)
+	}
+	return repository
+}
+
+func toTeam(group *gitlab.Group) *Team {
+	organization := group.FullPath
+	if i := strings.LastIndex(organization, "/"); i > 0 {
+		organization = organization[:i]
+	}
+	return &Team{
+		ID:           uint64(group.ID),
+		Name:         group.Name,
+		Organization: organization,
+	}
+}
+
+// listGroupProjects returns all projects of the given group, following the pages of the listing.
+func (s *GitlabSCM) listGroupProjects(ctx context.Context, gid interface{}) ([]*gitlab.Project, error) {
+	opt := &gitlab.ListGroupProjectsOptions{ListOptions: gitlab.ListOptions{PerPage: perPage}}
+	var projects []*gitlab.Project
+	for {
+		page, resp, err := s.client.Groups.ListGroupProjects(gid, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		projects = append(projects, page...)
+		if resp.NextPage == 0 {
+			return projects, nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
+
+// listSubgroups returns all subgroups of the given group, following the pages of the listing.
+func (s *GitlabSCM) listSubgroups(ctx context.Context, gid interface{}) ([]*gitlab.Group, error) {
+	opt := &gitlab.ListSubgroupsOptions{ListOptions: gitlab.ListOptions{PerPage: perPage}}
+	var groups []*gitlab.Group
+	for {
+		page, resp, err := s.client.Groups.ListSubgroups(gid, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, page...)
+		if resp.NextPage == 0 {
+			return groups, nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
+
+// listGroupMembers returns all direct members of the given group, following the pages of the listing.
+func (s *GitlabSCM) listGroupMembers(ctx context.Context, gid interface{}) ([]*gitlab.GroupMember, error) {
+	opt := &gitlab.ListGroupMembersOptions{ListOptions: gitlab.ListOptions{PerPage: perPage}}
+	var members []*gitlab.GroupMember
+	for {
+		page, resp, err := s.client.Groups.ListGroupMembers(gid, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, page...)
+		if resp.NextPage == 0 {
+			return members, nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
+
+// listGroupHooks returns all hooks of the given group, following the pages of the listing.
+// The request is made directly, since the client's ListGroupHooks takes no context.
+func (s *GitlabSCM) listGroupHooks(ctx context.Context, group string) ([]*gitlab.GroupHook, error) {
+	opt := &gitlab.ListOptions{PerPage: perPage}
+	var hooks []*gitlab.GroupHook
+	for {
+		req, err := s.client.NewRequest(http.MethodGet, "groups/"+url.PathEscape(group)+"/hooks", opt, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+		if err != nil {
+			return nil, err
+		}
+		var page []*gitlab.GroupHook
+		resp, err := s.client.Do(req, &page)
+		if err != nil {
+			return nil, err
+		}
+		hooks = append(hooks, page...)
+		if resp.NextPage == 0 {
+			return hooks, nil
+		}
+		opt.Page = resp.NextPage
 	}
 }
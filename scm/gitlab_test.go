@@ -0,0 +1,851 @@
+package scm_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+)
+
+// GitLab access levels used by the stand-in server.
+const (
+	reporter   = 20
+	developer  = 30
+	maintainer = 40
+	owner      = 50
+)
+
+type fakeGitLabHook struct {
+	ID         int    `json:"id"`
+	URL        string `json:"url"`
+	PushEvents bool   `json:"push_events"`
+	Token      string `json:"-"`
+}
+
+type fakeGitLabGroup struct {
+	ID       int
+	Name     string
+	Path     string
+	FullPath string
+	ParentID int
+	members  map[int]int // user ID -> access level
+	hooks    []*fakeGitLabHook
+}
+
+type fakeGitLabProject struct {
+	ID          int
+	Name        string
+	Path        string
+	NamespaceID int
+	members     map[int]int // user ID -> access level
+	shared      map[int]int // group ID -> access level
+	hooks       []*fakeGitLabHook
+	files       map[string]string
+}
+
+// fakeGitLab is a minimal in-memory stand-in for the GitLab v4 API,
+// supporting the endpoints used by GitlabSCM.
+type fakeGitLab struct {
+	mu          sync.Mutex
+	url         string
+	token       string
+	currentUser int
+	users       map[int]string // user ID -> username
+	groups      map[int]*fakeGitLabGroup
+	projects    map[int]*fakeGitLabProject
+	nextID      int
+}
+
+func newFakeGitLab(t *testing.T, users ...string) (*fakeGitLab, *httptest.Server) {
+	t.Helper()
+	f := &fakeGitLab{
+		token:       "secret-token",
+		currentUser: 1,
+		users:       make(map[int]string),
+		groups:      make(map[int]*fakeGitLabGroup),
+		projects:    make(map[int]*fakeGitLabProject),
+		nextID:      100,
+	}
+	for i, user := range users {
+		f.users[i+1] = user
+	}
+	srv := httptest.NewServer(f)
+	f.url = srv.URL
+	return f, srv
+}
+
+func (f *fakeGitLab) id() int {
+	f.nextID++
+	return f.nextID
+}
+
+func (f *fakeGitLab) userID(name string) int {
+	for id, username := range f.users {
+		if username == name {
+			return id
+		}
+	}
+	return 0
+}
+
+func (f *fakeGitLab) group(id string) *fakeGitLabGroup {
+	for _, g := range f.groups {
+		if strconv.Itoa(g.ID) == id || g.FullPath == id {
+			return g
+		}
+	}
+	return nil
+}
+
+func (f *fakeGitLab) project(id string) *fakeGitLabProject {
+	for _, p := range f.projects {
+		if strconv.Itoa(p.ID) == id || f.groups[p.NamespaceID].FullPath+"/"+p.Path == id {
+			return p
+		}
+	}
+	return nil
+}
+
+func (f *fakeGitLab) groupJSON(g *fakeGitLabGroup) map[string]interface{} {
+	return map[string]interface{}{"id": g.ID, "name": g.Name, "path": g.Path, "full_path": g.FullPath, "parent_id": g.ParentID}
+}
+
+func (f *fakeGitLab) projectJSON(p *fakeGitLabProject) map[string]interface{} {
+	ns := f.groups[p.NamespaceID]
+	return map[string]interface{}{
+		"id":                  p.ID,
+		"name":                p.Name,
+		"path":                p.Path,
+		"path_with_namespace": ns.FullPath + "/" + p.Path,
+		"namespace":           map[string]interface{}{"id": ns.ID, "full_path": ns.FullPath},
+		"web_url":             f.url + "/" + ns.FullPath + "/" + p.Path,
+	}
+}
+
+func (f *fakeGitLab) membersJSON(members map[int]int) []map[string]interface{} {
+	list := make([]map[string]interface{}, 0)
+	for id, level := range members {
+		list = append(list, map[string]interface{}{"id": id, "username": f.users[id], "access_level": level})
+	}
+	return list
+}
+
+func (f *fakeGitLab) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.URL.Path == "/oauth/token/info" {
+		if r.Header.Get("Authorization") != "Bearer "+f.token {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		reply(w, http.StatusOK, map[string]interface{}{"scope": []string{"api"}})
+		return
+	}
+	if r.Header.Get("Authorization") != "Bearer "+f.token {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	// split the escaped path so that namespaced IDs such as "course%2Ftests" are kept intact
+	var parts []string
+	for _, part := range strings.Split(strings.TrimPrefix(r.URL.EscapedPath(), "/api/v4/"), "/") {
+		unescaped, err := url.PathUnescape(part)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		parts = append(parts, unescaped)
+	}
+	var body map[string]interface{}
+	if r.Method == http.MethodPost || r.Method == http.MethodPut {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
+	intField := func(name string) int {
+		v, _ := body[name].(float64)
+		return int(v)
+	}
+	strField := func(name string) string {
+		v, _ := body[name].(string)
+		return v
+	}
+
+	switch {
+	case len(parts) == 1 && parts[0] == "user":
+		reply(w, http.StatusOK, map[string]interface{}{"id": f.currentUser, "username": f.users[f.currentUser]})
+
+	case len(parts) == 1 && parts[0] == "users":
+		list := make([]map[string]interface{}, 0)
+		if id := f.userID(r.URL.Query().Get("username")); id > 0 {
+			list = append(list, map[string]interface{}{"id": id, "username": f.users[id]})
+		}
+		reply(w, http.StatusOK, list)
+
+	case len(parts) == 2 && parts[0] == "users":
+		id, _ := strconv.Atoi(parts[1])
+		if _, ok := f.users[id]; !ok {
+			reply(w, http.StatusNotFound, nil)
+			return
+		}
+		reply(w, http.StatusOK, map[string]interface{}{"id": id, "username": f.users[id]})
+
+	case len(parts) == 1 && parts[0] == "groups" && r.Method == http.MethodPost:
+		g := &fakeGitLabGroup{ID: f.id(), Name: strField("name"), Path: strField("path"), ParentID: intField("parent_id"), members: make(map[int]int)}
+		g.FullPath = g.Path
+		if parent, ok := f.groups[g.ParentID]; ok {
+			g.FullPath = parent.FullPath + "/" + g.Path
+		}
+		if f.group(g.FullPath) != nil {
+			reply(w, http.StatusBadRequest, nil)
+			return
+		}
+		g.members[f.currentUser] = owner
+		f.groups[g.ID] = g
+		reply(w, http.StatusCreated, f.groupJSON(g))
+
+	case len(parts) >= 2 && parts[0] == "groups":
+		g := f.group(parts[1])
+		if g == nil {
+			reply(w, http.StatusNotFound, nil)
+			return
+		}
+		f.serveGroup(w, r, g, parts[2:], intField, strField)
+
+	case len(parts) == 1 && parts[0] == "projects" && r.Method == http.MethodPost:
+		p := &fakeGitLabProject{ID: f.id(), Name: strField("name"), Path: strField("path"), NamespaceID: intField("namespace_id"),
+			members: make(map[int]int), shared: make(map[int]int), files: make(map[string]string)}
+		if _, ok := f.groups[p.NamespaceID]; !ok || f.project(f.groups[p.NamespaceID].FullPath+"/"+p.Path) != nil {
+			reply(w, http.StatusBadRequest, nil)
+			return
+		}
+		f.projects[p.ID] = p
+		reply(w, http.StatusCreated, f.projectJSON(p))
+
+	case len(parts) >= 2 && parts[0] == "projects":
+		p := f.project(parts[1])
+		if p == nil {
+			reply(w, http.StatusNotFound, nil)
+			return
+		}
+		f.serveProject(w, r, p, parts[2:], intField, strField)
+
+	default:
+		reply(w, http.StatusNotFound, nil)
+	}
+}
+
+func (f *fakeGitLab) serveGroup(w http.ResponseWriter, r *http.Request, g *fakeGitLabGroup, parts []string, intField func(string) int, strField func(string) string) {
+	switch {
+	case len(parts) == 0 && r.Method == http.MethodGet:
+		reply(w, http.StatusOK, f.groupJSON(g))
+	case len(parts) == 0 && r.Method == http.MethodPut:
+		reply(w, http.StatusOK, f.groupJSON(g))
+	case len(parts) == 0 && r.Method == http.MethodDelete:
+		delete(f.groups, g.ID)
+		reply(w, http.StatusAccepted, nil)
+
+	case len(parts) == 1 && parts[0] == "subgroups":
+		list := make([]map[string]interface{}, 0)
+		for _, sub := range f.groups {
+			if sub.ParentID == g.ID {
+				list = append(list, f.groupJSON(sub))
+			}
+		}
+		replyPage(w, r, list)
+
+	case len(parts) == 1 && parts[0] == "projects":
+		list := make([]map[string]interface{}, 0)
+		for _, p := range f.projects {
+			if p.NamespaceID == g.ID {
+				list = append(list, f.projectJSON(p))
+			}
+		}
+		replyPage(w, r, list)
+
+	case len(parts) == 1 && parts[0] == "hooks" && r.Method == http.MethodGet:
+		list := make([]map[string]interface{}, 0)
+		for _, hook := range g.hooks {
+			list = append(list, map[string]interface{}{"id": hook.ID, "url": hook.URL, "push_events": hook.PushEvents})
+		}
+		replyPage(w, r, list)
+	case len(parts) == 1 && parts[0] == "hooks" && r.Method == http.MethodPost:
+		hook := &fakeGitLabHook{ID: f.id(), URL: strField("url"), PushEvents: true, Token: strField("token")}
+		g.hooks = append(g.hooks, hook)
+		reply(w, http.StatusCreated, hook)
+
+	case len(parts) == 1 && parts[0] == "members" && r.Method == http.MethodGet:
+		replyPage(w, r, f.membersJSON(g.members))
+	case len(parts) == 1 && parts[0] == "members" && r.Method == http.MethodPost:
+		userID := intField("user_id")
+		if _, ok := g.members[userID]; ok {
+			reply(w, http.StatusConflict, map[string]interface{}{"message": "Member already exists"})
+			return
+		}
+		g.members[userID] = intField("access_level")
+		reply(w, http.StatusCreated, map[string]interface{}{"id": userID, "username": f.users[userID], "access_level": g.members[userID]})
+
+	case len(parts) == 2 && parts[0] == "members":
+		userID, _ := strconv.Atoi(parts[1])
+		level, ok := g.members[userID]
+		if !ok {
+			reply(w, http.StatusNotFound, nil)
+			return
+		}
+		switch r.Method {
+		case http.MethodPut:
+			g.members[userID] = intField("access_level")
+			level = g.members[userID]
+		case http.MethodDelete:
+			delete(g.members, userID)
+			reply(w, http.StatusNoContent, nil)
+			return
+		}
+		reply(w, http.StatusOK, map[string]interface{}{"id": userID, "username": f.users[userID], "access_level": level})
+
+	default:
+		reply(w, http.StatusNotFound, nil)
+	}
+}
+
+func (f *fakeGitLab) serveProject(w http.ResponseWriter, r *http.Request, p *fakeGitLabProject, parts []string, intField func(string) int, strField func(string) string) {
+	switch {
+	case len(parts) == 0 && r.Method == http.MethodGet:
+		reply(w, http.StatusOK, f.projectJSON(p))
+	case len(parts) == 0 && r.Method == http.MethodDelete:
+		delete(f.projects, p.ID)
+		reply(w, http.StatusAccepted, nil)
+
+	case len(parts) == 1 && parts[0] == "share":
+		p.shared[intField("group_id")] = intField("group_access")
+		reply(w, http.StatusCreated, nil)
+
+	case len(parts) == 1 && parts[0] == "hooks" && r.Method == http.MethodGet:
+		reply(w, http.StatusOK, p.hooks)
+	case len(parts) == 1 && parts[0] == "hooks" && r.Method == http.MethodPost:
+		hook := &fakeGitLabHook{ID: f.id(), URL: strField("url"), PushEvents: true, Token: strField("token")}
+		p.hooks = append(p.hooks, hook)
+		reply(w, http.StatusCreated, hook)
+
+	case len(parts) == 1 && parts[0] == "members" && r.Method == http.MethodPost:
+		userID := intField("user_id")
+		if _, ok := p.members[userID]; ok {
+			reply(w, http.StatusConflict, map[string]interface{}{"message": "Member already exists"})
+			return
+		}
+		p.members[userID] = intField("access_level")
+		reply(w, http.StatusCreated, map[string]interface{}{"id": userID, "access_level": p.members[userID]})
+	case len(parts) == 2 && parts[0] == "members" && r.Method == http.MethodPut:
+		userID, _ := strconv.Atoi(parts[1])
+		p.members[userID] = intField("access_level")
+		reply(w, http.StatusOK, map[string]interface{}{"id": userID, "access_level": p.members[userID]})
+
+	case len(parts) == 2 && parts[0] == "repository" && parts[1] == "commits":
+		list := make([]map[string]interface{}, 0)
+		if len(p.files) > 0 {
+			list = append(list, map[string]interface{}{"id": "deadbeef"})
+		}
+		reply(w, http.StatusOK, list)
+
+	case len(parts) == 4 && parts[0] == "repository" && parts[1] == "files" && parts[3] == "raw":
+		content, ok := p.files[parts[2]]
+		if !ok {
+			reply(w, http.StatusNotFound, nil)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(content))
+
+	default:
+		reply(w, http.StatusNotFound, nil)
+	}
+}
+
+func reply(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if v != nil {
+		_ = json.NewEncoder(w).Encode(v)
+	}
+}
+
+// replyPage replies with the page of the given list, ordered by ID, that is requested by the
+// page and per_page query parameters, like GitLab does, including the X-Next-Page header.
+func replyPage(w http.ResponseWriter, r *http.Request, list []map[string]interface{}) {
+	sort.Slice(list, func(i, j int) bool { return list[i]["id"].(int) < list[j]["id"].(int) })
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+	perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+	if perPage < 1 {
+		perPage = 20
+	}
+	start, end := (page-1)*perPage, page*perPage
+	if start > len(list) {
+		start = len(list)
+	}
+	if end < len(list) {
+		w.Header().Set("X-Next-Page", strconv.Itoa(page+1))
+	} else {
+		end = len(list)
+	}
+	reply(w, http.StatusOK, list[start:end])
+}
+
+func newGitlabSCM(t *testing.T, f *fakeGitLab, srv *httptest.Server) scm.SCM {
+	t.Helper()
+	s, err := scm.NewGitlabSCMClient(zap.NewNop().Sugar(), srv.URL, f.token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func groupMembers(f *fakeGitLab, path string) map[string]int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	members := make(map[string]int)
+	for id, level := range f.group(path).members {
+		members[f.users[id]] = level
+	}
+	return members
+}
+
+func TestGitlabOrganization(t *testing.T) {
+	f, srv := newFakeGitLab(t, "teacher", "student", "outsider")
+	defer srv.Close()
+	s := newGitlabSCM(t, f, srv)
+	ctx := context.Background()
+
+	org, err := s.CreateOrganization(ctx, &scm.OrganizationOptions{Name: "DAT320", Path: "dat320"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.UpdateOrgMembership(ctx, &scm.OrgMembershipOptions{Organization: "dat320", Username: "student", Role: scm.OrgMember}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.UpdateOrganization(ctx, &scm.OrganizationOptions{Path: "dat320", DefaultPermission: "none"}); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := s.GetOrganization(ctx, &scm.GetOrgOptions{Name: "DAT320"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.GetID() != org.GetID() || got.GetPath() != "dat320" {
+		t.Errorf("GetOrganization() = %v, want %v", got, org)
+	}
+	if _, err := s.GetOrganization(ctx, &scm.GetOrgOptions{ID: org.GetID(), Username: "teacher"}); err != nil {
+		t.Errorf("GetOrganization(teacher) = %v, want owner", err)
+	}
+	if _, err := s.GetOrganization(ctx, &scm.GetOrgOptions{ID: org.GetID(), Username: "student"}); err != scm.ErrNotOwner {
+		t.Errorf("GetOrganization(student) = %v, want %v", err, scm.ErrNotOwner)
+	}
+	if _, err := s.GetOrganization(ctx, &scm.GetOrgOptions{ID: org.GetID(), Username: "outsider"}); err != scm.ErrNotMember {
+		t.Errorf("GetOrganization(outsider) = %v, want %v", err, scm.ErrNotMember)
+	}
+	if _, err := s.GetOrganization(ctx, &scm.GetOrgOptions{Name: "unknown"}); err == nil {
+		t.Error("GetOrganization(unknown) succeeded, want error")
+	}
+
+	// promoting the student to owner updates the existing membership
+	if err := s.UpdateOrgMembership(ctx, &scm.OrgMembershipOptions{Organization: "dat320", Username: "student", Role: scm.OrgOwner}); err != nil {
+		t.Fatal(err)
+	}
+	if level := groupMembers(f, "dat320")["student"]; level != owner {
+		t.Errorf("student access level = %d, want %d", level, owner)
+	}
+	if _, err := s.GetOrganization(ctx, &scm.GetOrgOptions{ID: org.GetID(), Username: "student"}); err != nil {
+		t.Errorf("GetOrganization(student) = %v, want owner", err)
+	}
+}
+
+func TestGitlabTeams(t *testing.T) {
+	f, srv := newFakeGitLab(t, "teacher", "alice", "bob", "carol")
+	defer srv.Close()
+	s := newGitlabSCM(t, f, srv)
+	ctx := context.Background()
+
+	org, err := s.CreateOrganization(ctx, &scm.OrganizationOptions{Name: "DAT320", Path: "dat320"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	team, err := s.CreateTeam(ctx, &scm.NewTeamOptions{Organization: "dat320", TeamName: "Group One", Users: []string{"alice", "bob"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantTeam := &scm.Team{ID: team.ID, Name: "Group One", Organization: "dat320"}
+	if diff := cmp.Diff(wantTeam, team); diff != "" {
+		t.Errorf("CreateTeam() mismatch (-want +got):\n%s", diff)
+	}
+	// creating the same team again returns the existing team
+	again, err := s.CreateTeam(ctx, &scm.NewTeamOptions{Organization: "dat320", TeamName: "Group One"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.ID != team.ID {
+		t.Errorf("CreateTeam() created team %d, want existing team %d", again.ID, team.ID)
+	}
+
+	got, err := s.GetTeam(ctx, &scm.TeamOptions{Organization: "dat320", TeamName: "Group One"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(wantTeam, got); diff != "" {
+		t.Errorf("GetTeam() mismatch (-want +got):\n%s", diff)
+	}
+	teams, err := s.GetTeams(ctx, org)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]*scm.Team{wantTeam}, teams); diff != "" {
+		t.Errorf("GetTeams() mismatch (-want +got):\n%s", diff)
+	}
+
+	teamPath := "dat320/group-one"
+	want := map[string]int{"teacher": owner, "alice": developer, "bob": developer}
+	if diff := cmp.Diff(want, groupMembers(f, teamPath)); diff != "" {
+		t.Errorf("team members mismatch (-want +got):\n%s", diff)
+	}
+
+	if err := s.UpdateTeamMembers(ctx, &scm.UpdateTeamOptions{OrganizationID: org.GetID(), TeamID: team.ID, Users: []string{"teacher", "bob", "carol"}}); err != nil {
+		t.Fatal(err)
+	}
+	want = map[string]int{"teacher": owner, "bob": developer, "carol": developer}
+	if diff := cmp.Diff(want, groupMembers(f, teamPath)); diff != "" {
+		t.Errorf("UpdateTeamMembers() mismatch (-want +got):\n%s", diff)
+	}
+
+	if err := s.AddTeamMember(ctx, &scm.TeamMembershipOptions{OrganizationID: org.GetID(), TeamID: team.ID, Username: "alice", Role: scm.TeamMaintainer}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.RemoveTeamMember(ctx, &scm.TeamMembershipOptions{Organization: "dat320", TeamName: "Group One", Username: "bob"}); err != nil {
+		t.Fatal(err)
+	}
+	want = map[string]int{"teacher": owner, "alice": maintainer, "carol": developer}
+	if diff := cmp.Diff(want, groupMembers(f, teamPath)); diff != "" {
+		t.Errorf("team members mismatch (-want +got):\n%s", diff)
+	}
+
+	if err := s.DeleteTeam(ctx, &scm.TeamOptions{OrganizationID: org.GetID(), TeamID: team.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.GetTeam(ctx, &scm.TeamOptions{OrganizationID: org.GetID(), TeamID: team.ID}); err == nil {
+		t.Error("GetTeam() succeeded for deleted team, want error")
+	}
+}
+
+func TestGitlabRepositories(t *testing.T) {
+	f, srv := newFakeGitLab(t, "teacher", "alice")
+	defer srv.Close()
+	s := newGitlabSCM(t, f, srv)
+	ctx := context.Background()
+
+	org, err := s.CreateOrganization(ctx, &scm.OrganizationOptions{Name: "DAT320", Path: "dat320"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var created []*scm.Repository
+	for _, path := range []string{pb.TestsRepo, pb.AssignmentRepo, "alice-labs"} {
+		repo, err := s.CreateRepository(ctx, &scm.CreateRepositoryOptions{Organization: org, Path: path, Private: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if repo.Owner != "dat320" || repo.Path != path || repo.OrgID != org.GetID() {
+			t.Errorf("CreateRepository() = %+v, want repository %s in dat320", repo, path)
+		}
+		created = append(created, repo)
+	}
+	// creating an existing repository returns the existing repository
+	existing, err := s.CreateRepository(ctx, &scm.CreateRepositoryOptions{Organization: org, Path: pb.TestsRepo, Private: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if existing.ID != created[0].ID {
+		t.Errorf("CreateRepository() created repository %d, want existing repository %d", existing.ID, created[0].ID)
+	}
+
+	repos, err := s.GetRepositories(ctx, org)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Slice(repos, func(i, j int) bool { return repos[i].ID < repos[j].ID })
+	if diff := cmp.Diff(created, repos); diff != "" {
+		t.Errorf("GetRepositories() mismatch (-want +got):\n%s", diff)
+	}
+	repo, err := s.GetRepository(ctx, &scm.RepositoryOptions{Owner: "dat320", Path: pb.TestsRepo})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(created[0], repo); diff != "" {
+		t.Errorf("GetRepository() mismatch (-want +got):\n%s", diff)
+	}
+
+	if !s.RepositoryIsEmpty(ctx, &scm.RepositoryOptions{ID: repo.ID}) {
+		t.Error("RepositoryIsEmpty() = false, want true")
+	}
+	f.mu.Lock()
+	f.projects[int(repo.ID)].files["lab1/assignment.yml"] = "assignmentid: 1\n"
+	f.mu.Unlock()
+	if s.RepositoryIsEmpty(ctx, &scm.RepositoryOptions{ID: repo.ID}) {
+		t.Error("RepositoryIsEmpty() = true, want false")
+	}
+	content, err := s.GetFileContent(ctx, &scm.FileOptions{Owner: "dat320", Repository: pb.TestsRepo, Path: "lab1/assignment.yml"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if content != "assignmentid: 1\n" {
+		t.Errorf("GetFileContent() = %q, want %q", content, "assignmentid: 1\n")
+	}
+	if _, err := s.GetFileContent(ctx, &scm.FileOptions{Owner: "dat320", Repository: pb.TestsRepo, Path: "lab2/assignment.yml"}); err == nil {
+		t.Error("GetFileContent() succeeded for missing file, want error")
+	}
+
+	// user repository access is granted directly, and updated if already granted
+	userRepo := created[2]
+	for _, permission := range []string{scm.RepoPull, scm.RepoPush} {
+		if err := s.UpdateRepoAccess(ctx, userRepo, "alice", permission); err != nil {
+			t.Fatal(err)
+		}
+	}
+	team, err := s.CreateTeam(ctx, &scm.NewTeamOptions{Organization: "dat320", TeamName: "teachers"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.AddTeamRepo(ctx, &scm.AddTeamRepoOptions{OrganizationID: org.GetID(), TeamID: team.ID, Owner: "dat320", Repo: pb.AssignmentRepo, Permission: scm.RepoPull}); err != nil {
+		t.Fatal(err)
+	}
+	f.mu.Lock()
+	if level := f.projects[int(userRepo.ID)].members[f.userID("alice")]; level != developer {
+		t.Errorf("alice access level = %d, want %d", level, developer)
+	}
+	if level := f.projects[int(created[1].ID)].shared[int(team.ID)]; level != reporter {
+		t.Errorf("team access level = %d, want %d", level, reporter)
+	}
+	f.mu.Unlock()
+
+	wantURL := strings.Replace(srv.URL, "http://", "http://oauth2:user-token@", 1) + "/dat320/alice-labs.git"
+	if cloneURL := s.CreateCloneURL(&scm.CreateClonePathOptions{UserToken: "user-token", Organization: "dat320", Repository: "alice-labs"}); cloneURL != wantURL {
+		t.Errorf("CreateCloneURL() = %s, want %s", cloneURL, wantURL)
+	}
+
+	if err := s.DeleteRepository(ctx, &scm.RepositoryOptions{ID: userRepo.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.GetRepository(ctx, &scm.RepositoryOptions{ID: userRepo.ID}); err == nil {
+		t.Error("GetRepository() succeeded for deleted repository, want error")
+	}
+}
+
+func TestGitlabHooks(t *testing.T) {
+	f, srv := newFakeGitLab(t, "teacher")
+	defer srv.Close()
+	s := newGitlabSCM(t, f, srv)
+	ctx := context.Background()
+
+	org, err := s.CreateOrganization(ctx, &scm.OrganizationOptions{Name: "DAT320", Path: "dat320"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, err := s.CreateRepository(ctx, &scm.CreateRepositoryOptions{Organization: org, Path: pb.TestsRepo})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.CreateHook(ctx, &scm.CreateHookOptions{URL: serverURL, Secret: secret, Organization: "dat320"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.CreateHook(ctx, &scm.CreateHookOptions{URL: serverURL + "/repo", Secret: secret, Repository: repo}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		repo *scm.Repository
+		org  string
+		url  string
+	}{
+		{nil, "dat320", serverURL},
+		{repo, "", serverURL + "/repo"},
+	} {
+		hooks, err := s.ListHooks(ctx, tt.repo, tt.org)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(hooks) != 1 || hooks[0].URL != tt.url || !cmp.Equal(hooks[0].Events, []string{"push"}) {
+			t.Errorf("ListHooks() = %+v, want push hook for %s", hooks, tt.url)
+		}
+	}
+	f.mu.Lock()
+	if token := f.group("dat320").hooks[0].Token; token != secret {
+		t.Errorf("hook token = %q, want %q", token, secret)
+	}
+	f.mu.Unlock()
+}
+
+func TestGitlabRemoveMember(t *testing.T) {
+	f, srv := newFakeGitLab(t, "teacher", "alice")
+	defer srv.Close()
+	s := newGitlabSCM(t, f, srv)
+	ctx := context.Background()
+
+	if _, err := s.CreateOrganization(ctx, &scm.OrganizationOptions{Name: "DAT320", Path: "dat320"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.UpdateOrgMembership(ctx, &scm.OrgMembershipOptions{Organization: "dat320", Username: "alice", Role: scm.OrgMember}); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"group-a", "group-b"} {
+		opt := &scm.NewTeamOptions{Organization: "dat320", TeamName: name}
+		if name == "group-a" {
+			opt.Users = []string{"alice"}
+		}
+		if _, err := s.CreateTeam(ctx, opt); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := s.RemoveMember(ctx, &scm.OrgMembershipOptions{Organization: "dat320", Username: "alice"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{"dat320", "dat320/group-a", "dat320/group-b"} {
+		if _, ok := groupMembers(f, path)["alice"]; ok {
+			t.Errorf("alice is still a member of %s", path)
+		}
+	}
+	if err := s.RemoveMember(ctx, &scm.OrgMembershipOptions{Organization: "dat320", Username: "bob"}); err == nil {
+		t.Error("RemoveMember() succeeded for unknown user, want error")
+	}
+}
+
+func TestGitlabPagination(t *testing.T) {
+	const n = 45 // more than the default page size of 20, and than twice the page size
+	users := []string{"teacher"}
+	for i := 1; i <= n; i++ {
+		users = append(users, "student"+strconv.Itoa(i))
+	}
+	f, srv := newFakeGitLab(t, users...)
+	defer srv.Close()
+	s := newGitlabSCM(t, f, srv)
+	ctx := context.Background()
+
+	org, err := s.CreateOrganization(ctx, &scm.OrganizationOptions{Name: "DAT320", Path: "dat320"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i <= n; i++ {
+		name := "group" + strconv.Itoa(i)
+		if _, err := s.CreateRepository(ctx, &scm.CreateRepositoryOptions{Organization: org, Path: name}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := s.CreateTeam(ctx, &scm.NewTeamOptions{Organization: "dat320", TeamName: name, Users: []string{users[i]}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	repos, err := s.GetRepositories(ctx, org)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(repos) != n {
+		t.Errorf("GetRepositories() returned %d repositories, want %d", len(repos), n)
+	}
+	teams, err := s.GetTeams(ctx, org)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(teams) != n {
+		t.Errorf("GetTeams() returned %d teams, want %d", len(teams), n)
+	}
+
+	// keeping all members but the last must only remove the last member
+	team, err := s.GetTeam(ctx, &scm.TeamOptions{Organization: "dat320", TeamName: "group1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.UpdateTeamMembers(ctx, &scm.UpdateTeamOptions{OrganizationID: org.GetID(), TeamID: team.ID, Users: users}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.UpdateTeamMembers(ctx, &scm.UpdateTeamOptions{OrganizationID: org.GetID(), TeamID: team.ID, Users: users[:n]}); err != nil {
+		t.Fatal(err)
+	}
+	members := groupMembers(f, "dat320/group1")
+	if len(members) != n {
+		t.Errorf("UpdateTeamMembers() kept %d members, want %d", len(members), n)
+	}
+	if _, ok := members[users[n]]; ok {
+		t.Errorf("UpdateTeamMembers() kept %s, want removed", users[n])
+	}
+
+	// the last student is only a member of the organization and of the team on the last page
+	if err := s.UpdateOrgMembership(ctx, &scm.OrgMembershipOptions{Organization: "dat320", Username: users[n], Role: scm.OrgMember}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.RemoveMember(ctx, &scm.OrgMembershipOptions{Organization: "dat320", Username: users[n]}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := groupMembers(f, "dat320/group"+strconv.Itoa(n))[users[n]]; ok {
+		t.Errorf("%s is still a member of the last team", users[n])
+	}
+
+	f.mu.Lock()
+	g := f.group("dat320")
+	for i := 0; i < n; i++ {
+		g.hooks = append(g.hooks, &fakeGitLabHook{ID: f.id(), URL: serverURL, PushEvents: true})
+	}
+	f.mu.Unlock()
+	hooks, err := s.ListHooks(ctx, nil, "dat320")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hooks) != n {
+		t.Errorf("ListHooks() returned %d hooks, want %d", len(hooks), n)
+	}
+}
+
+func TestGitlabUsers(t *testing.T) {
+	f, srv := newFakeGitLab(t, "teacher", "alice")
+	defer srv.Close()
+	s := newGitlabSCM(t, f, srv)
+	ctx := context.Background()
+
+	name, err := s.GetUserName(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "teacher" {
+		t.Errorf("GetUserName() = %s, want teacher", name)
+	}
+	name, err = s.GetUserNameByID(ctx, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "alice" {
+		t.Errorf("GetUserNameByID(2) = %s, want alice", name)
+	}
+	if _, err := s.GetUserNameByID(ctx, 3); err == nil {
+		t.Error("GetUserNameByID(3) succeeded, want error")
+	}
+	if scopes := s.GetUserScopes(ctx).Scopes; !cmp.Equal(scopes, []string{"api"}) {
+		t.Errorf("GetUserScopes() = %v, want [api]", scopes)
+	}
+
+	// validation errors are reported without contacting the server
+	var missing scm.ErrMissingFields
+	if err := s.UpdateOrgMembership(ctx, &scm.OrgMembershipOptions{}); !errors.As(err, &missing) {
+		t.Errorf("UpdateOrgMembership() = %v, want %T", err, missing)
+	}
+}
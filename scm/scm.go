@@ -3,6 +3,7 @@ package scm
 import (
 	"context"
 	"errors"
+	"os"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"go.uber.org/zap"
@@ -72,7 +73,11 @@ func NewSCMClient(logger *zap.SugaredLogger, provider, token string) (SCM, error
 	case "github":
 		return NewGithubSCMClient(logger, token), nil
 	case "gitlab":
-		return NewGitlabSCMClient(token), nil
+		client, err := NewGitlabSCMClient(logger, os.Getenv("GITLAB_URL"), token)
+		if err != nil {
+			return nil, err
+		}
+		return client, nil
 	case "fake":
 		return NewFakeSCMClient(), nil
 	}
@@ -103,7 +108,7 @@ type GetOrgOptions struct {
 type Repository struct {
 	ID      uint64
 	Path    string
-	Owner   string // Only used by GitHub.
+	Owner   string // Organization or group path.
 	WebURL  string // Repository website.
 	SSHURL  string // SSH clone URL, used by GitLab.
 	HTTPURL string // HTTP(S) clone URL.
@@ -208,7 +213,7 @@ type AddTeamRepoOptions struct {
 	OrganizationID uint64
 	TeamID         uint64
 	Repo           string
-	Owner          string // Name of the organization. Only used by GitHub.
+	Owner          string // Name of the organization.
 	Permission     string // Permission level for team members. Can be "push", "pull", "admin".
 }
 
@@ -0,0 +1,193 @@
+// Package slipdays recomputes the slip days used by the students of a course by
+// replaying the build dates of their submission attempts against the current
+// deadlines, deadline extensions and grace period of the course.
+package slipdays
+
+import (
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+)
+
+// Recompute recomputes the slip days used by the students of the requested course,
+// and returns the changes to their used and remaining slip days. If the request changes
+// the slip days of the course, the remaining slip days are computed with the new slip days.
+// The changes are saved in a single transaction only if the request applies them.
+//
+// An attempt is replayed as a build of the submission's score at the attempt's build date,
+// and the submission counts as approved for attempts built on or after its approval date.
+// Attempts without a build date, such as those of submissions built before attempts were
+// recorded, cannot be replayed; the used slip days of such assignments are kept unless
+// the assignment also has attempts with build dates, and are reported with each student's change.
+func Recompute(db database.Database, request *pb.SlipDaysRequest) (*pb.SlipDaysRecomputation, error) {
+	courseID := request.GetCourseID()
+	course, err := db.GetCourse(courseID, false)
+	if err != nil {
+		return nil, err
+	}
+	assignments, err := db.GetCourseAssignmentsWithSubmissionsNoBuildInfo(courseID, pb.SubmissionsForCourseRequest_ALL)
+	if err != nil {
+		return nil, err
+	}
+	enrollments, err := db.GetEnrollmentsByCourse(courseID, pb.Enrollment_STUDENT)
+	if err != nil {
+		return nil, err
+	}
+	extensions, err := db.GetDeadlineExtensions(courseID)
+	if err != nil {
+		return nil, err
+	}
+	attempts, err := db.GetCourseSubmissionAttempts(courseID)
+	if err != nil {
+		return nil, err
+	}
+	attemptsBySubmission := make(map[uint64][]*pb.SubmissionAttempt)
+	for _, attempt := range attempts {
+		attemptsBySubmission[attempt.GetSubmissionID()] = append(attemptsBySubmission[attempt.GetSubmissionID()], attempt)
+	}
+
+	result := &pb.SlipDaysRecomputation{
+		OldSlipDays: course.GetSlipDays(),
+		NewSlipDays: course.GetSlipDays(),
+	}
+	if request.GetChangeSlipDays() {
+		result.NewSlipDays = request.GetSlipDays()
+	}
+	var updates []*pb.UsedSlipDays
+	for _, enrollment := range enrollments {
+		replayed, err := replay(enrollment, course, assignments, attemptsBySubmission, extensions)
+		if err != nil {
+			return nil, err
+		}
+		change := &pb.SlipDaysChange{
+			EnrollmentID: enrollment.GetID(),
+			UserID:       enrollment.GetUserID(),
+			Login:        enrollment.GetUser().GetLogin(),
+			OldRemaining: enrollment.RemainingSlipDays(course),
+		}
+		var totalUsed uint32
+		for _, assignment := range assignments {
+			record := usedSlipDays(enrollment, assignment.GetID())
+			newUsed, ok := replayed[assignment.GetID()]
+			if !ok {
+				newUsed = record.GetUsedSlipDays()
+				if newUsed > 0 {
+					change.KeptAssignmentIDs = append(change.KeptAssignmentIDs, assignment.GetID())
+				}
+			}
+			totalUsed += newUsed
+			if newUsed == record.GetUsedSlipDays() {
+				continue
+			}
+			change.Assignments = append(change.Assignments, &pb.UsedSlipDaysChange{
+				AssignmentID:    assignment.GetID(),
+				OldUsedSlipDays: record.GetUsedSlipDays(),
+				NewUsedSlipDays: newUsed,
+			})
+			updates = append(updates, &pb.UsedSlipDays{
+				ID:           record.GetID(),
+				EnrollmentID: enrollment.GetID(),
+				AssignmentID: assignment.GetID(),
+				UsedSlipDays: newUsed,
+			})
+		}
+		change.NewRemaining = int32(result.NewSlipDays) - int32(totalUsed)
+		if len(change.Assignments) > 0 || len(change.KeptAssignmentIDs) > 0 || change.NewRemaining != change.OldRemaining {
+			result.Changes = append(result.Changes, change)
+		}
+	}
+
+	if !request.GetApply() {
+		return result, nil
+	}
+	if err := db.Transaction(func(tx database.Database) error {
+		if request.GetChangeSlipDays() {
+			if err := tx.UpdateCourseSlipDays(courseID, result.NewSlipDays); err != nil {
+				return err
+			}
+		}
+		return tx.UpdateSlipDays(updates)
+	}); err != nil {
+		return nil, err
+	}
+	result.Applied = true
+	return result, nil
+}
+
+// replay returns the slip days used by the given enrollment for each assignment
+// with submission attempts that have build dates, and for each assignment
+// that does not use slip days.
+func replay(enrollment *pb.Enrollment, course *pb.Course, assignments []*pb.Assignment, attempts map[uint64][]*pb.SubmissionAttempt, extensions []*pb.DeadlineExtension) (map[uint64]uint32, error) {
+	replayed := &pb.Enrollment{
+		ID:       enrollment.GetID(),
+		CourseID: enrollment.GetCourseID(),
+		UserID:   enrollment.GetUserID(),
+		GroupID:  enrollment.GetGroupID(),
+		Course:   course,
+	}
+	used := make(map[uint64]uint32)
+	for _, assignment := range assignments {
+		if !assignment.UsesSlipDays(course) {
+			// late submissions of assignments with other late policies do not use slip days
+			used[assignment.GetID()] = 0
+			continue
+		}
+		if _, err := assignment.SinceDeadline(time.Now()); err != nil {
+			// slip days are not used for assignments without a valid deadline
+			continue
+		}
+		for _, submission := range assignment.GetSubmissions() {
+			if !belongsTo(submission, enrollment) {
+				continue
+			}
+			for _, attempt := range attempts[submission.GetID()] {
+				buildTime, err := time.Parse(pb.TimeLayout, attempt.GetCreatedDate())
+				if err != nil {
+					continue
+				}
+				used[assignment.GetID()] = 0
+				build := &pb.Submission{
+					AssignmentID: assignment.GetID(),
+					Score:        attempt.GetScore(),
+					Status:       statusAt(submission, attempt.GetCreatedDate()),
+				}
+				if err := replayed.UpdateSlipDays(buildTime, assignment, build, extensions...); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	for _, record := range replayed.GetUsedSlipDays() {
+		used[record.GetAssignmentID()] = record.GetUsedSlipDays()
+	}
+	return used, nil
+}
+
+// belongsTo returns true if the given submission is the enrollment's student's or group's submission.
+func belongsTo(submission *pb.Submission, enrollment *pb.Enrollment) bool {
+	if submission.GetGroupID() > 0 {
+		return submission.GetGroupID() == enrollment.GetGroupID()
+	}
+	return submission.GetUserID() == enrollment.GetUserID()
+}
+
+// statusAt returns the status of the given submission at the given build date.
+// A submission is only approved for builds on or after its approval date.
+func statusAt(submission *pb.Submission, buildDate string) pb.Submission_Status {
+	// dates in pb.TimeLayout compare in chronological order
+	if submission.IsApproved() && submission.GetApprovedDate() != "" && submission.GetApprovedDate() <= buildDate {
+		return pb.Submission_APPROVED
+	}
+	return pb.Submission_NONE
+}
+
+// usedSlipDays returns the enrollment's record of used slip days for the given assignment, or nil.
+func usedSlipDays(enrollment *pb.Enrollment, assignmentID uint64) *pb.UsedSlipDays {
+	for _, record := range enrollment.GetUsedSlipDays() {
+		if record.GetAssignmentID() == assignmentID {
+			return record
+		}
+	}
+	return nil
+}
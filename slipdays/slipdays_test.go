@@ -0,0 +1,208 @@
+package slipdays_test
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/slipdays"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"go.uber.org/zap"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestRecompute(t *testing.T) {
+	f, err := ioutil.TempFile(os.TempDir(), "testdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	defer os.Remove(f.Name())
+	db, err := database.NewGormDB(f.Name(), zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var users []*pb.User
+	for remoteID, login := range []string{"teacher", "alice", "bob", "carol"} {
+		user := &pb.User{Login: login}
+		if err := db.CreateUserFromRemoteIdentity(user, &pb.RemoteIdentity{Provider: "fake", RemoteID: uint64(remoteID + 1)}); err != nil {
+			t.Fatal(err)
+		}
+		users = append(users, user)
+	}
+	course := &pb.Course{SlipDays: 5}
+	if err := db.CreateCourse(users[0].ID, course); err != nil {
+		t.Fatal(err)
+	}
+	alice, bob, carol := users[1], users[2], users[3]
+	for _, student := range []*pb.User{alice, bob, carol} {
+		if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the deadline has been moved 52 hours back, so that the builds are now three days late
+	lab := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, ScoreLimit: 80, Deadline: time.Now().Add(-52 * time.Hour).Format(pb.TimeLayout)}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+	for _, submission := range []*pb.Submission{
+		{AssignmentID: lab.ID, UserID: alice.ID, Score: 50},
+		// bob's submission was approved before it was built
+		{AssignmentID: lab.ID, UserID: bob.ID, Score: 50, Status: pb.Submission_APPROVED, ApprovedDate: "2021-01-01T00:00:00"},
+		{AssignmentID: lab.ID, UserID: carol.ID, Score: 50},
+	} {
+		if err := db.CreateSubmission(submission); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// carol's deadline is extended
+	extension := &pb.DeadlineExtension{AssignmentID: lab.ID, UserID: carol.ID, Deadline: time.Now().Add(24 * time.Hour).Format(pb.TimeLayout)}
+	if err := db.CreateDeadlineExtension(extension); err != nil {
+		t.Fatal(err)
+	}
+	// the slip days recorded for the old deadline
+	for student, used := range map[*pb.User]uint32{alice: 1, bob: 2} {
+		enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, student.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateSlipDays([]*pb.UsedSlipDays{{EnrollmentID: enrollment.ID, AssignmentID: lab.ID, UsedSlipDays: used}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	enrollmentIDs := make(map[uint64]uint64)
+	for _, student := range []*pb.User{alice, bob, carol} {
+		enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, student.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		enrollmentIDs[student.ID] = enrollment.ID
+	}
+	change := func(student *pb.User, oldRemaining, newRemaining int32, assignments ...*pb.UsedSlipDaysChange) *pb.SlipDaysChange {
+		return &pb.SlipDaysChange{
+			EnrollmentID: enrollmentIDs[student.ID],
+			UserID:       student.ID,
+			Login:        student.Login,
+			OldRemaining: oldRemaining,
+			NewRemaining: newRemaining,
+			Assignments:  assignments,
+		}
+	}
+	ignore := cmpopts.IgnoreUnexported(pb.SlipDaysRecomputation{}, pb.SlipDaysChange{}, pb.UsedSlipDaysChange{})
+
+	// a dry run reports the changes without saving them
+	got, err := slipdays.Recompute(db, &pb.SlipDaysRequest{CourseID: course.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &pb.SlipDaysRecomputation{
+		OldSlipDays: 5,
+		NewSlipDays: 5,
+		Changes: []*pb.SlipDaysChange{
+			change(alice, 4, 2, &pb.UsedSlipDaysChange{AssignmentID: lab.ID, OldUsedSlipDays: 1, NewUsedSlipDays: 3}),
+			change(bob, 3, 5, &pb.UsedSlipDaysChange{AssignmentID: lab.ID, OldUsedSlipDays: 2, NewUsedSlipDays: 0}),
+		},
+	}
+	if diff := cmp.Diff(want, got, ignore); diff != "" {
+		t.Errorf("Recompute() dry run mismatch (-want +got):\n%s", diff)
+	}
+	enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, alice.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if remaining := enrollment.RemainingSlipDays(course); remaining != 4 {
+		t.Errorf("dry run changed remaining slip days of %s to %d, want 4", alice.Login, remaining)
+	}
+
+	// applying the changes with more slip days for the course
+	got, err = slipdays.Recompute(db, &pb.SlipDaysRequest{CourseID: course.ID, ChangeSlipDays: true, SlipDays: 7, Apply: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = &pb.SlipDaysRecomputation{
+		OldSlipDays: 5,
+		NewSlipDays: 7,
+		Changes: []*pb.SlipDaysChange{
+			change(alice, 4, 4, &pb.UsedSlipDaysChange{AssignmentID: lab.ID, OldUsedSlipDays: 1, NewUsedSlipDays: 3}),
+			change(bob, 3, 7, &pb.UsedSlipDaysChange{AssignmentID: lab.ID, OldUsedSlipDays: 2, NewUsedSlipDays: 0}),
+			change(carol, 5, 7),
+		},
+		Applied: true,
+	}
+	if diff := cmp.Diff(want, got, ignore); diff != "" {
+		t.Errorf("Recompute() mismatch (-want +got):\n%s", diff)
+	}
+	course, err = db.GetCourse(course.ID, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for student, wantRemaining := range map[*pb.User]int32{alice: 4, bob: 7, carol: 7} {
+		enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, student.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if remaining := enrollment.RemainingSlipDays(course); remaining != wantRemaining {
+			t.Errorf("%s has %d remaining slip days, want %d", student.Login, remaining, wantRemaining)
+		}
+	}
+
+	// recomputing again changes nothing
+	got, err = slipdays.Recompute(db, &pb.SlipDaysRequest{CourseID: course.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.GetChanges()) != 0 {
+		t.Errorf("Recompute() after applying = %v, want no changes", got.GetChanges())
+	}
+
+	// slip days recorded for an assignment with a late penalty policy are not used
+	lab2 := &pb.Assignment{CourseID: course.ID, Name: "lab2", Order: 2, ScoreLimit: 80, Deadline: lab.Deadline, LatePolicy: pb.Course_HARD_CUTOFF}
+	if err := db.CreateAssignment(lab2); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateSlipDays([]*pb.UsedSlipDays{{EnrollmentID: enrollmentIDs[alice.ID], AssignmentID: lab2.ID, UsedSlipDays: 2}}); err != nil {
+		t.Fatal(err)
+	}
+	// slip days recorded for builds without build dates are kept and reported
+	lab3 := &pb.Assignment{CourseID: course.ID, Name: "lab3", Order: 3, ScoreLimit: 80, Deadline: lab.Deadline}
+	if err := db.CreateAssignment(lab3); err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{AssignmentID: lab3.ID, UserID: alice.ID, Score: 50}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+	conn, err := gorm.Open(sqlite.Open(f.Name()), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := conn.Model(&pb.SubmissionAttempt{ID: submission.GradedAttemptID}).Update("created_date", "").Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateSlipDays([]*pb.UsedSlipDays{{EnrollmentID: enrollmentIDs[alice.ID], AssignmentID: lab3.ID, UsedSlipDays: 1}}); err != nil {
+		t.Fatal(err)
+	}
+	got, err = slipdays.Recompute(db, &pb.SlipDaysRequest{CourseID: course.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	aliceChange := change(alice, 1, 3, &pb.UsedSlipDaysChange{AssignmentID: lab2.ID, OldUsedSlipDays: 2, NewUsedSlipDays: 0})
+	aliceChange.KeptAssignmentIDs = []uint64{lab3.ID}
+	want = &pb.SlipDaysRecomputation{
+		OldSlipDays: 7,
+		NewSlipDays: 7,
+		Changes:     []*pb.SlipDaysChange{aliceChange},
+	}
+	if diff := cmp.Diff(want, got, ignore); diff != "" {
+		t.Errorf("Recompute() with late penalty policy and kept slip days mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -166,11 +166,18 @@ var teacherScopes = map[string]bool{
 	"admin:org_hook": true,
 }
 
+// gitlabTeacherScope is the GitLab scope that grants full API access,
+// and is therefore sufficient for a teacher token to be valid.
+const gitlabTeacherScope = "api"
+
 // hasTeacherScopes checks whether current user has upgraded scopes on provided scm client.
 func hasTeacherScopes(ctx context.Context, sc scm.SCM) bool {
 	authorization := sc.GetUserScopes(ctx)
 	scopesFound := 0
 	for _, scope := range authorization.Scopes {
+		if scope == gitlabTeacherScope {
+			return true
+		}
 		if teacherScopes[scope] {
 			scopesFound++
 		}
@@ -9,6 +9,7 @@ import (
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/assignments"
+	"github.com/autograde/quickfeed/database"
 	"github.com/autograde/quickfeed/scm"
 )
 
@@ -104,23 +105,32 @@ func (s *AutograderService) loadCriteria(ctx context.Context, sc scm.SCM, reques
 		return nil, err
 	}
 
-	if len(assignment.GradingBenchmarks) > 0 {
-		if err := s.removeOldCriteriaAndReviews(assignment); err != nil {
-			return nil, err
-		}
-	}
-
-	for _, bm := range benchmarks {
-		bm.AssignmentID = assignment.ID
-		if err := s.db.CreateBenchmark(bm); err != nil {
-			return nil, err
+	// replace the old criteria with the new ones, or keep the old ones if anything fails
+	if err := s.db.Transaction(func(tx database.Database) error {
+		if len(assignment.GradingBenchmarks) > 0 {
+			if err := removeOldCriteriaAndReviews(tx, assignment); err != nil {
+				return err
+			}
 		}
-		for _, c := range bm.Criteria {
-			c.BenchmarkID = bm.ID
-			if err := s.db.CreateCriterion(c); err != nil {
-				return nil, err
+		for _, bm := range benchmarks {
+			bm.AssignmentID = assignment.ID
+			// create the benchmark without its criteria, which are created below
+			criteria := bm.Criteria
+			bm.Criteria = nil
+			if err := tx.CreateBenchmark(bm); err != nil {
+				return err
+			}
+			bm.Criteria = criteria
+			for _, c := range bm.Criteria {
+				c.BenchmarkID = bm.ID
+				if err := tx.CreateCriterion(c); err != nil {
+					return err
+				}
 			}
 		}
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
 	return benchmarks, nil
@@ -146,31 +156,36 @@ func (s *AutograderService) createReview(query *pb.Review) (*pb.Review, error) {
 	return query, nil
 }
 
-func (s *AutograderService) updateReview(query *pb.Review) error {
+func (s *AutograderService) updateReview(courseID uint64, query *pb.Review) error {
 	if query.ID == 0 {
 		return fmt.Errorf("Cannot update review with empty ID")
 	}
 	query.Edited = time.Now().Format("02 Jan 15:04")
-	return s.db.UpdateReview(query)
+	if err := s.db.UpdateReview(query); err != nil {
+		return err
+	}
+	s.publishReviewedSubmission(courseID, query)
+	return nil
 }
 
-func (s *AutograderService) removeOldCriteriaAndReviews(assignment *pb.Assignment) error {
+// removeOldCriteriaAndReviews removes the assignment's grading criteria and the reviews of its submissions.
+func removeOldCriteriaAndReviews(db database.Database, assignment *pb.Assignment) error {
 	for _, bm := range assignment.GradingBenchmarks {
 		for _, c := range bm.Criteria {
-			if err := s.db.DeleteCriterion(c); err != nil {
-				fmt.Printf("Failed to delete criteria %v: %s\n", c, err)
+			if err := db.DeleteCriterion(c); err != nil {
+				return fmt.Errorf("failed to delete criterion %d: %w", c.GetID(), err)
 			}
 		}
-		if err := s.db.DeleteBenchmark(bm); err != nil {
-			fmt.Printf("Failed to delete benchmark %v: %s\n", bm, err)
+		if err := db.DeleteBenchmark(bm); err != nil {
+			return fmt.Errorf("failed to delete benchmark %d: %w", bm.GetID(), err)
 		}
 	}
-	submissions, err := s.db.GetSubmissions(&pb.Submission{AssignmentID: assignment.GetID()})
+	submissions, err := db.GetSubmissions(&pb.Submission{AssignmentID: assignment.GetID()})
 	if err != nil {
 		return err
 	}
 	for _, submission := range submissions {
-		if err := s.db.DeleteReview(&pb.Review{SubmissionID: submission.ID}); err != nil {
+		if err := db.DeleteReview(&pb.Review{SubmissionID: submission.ID}); err != nil {
 			return err
 		}
 	}
@@ -189,3 +204,28 @@ func (s *AutograderService) getAssignmentWithCourse(query *pb.Assignment, withCo
 	}
 	return assignment, course, nil
 }
+
+// getCourseAssignment returns the given assignment, provided that it belongs to the given course.
+func (s *AutograderService) getCourseAssignment(courseID, assignmentID uint64) (*pb.Assignment, error) {
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: assignmentID})
+	if err != nil {
+		return nil, err
+	}
+	if assignment.GetCourseID() != courseID {
+		return nil, fmt.Errorf("assignment %d does not belong to course %d", assignmentID, courseID)
+	}
+	return assignment, nil
+}
+
+// getTestStatistics returns the test case statistics of the requested assignment.
+func (s *AutograderService) getTestStatistics(request *pb.TestStatisticsRequest) (*pb.TestStatistics, error) {
+	assignment, err := s.getCourseAssignment(request.GetCourseID(), request.GetAssignmentID())
+	if err != nil {
+		return nil, err
+	}
+	tests, err := s.db.GetTestStatistics(assignment.GetID(), request.GetAllAttempts())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TestStatistics{Tests: tests}, nil
+}
@@ -0,0 +1,154 @@
+package web_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/autograde/quickfeed/web"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// failingDB is a database that fails to create the n'th grading criterion,
+// counting criteria created both in and outside of transactions.
+type failingDB struct {
+	database.Database
+	failAt *int
+}
+
+var errInjected = errors.New("injected failure")
+
+func (db failingDB) Transaction(fn func(tx database.Database) error) error {
+	return db.Database.Transaction(func(tx database.Database) error {
+		return fn(failingDB{Database: tx, failAt: db.failAt})
+	})
+}
+
+func (db failingDB) CreateCriterion(criterion *pb.GradingCriterion) error {
+	if *db.failAt--; *db.failAt == 0 {
+		return errInjected
+	}
+	return db.Database.CreateCriterion(criterion)
+}
+
+func TestLoadCriteriaRollback(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	fakeGothProvider()
+	admin := createFakeUser(t, db, 1)
+	ctx := withUserContext(context.Background(), admin)
+	fakeProvider, scms := fakeProviderMap(t)
+	org, err := fakeProvider.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	course := &pb.Course{Provider: "fake", OrganizationID: org.GetID(), OrganizationPath: org.GetPath()}
+	if err := db.CreateCourse(admin.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	lab1 := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(lab1); err != nil {
+		t.Fatal(err)
+	}
+	repo, err := fakeProvider.(*scm.FakeSCM).SeedTestsRepo(ctx, org, lab1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	commitCriteria := func(criteria string) {
+		t.Helper()
+		if err := fakeProvider.(*scm.FakeSCM).CommitFiles(ctx, &scm.RepositoryOptions{ID: repo.ID}, map[string]string{
+			"lab1/criteria.json": criteria,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	failAt := 0
+	ags := web.NewAutograderService(zap.NewNop(), failingDB{Database: db, failAt: &failAt}, scms, web.BaseHookOptions{}, newLocalQueue(db))
+	request := &pb.LoadCriteriaRequest{CourseID: course.ID, AssignmentID: lab1.ID}
+
+	commitCriteria(`[{"heading": "Old", "criteria": [{"description": "old"}]}]`)
+	if _, err := ags.LoadCriteria(ctx, request); err != nil {
+		t.Fatal(err)
+	}
+
+	// replace the old criteria, but fail on the second new criterion
+	commitCriteria(`[{"heading": "New", "criteria": [{"description": "new1"}, {"description": "new2"}]}]`)
+	failAt = 2
+	if _, err := ags.LoadCriteria(ctx, request); err == nil {
+		t.Fatal("expected LoadCriteria to fail")
+	}
+
+	assignments, err := db.GetAssignmentsByCourse(course.ID, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	benchmarks := assignments[0].GetGradingBenchmarks()
+	if len(benchmarks) != 1 || benchmarks[0].GetHeading() != "Old" {
+		t.Fatalf("got benchmarks %v, want only the old benchmark", benchmarks)
+	}
+	if criteria := benchmarks[0].GetCriteria(); len(criteria) != 1 || criteria[0].GetDescription() != "old" {
+		t.Errorf("got criteria %v, want only the old criterion", criteria)
+	}
+}
+
+func TestGetTestStatistics(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := &pb.Course{Provider: "fake", OrganizationID: 1}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	otherCourse := &pb.Course{Provider: "fake", OrganizationID: 2}
+	if err := db.CreateCourse(teacher.ID, otherCourse); err != nil {
+		t.Fatal(err)
+	}
+	lab := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	otherLab := &pb.Assignment{CourseID: otherCourse.ID, Name: "lab1", Order: 1}
+	for _, assignment := range []*pb.Assignment{lab, otherLab} {
+		if err := db.CreateAssignment(assignment); err != nil {
+			t.Fatal(err)
+		}
+	}
+	student := createFakeUser(t, db, 2)
+	enrollStudent(t, db, student, course)
+	submission := &pb.Submission{AssignmentID: lab.ID, UserID: student.ID, TestResults: []*pb.TestResult{
+		{TestName: "TestFib", Score: 0, MaxScore: 10, Weight: 1, Duration: 30, FailureOutput: "Fib(3) = 1, want 2"},
+	}}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := fakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
+	request := &pb.TestStatisticsRequest{CourseID: course.ID, AssignmentID: lab.ID}
+	if _, err := ags.GetTestStatistics(withUserContext(context.Background(), student), request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetTestStatistics() as student: have error %v, want %v", err, codes.PermissionDenied)
+	}
+
+	ctx := withUserContext(context.Background(), teacher)
+	statistics, err := ags.GetTestStatistics(ctx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*pb.TestCaseStatistics{{TestName: "TestFib", Runs: 1, Failures: 1, AverageScore: 0, AverageDuration: 30}}
+	if diff := cmp.Diff(want, statistics.GetTests(), cmpopts.IgnoreUnexported(pb.TestCaseStatistics{})); diff != "" {
+		t.Errorf("GetTestStatistics() mismatch (-want +got):\n%s", diff)
+	}
+
+	// the assignment must belong to the requested course
+	request = &pb.TestStatisticsRequest{CourseID: course.ID, AssignmentID: otherLab.ID}
+	if _, err := ags.GetTestStatistics(ctx, request); status.Code(err) != codes.NotFound {
+		t.Errorf("GetTestStatistics() of another course's assignment: have error %v, want %v", err, codes.NotFound)
+	}
+}
@@ -19,21 +19,21 @@ import (
 // other shared data structures.
 type AutograderService struct {
 	logger *zap.SugaredLogger
-	db     *database.GormDB
+	db     database.Database
 	scms   *auth.Scms
 	bh     BaseHookOptions
-	runner ci.Runner
+	queue  *ci.Queue
 	pb.UnimplementedAutograderServiceServer
 }
 
 // NewAutograderService returns an AutograderService object.
-func NewAutograderService(logger *zap.Logger, db *database.GormDB, scms *auth.Scms, bh BaseHookOptions, runner ci.Runner) *AutograderService {
+func NewAutograderService(logger *zap.Logger, db database.Database, scms *auth.Scms, bh BaseHookOptions, queue *ci.Queue) *AutograderService {
 	return &AutograderService{
 		logger: logger.Sugar(),
 		db:     db,
 		scms:   scms,
 		bh:     bh,
-		runner: runner,
+		queue:  queue,
 	}
 }
 
@@ -525,6 +525,7 @@ func (s *AutograderService) GetSubmissions(ctx context.Context, in *pb.Submissio
 		s.logger.Errorf("GetSubmissions failed: %v", err)
 		return nil, status.Error(codes.NotFound, "no submissions found")
 	}
+	s.withholdHiddenResults(usr.GetID(), in.GetCourseID(), submissions.GetSubmissions())
 	return submissions, nil
 }
 
@@ -551,6 +552,75 @@ func (s *AutograderService) GetSubmissionsByCourse(ctx context.Context, in *pb.S
 	return courseLinks, nil
 }
 
+// GetTestStatistics returns the number of runs and failures, the average score and the average
+// duration of each test case of the given assignment, ordered by decreasing number of failures.
+// Access policy: Teacher of CourseID
+func (s *AutograderService) GetTestStatistics(ctx context.Context, in *pb.TestStatisticsRequest) (*pb.TestStatistics, error) {
+	if !in.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "invalid payload")
+	}
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetTestStatistics failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		s.logger.Error("GetTestStatistics failed: user is not teacher")
+		return nil, status.Error(codes.PermissionDenied, "only teachers can get test statistics")
+	}
+	statistics, err := s.getTestStatistics(in)
+	if err != nil {
+		s.logger.Errorf("GetTestStatistics failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get test statistics")
+	}
+	return statistics, nil
+}
+
+// GetCourseStatistics returns the score distributions, approval rates, median time to approval,
+// used slip days and push activity of the course assignments, and the number of active students.
+// Access policy: Teacher of CourseID
+func (s *AutograderService) GetCourseStatistics(ctx context.Context, in *pb.CourseRequest) (*pb.CourseStatistics, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetCourseStatistics failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		s.logger.Error("GetCourseStatistics failed: user is not teacher")
+		return nil, status.Error(codes.PermissionDenied, "only teachers can get course statistics")
+	}
+	statistics, err := s.getCourseStatistics(in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("GetCourseStatistics failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get course statistics")
+	}
+	return statistics, nil
+}
+
+// ExportGradebook returns the gradebook of the given course in the requested format,
+// with one row per student, or one row per group with only the group assignments.
+// Access policy: Teacher of CourseID
+func (s *AutograderService) ExportGradebook(ctx context.Context, in *pb.GradebookRequest) (*pb.GradebookFile, error) {
+	if !in.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "invalid payload")
+	}
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("ExportGradebook failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		s.logger.Error("ExportGradebook failed: user is not teacher")
+		return nil, status.Error(codes.PermissionDenied, "only teachers can export the gradebook")
+	}
+	file, err := s.exportGradebook(in)
+	if err != nil {
+		s.logger.Errorf("ExportGradebook failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to export gradebook")
+	}
+	return file, nil
+}
+
 // UpdateSubmission is called to approve the given submission or to undo approval.
 // Access policy: Teacher of CourseID.
 func (s *AutograderService) UpdateSubmission(ctx context.Context, in *pb.UpdateSubmissionRequest) (*pb.Void, error) {
@@ -575,8 +645,14 @@ func (s *AutograderService) UpdateSubmission(ctx context.Context, in *pb.UpdateS
 	return &pb.Void{}, err
 }
 
-// RebuildSubmission rebuilds the submission with the given ID
+// RebuildSubmission rebuilds the submission with the given ID.
+// The results of hidden tests are withheld from students until the submission is released.
 func (s *AutograderService) RebuildSubmission(ctx context.Context, in *pb.RebuildRequest) (*pb.Submission, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("RebuildSubmission failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
 	if !s.isValidSubmission(in.GetSubmissionID()) {
 		s.logger.Errorf("ApproveSubmission failed: submitter has no access to the course")
 		return nil, status.Error(codes.PermissionDenied, "submitter has no course access")
@@ -585,9 +661,354 @@ func (s *AutograderService) RebuildSubmission(ctx context.Context, in *pb.Rebuil
 	if err != nil {
 		return nil, err
 	}
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: submission.GetAssignmentID()})
+	if err != nil {
+		s.logger.Errorf("RebuildSubmission failed: %v", err)
+		return nil, status.Error(codes.NotFound, "assignment not found")
+	}
+	s.withholdHiddenResults(usr.GetID(), assignment.GetCourseID(), []*pb.Submission{submission})
 	return submission, nil
 }
 
+// GetSubmissionAttempts returns the attempts of the given submission in the order they were
+// recorded, without their build logs. The results of hidden tests are withheld from students
+// until the submission is released.
+// Access policy: Teacher of CourseID, or Student of CourseID owning the submission or belonging to its group.
+func (s *AutograderService) GetSubmissionAttempts(ctx context.Context, in *pb.SubmissionAttemptRequest) (*pb.SubmissionAttempts, error) {
+	if !in.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "invalid payload")
+	}
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetSubmissionAttempts failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	submission, err := s.getCourseSubmission(usr.GetID(), in.GetCourseID(), in.GetSubmissionID())
+	if err != nil {
+		s.logger.Errorf("GetSubmissionAttempts failed: %v", err)
+		return nil, status.Error(codes.NotFound, "submission not found")
+	}
+	attempts, err := s.db.GetSubmissionAttempts(in.GetSubmissionID())
+	if err != nil {
+		s.logger.Errorf("GetSubmissionAttempts failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get submission attempts")
+	}
+	if s.withholdsHiddenResults(usr.GetID(), in.GetCourseID(), submission) {
+		for _, attempt := range attempts {
+			attempt.RemoveHiddenResults()
+		}
+	}
+	return &pb.SubmissionAttempts{Attempts: attempts}, nil
+}
+
+// GetSubmissionAttempt returns the given attempt of the given submission, including its build log.
+// The results of hidden tests are withheld from students until the submission is released.
+// Access policy: Teacher of CourseID, or Student of CourseID owning the submission or belonging to its group.
+func (s *AutograderService) GetSubmissionAttempt(ctx context.Context, in *pb.SubmissionAttemptRequest) (*pb.SubmissionAttempt, error) {
+	if !in.IsValid() || in.GetAttemptID() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid payload")
+	}
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetSubmissionAttempt failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	submission, err := s.getCourseSubmission(usr.GetID(), in.GetCourseID(), in.GetSubmissionID())
+	if err != nil {
+		s.logger.Errorf("GetSubmissionAttempt failed: %v", err)
+		return nil, status.Error(codes.NotFound, "submission not found")
+	}
+	attempt, err := s.db.GetSubmissionAttempt(&pb.SubmissionAttempt{ID: in.GetAttemptID(), SubmissionID: in.GetSubmissionID()})
+	if err != nil {
+		s.logger.Errorf("GetSubmissionAttempt failed: %v", err)
+		return nil, status.Error(codes.NotFound, "submission attempt not found")
+	}
+	if s.withholdsHiddenResults(usr.GetID(), in.GetCourseID(), submission) {
+		attempt.RemoveHiddenResults()
+	}
+	return attempt, nil
+}
+
+// UpdateGradedAttempt makes the given attempt the graded attempt of its submission,
+// replacing the submission's score and build results with those of the attempt.
+// The submission's approval status and reviews are kept.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) UpdateGradedAttempt(ctx context.Context, in *pb.SubmissionAttemptRequest) (*pb.Submission, error) {
+	if !in.IsValid() || in.GetAttemptID() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid payload")
+	}
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("UpdateGradedAttempt failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		s.logger.Error("UpdateGradedAttempt failed: user is not teacher")
+		return nil, status.Error(codes.PermissionDenied, "only teachers can update the graded attempt")
+	}
+	if _, err := s.getCourseSubmission(usr.GetID(), in.GetCourseID(), in.GetSubmissionID()); err != nil {
+		s.logger.Errorf("UpdateGradedAttempt failed: %v", err)
+		return nil, status.Error(codes.NotFound, "submission not found")
+	}
+	submission, err := s.updateGradedAttempt(in)
+	if err != nil {
+		s.logger.Errorf("UpdateGradedAttempt failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to update graded attempt")
+	}
+	return submission, nil
+}
+
+// SubmissionStream streams the course submissions as they are created or updated,
+// until the client cancels the stream. Teachers receive all course submissions, while
+// students receive their own submissions and the submissions of their group, without
+// the results of hidden tests until the submissions are released.
+// If the client does not keep up with the updates, the stream is closed with
+// code ResourceExhausted, and the client should reconnect and refetch the submissions.
+// Access policy: Student or Teacher of CourseID
+func (s *AutograderService) SubmissionStream(in *pb.CourseRequest, stream pb.AutograderService_SubmissionStreamServer) error {
+	if !in.IsValid() {
+		return status.Error(codes.InvalidArgument, "invalid payload")
+	}
+	ctx := stream.Context()
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("SubmissionStream failed: authentication error: %v", err)
+		return ErrInvalidUserInfo
+	}
+	filter, err := s.submissionFilter(usr.GetID(), in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("SubmissionStream failed: user %d has no access to course %d: %v", usr.GetID(), in.GetCourseID(), err)
+		return status.Error(codes.PermissionDenied, "only course students and teachers can stream submissions")
+	}
+	teacher := s.isTeacher(usr.GetID(), in.GetCourseID())
+	sub := s.queue.Submissions().Subscribe(filter)
+	defer sub.Close()
+	for {
+		select {
+		case event, ok := <-sub.Events():
+			if !ok {
+				s.logger.Errorf("SubmissionStream failed for user %d: %v", usr.GetID(), sub.Err())
+				return status.Error(codes.ResourceExhausted, "too many submission updates; please reconnect")
+			}
+			if err := stream.Send(visibleSubmission(event.Submission, teacher)); err != nil {
+				s.logger.Debugf("SubmissionStream: failed to send submission to user %d: %v", usr.GetID(), err)
+				return err
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// GetBuildJobs returns the queued and running build jobs for the given course.
+// Access policy: Teacher of CourseID
+func (s *AutograderService) GetBuildJobs(ctx context.Context, in *pb.CourseRequest) (*pb.BuildJobs, error) {
+	if !in.IsValid() {
+		return nil, ErrInvalidUserInfo
+	}
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetBuildJobs failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		s.logger.Error("GetBuildJobs failed: user is not teacher")
+		return nil, status.Error(codes.PermissionDenied, "only teachers can access the build queue")
+	}
+	jobs, err := s.queue.Jobs(in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("GetBuildJobs failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get build jobs")
+	}
+	return &pb.BuildJobs{Jobs: jobs}, nil
+}
+
+// CancelBuildJob cancels a queued or running build job.
+// Access policy: Teacher of CourseID
+func (s *AutograderService) CancelBuildJob(ctx context.Context, in *pb.BuildJobRequest) (*pb.Void, error) {
+	if !in.IsValid() {
+		return nil, ErrInvalidUserInfo
+	}
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("CancelBuildJob failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		s.logger.Error("CancelBuildJob failed: user is not teacher")
+		return nil, status.Error(codes.PermissionDenied, "only teachers can cancel build jobs")
+	}
+	if err := s.cancelBuildJob(in.GetCourseID(), in.GetJobID()); err != nil {
+		s.logger.Errorf("CancelBuildJob failed: %v", err)
+		return nil, status.Error(codes.FailedPrecondition, "failed to cancel build job")
+	}
+	return &pb.Void{}, nil
+}
+
+// BuildLogStream streams the output of the queued and running builds of the given commit,
+// until the builds end or the client cancels the stream. Score lines are never streamed.
+// If the client does not keep up with the output, the stream is closed with code ResourceExhausted.
+// Access policy: Teacher of CourseID, Student owning the repository of the build
+func (s *AutograderService) BuildLogStream(in *pb.BuildLogRequest, stream pb.AutograderService_BuildLogStreamServer) error {
+	if !in.IsValid() {
+		return status.Error(codes.InvalidArgument, "invalid payload")
+	}
+	ctx := stream.Context()
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("BuildLogStream failed: authentication error: %v", err)
+		return ErrInvalidUserInfo
+	}
+	jobs, err := s.buildLogJobs(usr.GetID(), in.GetCourseID(), in.GetCommitID())
+	if err != nil {
+		s.logger.Errorf("BuildLogStream failed: user %d has no access to course %d: %v", usr.GetID(), in.GetCourseID(), err)
+		return status.Error(codes.PermissionDenied, "only course students and teachers can stream build logs")
+	}
+	if len(jobs) == 0 {
+		return status.Error(codes.NotFound, "no queued or running builds for commit")
+	}
+	jobIDs := make([]uint64, len(jobs))
+	for i, job := range jobs {
+		jobIDs[i] = job.GetID()
+	}
+	sub := s.queue.BuildLogs().Subscribe(jobIDs...)
+	defer sub.Close()
+
+	// jobs that ended before subscribing may never publish their end; ignore those
+	assignments := make(map[uint64]uint64) // job ID -> assignment ID
+	for _, job := range jobs {
+		current, err := s.db.GetBuildJob(job.GetID())
+		if err != nil {
+			continue
+		}
+		if current.GetStatus() == pb.BuildJob_QUEUED || current.GetStatus() == pb.BuildJob_RUNNING {
+			assignments[job.GetID()] = job.GetAssignmentID()
+		}
+	}
+	for len(assignments) > 0 {
+		select {
+		case event, ok := <-sub.Events():
+			if !ok {
+				s.logger.Errorf("BuildLogStream failed for user %d: %v", usr.GetID(), sub.Err())
+				return status.Error(codes.ResourceExhausted, "too much build output; please reconnect")
+			}
+			assignmentID, ok := assignments[event.JobID]
+			if !ok {
+				continue
+			}
+			if event.Done {
+				delete(assignments, event.JobID)
+				continue
+			}
+			if err := stream.Send(&pb.BuildLogLine{JobID: event.JobID, AssignmentID: assignmentID, Line: event.Line}); err != nil {
+				s.logger.Debugf("BuildLogStream: failed to send build output to user %d: %v", usr.GetID(), err)
+				return err
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+	return nil
+}
+
+// GetBuildQuotas returns the build quotas of the repositories with recent builds of pushes for the given assignment.
+// Access policy: Teacher of CourseID
+func (s *AutograderService) GetBuildQuotas(ctx context.Context, in *pb.BuildQuotaRequest) (*pb.BuildQuotas, error) {
+	if !in.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "invalid payload")
+	}
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetBuildQuotas failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		s.logger.Error("GetBuildQuotas failed: user is not teacher")
+		return nil, status.Error(codes.PermissionDenied, "only teachers can access build quotas")
+	}
+	quotas, err := s.getBuildQuotas(in.GetCourseID(), in.GetAssignmentID())
+	if err != nil {
+		s.logger.Errorf("GetBuildQuotas failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get build quotas")
+	}
+	return &pb.BuildQuotas{Quotas: quotas}, nil
+}
+
+// ResetBuildQuota resets the build quota of the given repository for the given assignment,
+// so that builds started before the reset are not counted. A delayed build is started right away.
+// Access policy: Teacher of CourseID
+func (s *AutograderService) ResetBuildQuota(ctx context.Context, in *pb.BuildQuotaRequest) (*pb.Void, error) {
+	if !in.IsValid() || in.GetRepositoryID() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid payload")
+	}
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("ResetBuildQuota failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		s.logger.Error("ResetBuildQuota failed: user is not teacher")
+		return nil, status.Error(codes.PermissionDenied, "only teachers can reset build quotas")
+	}
+	if err := s.resetBuildQuota(in.GetCourseID(), in.GetAssignmentID(), in.GetRepositoryID()); err != nil {
+		s.logger.Errorf("ResetBuildQuota failed: %v", err)
+		return nil, status.Error(codes.FailedPrecondition, "failed to reset build quota")
+	}
+	return &pb.Void{}, nil
+}
+
+// CheckSimilarity compares the code of the latest submissions for the given assignment,
+// excluding the assignment's template code, and stores and returns the similar pairs,
+// most similar first.
+// Access policy: Teacher of CourseID
+func (s *AutograderService) CheckSimilarity(ctx context.Context, in *pb.SimilarityRequest) (*pb.SimilarityPairs, error) {
+	if !in.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "invalid payload")
+	}
+	usr, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("CheckSimilarity failed: scm authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		s.logger.Error("CheckSimilarity failed: user is not teacher")
+		return nil, status.Error(codes.PermissionDenied, "only teachers can check submission similarity")
+	}
+	pairs, err := s.checkSimilarity(ctx, scm, in)
+	if err != nil {
+		s.logger.Errorf("CheckSimilarity failed: %v", err)
+		if contextCanceled(ctx) {
+			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
+		}
+		return nil, status.Error(codes.InvalidArgument, "failed to check submission similarity")
+	}
+	return pairs, nil
+}
+
+// GetSimilarityPairs returns the similar pairs of submissions found by the last
+// similarity check of the given assignment, most similar first.
+// Access policy: Teacher of CourseID
+func (s *AutograderService) GetSimilarityPairs(ctx context.Context, in *pb.SimilarityRequest) (*pb.SimilarityPairs, error) {
+	if !in.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "invalid payload")
+	}
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetSimilarityPairs failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		s.logger.Error("GetSimilarityPairs failed: user is not teacher")
+		return nil, status.Error(codes.PermissionDenied, "only teachers can access submission similarity")
+	}
+	pairs, err := s.getSimilarityPairs(in)
+	if err != nil {
+		s.logger.Errorf("GetSimilarityPairs failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get submission similarity")
+	}
+	return pairs, nil
+}
+
 // CreateBenchmark adds a new grading benchmark for an assignment
 // Access policy: Teacher of CourseID
 func (s *AutograderService) CreateBenchmark(ctx context.Context, in *pb.GradingBenchmark) (*pb.GradingBenchmark, error) {
@@ -721,7 +1142,7 @@ func (s *AutograderService) UpdateReview(ctx context.Context, in *pb.ReviewReque
 	if err := in.Review.MarshalReviewString(); err != nil {
 		return nil, status.Error(codes.InvalidArgument, "failed to create review: parsing error")
 	}
-	if err = s.updateReview(in.Review); err != nil {
+	if err = s.updateReview(in.GetCourseID(), in.Review); err != nil {
 		s.logger.Errorf("UpdateReview failed for review %+v: %v", in, err)
 		err = status.Error(codes.InvalidArgument, "failed to update review")
 	}
@@ -809,6 +1230,97 @@ func (s *AutograderService) UpdateAssignments(ctx context.Context, in *pb.Course
 	return &pb.Void{}, nil
 }
 
+// GetDeadlineExtensions returns the deadline extensions of the course assignments.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) GetDeadlineExtensions(ctx context.Context, in *pb.CourseRequest) (*pb.DeadlineExtensions, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetDeadlineExtensions failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		s.logger.Error("GetDeadlineExtensions failed: user is not teacher")
+		return nil, status.Error(codes.PermissionDenied, "only teachers can get deadline extensions")
+	}
+	extensions, err := s.getDeadlineExtensions(in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("GetDeadlineExtensions failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get deadline extensions")
+	}
+	return extensions, nil
+}
+
+// GrantDeadlineExtension grants a student or a group an extended deadline for an assignment,
+// replacing any previous extension of the assignment's deadline for the student or group.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) GrantDeadlineExtension(ctx context.Context, in *pb.DeadlineExtensionRequest) (*pb.DeadlineExtension, error) {
+	if !in.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "invalid payload")
+	}
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GrantDeadlineExtension failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		s.logger.Error("GrantDeadlineExtension failed: user is not teacher")
+		return nil, status.Error(codes.PermissionDenied, "only teachers can grant deadline extensions")
+	}
+	extension, err := s.grantDeadlineExtension(in)
+	if err != nil {
+		s.logger.Errorf("GrantDeadlineExtension failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to grant deadline extension")
+	}
+	return extension, nil
+}
+
+// RevokeDeadlineExtension removes the given deadline extension.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) RevokeDeadlineExtension(ctx context.Context, in *pb.DeadlineExtensionRequest) (*pb.Void, error) {
+	if !in.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "invalid payload")
+	}
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("RevokeDeadlineExtension failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		s.logger.Error("RevokeDeadlineExtension failed: user is not teacher")
+		return nil, status.Error(codes.PermissionDenied, "only teachers can revoke deadline extensions")
+	}
+	if err := s.db.DeleteDeadlineExtension(in.GetCourseID(), in.GetExtension().GetID()); err != nil {
+		s.logger.Errorf("RevokeDeadlineExtension failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to revoke deadline extension")
+	}
+	return &pb.Void{}, nil
+}
+
+// RecomputeSlipDays recomputes the slip days used by the course students from the build dates
+// of their submission attempts and the current deadlines, deadline extensions and grace period.
+// The changes are reported, and only saved if the request applies them.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) RecomputeSlipDays(ctx context.Context, in *pb.SlipDaysRequest) (*pb.SlipDaysRecomputation, error) {
+	if !in.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "invalid payload")
+	}
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("RecomputeSlipDays failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		s.logger.Error("RecomputeSlipDays failed: user is not teacher")
+		return nil, status.Error(codes.PermissionDenied, "only teachers can recompute slip days")
+	}
+	recomputation, err := s.recomputeSlipDays(in)
+	if err != nil {
+		s.logger.Errorf("RecomputeSlipDays failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to recompute slip days")
+	}
+	return recomputation, nil
+}
+
 // GetProviders returns a list of SCM providers supported by the backend.
 // Access policy: Any User.
 func (s *AutograderService) GetProviders(ctx context.Context, in *pb.Void) (*pb.Providers, error) {
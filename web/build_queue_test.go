@@ -0,0 +1,281 @@
+package web_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBuildQueueAccess(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := &pb.Course{Provider: "fake", OrganizationID: 1}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	student := createFakeUser(t, db, 2)
+	enrollStudent(t, db, student, course)
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	repo := &pb.Repository{OrganizationID: 1, RepositoryID: 1, UserID: student.ID, RepoType: pb.Repository_USER}
+	if err := db.CreateRepository(repo); err != nil {
+		t.Fatal(err)
+	}
+
+	queue := newLocalQueue(db)
+	job, err := queue.Enqueue(&ci.RunData{Course: course, Assignment: assignment, Repo: repo, CommitID: "deadbeef"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := fakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, queue)
+
+	studentCtx := withUserContext(context.Background(), student)
+	if _, err := ags.GetBuildJobs(studentCtx, &pb.CourseRequest{CourseID: course.ID}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetBuildJobs() as student: have error %v, want %v", err, codes.PermissionDenied)
+	}
+	if _, err := ags.CancelBuildJob(studentCtx, &pb.BuildJobRequest{CourseID: course.ID, JobID: job.ID}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("CancelBuildJob() as student: have error %v, want %v", err, codes.PermissionDenied)
+	}
+
+	teacherCtx := withUserContext(context.Background(), teacher)
+	if _, err := ags.GetBuildJobs(teacherCtx, &pb.CourseRequest{}); err != web.ErrInvalidUserInfo {
+		t.Errorf("GetBuildJobs() without course: have error %v, want %v", err, web.ErrInvalidUserInfo)
+	}
+	if _, err := ags.CancelBuildJob(teacherCtx, &pb.BuildJobRequest{CourseID: course.ID}); err != web.ErrInvalidUserInfo {
+		t.Errorf("CancelBuildJob() without job: have error %v, want %v", err, web.ErrInvalidUserInfo)
+	}
+	jobs, err := ags.GetBuildJobs(teacherCtx, &pb.CourseRequest{CourseID: course.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs.GetJobs()) != 1 || jobs.GetJobs()[0].GetID() != job.ID {
+		t.Fatalf("GetBuildJobs() = %v, want job %d", jobs.GetJobs(), job.ID)
+	}
+	if _, err := ags.CancelBuildJob(teacherCtx, &pb.BuildJobRequest{CourseID: course.ID, JobID: job.ID}); err != nil {
+		t.Fatal(err)
+	}
+	jobs, err = ags.GetBuildJobs(teacherCtx, &pb.CourseRequest{CourseID: course.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs.GetJobs()) != 0 {
+		t.Errorf("GetBuildJobs() after cancel = %v, want no jobs", jobs.GetJobs())
+	}
+	// canceling an already canceled job should fail
+	if _, err := ags.CancelBuildJob(teacherCtx, &pb.BuildJobRequest{CourseID: course.ID, JobID: job.ID}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("CancelBuildJob() twice: have error %v, want %v", err, codes.FailedPrecondition)
+	}
+}
+
+func TestBuildQuotaAccess(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := &pb.Course{Provider: "fake", OrganizationID: 1}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	student := createFakeUser(t, db, 2)
+	enrollStudent(t, db, student, course)
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, BuildsPerHour: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	repo := &pb.Repository{OrganizationID: 1, RepositoryID: 1, UserID: student.ID, RepoType: pb.Repository_USER}
+	if err := db.CreateRepository(repo); err != nil {
+		t.Fatal(err)
+	}
+	otherRepo := &pb.Repository{OrganizationID: 2, RepositoryID: 2, UserID: student.ID, RepoType: pb.Repository_USER}
+	if err := db.CreateRepository(otherRepo); err != nil {
+		t.Fatal(err)
+	}
+	// a build started for a push to the student's repository uses up the quota
+	if err := db.CreateBuildJob(&pb.BuildJob{
+		CourseID:     course.ID,
+		AssignmentID: assignment.ID,
+		RepositoryID: repo.ID,
+		Status:       pb.BuildJob_DONE,
+		StartedDate:  time.Now().Format(pb.TimeLayout),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := fakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
+	request := &pb.BuildQuotaRequest{CourseID: course.ID, AssignmentID: assignment.ID, RepositoryID: repo.ID}
+
+	studentCtx := withUserContext(context.Background(), student)
+	if _, err := ags.GetBuildQuotas(studentCtx, request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetBuildQuotas() as student: have error %v, want %v", err, codes.PermissionDenied)
+	}
+	if _, err := ags.ResetBuildQuota(studentCtx, request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ResetBuildQuota() as student: have error %v, want %v", err, codes.PermissionDenied)
+	}
+
+	teacherCtx := withUserContext(context.Background(), teacher)
+	quotas, err := ags.GetBuildQuotas(teacherCtx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(quotas.GetQuotas()) != 1 || quotas.GetQuotas()[0].GetRepositoryID() != repo.ID || quotas.GetQuotas()[0].GetNextBuild() == "" {
+		t.Fatalf("GetBuildQuotas() = %v, want used up quota for repository %d", quotas.GetQuotas(), repo.ID)
+	}
+	if _, err := ags.ResetBuildQuota(teacherCtx, &pb.BuildQuotaRequest{CourseID: course.ID, AssignmentID: assignment.ID}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ResetBuildQuota() without repository: have error %v, want %v", err, codes.InvalidArgument)
+	}
+	otherRequest := &pb.BuildQuotaRequest{CourseID: course.ID, AssignmentID: assignment.ID, RepositoryID: otherRepo.ID}
+	if _, err := ags.ResetBuildQuota(teacherCtx, otherRequest); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("ResetBuildQuota() for repository of other course: have error %v, want %v", err, codes.FailedPrecondition)
+	}
+	if _, err := ags.ResetBuildQuota(teacherCtx, request); err != nil {
+		t.Fatal(err)
+	}
+	quotas, err = ags.GetBuildQuotas(teacherCtx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(quotas.GetQuotas()) != 1 || quotas.GetQuotas()[0].GetRemaining() != 1 || quotas.GetQuotas()[0].GetNextBuild() != "" {
+		t.Errorf("GetBuildQuotas() after reset = %v, want 1 remaining build", quotas.GetQuotas())
+	}
+}
+
+// streamingRunner is a test runner that emits the lines sent on its lines channel,
+// and finishes the job when the channel is closed.
+type streamingRunner struct {
+	lines chan string
+}
+
+func (r *streamingRunner) Run(ctx context.Context, job *ci.Job) (string, error) {
+	return r.RunStream(ctx, job, func(string) {})
+}
+
+func (r *streamingRunner) RunStream(ctx context.Context, job *ci.Job, emit func(line string)) (string, error) {
+	for {
+		select {
+		case line, ok := <-r.lines:
+			if !ok {
+				return "tests passed", nil
+			}
+			emit(line)
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+}
+
+func recvBuildLogLine(t *testing.T, stream pb.AutograderService_BuildLogStreamClient, job *pb.BuildJob, wantLine string) {
+	t.Helper()
+	line, err := stream.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if line.GetJobID() != job.GetID() || line.GetAssignmentID() != job.GetAssignmentID() || line.GetLine() != wantLine {
+		t.Errorf("got line %q from job %d, want line %q from job %d", line.GetLine(), line.GetJobID(), wantLine, job.GetID())
+	}
+}
+
+func TestBuildLogStream(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := &pb.Course{Provider: "fake", OrganizationID: 1}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	student := createFakeUser(t, db, 2)
+	enrollStudent(t, db, student, course)
+	otherStudent := createFakeUser(t, db, 3)
+	enrollStudent(t, db, otherStudent, course)
+	outsider := createFakeUser(t, db, 4)
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", ScriptFile: "go", Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	repo := &pb.Repository{OrganizationID: 1, RepositoryID: 1, UserID: student.ID, RepoType: pb.Repository_USER}
+	if err := db.CreateRepository(repo); err != nil {
+		t.Fatal(err)
+	}
+
+	runner := &streamingRunner{lines: make(chan string)}
+	queue := ci.NewQueue(zap.NewNop().Sugar(), db, runner, ci.QueueOptions{Workers: 1, ScriptPath: "../ci/scripts"})
+	job, err := queue.Enqueue(&ci.RunData{Course: course, Assignment: assignment, Repo: repo, CommitID: "deadbeef", JobOwner: "student"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := fakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, queue)
+	client, stop := serveBufconn(t, ags)
+	defer stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	request := &pb.BuildLogRequest{CourseID: course.ID, CommitID: "deadbeef"}
+	for _, test := range []struct {
+		user     *pb.User
+		request  *pb.BuildLogRequest
+		wantCode codes.Code
+	}{
+		{outsider, request, codes.PermissionDenied},
+		{otherStudent, request, codes.NotFound},
+		{teacher, &pb.BuildLogRequest{CourseID: course.ID, CommitID: "cafebabe"}, codes.NotFound},
+		{teacher, &pb.BuildLogRequest{CourseID: course.ID}, codes.InvalidArgument},
+	} {
+		stream, err := client.BuildLogStream(withOutgoingUser(ctx, test.user), test.request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := stream.Recv(); status.Code(err) != test.wantCode {
+			t.Errorf("BuildLogStream(%v) as user %d: have error %v, want code %s", test.request, test.user.ID, err, test.wantCode)
+		}
+	}
+
+	// the student subscribes while the job is queued
+	studentStream, err := client.BuildLogStream(withOutgoingUser(ctx, student), request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitForSubscribers(t, queue.BuildLogs(), 1)
+	if err := queue.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	runner.lines <- "=== RUN   TestLab1"
+	recvBuildLogLine(t, studentStream, job, "=== RUN   TestLab1")
+
+	// the teacher joins the running job and receives the output so far
+	teacherStream, err := client.BuildLogStream(withOutgoingUser(ctx, teacher), request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitForSubscribers(t, queue.BuildLogs(), 2)
+	recvBuildLogLine(t, teacherStream, job, "=== RUN   TestLab1")
+
+	// score lines are never streamed
+	runner.lines <- `{"Secret":"For Your Eyes Only","TestName":"TestLab1","Score":100,"MaxScore":100,"Weight":1}`
+	runner.lines <- "--- PASS: TestLab1"
+	close(runner.lines)
+	for _, stream := range []pb.AutograderService_BuildLogStreamClient{studentStream, teacherStream} {
+		recvBuildLogLine(t, stream, job, "--- PASS: TestLab1")
+		// the stream ends with the job
+		if line, err := stream.Recv(); err != io.EOF {
+			t.Errorf("got line %v and error %v, want %v", line, err, io.EOF)
+		}
+	}
+	waitForSubscribers(t, queue.BuildLogs(), 0)
+}
@@ -9,6 +9,7 @@ import (
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/database"
 	"github.com/autograde/quickfeed/scm"
 )
 
@@ -127,6 +128,14 @@ func (s *AutograderService) getCourse(courseID uint64) (*pb.Course, error) {
 	return s.db.GetCourse(courseID, false)
 }
 
+// activePeriod is the period in which students must have activity to be counted as active.
+const activePeriod = 14 * 24 * time.Hour
+
+// getCourseStatistics returns the statistics of the students and assignments of the given course.
+func (s *AutograderService) getCourseStatistics(courseID uint64) (*pb.CourseStatistics, error) {
+	return s.db.GetCourseStatistics(courseID, time.Now().Add(-activePeriod))
+}
+
 // getSubmissions returns all the latests submissions for a user of the given course.
 func (s *AutograderService) getSubmissions(request *pb.SubmissionRequest) (*pb.Submissions, error) {
 	// only one of user ID and group ID will be set; enforced by IsValid on pb.SubmissionRequest
@@ -278,7 +287,11 @@ func (s *AutograderService) updateSubmission(courseID, submissionID uint64, stat
 	if score > 0 {
 		submission.Score = score
 	}
-	return s.db.UpdateSubmission(submission)
+	if err := s.db.UpdateSubmission(submission); err != nil {
+		return err
+	}
+	s.publishSubmission(courseID, submission)
+	return nil
 }
 
 // updateSubmissions updates status and release state of multiple submissions for the
@@ -407,12 +420,15 @@ func (s *AutograderService) rejectEnrollment(ctx context.Context, sc scm.SCM, en
 		if err := removeUserFromCourse(ctx, sc, user.GetLogin(), repo); err != nil {
 			s.logger.Debug("updateEnrollment: rejectUserFromCourse failed (expected behavior): ", err)
 		}
-
-		if err := s.db.DeleteRepositoryByRemoteID(repo.GetRepositoryID()); err != nil {
-			return err
-		}
 	}
-	return s.db.RejectEnrollment(user.ID, course.ID)
+	return s.db.Transaction(func(tx database.Database) error {
+		for _, repo := range repos {
+			if err := tx.DeleteRepositoryByRemoteID(repo.GetRepositoryID()); err != nil {
+				return err
+			}
+		}
+		return tx.RejectEnrollment(user.ID, course.ID)
+	})
 }
 
 // enrollStudent enrolls the given user as a student into the given course.
@@ -466,9 +482,12 @@ func (s *AutograderService) enrollStudent(ctx context.Context, sc scm.SCM, enrol
 			RepoType:       pb.Repository_USER,
 		}
 
-		if err := s.db.CreateRepository(&userRepo); err != nil {
-			return err
-		}
+		return s.db.Transaction(func(tx database.Database) error {
+			if err := tx.CreateRepository(&userRepo); err != nil {
+				return err
+			}
+			return tx.UpdateEnrollment(userEnrolQuery)
+		})
 	}
 
 	return s.db.UpdateEnrollment(userEnrolQuery)
@@ -518,7 +537,7 @@ func (s *AutograderService) getEnrollmentsWithActivity(courseID uint64) ([]*pb.E
 		}
 		enrol.TotalApproved = totalApproved
 		if enrol.LastActivityDate == "" && !submissionDate.IsZero() {
-			enrol.LastActivityDate = submissionDate.Format("02 Jan")
+			enrol.LastActivityDate = submissionDate.Format(pb.DateLayout)
 		}
 		enrollmentsWithActivity = append(enrollmentsWithActivity, enrol)
 	}
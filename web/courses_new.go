@@ -7,6 +7,7 @@ import (
 	"github.com/autograde/quickfeed/web/auth"
 
 	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
 	"github.com/autograde/quickfeed/scm"
 )
 
@@ -65,6 +66,7 @@ func (s *AutograderService) createCourse(ctx context.Context, sc scm.SCM, reques
 	}
 
 	// create course repos and webhooks for each repo
+	var dbRepos []*pb.Repository
 	for path, private := range RepoPaths {
 
 		repoOptions := &scm.CreateRepositoryOptions{
@@ -77,16 +79,12 @@ func (s *AutograderService) createCourse(ctx context.Context, sc scm.SCM, reques
 			return nil, err
 		}
 
-		dbRepo := pb.Repository{
+		dbRepos = append(dbRepos, &pb.Repository{
 			OrganizationID: org.ID,
 			RepositoryID:   repo.ID,
 			HTMLURL:        repo.WebURL,
 			RepoType:       pb.RepoType(path),
-		}
-		if err := s.db.CreateRepository(&dbRepo); err != nil {
-			s.logger.Debugf("createCourse: failed to create database record for repository %s: %s", path, err)
-			return nil, err
-		}
+		})
 	}
 
 	// add course creator to teacher team
@@ -116,20 +114,28 @@ func (s *AutograderService) createCourse(ctx context.Context, sc scm.SCM, reques
 	if err != nil {
 		return nil, err
 	}
-	repoQuery := &pb.Repository{
+	dbRepos = append(dbRepos, &pb.Repository{
 		OrganizationID: org.GetID(),
 		RepositoryID:   scmRepo.ID,
 		UserID:         courseCreator.ID,
 		HTMLURL:        scmRepo.WebURL,
 		RepoType:       pb.Repository_USER,
-	}
-	if err := s.db.CreateRepository(repoQuery); err != nil {
-		return nil, err
-	}
+	})
 
+	// create the database records for the course and its repositories together
 	request.OrganizationPath = org.GetPath()
-	if err := s.db.CreateCourse(request.GetCourseCreatorID(), request); err != nil {
-		s.logger.Debugf("createCourse: failed to create database record for course %s: %s", request.Name, err)
+	if err := s.db.Transaction(func(tx database.Database) error {
+		for _, dbRepo := range dbRepos {
+			if err := tx.CreateRepository(dbRepo); err != nil {
+				return fmt.Errorf("failed to create database record for repository %s: %w", dbRepo.GetHTMLURL(), err)
+			}
+		}
+		if err := tx.CreateCourse(request.GetCourseCreatorID(), request); err != nil {
+			return fmt.Errorf("failed to create database record for course %s: %w", request.Name, err)
+		}
+		return nil
+	}); err != nil {
+		s.logger.Debugf("createCourse: %s", err)
 		return nil, err
 	}
 	return request, nil
@@ -2,6 +2,7 @@ package web_test
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"strconv"
 	"testing"
@@ -16,6 +17,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/database"
 	"github.com/autograde/quickfeed/scm"
 	"github.com/autograde/quickfeed/web"
 	"github.com/autograde/quickfeed/web/auth"
@@ -66,7 +68,7 @@ func TestGetCourses(t *testing.T) {
 
 	admin := createFakeUser(t, db, 10)
 	_, scms := fakeProviderMap(t)
-	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
 
 	var testCourses []*pb.Course
 	for _, course := range allCourses {
@@ -108,6 +110,12 @@ func fakeProviderMap(t *testing.T) (scm.SCM, *auth.Scms) {
 	return scm, scms
 }
 
+// newLocalQueue is a test helper function to create a build queue
+// that runs builds locally; the queue is not started.
+func newLocalQueue(db database.Database) *ci.Queue {
+	return ci.NewQueue(zap.NewNop().Sugar(), db, &ci.Local{}, ci.QueueOptions{})
+}
+
 func fakeGothProvider() {
 	baseURL := "fake"
 	goth.UseProviders(&auth.FakeProvider{
@@ -127,11 +135,11 @@ func TestNewCourse(t *testing.T) {
 	admin := createFakeUser(t, db, 10)
 	ctx := withUserContext(context.Background(), admin)
 	fakeProvider, scms := fakeProviderMap(t)
-	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
 
 	for _, testCourse := range allCourses {
 		// each course needs a separate directory
-		_, err := fakeProvider.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"})
+		_, err := fakeProvider.CreateOrganization(ctx, &scm.OrganizationOptions{Path: fmt.Sprintf("path%d", testCourse.GetOrganizationID()), Name: "name"})
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -163,7 +171,7 @@ func TestNewCourseExistingRepos(t *testing.T) {
 	admin := createFakeUser(t, db, 10)
 	ctx := withUserContext(context.Background(), admin)
 	fakeProvider, scms := fakeProviderMap(t)
-	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
 
 	directory, _ := fakeProvider.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"})
 	for path, private := range web.RepoPaths {
@@ -190,7 +198,7 @@ func TestEnrollmentProcess(t *testing.T) {
 	admin := createFakeUser(t, db, 1)
 	ctx := withUserContext(context.Background(), admin)
 	fakeProvider, scms := fakeProviderMap(t)
-	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
 	_, err := fakeProvider.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"})
 	if err != nil {
 		t.Fatal(err)
@@ -293,7 +301,7 @@ func TestListCoursesWithEnrollment(t *testing.T) {
 	admin := createFakeUser(t, db, 1)
 	user := createFakeUser(t, db, 2)
 	_, scms := fakeProviderMap(t)
-	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
 
 	var testCourses []*pb.Course
 	for _, course := range allCourses {
@@ -372,7 +380,7 @@ func TestListCoursesWithEnrollmentStatuses(t *testing.T) {
 
 	user := createFakeUser(t, db, 2)
 	_, scms := fakeProviderMap(t)
-	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
 
 	if err := db.CreateEnrollment(&pb.Enrollment{
 		UserID:   user.ID,
@@ -433,7 +441,7 @@ func TestGetCourse(t *testing.T) {
 		t.Fatal(err)
 	}
 	_, scms := fakeProviderMap(t)
-	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
 
 	foundCourse, err := ags.GetCourse(context.Background(), &pb.CourseRequest{CourseID: course.ID})
 	if err != nil {
@@ -463,7 +471,7 @@ func TestPromoteDemoteRejectTeacher(t *testing.T) {
 	}
 
 	fakeProvider, scms := fakeProviderMap(t)
-	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
 
 	if err := db.CreateEnrollment(&pb.Enrollment{
 		UserID:   student1.ID,
@@ -521,6 +529,11 @@ func TestPromoteDemoteRejectTeacher(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+	for _, team := range []string{scm.TeachersTeam, scm.StudentsTeam} {
+		if _, err := fakeProvider.CreateTeam(ctx, &scm.NewTeamOptions{Organization: "path", TeamName: team}); err != nil {
+			t.Fatal(err)
+		}
+	}
 
 	if _, err := ags.UpdateEnrollment(ctx, &pb.Enrollment{
 		UserID:   student1.ID,
@@ -639,3 +652,44 @@ func TestPromoteDemoteRejectTeacher(t *testing.T) {
 		t.Error("expected error 'ta cannot be demoted course creator'")
 	}
 }
+
+func TestGetCourseStatistics(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := &pb.Course{Provider: "fake", OrganizationID: 1}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	lab := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+	student := createFakeUser(t, db, 2)
+	enrollStudent(t, db, student, course)
+	if err := db.CreateSubmission(&pb.Submission{AssignmentID: lab.ID, UserID: student.ID, Score: 75}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := fakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
+	request := &pb.CourseRequest{CourseID: course.ID}
+	if _, err := ags.GetCourseStatistics(withUserContext(context.Background(), student), request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetCourseStatistics() as student: have error %v, want %v", err, codes.PermissionDenied)
+	}
+	statistics, err := ags.GetCourseStatistics(withUserContext(context.Background(), teacher), request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &pb.CourseStatistics{
+		Students:       1,
+		ActiveStudents: 1,
+		Assignments: []*pb.AssignmentStatistics{
+			{AssignmentID: lab.ID, Name: "lab1", Submissions: 1, ScoreDistribution: []uint32{0, 0, 0, 0, 0, 0, 0, 1, 0, 0}},
+		},
+	}
+	if diff := cmp.Diff(want, statistics, cmpopts.IgnoreUnexported(pb.CourseStatistics{}, pb.AssignmentStatistics{})); diff != "" {
+		t.Errorf("GetCourseStatistics() mismatch (-want +got):\n%s", diff)
+	}
+}
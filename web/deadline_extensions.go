@@ -0,0 +1,60 @@
+package web
+
+import (
+	"fmt"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/slipdays"
+)
+
+// getDeadlineExtensions returns the deadline extensions of the assignments of the given course.
+func (s *AutograderService) getDeadlineExtensions(courseID uint64) (*pb.DeadlineExtensions, error) {
+	extensions, err := s.db.GetDeadlineExtensions(courseID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeadlineExtensions{Extensions: extensions}, nil
+}
+
+// grantDeadlineExtension creates or replaces the requested deadline extension, after
+// checking that the assignment and the student or group belong to the requested course,
+// and that the extended deadline is later than the assignment's deadline.
+func (s *AutograderService) grantDeadlineExtension(request *pb.DeadlineExtensionRequest) (*pb.DeadlineExtension, error) {
+	extension := request.GetExtension()
+	assignment, err := s.getCourseAssignment(request.GetCourseID(), extension.GetAssignmentID())
+	if err != nil {
+		return nil, err
+	}
+	if extension.GetGroupID() > 0 {
+		group, err := s.db.GetGroup(extension.GetGroupID())
+		if err != nil {
+			return nil, err
+		}
+		if group.GetCourseID() != request.GetCourseID() {
+			return nil, fmt.Errorf("group %d does not belong to course %d", group.GetID(), request.GetCourseID())
+		}
+	} else {
+		enrollment, err := s.db.GetEnrollmentByCourseAndUser(request.GetCourseID(), extension.GetUserID())
+		if err != nil {
+			return nil, err
+		}
+		if !enrollment.IsStudent() {
+			return nil, fmt.Errorf("user %d is not a student of course %d", extension.GetUserID(), request.GetCourseID())
+		}
+	}
+	if assignment.GetDeadline() != "" && extension.GetDeadline() <= assignment.GetDeadline() {
+		// deadlines in pb.TimeLayout compare in chronological order
+		return nil, fmt.Errorf("extended deadline %s is not after the deadline %s of assignment %d",
+			extension.GetDeadline(), assignment.GetDeadline(), assignment.GetID())
+	}
+	if err := s.db.CreateDeadlineExtension(extension); err != nil {
+		return nil, err
+	}
+	return extension, nil
+}
+
+// recomputeSlipDays recomputes the slip days of the students of the requested course
+// against the current deadlines, and saves the changes if requested.
+func (s *AutograderService) recomputeSlipDays(request *pb.SlipDaysRequest) (*pb.SlipDaysRecomputation, error) {
+	return slipdays.Recompute(s.db, request)
+}
@@ -0,0 +1,144 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/web"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDeadlineExtensions(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := &pb.Course{Provider: "fake", OrganizationID: 1}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(-time.Hour)
+	lab := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, Deadline: deadline.Format(pb.TimeLayout)}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+	student := createFakeUser(t, db, 2)
+	enrollStudent(t, db, student, course)
+	member := createFakeUser(t, db, 3)
+	enrollStudent(t, db, member, course)
+	group := &pb.Group{Name: "group", CourseID: course.ID, Users: []*pb.User{member}}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+	outsider := createFakeUser(t, db, 4)
+
+	_, scms := fakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
+	ctx := withUserContext(context.Background(), teacher)
+	extended := func(hours int) string {
+		return deadline.Add(time.Duration(hours) * time.Hour).Format(pb.TimeLayout)
+	}
+	request := func(extension *pb.DeadlineExtension) *pb.DeadlineExtensionRequest {
+		return &pb.DeadlineExtensionRequest{CourseID: course.ID, Extension: extension}
+	}
+
+	if _, err := ags.GrantDeadlineExtension(withUserContext(context.Background(), student),
+		request(&pb.DeadlineExtension{AssignmentID: lab.ID, UserID: student.ID, Deadline: extended(48)})); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GrantDeadlineExtension() as student: have error %v, want %v", err, codes.PermissionDenied)
+	}
+	for _, invalid := range []*pb.DeadlineExtension{
+		{AssignmentID: lab.ID, UserID: student.ID, GroupID: group.ID, Deadline: extended(48)},
+		{AssignmentID: lab.ID, UserID: student.ID, Deadline: "tomorrow"},
+		{AssignmentID: lab.ID, UserID: student.ID, Deadline: extended(-1)},
+		{AssignmentID: lab.ID, UserID: outsider.ID, Deadline: extended(48)},
+		{AssignmentID: lab.ID + 1, UserID: student.ID, Deadline: extended(48)},
+	} {
+		if _, err := ags.GrantDeadlineExtension(ctx, request(invalid)); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("GrantDeadlineExtension(%v): have error %v, want %v", invalid, err, codes.InvalidArgument)
+		}
+	}
+
+	studentExtension, err := ags.GrantDeadlineExtension(ctx, request(&pb.DeadlineExtension{AssignmentID: lab.ID, UserID: student.ID, Deadline: extended(24), Reason: "sick"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	groupExtension, err := ags.GrantDeadlineExtension(ctx, request(&pb.DeadlineExtension{AssignmentID: lab.ID, GroupID: group.ID, Deadline: extended(24)}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// granting another extension replaces the previous extension
+	replaced, err := ags.GrantDeadlineExtension(ctx, request(&pb.DeadlineExtension{AssignmentID: lab.ID, UserID: student.ID, Deadline: extended(72), Reason: "still sick"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replaced.GetID() != studentExtension.GetID() {
+		t.Errorf("GrantDeadlineExtension() replacing extension %d returned extension %d", studentExtension.GetID(), replaced.GetID())
+	}
+
+	extensions, err := ags.GetDeadlineExtensions(ctx, &pb.CourseRequest{CourseID: course.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*pb.DeadlineExtension{
+		{ID: studentExtension.GetID(), AssignmentID: lab.ID, UserID: student.ID, Deadline: extended(72), Reason: "still sick"},
+		{ID: groupExtension.GetID(), AssignmentID: lab.ID, GroupID: group.ID, Deadline: extended(24)},
+	}
+	if diff := cmp.Diff(want, extensions.GetExtensions(), cmpopts.IgnoreUnexported(pb.DeadlineExtension{})); diff != "" {
+		t.Errorf("GetDeadlineExtensions() mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := ags.RevokeDeadlineExtension(ctx, request(replaced)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ags.RevokeDeadlineExtension(ctx, request(replaced)); status.Code(err) != codes.NotFound {
+		t.Errorf("RevokeDeadlineExtension() of revoked extension: have error %v, want %v", err, codes.NotFound)
+	}
+	extensions, err = ags.GetDeadlineExtensions(ctx, &pb.CourseRequest{CourseID: course.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want[1:], extensions.GetExtensions(), cmpopts.IgnoreUnexported(pb.DeadlineExtension{})); diff != "" {
+		t.Errorf("GetDeadlineExtensions() after revoke mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestRecomputeSlipDays(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := &pb.Course{Provider: "fake", OrganizationID: 1, SlipDays: 5}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	student := createFakeUser(t, db, 2)
+	enrollStudent(t, db, student, course)
+
+	_, scms := fakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
+	request := &pb.SlipDaysRequest{CourseID: course.ID, ChangeSlipDays: true, SlipDays: 3}
+	if _, err := ags.RecomputeSlipDays(withUserContext(context.Background(), student), request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("RecomputeSlipDays() as student: have error %v, want %v", err, codes.PermissionDenied)
+	}
+	recomputation, err := ags.RecomputeSlipDays(withUserContext(context.Background(), teacher), request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if recomputation.GetApplied() || len(recomputation.GetChanges()) != 1 || recomputation.GetChanges()[0].GetNewRemaining() != 3 {
+		t.Errorf("RecomputeSlipDays() = %v, want an unapplied change to 3 remaining slip days", recomputation)
+	}
+	// the dry run does not change the course
+	course, err = db.GetCourse(course.ID, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if course.GetSlipDays() != 5 {
+		t.Errorf("have %d course slip days after dry run, want 5", course.GetSlipDays())
+	}
+}
@@ -0,0 +1,30 @@
+package web
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/gradebook"
+)
+
+// exportGradebook returns the gradebook of the requested course in the requested format.
+func (s *AutograderService) exportGradebook(request *pb.GradebookRequest) (*pb.GradebookFile, error) {
+	submissionType := pb.SubmissionsForCourseRequest_ALL
+	if request.GetGroups() {
+		submissionType = pb.SubmissionsForCourseRequest_GROUP
+	}
+	courseSubmissions, err := s.getAllCourseSubmissions(&pb.SubmissionsForCourseRequest{
+		CourseID: request.GetCourseID(),
+		Type:     submissionType,
+	})
+	if err != nil {
+		return nil, err
+	}
+	rules := gradebook.Rules{
+		MinApproved: int(request.GetMinApproved()),
+		Required:    request.GetRequiredAssignments(),
+	}
+	gb, err := gradebook.New(courseSubmissions, request.GetGroups(), rules, request.GetIgnoredLogins()...)
+	if err != nil {
+		return nil, err
+	}
+	return gb.Export(request.GetFormat())
+}
@@ -0,0 +1,78 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/web"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestExportGradebook(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT320", Year: 2021, Provider: "fake", OrganizationID: 1}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	lab1 := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	lab2 := &pb.Assignment{CourseID: course.ID, Name: "lab2", Order: 2}
+	for _, assignment := range []*pb.Assignment{lab1, lab2} {
+		if err := db.CreateAssignment(assignment); err != nil {
+			t.Fatal(err)
+		}
+	}
+	alice := createNamedUser(t, db, 2, "Alice")
+	bob := createNamedUser(t, db, 3, "Bob")
+	enrollStudent(t, db, alice, course)
+	enrollStudent(t, db, bob, course)
+	for _, submission := range []*pb.Submission{
+		{AssignmentID: lab1.ID, UserID: alice.ID, Score: 90, Status: pb.Submission_APPROVED},
+		{AssignmentID: lab2.ID, UserID: alice.ID, Score: 80, Status: pb.Submission_APPROVED},
+		{AssignmentID: lab1.ID, UserID: bob.ID, Score: 50},
+	} {
+		if err := db.CreateSubmission(submission); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, scms := fakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
+	request := &pb.GradebookRequest{CourseID: course.ID, Format: pb.GradebookRequest_MOODLE, MinApproved: 2}
+	if _, err := ags.ExportGradebook(withUserContext(context.Background(), alice), request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ExportGradebook() as student: have error %v, want %v", err, codes.PermissionDenied)
+	}
+
+	ctx := withUserContext(context.Background(), teacher)
+	file, err := ags.ExportGradebook(ctx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `ID number,Email address,Full name,lab1,lab2,Approved assignments
+,,Alice,90,80,2
+,,Bob,50,,0
+`
+	if file.GetName() != "dat320-2021-moodle.csv" || file.GetContentType() != "text/csv" {
+		t.Errorf("ExportGradebook() = %q (%s), want dat320-2021-moodle.csv (text/csv)", file.GetName(), file.GetContentType())
+	}
+	if diff := cmp.Diff(want, string(file.GetContent())); diff != "" {
+		t.Errorf("ExportGradebook() mismatch (-want +got):\n%s", diff)
+	}
+
+	// required assignments must exist
+	request.RequiredAssignments = []string{"lab3"}
+	if _, err := ags.ExportGradebook(ctx, request); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ExportGradebook() with unknown required assignment: have error %v, want %v", err, codes.InvalidArgument)
+	}
+	// only student gradebooks can be imported by learning management systems
+	request = &pb.GradebookRequest{CourseID: course.ID, Format: pb.GradebookRequest_CANVAS, Groups: true}
+	if _, err := ags.ExportGradebook(ctx, request); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ExportGradebook() of groups to Canvas: have error %v, want %v", err, codes.InvalidArgument)
+	}
+}
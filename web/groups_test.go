@@ -7,9 +7,9 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 
 	pb "github.com/autograde/quickfeed/ag"
-	"github.com/autograde/quickfeed/ci"
 	"github.com/autograde/quickfeed/scm"
 	"github.com/autograde/quickfeed/web"
 )
@@ -40,7 +40,7 @@ func TestNewGroup(t *testing.T) {
 
 	ctx := withUserContext(context.Background(), admin)
 	fakeProvider, scms := fakeProviderMap(t)
-	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
 
 	_, err := fakeProvider.CreateOrganization(ctx,
 		&scm.OrganizationOptions{Path: "path", Name: "name"},
@@ -95,7 +95,7 @@ func TestCreateGroupWithMissingFields(t *testing.T) {
 
 	ctx := withUserContext(context.Background(), admin)
 	fakeProvider, scms := fakeProviderMap(t)
-	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
 
 	_, err := fakeProvider.CreateOrganization(ctx,
 		&scm.OrganizationOptions{Path: "path", Name: "name"},
@@ -164,7 +164,7 @@ func TestNewGroupTeacherCreator(t *testing.T) {
 	}
 
 	fakeProvider, scms := fakeProviderMap(t)
-	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
 
 	_, err := fakeProvider.CreateOrganization(context.Background(),
 		&scm.OrganizationOptions{Path: "path", Name: "name"},
@@ -248,7 +248,7 @@ func TestNewGroupStudentCreateGroupWithTeacher(t *testing.T) {
 
 	fakeProvider, scms := fakeProviderMap(t)
 	ctx := withUserContext(context.Background(), user)
-	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
 
 	_, err := fakeProvider.CreateOrganization(ctx,
 		&scm.OrganizationOptions{Path: "path", Name: "name"},
@@ -277,7 +277,7 @@ func TestStudentCreateNewGroupTeacherUpdateGroup(t *testing.T) {
 	// set up fake goth provider (only needs to be done once)
 	fakeGothProvider()
 	fakeProvider, scms := fakeProviderMap(t)
-	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
 	_, err := fakeProvider.CreateOrganization(context.Background(),
 		&scm.OrganizationOptions{Path: "path", Name: "name"},
 	)
@@ -503,7 +503,7 @@ func TestDeleteGroup(t *testing.T) {
 	group := &pb.Group{Name: "Test Delete Group", CourseID: testCourse.ID, Users: []*pb.User{user}}
 
 	_, scms := fakeProviderMap(t)
-	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
 
 	ctx := withUserContext(context.Background(), user)
 	respGroup, err := ags.CreateGroup(ctx, group)
@@ -549,7 +549,7 @@ func TestGetGroup(t *testing.T) {
 	}
 
 	_, scms := fakeProviderMap(t)
-	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
 	ctx := withUserContext(context.Background(), user)
 
 	group := &pb.Group{Name: "Test Group", CourseID: testCourse.ID, Users: []*pb.User{user}}
@@ -603,7 +603,7 @@ func TestPatchGroupStatus(t *testing.T) {
 	}
 
 	fakeProvider, scms := fakeProviderMap(t)
-	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
 	ctx := withUserContext(context.Background(), teacher)
 
 	if _, err := fakeProvider.CreateOrganization(ctx, &scm.OrganizationOptions{
@@ -696,7 +696,7 @@ func TestGetGroupByUserAndCourse(t *testing.T) {
 	}
 
 	_, scms := fakeProviderMap(t)
-	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
 	ctx := withUserContext(context.Background(), admin)
 
 	user1 := createFakeUser(t, db, 2)
@@ -758,14 +758,16 @@ func TestDeleteApprovedGroup(t *testing.T) {
 	defer cleanup()
 
 	admin := createFakeUser(t, db, 1)
-	course := allCourses[0]
+	// copy the course, since CreateCourse in other tests may have set a different organization path
+	course := proto.Clone(allCourses[0]).(*pb.Course)
+	course.OrganizationPath = course.Code
 	err := db.CreateCourse(admin.ID, course)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	fakeProvider, scms := fakeProviderMap(t)
-	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
 	ctx := withUserContext(context.Background(), admin)
 
 	if _, err := fakeProvider.CreateOrganization(ctx, &scm.OrganizationOptions{
@@ -882,7 +884,7 @@ func TestGetGroups(t *testing.T) {
 	admin := users[0]
 
 	_, scms := fakeProviderMap(t)
-	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
 	// admin will be enrolled as teacher because of course creation below
 	withUserContext(context.Background(), admin)
 
@@ -944,3 +946,155 @@ func TestGetGroups(t *testing.T) {
 		t.Errorf("mismatch (-wantGroups +gotGroups):\n%s", diff)
 	}
 }
+
+func TestGroupLifecycle(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	fakeGothProvider()
+	fakeProvider, scms := fakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
+	org := &pb.Organization{ID: 1, Path: "path"}
+	if _, err := fakeProvider.CreateOrganization(context.Background(), &scm.OrganizationOptions{Path: org.Path, Name: "name"}); err != nil {
+		t.Fatal(err)
+	}
+
+	admin := createFakeUser(t, db, 1)
+	course := &pb.Course{Provider: "fake", OrganizationID: org.ID}
+	if err := db.CreateCourse(admin.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	teacher := createFakeUser(t, db, 2)
+	enrollStudent(t, db, teacher, course)
+	if err := db.UpdateEnrollment(&pb.Enrollment{
+		UserID:   teacher.ID,
+		CourseID: course.ID,
+		Status:   pb.Enrollment_TEACHER,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	user1 := createFakeUser(t, db, 3)
+	enrollStudent(t, db, user1, course)
+	user2 := createFakeUser(t, db, 4)
+	enrollStudent(t, db, user2, course)
+	user3 := createFakeUser(t, db, 5)
+	enrollStudent(t, db, user3, course)
+	pending := createFakeUser(t, db, 6)
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: pending.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+
+	// teamMembers returns the logins of the members of the course's only team on the SCM
+	teamMembers := func() []string {
+		t.Helper()
+		teams, err := fakeProvider.GetTeams(context.Background(), org)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(teams) != 1 {
+			t.Fatalf("expected 1 team, got %d", len(teams))
+		}
+		var logins []string
+		for login := range fakeProvider.(*scm.FakeSCM).TeamMembers[teams[0].ID] {
+			logins = append(logins, login)
+		}
+		return logins
+	}
+	sortStrings := cmpopts.SortSlices(func(a, b string) bool { return a < b })
+
+	// students cannot create groups with members that are not yet accepted into the course
+	ctx := withUserContext(context.Background(), user1)
+	if _, err := ags.CreateGroup(ctx, &pb.Group{Name: "pending", CourseID: course.ID, Users: []*pb.User{user1, pending}}); err == nil {
+		t.Error("expected CreateGroup to fail with a member that is not accepted into the course")
+	}
+
+	group, err := ags.CreateGroup(ctx, &pb.Group{Name: "lifecycle", CourseID: course.ID, Users: []*pb.User{user1, user2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if group.Status != pb.Group_PENDING {
+		t.Errorf("have group status %v want %v", group.Status, pb.Group_PENDING)
+	}
+	if teams, _ := fakeProvider.GetTeams(context.Background(), org); len(teams) != 0 {
+		t.Errorf("expected no teams for pending group, got %d", len(teams))
+	}
+
+	// a student cannot be a member of two groups
+	ctx = withUserContext(context.Background(), user2)
+	if _, err := ags.CreateGroup(ctx, &pb.Group{Name: "other", CourseID: course.ID, Users: []*pb.User{user2, user3}}); err == nil {
+		t.Error("expected CreateGroup to fail with a member of another group")
+	}
+
+	// only teachers can approve groups
+	group.Status = pb.Group_APPROVED
+	if _, err := ags.UpdateGroup(ctx, group); err == nil {
+		t.Error("expected UpdateGroup to fail for a student")
+	}
+
+	// unknown groups cannot be approved
+	ctx = withUserContext(context.Background(), teacher)
+	if _, err := ags.UpdateGroup(ctx, &pb.Group{ID: 123, Name: "unknown", CourseID: course.ID, Users: []*pb.User{user3}}); err == nil {
+		t.Error("expected UpdateGroup to fail for an unknown group")
+	}
+
+	// approving the group creates its team and repository on the SCM
+	if _, err := ags.UpdateGroup(ctx, group); err != nil {
+		t.Fatal(err)
+	}
+	approved, err := db.GetGroup(group.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if approved.Status != pb.Group_APPROVED {
+		t.Errorf("have group status %v want %v", approved.Status, pb.Group_APPROVED)
+	}
+	if diff := cmp.Diff([]string{user1.Login, user2.Login}, teamMembers(), sortStrings); diff != "" {
+		t.Errorf("team members mismatch (-want +have):\n%s", diff)
+	}
+	repos, err := fakeProvider.GetRepositories(context.Background(), org)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(repos) != 1 || repos[0].Path != group.Name {
+		t.Errorf("expected repository %s on the SCM, got %v", group.Name, repos)
+	}
+
+	// approved groups cannot be renamed, but members can be changed
+	if _, err := ags.UpdateGroup(ctx, &pb.Group{ID: group.ID, Name: "renamed", CourseID: course.ID, Users: []*pb.User{user1, user3}}); err != nil {
+		t.Fatal(err)
+	}
+	updated, err := db.GetGroup(group.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Name != group.Name {
+		t.Errorf("have group name %q want %q", updated.Name, group.Name)
+	}
+	if diff := cmp.Diff([]string{user1.Login, user3.Login}, teamMembers(), sortStrings); diff != "" {
+		t.Errorf("team members mismatch (-want +have):\n%s", diff)
+	}
+	if enr, err := db.GetEnrollmentByCourseAndUser(course.ID, user2.ID); err != nil || enr.GroupID != 0 {
+		t.Errorf("expected %s to be removed from the group (enrollment=%v, err=%v)", user2.Login, enr, err)
+	}
+
+	// deleting the group removes its team and repository from the SCM
+	if _, err := ags.DeleteGroup(ctx, &pb.GroupRequest{CourseID: course.ID, GroupID: group.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if teams, _ := fakeProvider.GetTeams(context.Background(), org); len(teams) != 0 {
+		t.Errorf("expected no teams after deleting the group, got %d", len(teams))
+	}
+	if repos, _ := fakeProvider.GetRepositories(context.Background(), org); len(repos) != 0 {
+		t.Errorf("expected no repositories after deleting the group, got %d", len(repos))
+	}
+	for _, user := range []*pb.User{user1, user3} {
+		if enr, err := db.GetEnrollmentByCourseAndUser(course.ID, user.ID); err != nil || enr.GroupID != 0 {
+			t.Errorf("expected %s to be removed from the group (enrollment=%v, err=%v)", user.Login, enr, err)
+		}
+	}
+
+	// deleted groups cannot be deleted again
+	if _, err := ags.DeleteGroup(ctx, &pb.GroupRequest{CourseID: course.ID, GroupID: group.ID}); err == nil {
+		t.Error("expected DeleteGroup to fail for a deleted group")
+	}
+}
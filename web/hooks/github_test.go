@@ -72,8 +72,8 @@ func TestGitHubWebHook(t *testing.T) {
 	}
 
 	var db database.Database
-	var runner ci.Runner
-	webhook := NewGitHubWebHook(logger, db, runner, secret)
+	queue := ci.NewQueue(logger, db, &ci.Local{}, ci.QueueOptions{})
+	webhook := NewGitHubWebHook(logger, db, queue, secret)
 
 	log.Println("starting webhook server")
 	http.HandleFunc("/webhook", webhook.Handle)
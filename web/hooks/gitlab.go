@@ -0,0 +1,83 @@
+package hooks
+
+import (
+	"crypto/subtle"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/log"
+	"github.com/xanzy/go-gitlab"
+	"go.uber.org/zap"
+)
+
+// gitlabTokenHeader is the header holding the secret token of GitLab webhook requests.
+const gitlabTokenHeader = "X-Gitlab-Token"
+
+// GitLabWebHook holds references and data for handling webhook events.
+type GitLabWebHook struct {
+	pushHandler
+	secret string
+}
+
+// NewGitLabWebHook creates a new webhook to handle POST requests from GitLab to the Autograder server.
+func NewGitLabWebHook(logger *zap.SugaredLogger, db database.Database, queue *ci.Queue, secret string) *GitLabWebHook {
+	return &GitLabWebHook{pushHandler: pushHandler{logger: logger, db: db, queue: queue}, secret: secret}
+}
+
+// Handle take POST requests from GitLab, representing Push events
+// associated with course repositories, which then triggers various
+// actions on the Autograder backend.
+func (wh GitLabWebHook) Handle(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get(gitlabTokenHeader)
+	if subtle.ConstantTimeCompare([]byte(token), []byte(wh.secret)) != 1 {
+		wh.logger.Errorf("Invalid %s header in webhook request", gitlabTokenHeader)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	defer r.Body.Close()
+	payload, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		wh.logger.Errorf("Error in request body: %v", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	event, err := gitlab.ParseWebhook(gitlab.HookEventType(r), payload)
+	if err != nil {
+		wh.logger.Errorf("Could not parse gitlab webhook: %v", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	switch e := event.(type) {
+	case *gitlab.PushEvent:
+		wh.logger.Debug(log.IndentJson(e))
+		wh.handlePush(fromGitLabPush(e))
+	default:
+		wh.logger.Debugf("Ignored event type %s", gitlab.HookEventType(r))
+	}
+}
+
+// fromGitLabPush converts a GitLab push event to a provider independent push event.
+func fromGitLabPush(e *gitlab.PushEvent) *pushEvent {
+	var changes []string
+	for _, commit := range e.Commits {
+		changes = append(changes, commit.Modified...)
+		changes = append(changes, commit.Added...)
+		changes = append(changes, commit.Removed...)
+	}
+	commitID := e.CheckoutSHA
+	if commitID == "" {
+		commitID = e.After
+	}
+	return &pushEvent{
+		ref:           e.Ref,
+		defaultBranch: e.Project.DefaultBranch,
+		repoID:        uint64(e.ProjectID),
+		repoName:      e.Project.Name,
+		commitID:      commitID,
+		sender:        e.UserUsername,
+		changes:       changes,
+	}
+}
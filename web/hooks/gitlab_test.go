@@ -0,0 +1,150 @@
+package hooks
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/database"
+	"go.uber.org/zap"
+)
+
+const gitlabPushEvent = `{
+	"object_kind": "push",
+	"ref": "refs/heads/master",
+	"checkout_sha": "deadbeef",
+	"user_username": "alice",
+	"project_id": 42,
+	"project": {"name": "alice-labs", "default_branch": "master", "path_with_namespace": "dat320/alice-labs"},
+	"commits": [
+		{"id": "deadbeef", "added": ["lab1/fib.go"], "modified": ["README.md"], "removed": []}
+	]
+}`
+
+func setupGitLabHook(t *testing.T) (*GitLabWebHook, database.Database, *pb.Course, func()) {
+	t.Helper()
+	f, err := ioutil.TempFile(os.TempDir(), "testdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		t.Fatal(err)
+	}
+	db, err := database.NewGormDB(f.Name(), zap.NewNop())
+	if err != nil {
+		os.Remove(f.Name())
+		t.Fatal(err)
+	}
+	cleanup := func() {
+		if err := os.Remove(f.Name()); err != nil {
+			t.Error(err)
+		}
+	}
+
+	user := &pb.User{}
+	if err := db.CreateUserFromRemoteIdentity(user, &pb.RemoteIdentity{Provider: "gitlab", RemoteID: 1}); err != nil {
+		t.Fatal(err)
+	}
+	course := &pb.Course{Code: "DAT320", OrganizationID: 1, Provider: "gitlab"}
+	if err := db.CreateCourse(user.GetID(), course); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateAssignment(&pb.Assignment{CourseID: course.GetID(), Name: "lab1", ScriptFile: "go", Order: 1}); err != nil {
+		t.Fatal(err)
+	}
+	repo := &pb.Repository{OrganizationID: 1, RepositoryID: 42, UserID: user.GetID(), RepoType: pb.Repository_USER}
+	if err := db.CreateRepository(repo); err != nil {
+		t.Fatal(err)
+	}
+	logger := zap.NewNop().Sugar()
+	queue := ci.NewQueue(logger, db, &ci.Local{}, ci.QueueOptions{})
+	return NewGitLabWebHook(logger, db, queue, secret), db, course, cleanup
+}
+
+func newGitLabRequest(token, body string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/hook/gitlab/events", strings.NewReader(body))
+	r.Header.Set("X-Gitlab-Event", "Push Hook")
+	if token != "" {
+		r.Header.Set(gitlabTokenHeader, token)
+	}
+	return r
+}
+
+func TestGitLabWebHookInvalidToken(t *testing.T) {
+	webhook, db, course, cleanup := setupGitLabHook(t)
+	defer cleanup()
+
+	for _, token := range []string{"", "wrong-secret"} {
+		w := httptest.NewRecorder()
+		webhook.Handle(w, newGitLabRequest(token, gitlabPushEvent))
+		if w.Code != http.StatusUnauthorized {
+			t.Errorf("Handle(token=%q) = %d, want %d", token, w.Code, http.StatusUnauthorized)
+		}
+	}
+	jobs, err := db.GetBuildJobs(course.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs) != 0 {
+		t.Errorf("have %d build jobs, want 0", len(jobs))
+	}
+}
+
+func TestGitLabWebHookInvalidPayload(t *testing.T) {
+	webhook, db, course, cleanup := setupGitLabHook(t)
+	defer cleanup()
+
+	for _, body := range []string{"", "not json", `{"object_kind": "push", "commits": "none"}`} {
+		w := httptest.NewRecorder()
+		webhook.Handle(w, newGitLabRequest(secret, body))
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("Handle(%q) = %d, want %d", body, w.Code, http.StatusBadRequest)
+		}
+	}
+	jobs, err := db.GetBuildJobs(course.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs) != 0 {
+		t.Errorf("have %d build jobs, want 0", len(jobs))
+	}
+}
+
+func TestGitLabWebHookPush(t *testing.T) {
+	webhook, db, course, cleanup := setupGitLabHook(t)
+	defer cleanup()
+
+	w := httptest.NewRecorder()
+	webhook.Handle(w, newGitLabRequest(secret, gitlabPushEvent))
+	if w.Code != http.StatusOK {
+		t.Errorf("Handle() = %d, want %d", w.Code, http.StatusOK)
+	}
+	jobs, err := db.GetBuildJobs(course.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs) != 1 {
+		t.Fatalf("have %d build jobs, want 1", len(jobs))
+	}
+	if jobs[0].GetCommitID() != "deadbeef" || jobs[0].GetJobOwner() != "alice" {
+		t.Errorf("have build job for commit %s by %s, want commit deadbeef by alice", jobs[0].GetCommitID(), jobs[0].GetJobOwner())
+	}
+
+	// pushes to other branches are ignored
+	w = httptest.NewRecorder()
+	featurePush := strings.NewReplacer("refs/heads/master", "refs/heads/feature", `"checkout_sha": "deadbeef"`, `"checkout_sha": "cafebabe"`).Replace(gitlabPushEvent)
+	webhook.Handle(w, newGitLabRequest(secret, featurePush))
+	jobs, err = db.GetBuildJobs(course.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs) != 1 || jobs[0].GetCommitID() != "deadbeef" {
+		t.Errorf("have build jobs %v, want only the job for commit deadbeef", jobs)
+	}
+}
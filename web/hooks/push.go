@@ -0,0 +1,195 @@
+package hooks
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/assignments"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/database"
+	"go.uber.org/zap"
+)
+
+// pushEvent holds the provider independent parts of a push event.
+type pushEvent struct {
+	ref           string   // pushed branch reference
+	defaultBranch string   // default branch of the repository
+	repoID        uint64   // remote repository ID
+	repoName      string   // repository name
+	commitID      string   // head commit of the push
+	sender        string   // login of the user that pushed
+	changes       []string // added, modified and removed files
+}
+
+// pushHandler dispatches push events to tests, user and group repositories.
+// It is shared by the webhooks of the different SCM providers.
+type pushHandler struct {
+	logger *zap.SugaredLogger
+	db     database.Database
+	queue  *ci.Queue
+}
+
+func (wh pushHandler) handlePush(payload *pushEvent) {
+	wh.logger.Debugf("Received push event for branch reference: %s (user's default branch: %s)",
+		payload.ref, payload.defaultBranch)
+	if !strings.HasSuffix(payload.ref, payload.defaultBranch) {
+		wh.logger.Debugf("Ignoring push event for non-default branch: %s", payload.ref)
+		return
+	}
+
+	repo, err := wh.db.GetRepositoryByRemoteID(payload.repoID)
+	if err != nil {
+		wh.logger.Errorf("Failed to get repository from database: %v", err)
+		return
+	}
+	wh.logger.Debugf("Received push event for repository %v", repo)
+
+	course, err := wh.db.GetCourseByOrganizationID(repo.OrganizationID)
+	if err != nil {
+		wh.logger.Errorf("Failed to get course from database: %v", err)
+		return
+	}
+	wh.logger.Debugf("For course(%d)=%v", course.GetID(), course.GetName())
+
+	switch {
+	case repo.IsTestsRepo():
+		// the push event is for the 'tests' repo, which means that we
+		// should update the course data (assignments) in the database
+		assignments.UpdateFromTestsRepo(wh.logger, wh.db, repo, course)
+		// build jobs copy the tests from the snapshot instead of cloning them
+		wh.queue.RefreshTests(course, repo)
+
+	case repo.IsUserRepo():
+		wh.logger.Debugf("Processing push event for user repo %s", payload.repoName)
+		wh.updateLastActivityDate(repo.UserID, course.ID)
+		assignments := wh.extractAssignments(payload, course)
+		for _, assignment := range assignments {
+			if !assignment.IsGroupLab {
+				// only run non-group assignments
+				wh.runAssignmentTests(assignment, repo, course, payload)
+			} else {
+				wh.logger.Debugf("Ignoring assignment: %s, pushed to user repo: %s", assignment.GetName(), payload.repoName)
+			}
+		}
+
+	case repo.IsGroupRepo():
+		wh.logger.Debugf("Processing push event for group repo %s", payload.repoName)
+		jobOwner, _, err := wh.db.GetUserByCourse(course, payload.sender)
+		if err != nil {
+			wh.logger.Errorf("Failed to find user %s in course %s: %v", payload.sender, course.GetName(), err)
+			return
+		}
+		wh.updateLastActivityDate(jobOwner.ID, course.ID)
+		assignments := wh.extractAssignments(payload, course)
+		for _, assignment := range assignments {
+			if assignment.IsGroupLab {
+				// only run group assignments
+				wh.runAssignmentTests(assignment, repo, course, payload)
+			} else {
+				wh.logger.Debugf("Ignoring assignment: %s, pushed to group repo: %s", assignment.GetName(), payload.repoName)
+			}
+		}
+
+	default:
+		wh.logger.Debug("Nothing to do for this push event")
+	}
+}
+
+// extractAssignments extracts information from the push payload
+// and determines the assignments that have been changed in this commit by
+// querying the database based on the lab name.
+func (wh pushHandler) extractAssignments(payload *pushEvent, course *pb.Course) []*pb.Assignment {
+	modifiedAssignments := make(map[string]bool)
+	extractChanges(payload.changes, modifiedAssignments)
+
+	var assignments []*pb.Assignment
+	for name := range modifiedAssignments {
+		// get assignment based on course id and assignment name
+		assignment, err := wh.db.GetAssignment(&pb.Assignment{Name: name, CourseID: course.GetID()})
+		if err != nil {
+			wh.logger.Errorf("Could not find assignment '%s' for course %d in database: %v", name, course.GetID(), err)
+			continue
+		}
+		assignments = append(assignments, assignment)
+	}
+	return assignments
+}
+
+func extractChanges(changes []string, modifiedAssignments map[string]bool) {
+	for _, changedFile := range changes {
+		index := strings.Index(changedFile, "/")
+		if index == -1 {
+			// ignore root-level files
+			continue
+		}
+		// we assume the first path component holds the assignment name
+		name := changedFile[:index]
+		if name == "" {
+			// ignore names that start with "/" or empty names
+			continue
+		}
+		modifiedAssignments[name] = true
+	}
+}
+
+// runAssignmentTests adds a build job to the build queue for the given assignment pushed to repo.
+func (wh pushHandler) runAssignmentTests(assignment *pb.Assignment, repo *pb.Repository, course *pb.Course, payload *pushEvent) {
+	runData := &ci.RunData{
+		Course:     course,
+		Assignment: assignment,
+		Repo:       repo,
+		CommitID:   payload.commitID,
+		JobOwner:   payload.sender,
+	}
+	if assignment.SkipTests {
+		wh.logger.Debugf("Assignment %s for course %s is manually reviewed", assignment.Name, course.Name)
+		wh.recordSubmissionWithoutTests(runData)
+		return
+	}
+	if _, err := wh.queue.Enqueue(runData); err != nil {
+		wh.logger.Errorf("Failed to queue build job for assignment %s for %s: %v", assignment.Name, runData.JobOwner, err)
+	}
+}
+
+// recordSubmissionWithoutTests saves a new submission without running any tests
+// for a manually graded assignment.
+func (wh pushHandler) recordSubmissionWithoutTests(data *ci.RunData) {
+	noTestBuildInfo, err := json.Marshal(&ci.BuildInfo{
+		BuildID:   0,
+		BuildDate: time.Now().Format(pb.TimeLayout),
+		BuildLog:  "No automated tests for this assignment",
+		ExecTime:  1,
+	})
+	if err != nil {
+		wh.logger.Errorf("Failed to marshal build info for course %s, assignment %s for student %s: %v", data.Course.Name, data.Assignment.Name, data.JobOwner, err)
+		return
+	}
+	newSubmission := &pb.Submission{
+		AssignmentID: data.Assignment.ID,
+		BuildInfo:    string(noTestBuildInfo),
+		CommitHash:   data.CommitID,
+		UserID:       data.Repo.UserID,
+		GroupID:      data.Repo.GroupID,
+	}
+	if err := wh.db.CreateSubmission(newSubmission); err != nil {
+		wh.logger.Errorf("Failed to save submission for user %s, assignment %d: %v", data.JobOwner, data.Assignment.ID, err)
+		return
+	}
+	wh.logger.Debugf("Saved manual review submission for user %s for assignment %d", data.JobOwner, data.Assignment.ID)
+}
+
+// updateLastActivityDate sets a current date as a last activity date of the student
+// on each new push to the student repository.
+func (wh pushHandler) updateLastActivityDate(userID, courseID uint64) {
+	query := &pb.Enrollment{
+		UserID:           userID,
+		CourseID:         courseID,
+		LastActivityDate: time.Now().Format(pb.DateLayout),
+	}
+
+	if err := wh.db.UpdateEnrollment(query); err != nil {
+		wh.logger.Errorf("Failed to update the last activity date for user %d: %v", userID, err)
+	}
+}
@@ -2,9 +2,11 @@ package web
 
 import (
 	"context"
+	"fmt"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/database"
 	"github.com/gosimple/slug"
 )
 
@@ -41,7 +43,14 @@ func (s *AutograderService) rebuildSubmission(ctx context.Context, request *pb.R
 		CommitID:   submission.GetCommitHash(),
 		JobOwner:   slug.Make(name),
 	}
-	ci.RunTests(s.logger, s.db, s.runner, runData)
+	job, err := s.queue.Run(ctx, runData)
+	if err != nil {
+		return nil, err
+	}
+	if job.GetStatus() != pb.BuildJob_DONE {
+		s.logger.Errorf("Rebuild job %d for submission %d ended with status %s: %s",
+			job.GetID(), submission.GetID(), job.GetStatus(), job.GetErrorMessage())
+	}
 	return s.db.GetSubmission(&pb.Submission{ID: request.GetSubmissionID()})
 }
 
@@ -53,3 +62,81 @@ func (s *AutograderService) lookupName(submission *pb.Submission) string {
 	user, _ := s.db.GetUser(submission.GetUserID())
 	return user.GetLogin()
 }
+
+// cancelBuildJob cancels the given build job if it belongs to the given course.
+func (s *AutograderService) cancelBuildJob(courseID, jobID uint64) error {
+	job, err := s.db.GetBuildJob(jobID)
+	if err != nil {
+		return err
+	}
+	if job.GetCourseID() != courseID {
+		return fmt.Errorf("build job %d does not belong to course %d", jobID, courseID)
+	}
+	return s.queue.Cancel(jobID)
+}
+
+// buildLogJobs returns the queued and running build jobs for the given commit in the given course,
+// whose output the given user may stream. Teachers may stream the output of all build jobs,
+// while students may only stream the output of build jobs for their own or their group's repository.
+// An error is returned if the user is not a student or teacher in the course.
+func (s *AutograderService) buildLogJobs(userID, courseID uint64, commitID string) ([]*pb.BuildJob, error) {
+	enrollment, err := s.db.GetEnrollmentByCourseAndUser(courseID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if enrollment.GetStatus() != pb.Enrollment_STUDENT && enrollment.GetStatus() != pb.Enrollment_TEACHER {
+		return nil, database.ErrNotEnrolled
+	}
+	jobs, err := s.db.GetBuildJobs(courseID, pb.BuildJob_QUEUED, pb.BuildJob_RUNNING)
+	if err != nil {
+		return nil, err
+	}
+	var commitJobs []*pb.BuildJob
+	for _, job := range jobs {
+		if job.GetCommitID() != commitID {
+			continue
+		}
+		if enrollment.GetStatus() == pb.Enrollment_STUDENT {
+			repos, err := s.db.GetRepositories(&pb.Repository{ID: job.GetRepositoryID()})
+			if err != nil || len(repos) != 1 {
+				continue
+			}
+			repo := repos[0]
+			if repo.GetUserID() != userID && (repo.GetGroupID() == 0 || repo.GetGroupID() != enrollment.GetGroupID()) {
+				continue
+			}
+		}
+		commitJobs = append(commitJobs, job)
+	}
+	return commitJobs, nil
+}
+
+// getBuildQuotas returns the build quotas of the given assignment, if it belongs to the given course.
+func (s *AutograderService) getBuildQuotas(courseID, assignmentID uint64) ([]*pb.BuildQuota, error) {
+	assignment, err := s.getCourseAssignment(courseID, assignmentID)
+	if err != nil {
+		return nil, err
+	}
+	return s.queue.Quotas(assignment)
+}
+
+// resetBuildQuota resets the build quota of the given repository for the given assignment,
+// if both the assignment and the repository belong to the given course.
+func (s *AutograderService) resetBuildQuota(courseID, assignmentID, repoID uint64) error {
+	assignment, err := s.getCourseAssignment(courseID, assignmentID)
+	if err != nil {
+		return err
+	}
+	course, err := s.db.GetCourse(courseID, false)
+	if err != nil {
+		return err
+	}
+	repos, err := s.db.GetRepositories(&pb.Repository{ID: repoID})
+	if err != nil {
+		return err
+	}
+	if len(repos) != 1 || repos[0].GetOrganizationID() != course.GetOrganizationID() {
+		return fmt.Errorf("repository %d does not belong to course %d", repoID, courseID)
+	}
+	return s.queue.ResetQuota(assignment, repoID)
+}
@@ -0,0 +1,40 @@
+package web
+
+import (
+	"context"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/plagiarism"
+	"github.com/autograde/quickfeed/scm"
+)
+
+// checkSimilarity compares the latest submissions for the requested assignment,
+// and stores and returns the pairs of similar submissions.
+func (s *AutograderService) checkSimilarity(ctx context.Context, sc scm.SCM, request *pb.SimilarityRequest) (*pb.SimilarityPairs, error) {
+	course, err := s.db.GetCourse(request.GetCourseID(), false)
+	if err != nil {
+		return nil, err
+	}
+	assignment, err := s.getCourseAssignment(request.GetCourseID(), request.GetAssignmentID())
+	if err != nil {
+		return nil, err
+	}
+	pairs, err := plagiarism.Check(ctx, s.logger, s.db, sc, course, assignment, plagiarism.Options{})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SimilarityPairs{Pairs: pairs}, nil
+}
+
+// getSimilarityPairs returns the stored pairs of similar submissions for the requested assignment.
+func (s *AutograderService) getSimilarityPairs(request *pb.SimilarityRequest) (*pb.SimilarityPairs, error) {
+	assignment, err := s.getCourseAssignment(request.GetCourseID(), request.GetAssignmentID())
+	if err != nil {
+		return nil, err
+	}
+	pairs, err := s.db.GetSimilarityPairs(assignment.GetID())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SimilarityPairs{Pairs: pairs}, nil
+}
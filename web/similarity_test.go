@@ -0,0 +1,179 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	similarityTemplate = `package lab1
+
+// Reverse returns the reversed string.
+func Reverse(s string) string {
+	// TODO(student): implement Reverse
+	return ""
+}
+`
+	similarityOriginal = `package lab1
+
+// Reverse returns the reversed string.
+func Reverse(s string) string {
+	runes := []rune(s)
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+	return string(runes)
+}
+`
+	// similarityCopy is similarityOriginal with renamed variables
+	similarityCopy = `package lab1
+
+// Reverse reverses s.
+func Reverse(str string) string {
+	r := []rune(str)
+	for a, b := 0, len(r)-1; a < b; a, b = a+1, b-1 {
+		r[a], r[b] = r[b], r[a]
+	}
+	return string(r)
+}
+`
+	similarityOther = `package lab1
+
+import "strings"
+
+// Reverse returns the reversed string.
+func Reverse(s string) string {
+	var sb strings.Builder
+	for i := len(s) - 1; i >= 0; i-- {
+		sb.WriteByte(s[i])
+	}
+	return sb.String()
+}
+`
+)
+
+func TestCheckSimilarity(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	fakeGothProvider()
+	teacher := createFakeUser(t, db, 1)
+	ctx := withUserContext(context.Background(), teacher)
+	fakeProvider, scms := fakeProviderMap(t)
+	fake := fakeProvider.(*scm.FakeSCM)
+	defer fake.Cleanup()
+	org, err := fake.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	course := &pb.Course{Provider: "fake", OrganizationID: org.GetID(), OrganizationPath: org.GetPath()}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	lab1 := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(lab1); err != nil {
+		t.Fatal(err)
+	}
+
+	createRepo := func(path string, files map[string]string) *scm.Repository {
+		t.Helper()
+		repo, err := fake.CreateRepository(ctx, &scm.CreateRepositoryOptions{Organization: org, Path: path})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := fake.CommitFiles(ctx, &scm.RepositoryOptions{ID: repo.ID}, files); err != nil {
+			t.Fatal(err)
+		}
+		return repo
+	}
+	createRepo(pb.AssignmentRepo, map[string]string{"lab1/reverse.go": similarityTemplate})
+
+	var students []*pb.User
+	for i, code := range []string{similarityOriginal, similarityCopy, similarityOther} {
+		student := createFakeUser(t, db, uint64(i+2))
+		enrollStudent(t, db, student, course)
+		repo := createRepo(pb.StudentRepoName(student.GetLogin()), map[string]string{
+			"README.md":       "# labs",
+			"lab1/reverse.go": code,
+		})
+		if err := db.CreateRepository(&pb.Repository{
+			OrganizationID: org.GetID(),
+			RepositoryID:   repo.ID,
+			UserID:         student.ID,
+			HTMLURL:        repo.WebURL,
+			RepoType:       pb.Repository_USER,
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.CreateSubmission(&pb.Submission{AssignmentID: lab1.ID, UserID: student.ID}); err != nil {
+			t.Fatal(err)
+		}
+		students = append(students, student)
+	}
+
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
+	request := &pb.SimilarityRequest{CourseID: course.ID, AssignmentID: lab1.ID}
+	studentCtx := withUserContext(context.Background(), students[0])
+	if _, err := ags.CheckSimilarity(studentCtx, request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("CheckSimilarity() as student: have error %v, want %v", err, codes.PermissionDenied)
+	}
+	if _, err := ags.GetSimilarityPairs(studentCtx, request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetSimilarityPairs() as student: have error %v, want %v", err, codes.PermissionDenied)
+	}
+
+	checked, err := ags.CheckSimilarity(ctx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pairs := checked.GetPairs()
+	if len(pairs) != 1 {
+		t.Fatalf("got %d pairs, want 1: %v", len(pairs), pairs)
+	}
+	pair := pairs[0]
+	if pair.GetOwnerA() != students[0].GetLogin() || pair.GetOwnerB() != students[1].GetLogin() {
+		t.Errorf("got pair (%s, %s), want (%s, %s)", pair.GetOwnerA(), pair.GetOwnerB(), students[0].GetLogin(), students[1].GetLogin())
+	}
+	if pair.GetSimilarity() < 90 {
+		t.Errorf("got similarity %d, want at least 90", pair.GetSimilarity())
+	}
+	if len(pair.GetMatches()) == 0 || pair.GetMatches()[0].GetFileA() != "reverse.go" {
+		t.Errorf("got matches %v, want matches in reverse.go", pair.GetMatches())
+	}
+
+	stored, err := ags.GetSimilarityPairs(ctx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stored.GetPairs()) != 1 || stored.GetPairs()[0].GetID() != pair.GetID() ||
+		len(stored.GetPairs()[0].GetMatches()) != len(pair.GetMatches()) {
+		t.Errorf("GetSimilarityPairs() = %v, want %v", stored.GetPairs(), pairs)
+	}
+
+	// checking again replaces the stored pairs instead of adding to them
+	if _, err := ags.CheckSimilarity(ctx, request); err != nil {
+		t.Fatal(err)
+	}
+	stored, err = ags.GetSimilarityPairs(ctx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stored.GetPairs()) != 1 || len(stored.GetPairs()[0].GetMatches()) != len(pair.GetMatches()) {
+		t.Errorf("GetSimilarityPairs() = %v, want %v", stored.GetPairs(), pairs)
+	}
+
+	// the assignment must belong to the course
+	otherCourse := &pb.Course{Provider: "fake", OrganizationID: org.GetID() + 1}
+	if err := db.CreateCourse(teacher.ID, otherCourse); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ags.GetSimilarityPairs(ctx, &pb.SimilarityRequest{CourseID: otherCourse.ID, AssignmentID: lab1.ID}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetSimilarityPairs() for other course: have error %v, want %v", err, codes.NotFound)
+	}
+}
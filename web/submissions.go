@@ -0,0 +1,137 @@
+package web
+
+import (
+	"fmt"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/events"
+	"google.golang.org/protobuf/proto"
+)
+
+// submissionFilter returns a filter for the course submissions that the given user may receive.
+// Teachers may receive all course submissions, while students may only receive their own
+// submissions and the submissions of the group they belong to when subscribing.
+// An error is returned if the user is not a student or teacher in the course.
+func (s *AutograderService) submissionFilter(userID, courseID uint64) (events.SubmissionFilter, error) {
+	enrollment, err := s.db.GetEnrollmentByCourseAndUser(courseID, userID)
+	if err != nil {
+		return nil, err
+	}
+	switch enrollment.GetStatus() {
+	case pb.Enrollment_TEACHER:
+		return func(e *events.SubmissionEvent) bool {
+			return e.CourseID == courseID
+		}, nil
+	case pb.Enrollment_STUDENT:
+		groupID := enrollment.GetGroupID()
+		return func(e *events.SubmissionEvent) bool {
+			return e.CourseID == courseID &&
+				(e.Submission.GetUserID() == userID || groupID > 0 && e.Submission.GetGroupID() == groupID)
+		}, nil
+	}
+	return nil, database.ErrNotEnrolled
+}
+
+// publishSubmission publishes the given submission to the subscribers of the course.
+func (s *AutograderService) publishSubmission(courseID uint64, submission *pb.Submission) {
+	if err := submission.MakeSubmissionReviews(); err != nil {
+		s.logger.Errorf("Failed to publish submission %d: %v", submission.GetID(), err)
+		return
+	}
+	s.queue.Submissions().Publish(courseID, submission)
+}
+
+// publishReviewedSubmission publishes the submission of the given review to the subscribers of the course,
+// provided that the review belongs to the submission and the submission belongs to the course.
+func (s *AutograderService) publishReviewedSubmission(courseID uint64, review *pb.Review) {
+	if review.GetSubmissionID() == 0 {
+		return
+	}
+	submission, err := s.db.GetSubmission(&pb.Submission{ID: review.GetSubmissionID()})
+	if err != nil {
+		s.logger.Errorf("Failed to publish submission %d: %v", review.GetSubmissionID(), err)
+		return
+	}
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: submission.GetAssignmentID()})
+	if err != nil {
+		s.logger.Errorf("Failed to publish submission %d: %v", submission.GetID(), err)
+		return
+	}
+	if assignment.GetCourseID() != courseID || !submission.HasReview(review.GetID()) {
+		s.logger.Errorf("Failed to publish submission %d: review %d does not belong to submission in course %d", submission.GetID(), review.GetID(), courseID)
+		return
+	}
+	s.publishSubmission(courseID, submission)
+}
+
+// getCourseSubmission returns the given submission if it belongs to the given course,
+// and the given user may access it: teachers may access all course submissions, while
+// students may only access their own submissions and the submissions of their group.
+func (s *AutograderService) getCourseSubmission(userID, courseID, submissionID uint64) (*pb.Submission, error) {
+	submission, err := s.db.GetSubmission(&pb.Submission{ID: submissionID})
+	if err != nil {
+		return nil, err
+	}
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: submission.GetAssignmentID()})
+	if err != nil {
+		return nil, err
+	}
+	if assignment.GetCourseID() != courseID {
+		return nil, fmt.Errorf("submission %d does not belong to course %d", submissionID, courseID)
+	}
+	filter, err := s.submissionFilter(userID, courseID)
+	if err != nil {
+		return nil, err
+	}
+	if !filter(&events.SubmissionEvent{CourseID: courseID, Submission: submission}) {
+		return nil, fmt.Errorf("user %d may not access submission %d", userID, submissionID)
+	}
+	return submission, nil
+}
+
+// updateGradedAttempt makes the given attempt the graded attempt of its submission,
+// and publishes the updated submission to the subscribers of the course.
+func (s *AutograderService) updateGradedAttempt(request *pb.SubmissionAttemptRequest) (*pb.Submission, error) {
+	if err := s.db.UpdateGradedAttempt(request.GetSubmissionID(), request.GetAttemptID()); err != nil {
+		return nil, err
+	}
+	submission, err := s.db.GetSubmission(&pb.Submission{ID: request.GetSubmissionID()})
+	if err != nil {
+		return nil, err
+	}
+	s.publishSubmission(request.GetCourseID(), submission)
+	return submission, nil
+}
+
+// withholdsHiddenResults returns true if the results of the hidden tests of the given
+// submission are withheld from the given user, since the user is not a teacher of the
+// course and the submission has not been released.
+func (s *AutograderService) withholdsHiddenResults(userID, courseID uint64, submission *pb.Submission) bool {
+	return !submission.GetReleased() && !s.isTeacher(userID, courseID)
+}
+
+// withholdHiddenResults removes the results of the hidden tests from the given
+// submissions that have not been released, unless the user is a teacher of the course.
+func (s *AutograderService) withholdHiddenResults(userID, courseID uint64, submissions []*pb.Submission) {
+	if s.isTeacher(userID, courseID) {
+		return
+	}
+	for _, submission := range submissions {
+		if !submission.GetReleased() {
+			submission.RemoveHiddenResults()
+		}
+	}
+}
+
+// visibleSubmission returns the given published submission, or a copy without the results
+// of the hidden tests if the submission has not been released and teacher is false.
+// Published submissions are shared by all subscribers, and must not be modified.
+func visibleSubmission(submission *pb.Submission, teacher bool) *pb.Submission {
+	if teacher || submission.GetReleased() {
+		return submission
+	}
+	visible := proto.Clone(submission).(*pb.Submission)
+	visible.RemoveHiddenResults()
+	return visible
+}
@@ -2,15 +2,22 @@ package web_test
 
 import (
 	"context"
+	"net"
 	"reflect"
+	"strconv"
 	"testing"
+	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
-	"github.com/autograde/quickfeed/ci"
 	"github.com/autograde/quickfeed/log"
 	"github.com/autograde/quickfeed/scm"
 	"github.com/autograde/quickfeed/web"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
 )
 
 func TestSubmissionsAccess(t *testing.T) {
@@ -63,7 +70,7 @@ func TestSubmissionsAccess(t *testing.T) {
 	}
 
 	fakeProvider, scms := fakeProviderMap(t)
-	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
 	ctx := withUserContext(context.Background(), teacher)
 
 	_, err = fakeProvider.CreateOrganization(context.Background(), &scm.OrganizationOptions{Path: "path", Name: "name"})
@@ -296,7 +303,7 @@ func TestApproveSubmission(t *testing.T) {
 	}
 
 	fakeProvider, scms := fakeProviderMap(t)
-	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
 	ctx := withUserContext(context.Background(), admin)
 
 	_, err = fakeProvider.CreateOrganization(context.Background(), &scm.OrganizationOptions{Path: "path", Name: "name"})
@@ -445,7 +452,7 @@ func TestGetCourseLabSubmissions(t *testing.T) {
 	}
 
 	fakeProvider, scms := fakeProviderMap(t)
-	ags := web.NewAutograderService(log.Zap(false), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ags := web.NewAutograderService(log.Zap(false), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
 	ctx := withUserContext(context.Background(), admin)
 
 	_, err := fakeProvider.CreateOrganization(context.Background(), &scm.OrganizationOptions{Path: "path", Name: "name"})
@@ -633,7 +640,7 @@ func TestCreateApproveList(t *testing.T) {
 	}
 
 	fakeProvider, scms := fakeProviderMap(t)
-	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
 	ctx := withUserContext(context.Background(), admin)
 	_, err := fakeProvider.CreateOrganization(context.Background(), &scm.OrganizationOptions{Path: "path", Name: "name"})
 	if err != nil {
@@ -724,3 +731,320 @@ func isApproved(requirements int, approved []bool) bool {
 	}
 	return requirements <= 0
 }
+
+// serveBufconn serves the given service over an in-memory connection,
+// and returns a client connected to it.
+func serveBufconn(t *testing.T, ags *web.AutograderService) (pb.AutograderServiceClient, func()) {
+	t.Helper()
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	pb.RegisterAutograderServiceServer(srv, ags)
+	go srv.Serve(lis)
+	conn, err := grpc.DialContext(context.Background(), "bufconn",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pb.NewAutograderServiceClient(conn), func() {
+		conn.Close()
+		srv.Stop()
+	}
+}
+
+// withOutgoingUser returns a client context for requests on behalf of the given user.
+func withOutgoingUser(ctx context.Context, user *pb.User) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "user", strconv.FormatUint(user.GetID(), 10))
+}
+
+func waitForSubscribers(t *testing.T, hub interface{ Subscribers() int }, want int) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for hub.Subscribers() != want {
+		if time.Now().After(deadline) {
+			t.Fatalf("got %d subscribers, want %d", hub.Subscribers(), want)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func recvSubmission(t *testing.T, stream pb.AutograderService_SubmissionStreamClient, wantID uint64, wantStatus pb.Submission_Status) {
+	t.Helper()
+	submission, err := stream.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if submission.GetID() != wantID || submission.GetStatus() != wantStatus {
+		t.Errorf("got submission %d with status %s, want submission %d with status %s",
+			submission.GetID(), submission.GetStatus(), wantID, wantStatus)
+	}
+}
+
+func TestSubmissionStream(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := &pb.Course{Provider: "fake", OrganizationID: 1}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	student1 := createFakeUser(t, db, 2)
+	enrollStudent(t, db, student1, course)
+	student2 := createFakeUser(t, db, 3)
+	enrollStudent(t, db, student2, course)
+	outsider := createFakeUser(t, db, 4)
+
+	lab := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+	submission1 := &pb.Submission{AssignmentID: lab.ID, UserID: student1.ID}
+	if err := db.CreateSubmission(submission1); err != nil {
+		t.Fatal(err)
+	}
+	submission2 := &pb.Submission{AssignmentID: lab.ID, UserID: student2.ID}
+	if err := db.CreateSubmission(submission2); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := fakeProviderMap(t)
+	queue := newLocalQueue(db)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, queue)
+	client, stop := serveBufconn(t, ags)
+	defer stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	request := &pb.CourseRequest{CourseID: course.ID}
+	teacherStream, err := client.SubmissionStream(withOutgoingUser(ctx, teacher), request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	student1Stream, err := client.SubmissionStream(withOutgoingUser(ctx, student1), request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	student2Stream, err := client.SubmissionStream(withOutgoingUser(ctx, student2), request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	outsiderStream, err := client.SubmissionStream(withOutgoingUser(ctx, outsider), request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := outsiderStream.Recv(); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("outsider got error %v, want code %s", err, codes.PermissionDenied)
+	}
+	waitForSubscribers(t, queue.Submissions(), 3)
+
+	// approving a submission notifies the teacher and the student
+	teacherCtx := withUserContext(context.Background(), teacher)
+	if _, err := ags.UpdateSubmission(teacherCtx, &pb.UpdateSubmissionRequest{
+		CourseID:     course.ID,
+		SubmissionID: submission1.ID,
+		Status:       pb.Submission_APPROVED,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	recvSubmission(t, teacherStream, submission1.ID, pb.Submission_APPROVED)
+	recvSubmission(t, student1Stream, submission1.ID, pb.Submission_APPROVED)
+
+	// a recorded build result notifies the teacher and the student
+	queue.Submissions().Publish(course.ID, submission2)
+	recvSubmission(t, teacherStream, submission2.ID, pb.Submission_NONE)
+	// student 2 did not receive student 1's submission
+	recvSubmission(t, student2Stream, submission2.ID, pb.Submission_NONE)
+
+	if _, err := ags.UpdateSubmission(teacherCtx, &pb.UpdateSubmissionRequest{
+		CourseID:     course.ID,
+		SubmissionID: submission1.ID,
+		Status:       pb.Submission_REJECTED,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	recvSubmission(t, teacherStream, submission1.ID, pb.Submission_REJECTED)
+	// student 1 did not receive student 2's submission
+	recvSubmission(t, student1Stream, submission1.ID, pb.Submission_REJECTED)
+
+	// canceling the streams unsubscribes from the hub
+	cancel()
+	if _, err := teacherStream.Recv(); status.Code(err) != codes.Canceled {
+		t.Errorf("got error %v, want code %s", err, codes.Canceled)
+	}
+	waitForSubscribers(t, queue.Submissions(), 0)
+}
+
+func TestSubmissionAttempts(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := &pb.Course{Provider: "fake", OrganizationID: 1}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	student := createFakeUser(t, db, 2)
+	enrollStudent(t, db, student, course)
+	other := createFakeUser(t, db, 3)
+	enrollStudent(t, db, other, course)
+
+	lab := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+	var attemptIDs []uint64
+	for i, commit := range []string{"abc", "def"} {
+		submission := &pb.Submission{AssignmentID: lab.ID, UserID: student.ID, CommitHash: commit, Score: uint32(50 + i*40), BuildInfo: "log " + commit}
+		if err := db.CreateSubmission(submission); err != nil {
+			t.Fatal(err)
+		}
+		attemptIDs = append(attemptIDs, submission.GradedAttemptID)
+	}
+	submission, err := db.GetSubmission(&pb.Submission{AssignmentID: lab.ID, UserID: student.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := fakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
+	studentCtx := withUserContext(context.Background(), student)
+	teacherCtx := withUserContext(context.Background(), teacher)
+	request := &pb.SubmissionAttemptRequest{CourseID: course.ID, SubmissionID: submission.ID}
+
+	for _, ctx := range []context.Context{studentCtx, teacherCtx} {
+		attempts, err := ags.GetSubmissionAttempts(ctx, request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(attempts.GetAttempts()) != 2 || attempts.GetAttempts()[0].GetCommitHash() != "abc" ||
+			attempts.GetAttempts()[1].GetCommitHash() != "def" || attempts.GetAttempts()[0].GetBuildInfo() != "" {
+			t.Errorf("GetSubmissionAttempts() = %v, want attempts for abc and def without build info", attempts.GetAttempts())
+		}
+	}
+	otherCtx := withUserContext(context.Background(), other)
+	if _, err := ags.GetSubmissionAttempts(otherCtx, request); status.Code(err) != codes.NotFound {
+		t.Errorf("GetSubmissionAttempts() as other student: have error %v, want %v", err, codes.NotFound)
+	}
+
+	attemptRequest := &pb.SubmissionAttemptRequest{CourseID: course.ID, SubmissionID: submission.ID, AttemptID: attemptIDs[0]}
+	attempt, err := ags.GetSubmissionAttempt(studentCtx, attemptRequest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attempt.GetBuildInfo() != "log abc" || attempt.GetScore() != 50 {
+		t.Errorf("GetSubmissionAttempt() = %v, want the first attempt with its build log", attempt)
+	}
+	if _, err := ags.GetSubmissionAttempt(otherCtx, attemptRequest); status.Code(err) != codes.NotFound {
+		t.Errorf("GetSubmissionAttempt() as other student: have error %v, want %v", err, codes.NotFound)
+	}
+
+	if _, err := ags.UpdateGradedAttempt(studentCtx, attemptRequest); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("UpdateGradedAttempt() as student: have error %v, want %v", err, codes.PermissionDenied)
+	}
+	graded, err := ags.UpdateGradedAttempt(teacherCtx, attemptRequest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if graded.GetGradedAttemptID() != attemptIDs[0] || graded.GetScore() != 50 || graded.GetCommitHash() != "abc" {
+		t.Errorf("UpdateGradedAttempt() = %v, want the results of the first attempt", graded)
+	}
+	// the attempt must belong to the submission
+	otherSubmission := &pb.Submission{AssignmentID: lab.ID, UserID: other.ID}
+	if err := db.CreateSubmission(otherSubmission); err != nil {
+		t.Fatal(err)
+	}
+	attemptRequest.AttemptID = otherSubmission.GradedAttemptID
+	if _, err := ags.UpdateGradedAttempt(teacherCtx, attemptRequest); status.Code(err) != codes.NotFound {
+		t.Errorf("UpdateGradedAttempt() with other submission's attempt: have error %v, want %v", err, codes.NotFound)
+	}
+}
+
+func TestHiddenTestResults(t *testing.T) {
+	db, cleanup := setup(t)
+	defer cleanup()
+
+	teacher := createFakeUser(t, db, 1)
+	course := &pb.Course{Provider: "fake", OrganizationID: 1}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	student := createFakeUser(t, db, 2)
+	enrollStudent(t, db, student, course)
+
+	lab := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, HiddenTests: "hidden_test.go"}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{
+		AssignmentID:       lab.ID,
+		UserID:             student.ID,
+		CommitHash:         "abc",
+		Score:              80,
+		HiddenScore:        50,
+		HiddenScoreObjects: "hidden scores",
+		HiddenBuildInfo:    "hidden log",
+		TestResults: []*pb.TestResult{
+			{TestName: "TestVisible", Score: 80, MaxScore: 100},
+			{TestName: "TestHidden", Score: 50, MaxScore: 100, Hidden: true},
+		},
+	}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := fakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
+	studentCtx := withUserContext(context.Background(), student)
+	teacherCtx := withUserContext(context.Background(), teacher)
+	request := &pb.SubmissionRequest{CourseID: course.ID, UserID: student.ID}
+	attemptRequest := &pb.SubmissionAttemptRequest{CourseID: course.ID, SubmissionID: submission.ID, AttemptID: submission.GradedAttemptID}
+
+	// hasHiddenResults returns true if the submission or attempt has any results of hidden tests
+	hasHiddenResults := func(hiddenScore uint32, hiddenBuildInfo string, results []*pb.TestResult) bool {
+		hidden := hiddenScore > 0 || hiddenBuildInfo != ""
+		for _, result := range results {
+			hidden = hidden || result.GetHidden()
+		}
+		return hidden
+	}
+	check := func(ctx context.Context, who string, wantHidden bool) {
+		t.Helper()
+		submissions, err := ags.GetSubmissions(ctx, request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(submissions.GetSubmissions()) != 1 {
+			t.Fatalf("GetSubmissions() as %s = %v, want one submission", who, submissions.GetSubmissions())
+		}
+		got := submissions.GetSubmissions()[0]
+		if hasHiddenResults(got.GetHiddenScore(), got.GetHiddenBuildInfo(), got.GetTestResults()) != wantHidden || len(got.GetTestResults()) == 0 {
+			t.Errorf("GetSubmissions() as %s = %v, want hidden results: %t", who, got, wantHidden)
+		}
+		attempt, err := ags.GetSubmissionAttempt(ctx, attemptRequest)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hasHiddenResults(attempt.GetHiddenScore(), attempt.GetHiddenBuildInfo(), attempt.GetTestResults()) != wantHidden {
+			t.Errorf("GetSubmissionAttempt() as %s = %v, want hidden results: %t", who, attempt, wantHidden)
+		}
+		attempts, err := ags.GetSubmissionAttempts(ctx, attemptRequest)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(attempts.GetAttempts()) != 1 || (attempts.GetAttempts()[0].GetHiddenScore() > 0) != wantHidden {
+			t.Errorf("GetSubmissionAttempts() as %s = %v, want hidden results: %t", who, attempts.GetAttempts(), wantHidden)
+		}
+	}
+	check(teacherCtx, "teacher", true)
+	check(studentCtx, "student", false)
+
+	// the hidden results are shown to students when the submission is released
+	if err := db.ReleaseReviews(lab.ID, time.Now().Format(pb.TimeLayout)); err != nil {
+		t.Fatal(err)
+	}
+	check(studentCtx, "student after release", true)
+}
@@ -2,12 +2,12 @@ package web_test
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	pb "github.com/autograde/quickfeed/ag"
-	"github.com/autograde/quickfeed/ci"
 	"github.com/autograde/quickfeed/database"
 	"github.com/autograde/quickfeed/web"
 	"github.com/autograde/quickfeed/web/auth"
@@ -52,7 +52,7 @@ func TestGetUsers(t *testing.T) {
 	defer cleanup()
 
 	_, scms := fakeProviderMap(t)
-	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
 	unexpectedUsers, err := ags.GetUsers(context.Background(), &pb.Void{})
 	if err == nil && unexpectedUsers != nil && len(unexpectedUsers.GetUsers()) > 0 {
 		t.Fatalf("found unexpected users %+v", unexpectedUsers)
@@ -116,7 +116,7 @@ func TestGetEnrollmentsByCourse(t *testing.T) {
 	}
 
 	_, scms := fakeProviderMap(t)
-	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
 	ctx := withUserContext(context.Background(), admin)
 
 	// users to enroll in course DAT520 Distributed Systems
@@ -196,7 +196,7 @@ func TestEnrollmentsWithoutGroupMembership(t *testing.T) {
 	admin := users[0]
 
 	_, scms := fakeProviderMap(t)
-	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
 	ctx := withUserContext(context.Background(), admin)
 
 	course := allCourses[1]
@@ -279,7 +279,7 @@ func TestUpdateUser(t *testing.T) {
 	nonAdminUser := createFakeUser(t, db, 11)
 
 	_, scms := fakeProviderMap(t)
-	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
 	ctx := withUserContext(context.Background(), firstAdminUser)
 
 	// we want to update nonAdminUser to become admin
@@ -324,6 +324,7 @@ func TestUpdateUser(t *testing.T) {
 		StudentID:        "99",
 		Email:            "test@test.com",
 		AvatarURL:        "www.hello.com",
+		Login:            nonAdminUser.Login,
 		RemoteIdentities: nonAdminUser.RemoteIdentities,
 	}
 
@@ -340,7 +341,7 @@ func TestUpdateUserFailures(t *testing.T) {
 	createFakeUser(t, db, 11)
 
 	_, scms := fakeProviderMap(t)
-	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, newLocalQueue(db))
 
 	u := createFakeUser(t, db, 3)
 	if u.IsAdmin {
@@ -397,6 +398,7 @@ func TestUpdateUserFailures(t *testing.T) {
 		StudentID:        "99",
 		Email:            "test@test.com",
 		AvatarURL:        "www.hello.com",
+		Login:            u.Login,
 		RemoteIdentities: u.RemoteIdentities,
 	}
 
@@ -407,9 +409,10 @@ func TestUpdateUserFailures(t *testing.T) {
 
 // createFakeUser is a test helper to create a user in the database
 // with the given remote id and the fake scm provider.
+// The user's login is derived from the remote id.
 func createFakeUser(t *testing.T, db database.Database, remoteID uint64) *pb.User {
 	t.Helper()
-	var user pb.User
+	user := pb.User{Login: fakeLogin(remoteID)}
 	err := db.CreateUserFromRemoteIdentity(&user,
 		&pb.RemoteIdentity{
 			Provider:    "fake",
@@ -424,7 +427,7 @@ func createFakeUser(t *testing.T, db database.Database, remoteID uint64) *pb.Use
 
 func createNamedUser(t *testing.T, db database.Database, remoteID uint64, name string) *pb.User {
 	t.Helper()
-	user := &pb.User{Name: name}
+	user := &pb.User{Name: name, Login: fakeLogin(remoteID)}
 	err := db.CreateUserFromRemoteIdentity(user,
 		&pb.RemoteIdentity{
 			Provider:    "fake",
@@ -437,6 +440,11 @@ func createNamedUser(t *testing.T, db database.Database, remoteID uint64, name s
 	return user
 }
 
+// fakeLogin returns the login name of the fake user with the given remote id.
+func fakeLogin(remoteID uint64) string {
+	return fmt.Sprintf("user%d", remoteID)
+}
+
 func enrollStudent(t *testing.T, db database.Database, student *pb.User, course *pb.Course) {
 	t.Helper()
 	if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
@@ -6,6 +6,7 @@ import (
 	"os"
 	"os/signal"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/autograde/quickfeed/web/auth"
@@ -92,6 +93,11 @@ func enableProviders(l *zap.SugaredLogger, baseURL string, fake bool) map[string
 		StudentScopes: []string{"read_user"},
 		TeacherScopes: []string{"api"},
 	}, func(key, secret, callback string, scopes ...string) goth.Provider {
+		// GITLAB_URL can be set to use a self-hosted GitLab instance
+		if gitlabURL := strings.TrimSuffix(os.Getenv("GITLAB_URL"), "/"); gitlabURL != "" {
+			return gitlab.NewCustomisedURL(key, secret, callback,
+				gitlabURL+"/oauth/authorize", gitlabURL+"/oauth/token", gitlabURL+"/api/v4/user", scopes...)
+		}
 		return gitlab.New(key, secret, callback, scopes...)
 	}); ok {
 		enabled["gitlab"] = true
@@ -114,15 +120,14 @@ func enableProviders(l *zap.SugaredLogger, baseURL string, fake bool) map[string
 
 func registerWebhooks(ags *AutograderService, e *echo.Echo, enabled map[string]bool, scriptPath string) {
 	if enabled["github"] {
-		ghHook := hooks.NewGitHubWebHook(ags.logger, ags.db, ags.runner, ags.bh.Secret)
+		ghHook := hooks.NewGitHubWebHook(ags.logger, ags.db, ags.queue, ags.bh.Secret)
 		e.POST("/hook/github/events", func(c echo.Context) error {
 			ghHook.Handle(c.Response(), c.Request())
 			return nil
 		})
 	}
 	if enabled["gitlab"] {
-		//TODO(meling) fix gitlab
-		glHook := hooks.NewGitHubWebHook(ags.logger, ags.db, ags.runner, ags.bh.Secret)
+		glHook := hooks.NewGitLabWebHook(ags.logger, ags.db, ags.queue, ags.bh.Secret)
 		e.POST("/hook/gitlab/events", func(c echo.Context) error {
 			glHook.Handle(c.Response(), c.Request())
 			return nil